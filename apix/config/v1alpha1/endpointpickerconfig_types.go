@@ -67,6 +67,16 @@ type EndpointPickerConfig struct {
 	// Parser specifies the parsing logic used by the EPP to process protocol messages.
 	// If unspecified, default parsing behavior will be applied.
 	Parser *ParserConfig `json:"parser,omitempty"`
+
+	// +optional
+	// RequestControl configures the request control plugins.
+	RequestControl *RequestControlConfig `json:"requestControl,omitempty"`
+
+	// +optional
+	// Persistence configures where the soft state of the plugins, e.g. the prefix cache index,
+	// the in-flight load counters and the performance fingerprints, is persisted. If omitted, the
+	// state is kept in memory only and lost on restart.
+	Persistence *PersistenceConfig `json:"persistence,omitempty"`
 }
 
 func (cfg EndpointPickerConfig) String() string {
@@ -92,6 +102,12 @@ func (cfg EndpointPickerConfig) String() string {
 	if cfg.Parser != nil {
 		parts = append(parts, fmt.Sprintf("Parser: %v", cfg.Parser))
 	}
+	if cfg.RequestControl != nil {
+		parts = append(parts, fmt.Sprintf("RequestControl: %v", cfg.RequestControl))
+	}
+	if cfg.Persistence != nil {
+		parts = append(parts, fmt.Sprintf("Persistence: %v", cfg.Persistence))
+	}
 	return "{" + strings.Join(parts, ", ") + "}"
 }
 
@@ -139,6 +155,19 @@ type SchedulingProfile struct {
 	// Plugins is the list of plugins for this SchedulingProfile. They are assigned
 	// to the appropriate "slots" based on their type.
 	Plugins []SchedulingPlugin `json:"plugins"`
+
+	// +optional
+	// Shadow marks this SchedulingProfile as a shadow profile. A shadow profile runs on a sampled
+	// fraction of the requests, after the other profiles, and its decision is only recorded and
+	// compared with the decision of the primary profile: it never affects routing. Shadow profiles
+	// are not passed to the profile handler.
+	Shadow *ShadowProfileConfig `json:"shadow,omitempty"`
+
+	// +optional
+	// Budget bounds the execution time of this SchedulingProfile. Once the budget is
+	// exceeded, the remaining scorers are skipped and the endpoint is picked by a
+	// fast-path picker.
+	Budget *SchedulingBudget `json:"budget,omitempty"`
 }
 
 func (sp SchedulingProfile) String() string {
@@ -147,6 +176,60 @@ func (sp SchedulingProfile) String() string {
 	if len(sp.Plugins) > 0 {
 		parts = append(parts, fmt.Sprintf("Plugins: %v", sp.Plugins))
 	}
+	if sp.Shadow != nil {
+		parts = append(parts, fmt.Sprintf("Shadow: %v", *sp.Shadow))
+	}
+	if sp.Budget != nil {
+		parts = append(parts, fmt.Sprintf("Budget: %v", *sp.Budget))
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+// ShadowProfileConfig configures a shadow SchedulingProfile.
+type ShadowProfileConfig struct {
+	// +optional
+	// SampleRate is the fraction of the requests, between 0 and 1, on which the shadow profile runs.
+	// If omitted, the shadow profile runs on all the requests.
+	SampleRate *float64 `json:"sampleRate,omitempty"`
+}
+
+func (sc ShadowProfileConfig) String() string {
+	if sc.SampleRate == nil {
+		return "{}"
+	}
+	return fmt.Sprintf("{SampleRate: %v}", *sc.SampleRate)
+}
+
+// SchedulingBudget bounds the execution time of a SchedulingProfile.
+type SchedulingBudget struct {
+	// +optional
+	// Timeout is the time budget of a run of the profile, including its filters and
+	// scorers. Scorers not started before the budget is exceeded are skipped.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// +optional
+	// PluginTimeout is the time budget of each scorer of the profile. A scorer which
+	// does not complete within its budget is abandoned, and the remaining scorers are
+	// skipped. It can be overridden per scorer with the Timeout of the SchedulingPlugin.
+	PluginTimeout *metav1.Duration `json:"pluginTimeout,omitempty"`
+
+	// +optional
+	// FallbackPickerRef is the name of the picker plugin used when the budget is
+	// exceeded. If omitted, a random endpoint is picked among the filtered endpoints.
+	FallbackPickerRef string `json:"fallbackPickerRef,omitempty"`
+}
+
+func (sb SchedulingBudget) String() string {
+	var parts []string
+	if sb.Timeout != nil {
+		parts = append(parts, fmt.Sprintf("Timeout: %s", sb.Timeout.Duration))
+	}
+	if sb.PluginTimeout != nil {
+		parts = append(parts, fmt.Sprintf("PluginTimeout: %s", sb.PluginTimeout.Duration))
+	}
+	if sb.FallbackPickerRef != "" {
+		parts = append(parts, "FallbackPickerRef: "+sb.FallbackPickerRef)
+	}
 	return "{" + strings.Join(parts, ", ") + "}"
 }
 
@@ -164,6 +247,16 @@ type SchedulingPlugin struct {
 	// +optional
 	// Weight is the weight to be used if this plugin is a Scorer.
 	Weight *float64 `json:"weight"`
+
+	// +optional
+	// Optional marks a Scorer as optional. Optional scorers are skipped while
+	// the EPP is under resource pressure, see --enable-self-pressure-degradation.
+	Optional bool `json:"optional,omitempty"`
+
+	// +optional
+	// Timeout is the time budget of this plugin if it is a Scorer, overriding the
+	// PluginTimeout of the SchedulingProfile's Budget.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
 }
 
 func (sp SchedulingPlugin) String() string {
@@ -172,6 +265,12 @@ func (sp SchedulingPlugin) String() string {
 	if sp.Weight != nil {
 		parts = append(parts, fmt.Sprintf("Weight: %.2f", *sp.Weight))
 	}
+	if sp.Optional {
+		parts = append(parts, "Optional: true")
+	}
+	if sp.Timeout != nil {
+		parts = append(parts, fmt.Sprintf("Timeout: %s", sp.Timeout.Duration))
+	}
 	return "{" + strings.Join(parts, ", ") + "}"
 }
 
@@ -284,6 +383,108 @@ type ParserConfig struct {
 	PluginRef string `json:"pluginRef"`
 }
 
+func (rc *RequestControlConfig) String() string {
+	if rc == nil {
+		return nilString
+	}
+	return fmt.Sprintf("{RequestMutatorRefs: %v, SchedulingHints: %v}", rc.RequestMutatorRefs, rc.SchedulingHints)
+}
+
+// RequestControlConfig contains the configuration of the request control plugins.
+type RequestControlConfig struct {
+	// +optional
+	// RequestMutatorRefs specifies the order in which the RequestMutator plugins mutate the
+	// request forwarded to the model server. The references are to the names of entries of
+	// the Plugins defined in the configuration's Plugins section. RequestMutator plugins that
+	// are not referenced run after the referenced ones, ordered by name.
+	RequestMutatorRefs []string `json:"requestMutatorRefs,omitempty"`
+
+	// +optional
+	// SchedulingHints lists the scheduling hints the requests may declare through their headers to influence their
+	// placement: pin-endpoint, exclude-endpoints, force-profile and disable-cache-affinity. The hints not listed are
+	// ignored, so that no request can influence its placement by default.
+	SchedulingHints []string `json:"schedulingHints,omitempty"`
+}
+
+func (pc *PersistenceConfig) String() string {
+	if pc == nil {
+		return nilString
+	}
+	return fmt.Sprintf("{Driver: %s, FlushInterval: %v, Bolt: %v, Redis: %v}", pc.Driver, pc.FlushInterval, pc.Bolt, pc.Redis)
+}
+
+// PersistenceConfig configures the persistence of the soft state of the plugins.
+//
+// The state of the plugins that can hand off their state to other EPP replicas is written
+// to the persistence driver periodically and on shutdown, and imported from it at startup.
+type PersistenceConfig struct {
+	// +optional
+	// Driver selects where the state is persisted: "memory" keeps it in memory only, "bolt" in a
+	// BoltDB file on a local volume, which survives restarts of the EPP container, and "redis" in
+	// a Redis server, which survives the rescheduling of the EPP pods.
+	// Default: memory
+	Driver string `json:"driver,omitempty"`
+
+	// +optional
+	// FlushInterval is the interval at which the state is persisted.
+	// Default: 30s
+	FlushInterval *metav1.Duration `json:"flushInterval,omitempty"`
+
+	// +optional
+	// Bolt configures the "bolt" driver. It is required if the driver is "bolt".
+	Bolt *BoltPersistenceConfig `json:"bolt,omitempty"`
+
+	// +optional
+	// Redis configures the "redis" driver. It is required if the driver is "redis".
+	Redis *RedisPersistenceConfig `json:"redis,omitempty"`
+}
+
+func (bc *BoltPersistenceConfig) String() string {
+	if bc == nil {
+		return nilString
+	}
+	return fmt.Sprintf("{Path: %s}", bc.Path)
+}
+
+// BoltPersistenceConfig configures the persistence of the state to a BoltDB file.
+type BoltPersistenceConfig struct {
+	// +required
+	// +kubebuilder:validation:Required
+	// Path is the BoltDB file the state is persisted to. It is created if it does not exist.
+	Path string `json:"path"`
+}
+
+func (rc *RedisPersistenceConfig) String() string {
+	if rc == nil {
+		return nilString
+	}
+	return fmt.Sprintf("{Address: %s, DB: %d, KeyPrefix: %s}", rc.Address, rc.DB, rc.KeyPrefix)
+}
+
+// RedisPersistenceConfig configures the persistence of the state to a Redis server.
+type RedisPersistenceConfig struct {
+	// +required
+	// +kubebuilder:validation:Required
+	// Address is the host:port address of the Redis server.
+	Address string `json:"address"`
+
+	// +optional
+	// DB is the Redis database the state is persisted to.
+	// Default: 0
+	DB int `json:"db,omitempty"`
+
+	// +optional
+	// KeyPrefix is the prefix of the keys the state is persisted under. EPP deployments sharing
+	// a Redis server must use distinct prefixes.
+	// Default: epp:
+	KeyPrefix string `json:"keyPrefix,omitempty"`
+
+	// +optional
+	// PasswordEnv is the name of the environment variable holding the password of the Redis
+	// server, if it requires authentication.
+	PasswordEnv string `json:"passwordEnv,omitempty"`
+}
+
 // FlowControlConfig configures the Flow Control layer.
 type FlowControlConfig struct {
 	// +optional
@@ -329,6 +530,27 @@ type FlowControlConfig struct {
 	// Must reference a named plugin instance defined in the top-level Plugins section.
 	// If omitted, a default static policy (threshold=1.0, no gating) is used.
 	UsageLimitPolicyPluginRef string `json:"usageLimitPolicyPluginRef,omitempty"`
+
+	// +optional
+	// RequestFamilyBudget bounds the capacity consumed by a family of requests, identified by the
+	// x-gateway-inference-request-family-id header, e.g. the calls fanned out by a single agent task.
+	// Requests exceeding the budget of their family are rejected.
+	// If omitted, request families are not bounded.
+	RequestFamilyBudget *RequestFamilyBudgetConfig `json:"requestFamilyBudget,omitempty"`
+
+	// +optional
+	// StarvationThreshold is the queueing time after which a request is dispatched ahead of the
+	// requests of the higher priority bands, so that sheddable or batch traffic is not starved by
+	// interactive traffic. The request is still held while its own band is saturated.
+	// If 0 or omitted, requests are dispatched strictly by priority.
+	StarvationThreshold *metav1.Duration `json:"starvationThreshold,omitempty"`
+
+	// +optional
+	// Preemption lets a request arriving while the queues are at capacity evict the newest queued
+	// requests of the sheddable (negative) priority bands below its own, which are rejected with a
+	// 429, rather than being rejected itself.
+	// If false or omitted, the requests arriving while the queues are at capacity are rejected.
+	Preemption *bool `json:"preemption,omitempty"`
 }
 
 func (fcc *FlowControlConfig) String() string {
@@ -365,6 +587,54 @@ func (fcc *FlowControlConfig) String() string {
 		parts = append(parts, "UsageLimitPolicyRef: "+fcc.UsageLimitPolicyPluginRef)
 	}
 
+	if fcc.RequestFamilyBudget != nil {
+		parts = append(parts, fmt.Sprintf("RequestFamilyBudget: %v", fcc.RequestFamilyBudget))
+	}
+
+	if fcc.StarvationThreshold != nil {
+		parts = append(parts, fmt.Sprintf("StarvationThreshold: %s", fcc.StarvationThreshold.Duration))
+	}
+
+	if fcc.Preemption != nil {
+		parts = append(parts, fmt.Sprintf("Preemption: %t", *fcc.Preemption))
+	}
+
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+// RequestFamilyBudgetConfig configures the budget shared by the requests of a family.
+type RequestFamilyBudgetConfig struct {
+	// +optional
+	// MaxTokens is the maximum number of prompt tokens admitted for a family, estimated from the
+	// size of the requests. If omitted, the tokens of a family are not bounded.
+	MaxTokens *int64 `json:"maxTokens,omitempty"`
+
+	// +optional
+	// MaxDuration is the maximum duration, from its first request, during which the requests of a
+	// family are admitted. If omitted, the duration of a family is not bounded.
+	MaxDuration *metav1.Duration `json:"maxDuration,omitempty"`
+
+	// +optional
+	// IdleTimeout is the duration without requests after which a family is forgotten, and its
+	// budget reset. If omitted, it defaults to 10 minutes.
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty"`
+}
+
+func (rfb *RequestFamilyBudgetConfig) String() string {
+	if rfb == nil {
+		return nilString
+	}
+
+	var parts []string
+	if rfb.MaxTokens != nil {
+		parts = append(parts, fmt.Sprintf("MaxTokens: %d", *rfb.MaxTokens))
+	}
+	if rfb.MaxDuration != nil {
+		parts = append(parts, fmt.Sprintf("MaxDuration: %s", rfb.MaxDuration.Duration))
+	}
+	if rfb.IdleTimeout != nil {
+		parts = append(parts, fmt.Sprintf("IdleTimeout: %s", rfb.IdleTimeout.Duration))
+	}
 	return "{" + strings.Join(parts, ", ") + "}"
 }
 
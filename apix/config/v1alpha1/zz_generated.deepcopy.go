@@ -26,6 +26,21 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BoltPersistenceConfig) DeepCopyInto(out *BoltPersistenceConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BoltPersistenceConfig.
+func (in *BoltPersistenceConfig) DeepCopy() *BoltPersistenceConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BoltPersistenceConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataLayerConfig) DeepCopyInto(out *DataLayerConfig) {
 	*out = *in
@@ -126,6 +141,16 @@ func (in *EndpointPickerConfig) DeepCopyInto(out *EndpointPickerConfig) {
 		*out = new(ParserConfig)
 		**out = **in
 	}
+	if in.RequestControl != nil {
+		in, out := &in.RequestControl, &out.RequestControl
+		*out = new(RequestControlConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Persistence != nil {
+		in, out := &in.Persistence, &out.Persistence
+		*out = new(PersistenceConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointPickerConfig.
@@ -195,6 +220,21 @@ func (in *FlowControlConfig) DeepCopyInto(out *FlowControlConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RequestFamilyBudget != nil {
+		in, out := &in.RequestFamilyBudget, &out.RequestFamilyBudget
+		*out = new(RequestFamilyBudgetConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StarvationThreshold != nil {
+		in, out := &in.StarvationThreshold, &out.StarvationThreshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Preemption != nil {
+		in, out := &in.Preemption, &out.Preemption
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowControlConfig.
@@ -222,6 +262,36 @@ func (in *ParserConfig) DeepCopy() *ParserConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistenceConfig) DeepCopyInto(out *PersistenceConfig) {
+	*out = *in
+	if in.FlushInterval != nil {
+		in, out := &in.FlushInterval, &out.FlushInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Bolt != nil {
+		in, out := &in.Bolt, &out.Bolt
+		*out = new(BoltPersistenceConfig)
+		**out = **in
+	}
+	if in.Redis != nil {
+		in, out := &in.Redis, &out.Redis
+		*out = new(RedisPersistenceConfig)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistenceConfig.
+func (in *PersistenceConfig) DeepCopy() *PersistenceConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistenceConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PluginSpec) DeepCopyInto(out *PluginSpec) {
 	*out = *in
@@ -267,6 +337,76 @@ func (in *PriorityBandConfig) DeepCopy() *PriorityBandConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisPersistenceConfig) DeepCopyInto(out *RedisPersistenceConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisPersistenceConfig.
+func (in *RedisPersistenceConfig) DeepCopy() *RedisPersistenceConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisPersistenceConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestControlConfig) DeepCopyInto(out *RequestControlConfig) {
+	*out = *in
+	if in.RequestMutatorRefs != nil {
+		in, out := &in.RequestMutatorRefs, &out.RequestMutatorRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SchedulingHints != nil {
+		in, out := &in.SchedulingHints, &out.SchedulingHints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestControlConfig.
+func (in *RequestControlConfig) DeepCopy() *RequestControlConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestControlConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestFamilyBudgetConfig) DeepCopyInto(out *RequestFamilyBudgetConfig) {
+	*out = *in
+	if in.MaxTokens != nil {
+		in, out := &in.MaxTokens, &out.MaxTokens
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxDuration != nil {
+		in, out := &in.MaxDuration, &out.MaxDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestFamilyBudgetConfig.
+func (in *RequestFamilyBudgetConfig) DeepCopy() *RequestFamilyBudgetConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestFamilyBudgetConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SaturationDetectorConfig) DeepCopyInto(out *SaturationDetectorConfig) {
 	*out = *in
@@ -282,6 +422,31 @@ func (in *SaturationDetectorConfig) DeepCopy() *SaturationDetectorConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingBudget) DeepCopyInto(out *SchedulingBudget) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.PluginTimeout != nil {
+		in, out := &in.PluginTimeout, &out.PluginTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingBudget.
+func (in *SchedulingBudget) DeepCopy() *SchedulingBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SchedulingPlugin) DeepCopyInto(out *SchedulingPlugin) {
 	*out = *in
@@ -290,6 +455,11 @@ func (in *SchedulingPlugin) DeepCopyInto(out *SchedulingPlugin) {
 		*out = new(float64)
 		**out = **in
 	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingPlugin.
@@ -312,6 +482,16 @@ func (in *SchedulingProfile) DeepCopyInto(out *SchedulingProfile) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Shadow != nil {
+		in, out := &in.Shadow, &out.Shadow
+		*out = new(ShadowProfileConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Budget != nil {
+		in, out := &in.Budget, &out.Budget
+		*out = new(SchedulingBudget)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingProfile.
@@ -323,3 +503,23 @@ func (in *SchedulingProfile) DeepCopy() *SchedulingProfile {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShadowProfileConfig) DeepCopyInto(out *ShadowProfileConfig) {
+	*out = *in
+	if in.SampleRate != nil {
+		in, out := &in.SampleRate, &out.SampleRate
+		*out = new(float64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShadowProfileConfig.
+func (in *ShadowProfileConfig) DeepCopy() *ShadowProfileConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ShadowProfileConfig)
+	in.DeepCopyInto(out)
+	return out
+}
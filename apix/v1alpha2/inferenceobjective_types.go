@@ -72,12 +72,62 @@ type InferenceObjectiveSpec struct {
 	// +optional
 	Priority *int `json:"priority,omitempty"`
 
+	// TTFTObjective is the target time to first token of requests using this objective.
+	// Latency-aware scheduling plugins use it to prefer endpoints expected to meet the objective.
+	// It can be overridden per request by the Endpoint Picker's x-slo-ttft-ms request header.
+	// +optional
+	TTFTObjective *metav1.Duration `json:"ttftObjective,omitempty"`
+
+	// TPOTObjective is the target average time per output token of requests using this objective.
+	// Latency-aware scheduling plugins use it to prefer endpoints expected to meet the objective.
+	// It can be overridden per request by the Endpoint Picker's x-slo-tpot-ms request header.
+	// +optional
+	TPOTObjective *metav1.Duration `json:"tpotObjective,omitempty"`
+
+	// Precision is the model precision requirement of requests using this objective, for pools serving the same
+	// model at several precisions (e.g. full-precision and quantized Deployments). Precision-aware scheduling plugins
+	// use it to route requests. It can be overridden per request by the Endpoint Picker's x-precision request header.
+	// +optional
+	Precision *PrecisionRequirement `json:"precision,omitempty"`
+
+	// Idempotent declares whether requests using this objective can be sent to the model servers more than once,
+	// e.g. when they have no side effects. Features duplicating requests, such as the fallback destinations retried by
+	// the proxy and redundant generations, only apply to idempotent requests. It can be overridden per request by the
+	// Endpoint Picker's x-idempotent request header. Defaults to the Endpoint Picker's configuration.
+	// +optional
+	Idempotent *bool `json:"idempotent,omitempty"`
+
+	// SaturationFallback opts requests using this objective in to the Endpoint Picker's pool fallback: while the pool is
+	// saturated, they are forwarded to the secondary pool configured on the Endpoint Picker, e.g. a pool serving a smaller
+	// model or a pool in a remote cluster, instead of being queued or shed. The responses of the requests falling back carry
+	// the x-gateway-inference-downgraded-to header. Defaults to false.
+	// +optional
+	SaturationFallback *bool `json:"saturationFallback,omitempty"`
+
+	// MaxQueueWait is the longest time requests using this objective may wait in the Endpoint Picker's flow control
+	// queues. Requests still queued after it are rejected with a 503 and a Retry-After header, rather than occupying
+	// memory until the client times out. It can be shortened per request by the Endpoint Picker's x-max-queue-wait-ms
+	// request header. Defaults to the Endpoint Picker's flow control default request TTL.
+	// +optional
+	MaxQueueWait *metav1.Duration `json:"maxQueueWait,omitempty"`
+
 	// PoolRef is a reference to the inference pool, the pool must exist in the same namespace.
 	//
 	// +kubebuilder:validation:Required
 	PoolRef PoolObjectReference `json:"poolRef"`
 }
 
+// PrecisionRequirement specifies the model precision requirement of requests.
+// +kubebuilder:validation:Enum=Full;PreferQuantized
+type PrecisionRequirement string
+
+const (
+	// PrecisionFull requires requests to be served by full-precision endpoints, e.g. for quality-critical workloads.
+	PrecisionFull PrecisionRequirement = "Full"
+	// PrecisionPreferQuantized prefers serving requests by quantized endpoints, e.g. for cost-tolerant workloads.
+	PrecisionPreferQuantized PrecisionRequirement = "PreferQuantized"
+)
+
 // InferenceObjectiveStatus defines the observed state of InferenceObjective
 type InferenceObjectiveStatus struct {
 	// Conditions track the state of the InferenceObjective.
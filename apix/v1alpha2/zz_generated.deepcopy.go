@@ -227,6 +227,36 @@ func (in *InferenceObjectiveSpec) DeepCopyInto(out *InferenceObjectiveSpec) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.TTFTObjective != nil {
+		in, out := &in.TTFTObjective, &out.TTFTObjective
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.TPOTObjective != nil {
+		in, out := &in.TPOTObjective, &out.TPOTObjective
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Precision != nil {
+		in, out := &in.Precision, &out.Precision
+		*out = new(PrecisionRequirement)
+		**out = **in
+	}
+	if in.Idempotent != nil {
+		in, out := &in.Idempotent, &out.Idempotent
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SaturationFallback != nil {
+		in, out := &in.SaturationFallback, &out.SaturationFallback
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxQueueWait != nil {
+		in, out := &in.MaxQueueWait, &out.MaxQueueWait
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	out.PoolRef = in.PoolRef
 }
 
@@ -18,6 +18,11 @@ limitations under the License.
 
 package v1alpha2
 
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apixv1alpha2 "sigs.k8s.io/gateway-api-inference-extension/apix/v1alpha2"
+)
+
 // InferenceObjectiveSpecApplyConfiguration represents a declarative configuration of the InferenceObjectiveSpec type for use
 // with apply.
 //
@@ -44,6 +49,33 @@ type InferenceObjectiveSpecApplyConfiguration struct {
 	// requests with Priority of 0 (the value used if Priority is unset or no InferenceObjective is specified).
 	// Similarly requests with a Priority of -10 will always be served after requests with Priority of 0.
 	Priority *int `json:"priority,omitempty"`
+	// TTFTObjective is the target time to first token of requests using this objective.
+	// Latency-aware scheduling plugins use it to prefer endpoints expected to meet the objective.
+	// It can be overridden per request by the Endpoint Picker's x-slo-ttft-ms request header.
+	TTFTObjective *v1.Duration `json:"ttftObjective,omitempty"`
+	// TPOTObjective is the target average time per output token of requests using this objective.
+	// Latency-aware scheduling plugins use it to prefer endpoints expected to meet the objective.
+	// It can be overridden per request by the Endpoint Picker's x-slo-tpot-ms request header.
+	TPOTObjective *v1.Duration `json:"tpotObjective,omitempty"`
+	// Precision is the model precision requirement of requests using this objective, for pools serving the same
+	// model at several precisions (e.g. full-precision and quantized Deployments). Precision-aware scheduling plugins
+	// use it to route requests. It can be overridden per request by the Endpoint Picker's x-precision request header.
+	Precision *apixv1alpha2.PrecisionRequirement `json:"precision,omitempty"`
+	// Idempotent declares whether requests using this objective can be sent to the model servers more than once,
+	// e.g. when they have no side effects. Features duplicating requests, such as the fallback destinations retried by
+	// the proxy and redundant generations, only apply to idempotent requests. It can be overridden per request by the
+	// Endpoint Picker's x-idempotent request header. Defaults to the Endpoint Picker's configuration.
+	Idempotent *bool `json:"idempotent,omitempty"`
+	// SaturationFallback opts requests using this objective in to the Endpoint Picker's pool fallback: while the pool is
+	// saturated, they are forwarded to the secondary pool configured on the Endpoint Picker, e.g. a pool serving a smaller
+	// model or a pool in a remote cluster, instead of being queued or shed. The responses of the requests falling back carry
+	// the x-gateway-inference-downgraded-to header. Defaults to false.
+	SaturationFallback *bool `json:"saturationFallback,omitempty"`
+	// MaxQueueWait is the longest time requests using this objective may wait in the Endpoint Picker's flow control
+	// queues. Requests still queued after it are rejected with a 503 and a Retry-After header, rather than occupying
+	// memory until the client times out. It can be shortened per request by the Endpoint Picker's x-max-queue-wait-ms
+	// request header. Defaults to the Endpoint Picker's flow control default request TTL.
+	MaxQueueWait *v1.Duration `json:"maxQueueWait,omitempty"`
 	// PoolRef is a reference to the inference pool, the pool must exist in the same namespace.
 	PoolRef *PoolObjectReferenceApplyConfiguration `json:"poolRef,omitempty"`
 }
@@ -62,6 +94,54 @@ func (b *InferenceObjectiveSpecApplyConfiguration) WithPriority(value int) *Infe
 	return b
 }
 
+// WithTTFTObjective sets the TTFTObjective field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TTFTObjective field is set to the value of the last call.
+func (b *InferenceObjectiveSpecApplyConfiguration) WithTTFTObjective(value v1.Duration) *InferenceObjectiveSpecApplyConfiguration {
+	b.TTFTObjective = &value
+	return b
+}
+
+// WithTPOTObjective sets the TPOTObjective field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TPOTObjective field is set to the value of the last call.
+func (b *InferenceObjectiveSpecApplyConfiguration) WithTPOTObjective(value v1.Duration) *InferenceObjectiveSpecApplyConfiguration {
+	b.TPOTObjective = &value
+	return b
+}
+
+// WithPrecision sets the Precision field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Precision field is set to the value of the last call.
+func (b *InferenceObjectiveSpecApplyConfiguration) WithPrecision(value apixv1alpha2.PrecisionRequirement) *InferenceObjectiveSpecApplyConfiguration {
+	b.Precision = &value
+	return b
+}
+
+// WithIdempotent sets the Idempotent field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Idempotent field is set to the value of the last call.
+func (b *InferenceObjectiveSpecApplyConfiguration) WithIdempotent(value bool) *InferenceObjectiveSpecApplyConfiguration {
+	b.Idempotent = &value
+	return b
+}
+
+// WithSaturationFallback sets the SaturationFallback field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SaturationFallback field is set to the value of the last call.
+func (b *InferenceObjectiveSpecApplyConfiguration) WithSaturationFallback(value bool) *InferenceObjectiveSpecApplyConfiguration {
+	b.SaturationFallback = &value
+	return b
+}
+
+// WithMaxQueueWait sets the MaxQueueWait field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxQueueWait field is set to the value of the last call.
+func (b *InferenceObjectiveSpecApplyConfiguration) WithMaxQueueWait(value v1.Duration) *InferenceObjectiveSpecApplyConfiguration {
+	b.MaxQueueWait = &value
+	return b
+}
+
 // WithPoolRef sets the PoolRef field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the PoolRef field is set to the value of the last call.
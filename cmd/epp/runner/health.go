@@ -42,6 +42,9 @@ type healthServer struct {
 	isLeader              *atomic.Bool
 	leaderElectionEnabled bool
 	supporter             appProtocolSupporter
+	// stateBootstrapped reports whether the plugin state bootstrap from a peer replica completed. Nil when the state
+	// is not bootstrapped.
+	stateBootstrapped func() bool
 }
 
 const (
@@ -50,7 +53,7 @@ const (
 )
 
 func (s *healthServer) Check(ctx context.Context, in *healthPb.HealthCheckRequest) (*healthPb.HealthCheckResponse, error) {
-	isLive := s.datastore.PoolHasSynced()
+	isLive := s.datastore.PoolHasSynced() && s.isBootstrapped()
 	protocolMatches := s.checkProtocolSupport(isLive)
 
 	// If leader election is disabled, use current logic: all checks are based on whether the pool has synced.
@@ -101,6 +104,11 @@ func (s *healthServer) Check(ctx context.Context, in *healthPb.HealthCheckReques
 	return &healthPb.HealthCheckResponse{Status: healthPb.HealthCheckResponse_SERVING}, nil
 }
 
+// isBootstrapped returns true unless the plugin state is being bootstrapped from a peer replica.
+func (s *healthServer) isBootstrapped() bool {
+	return s.stateBootstrapped == nil || s.stateBootstrapped()
+}
+
 func (s *healthServer) checkProtocolSupport(isLive bool) bool {
 	if !isLive {
 		// If the pool is not synced, we should skip checking the protocol support.
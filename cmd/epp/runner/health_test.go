@@ -64,6 +64,7 @@ func TestHealthServer_Check(t *testing.T) {
 		pool                  *datalayer.EndpointPool
 		poolErr               error
 		supporter             *mockSupporter
+		stateBootstrapped     func() bool
 		service               string
 		wantStatus            healthPb.HealthCheckResponse_ServingStatus
 	}{
@@ -88,6 +89,22 @@ func TestHealthServer_Check(t *testing.T) {
 			supporter:             &mockSupporter{protocols: []v1.AppProtocol{v1.AppProtocolHTTP}},
 			wantStatus:            healthPb.HealthCheckResponse_NOT_SERVING,
 		},
+		{
+			name:                  "LeaderElectionDisabled_StateBootstrapInProgress",
+			leaderElectionEnabled: false,
+			hasSynced:             true,
+			pool:                  &datalayer.EndpointPool{AppProtocol: v1.AppProtocolHTTP},
+			stateBootstrapped:     func() bool { return false },
+			wantStatus:            healthPb.HealthCheckResponse_NOT_SERVING,
+		},
+		{
+			name:                  "LeaderElectionDisabled_StateBootstrapDone",
+			leaderElectionEnabled: false,
+			hasSynced:             true,
+			pool:                  &datalayer.EndpointPool{AppProtocol: v1.AppProtocolHTTP},
+			stateBootstrapped:     func() bool { return true },
+			wantStatus:            healthPb.HealthCheckResponse_SERVING,
+		},
 		{
 			name:                  "LeaderElectionEnabled_Liveness_AlwaysServing",
 			leaderElectionEnabled: true,
@@ -111,6 +128,16 @@ func TestHealthServer_Check(t *testing.T) {
 			service:               ReadinessCheckService,
 			wantStatus:            healthPb.HealthCheckResponse_NOT_SERVING,
 		},
+		{
+			name:                  "LeaderElectionEnabled_Readiness_StateBootstrapInProgress",
+			leaderElectionEnabled: true,
+			isLeader:              true,
+			hasSynced:             true,
+			pool:                  &datalayer.EndpointPool{AppProtocol: v1.AppProtocolHTTP},
+			stateBootstrapped:     func() bool { return false },
+			service:               ReadinessCheckService,
+			wantStatus:            healthPb.HealthCheckResponse_NOT_SERVING,
+		},
 		{
 			name:                  "LeaderElectionEnabled_Readiness_NotLeader",
 			leaderElectionEnabled: true,
@@ -178,6 +205,7 @@ func TestHealthServer_Check(t *testing.T) {
 				isLeader:              &isLeader,
 				leaderElectionEnabled: tt.leaderElectionEnabled,
 				supporter:             supporter,
+				stateBootstrapped:     tt.stateBootstrapped,
 			}
 
 			resp, err := s.Check(context.Background(), &healthPb.HealthCheckRequest{Service: tt.service})
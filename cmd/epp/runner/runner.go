@@ -35,6 +35,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
+	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -47,15 +48,25 @@ import (
 	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/profiling"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/tracing"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/adaptivescrape"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/adminauth"
 	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/bias"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/config"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/config/loader"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datastore"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/debugstate"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/decisionaudit"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/decisioncompare"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/exclusion"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/explain"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/featuregate"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/contracts"
 	fccontroller "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/controller"
 	fcregistry "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/registry"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
 	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
 	fwkrh "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
 	attrconcurrency "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/attribute/concurrency"
@@ -66,42 +77,87 @@ import (
 	sourcenotifications "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/source/notifications"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/flowcontrol/fairness/globalstrict"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/flowcontrol/fairness/roundrobin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/flowcontrol/fairness/wfq"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/flowcontrol/ordering/edf"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/flowcontrol/ordering/fcfs"
 	slodeadline "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/flowcontrol/ordering/slodeadline"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/flowcontrol/saturationdetector/composite"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/flowcontrol/saturationdetector/concurrency"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/flowcontrol/saturationdetector/utilization"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/flowcontrol/usagelimits"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requestcontrol/admitter/contextwindow"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requestcontrol/admitter/latencyslo"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requestcontrol/admitter/maxtokens"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requestcontrol/admitter/ratelimit"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requestcontrol/backendabort"
 	reqdataprodprefix "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requestcontrol/dataproducer/approximateprefix"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requestcontrol/dataproducer/fingerprint"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requestcontrol/dataproducer/inflightload"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requestcontrol/dataproducer/metricsbackfill"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requestcontrol/dataproducer/orcaload"
 	latencyproducer "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requestcontrol/dataproducer/predictedlatency"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requestcontrol/mutator/headers"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requestcontrol/mutator/modelalias"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requestcontrol/requestattributereporter"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requestcontrol/responseheaders"
 	testresponsereceived "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requestcontrol/test/responsereceived"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requesthandling/parsers/anthropic"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requesthandling/parsers/kservegrpc"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requesthandling/parsers/openai"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requesthandling/parsers/passthrough"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requesthandling/parsers/vllmgrpc"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/celexpr"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/filter/adaptiveconcurrency"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/filter/evalrunaffinity"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/filter/loadhintfilter"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/filter/precisionfilter"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/filter/prefixcacheaffinity"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/filter/promptquarantine"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/filter/slicefilter"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/filter/sloheadroomtier"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/grpcplugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/picker/deterministichash"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/picker/failuredomain"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/picker/maxscore"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/picker/random"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/picker/topk"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/picker/weightedrandom"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/profile"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/scorer/capacityqueue"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/scorer/kvcacheutilization"
 	latencyscorer "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/scorer/latency"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/scorer/leastloaded"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/scorer/leastoutstanding"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/scorer/loraaffinity"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/scorer/precision"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/scorer/prefix"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/scorer/queuedepth"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/scorer/runningrequests"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/scorer/sloaware"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/scorer/tokenload"
 	testfilter "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/test/filter"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/gatewayprovider"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/handlers"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/healthprobe"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/loadhints"
+	loadhintsgen "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/loadhints/api/gen"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics/collectors"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/otlpreceiver"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/peerstate"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/persistence"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/pluginquarantine"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/poolfallback"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/poolpause"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/queuedebug"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/requestcontrol"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/selfpressure"
 	runserver "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/server"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/spillover"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/env"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/whatif"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/wirecapture"
 	"sigs.k8s.io/gateway-api-inference-extension/version"
 )
 
@@ -134,6 +190,9 @@ type Runner struct {
 	customCollectors     []prometheus.Collector
 	parser               fwkrh.Parser
 	dlRuntime            *datalayer.Runtime
+	extProcServer        *runserver.ExtProcServerRunner
+	peerState            *peerstate.Registry
+	debugState           *debugstate.Dumper
 }
 
 // WithExecutableName sets the name of the executable containing the runner.
@@ -199,30 +258,32 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
-	pmc, err := backendmetrics.NewPodMetricsClientImpl(setupLog, backendmetrics.Config{
-		ModelServerMetricsScheme:        opts.ModelServerMetricsScheme,
-		ModelServerMetricsHTTPSInsecure: opts.ModelServerMetricsHTTPSInsecure,
-		ModelServerMetricsPath:          opts.ModelServerMetricsPath,
-
-		TotalQueuedRequestsMetric:    opts.TotalQueuedRequestsMetric,
-		TotalRunningRequestsMetric:   opts.TotalRunningRequestsMetric,
-		KVCacheUsagePercentageMetric: opts.KVCacheUsagePercentageMetric,
-		LoRAInfoMetric:               opts.LoRAInfoMetric,
-		CacheInfoMetric:              opts.CacheInfoMetric,
-	})
+	pmc, err := backendmetrics.NewPodMetricsClientImpl(setupLog, opts.ModelServerMetricsConfig())
 	if err != nil {
 		return err
 	}
 
-	mgr, _, err := r.setup(ctx, cfg, opts, pmc, nil)
+	// The components run until the ext-proc server has drained, rather than stopping on the shutdown signal, so that
+	// the queued and in-flight requests keep being scheduled and served meanwhile.
+	lifecycleCtx, stop := context.WithCancel(context.WithoutCancel(ctx))
+	defer stop()
+	mgr, _, err := r.setup(lifecycleCtx, cfg, opts, pmc, nil)
 	if err != nil {
 		return err
 	}
+	go func() {
+		select {
+		case <-ctx.Done():
+			r.extProcServer.Drain(setupLog)
+			stop()
+		case <-lifecycleCtx.Done():
+		}
+	}()
 
 	// --- Start Manager ---
-	// This blocks until a signal is received.
+	// This blocks until a signal is received and the ext-proc server has drained.
 	setupLog.Info("Controller manager starting")
-	if err := mgr.Start(ctx); err != nil {
+	if err := mgr.Start(lifecycleCtx); err != nil {
 		setupLog.Error(err, "Error starting controller manager")
 		return err
 	}
@@ -252,17 +313,31 @@ func (r *Runner) setup(ctx context.Context, cfg *rest.Config, opts *runserver.Op
 
 	startCrdReconcilers := opts.EndpointSelector == "" // If endpointSelector is empty, it means it's not in the standalone mode. Then we should start the inferencePool and other CRD Reconciler.
 	controllerCfg := runserver.NewControllerConfig(startCrdReconcilers)
+	if opts.EndpointDiscoveryMode == runserver.EndpointDiscoveryEndpointSlices {
+		controllerCfg = controllerCfg.WithEndpointSlices(opts.EndpointSliceService)
+	}
+	if len(opts.NodeLabels) > 0 {
+		controllerCfg = controllerCfg.WithNodes()
+	}
 	if err := controllerCfg.PopulateControllerConfig(cfg); err != nil {
 		setupLog.Error(err, "Failed to populate controller config")
 		return nil, nil, err
 	}
 
 	ds, err := setupDatastore(ctx, epf, int32(opts.ModelServerMetricsPort), startCrdReconcilers,
-		gknn.Namespace, gknn.Name, opts.EndpointSelector, opts.EndpointTargetPorts)
+		gknn.Namespace, gknn.Name, opts.EndpointSelector, opts.EndpointTargetPorts, opts.EndpointSliceService, opts.NodeLabels)
 	if err != nil {
 		setupLog.Error(err, "Failed to setup datastore")
 		return nil, nil, err
 	}
+	if opts.StaticEndpointsFile != "" {
+		staticEndpoints, err := datastore.LoadStaticEndpoints(opts.StaticEndpointsFile)
+		if err != nil {
+			setupLog.Error(err, "Failed to load static endpoints")
+			return nil, nil, err
+		}
+		ds.StaticEndpointsSet(ctx, gknn.Namespace, staticEndpoints)
+	}
 	eppConfig, err := r.parseConfigurationPhaseTwo(ctx, rawConfig, ds)
 	if err != nil {
 		setupLog.Error(err, "Failed to parse configuration")
@@ -318,6 +393,101 @@ func (r *Runner) setup(ctx context.Context, cfg *rest.Config, opts *runserver.Op
 		}
 	}
 
+	// The admin and debug APIs are scoped by role when tokens are configured; their mutating calls are always audited.
+	var adminTokens adminauth.Tokens
+	if opts.AdminAPITokensFile != "" {
+		if adminTokens, err = adminauth.LoadTokens(opts.AdminAPITokensFile); err != nil {
+			setupLog.Error(err, "Failed to load admin API tokens")
+			return nil, nil, err
+		}
+		setupLog.Info("Admin API tokens loaded", "tokens", len(adminTokens))
+	}
+	adminAuthorizer := adminauth.NewAuthorizer(adminTokens)
+
+	candidateOpts := []requestcontrol.EndpointCandidatesOption{requestcontrol.WithDisableEndpointSubsetFilter(opts.DisableEndpointSubsetFilter)}
+	if opts.EnableEndpointExclusionAPI {
+		exclusions := exclusion.NewStore(opts.EndpointExclusionMaxDuration)
+		if err := mgr.AddMetricsServerExtraHandler(exclusion.HandlerPath, adminAuthorizer.Wrap(exclusion.NewHandler(exclusions))); err != nil {
+			setupLog.Error(err, "Failed to setup endpoint exclusion API handler")
+			return nil, nil, err
+		}
+		go exclusions.Run(ctx)
+		candidateOpts = append(candidateOpts, requestcontrol.WithEndpointExcluder(exclusions))
+		setupLog.Info("Endpoint exclusion API enabled", "path", exclusion.HandlerPath)
+	}
+	if opts.EnableHealthProbing {
+		prober, err := healthprobe.NewProber(ds, opts.HealthProbeConfig())
+		if err != nil {
+			setupLog.Error(err, "Failed to create endpoint health prober")
+			return nil, nil, err
+		}
+		go prober.Run(ctx)
+		candidateOpts = append(candidateOpts, requestcontrol.WithEndpointExcluder(prober))
+		setupLog.Info("Active health probing enabled", "path", opts.HealthProbePath, "interval", opts.HealthProbeInterval,
+			"failureThreshold", opts.HealthProbeFailureThreshold, "successThreshold", opts.HealthProbeSuccessThreshold)
+	}
+	if opts.EnablePeerStateAPI {
+		if err := mgr.AddMetricsServerExtraHandler(peerstate.HandlerPath, adminAuthorizer.Wrap(peerstate.NewHandler(r.peerState))); err != nil {
+			setupLog.Error(err, "Failed to setup peer state API handler")
+			return nil, nil, err
+		}
+		setupLog.Info("Peer state API enabled", "path", peerstate.HandlerPath, "plugins", r.peerState.Len())
+	}
+	if opts.EnableStateDebugAPI {
+		if err := mgr.AddMetricsServerExtraHandler(debugstate.HandlerPath, adminAuthorizer.Wrap(debugstate.NewHandler(r.debugState))); err != nil {
+			setupLog.Error(err, "Failed to setup state debug API handler")
+			return nil, nil, err
+		}
+		setupLog.Info("State debug API enabled", "path", debugstate.HandlerPath)
+	}
+	// The persisted state is restored before bootstrapping from a peer, so that the state of the peer, if any, is the
+	// most recent one.
+	if persistenceConfig := eppConfig.Persistence; persistenceConfig != nil && persistenceConfig.Driver != "" &&
+		persistenceConfig.Driver != persistence.DriverMemory {
+		driver, err := persistence.NewDriver(persistenceConfig)
+		if err != nil {
+			setupLog.Error(err, "Failed to setup plugin state persistence")
+			return nil, nil, err
+		}
+		persister := persistence.NewPersister(driver, r.peerState, persistence.FlushInterval(persistenceConfig))
+		if err := persister.Restore(ctx); err != nil {
+			setupLog.Error(err, "Failed to restore persisted plugin state, starting with empty state")
+		}
+		go persister.Run(ctx)
+		setupLog.Info("Plugin state persistence enabled", "driver", persistenceConfig.Driver,
+			"flushInterval", persistence.FlushInterval(persistenceConfig), "plugins", r.peerState.Len())
+	}
+	// Bootstrapping from a peer delays readiness until the plugin state is imported, so that the replica does not take
+	// traffic with empty state.
+	var stateBootstrapped func() bool
+	if opts.PeerStateBootstrapURL != "" {
+		bootstrapper := peerstate.NewBootstrapper(r.peerState, opts.PeerStateBootstrapURL, opts.PeerStateBootstrapTimeout,
+			cfg.BearerToken, cfg.BearerTokenFile, opts.PeerStateBootstrapAdminTokenFile)
+		stateBootstrapped = bootstrapper.Done
+		go bootstrapper.Run(ctx)
+		setupLog.Info("Bootstrapping plugin state from peer", "url", opts.PeerStateBootstrapURL)
+	}
+
+	var decisionComparer *decisioncompare.Comparer
+	if opts.DecisionCompareMode {
+		decisionComparer = decisioncompare.NewComparer(opts.DecisionCompareSamples)
+		if err := mgr.AddMetricsServerExtraHandler(decisioncompare.HandlerPath, adminAuthorizer.Wrap(decisioncompare.NewHandler(decisionComparer))); err != nil {
+			setupLog.Error(err, "Failed to setup decision compare API handler")
+			return nil, nil, err
+		}
+		setupLog.Info("Decision compare mode enabled", "path", decisioncompare.HandlerPath)
+	}
+	var wireCapturer *wirecapture.Capturer
+	if opts.ExtProcCaptureSampleFraction > 0 {
+		wireCapturer = wirecapture.NewCapturer(opts.WireCaptureConfig())
+		if err := mgr.AddMetricsServerExtraHandler(wirecapture.HandlerPath, adminAuthorizer.Wrap(wirecapture.NewHandler(wireCapturer))); err != nil {
+			setupLog.Error(err, "Failed to setup ext-proc capture API handler")
+			return nil, nil, err
+		}
+		setupLog.Info("Ext-proc capture enabled", "path", wirecapture.HandlerPath,
+			"sampleFraction", opts.ExtProcCaptureSampleFraction, "maxBodyBytes", opts.ExtProcCaptureMaxBodyBytes)
+	}
+
 	// --- Initialize Core EPP Components ---
 	if r.schedulerConfig == nil {
 		err := errors.New("scheduler config must be set either by config api or through code")
@@ -327,7 +497,68 @@ func (r *Runner) setup(ctx context.Context, cfg *rest.Config, opts *runserver.Op
 
 	setupLog.Info("parsed config", "scheduler-config", r.schedulerConfig)
 
-	scheduler := scheduling.NewSchedulerWithConfig(r.schedulerConfig)
+	// The panics of the plugins are recovered whether the quarantine is enabled or not.
+	var pluginBreaker *pluginquarantine.Breaker
+	if opts.PluginQuarantineThreshold > 0 {
+		pluginBreaker = pluginquarantine.NewBreaker(opts.PluginQuarantineThreshold, opts.PluginQuarantineCooldown)
+		if err := mgr.AddMetricsServerExtraHandler(pluginquarantine.HandlerPath, adminAuthorizer.Wrap(pluginquarantine.NewHandler(pluginBreaker))); err != nil {
+			setupLog.Error(err, "Failed to setup plugin quarantine status handler")
+			return nil, nil, err
+		}
+		setupLog.Info("Plugin quarantine enabled", "path", pluginquarantine.HandlerPath,
+			"threshold", opts.PluginQuarantineThreshold, "cooldown", opts.PluginQuarantineCooldown)
+	}
+
+	scheduler := scheduling.NewSchedulerWithConfig(r.schedulerConfig).WithPluginBreaker(pluginBreaker)
+	// The cached scores of the endpoints joining or leaving the pool are evicted.
+	ds.Subscribe(ctx, scheduler)
+	if opts.EnableSelfPressureDegradation {
+		monitor, err := selfpressure.NewMonitor(selfpressure.Config{
+			CPUThreshold:    opts.SelfPressureCPUThreshold,
+			MemoryThreshold: opts.SelfPressureMemoryThreshold,
+		})
+		if err != nil {
+			setupLog.Error(err, "Failed to create self-pressure monitor")
+			return nil, nil, err
+		}
+		monitor.OnPressureChange(func(underPressure bool) {
+			if underPressure {
+				r.dlRuntime.SetPollingStretch(opts.SelfPressureScrapeStretchFactor)
+			} else {
+				r.dlRuntime.SetPollingStretch(1)
+			}
+		})
+		scheduler.WithPressureSignal(monitor)
+		go monitor.Run(ctx)
+		setupLog.Info("Self-pressure degradation enabled", "cpuThreshold", opts.SelfPressureCPUThreshold,
+			"memoryThreshold", opts.SelfPressureMemoryThreshold, "scrapeStretchFactor", opts.SelfPressureScrapeStretchFactor)
+	}
+	staleMetricsAction, err := scheduling.ParseStaleMetricsAction(opts.StaleMetricsPolicy)
+	if err != nil {
+		setupLog.Error(err, "Invalid stale metrics policy")
+		return nil, nil, err
+	}
+	scheduler.WithStalenessPolicy(scheduling.NewStalenessPolicy(opts.MetricsStalenessThreshold, staleMetricsAction))
+	setupLog.Info("Stale metrics policy configured", "action", staleMetricsAction, "threshold", opts.MetricsStalenessThreshold)
+	if opts.EnableEndpointBiasAPI {
+		biasRules := bias.NewStore(opts.EndpointBiasMaxDuration)
+		if err := mgr.AddMetricsServerExtraHandler(bias.HandlerPath, adminAuthorizer.Wrap(bias.NewHandler(biasRules))); err != nil {
+			setupLog.Error(err, "Failed to setup endpoint bias API handler")
+			return nil, nil, err
+		}
+		go biasRules.Run(ctx)
+		scheduler.WithScoreBias(biasRules)
+		setupLog.Info("Endpoint bias API enabled", "path", bias.HandlerPath, "maxDuration", opts.EndpointBiasMaxDuration)
+	}
+	if opts.DecisionAuditLog != "" {
+		auditLogger, err := decisionaudit.Open(opts.DecisionAuditLog, ctrl.Log.WithName("decision-audit"))
+		if err != nil {
+			setupLog.Error(err, "Failed to open the decision audit log")
+			return nil, nil, err
+		}
+		scheduler.WithDecisionAuditSink(auditLogger)
+		setupLog.Info("Decision audit log enabled", "destination", opts.DecisionAuditLog)
+	}
 
 	// Data layer is enabled by default; use the 'enableLegacyMetrics' feature gate to fall back to legacy polling.
 	datalayerMetricsEnabled := !r.featureGates[datalayer.EnableLegacyMetricsFeatureGate]
@@ -339,7 +570,10 @@ func (r *Runner) setup(ctx context.Context, cfg *rest.Config, opts *runserver.Op
 	// --- Admission Control Initialization ---
 	var admissionController requestcontrol.AdmissionController
 	var endpointCandidates contracts.EndpointCandidates
-	endpointCandidates = requestcontrol.NewDatastoreEndpointCandidates(ds, requestcontrol.WithDisableEndpointSubsetFilter(opts.DisableEndpointSubsetFilter))
+	var queueStats spillover.QueueStats
+	var stopFlowControl context.CancelFunc
+	var defaultMaxQueueWait time.Duration
+	endpointCandidates = requestcontrol.NewDatastoreEndpointCandidates(ds, candidateOpts...)
 	if r.featureGates[flowcontrol.FeatureGate] {
 		endpointCandidates = requestcontrol.NewCachedEndpointCandidates(ctx, endpointCandidates, time.Millisecond*50)
 		setupLog.Info("Initializing experimental Flow Control layer")
@@ -347,8 +581,12 @@ func (r *Runner) setup(ctx context.Context, cfg *rest.Config, opts *runserver.Op
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to initialize Flow Registry: %w", err)
 		}
+		// Flow control may be stopped before the other components when the ext-proc server drains, see
+		// ExtProcServerRunner.Drain.
+		fcCtx, cancel := context.WithCancel(ctx)
+		stopFlowControl = cancel
 		fc, err := fccontroller.NewFlowController(
-			ctx,
+			fcCtx,
 			opts.PoolName,
 			eppConfig.FlowControlConfig.Controller,
 			fccontroller.Deps{
@@ -359,16 +597,107 @@ func (r *Runner) setup(ctx context.Context, cfg *rest.Config, opts *runserver.Op
 			},
 		)
 		if err != nil {
+			cancel()
 			return nil, nil, fmt.Errorf("failed to initialize Flow Controller: %w", err)
 		}
 		go registry.Run(ctx)
+		queueStats = registry
+		defaultMaxQueueWait = eppConfig.FlowControlConfig.Controller.DefaultRequestTTL
+		if opts.EnableQueueDebugAPI {
+			inspector := queuedebug.NewInspector(registry, clock.RealClock{})
+			if err := mgr.AddMetricsServerExtraHandler(queuedebug.HandlerPath, adminAuthorizer.Wrap(queuedebug.NewHandler(inspector))); err != nil {
+				cancel()
+				setupLog.Error(err, "Failed to setup queue debug API handler")
+				return nil, nil, err
+			}
+			setupLog.Info("Queue debug API enabled", "path", queuedebug.HandlerPath)
+		}
 		admissionController = requestcontrol.NewFlowControlAdmissionController(fc, opts.PoolName)
 	} else {
 		setupLog.Info("Experimental Flow Control layer is disabled, using legacy admission control")
+		if opts.EnableQueueDebugAPI {
+			setupLog.Info("Queue debug API not enabled, it requires the Flow Control layer")
+		}
 		admissionController = requestcontrol.NewLegacyAdmissionController(eppConfig.SaturationDetector, endpointCandidates)
 	}
 
-	director := requestcontrol.NewDirectorWithConfig(ds, scheduler, admissionController, endpointCandidates, r.requestControlConfig)
+	// Requests are held or rejected while the pool is paused, before they are admitted or queued by flow control.
+	if opts.EnablePoolPauseAPI {
+		pauser := poolpause.NewController(opts.PoolName, opts.PoolPauseMaxDuration)
+		if err := mgr.AddMetricsServerExtraHandler(poolpause.HandlerPath, adminAuthorizer.Wrap(poolpause.NewHandler(pauser))); err != nil {
+			setupLog.Error(err, "Failed to setup pool pause API handler")
+			return nil, nil, err
+		}
+		go pauser.Run(ctx)
+		admissionController = requestcontrol.NewGatedAdmissionController(pauser, admissionController)
+		setupLog.Info("Pool pause API enabled", "path", poolpause.HandlerPath, "maxDuration", opts.PoolPauseMaxDuration)
+	}
+
+	director := requestcontrol.NewDirectorWithConfig(ds, scheduler, admissionController, endpointCandidates, r.requestControlConfig).
+		WithPluginBreaker(pluginBreaker).
+		WithIdempotencyPolicy(requestcontrol.NewIdempotencyPolicy(opts.RequestsIdempotentByDefault)).
+		WithSchedulingHintsPolicy(requestcontrol.NewSchedulingHintsPolicy(eppConfig.SchedulingHints)).
+		WithDefaultMaxQueueWait(defaultMaxQueueWait)
+	if opts.SpilloverPeerAddress != "" {
+		if queueStats == nil {
+			setupLog.Info("Spillover requires the flow control feature gate, ignoring it")
+		} else {
+			spiller, err := spillover.NewSpiller(opts.SpilloverConfig(), queueStats)
+			if err != nil {
+				setupLog.Error(err, "Failed to create spillover")
+				return nil, nil, err
+			}
+			director.WithSpiller(spiller)
+			setupLog.Info("Spillover enabled", "peer", opts.SpilloverPeerAddress, "queueThreshold", opts.SpilloverQueueThreshold)
+		}
+	}
+	if opts.PoolFallbackAddress != "" {
+		router, err := poolfallback.NewRouter(opts.PoolFallbackConfig(), eppConfig.SaturationDetector)
+		if err != nil {
+			setupLog.Error(err, "Failed to create pool fallback")
+			return nil, nil, err
+		}
+		director.WithPoolFallback(router)
+		setupLog.Info("Pool fallback enabled", "secondaryPool", opts.PoolFallbackSecondaryPool,
+			"address", opts.PoolFallbackAddress, "saturationThreshold", opts.PoolFallbackSaturationThreshold)
+	}
+	if opts.EnableWhatIfAPI {
+		recorder := whatif.NewRecorder(opts.WhatIfRecords)
+		if err := mgr.AddMetricsServerExtraHandler(whatif.HandlerPath, adminAuthorizer.Wrap(whatif.NewHandler(recorder, scheduler))); err != nil {
+			setupLog.Error(err, "Failed to setup what-if API handler")
+			return nil, nil, err
+		}
+		director.WithDecisionRecorder(recorder)
+		setupLog.Info("What-if API enabled", "path", whatif.HandlerPath, "records", opts.WhatIfRecords)
+	}
+	if opts.EnableExplainDebugAPI {
+		explainer := explain.NewExplainer(r.parser, scheduler, endpointCandidates)
+		if err := mgr.AddMetricsServerExtraHandler(explain.HandlerPath, adminAuthorizer.Wrap(explain.NewHandler(explainer))); err != nil {
+			setupLog.Error(err, "Failed to setup explain debug API handler")
+			return nil, nil, err
+		}
+		setupLog.Info("Explain debug API enabled", "path", explain.HandlerPath)
+	}
+	if opts.EnableAdaptiveMetricsRefresh {
+		if r.featureGates[datalayer.EnableLegacyMetricsFeatureGate] {
+			setupLog.Info("Adaptive metrics refresh is not supported with legacy metrics polling, ignoring it")
+		} else {
+			controller, err := adaptivescrape.NewController(opts.AdaptiveScrapeConfig(), ds)
+			if err != nil {
+				setupLog.Error(err, "Failed to create adaptive metrics refresh controller")
+				return nil, nil, err
+			}
+			controller.OnStretchChange(r.dlRuntime.SetAdaptiveStretch)
+			director.WithArrivalRecorder(controller)
+			go controller.Run(ctx)
+			setupLog.Info("Adaptive metrics refresh enabled", "minInterval", opts.RefreshMetricsInterval,
+				"maxInterval", opts.AdaptiveMetricsRefreshMaxInterval, "burstRequestRate", opts.AdaptiveMetricsRefreshBurstRequests)
+		}
+	}
+
+	gatewayProvider, _ := gatewayprovider.Get(opts.GatewayProvider) // validated by Validate
+	setupLog.Info("Gateway provider selected", "provider", gatewayProvider.Name())
+	fairnessIDSource, _ := handlers.ParseFairnessIDSource(opts.FairnessIDSource) // validated by Validate
 
 	serverRunner := &runserver.ExtProcServerRunner{
 		GrpcPort:                         opts.GRPCPort,
@@ -384,6 +713,14 @@ func (r *Runner) setup(ctx context.Context, cfg *rest.Config, opts *runserver.Op
 		Director:                         director,
 		Parser:                           r.parser,
 		SaturationDetector:               eppConfig.SaturationDetector,
+		DecisionComparer:                 decisionComparer,
+		WireCapturer:                     wireCapturer,
+		GatewayProvider:                  gatewayProvider,
+		RequestBodyConfig:                opts.RequestBodyConfig(),
+		FairnessIDSource:                 fairnessIDSource,
+		DrainTimeout:                     opts.DrainTimeout,
+		RejectQueuedOnDrain:              opts.DrainQueuedRequests == runserver.DrainQueuedRequestsReject,
+		StopFlowControl:                  stopFlowControl,
 		UseExperimentalDatalayerV2:       r.featureGates[datalayer.ExperimentalDatalayerFeatureGate] || !r.featureGates[datalayer.EnableLegacyMetricsFeatureGate],
 	}
 
@@ -394,14 +731,53 @@ func (r *Runner) setup(ctx context.Context, cfg *rest.Config, opts *runserver.Op
 
 	// --- Add Runnables to Manager ---
 	// Register health server.
-	if err := registerHealthServer(mgr, ctrl.Log.WithName("health"), ds, opts.GRPCHealthPort, isLeader, opts.EnableLeaderElection, r.parser); err != nil {
+	if err := registerHealthServer(mgr, ctrl.Log.WithName("health"), ds, opts.GRPCHealthPort, isLeader, opts.EnableLeaderElection, r.parser, stateBootstrapped); err != nil {
 		return nil, nil, err
 	}
 
+	// Register load hints server. It is not authenticated, accepting the hints of an endpoint only from the IP of its
+	// pod, so its port must not be exposed outside the cluster.
+	if opts.LoadHintsPort != 0 {
+		srv := grpc.NewServer()
+		loadhintsgen.RegisterLoadHintsServer(srv, loadhints.NewServer(ds, opts.LoadHintsMaxDuration))
+		if err := mgr.Add(runnable.NoLeaderElection(runnable.GRPCServer("load-hints", srv, opts.LoadHintsPort))); err != nil {
+			setupLog.Error(err, "Failed to register load hints server")
+			return nil, nil, err
+		}
+	}
+
+	// Register OTLP metrics receiver. It is not authenticated, accepting the metrics of an endpoint only from the IP of
+	// its pod, so its port must not be exposed outside the cluster.
+	if opts.OTLPMetricsPort != 0 {
+		metricsConfig := opts.ModelServerMetricsConfig()
+		mapping, err := backendmetrics.NewMetricMapping(metricsConfig.TotalQueuedRequestsMetric,
+			metricsConfig.TotalRunningRequestsMetric, metricsConfig.KVCacheUsagePercentageMetric, "", "")
+		if err != nil {
+			setupLog.Error(err, "Failed to create OTLP metrics mapping")
+			return nil, nil, err
+		}
+		mux := http.NewServeMux()
+		mux.Handle(otlpreceiver.HandlerPath, otlpreceiver.NewReceiver(ds, mapping))
+		srv := &manager.Server{
+			Name: "otlp-metrics",
+			Server: &http.Server{
+				Addr:              fmt.Sprintf(":%d", opts.OTLPMetricsPort),
+				Handler:           mux,
+				ReadHeaderTimeout: 10 * time.Second,
+			},
+		}
+		if err := mgr.Add(srv); err != nil {
+			setupLog.Error(err, "Failed to register OTLP metrics receiver")
+			return nil, nil, err
+		}
+		setupLog.Info("OTLP metrics receiver enabled", "port", opts.OTLPMetricsPort, "path", otlpreceiver.HandlerPath)
+	}
+
 	// Register ext-proc server.
 	if err := registerExtProcServer(mgr, serverRunner, ctrl.Log.WithName("ext-proc")); err != nil {
 		return nil, nil, err
 	}
+	r.extProcServer = serverRunner
 	return mgr, ds, nil
 }
 
@@ -441,17 +817,20 @@ func NewEndpointPoolFromOptions(
 }
 
 func setupDatastore(ctx context.Context, epFactory datalayer.EndpointFactory, modelServerMetricsPort int32,
-	startCrdReconcilers bool, namespace, name, endpointSelector string, endpointTargetPorts []int) (datastore.Datastore, error) {
+	startCrdReconcilers bool, namespace, name, endpointSelector string, endpointTargetPorts []int,
+	endpointSliceService string, nodeLabels []string) (datastore.Datastore, error) {
 
 	if startCrdReconcilers {
-		return datastore.NewDatastore(ctx, epFactory, modelServerMetricsPort), nil
+		return datastore.NewDatastore(ctx, epFactory, modelServerMetricsPort).WithEndpointSlices(endpointSliceService).
+			WithNodeLabels(nodeLabels), nil
 	} else {
 		endpointPool, err := NewEndpointPoolFromOptions(namespace, name, endpointSelector, endpointTargetPorts)
 		if err != nil {
 			setupLog.Error(err, "Failed to construct endpoint pool from options")
 			return nil, err
 		}
-		return datastore.NewDatastore(ctx, epFactory, modelServerMetricsPort).WithEndpointPool(endpointPool), nil
+		return datastore.NewDatastore(ctx, epFactory, modelServerMetricsPort).WithEndpointPool(endpointPool).
+			WithEndpointSlices(endpointSliceService).WithNodeLabels(nodeLabels), nil
 	}
 }
 
@@ -459,17 +838,30 @@ func setupDatastore(ctx context.Context, epFactory datalayer.EndpointFactory, mo
 func (r *Runner) registerInTreePlugins() {
 	fwkplugin.Register(prefix.PrefixCacheScorerPluginType, prefix.PrefixCachePluginFactory)
 	fwkplugin.Register(maxscore.MaxScorePickerType, maxscore.MaxScorePickerFactory)
+	fwkplugin.Register(deterministichash.DeterministicHashPickerType, deterministichash.DeterministicHashPickerFactory)
+	fwkplugin.Register(failuredomain.FailureDomainSpreadPickerType, failuredomain.FailureDomainSpreadPickerFactory)
 	fwkplugin.Register(random.RandomPickerType, random.RandomPickerFactory)
+	fwkplugin.Register(topk.TopKPickerType, topk.TopKPickerFactory)
 	fwkplugin.Register(weightedrandom.WeightedRandomPickerType, weightedrandom.WeightedRandomPickerFactory)
 	fwkplugin.Register(profile.SingleProfileHandlerType, profile.SingleProfileHandlerFactory)
+	fwkplugin.Register(profile.CriticalityProfileHandlerType, profile.CriticalityProfileHandlerFactory)
+	fwkplugin.Register(profile.RedundancyProfileHandlerType, profile.RedundancyProfileHandlerFactory)
+	fwkplugin.Register(profile.FanOutProfileHandlerType, profile.FanOutProfileHandlerFactory)
+	fwkplugin.Register(profile.AppendTargetsProcessorType, profile.AppendTargetsProcessorFactory)
 	fwkplugin.Register(kvcacheutilization.KvCacheUtilizationScorerType, kvcacheutilization.KvCacheUtilizationScorerFactory)
 	fwkplugin.Register(queuedepth.QueueScorerType, queuedepth.QueueScorerFactory)
+	fwkplugin.Register(capacityqueue.CapacityQueueScorerType, capacityqueue.CapacityQueueScorerFactory)
+	fwkplugin.Register(sloaware.SLOAwareScorerType, sloaware.SLOAwareScorerFactory)
+	fwkplugin.Register(precision.PrecisionScorerType, precision.PrecisionScorerFactory)
+	fwkplugin.Register(leastloaded.LeastLoadedScorerType, leastloaded.LeastLoadedScorerFactory)
+	fwkplugin.Register(leastoutstanding.LeastOutstandingScorerType, leastoutstanding.LeastOutstandingScorerFactory)
 	fwkplugin.Register(runningrequests.RunningRequestsSizeScorerType, runningrequests.RunningRequestsSizeScorerFactory)
 	fwkplugin.Register(loraaffinity.LoraAffinityScorerType, loraaffinity.LoraAffinityScorerFactory)
 	fwkplugin.Register(tokenload.TokenLoadScorerType, tokenload.TokenLoadScorerFactory)
 	// Flow Control plugins
 	fwkplugin.Register(globalstrict.GlobalStrictFairnessPolicyType, globalstrict.GlobalStrictFairnessPolicyFactory)
 	fwkplugin.Register(roundrobin.RoundRobinFairnessPolicyType, roundrobin.RoundRobinFairnessPolicyFactory)
+	fwkplugin.Register(wfq.WeightedFairQueuingFairnessPolicyType, wfq.WeightedFairQueuingFairnessPolicyFactory)
 	fwkplugin.Register(fcfs.FCFSOrderingPolicyType, fcfs.FCFSOrderingPolicyFactory)
 	fwkplugin.Register(edf.EDFOrderingPolicyType, edf.EDFOrderingPolicyFactory)
 	fwkplugin.Register(slodeadline.SLODeadlineOrderingPolicyType, slodeadline.SLODeadlineOrderingPolicyFactory)
@@ -479,15 +871,36 @@ func (r *Runner) registerInTreePlugins() {
 	fwkplugin.RegisterAsDefaultProducer(reqdataprodprefix.ApproxPrefixCachePluginType, reqdataprodprefix.ApproxPrefixCacheFactory, attrprefix.PrefixCacheMatchInfoKey)
 	fwkplugin.RegisterAsDefaultProducer(inflightload.InFlightLoadProducerType, inflightload.InFlightLoadProducerFactory, attrconcurrency.InFlightLoadKey)
 	fwkplugin.RegisterAsDefaultProducer(latencyproducer.LatencyDataProviderPluginType, latencyproducer.PredictedLatencyFactory, attrlatency.LatencyPredictionInfoKey)
+	fwkplugin.Register(fingerprint.FingerprintProducerType, fingerprint.Factory)
+	fwkplugin.Register(metricsbackfill.MetricsBackfillProducerType, metricsbackfill.Factory)
+	fwkplugin.Register(orcaload.OrcaLoadProducerType, orcaload.Factory)
 
 	// Latency predictor plugins
 	fwkplugin.Register(latencyslo.LatencyAdmissionPluginType, latencyslo.LatencyAdmissionFactory)
+	fwkplugin.RegisterWithSchema(contextwindow.PluginType, contextwindow.Factory, contextwindow.ParameterSchema)
+	fwkplugin.RegisterWithSchema(maxtokens.PluginType, maxtokens.Factory, maxtokens.ParameterSchema)
+	fwkplugin.RegisterWithSchema(ratelimit.PluginType, ratelimit.Factory, ratelimit.ParameterSchema)
+	fwkplugin.Register(headers.PluginType, headers.Factory)
+	fwkplugin.Register(modelalias.PluginType, modelalias.Factory)
 
 	// Latency scoring and filtering plugins
 	fwkplugin.Register(prefixcacheaffinity.PluginType, prefixcacheaffinity.Factory)
+	fwkplugin.Register(evalrunaffinity.PluginType, evalrunaffinity.Factory)
+	fwkplugin.Register(slicefilter.PluginType, slicefilter.Factory)
+	fwkplugin.Register(loadhintfilter.PluginType, loadhintfilter.Factory)
+	fwkplugin.Register(precisionfilter.PluginType, precisionfilter.Factory)
+	fwkplugin.Register(promptquarantine.PluginType, promptquarantine.Factory)
+	fwkplugin.Register(adaptiveconcurrency.PluginType, adaptiveconcurrency.Factory)
 	fwkplugin.Register(sloheadroomtier.PluginType, sloheadroomtier.Factory)
 	fwkplugin.Register(latencyscorer.LatencyScorerType, latencyscorer.Factory)
 
+	// Out-of-process scheduling plugins
+	fwkplugin.Register(grpcplugin.PluginType, grpcplugin.Factory)
+
+	// Expression-based scheduling plugins
+	fwkplugin.RegisterWithSchema(celexpr.FilterType, celexpr.FilterFactory, celexpr.ParameterSchema)
+	fwkplugin.RegisterWithSchema(celexpr.ScorerType, celexpr.ScorerFactory, celexpr.ParameterSchema)
+
 	// register filter for test purpose only (used in conformance tests)
 	fwkplugin.Register(testfilter.HeaderBasedTestingFilterType, testfilter.HeaderBasedTestingFilterFactory)
 	// register response received plugin for test purpose only (used in conformance tests)
@@ -500,10 +913,15 @@ func (r *Runner) registerInTreePlugins() {
 	fwkplugin.Register(sourcenotifications.EndpointNotificationSourceType, sourcenotifications.EndpointSourceFactory)
 	// register request control pluigns
 	fwkplugin.Register(requestattributereporter.RequestAttributeReporterType, requestattributereporter.RequestAttributeReporterPluginFactory)
+	fwkplugin.Register(backendabort.PluginType, backendabort.Factory)
+	fwkplugin.Register(responseheaders.PluginType, responseheaders.Factory)
 	fwkplugin.Register(openai.OpenAIParserType, openai.OpenAIParserPluginFactory)
 	fwkplugin.Register(vllmgrpc.VllmGRPCParserType, vllmgrpc.VllmGRPCParserPluginFactory)
 	fwkplugin.Register(passthrough.PassthroughParserType, passthrough.PassthroughParserPluginFactory)
+	fwkplugin.Register(anthropic.AnthropicParserType, anthropic.AnthropicParserPluginFactory)
+	fwkplugin.Register(kservegrpc.KServeGRPCParserType, kservegrpc.KServeGRPCParserPluginFactory)
 	// register saturation detector plugins
+	fwkplugin.Register(composite.CompositeDetectorType, composite.CompositeDetectorFactory)
 	fwkplugin.Register(concurrency.ConcurrencyDetectorType, concurrency.ConcurrencyDetectorFactory)
 	fwkplugin.Register(utilization.UtilizationDetectorType, utilization.UtilizationDetectorFactory)
 }
@@ -522,9 +940,17 @@ func (r *Runner) parseConfigurationPhaseOne(ctx context.Context, opts *runserver
 		}
 	}
 
-	loader.RegisterFeatureGate(datalayer.ExperimentalDatalayerFeatureGate)
-	loader.RegisterFeatureGate(datalayer.EnableLegacyMetricsFeatureGate)
-	loader.RegisterFeatureGate(flowcontrol.FeatureGate)
+	featuregate.Register(datalayer.ExperimentalDatalayerFeatureGate, featuregate.Spec{Stage: featuregate.Deprecated})
+	featuregate.Register(datalayer.EnableLegacyMetricsFeatureGate, featuregate.Spec{Stage: featuregate.Deprecated})
+	featuregate.Register(flowcontrol.FeatureGate, featuregate.Spec{Stage: featuregate.Alpha})
+
+	overrides, err := featuregate.ParseOverrides(opts.FeatureGates)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse feature gates - %w", err)
+	}
+	if err := featuregate.SetOverrides(overrides); err != nil {
+		return nil, fmt.Errorf("failed to set feature gates - %w", err)
+	}
 
 	r.registerInTreePlugins()
 
@@ -534,6 +960,11 @@ func (r *Runner) parseConfigurationPhaseOne(ctx context.Context, opts *runserver
 	}
 
 	r.featureGates = featureGates
+	for _, gate := range featuregate.Registered() {
+		if spec, _ := featuregate.Lookup(gate); featureGates[gate] {
+			setupLog.Info("Feature gate enabled", "gate", gate, "stage", spec.Stage)
+		}
+	}
 
 	if r.featureGates[datalayer.ExperimentalDatalayerFeatureGate] {
 		setupLog.Info("The data layer is now enabled by default. " +
@@ -590,6 +1021,16 @@ func (r *Runner) parseConfigurationPhaseTwo(ctx context.Context, rawConfig *conf
 		handle.AddPlugin(p.TypedName().Name, p)
 	}
 	r.requestControlConfig.AddPlugins(dataProducers...)
+	r.requestControlConfig.OrderRequestMutators(cfg.RequestMutatorOrder)
+	r.peerState = peerstate.NewRegistry(handle.GetAllPlugins()...)
+	r.debugState = debugstate.NewDumper(ds, rawConfig, handle.GetAllPlugins()...)
+
+	// Subscribe the plugins tracking the endpoints to their changes, for the lifetime of the EPP.
+	for _, p := range handle.GetAllPlugins() {
+		if subscriber, ok := p.(fwkdl.EndpointSubscriber); ok {
+			ds.Subscribe(ctx, subscriber)
+		}
+	}
 
 	// Sort data plugins in DAG order (topological sort). Also check DAG for cycles.
 	// This must run after auto-created producers are added so they are included in the ordering.
@@ -654,7 +1095,7 @@ func registerExtProcServer(mgr manager.Manager, runner *runserver.ExtProcServerR
 }
 
 // registerHealthServer adds the Health gRPC server as a Runnable to the given manager.
-func registerHealthServer(mgr manager.Manager, logger logr.Logger, ds datastore.Datastore, port int, isLeader *atomic.Bool, leaderElectionEnabled bool, supporter appProtocolSupporter) error {
+func registerHealthServer(mgr manager.Manager, logger logr.Logger, ds datastore.Datastore, port int, isLeader *atomic.Bool, leaderElectionEnabled bool, supporter appProtocolSupporter, stateBootstrapped func() bool) error {
 	srv := grpc.NewServer()
 	healthPb.RegisterHealthServer(srv, &healthServer{
 		logger:                logger,
@@ -662,6 +1103,7 @@ func registerHealthServer(mgr manager.Manager, logger logr.Logger, ds datastore.
 		isLeader:              isLeader,
 		leaderElectionEnabled: leaderElectionEnabled,
 		supporter:             supporter,
+		stateBootstrapped:     stateBootstrapped,
 	})
 	if err := mgr.Add(
 		runnable.NoLeaderElection(runnable.GRPCServer("health", srv, port))); err != nil {
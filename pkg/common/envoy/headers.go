@@ -46,6 +46,32 @@ func ExtractHeaderValue(req *extProcPb.ProcessingRequest_RequestHeaders, headerK
 	return ""
 }
 
+// RequestHeadersCarrier adapts the headers of a processing request to a read-only OpenTelemetry TextMapCarrier, so
+// that the trace context propagated by the gateway can be extracted from them.
+type RequestHeadersCarrier struct {
+	Req *extProcPb.ProcessingRequest_RequestHeaders
+}
+
+// Get returns the value of the given header key, or an empty string if it is missing.
+func (c RequestHeadersCarrier) Get(key string) string {
+	return ExtractHeaderValue(c.Req, key)
+}
+
+// Set is a no-op, the request headers are never mutated through the carrier.
+func (c RequestHeadersCarrier) Set(string, string) {}
+
+// Keys returns the keys of all the request headers.
+func (c RequestHeadersCarrier) Keys() []string {
+	if c.Req == nil || c.Req.RequestHeaders == nil || c.Req.RequestHeaders.Headers == nil {
+		return nil
+	}
+	keys := make([]string, 0, len(c.Req.RequestHeaders.Headers.Headers))
+	for _, headerKv := range c.Req.RequestHeaders.Headers.Headers {
+		keys = append(keys, headerKv.Key)
+	}
+	return keys
+}
+
 func GenerateHeadersMutation(headers map[string]string) []*corev3.HeaderValueOption {
 	headersMutation := make([]*corev3.HeaderValueOption, 0, len(headers))
 	for key, value := range headers {
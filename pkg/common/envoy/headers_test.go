@@ -221,3 +221,31 @@ func TestGetHeaderValue(t *testing.T) {
 		})
 	}
 }
+
+func TestRequestHeadersCarrier(t *testing.T) {
+	traceparent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	carrier := RequestHeadersCarrier{Req: &extProcPb.ProcessingRequest_RequestHeaders{
+		RequestHeaders: &extProcPb.HttpHeaders{
+			Headers: &corev3.HeaderMap{
+				Headers: []*corev3.HeaderValue{
+					{Key: "Traceparent", RawValue: []byte(traceparent)},
+					{Key: "x-request-id", Value: "123"},
+				},
+			},
+		},
+	}}
+
+	if got := carrier.Get("traceparent"); got != traceparent {
+		t.Errorf("Get(traceparent) = %v, want %v", got, traceparent)
+	}
+	carrier.Set("traceparent", "ignored")
+	if got := carrier.Get("traceparent"); got != traceparent {
+		t.Errorf("Set() mutated the headers, Get(traceparent) = %v", got)
+	}
+	if diff := cmp.Diff([]string{"Traceparent", "x-request-id"}, carrier.Keys()); diff != "" {
+		t.Errorf("Keys() mismatch (-want +got):\n%s", diff)
+	}
+	if keys := (RequestHeadersCarrier{}).Keys(); keys != nil {
+		t.Errorf("Keys() of a nil request = %v, want nil", keys)
+	}
+}
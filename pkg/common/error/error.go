@@ -17,8 +17,12 @@ limitations under the License.
 package error
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
+	"time"
 
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	extProcPb "github.com/envoyproxy/go-control-plane/envoy/service/ext_proc/v3"
 	envoyTypePb "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"google.golang.org/grpc/status"
@@ -28,6 +32,25 @@ import (
 type Error struct {
 	Code string
 	Msg  string
+	// RetryAfter is when the client may retry, returned in the Retry-After header of the 429 and 503 responses.
+	// DefaultRetryAfter is returned when zero.
+	RetryAfter time.Duration
+	// RateLimit is the status of the rate limit rejecting the request, returned in the rate limit headers of the
+	// response, if any.
+	RateLimit *RateLimit
+}
+
+// RateLimit is the status of a rate limit, returned in the x-ratelimit-limit-<resource>,
+// x-ratelimit-remaining-<resource> and x-ratelimit-reset-<resource> headers of the responses.
+type RateLimit struct {
+	// Resource is what is limited, e.g. requests or tokens.
+	Resource string
+	// Limit is the size of the budget.
+	Limit int64
+	// Remaining is what is left of the budget.
+	Remaining int64
+	// Reset is the duration until the budget is replenished enough to admit the request.
+	Reset time.Duration
 }
 
 const (
@@ -40,8 +63,14 @@ const (
 	ServiceUnavailable = "ServiceUnavailable"
 	ModelServerError   = "ModelServerError"
 	ResourceExhausted  = "ResourceExhausted"
+	PayloadTooLarge    = "PayloadTooLarge"
+	// Quarantined indicates that the request matches a prompt pattern quarantined for repeatedly failing backends.
+	Quarantined = "Quarantined"
 )
 
+// DefaultRetryAfter is the Retry-After of the 429 and 503 responses of the errors without a RetryAfter.
+const DefaultRetryAfter = time.Second
+
 // Error returns a string version of the error.
 func (e Error) Error() string {
 	return fmt.Sprintf("inference error: %s - %s", e.Code, e.Msg)
@@ -56,44 +85,82 @@ func CanonicalCode(err error) string {
 	return Unknown
 }
 
-// BuildErrResponse maps an error to an Envoy ImmediateResponse with the appropriate
-// HTTP status code and error message body. If the error code is not recognized,
-// it returns a gRPC error instead of an ImmediateResponse.
+// httpError is how an error code is surfaced to the clients: the HTTP status, and the type and code of the body
+// following the OpenAI error schema.
+type httpError struct {
+	status    envoyTypePb.StatusCode
+	errorType string
+	code      string
+	retryable bool // whether the response carries a Retry-After header
+}
+
+var httpErrors = map[string]httpError{
+	BadRequest:         {status: envoyTypePb.StatusCode_BadRequest, errorType: "invalid_request_error", code: "bad_request"},
+	Unauthorized:       {status: envoyTypePb.StatusCode_Unauthorized, errorType: "authentication_error", code: "unauthorized"},
+	Forbidden:          {status: envoyTypePb.StatusCode_Forbidden, errorType: "permission_error", code: "forbidden"},
+	NotFound:           {status: envoyTypePb.StatusCode_NotFound, errorType: "invalid_request_error", code: "not_found"},
+	PayloadTooLarge:    {status: envoyTypePb.StatusCode_PayloadTooLarge, errorType: "invalid_request_error", code: "payload_too_large"},
+	ResourceExhausted:  {status: envoyTypePb.StatusCode_TooManyRequests, errorType: "rate_limit_error", code: "rate_limit_exceeded", retryable: true},
+	Quarantined:        {status: envoyTypePb.StatusCode_UnprocessableEntity, errorType: "invalid_request_error", code: "quarantined"},
+	Internal:           {status: envoyTypePb.StatusCode_InternalServerError, errorType: "server_error", code: "internal_error"},
+	ServiceUnavailable: {status: envoyTypePb.StatusCode_ServiceUnavailable, errorType: "server_error", code: "service_unavailable", retryable: true},
+}
+
+// errorBody is the body of the error responses, following the OpenAI error schema so that the client SDKs surface
+// the message and code of the errors.
+type errorBody struct {
+	Error errorBodyError `json:"error"`
+}
+
+type errorBodyError struct {
+	Message string  `json:"message"`
+	Type    string  `json:"type"`
+	Param   *string `json:"param"`
+	Code    string  `json:"code"`
+}
+
+// BuildErrResponse maps an error to an Envoy ImmediateResponse with the appropriate HTTP status code, a JSON body
+// following the OpenAI error schema and, for the 429 and 503 responses, a Retry-After header. If the error code is
+// not recognized, it returns a gRPC error instead of an ImmediateResponse.
 func BuildErrResponse(err error) (*extProcPb.ProcessingResponse, error) {
-	var httpCode envoyTypePb.StatusCode
-
-	switch CanonicalCode(err) {
-	case BadRequest:
-		httpCode = envoyTypePb.StatusCode_BadRequest
-	case Unauthorized:
-		httpCode = envoyTypePb.StatusCode_Unauthorized
-	case Forbidden:
-		httpCode = envoyTypePb.StatusCode_Forbidden
-	case NotFound:
-		httpCode = envoyTypePb.StatusCode_NotFound
-	case ResourceExhausted:
-		httpCode = envoyTypePb.StatusCode_TooManyRequests
-	case Internal:
-		httpCode = envoyTypePb.StatusCode_InternalServerError
-	case ServiceUnavailable:
-		httpCode = envoyTypePb.StatusCode_ServiceUnavailable
-	default:
+	e, ok := err.(Error)
+	httpErr, known := httpErrors[e.Code]
+	if !ok || !known {
 		return nil, status.Errorf(status.Code(err), "failed to handle request: %v", err)
 	}
 
-	resp := &extProcPb.ProcessingResponse{
+	body, marshalErr := json.Marshal(errorBody{Error: errorBodyError{Message: e.Msg, Type: httpErr.errorType, Code: httpErr.code}})
+	if marshalErr != nil {
+		return nil, status.Errorf(status.Code(err), "failed to handle request: %v", err)
+	}
+	headers := []*corev3.HeaderValueOption{header("content-type", "application/json")}
+	if httpErr.retryable {
+		retryAfter := e.RetryAfter
+		if retryAfter <= 0 {
+			retryAfter = DefaultRetryAfter
+		}
+		headers = append(headers, header("retry-after", fmt.Sprint(int64(math.Ceil(retryAfter.Seconds())))))
+	}
+	if limit := e.RateLimit; limit != nil {
+		headers = append(headers,
+			header("x-ratelimit-limit-"+limit.Resource, fmt.Sprint(limit.Limit)),
+			header("x-ratelimit-remaining-"+limit.Resource, fmt.Sprint(limit.Remaining)),
+			header("x-ratelimit-reset-"+limit.Resource, limit.Reset.Round(time.Millisecond).String()))
+	}
+
+	return &extProcPb.ProcessingResponse{
 		Response: &extProcPb.ProcessingResponse_ImmediateResponse{
 			ImmediateResponse: &extProcPb.ImmediateResponse{
 				Status: &envoyTypePb.HttpStatus{
-					Code: httpCode,
+					Code: httpErr.status,
 				},
+				Headers: &extProcPb.HeaderMutation{SetHeaders: headers},
+				Body:    body,
 			},
 		},
-	}
-
-	if err.Error() != "" {
-		resp.Response.(*extProcPb.ProcessingResponse_ImmediateResponse).ImmediateResponse.Body = []byte(err.Error())
-	}
+	}, nil
+}
 
-	return resp, nil
+func header(key, value string) *corev3.HeaderValueOption {
+	return &corev3.HeaderValueOption{Header: &corev3.HeaderValue{Key: key, RawValue: []byte(value)}}
 }
@@ -17,9 +17,11 @@ limitations under the License.
 package error
 
 import (
+	"encoding/json"
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	envoyTypePb "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 )
@@ -258,6 +260,18 @@ func TestBuildErrResponse(t *testing.T) {
 			wantHTTPStatus:   envoyTypePb.StatusCode_TooManyRequests,
 			wantBodyContains: "no capacity",
 		},
+		{
+			name:             "PayloadTooLarge returns 413",
+			err:              Error{Code: PayloadTooLarge, Msg: "request body too large"},
+			wantHTTPStatus:   envoyTypePb.StatusCode_PayloadTooLarge,
+			wantBodyContains: "request body too large",
+		},
+		{
+			name:             "Quarantined returns 422",
+			err:              Error{Code: Quarantined, Msg: "quarantined prompt"},
+			wantHTTPStatus:   envoyTypePb.StatusCode_UnprocessableEntity,
+			wantBodyContains: "quarantined prompt",
+		},
 		{
 			name:             "Internal returns 500",
 			err:              Error{Code: Internal, Msg: "unexpected failure"},
@@ -311,3 +325,101 @@ func TestBuildErrResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildErrResponseSchemaAndRetryAfter(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            Error
+		wantType       string
+		wantCode       string
+		wantRetryAfter string
+	}{
+		{
+			name:     "NotFound has no Retry-After",
+			err:      Error{Code: NotFound, Msg: "model not found"},
+			wantType: "invalid_request_error",
+			wantCode: "not_found",
+		},
+		{
+			name:           "ResourceExhausted defaults the Retry-After",
+			err:            Error{Code: ResourceExhausted, Msg: "no capacity"},
+			wantType:       "rate_limit_error",
+			wantCode:       "rate_limit_exceeded",
+			wantRetryAfter: "1",
+		},
+		{
+			name:           "ServiceUnavailable rounds the Retry-After up",
+			err:            Error{Code: ServiceUnavailable, Msg: "pool paused", RetryAfter: 2500 * time.Millisecond},
+			wantType:       "server_error",
+			wantCode:       "service_unavailable",
+			wantRetryAfter: "3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := BuildErrResponse(tt.err)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			ir := resp.GetImmediateResponse()
+
+			var body struct {
+				Error struct {
+					Message string  `json:"message"`
+					Type    string  `json:"type"`
+					Param   *string `json:"param"`
+					Code    string  `json:"code"`
+				} `json:"error"`
+			}
+			if err := json.Unmarshal(ir.GetBody(), &body); err != nil {
+				t.Fatalf("body %q is not JSON: %v", string(ir.GetBody()), err)
+			}
+			if body.Error.Message != tt.err.Msg || body.Error.Type != tt.wantType || body.Error.Code != tt.wantCode ||
+				body.Error.Param != nil {
+				t.Errorf("body %q, want message %q, type %q and code %q", string(ir.GetBody()), tt.err.Msg, tt.wantType,
+					tt.wantCode)
+			}
+
+			headers := map[string]string{}
+			for _, header := range ir.GetHeaders().GetSetHeaders() {
+				headers[header.GetHeader().GetKey()] = string(header.GetHeader().GetRawValue())
+			}
+			if headers["content-type"] != "application/json" {
+				t.Errorf("content-type = %q, want application/json", headers["content-type"])
+			}
+			if headers["retry-after"] != tt.wantRetryAfter {
+				t.Errorf("retry-after = %q, want %q", headers["retry-after"], tt.wantRetryAfter)
+			}
+		})
+	}
+}
+
+func TestBuildErrResponseRateLimitHeaders(t *testing.T) {
+	resp, err := BuildErrResponse(Error{
+		Code:       ResourceExhausted,
+		Msg:        "rate limit of tokens exceeded",
+		RetryAfter: 1500 * time.Millisecond,
+		RateLimit:  &RateLimit{Resource: "tokens", Limit: 1000, Remaining: 40, Reset: 1500 * time.Millisecond},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	headers := map[string]string{}
+	for _, header := range resp.GetImmediateResponse().GetHeaders().GetSetHeaders() {
+		headers[header.GetHeader().GetKey()] = string(header.GetHeader().GetRawValue())
+	}
+	want := map[string]string{
+		"content-type":                 "application/json",
+		"retry-after":                  "2",
+		"x-ratelimit-limit-tokens":     "1000",
+		"x-ratelimit-remaining-tokens": "40",
+		"x-ratelimit-reset-tokens":     "1.5s",
+	}
+	for key, value := range want {
+		if headers[key] != value {
+			t.Errorf("%s = %q, want %q", key, headers[key], value)
+		}
+	}
+}
@@ -18,4 +18,24 @@ package request
 
 const (
 	RequestIdHeaderKey = "x-request-id"
+	// TTFTObjectiveHeaderKey declares the request's time to first token objective, in milliseconds.
+	TTFTObjectiveHeaderKey = "x-slo-ttft-ms"
+	// TPOTObjectiveHeaderKey declares the request's average time per output token objective, in milliseconds.
+	TPOTObjectiveHeaderKey = "x-slo-tpot-ms"
+	// MaxQueueWaitHeaderKey declares the longest time the request may wait in the flow control queues, in milliseconds.
+	MaxQueueWaitHeaderKey = "x-max-queue-wait-ms"
+	// PrecisionHeaderKey declares the request's model precision requirement, either "Full" or "PreferQuantized".
+	PrecisionHeaderKey = "x-precision"
+	// IdempotentHeaderKey declares whether the request can be sent to the model servers more than once, "true" or
+	// "false".
+	IdempotentHeaderKey = "x-idempotent"
+	// SpilledFromHeaderKey carries the name of the pool whose EPP spilled the request over to the pool of this EPP. The
+	// requests carrying it are never spilled over again.
+	SpilledFromHeaderKey = "x-gateway-inference-spilled-from"
+	// DowngradedFromHeaderKey carries the name of the pool whose EPP forwarded the request to the secondary pool of
+	// this EPP because it was saturated. The requests carrying it never fall back again.
+	DowngradedFromHeaderKey = "x-gateway-inference-downgraded-from"
+	// DowngradedToHeaderKey is the response header carrying the name of the secondary pool which served a request
+	// that fell back because its pool was saturated.
+	DowngradedToHeaderKey = "x-gateway-inference-downgraded-to"
 )
@@ -0,0 +1,224 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package adaptivescrape adapts the interval at which the metrics of the model servers are scraped to the activity
+// of the pool.
+//
+// Scraping every endpoint at a short interval keeps the scheduling decisions fresh, but its overhead grows with the
+// size of the pool and is wasted while the pool is idle or steady. The Controller scrapes at the shortest interval
+// during request bursts and while the load of the pool changes quickly, and progressively relaxes the interval up to
+// the longest one as the pool calms down.
+package adaptivescrape
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+const (
+	// DefaultMaxInterval is the default longest scrape interval, used while the pool is idle or steady.
+	DefaultMaxInterval = 500 * time.Millisecond
+	// DefaultBurstRequestRate is the default request rate, in requests per second, from which the pool is scraped at
+	// the shortest interval.
+	DefaultBurstRequestRate = 100.0
+
+	// minEvaluationInterval bounds how often the activity of the pool is evaluated.
+	minEvaluationInterval = time.Second
+	// volatileLoadChange is the relative change of the load of the pool, or the change of its mean KV cache
+	// utilization, between two evaluations from which the pool is scraped at the shortest interval.
+	volatileLoadChange = 0.2
+)
+
+// Config configures the Controller.
+type Config struct {
+	// MinInterval is the shortest scrape interval, used during bursts. It is the interval of the scrape ticker.
+	MinInterval time.Duration
+	// MaxInterval is the longest scrape interval, used while the pool is idle or steady.
+	MaxInterval time.Duration
+	// BurstRequestRate is the request rate, in requests per second, from which the pool is scraped at MinInterval.
+	BurstRequestRate float64
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c Config) Validate() error {
+	if c.MinInterval <= 0 {
+		return fmt.Errorf("min interval must be positive, got %v", c.MinInterval)
+	}
+	if c.MaxInterval < c.MinInterval {
+		return fmt.Errorf("max interval %v must not be shorter than min interval %v", c.MaxInterval, c.MinInterval)
+	}
+	if c.BurstRequestRate <= 0 {
+		return fmt.Errorf("burst request rate must be positive, got %f", c.BurstRequestRate)
+	}
+	return nil
+}
+
+// maxStretch returns the factor by which MinInterval is stretched to reach MaxInterval.
+func (c Config) maxStretch() int {
+	return max(int(c.MaxInterval/c.MinInterval), 1)
+}
+
+// PodLister lists the endpoints of the pool.
+type PodLister interface {
+	PodList(predicate func(fwkdl.Endpoint) bool) []fwkdl.Endpoint
+}
+
+// Controller periodically evaluates the request rate and the volatility of the pool and derives the factor by which
+// the shortest scrape interval is stretched. It is safe for concurrent use.
+type Controller struct {
+	config Config
+	pods   PodLister
+
+	arrivals atomic.Int64
+
+	// Only accessed from the evaluation goroutine.
+	lastEvaluation time.Time
+	lastLoad       map[string]endpointLoad
+	stretch        int
+
+	mu        sync.Mutex
+	listeners []func(stretch int)
+}
+
+// endpointLoad is the load of an endpoint at an evaluation.
+type endpointLoad struct {
+	requests int
+	kvUsage  float64
+}
+
+// NewController creates a new Controller evaluating the endpoints listed by pods.
+func NewController(config Config, pods PodLister) (*Controller, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return &Controller{
+		config:  config,
+		pods:    pods,
+		stretch: 1,
+	}, nil
+}
+
+// RecordArrival records the arrival of a request.
+func (c *Controller) RecordArrival() {
+	c.arrivals.Add(1)
+}
+
+// OnStretchChange registers a listener that is called whenever the stretch factor of the scrape interval changes.
+func (c *Controller) OnStretchChange(listener func(stretch int)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.listeners = append(c.listeners, listener)
+}
+
+// Run periodically evaluates the activity of the pool until the context is cancelled.
+func (c *Controller) Run(ctx context.Context) {
+	logger := log.FromContext(ctx).WithName("adaptive-scrape")
+	ticker := time.NewTicker(max(c.config.MaxInterval, minEvaluationInterval))
+	defer ticker.Stop()
+
+	metrics.RecordMetricsRefreshInterval(c.config.MinInterval)
+	c.lastEvaluation = time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			logger.V(logutil.DEFAULT).Info("Shutting down adaptive scrape controller")
+			return
+		case now := <-ticker.C:
+			c.evaluate(ctx, now)
+		}
+	}
+}
+
+// evaluate derives the stretch factor from the activity of the pool since the last evaluation. The scrape interval is
+// shortened at once when the pool becomes active, and relaxed one step per evaluation as it calms down.
+func (c *Controller) evaluate(ctx context.Context, now time.Time) {
+	elapsed := now.Sub(c.lastEvaluation).Seconds()
+	c.lastEvaluation = now
+	if elapsed <= 0 {
+		return
+	}
+	rate := float64(c.arrivals.Swap(0)) / elapsed
+	volatility := c.volatility()
+
+	activity := min(max(rate/c.config.BurstRequestRate, volatility/volatileLoadChange), 1)
+	maxStretch := c.config.maxStretch()
+	target := 1 + int(math.Round((1-activity)*float64(maxStretch-1)))
+	stretch := min(target, c.stretch+1)
+	if stretch == c.stretch {
+		return
+	}
+
+	log.FromContext(ctx).V(logutil.DEBUG).Info("Adapting metrics scrape interval", "requestRate", rate,
+		"volatility", volatility, "interval", time.Duration(stretch)*c.config.MinInterval)
+	c.stretch = stretch
+	metrics.RecordMetricsRefreshInterval(time.Duration(stretch) * c.config.MinInterval)
+
+	c.mu.Lock()
+	listeners := c.listeners
+	c.mu.Unlock()
+	for _, listener := range listeners {
+		listener(stretch)
+	}
+}
+
+// volatility returns how much the load of the pool changed since the last evaluation: the larger of the relative
+// change of the requests on the endpoints and of the mean absolute change of their KV cache utilization.
+func (c *Controller) volatility() float64 {
+	endpoints := c.pods.PodList(func(fwkdl.Endpoint) bool { return true })
+	load := make(map[string]endpointLoad, len(endpoints))
+	var previousRequests, requestsChange int
+	var kvChange float64
+	compared := 0
+	for _, endpoint := range endpoints {
+		m := endpoint.GetMetrics()
+		if m == nil || endpoint.GetMetadata() == nil {
+			continue
+		}
+		key := endpoint.GetMetadata().NamespacedName.String()
+		current := endpointLoad{requests: m.WaitingQueueSize + m.RunningRequestsSize, kvUsage: m.KVCacheUsagePercent}
+		load[key] = current
+		previous, ok := c.lastLoad[key]
+		if !ok {
+			continue
+		}
+		compared++
+		previousRequests += previous.requests
+		requestsChange += abs(current.requests - previous.requests)
+		kvChange += math.Abs(current.kvUsage - previous.kvUsage)
+	}
+	c.lastLoad = load
+	if compared == 0 {
+		return 0
+	}
+	return max(float64(requestsChange)/float64(max(previousRequests, compared)), kvChange/float64(compared))
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
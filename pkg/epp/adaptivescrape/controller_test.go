@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adaptivescrape
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+type fakePods struct {
+	endpoints []fwkdl.Endpoint
+}
+
+func (p *fakePods) PodList(predicate func(fwkdl.Endpoint) bool) []fwkdl.Endpoint {
+	return p.endpoints
+}
+
+func (p *fakePods) setLoad(requests ...int) {
+	p.endpoints = nil
+	for i, r := range requests {
+		p.endpoints = append(p.endpoints, fwkdl.NewEndpoint(
+			&fwkdl.EndpointMetadata{NamespacedName: types.NamespacedName{Namespace: "default", Name: string(rune('a' + i))}},
+			&fwkdl.Metrics{RunningRequestsSize: r}))
+	}
+}
+
+func TestController_AdaptsStretch(t *testing.T) {
+	pods := &fakePods{}
+	pods.setLoad(10, 10)
+	controller, err := NewController(Config{
+		MinInterval:      50 * time.Millisecond,
+		MaxInterval:      200 * time.Millisecond,
+		BurstRequestRate: 100,
+	}, pods)
+	require.NoError(t, err)
+	stretches := []int{}
+	controller.OnStretchChange(func(stretch int) {
+		stretches = append(stretches, stretch)
+	})
+	ctx := context.Background()
+	now := time.Now()
+	controller.lastEvaluation = now
+
+	evaluate := func(arrivals int) int {
+		for range arrivals {
+			controller.RecordArrival()
+		}
+		now = now.Add(time.Second)
+		controller.evaluate(ctx, now)
+		return controller.stretch
+	}
+
+	// The interval is relaxed one step per evaluation while the pool is steady.
+	assert.Equal(t, 2, evaluate(0))
+	assert.Equal(t, 3, evaluate(0))
+	assert.Equal(t, 4, evaluate(0))
+	assert.Equal(t, 4, evaluate(0), "stretch should be bounded by the max interval")
+
+	// A burst of requests shortens the interval at once.
+	assert.Equal(t, 1, evaluate(100))
+	assert.Equal(t, 2, evaluate(0))
+	// Half the burst rate holds the interval in the middle of the range.
+	assert.Equal(t, 3, evaluate(50))
+	assert.Equal(t, 3, evaluate(50))
+
+	// A quick change of the load of the pool shortens the interval at once.
+	pods.setLoad(20, 10)
+	assert.Equal(t, 1, evaluate(0))
+
+	assert.Equal(t, []int{2, 3, 4, 1, 2, 3, 1}, stretches)
+}
+
+func TestController_Volatility(t *testing.T) {
+	pods := &fakePods{}
+	controller, err := NewController(Config{MinInterval: time.Second, MaxInterval: time.Second, BurstRequestRate: 1}, pods)
+	require.NoError(t, err)
+
+	pods.setLoad(10, 10)
+	assert.Equal(t, 0.0, controller.volatility(), "no previous load to compare to")
+	pods.setLoad(10, 10)
+	assert.Equal(t, 0.0, controller.volatility())
+	pods.setLoad(12, 8)
+	assert.InDelta(t, 0.2, controller.volatility(), 1e-9)
+	pods.setLoad(12, 8, 100)
+	assert.Equal(t, 0.0, controller.volatility(), "new endpoints should not count as a change")
+}
+
+func TestConfigValidate(t *testing.T) {
+	assert.NoError(t, Config{MinInterval: time.Second, MaxInterval: time.Second, BurstRequestRate: 1}.Validate())
+	assert.Error(t, Config{MinInterval: 0, MaxInterval: time.Second, BurstRequestRate: 1}.Validate())
+	assert.Error(t, Config{MinInterval: time.Second, MaxInterval: time.Millisecond, BurstRequestRate: 1}.Validate())
+	assert.Error(t, Config{MinInterval: time.Second, MaxInterval: time.Second, BurstRequestRate: 0}.Validate())
+}
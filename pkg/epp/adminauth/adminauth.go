@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package adminauth scopes the access to the admin and debug APIs of the EPP with role-scoped tokens, and audits the
+// mutating calls.
+//
+// The tokens are read from a CSV file of "token,user,role" lines. The viewer role is limited to the read-only
+// operations (GET and HEAD), the operator role may also call the mutating operations, e.g. the endpoint exclusions.
+// The tokens are passed in the TokenHeader header, so that they can be combined with the authentication of the
+// metrics endpoint, which uses the Authorization header.
+package adminauth
+
+import (
+	"crypto/sha256"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// TokenHeader is the request header holding the admin API token.
+const TokenHeader = "X-EPP-Admin-Token"
+
+// Role is the scope of an admin API token.
+type Role string
+
+const (
+	// RoleViewer allows the read-only operations.
+	RoleViewer Role = "viewer"
+	// RoleOperator allows all the operations.
+	RoleOperator Role = "operator"
+)
+
+// Identity is the holder of an admin API token.
+type Identity struct {
+	User string
+	Role Role
+}
+
+// Tokens maps the SHA-256 digests of the admin API tokens to their holder, so that the tokens themselves are not kept
+// in memory.
+type Tokens map[[sha256.Size]byte]Identity
+
+// LoadTokens reads the tokens from the CSV file at the given path. Each line holds a token, a user name and a role;
+// empty lines and lines starting with '#' are ignored.
+func LoadTokens(path string) (Tokens, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open admin API tokens file - %w", err)
+	}
+	defer file.Close()
+	return parseTokens(file)
+}
+
+func parseTokens(r io.Reader) (Tokens, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+	tokens := Tokens{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse admin API tokens - %w", err)
+		}
+		token, user, role := record[0], record[1], Role(record[2])
+		line, _ := reader.FieldPos(0)
+		if token == "" || user == "" {
+			return nil, fmt.Errorf("line %d: token and user must not be empty", line)
+		}
+		if role != RoleViewer && role != RoleOperator {
+			return nil, fmt.Errorf("line %d: role must be %q or %q, got %q", line, RoleViewer, RoleOperator, role)
+		}
+		digest := sha256.Sum256([]byte(token))
+		if _, ok := tokens[digest]; ok {
+			return nil, fmt.Errorf("line %d: duplicate token", line)
+		}
+		tokens[digest] = Identity{User: user, Role: role}
+	}
+	if len(tokens) == 0 {
+		return nil, errors.New("no admin API token found")
+	}
+	return tokens, nil
+}
+
+// Authorizer authorizes the calls to the admin and debug APIs and audits the mutating ones. An Authorizer without
+// tokens authorizes all the calls, and still audits them.
+type Authorizer struct {
+	tokens Tokens
+	logger logr.Logger
+}
+
+// NewAuthorizer returns an Authorizer for the given tokens, which may be nil to authorize all the calls.
+func NewAuthorizer(tokens Tokens) *Authorizer {
+	return &Authorizer{tokens: tokens, logger: log.Log.WithName("admin-audit")}
+}
+
+// Wrap returns a handler authorizing and auditing the calls to the given handler.
+func (a *Authorizer) Wrap(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		identity, status := a.authorize(r)
+		if !mutating(r.Method) {
+			if status != http.StatusOK {
+				http.Error(w, http.StatusText(status), status)
+				return
+			}
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		if status != http.StatusOK {
+			http.Error(recorder, http.StatusText(status), status)
+		} else {
+			handler.ServeHTTP(recorder, r)
+		}
+		a.logger.Info("Admin API call", "user", identity.User, "role", identity.Role, "method", r.Method,
+			"path", r.URL.Path, "query", r.URL.RawQuery, "remoteAddr", r.RemoteAddr, "status", recorder.status)
+	})
+}
+
+// authorize returns the holder of the token of the given request, and http.StatusOK if the request is authorized.
+func (a *Authorizer) authorize(r *http.Request) (Identity, int) {
+	if a.tokens == nil {
+		return Identity{}, http.StatusOK
+	}
+	token := strings.TrimSpace(r.Header.Get(TokenHeader))
+	if token == "" {
+		return Identity{}, http.StatusUnauthorized
+	}
+	identity, ok := a.tokens[sha256.Sum256([]byte(token))]
+	if !ok {
+		return Identity{}, http.StatusUnauthorized
+	}
+	if identity.Role != RoleOperator && mutating(r.Method) {
+		return identity, http.StatusForbidden
+	}
+	return identity, http.StatusOK
+}
+
+// mutating returns whether the calls of the given method may mutate the state of the EPP.
+func mutating(method string) bool {
+	return method != http.MethodGet && method != http.MethodHead
+}
+
+// statusRecorder records the status code of a response for the audit log.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
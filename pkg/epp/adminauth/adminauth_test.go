@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adminauth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr/funcr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const tokensFile = `
+# On-call rotation.
+viewer-token,alice,viewer
+operator-token, bob, operator
+`
+
+func TestLoadTokens(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tokens.csv")
+	require.NoError(t, os.WriteFile(path, []byte(tokensFile), 0o600))
+	tokens, err := LoadTokens(path)
+	require.NoError(t, err)
+	assert.Len(t, tokens, 2)
+
+	_, err = LoadTokens(filepath.Join(t.TempDir(), "missing.csv"))
+	assert.Error(t, err)
+
+	for name, content := range map[string]string{
+		"empty":          "# no token\n",
+		"unknown role":   "token,alice,admin\n",
+		"missing user":   "token,,viewer\n",
+		"missing field":  "token,alice\n",
+		"duplicate":      "token,alice,viewer\ntoken,bob,operator\n",
+		"malformed line": "token,\"alice,viewer\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseTokens(strings.NewReader(content))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestAuthorizer(t *testing.T) {
+	tokens, err := parseTokens(strings.NewReader(tokensFile))
+	require.NoError(t, err)
+	var audit []string
+	authorizer := NewAuthorizer(tokens)
+	authorizer.logger = funcr.New(func(_, args string) { audit = append(audit, args) }, funcr.Options{})
+	handler := authorizer.Wrap(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+
+	tests := []struct {
+		name       string
+		method     string
+		token      string
+		wantStatus int
+		wantAudit  string
+	}{
+		{name: "viewer reads", method: http.MethodGet, token: "viewer-token", wantStatus: http.StatusOK},
+		{name: "operator reads", method: http.MethodGet, token: "operator-token", wantStatus: http.StatusOK},
+		{name: "viewer mutates", method: http.MethodPost, token: "viewer-token", wantStatus: http.StatusForbidden,
+			wantAudit: `"user"="alice" "role"="viewer" "method"="POST"`},
+		{name: "operator mutates", method: http.MethodDelete, token: "operator-token", wantStatus: http.StatusNoContent,
+			wantAudit: `"user"="bob" "role"="operator" "method"="DELETE"`},
+		{name: "missing token", method: http.MethodGet, wantStatus: http.StatusUnauthorized},
+		{name: "unknown token", method: http.MethodPost, token: "other-token", wantStatus: http.StatusUnauthorized,
+			wantAudit: `"user"="" "role"="" "method"="POST"`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			audit = nil
+			request := httptest.NewRequest(test.method, "/admin/v1/endpoint-exclusions?target=default/pod", nil)
+			if test.token != "" {
+				request.Header.Set(TokenHeader, test.token)
+			}
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+			assert.Equal(t, test.wantStatus, recorder.Code)
+			if test.wantAudit == "" {
+				assert.Empty(t, audit, "read-only calls are not audited")
+				return
+			}
+			require.Len(t, audit, 1)
+			assert.Contains(t, audit[0], test.wantAudit)
+			assert.Contains(t, audit[0], `"query"="target=default/pod"`)
+		})
+	}
+}
+
+func TestAuthorizerWithoutTokens(t *testing.T) {
+	var audit []string
+	authorizer := NewAuthorizer(nil)
+	authorizer.logger = funcr.New(func(_, args string) { audit = append(audit, args) }, funcr.Options{})
+	handler := authorizer.Wrap(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/admin/v1/endpoint-exclusions", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	require.Len(t, audit, 1, "mutating calls are audited without tokens")
+	assert.Contains(t, audit[0], `"status"=200`)
+}
@@ -91,6 +91,7 @@ func refreshPrometheusMetrics(logger logr.Logger, datastore datalayer.PoolInfo,
 	logger.V(logutil.TRACE).Info("Refreshing Prometheus Metrics", "ReadyPods", len(podMetrics))
 	podTotalCount := len(podMetrics)
 	metrics.RecordInferencePoolReadyPods(pool.Name, float64(podTotalCount))
+	metrics.RecordInferencePoolSlices(pool.Name, podMetrics)
 
 	if podTotalCount == 0 {
 		return
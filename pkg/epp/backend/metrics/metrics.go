@@ -17,12 +17,14 @@ limitations under the License.
 package metrics
 
 import (
+	"cmp"
 	"context"
 	"crypto/tls"
 	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	dto "github.com/prometheus/client_model/go"
@@ -31,9 +33,14 @@ import (
 	"go.uber.org/multierr"
 
 	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/timing"
 )
 
 const (
+	// timeAnomalySource is the source the time anomalies of the scraped metrics are recorded under.
+	timeAnomalySource = "metrics-scrape"
+
 	// LoRA metrics based on protocol
 	LoraInfoRunningAdaptersMetricName = "running_lora_adapters"
 	LoraInfoWaitingAdaptersMetricName = "waiting_lora_adapters"
@@ -61,6 +68,8 @@ type Config struct {
 	KVCacheUsagePercentageMetric string
 	LoRAInfoMetric               string
 	CacheInfoMetric              string
+	CacheBlockSizeLabel          string
+	CacheNumBlocksLabel          string
 }
 
 func NewPodMetricsClientImpl(logger logr.Logger, config Config) (PodMetricsClient, error) {
@@ -74,6 +83,8 @@ func NewPodMetricsClientImpl(logger logr.Logger, config Config) (PodMetricsClien
 	if err != nil {
 		return nil, err
 	}
+	mapping.CacheBlockSizeLabel = config.CacheBlockSizeLabel
+	mapping.CacheNumBlocksLabel = config.CacheNumBlocksLabel
 	verifyMetricMapping(logger, *mapping)
 
 	var metricsHttpClient *http.Client
@@ -219,14 +230,16 @@ func (p *PodMetricsClientImpl) promToPodMetrics(
 		if err != nil {
 			errs = multierr.Append(errs, err)
 		} else {
+			blockSizeLabel := cmp.Or(p.MetricMapping.CacheBlockSizeLabel, CacheConfigBlockSizeInfoMetricName)
+			numBlocksLabel := cmp.Or(p.MetricMapping.CacheNumBlocksLabel, CacheConfigNumGPUBlocksMetricName)
 			for _, v := range cacheMetrics.GetLabel() {
 				switch v.GetName() {
-				case CacheConfigBlockSizeInfoMetricName:
+				case blockSizeLabel:
 					updated.CacheBlockSize, err = strconv.Atoi(v.GetValue())
 					if err != nil {
 						errs = multierr.Append(errs, err)
 					}
-				case CacheConfigNumGPUBlocksMetricName:
+				case numBlocksLabel:
 					updated.CacheNumBlocks, err = strconv.Atoi(v.GetValue())
 					if err != nil {
 						errs = multierr.Append(errs, err)
@@ -253,10 +266,11 @@ func (p *PodMetricsClientImpl) getLatestLoraMetric(metricFamilies map[string]*dt
 		return nil, fmt.Errorf("metric family %q not found", p.MetricMapping.LoraRequestInfo.MetricName)
 	}
 
-	var latest *dto.Metric
+	var latest, skewed *dto.Metric
 	var latestTs float64 // Use float64, as Gauge.Value is float64
 
 	// Iterate over all metrics in the family.
+	now := time.Now()
 	for _, m := range loraRequests.GetMetric() {
 		running := ""
 		waiting := ""
@@ -274,6 +288,13 @@ func (p *PodMetricsClientImpl) getLatestLoraMetric(metricFamilies map[string]*dt
 			continue
 		}
 
+		// Metrics timestamped in the future are only selected if no other metric is.
+		if isFuture(time.UnixMilli(int64(m.GetGauge().GetValue()*1000)), now) {
+			if skewed == nil {
+				skewed = m
+			}
+			continue
+		}
 		// Select the metric with the *largest Gauge Value* (which represents the timestamp).
 		if m.GetGauge().GetValue() > latestTs {
 			latestTs = m.GetGauge().GetValue()
@@ -281,7 +302,7 @@ func (p *PodMetricsClientImpl) getLatestLoraMetric(metricFamilies map[string]*dt
 		}
 	}
 	if latest == nil {
-		return nil, nil
+		return skewed, nil
 	}
 
 	return latest, nil // Convert nanoseconds to time.Time
@@ -306,10 +327,15 @@ func getLatestMetric(mf *dto.MetricFamily, spec *MetricSpec) (*dto.Metric, error
 	var latestMetric *dto.Metric
 	var latestTimestamp int64 = -1 // Initialize to -1 so any timestamp is greater
 
+	now := time.Now()
 	for _, m := range mf.GetMetric() {
 		if spec.Labels == nil || labelsMatch(m.GetLabel(), spec.Labels) {
-			if m.GetTimestampMs() > latestTimestamp {
-				latestTimestamp = m.GetTimestampMs()
+			ts := m.GetTimestampMs()
+			if ts > 0 && isFuture(time.UnixMilli(ts), now) {
+				ts = 0
+			}
+			if ts > latestTimestamp {
+				latestTimestamp = ts
 				latestMetric = m
 			}
 		}
@@ -322,6 +348,17 @@ func getLatestMetric(mf *dto.MetricFamily, spec *MetricSpec) (*dto.Metric, error
 	return nil, fmt.Errorf("no matching metric found for %q with labels %+v", spec.MetricName, spec.Labels)
 }
 
+// isFuture returns whether the timestamp of a metric is ahead of now by more than the tolerated clock skew, and
+// records the anomaly if so. Such a metric, e.g. of a model server with a skewed clock, would otherwise shadow the
+// more recent metrics until the clocks catch up: its timestamp is not trusted.
+func isFuture(ts, now time.Time) bool {
+	if anomaly := timing.CheckTimestamp(ts, now); anomaly != "" {
+		metrics.RecordTimeAnomaly(timeAnomalySource, string(anomaly))
+		return true
+	}
+	return false
+}
+
 // labelsMatch checks if a metric's labels contain all the labels in the spec.
 func labelsMatch(metricLabels []*dto.LabelPair, specLabels map[string]string) bool {
 	if len(specLabels) == 0 {
@@ -34,6 +34,10 @@ type MetricMapping struct {
 	KVCacheUtilization   *MetricSpec
 	LoraRequestInfo      *MetricSpec
 	CacheConfigInfo      *MetricSpec
+	// CacheBlockSizeLabel and CacheNumBlocksLabel are the labels of CacheConfigInfo carrying the block size and the
+	// number of blocks of the KV cache. They default to "block_size" and "num_gpu_blocks".
+	CacheBlockSizeLabel string
+	CacheNumBlocksLabel string
 }
 
 // stringToMetricSpec converts a string to a MetricSpec.
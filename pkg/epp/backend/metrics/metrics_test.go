@@ -24,7 +24,9 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/go-logr/logr"
 	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/multierr"
@@ -74,6 +76,10 @@ func TestGetMetric(t *testing.T) {
 			makeMetric(map[string]string{}, 5.0, 3000),
 			makeMetric(map[string]string{}, 6.0, 1000),
 		),
+		"metric5": makeMetricFamily("metric5",
+			makeMetric(map[string]string{}, 7.0, time.Now().Add(time.Hour).UnixMilli()),
+			makeMetric(map[string]string{}, 8.0, 1000),
+		),
 	}
 
 	tests := []struct {
@@ -162,6 +168,14 @@ func TestGetMetric(t *testing.T) {
 			wantGaugeValue: 5.0,
 			wantError:      false,
 		},
+		{
+			name: "get metric timestamped in the future",
+			spec: MetricSpec{
+				MetricName: "metric5",
+			},
+			wantGaugeValue: 8.0, // the timestamp of a metric ahead of the EPP clock is not trusted
+			wantError:      false,
+		},
 	}
 
 	p := &PodMetricsClientImpl{} // No need for MetricMapping here
@@ -431,6 +445,25 @@ func TestCacheConfigInfoMetrics(t *testing.T) {
 			},
 			expectedErr: errors.New("strconv.Atoi: parsing \"invalid\": invalid syntax"),
 		},
+		{
+			name: "cache config metrics with custom labels",
+			metricFamilies: map[string]*dto.MetricFamily{
+				"sglang:cache_config_info": makeMetricFamily("sglang:cache_config_info",
+					makeMetric(map[string]string{"page_size": "64", "num_pages": "512"}, 1.0, 1000),
+				),
+			},
+			mapping: &MetricMapping{
+				CacheConfigInfo:     &MetricSpec{MetricName: "sglang:cache_config_info"},
+				CacheBlockSizeLabel: "page_size",
+				CacheNumBlocksLabel: "num_pages",
+			},
+			existingMetrics: &MetricsState{},
+			expectedMetrics: &MetricsState{
+				CacheBlockSize: 64,
+				CacheNumBlocks: 512,
+			},
+			expectedErr: nil,
+		},
 		{
 			name: "no cache config if not in MetricMapping",
 			metricFamilies: map[string]*dto.MetricFamily{
@@ -604,3 +637,29 @@ func TestFetchMetrics(t *testing.T) {
 		t.Errorf("FetchMetrics() error = %v, want error containing %q", err, expectedSubstr)
 	}
 }
+
+func TestModelServerConfig(t *testing.T) {
+	for _, modelServerType := range ModelServerTypes() {
+		config, err := ModelServerConfig(modelServerType)
+		assert.NoError(t, err)
+		_, err = NewPodMetricsClientImpl(logr.Discard(), config)
+		assert.NoError(t, err, "the metrics of %q must be valid", modelServerType)
+	}
+
+	config, err := ModelServerConfig(ModelServerTypeSGLang)
+	assert.NoError(t, err)
+	assert.Equal(t, "sglang:num_running_reqs", config.TotalRunningRequestsMetric)
+	assert.Empty(t, config.LoRAInfoMetric)
+
+	config, err = ModelServerConfig(ModelServerTypeTGI)
+	assert.NoError(t, err)
+	assert.Equal(t, "tgi_queue_size", config.TotalQueuedRequestsMetric)
+	assert.Empty(t, config.KVCacheUsagePercentageMetric)
+
+	config, err = ModelServerConfig(ModelServerTypeTritonTensorRTLLM)
+	assert.NoError(t, err)
+	assert.Equal(t, "nv_trt_llm_request_metrics{request_type=scheduled}", config.TotalRunningRequestsMetric)
+
+	_, err = ModelServerConfig("unknown")
+	assert.Error(t, err)
+}
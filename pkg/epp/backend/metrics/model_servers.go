@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
+
+const (
+	ModelServerTypeVLLM   = "vllm"
+	ModelServerTypeSGLang = "sglang"
+	ModelServerTypeTGI    = "tgi"
+
+	ModelServerTypeTritonTensorRTLLM = "triton-tensorrt-llm"
+)
+
+// modelServerConfigs are the metrics scraped from each type of model server.
+var modelServerConfigs = map[string]Config{
+	ModelServerTypeVLLM: {
+		TotalQueuedRequestsMetric:    "vllm:num_requests_waiting",
+		TotalRunningRequestsMetric:   "vllm:num_requests_running",
+		KVCacheUsagePercentageMetric: "vllm:kv_cache_usage_perc",
+		LoRAInfoMetric:               "vllm:lora_requests_info",
+		CacheInfoMetric:              "vllm:cache_config_info",
+	},
+	ModelServerTypeSGLang: {
+		TotalQueuedRequestsMetric:    "sglang:num_queue_reqs",
+		TotalRunningRequestsMetric:   "sglang:num_running_reqs",
+		KVCacheUsagePercentageMetric: "sglang:token_usage",
+		// SGLang does not report its LoRA adapters.
+		LoRAInfoMetric:      "",
+		CacheInfoMetric:     "sglang:cache_config_info",
+		CacheBlockSizeLabel: "page_size",
+		CacheNumBlocksLabel: "num_pages",
+	},
+	ModelServerTypeTGI: {
+		TotalQueuedRequestsMetric:  "tgi_queue_size",
+		TotalRunningRequestsMetric: "tgi_batch_current_size",
+		// TGI reports neither its KV cache utilization nor its LoRA adapters. The data layer metrics extractor derives
+		// the former from the tokens of the current batch.
+		KVCacheUsagePercentageMetric: "",
+		LoRAInfoMetric:               "",
+		CacheInfoMetric:              "",
+	},
+	ModelServerTypeTritonTensorRTLLM: {
+		TotalQueuedRequestsMetric:    "nv_trt_llm_request_metrics{request_type=waiting}",
+		TotalRunningRequestsMetric:   "nv_trt_llm_request_metrics{request_type=scheduled}",
+		KVCacheUsagePercentageMetric: "nv_trt_llm_kv_cache_block_metrics{kv_cache_block_type=fraction}",
+		// Triton does not report its LoRA adapters. The data layer metrics extractor also supports the older
+		// TensorRT-LLM backends, which do not report the waiting requests and the KV cache fraction.
+		LoRAInfoMetric:  "",
+		CacheInfoMetric: "",
+	},
+}
+
+// ModelServerTypes returns the types of model servers whose metrics are known, sorted.
+func ModelServerTypes() []string {
+	return slices.Sorted(maps.Keys(modelServerConfigs))
+}
+
+// ModelServerConfig returns the metrics configuration of the given type of model server. Only the metric fields of
+// the returned configuration are set.
+func ModelServerConfig(modelServerType string) (Config, error) {
+	config, ok := modelServerConfigs[modelServerType]
+	if !ok {
+		return Config{}, fmt.Errorf("unknown model server type %q, must be one of %v", modelServerType, ModelServerTypes())
+	}
+	return config, nil
+}
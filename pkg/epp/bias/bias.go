@@ -0,0 +1,244 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package bias implements time-bounded score bias rules, which softly pin traffic toward (or away from) a set of
+// endpoints.
+//
+// A bias rule multiplies the weighted scores of the endpoints it selects, e.g. by 2 to favor new hardware during an
+// evaluation, without filtering any endpoint out: the other endpoints remain eligible and win whenever their scores are
+// sufficiently higher. Rules expire automatically; an expired rule is never applied even if the background sweep has
+// not yet removed it.
+package bias
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+const (
+	// DefaultMaxDuration is the default upper bound on the duration of a single rule.
+	DefaultMaxDuration = 24 * time.Hour
+
+	// MaxMultiplier is the upper bound on the multiplier of a rule. Its inverse is the lower bound.
+	MaxMultiplier = 10.0
+
+	// sweepInterval dictates how often expired rules are removed and their metrics cleared.
+	sweepInterval = time.Second
+)
+
+// Rule describes a single bias rule.
+type Rule struct {
+	// Name identifies the rule.
+	Name string `json:"name"`
+	// Targets are the namespaced names of the endpoints or pods the rule applies to. Selecting a pod selects all of its
+	// endpoints.
+	Targets []types.NamespacedName `json:"targets,omitempty"`
+	// Selector selects the endpoints the rule applies to by the labels of their pods. An endpoint matching either the
+	// targets or the selector is biased.
+	Selector map[string]string `json:"selector,omitempty"`
+	// Multiplier is the factor applied to the weighted score of the selected endpoints.
+	Multiplier float64 `json:"multiplier"`
+	// Reason is a free-form, human readable explanation of the rule.
+	Reason string `json:"reason"`
+	// Source optionally identifies the person or system that set the rule.
+	Source string `json:"source,omitempty"`
+	// CreatedAt is the time the rule was (last) set.
+	CreatedAt time.Time `json:"createdAt"`
+	// ExpiresAt is the time after which the rule is no longer applied.
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// matches returns true if the rule applies to the given endpoint.
+func (r *Rule) matches(endpoint *fwkdl.EndpointMetadata) bool {
+	podName := types.NamespacedName{Namespace: endpoint.NamespacedName.Namespace, Name: endpoint.PodName}
+	for _, target := range r.Targets {
+		if target == endpoint.NamespacedName || target == podName {
+			return true
+		}
+	}
+	return len(r.Selector) > 0 && labels.SelectorFromSet(r.Selector).Matches(labels.Set(endpoint.Labels))
+}
+
+// Store holds the set of active bias rules. It is safe for concurrent use.
+type Store struct {
+	clock       clock.WithTicker
+	maxDuration time.Duration
+
+	mu    sync.RWMutex
+	rules map[string]Rule
+}
+
+// NewStore creates a new bias rule store. Durations requested through Set are capped by maxDuration; a non-positive
+// maxDuration selects DefaultMaxDuration.
+func NewStore(maxDuration time.Duration) *Store {
+	return newStoreWithClock(maxDuration, &clock.RealClock{})
+}
+
+func newStoreWithClock(maxDuration time.Duration, clk clock.WithTicker) *Store {
+	if maxDuration <= 0 {
+		maxDuration = DefaultMaxDuration
+	}
+	return &Store{
+		clock:       clk,
+		maxDuration: maxDuration,
+		rules:       make(map[string]Rule),
+	}
+}
+
+// Set sets the given rule for the given duration. Setting a rule with the name of an existing rule replaces it.
+func (s *Store) Set(rule Rule, duration time.Duration) (Rule, error) {
+	if rule.Name == "" {
+		return Rule{}, errors.New("name must not be empty")
+	}
+	if len(rule.Targets) == 0 && len(rule.Selector) == 0 {
+		return Rule{}, errors.New("at least one of targets or selector must be set")
+	}
+	for _, target := range rule.Targets {
+		if target.Name == "" || target.Namespace == "" {
+			return Rule{}, errors.New("target namespace and name must not be empty")
+		}
+	}
+	if _, err := labels.ValidatedSelectorFromSet(rule.Selector); err != nil {
+		return Rule{}, fmt.Errorf("invalid selector - %w", err)
+	}
+	if rule.Multiplier < 1/MaxMultiplier || rule.Multiplier > MaxMultiplier {
+		return Rule{}, fmt.Errorf("multiplier must be between %g and %g, got %g", 1/MaxMultiplier, MaxMultiplier, rule.Multiplier)
+	}
+	if duration <= 0 {
+		return Rule{}, errors.New("duration must be positive")
+	}
+	if duration > s.maxDuration {
+		return Rule{}, fmt.Errorf("duration %s exceeds the maximum allowed duration %s", duration, s.maxDuration)
+	}
+	if rule.Reason == "" {
+		return Rule{}, errors.New("reason must not be empty")
+	}
+
+	now := s.clock.Now()
+	rule.CreatedAt = now
+	rule.ExpiresAt = now.Add(duration)
+
+	s.mu.Lock()
+	s.rules[rule.Name] = rule
+	s.mu.Unlock()
+
+	metrics.RecordEndpointBiasRule(rule.Name, rule.Source, rule.Multiplier)
+	return rule, nil
+}
+
+// Remove removes the rule with the given name. It returns false if there is no such rule.
+func (s *Store) Remove(name string) bool {
+	s.mu.Lock()
+	_, found := s.rules[name]
+	delete(s.rules, name)
+	s.mu.Unlock()
+
+	if found {
+		metrics.RecordEndpointBiasRuleLifted(name, metrics.EndpointBiasRuleRemoved)
+	}
+	return found
+}
+
+// List returns all active, non-expired rules ordered by name.
+func (s *Store) List() []Rule {
+	now := s.clock.Now()
+
+	s.mu.RLock()
+	res := make([]Rule, 0, len(s.rules))
+	for _, rule := range s.rules {
+		if now.Before(rule.ExpiresAt) {
+			res = append(res, rule)
+		}
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+	return res
+}
+
+// Multiplier returns the factor to apply to the weighted score of the given endpoint: the product of the multipliers
+// of the active rules selecting it, or 1 if there is none.
+func (s *Store) Multiplier(endpoint *fwkdl.EndpointMetadata) float64 {
+	if endpoint == nil {
+		return 1
+	}
+	now := s.clock.Now()
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	multiplier := 1.0
+	for _, rule := range s.rules {
+		if now.Before(rule.ExpiresAt) && rule.matches(endpoint) {
+			multiplier *= rule.Multiplier
+		}
+	}
+	return multiplier
+}
+
+// Run periodically removes expired rules until the context is cancelled.
+func (s *Store) Run(ctx context.Context) {
+	logger := log.FromContext(ctx).WithName("endpoint-bias")
+	ticker := s.clock.NewTicker(sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.V(logutil.DEFAULT).Info("Shutting down endpoint bias sweep")
+			return
+		case <-ticker.C():
+			for _, rule := range s.sweep() {
+				logger.V(logutil.DEFAULT).Info("Endpoint bias rule expired", "name", rule.Name,
+					"multiplier", rule.Multiplier, "reason", rule.Reason, "source", rule.Source)
+			}
+		}
+	}
+}
+
+// sweep removes all expired rules and returns them.
+func (s *Store) sweep() []Rule {
+	now := s.clock.Now()
+
+	s.mu.Lock()
+	expired := []Rule{}
+	for name, rule := range s.rules {
+		if !now.Before(rule.ExpiresAt) {
+			expired = append(expired, rule)
+			delete(s.rules, name)
+		}
+	}
+	s.mu.Unlock()
+
+	for _, rule := range expired {
+		metrics.RecordEndpointBiasRuleLifted(rule.Name, metrics.EndpointBiasRuleExpired)
+	}
+	return expired
+}
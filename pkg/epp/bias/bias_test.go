@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bias
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	testclock "k8s.io/utils/clock/testing"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+func endpoint(pod string, rank string, labels map[string]string) *fwkdl.EndpointMetadata {
+	return &fwkdl.EndpointMetadata{
+		NamespacedName: types.NamespacedName{Namespace: "default", Name: pod + "-rank-" + rank},
+		PodName:        pod,
+		Labels:         labels,
+	}
+}
+
+func TestStore_SetAndExpire(t *testing.T) {
+	clk := testclock.NewFakeClock(time.Now())
+	store := newStoreWithClock(time.Hour, clk)
+	h100 := map[string]string{"accelerator": "h100"}
+
+	_, err := store.Set(Rule{Name: "h100-eval", Selector: h100, Multiplier: 2, Reason: "evaluation", Source: "alice"}, time.Minute)
+	require.NoError(t, err)
+	_, err = store.Set(Rule{Name: "pod-a", Targets: []types.NamespacedName{{Namespace: "default", Name: "pod-a"}},
+		Multiplier: 1.5, Reason: "canary"}, 2*time.Minute)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2.0, store.Multiplier(endpoint("pod-b", "0", h100)))
+	assert.Equal(t, 1.5, store.Multiplier(endpoint("pod-a", "1", nil)), "all ranks of a targeted pod should be biased")
+	assert.Equal(t, 3.0, store.Multiplier(endpoint("pod-a", "0", h100)), "the multipliers of matching rules should compound")
+	assert.Equal(t, 1.0, store.Multiplier(endpoint("pod-c", "0", map[string]string{"accelerator": "a100"})))
+	assert.Equal(t, 1.0, store.Multiplier(nil))
+	assert.Len(t, store.List(), 2)
+
+	clk.Step(time.Minute)
+	assert.Equal(t, 1.0, store.Multiplier(endpoint("pod-b", "0", h100)), "rule should not be applied after expiry")
+	assert.Len(t, store.List(), 1, "expired rules should not be listed")
+
+	expired := store.sweep()
+	require.Len(t, expired, 1)
+	assert.Equal(t, "h100-eval", expired[0].Name)
+
+	assert.True(t, store.Remove("pod-a"))
+	assert.False(t, store.Remove("pod-a"))
+	assert.Equal(t, 1.0, store.Multiplier(endpoint("pod-a", "0", nil)))
+	assert.Empty(t, store.List())
+}
+
+func TestStore_SetValidation(t *testing.T) {
+	store := NewStore(10 * time.Minute)
+	valid := Rule{Name: "r", Selector: map[string]string{"a": "b"}, Multiplier: 2, Reason: "r"}
+
+	tests := []struct {
+		name     string
+		mutate   func(rule *Rule)
+		duration time.Duration
+	}{
+		{name: "empty name", mutate: func(rule *Rule) { rule.Name = "" }, duration: time.Minute},
+		{name: "no targets nor selector", mutate: func(rule *Rule) { rule.Selector = nil }, duration: time.Minute},
+		{name: "invalid target", mutate: func(rule *Rule) { rule.Targets = []types.NamespacedName{{Name: "pod-a"}} }, duration: time.Minute},
+		{name: "invalid selector", mutate: func(rule *Rule) { rule.Selector = map[string]string{"a b": "c"} }, duration: time.Minute},
+		{name: "multiplier too high", mutate: func(rule *Rule) { rule.Multiplier = 11 }, duration: time.Minute},
+		{name: "multiplier too low", mutate: func(rule *Rule) { rule.Multiplier = 0 }, duration: time.Minute},
+		{name: "empty reason", mutate: func(rule *Rule) { rule.Reason = "" }, duration: time.Minute},
+		{name: "non-positive duration", mutate: func(*Rule) {}, duration: 0},
+		{name: "duration above max", mutate: func(*Rule) {}, duration: time.Hour},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rule := valid
+			test.mutate(&rule)
+			_, err := store.Set(rule, test.duration)
+			assert.Error(t, err)
+		})
+	}
+	_, err := store.Set(valid, time.Minute)
+	assert.NoError(t, err)
+}
+
+func TestHandler(t *testing.T) {
+	store := NewStore(time.Hour)
+	handler := NewHandler(store)
+
+	serve := func(method, target, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(method, target, strings.NewReader(body)))
+		return rec
+	}
+
+	rec := serve(http.MethodPost, HandlerPath,
+		`{"name":"eval","targets":["default/pod-a"],"multiplier":2,"duration":"5m","reason":"new hardware","source":"alice"}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, 2.0, store.Multiplier(endpoint("pod-a", "0", nil)))
+
+	rec = serve(http.MethodPost, HandlerPath, `{"name":"eval","targets":["pod-a"],"multiplier":2,"duration":"5m","reason":"r"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = serve(http.MethodPost, HandlerPath, `{"name":"eval","targets":["default/pod-a"],"multiplier":2,"duration":"forever","reason":"r"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = serve(http.MethodPost, HandlerPath, `{"name":"eval","targets":["default/pod-a"],"multiplier":20,"duration":"5m","reason":"r"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = serve(http.MethodPost, HandlerPath, `{"name":"eval","selector":{"a":"b"},"multiplier":2,"duration":"5m","reason":"r","unknown":1}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = serve(http.MethodGet, HandlerPath, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "new hardware")
+
+	rec = serve(http.MethodDelete, HandlerPath+"?name=eval", "")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, 1.0, store.Multiplier(endpoint("pod-a", "0", nil)))
+	rec = serve(http.MethodDelete, HandlerPath+"?name=eval", "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = serve(http.MethodPut, HandlerPath, "")
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bias
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+)
+
+const (
+	// HandlerPath is the path on which the bias admin API is served.
+	HandlerPath = "/admin/v1/endpoint-bias-rules"
+
+	// maxRequestBytes bounds the size of a rule request body.
+	maxRequestBytes = 64 * 1024
+)
+
+// SetRequest is the body of a POST request to the bias admin API.
+type SetRequest struct {
+	// Name identifies the rule. Setting a rule with the name of an existing rule replaces it.
+	Name string `json:"name"`
+	// Targets are the endpoints or pods to bias, in the form "<namespace>/<name>".
+	Targets []string `json:"targets,omitempty"`
+	// Selector selects the endpoints to bias by the labels of their pods.
+	Selector map[string]string `json:"selector,omitempty"`
+	// Multiplier is the factor applied to the weighted score of the selected endpoints.
+	Multiplier float64 `json:"multiplier"`
+	// Duration is the rule duration, in Go duration format (e.g. "30m", "12h").
+	Duration string `json:"duration"`
+	// Reason is a free-form, human readable explanation of the rule.
+	Reason string `json:"reason"`
+	// Source optionally identifies the person or system setting the rule.
+	Source string `json:"source,omitempty"`
+}
+
+// NewHandler returns an http.Handler serving the bias admin API:
+//
+//	GET    - lists active rules.
+//	POST   - creates or replaces a rule, see SetRequest.
+//	DELETE - removes the rule given by the "name" query parameter.
+func NewHandler(store *Store) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, store.List())
+		case http.MethodPost:
+			handleSet(store, w, r)
+		case http.MethodDelete:
+			name := r.URL.Query().Get("name")
+			if !store.Remove(name) {
+				http.Error(w, fmt.Sprintf("no bias rule found for %q", name), http.StatusNotFound)
+				return
+			}
+			log.FromContext(r.Context()).V(logutil.DEFAULT).Info("Endpoint bias rule removed", "name", name)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.Header().Set("Allow", strings.Join([]string{http.MethodGet, http.MethodPost, http.MethodDelete}, ", "))
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+func handleSet(store *Store, w http.ResponseWriter, r *http.Request) {
+	req := SetRequest{}
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBytes))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("failed to decode request body - %v", err), http.StatusBadRequest)
+		return
+	}
+	targets := make([]types.NamespacedName, 0, len(req.Targets))
+	for _, raw := range req.Targets {
+		target, err := parseTarget(raw)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		targets = append(targets, target)
+	}
+	duration, err := time.ParseDuration(req.Duration)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid duration %q - %v", req.Duration, err), http.StatusBadRequest)
+		return
+	}
+	rule, err := store.Set(Rule{
+		Name:       req.Name,
+		Targets:    targets,
+		Selector:   req.Selector,
+		Multiplier: req.Multiplier,
+		Reason:     req.Reason,
+		Source:     req.Source,
+	}, duration)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	log.FromContext(r.Context()).V(logutil.DEFAULT).Info("Endpoint bias rule set", "name", rule.Name,
+		"targets", rule.Targets, "selector", rule.Selector, "multiplier", rule.Multiplier, "expiresAt", rule.ExpiresAt,
+		"reason", rule.Reason, "source", rule.Source)
+	writeJSON(w, http.StatusOK, rule)
+}
+
+// parseTarget parses a "<namespace>/<name>" string into a NamespacedName.
+func parseTarget(target string) (types.NamespacedName, error) {
+	namespace, name, found := strings.Cut(target, "/")
+	if !found || namespace == "" || name == "" || strings.Contains(name, "/") {
+		return types.NamespacedName{}, fmt.Errorf("invalid target %q, expected <namespace>/<name>", target)
+	}
+	return types.NamespacedName{Namespace: namespace, Name: name}, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package config
 
 import (
+	configapi "sigs.k8s.io/gateway-api-inference-extension/apix/config/v1alpha1"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol"
 	fwkflowcontrol "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/flowcontrol"
@@ -31,4 +32,10 @@ type Config struct {
 	DataConfig         *datalayer.Config
 	FlowControlConfig  *flowcontrol.Config
 	ParserConfig       *handlers.Config
+	// RequestMutatorOrder is the order in which the RequestMutator plugins run, by plugin name.
+	RequestMutatorOrder []string
+	// SchedulingHints are the names of the scheduling hints the requests may declare through their headers.
+	SchedulingHints []string
+	// Persistence selects the driver persisting the soft state of the plugins. Nil keeps the state in memory only.
+	Persistence *configapi.PersistenceConfig
 }
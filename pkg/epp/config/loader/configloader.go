@@ -19,7 +19,6 @@ package loader
 import (
 	"errors"
 	"fmt"
-	"sync"
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -30,10 +29,12 @@ import (
 	configapi "sigs.k8s.io/gateway-api-inference-extension/apix/config/v1alpha1"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/config"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/featuregate"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol"
 	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
 	fwkflowcontrol "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/flowcontrol"
 	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	fwkrc "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
 	fwkrh "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
 	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/profile"
@@ -41,21 +42,16 @@ import (
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling"
 )
 
-var (
-	scheme                   = runtime.NewScheme()
-	registeredFeatureGatesMu sync.RWMutex
-	registeredFeatureGates   = sets.New[string]()
-)
+var scheme = runtime.NewScheme()
 
 func init() {
 	utilruntime.Must(configapi.Install(scheme))
 }
 
-// RegisterFeatureGate registers a feature gate name for validation purposes.
+// RegisterFeatureGate registers an alpha feature gate, disabled by default. Gates of another stage or default are
+// registered with featuregate.Register.
 func RegisterFeatureGate(gate string) {
-	registeredFeatureGatesMu.Lock()
-	defer registeredFeatureGatesMu.Unlock()
-	registeredFeatureGates.Insert(gate)
+	featuregate.Register(gate, featuregate.Spec{Stage: featuregate.Alpha})
 }
 
 // LoadRawConfig parses the raw configuration bytes, applies initial defaults, and extracts feature gates.
@@ -83,6 +79,7 @@ func LoadRawConfig(configBytes []byte, logger logr.Logger) (*configapi.EndpointP
 	}
 
 	featureConfig := loadFeatureConfig(rawConfig.FeatureGates)
+	featuregate.Apply(featureConfig)
 	return rawConfig, featureConfig, nil
 }
 
@@ -106,13 +103,17 @@ func InstantiateAndConfigure(
 	if err := validateConfig(rawConfig); err != nil {
 		return nil, fmt.Errorf("configuration validation failed: %w", err)
 	}
+	if err := validateCapacitySources(handle); err != nil {
+		return nil, fmt.Errorf("configuration validation failed: %w", err)
+	}
 
-	schedulerConfig, err := buildSchedulerConfig(rawConfig.SchedulingProfiles, handle)
+	schedulerConfig, err := buildSchedulerConfig(rawConfig.SchedulingProfiles, rawConfig.Plugins, handle)
 	if err != nil {
 		return nil, fmt.Errorf("scheduler config build failed: %w", err)
 	}
 
 	featureGates := loadFeatureConfig(rawConfig.FeatureGates)
+	featuregate.Apply(featureGates)
 	var dataConfig *datalayer.Config
 	if !featureGates[datalayer.EnableLegacyMetricsFeatureGate] {
 		var err error
@@ -139,6 +140,11 @@ func InstantiateAndConfigure(
 		return nil, fmt.Errorf("parse config build failed: %w", err)
 	}
 
+	requestMutatorOrder, err := buildRequestMutatorOrder(rawConfig.RequestControl, handle)
+	if err != nil {
+		return nil, fmt.Errorf("request control config build failed: %w", err)
+	}
+
 	plugin, ok := handle.GetAllPluginsWithNames()[rawConfig.SaturationDetector.PluginRef]
 	if !ok {
 		return nil, fmt.Errorf("saturation detector plugin '%s' not found", rawConfig.SaturationDetector.PluginRef)
@@ -149,11 +155,14 @@ func InstantiateAndConfigure(
 	}
 
 	return &config.Config{
-		SchedulerConfig:    schedulerConfig,
-		SaturationDetector: saturationDetector,
-		DataConfig:         dataConfig,
-		FlowControlConfig:  flowControlConfig,
-		ParserConfig:       parserConfig,
+		SchedulerConfig:     schedulerConfig,
+		SaturationDetector:  saturationDetector,
+		DataConfig:          dataConfig,
+		FlowControlConfig:   flowControlConfig,
+		ParserConfig:        parserConfig,
+		RequestMutatorOrder: requestMutatorOrder,
+		SchedulingHints:     schedulingHints(rawConfig.RequestControl),
+		Persistence:         rawConfig.Persistence,
 	}, nil
 }
 
@@ -181,6 +190,9 @@ func instantiatePlugins(configuredPlugins []configapi.PluginSpec, handle fwkplug
 		if !ok {
 			return fmt.Errorf("plugin type '%s' is not registered", spec.Type)
 		}
+		if err := validateParameters(spec); err != nil {
+			return fmt.Errorf("invalid parameters of plugin '%s' (type: %s): %w", spec.Name, spec.Type, err)
+		}
 		plugin, err := factory(spec.Name, spec.Parameters, handle)
 		if err != nil {
 			return fmt.Errorf("failed to create plugin '%s' (type: %s): %w", spec.Name, spec.Type, err)
@@ -194,10 +206,12 @@ func instantiatePlugins(configuredPlugins []configapi.PluginSpec, handle fwkplug
 
 func buildSchedulerConfig(
 	configProfiles []configapi.SchedulingProfile,
+	configPlugins []configapi.PluginSpec,
 	handle fwkplugin.Handle,
 ) (*scheduling.SchedulerConfig, error) {
 
 	profiles := make(map[string]framework.SchedulerProfile)
+	shadowProfiles := make(map[string]scheduling.ShadowProfile)
 
 	for _, cfgProfile := range configProfiles {
 		fwProfile := scheduling.NewSchedulerProfile()
@@ -216,13 +230,32 @@ func buildSchedulerConfig(
 				if pluginRef.Weight != nil {
 					weight = *pluginRef.Weight
 				}
-				plugin = scheduling.NewWeightedScorer(scorer, weight)
+				weightedScorer := scheduling.NewWeightedScorer(scorer, weight).WithOptional(pluginRef.Optional)
+				if pluginRef.Timeout != nil {
+					weightedScorer = weightedScorer.WithTimeout(pluginRef.Timeout.Duration)
+				}
+				plugin = weightedScorer
 			}
 
 			if err := fwProfile.AddPlugins(plugin); err != nil {
 				return nil, fmt.Errorf("failed to add plugin '%s' to profile '%s': %w", pluginRef.PluginRef, cfgProfile.Name, err)
 			}
 		}
+		if cfgProfile.Budget != nil {
+			budget, err := buildProfileBudget(cfgProfile.Budget, handle)
+			if err != nil {
+				return nil, fmt.Errorf("invalid budget of profile '%s': %w", cfgProfile.Name, err)
+			}
+			fwProfile.WithBudget(budget)
+		}
+		if cfgProfile.Shadow != nil {
+			sampleRate := 1.0
+			if cfgProfile.Shadow.SampleRate != nil {
+				sampleRate = *cfgProfile.Shadow.SampleRate
+			}
+			shadowProfiles[cfgProfile.Name] = scheduling.ShadowProfile{Profile: fwProfile, SampleRate: sampleRate}
+			continue
+		}
 		profiles[cfgProfile.Name] = fwProfile
 	}
 
@@ -245,20 +278,65 @@ func buildSchedulerConfig(
 		return nil, errors.New("SingleProfileHandler cannot support multiple scheduling profiles")
 	}
 
-	return scheduling.NewSchedulerConfig(profileHandler, profiles), nil
+	// Processors run in the order of their declaration.
+	var processors []framework.ProfileResultsProcessor
+	for _, spec := range configPlugins {
+		if processor, ok := handle.Plugin(spec.Name).(framework.ProfileResultsProcessor); ok {
+			processors = append(processors, processor)
+		}
+	}
+
+	for _, plugin := range append([]fwkplugin.Plugin{profileHandler}, pluginsOf(processors)...) {
+		referencer, ok := plugin.(profileReferencer)
+		if !ok {
+			continue
+		}
+		for _, name := range referencer.ReferencedProfiles() {
+			if _, found := profiles[name]; !found {
+				return nil, fmt.Errorf("plugin '%s' references undefined scheduling profile '%s'", plugin.TypedName().Name, name)
+			}
+		}
+	}
+
+	return scheduling.NewSchedulerConfig(profileHandler, profiles).
+		WithShadowProfiles(shadowProfiles).
+		WithProfileResultsProcessors(processors...), nil
 }
 
-func loadFeatureConfig(gates configapi.FeatureGates) map[string]bool {
-	registeredFeatureGatesMu.RLock()
-	defer registeredFeatureGatesMu.RUnlock()
-	config := make(map[string]bool, len(registeredFeatureGates))
-	for gate := range registeredFeatureGates {
-		config[gate] = false
+func buildProfileBudget(cfgBudget *configapi.SchedulingBudget, handle fwkplugin.Handle) (scheduling.ProfileBudget, error) {
+	budget := scheduling.ProfileBudget{}
+	if cfgBudget.Timeout != nil {
+		budget.Timeout = cfgBudget.Timeout.Duration
+	}
+	if cfgBudget.PluginTimeout != nil {
+		budget.PluginTimeout = cfgBudget.PluginTimeout.Duration
 	}
-	for _, gate := range gates {
-		config[gate] = true
+	if cfgBudget.FallbackPickerRef != "" {
+		picker, ok := handle.Plugin(cfgBudget.FallbackPickerRef).(framework.Picker)
+		if !ok {
+			return budget, fmt.Errorf("fallback picker '%s' is not a picker", cfgBudget.FallbackPickerRef)
+		}
+		budget.FallbackPicker = picker
 	}
-	return config
+	return budget, nil
+}
+
+// profileReferencer is implemented by the profile handlers and processors referencing scheduling profiles by name.
+type profileReferencer interface {
+	ReferencedProfiles() []string
+}
+
+func pluginsOf(processors []framework.ProfileResultsProcessor) []fwkplugin.Plugin {
+	plugins := make([]fwkplugin.Plugin, 0, len(processors))
+	for _, processor := range processors {
+		plugins = append(plugins, processor)
+	}
+	return plugins
+}
+
+// loadFeatureConfig returns the state of the registered feature gates, see featuregate.Resolve.
+func loadFeatureConfig(gates configapi.FeatureGates) map[string]bool {
+	return featuregate.Resolve(gates)
 }
 
 func buildParserConfig(rawParserConfig *configapi.ParserConfig, handle fwkplugin.Handle) (*handlers.Config, error) {
@@ -278,6 +356,30 @@ func buildParserConfig(rawParserConfig *configapi.ParserConfig, handle fwkplugin
 	}, nil
 }
 
+func buildRequestMutatorOrder(rawRequestControlConfig *configapi.RequestControlConfig, handle fwkplugin.Handle) ([]string, error) {
+	if rawRequestControlConfig == nil {
+		return nil, nil
+	}
+	seen := sets.New[string]()
+	for _, ref := range rawRequestControlConfig.RequestMutatorRefs {
+		if seen.Has(ref) {
+			return nil, fmt.Errorf("request mutator '%s' is referenced more than once", ref)
+		}
+		seen.Insert(ref)
+		if _, ok := handle.Plugin(ref).(fwkrc.RequestMutator); !ok {
+			return nil, fmt.Errorf("request mutator '%s' is not a RequestMutator plugin", ref)
+		}
+	}
+	return rawRequestControlConfig.RequestMutatorRefs, nil
+}
+
+func schedulingHints(rawRequestControlConfig *configapi.RequestControlConfig) []string {
+	if rawRequestControlConfig == nil {
+		return nil
+	}
+	return rawRequestControlConfig.SchedulingHints
+}
+
 func buildDataLayerConfig(rawDataConfig *configapi.DataLayerConfig, handle fwkplugin.Handle) (*datalayer.Config, error) {
 	cfg := datalayer.Config{
 		Sources: []datalayer.DataSourceConfig{},
@@ -45,9 +45,12 @@ import (
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/flowcontrol/fairness/globalstrict"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/flowcontrol/ordering/fcfs"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/flowcontrol/usagelimits"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requestcontrol/admitter/contextwindow"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requestcontrol/mutator/headers"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requesthandling/parsers/openai"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/picker/maxscore"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/profile"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/scorer/capacityqueue"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/scorer/kvcacheutilization"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/scorer/prefix"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/scorer/queuedepth"
@@ -476,6 +479,77 @@ func TestInstantiateAndConfigure(t *testing.T) {
 			configText: errorMultiProfilesUseSingleProfileHandlerText,
 			wantErr:    true,
 		},
+		{
+			name:       "Success (Scheduling) - Criticality Handler with Multiple Profiles",
+			configText: successCriticalityProfileHandlerText,
+			wantErr:    false,
+		},
+		{
+			name:       "Success (Scheduling) - Fan-Out Handler with Results Processor",
+			configText: successFanOutProfileHandlerText,
+			wantErr:    false,
+		},
+		{
+			name:       "Error (Scheduling) - Results Processor References Undefined Profile",
+			configText: errorProcessorUndefinedProfileText,
+			wantErr:    true,
+		},
+		{
+			name:       "Success (Scheduling) - Profile Budget",
+			configText: successSchedulingBudgetText,
+			wantErr:    false,
+		},
+		{
+			name:       "Error (Scheduling) - Budget References Undefined Fallback Picker",
+			configText: errorSchedulingBudgetUndefinedFallbackText,
+			wantErr:    true,
+		},
+		{
+			name:       "Success (RequestControl) - Request Mutator Order",
+			configText: successRequestMutatorOrderText,
+			wantErr:    false,
+			validate: func(t *testing.T, handle fwkplugin.Handle, rawCfg *configapi.EndpointPickerConfig, cfg *config.Config) {
+				require.Equal(t, []string{"routingHints", "stripInternal"}, cfg.RequestMutatorOrder)
+			},
+		},
+		{
+			name:       "Error (RequestControl) - Request Mutator References Undefined Plugin",
+			configText: errorRequestMutatorUndefinedText,
+			wantErr:    true,
+		},
+		{
+			name:       "Error (RequestControl) - Request Mutator References Non-Mutator Plugin",
+			configText: errorRequestMutatorWrongTypeText,
+			wantErr:    true,
+		},
+		{
+			name:       "Success (RequestControl) - Scheduling Hints",
+			configText: successSchedulingHintsText,
+			wantErr:    false,
+			validate: func(t *testing.T, handle fwkplugin.Handle, rawCfg *configapi.EndpointPickerConfig, cfg *config.Config) {
+				require.Equal(t, []string{"pin-endpoint", "disable-cache-affinity"}, cfg.SchedulingHints)
+			},
+		},
+		{
+			name:       "Error (RequestControl) - Unknown Scheduling Hint",
+			configText: errorUnknownSchedulingHintText,
+			wantErr:    true,
+		},
+		{
+			name:       "Success (Scheduling) - Shadow Profile with Single Handler",
+			configText: successShadowProfileText,
+			wantErr:    false,
+		},
+		{
+			name:       "Error (Scheduling) - Invalid Shadow Sample Rate",
+			configText: errorShadowProfileSampleRateText,
+			wantErr:    true,
+		},
+		{
+			name:       "Error (Scheduling) - Criticality Handler References Undefined Profile",
+			configText: errorCriticalityProfileHandlerUndefinedProfileText,
+			wantErr:    true,
+		},
 
 		// --- Feature Validation: Data Layer ---
 		{
@@ -777,7 +851,11 @@ func registerTestPlugins(t *testing.T) {
 	// Ensure system defaults are registered too.
 	fwkplugin.Register(maxscore.MaxScorePickerType, maxscore.MaxScorePickerFactory)
 	fwkplugin.Register(profile.SingleProfileHandlerType, profile.SingleProfileHandlerFactory)
+	fwkplugin.Register(profile.CriticalityProfileHandlerType, profile.CriticalityProfileHandlerFactory)
+	fwkplugin.Register(profile.FanOutProfileHandlerType, profile.FanOutProfileHandlerFactory)
+	fwkplugin.Register(profile.AppendTargetsProcessorType, profile.AppendTargetsProcessorFactory)
 	fwkplugin.Register(openai.OpenAIParserType, openai.OpenAIParserPluginFactory)
+	fwkplugin.Register(headers.PluginType, headers.Factory)
 	fwkplugin.Register(usagelimits.StaticUsageLimitPolicyType, usagelimits.StaticPolicyFactory)
 	// Datalayer plugins are now defaults; register their real factories.
 	fwkplugin.Register(sourcemetrics.MetricsDataSourceType, sourcemetrics.MetricsDataSourceFactory)
@@ -852,6 +930,146 @@ func TestValidateSaturationDetector(t *testing.T) {
 	}
 }
 
+func TestValidateCapacitySources(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		scorer  string
+		engine  string
+		wantErr bool
+	}{
+		{name: "No capacity source", scorer: `{}`, wantErr: true},
+		{name: "Capacity label", scorer: `{"capacityLabel": "max-num-seqs"}`},
+		{name: "Default capacity", scorer: `{"defaultCapacity": 256}`},
+		{
+			name:   "Scraped max concurrency",
+			scorer: `{}`,
+			engine: `{"engineConfigs": [{"name": "vllm", "maxConcurrencySpec": "max_concurrency"}]}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			handle := utils.NewTestHandle(context.Background())
+			scorer, err := capacityqueue.CapacityQueueScorerFactory("capacity", json.RawMessage(tc.scorer), handle)
+			require.NoError(t, err)
+			handle.AddPlugin("capacity", scorer)
+			var params json.RawMessage
+			if tc.engine != "" {
+				params = json.RawMessage(tc.engine)
+			}
+			extractor, err := extractormetrics.CoreMetricsExtractorFactory(extractormetrics.MetricsExtractorType, params, handle)
+			require.NoError(t, err)
+			handle.AddPlugin(extractormetrics.MetricsExtractorType, extractor)
+
+			err = validateCapacitySources(handle)
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidatePersistence(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		persistence *configapi.PersistenceConfig
+		wantErr     bool
+	}{
+		{name: "Nil config"},
+		{name: "Memory driver", persistence: &configapi.PersistenceConfig{Driver: "memory"}},
+		{name: "Default driver", persistence: &configapi.PersistenceConfig{}},
+		{
+			name:        "Bolt driver",
+			persistence: &configapi.PersistenceConfig{Driver: "bolt", Bolt: &configapi.BoltPersistenceConfig{Path: "/var/lib/epp/state.db"}},
+		},
+		{name: "Bolt driver without path", persistence: &configapi.PersistenceConfig{Driver: "bolt"}, wantErr: true},
+		{
+			name:        "Redis driver",
+			persistence: &configapi.PersistenceConfig{Driver: "redis", Redis: &configapi.RedisPersistenceConfig{Address: "redis:6379"}},
+		},
+		{
+			name:        "Redis driver without address",
+			persistence: &configapi.PersistenceConfig{Driver: "redis", Redis: &configapi.RedisPersistenceConfig{}},
+			wantErr:     true,
+		},
+		{
+			name: "Redis driver with negative db",
+			persistence: &configapi.PersistenceConfig{Driver: "redis",
+				Redis: &configapi.RedisPersistenceConfig{Address: "redis:6379", DB: -1}},
+			wantErr: true,
+		},
+		{
+			name:        "Negative flush interval",
+			persistence: &configapi.PersistenceConfig{FlushInterval: &metav1.Duration{Duration: -time.Second}},
+			wantErr:     true,
+		},
+		{name: "Unknown driver", persistence: &configapi.PersistenceConfig{Driver: "etcd"}, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := validatePersistence(&configapi.EndpointPickerConfig{Persistence: tc.persistence})
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateParameters(t *testing.T) {
+	// Not parallel because it modifies global plugin registry.
+	fwkplugin.RegisterWithSchema(contextwindow.PluginType, contextwindow.Factory, contextwindow.ParameterSchema)
+	const malformedSchemaType = "test-malformed-schema"
+	fwkplugin.RegisterWithSchema(malformedSchemaType, contextwindow.Factory, `{"type": 1}`)
+
+	tests := []struct {
+		name       string
+		pluginType string
+		parameters string
+		wantErrs   []string
+	}{
+		{name: "No parameters", pluginType: contextwindow.PluginType},
+		{name: "Valid parameters", pluginType: contextwindow.PluginType,
+			parameters: `{"policy": "clamp", "models": {"my-model": 8192}, "charactersPerToken": 3.5}`},
+		{name: "No schema", pluginType: testPluginType, parameters: `{"unknown": true}`},
+		{name: "Unknown field", pluginType: contextwindow.PluginType, parameters: `{"polcy": "clamp"}`,
+			wantErrs: []string{"polcy"}},
+		{name: "Wrong type", pluginType: contextwindow.PluginType, parameters: `{"models": {"my-model": "8k"}}`,
+			wantErrs: []string{"parameters.models.my-model", "integer"}},
+		{name: "Out of range and invalid enum", pluginType: contextwindow.PluginType,
+			parameters: `{"policy": "truncate", "charactersPerToken": 0}`,
+			wantErrs:   []string{"parameters.charactersPerToken", "parameters.policy", "should be one of"}},
+		{name: "Malformed parameters", pluginType: contextwindow.PluginType, parameters: `{`,
+			wantErrs: []string{"malformed parameters"}},
+		{name: "Malformed schema", pluginType: malformedSchemaType, wantErrs: []string{"invalid parameter schema"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateParameters(configapi.PluginSpec{Name: "plugin", Type: tc.pluginType,
+				Parameters: json.RawMessage(tc.parameters)})
+			if len(tc.wantErrs) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			for _, want := range tc.wantErrs {
+				require.Contains(t, err.Error(), want)
+			}
+		})
+	}
+}
+
 func TestEnsureSaturationDetector(t *testing.T) {
 	t.Parallel()
 
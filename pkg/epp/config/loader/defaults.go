@@ -18,7 +18,6 @@ package loader
 
 import (
 	"fmt"
-	"slices"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -160,8 +159,15 @@ func ensureSchedulingLayer(
 		cfg.SchedulingProfiles = []configapi.SchedulingProfile{defaultProfile}
 	}
 
-	// If there is only 1 profile and no handler is explicitly configured, use the SingleProfileHandler.
-	if len(cfg.SchedulingProfiles) == 1 {
+	// If there is only 1 profile, besides shadow profiles, and no handler is explicitly configured, use the
+	// SingleProfileHandler.
+	activeProfiles := 0
+	for _, prof := range cfg.SchedulingProfiles {
+		if prof.Shadow == nil {
+			activeProfiles++
+		}
+	}
+	if activeProfiles == 1 {
 		hasHandler := false
 		for _, p := range allPlugins {
 			if _, ok := p.(framework.ProfileHandler); ok {
@@ -293,7 +299,7 @@ func ensureSaturationDetector(
 // If a data section is explicitly provided (even empty), it is left unchanged — an empty section
 // disables metrics collection without falling back to legacy.
 func ensureDataLayer(cfg *configapi.EndpointPickerConfig, handle fwkplugin.Handle, allPlugins map[string]fwkplugin.Plugin) error {
-	if slices.Contains(cfg.FeatureGates, datalayer.EnableLegacyMetricsFeatureGate) {
+	if loadFeatureConfig(cfg.FeatureGates)[datalayer.EnableLegacyMetricsFeatureGate] {
 		return nil
 	}
 
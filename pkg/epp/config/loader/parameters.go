@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loader
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"k8s.io/kube-openapi/pkg/validation/strfmt"
+	"k8s.io/kube-openapi/pkg/validation/validate"
+
+	configapi "sigs.k8s.io/gateway-api-inference-extension/apix/config/v1alpha1"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+// validateParameters validates the parameters of the given plugin against the schema registered for its type, if any.
+// All the violations are reported, ordered, in a single error.
+func validateParameters(pluginSpec configapi.PluginSpec) error {
+	schemaText, ok := fwkplugin.ParameterSchemaRegistry[pluginSpec.Type]
+	if !ok {
+		return nil
+	}
+	schema := &spec.Schema{}
+	if err := json.Unmarshal([]byte(schemaText), schema); err != nil {
+		return fmt.Errorf("invalid parameter schema of plugin type '%s': %w", pluginSpec.Type, err)
+	}
+
+	var parameters any = map[string]any{}
+	if len(pluginSpec.Parameters) > 0 {
+		if err := json.Unmarshal(pluginSpec.Parameters, &parameters); err != nil {
+			return fmt.Errorf("malformed parameters: %w", err)
+		}
+	}
+	result := validate.NewSchemaValidator(schema, nil, "parameters", strfmt.Default).Validate(parameters)
+	if result.IsValid() {
+		return nil
+	}
+	violations := make([]string, 0, len(result.Errors))
+	for _, err := range result.Errors {
+		violations = append(violations, err.Error())
+	}
+	sort.Strings(violations)
+	return errors.New(strings.Join(violations, "; "))
+}
@@ -455,6 +455,262 @@ schedulingProfiles:
   - pluginRef: maxScore
 `
 
+// successCriticalityProfileHandlerText selects between multiple profiles by request criticality.
+const successCriticalityProfileHandlerText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- name: profileHandler
+  type: criticality-profile-handler
+  parameters:
+    rules:
+    - minPriority: 0
+      profile: full
+    defaultProfile: cheap
+- name: maxScore
+  type: max-score-picker
+schedulingProfiles:
+- name: full
+  plugins:
+  - pluginRef: maxScore
+- name: cheap
+  plugins:
+  - pluginRef: maxScore
+`
+
+// errorCriticalityProfileHandlerUndefinedProfileText references a profile that is not defined.
+const errorCriticalityProfileHandlerUndefinedProfileText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- name: profileHandler
+  type: criticality-profile-handler
+  parameters:
+    rules:
+    - minPriority: 0
+      profile: full
+    defaultProfile: cheap
+- name: maxScore
+  type: max-score-picker
+schedulingProfiles:
+- name: full
+  plugins:
+  - pluginRef: maxScore
+`
+
+// successFanOutProfileHandlerText runs two profiles per request and appends the targets of the secondary profile to
+// the targets of the primary profile.
+const successFanOutProfileHandlerText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- name: profileHandler
+  type: fan-out-profile-handler
+  parameters:
+    primaryProfile: primary
+- name: appendTargets
+  type: append-targets-processor
+  parameters:
+    profiles: ["fallback"]
+- name: maxScore
+  type: max-score-picker
+schedulingProfiles:
+- name: primary
+  plugins:
+  - pluginRef: maxScore
+- name: fallback
+  plugins:
+  - pluginRef: maxScore
+`
+
+// errorProcessorUndefinedProfileText has a profile results processor referencing a profile that is not defined.
+const errorProcessorUndefinedProfileText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- name: profileHandler
+  type: fan-out-profile-handler
+  parameters:
+    primaryProfile: primary
+- name: appendTargets
+  type: append-targets-processor
+  parameters:
+    profiles: ["mirror"]
+- name: maxScore
+  type: max-score-picker
+schedulingProfiles:
+- name: primary
+  plugins:
+  - pluginRef: maxScore
+`
+
+// successSchedulingBudgetText bounds the execution time of a profile and of one of its scorers.
+const successSchedulingBudgetText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- name: maxScore
+  type: max-score-picker
+- name: fallback
+  type: max-score-picker
+- name: testScorer
+  type: test-scorer
+schedulingProfiles:
+- name: default
+  budget:
+    timeout: 20ms
+    pluginTimeout: 5ms
+    fallbackPickerRef: fallback
+  plugins:
+  - pluginRef: testScorer
+    timeout: 10ms
+  - pluginRef: maxScore
+`
+
+// errorSchedulingBudgetUndefinedFallbackText references an undefined fallback picker.
+const errorSchedulingBudgetUndefinedFallbackText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- name: maxScore
+  type: max-score-picker
+schedulingProfiles:
+- name: default
+  budget:
+    timeout: 20ms
+    fallbackPickerRef: fallback
+  plugins:
+  - pluginRef: maxScore
+`
+
+// successRequestMutatorOrderText orders two request mutators.
+const successRequestMutatorOrderText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- name: maxScore
+  type: max-score-picker
+- name: stripInternal
+  type: header-mutator
+  parameters:
+    remove: ["x-internal"]
+- name: routingHints
+  type: header-mutator
+  parameters:
+    set:
+      x-target-pod: "{{ .PodName }}"
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: maxScore
+requestControl:
+  requestMutatorRefs:
+  - routingHints
+  - stripInternal
+`
+
+// errorRequestMutatorUndefinedText references an undefined request mutator.
+const errorRequestMutatorUndefinedText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- name: maxScore
+  type: max-score-picker
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: maxScore
+requestControl:
+  requestMutatorRefs:
+  - routingHints
+`
+
+// errorRequestMutatorWrongTypeText references a plugin that is not a request mutator.
+const errorRequestMutatorWrongTypeText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- name: maxScore
+  type: max-score-picker
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: maxScore
+requestControl:
+  requestMutatorRefs:
+  - maxScore
+`
+
+// successSchedulingHintsText allows some of the scheduling hints.
+const successSchedulingHintsText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- name: maxScore
+  type: max-score-picker
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: maxScore
+requestControl:
+  schedulingHints:
+  - pin-endpoint
+  - disable-cache-affinity
+`
+
+// errorUnknownSchedulingHintText allows an unknown scheduling hint.
+const errorUnknownSchedulingHintText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- name: maxScore
+  type: max-score-picker
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: maxScore
+requestControl:
+  schedulingHints:
+  - pin-pod
+`
+
+// successShadowProfileText defines a shadow profile next to a single profile, which defaults to the
+// SingleProfileHandler.
+const successShadowProfileText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- name: maxScore
+  type: max-score-picker
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: maxScore
+- name: candidate
+  shadow:
+    sampleRate: 0.1
+  plugins:
+  - pluginRef: maxScore
+`
+
+// errorShadowProfileSampleRateText defines a shadow profile with a sample rate above 1.
+const errorShadowProfileSampleRateText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- name: maxScore
+  type: max-score-picker
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: maxScore
+- name: candidate
+  shadow:
+    sampleRate: 2
+  plugins:
+  - pluginRef: maxScore
+`
+
 // errorMultiProfilesUseSingleProfileHandlerText uses SingleProfileHandler with multiple profiles.
 const errorMultiProfilesUseSingleProfileHandlerText = `
 apiVersion: inference.networking.x-k8s.io/v1alpha1
@@ -19,9 +19,16 @@ package loader
 import (
 	"errors"
 	"fmt"
+	"slices"
 
 	"k8s.io/apimachinery/pkg/util/sets"
 	configapi "sigs.k8s.io/gateway-api-inference-extension/apix/config/v1alpha1"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/featuregate"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	extractormetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/extractor/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/scorer/capacityqueue"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/persistence"
 )
 
 // validateConfig performs a deep validation of the configuration integrity.
@@ -36,6 +43,81 @@ func validateConfig(cfg *configapi.EndpointPickerConfig) error {
 	if err := validateSaturationDetector(cfg); err != nil {
 		return fmt.Errorf("saturation detector validation failed: %w", err)
 	}
+	if err := validateRequestControl(cfg); err != nil {
+		return fmt.Errorf("request control validation failed: %w", err)
+	}
+	if err := validatePersistence(cfg); err != nil {
+		return fmt.Errorf("persistence validation failed: %w", err)
+	}
+	return nil
+}
+
+// validateCapacitySources rejects the capacity queue scorers left without a source of the capacity of the endpoints:
+// neither configured with a capacity label or default capacity, nor fed by a metrics extractor mapping the max
+// concurrency of an engine. Such a scorer would score the endpoints by their raw queue depth.
+func validateCapacitySources(handle fwkplugin.Handle) error {
+	plugins := handle.GetAllPluginsWithNames()
+	for _, plugin := range plugins {
+		if extractor, ok := plugin.(*extractormetrics.Extractor); ok && extractor.ReportsMaxConcurrency() {
+			return nil
+		}
+	}
+	for name, plugin := range plugins {
+		if scorer, ok := plugin.(*capacityqueue.CapacityQueueScorer); ok && !scorer.CapacityConfigured() {
+			return fmt.Errorf("capacity queue scorer '%s' has no capacity source: set its capacityLabel or defaultCapacity "+
+				"parameter, or the maxConcurrencySpec of an engine of the metrics extractor", name)
+		}
+	}
+	return nil
+}
+
+func validatePersistence(cfg *configapi.EndpointPickerConfig) error {
+	if cfg.Persistence == nil {
+		return nil
+	}
+	if cfg.Persistence.FlushInterval != nil && cfg.Persistence.FlushInterval.Duration < 0 {
+		return fmt.Errorf("flushInterval must not be negative, got %s", cfg.Persistence.FlushInterval.Duration)
+	}
+	switch cfg.Persistence.Driver {
+	case "", persistence.DriverMemory:
+	case persistence.DriverBolt:
+		if cfg.Persistence.Bolt == nil || cfg.Persistence.Bolt.Path == "" {
+			return errors.New("the bolt driver requires bolt.path")
+		}
+	case persistence.DriverRedis:
+		if cfg.Persistence.Redis == nil || cfg.Persistence.Redis.Address == "" {
+			return errors.New("the redis driver requires redis.address")
+		}
+		if cfg.Persistence.Redis.DB < 0 {
+			return fmt.Errorf("redis.db must not be negative, got %d", cfg.Persistence.Redis.DB)
+		}
+	default:
+		return fmt.Errorf("unknown driver '%s', expected one of '%s', '%s' or '%s'", cfg.Persistence.Driver,
+			persistence.DriverMemory, persistence.DriverBolt, persistence.DriverRedis)
+	}
+	return nil
+}
+
+func validateRequestControl(cfg *configapi.EndpointPickerConfig) error {
+	if cfg.RequestControl == nil {
+		return nil
+	}
+
+	definedPlugins := sets.New[string]()
+	for _, p := range cfg.Plugins {
+		definedPlugins.Insert(p.Name)
+	}
+
+	for _, ref := range cfg.RequestControl.RequestMutatorRefs {
+		if !definedPlugins.Has(ref) {
+			return fmt.Errorf("requestMutatorRefs references undefined plugin '%s'", ref)
+		}
+	}
+	for _, hint := range cfg.RequestControl.SchedulingHints {
+		if !slices.Contains(fwksched.SchedulingHintNames, hint) {
+			return fmt.Errorf("unknown scheduling hint '%s', expected one of %v", hint, fwksched.SchedulingHintNames)
+		}
+	}
 	return nil
 }
 
@@ -65,6 +147,7 @@ func validateSchedulingProfiles(cfg *configapi.EndpointPickerConfig) error {
 		definedPlugins.Insert(p.Name)
 	}
 	seenProfileNames := sets.New[string]()
+	shadowProfiles := 0
 
 	for i, profile := range cfg.SchedulingProfiles {
 		if profile.Name == "" {
@@ -75,10 +158,34 @@ func validateSchedulingProfiles(cfg *configapi.EndpointPickerConfig) error {
 		}
 		seenProfileNames.Insert(profile.Name)
 
+		if profile.Shadow != nil {
+			shadowProfiles++
+			if rate := profile.Shadow.SampleRate; rate != nil && (*rate < 0 || *rate > 1) {
+				return fmt.Errorf("schedulingProfiles[%s] has invalid shadow sampleRate %v, must be between 0 and 1",
+					profile.Name, *rate)
+			}
+		}
+
+		if budget := profile.Budget; budget != nil {
+			if budget.Timeout != nil && budget.Timeout.Duration < 0 {
+				return fmt.Errorf("schedulingProfiles[%s] has negative budget timeout %s", profile.Name, budget.Timeout.Duration)
+			}
+			if budget.PluginTimeout != nil && budget.PluginTimeout.Duration < 0 {
+				return fmt.Errorf("schedulingProfiles[%s] has negative budget pluginTimeout %s", profile.Name, budget.PluginTimeout.Duration)
+			}
+			if budget.FallbackPickerRef != "" && !definedPlugins.Has(budget.FallbackPickerRef) {
+				return fmt.Errorf("schedulingProfiles[%s] budget references undefined fallback picker '%s'",
+					profile.Name, budget.FallbackPickerRef)
+			}
+		}
+
 		for j, pluginRef := range profile.Plugins {
 			if pluginRef.PluginRef == "" {
 				return fmt.Errorf("schedulingProfiles[%s].plugins[%d] is missing a 'pluginRef'", profile.Name, j)
 			}
+			if pluginRef.Timeout != nil && pluginRef.Timeout.Duration < 0 {
+				return fmt.Errorf("schedulingProfiles[%s].plugins[%d] has negative timeout %s", profile.Name, j, pluginRef.Timeout.Duration)
+			}
 
 			if !definedPlugins.Has(pluginRef.PluginRef) {
 				return fmt.Errorf("schedulingProfiles[%s] references undefined plugin '%s'",
@@ -86,21 +193,12 @@ func validateSchedulingProfiles(cfg *configapi.EndpointPickerConfig) error {
 			}
 		}
 	}
+	if shadowProfiles > 0 && shadowProfiles == len(cfg.SchedulingProfiles) {
+		return errors.New("schedulingProfiles must include at least one profile that is not a shadow profile")
+	}
 	return nil
 }
 
 func validateFeatureGates(gates configapi.FeatureGates) error {
-	if gates == nil {
-		return nil
-	}
-
-	registeredFeatureGatesMu.RLock()
-	defer registeredFeatureGatesMu.RUnlock()
-	for _, gate := range gates {
-		if !registeredFeatureGates.Has(gate) {
-			return fmt.Errorf("feature gate '%s' is unknown or unregistered", gate)
-		}
-	}
-
-	return nil
+	return featuregate.Validate(gates)
 }
@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	discoveryv1 "k8s.io/api/discovery/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datastore"
+)
+
+// EndpointSliceReconciler discovers the endpoints of the pool from the EndpointSlices of a Service, in lieu of the
+// PodReconciler.
+type EndpointSliceReconciler struct {
+	client.Reader
+	Datastore datastore.Datastore
+	// ServiceName is the name of the Service whose EndpointSlices are watched, in the namespace of the pool.
+	ServiceName string
+}
+
+// Reconcile resyncs all the endpoints of the pool, since the endpoints of a pod may move between the slices of the
+// Service.
+func (c *EndpointSliceReconciler) Reconcile(ctx context.Context, _ ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	if !c.Datastore.PoolHasSynced() {
+		logger.V(logutil.TRACE).Info("Skipping reconciling EndpointSlice because the InferencePool is not available yet")
+		// When the inferencePool is initialized it lists the endpoint slices and populates the datastore, so no need to requeue.
+		return ctrl.Result{}, nil
+	}
+
+	logger.V(logutil.VERBOSE).Info("EndpointSlice being reconciled")
+	if err := c.Datastore.EndpointsResync(ctx, c.Reader); err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to resync endpoints - %w", err)
+	}
+	return ctrl.Result{}, nil
+}
+
+func (c *EndpointSliceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	filter := predicate.NewPredicateFuncs(func(object client.Object) bool {
+		return object.GetLabels()[discoveryv1.LabelServiceName] == c.ServiceName
+	})
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&discoveryv1.EndpointSlice{}).
+		WithEventFilter(filter).
+		Complete(c)
+}
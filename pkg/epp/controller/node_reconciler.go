@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datastore"
+)
+
+// NodeReconciler keeps the selected labels of the nodes, e.g. their zone, GPU model or instance type, up to date on the
+// endpoints of the pods running on them.
+type NodeReconciler struct {
+	client.Reader
+	Datastore datastore.Datastore
+}
+
+func (c *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	logger.V(logutil.TRACE).Info("Node being reconciled")
+
+	node := &corev1.Node{}
+	if err := c.Get(ctx, req.NamespacedName, node); err != nil {
+		if apierrors.IsNotFound(err) {
+			c.Datastore.NodeDelete(req.Name)
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("unable to get node - %w", err)
+	}
+
+	c.Datastore.NodeUpdateOrAdd(ctx, node)
+	return ctrl.Result{}, nil
+}
+
+func (c *NodeReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Only the label changes matter, the frequent status updates of the nodes are ignored.
+	filter := predicate.Funcs{
+		UpdateFunc: func(ue event.UpdateEvent) bool {
+			return !maps.Equal(ue.ObjectOld.GetLabels(), ue.ObjectNew.GetLabels())
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.Node{}).
+		WithEventFilter(filter).
+		Complete(c)
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -83,6 +84,12 @@ type Collector struct {
 	// for change-only logging. Only accessed from the collection goroutine — no synchronization required.
 	lastPollErrors    map[string]error
 	lastExtractErrors map[string]error
+
+	// stretch, when set, holds the factor by which the polling interval is currently stretched: only every
+	// stretch-th tick triggers a collection. ticks counts the ticks since the last collection and is only
+	// accessed from the collection goroutine.
+	stretch *atomic.Int32
+	ticks   int32
 }
 
 // NewCollector returns a new collector.
@@ -137,6 +144,9 @@ func (c *Collector) startCollection(ctx context.Context, ticker Ticker, ep fwkdl
 				case <-c.ctx.Done(): // per endpoint context cancelled
 					return
 				case <-ticker.Channel():
+					if c.skipTick() {
+						continue
+					}
 					for _, src := range sources {
 						tn := src.TypedName()
 						key := tn.String()
@@ -183,6 +193,24 @@ func (c *Collector) startCollection(ctx context.Context, ticker Ticker, ep fwkdl
 	}
 }
 
+// skipTick returns true if the current tick should not trigger a collection because polling is stretched.
+func (c *Collector) skipTick() bool {
+	if c.stretch == nil {
+		return false
+	}
+	factor := c.stretch.Load()
+	if factor <= 1 {
+		c.ticks = 0
+		return false
+	}
+	c.ticks++
+	if c.ticks < factor {
+		return true
+	}
+	c.ticks = 0
+	return false
+}
+
 // Stop terminates the collector and waits for the collection goroutine to exit.
 func (c *Collector) Stop() error {
 	if c.ctx == nil || c.cancel == nil {
@@ -121,6 +121,25 @@ func TestCollectorCollectsOnTicks(t *testing.T) {
 	require.NoError(t, c.Stop())
 }
 
+func TestCollectorSkipsTicksWhenStretched(t *testing.T) {
+	source := &datasourcemocks.MetricsDataSource{}
+	c := NewCollector()
+	c.stretch = &atomic.Int32{}
+	c.stretch.Store(3)
+	ticker := mocks.NewTicker()
+	ctx := context.Background()
+
+	require.NoError(t, c.Start(ctx, ticker, endpoint, []fwkdl.PollingDataSource{source}, nil))
+	for range 6 {
+		ticker.Tick()
+	}
+	require.Eventually(t, func() bool {
+		return len(ticker.Channel()) == 0
+	}, 1*time.Second, 2*time.Millisecond, "expected all ticks to be consumed")
+	require.NoError(t, c.Stop())
+	assert.Equal(t, int64(2), atomic.LoadInt64(&source.CallCount), "expected every third tick to collect")
+}
+
 func TestCollectorStopCancelsContext(t *testing.T) {
 	source := &datasourcemocks.MetricsDataSource{}
 	c := NewCollector()
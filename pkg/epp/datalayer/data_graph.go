@@ -47,6 +47,9 @@ func ValidateAndOrderDataDependencies(plugins []plugin.Plugin) ([]string, error)
 			consumers[name] = consumer
 		}
 	}
+	if err := validateRequiredData(producers, consumers); err != nil {
+		return nil, err
+	}
 	dag, err := buildDAG(producers, consumers)
 	if err != nil {
 		return nil, err
@@ -187,6 +190,33 @@ func pluginToLayerExecutionOrder(plugin plugin.Plugin) int {
 	return DefaultLayer
 }
 
+// validateRequiredData validates that the data keys required by the dependent plugins are consumed by them and
+// produced by other plugins. The ordering of the producers and the types of the data are validated by buildDAG.
+func validateRequiredData(producers map[string]plugin.ProducerPlugin, consumers map[string]plugin.ConsumerPlugin) error {
+	for cName, consumer := range consumers {
+		dependent, ok := consumer.(plugin.DependentPlugin)
+		if !ok {
+			continue
+		}
+		for _, key := range dependent.Requires() {
+			if _, ok := dependent.Consumes()[key]; !ok {
+				return fmt.Errorf("plugin %s requires data %q that it does not consume", cName, key)
+			}
+			produced := false
+			for pName, producer := range producers {
+				if _, ok := producer.Produces()[key]; ok && pName != cName {
+					produced = true
+					break
+				}
+			}
+			if !produced {
+				return fmt.Errorf("plugin %s requires data %q that is not produced by any configured plugin", cName, key)
+			}
+		}
+	}
+	return nil
+}
+
 // buildDAG builds a dependency graph among data preparation plugins based on their
 // produced and consumed data keys.
 func buildDAG(producers map[string]plugin.ProducerPlugin, consumers map[string]plugin.ConsumerPlugin) (map[string][]string, error) {
@@ -151,6 +151,79 @@ func TestValidatePluginExecutionOrder(t *testing.T) {
 	}
 }
 
+// mockDependentPlugin is a scheduling plugin requiring some of the data it consumes.
+type mockDependentPlugin struct {
+	MockSchedulingPlugin
+	requires []string
+}
+
+func (m *mockDependentPlugin) Requires() []string {
+	return m.requires
+}
+
+// mockDependentFairnessPolicy is a flow control plugin requiring some of the data it consumes.
+type mockDependentFairnessPolicy struct {
+	MockConsumerFairnessPolicy
+	requires []string
+}
+
+func (m *mockDependentFairnessPolicy) Requires() []string {
+	return m.requires
+}
+
+func TestValidateRequiredData(t *testing.T) {
+	producer := &mockPrepareRequestDataP{name: "A", produces: map[string]any{"keyA": nil}}
+	dependent := &mockDependentPlugin{
+		MockSchedulingPlugin: MockSchedulingPlugin{consumes: map[string]any{"keyA": nil, "keyB": nil}},
+		requires:             []string{"keyA"},
+	}
+	dependentOnUnconsumed := &mockDependentPlugin{
+		MockSchedulingPlugin: MockSchedulingPlugin{consumes: map[string]any{"keyA": nil}},
+		requires:             []string{"keyC"},
+	}
+	dependentFairnessPolicy := &mockDependentFairnessPolicy{
+		MockConsumerFairnessPolicy: MockConsumerFairnessPolicy{consumes: map[string]any{"keyA": nil}},
+		requires:                   []string{"keyA"},
+	}
+
+	testCases := []struct {
+		name        string
+		plugins     []fwkplugin.Plugin
+		expectedErr string
+	}{
+		{
+			name:    "Required data produced",
+			plugins: []fwkplugin.Plugin{producer, dependent},
+		},
+		{
+			name:        "Required data not produced",
+			plugins:     []fwkplugin.Plugin{dependent},
+			expectedErr: `plugin MockSchedulingPlugin/mock requires data "keyA" that is not produced by any configured plugin`,
+		},
+		{
+			name:        "Required data produced after the consumer",
+			plugins:     []fwkplugin.Plugin{producer, dependentFairnessPolicy},
+			expectedErr: "invalid plugin layer execution order",
+		},
+		{
+			name:        "Required data not consumed",
+			plugins:     []fwkplugin.Plugin{producer, dependentOnUnconsumed},
+			expectedErr: `requires data "keyC" that it does not consume`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ValidateAndOrderDataDependencies(tc.plugins)
+			if tc.expectedErr != "" {
+				assert.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
 func TestDAGAndTopologicalOrder(t *testing.T) {
 	pluginA := &mockPrepareRequestDataP{name: "A", produces: map[string]any{"keyA": nil}}
 	pluginB := &mockPrepareRequestDataP{name: "B", consumes: map[string]any{"keyA": nil}, produces: map[string]any{"keyB": nil}}
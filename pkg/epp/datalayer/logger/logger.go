@@ -112,6 +112,7 @@ func refreshPrometheusMetrics(logger logr.Logger, datastore datalayer.PoolInfo,
 	logger.V(logutil.TRACE).Info("Refreshing Prometheus Metrics", "ReadyPods", len(podMetrics))
 	podCount := len(podMetrics)
 	metrics.RecordInferencePoolReadyPods(pool.Name, float64(podCount))
+	metrics.RecordInferencePoolSlices(pool.Name, podMetrics)
 
 	if podCount == 0 {
 		return
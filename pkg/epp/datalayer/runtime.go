@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"reflect"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -34,6 +35,10 @@ import (
 // Runtime manages data sources, extractors, their mapping, and endpoint lifecycle.
 type Runtime struct {
 	pollingInterval time.Duration // used for polling sources
+	pollingStretch  atomic.Int32  // factor by which pollingInterval is currently stretched, see SetPollingStretch
+	stretchMu       sync.Mutex    // serializes the updates of pollingStretch
+	pressureStretch int32         // stretch factor set by SetPollingStretch, guarded by stretchMu
+	adaptiveStretch int32         // stretch factor set by SetAdaptiveStretch, guarded by stretchMu
 
 	pollers          sync.Map // Map of polling sources (key=source name, value=PollingDataSource)
 	notifiers        sync.Map // Map of k8s notification sources (key=source name, value=NotificationSource)
@@ -192,6 +197,7 @@ func (r *Runtime) NewEndpoint(ctx context.Context, endpointMetadata *fwkdl.Endpo
 
 	endpoint := fwkdl.NewEndpoint(endpointMetadata, nil)
 	collector := NewCollector()
+	collector.stretch = &r.pollingStretch
 
 	key := endpointMetadata.GetNamespacedName()
 	if _, loaded := r.collectors.LoadOrStore(key, collector); loaded {
@@ -210,6 +216,29 @@ func (r *Runtime) NewEndpoint(ctx context.Context, endpointMetadata *fwkdl.Endpo
 	return endpoint
 }
 
+// SetPollingStretch stretches the polling interval of all endpoints, current and future, by the given factor.
+// Polling then only happens every factor-th interval. A factor <= 1 restores the configured polling interval.
+func (r *Runtime) SetPollingStretch(factor int) {
+	r.stretchMu.Lock()
+	defer r.stretchMu.Unlock()
+	r.pressureStretch = int32(max(factor, 1))
+	r.updatePollingStretch()
+}
+
+// SetAdaptiveStretch stretches the polling interval of all endpoints by the given factor, adapted to the activity of
+// the pool. It combines with the stretch set by SetPollingStretch: polling happens every product-th interval.
+func (r *Runtime) SetAdaptiveStretch(factor int) {
+	r.stretchMu.Lock()
+	defer r.stretchMu.Unlock()
+	r.adaptiveStretch = int32(max(factor, 1))
+	r.updatePollingStretch()
+}
+
+// updatePollingStretch must be called with stretchMu held.
+func (r *Runtime) updatePollingStretch() {
+	r.pollingStretch.Store(max(r.pressureStretch, 1) * max(r.adaptiveStretch, 1))
+}
+
 // ReleaseEndpoint terminates polling for data on the given endpoint.
 func (r *Runtime) ReleaseEndpoint(ep fwkdl.Endpoint) {
 	r.dispatchEndpointEvent(context.Background(), r.logger, fwkdl.EndpointEvent{Type: fwkdl.EventDelete, Endpoint: ep})
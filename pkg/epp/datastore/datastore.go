@@ -17,6 +17,7 @@ limitations under the License.
 package datastore
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
@@ -80,6 +81,18 @@ type Datastore interface {
 	PodList(predicate func(fwkdl.Endpoint) bool) []fwkdl.Endpoint
 	PodUpdateOrAddIfNotExist(ctx context.Context, pod *corev1.Pod) bool
 	PodDelete(podName string)
+	// StaticEndpointsSet replaces the static endpoints, served alongside the endpoints of the pods of the pool.
+	StaticEndpointsSet(ctx context.Context, namespace string, endpoints []StaticEndpoint)
+	// EndpointsResync resyncs the endpoints of the pool, e.g. after a change of the EndpointSlices of its Service.
+	EndpointsResync(ctx context.Context, reader client.Reader) error
+	// Subscribe subscribes the given subscriber to the changes of the endpoints, replaying the current endpoints to it
+	// first. The returned function cancels the subscription.
+	Subscribe(ctx context.Context, subscriber fwkdl.EndpointSubscriber) func()
+
+	// Node operations
+	// NodeUpdateOrAdd updates the selected labels of the given node on the endpoints of its pods.
+	NodeUpdateOrAdd(ctx context.Context, node *corev1.Node)
+	NodeDelete(nodeName string)
 
 	// Clears the store state, happens when the pool gets deleted.
 	Clear()
@@ -99,6 +112,9 @@ func NewDatastore(parentCtx context.Context, epFactory datalayer.EndpointFactory
 		objectives:             make(map[string]*v1alpha2.InferenceObjective),
 		modelRewrites:          newModelRewriteStore(),
 		pods:                   &sync.Map{},
+		groups:                 newInferenceGroups(),
+		staticEndpoints:        sets.New[types.NamespacedName](),
+		nodeLabels:             nodeLabels{nodes: map[string]map[string]string{}},
 		modelServerMetricsPort: modelServerMetricsPort,
 		epf:                    epFactory,
 	}
@@ -116,6 +132,19 @@ type datastore struct {
 	modelRewrites *modelRewriteStore
 	// key: types.NamespacedName, value: fwkdl.Endpoint
 	pods *sync.Map
+	// groups tracks the multi-node inference groups, whose leader is the only endpoint.
+	groups *inferenceGroups
+	// subscriptions are the subscriptions to the changes of the endpoints.
+	subscriptions subscriptions
+	// nodeLabels caches the labels of the nodes set on the endpoints.
+	nodeLabels nodeLabels
+	// staticMu protects staticEndpoints.
+	staticMu sync.RWMutex
+	// staticEndpoints are the names of the static endpoints, which are not discovered from the pods of the pool.
+	staticEndpoints sets.Set[types.NamespacedName]
+	// endpointSliceService is the Service whose EndpointSlices the endpoints are discovered from, empty to discover
+	// them from the pods selected by the pool.
+	endpointSliceService string
 	// modelServerMetricsPort metrics port from EPP command line argument
 	// used only if there is only one inference engine per pod
 	modelServerMetricsPort int32 // TODO: deprecating
@@ -133,12 +162,17 @@ func (ds *datastore) Clear() {
 	ds.pool = nil
 	ds.objectives = make(map[string]*v1alpha2.InferenceObjective)
 	ds.modelRewrites = newModelRewriteStore()
-	// stop all pods go routines before clearing the pods map.
-	ds.pods.Range(func(_, v any) bool {
-		ds.epf.ReleaseEndpoint(v.(fwkdl.Endpoint))
+	// stop all pods go routines before clearing the pods map. Static endpoints do not belong to the pool and are kept.
+	ds.pods.Range(func(k, v any) bool {
+		if ds.isStatic(k.(types.NamespacedName)) {
+			return true
+		}
+		ep := v.(fwkdl.Endpoint)
+		ds.endpointDelete(k, ep)
+		ds.epf.ReleaseEndpoint(ep)
 		return true
 	})
-	ds.pods.Clear()
+	ds.groups.reset()
 }
 
 // /// Pool APIs ///
@@ -279,6 +313,15 @@ func (ds *datastore) PodUpdateOrAddIfNotExist(ctx context.Context, pod *corev1.P
 	pool := ds.pool
 	ds.mu.RUnlock()
 
+	return ds.podUpdate(ctx, pod, pool)
+}
+
+// podUpdate adds or updates the endpoints of the given ready pod. The pods of multi-node inference groups are handled
+// as a group, whose leader is the only endpoint.
+func (ds *datastore) podUpdate(ctx context.Context, pod *corev1.Pod, pool *datalayer.EndpointPool) bool {
+	if pool != nil && isGroupMember(pod, pool) {
+		return ds.groupPodUpdateOrAdd(ctx, pod, pool)
+	}
 	return ds.podUpdateOrAddIfNotExist(ctx, pod, pool)
 }
 
@@ -311,10 +354,13 @@ func (ds *datastore) podUpdateOrAddIfNotExist(ctx context.Context, pod *corev1.P
 			&fwkdl.EndpointMetadata{
 				NamespacedName: createEndpointNamespacedName(pod, idx),
 				PodName:        pod.Name,
+				PodUID:         pod.UID,
 				Address:        pod.Status.PodIP,
 				Port:           strconv.Itoa(port),
 				MetricsHost:    net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(metricsPort)),
 				Labels:         labels,
+				NodeName:       pod.Spec.NodeName,
+				NodeLabels:     ds.nodeLabelsGet(pod.Spec.NodeName),
 			})
 	}
 
@@ -330,7 +376,17 @@ func (ds *datastore) podUpdateOrAddIfNotExist(ctx context.Context, pod *corev1.P
 		existingEpSet.Insert(endpointMetadata.NamespacedName)
 		var ep fwkdl.Endpoint
 		existing, ok := ds.pods.Load(endpointMetadata.NamespacedName)
+		if ok && podRecreated(existing.(fwkdl.Endpoint).GetMetadata(), endpointMetadata) {
+			// The endpoint is replaced rather than updated, so that the metrics and the state of the previous pod, e.g.
+			// its affinity and prefix cache entries, are not attributed to the new one.
+			log.FromContext(ctx).V(logutil.DEFAULT).Info("Pod recreated, resetting its endpoint",
+				"endpoint", endpointMetadata.NamespacedName, "uid", endpointMetadata.PodUID, "address", endpointMetadata.Address)
+			ds.endpointDelete(endpointMetadata.NamespacedName, existing.(fwkdl.Endpoint))
+			ds.epf.ReleaseEndpoint(existing.(fwkdl.Endpoint))
+			ok = false
+		}
 		if !ok {
+			ds.endpointDeleteReusedAddress(ctx, endpointMetadata)
 			ep = ds.epf.NewEndpoint(ds.parentCtx, endpointMetadata, ds)
 			if ep == nil {
 				// NewEndpoint returns nil when a collector is already running for this
@@ -338,13 +394,13 @@ func (ds *datastore) podUpdateOrAddIfNotExist(ctx context.Context, pod *corev1.P
 				// is still valid; skip re-registering it.
 				continue
 			}
-			ds.pods.Store(endpointMetadata.NamespacedName, ep)
+			ds.endpointStore(endpointMetadata.NamespacedName, ep)
 			result = false
 		} else {
 			ep = existing.(fwkdl.Endpoint)
 		}
 		// Update endpoint properties if anything changed.
-		ep.UpdateMetadata(endpointMetadata)
+		ds.endpointUpdate(ep, endpointMetadata)
 	}
 
 	// remove endpoints that are no longer active in the pool
@@ -355,7 +411,7 @@ func (ds *datastore) podUpdateOrAddIfNotExist(ctx context.Context, pod *corev1.P
 
 		namespacedName := createEndpointNamespacedName(pod, idx)
 		if ep, ok := ds.pods.Load(namespacedName); ok {
-			ds.pods.Delete(namespacedName)
+			ds.endpointDelete(namespacedName, ep.(fwkdl.Endpoint))
 			ds.epf.ReleaseEndpoint(ep.(fwkdl.Endpoint))
 		}
 	}
@@ -363,11 +419,51 @@ func (ds *datastore) podUpdateOrAddIfNotExist(ctx context.Context, pod *corev1.P
 	return result
 }
 
+// podRecreated returns whether the given metadata of an endpoint belongs to another pod than its current metadata,
+// i.e. whether the pod was deleted and recreated with the same name.
+func podRecreated(current, updated *fwkdl.EndpointMetadata) bool {
+	if current.PodUID != "" && updated.PodUID != "" && current.PodUID != updated.PodUID {
+		return true
+	}
+	return current.Address != updated.Address
+}
+
+// endpointDeleteReusedAddress removes the endpoints of other pods with the address and port of the given endpoint.
+// Pod IPs are reused once freed, so a new pod may get the IP of a deleted pod before the deletion of the latter is
+// observed, whose endpoints would otherwise report the metrics of the new pod.
+func (ds *datastore) endpointDeleteReusedAddress(ctx context.Context, endpointMetadata *fwkdl.EndpointMetadata) {
+	if endpointMetadata.Address == "" {
+		return
+	}
+	ds.pods.Range(func(k, v any) bool {
+		ep := v.(fwkdl.Endpoint)
+		metadata := ep.GetMetadata()
+		if metadata.Address != endpointMetadata.Address || metadata.Port != endpointMetadata.Port ||
+			metadata.PodName == endpointMetadata.PodName || ds.isStatic(k.(types.NamespacedName)) {
+			return true
+		}
+		log.FromContext(ctx).V(logutil.DEFAULT).Info("Endpoint address reused by another pod, removing the endpoint",
+			"endpoint", metadata.NamespacedName, "address", net.JoinHostPort(metadata.Address, metadata.Port),
+			"pod", endpointMetadata.PodName)
+		ds.endpointDelete(k, ep)
+		ds.epf.ReleaseEndpoint(ep)
+		return true
+	})
+}
+
 func (ds *datastore) PodDelete(podName string) {
+	if ds.groupPodDelete(podName) {
+		return
+	}
+	ds.podDeleteEndpoints(podName)
+}
+
+// podDeleteEndpoints removes the endpoints of the given pod.
+func (ds *datastore) podDeleteEndpoints(podName string) {
 	ds.pods.Range(func(k, v any) bool {
 		ep := v.(fwkdl.Endpoint)
-		if ep.GetMetadata().PodName == podName {
-			ds.pods.Delete(k)
+		if ep.GetMetadata().PodName == podName && !ds.isStatic(k.(types.NamespacedName)) {
+			ds.endpointDelete(k, ep)
 			ds.epf.ReleaseEndpoint(ep)
 		}
 		return true
@@ -376,18 +472,21 @@ func (ds *datastore) PodDelete(podName string) {
 
 func (ds *datastore) podResyncAll(ctx context.Context, reader client.Reader) error {
 	logger := log.FromContext(ctx)
-	podList := &corev1.PodList{}
-	if err := reader.List(ctx, podList, &client.ListOptions{
-		LabelSelector: labels.SelectorFromSet(ds.pool.Selector),
-		Namespace:     ds.pool.Namespace,
-	}); err != nil {
-		return fmt.Errorf("failed to list pods - %w", err)
+	pods, err := ds.listPods(ctx, reader)
+	if err != nil {
+		return err
 	}
 
 	// Track active endpoints by their full name (including rank suffix).
 	// This ensures orphaned rank endpoints are removed when targetPorts shrinks.
 	activeEndpoints := sets.New[types.NamespacedName]()
-	for _, pod := range podList.Items {
+	// The group leaders are added after their workers, so that the endpoints of healthy groups are not removed and
+	// added back while the group is rebuilt.
+	ds.groups.reset()
+	slices.SortStableFunc(pods, func(a, b corev1.Pod) int {
+		return cmp.Compare(isGroupLeader(&a), isGroupLeader(&b))
+	})
+	for _, pod := range pods {
 		if !podutil.IsPodReady(&pod) {
 			continue
 		}
@@ -396,7 +495,7 @@ func (ds *datastore) podResyncAll(ctx context.Context, reader client.Reader) err
 		for idx := range ds.pool.TargetPorts {
 			activeEndpoints.Insert(createEndpointNamespacedName(&pod, idx))
 		}
-		if !ds.podUpdateOrAddIfNotExist(ctx, &pod, ds.pool) {
+		if !ds.podUpdate(ctx, &pod, ds.pool) {
 			logger.V(logutil.DEFAULT).Info("Pod added", "name", namespacedName)
 		} else {
 			logger.V(logutil.DEFAULT).Info("Pod already exists", "name", namespacedName)
@@ -407,9 +506,9 @@ func (ds *datastore) podResyncAll(ctx context.Context, reader client.Reader) err
 	ds.pods.Range(func(k, v any) bool {
 		ep := v.(fwkdl.Endpoint)
 		endpointName := ep.GetMetadata().NamespacedName
-		if !activeEndpoints.Has(endpointName) {
+		if !activeEndpoints.Has(endpointName) && !ds.isStatic(endpointName) {
 			logger.V(logutil.VERBOSE).Info("Removing endpoint", "endpoint", endpointName)
-			ds.pods.Delete(k)
+			ds.endpointDelete(k, ep)
 			ds.epf.ReleaseEndpoint(ep)
 		}
 		return true
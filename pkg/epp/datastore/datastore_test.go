@@ -30,11 +30,13 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
 
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	v1 "sigs.k8s.io/gateway-api-inference-extension/api/v1"
@@ -42,6 +44,7 @@ import (
 	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
 	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/source/mocks"
 	pooltuil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/pool"
 	testutil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/testing"
@@ -1299,3 +1302,386 @@ func TestExtractActivePorts(t *testing.T) {
 		})
 	}
 }
+
+func makeGroupPod(name string, workerIndex string, size string) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels:    map[string]string{groupKeyLabel: "group-0", workerIndexLabel: workerIndex},
+		},
+		Status: corev1.PodStatus{
+			Phase:      corev1.PodRunning,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+			PodIP:      "10.0.0." + workerIndex,
+		},
+	}
+	if size != "" {
+		pod.Annotations = map[string]string{groupSizeAnnotation: size}
+	}
+	return pod
+}
+
+func TestInferenceGroups(t *testing.T) {
+	leader := makeGroupPod("lws-0", "0", "3")
+	worker1, worker2 := makeGroupPod("lws-0-1", "1", ""), makeGroupPod("lws-0-2", "2", "")
+
+	podNames := func(ds Datastore) []string {
+		names := []string{}
+		for _, ep := range ds.PodList(AllPodsPredicate) {
+			names = append(names, ep.GetMetadata().PodName)
+		}
+		return names
+	}
+
+	period := time.Second
+	factories := []datalayer.EndpointFactory{
+		backendmetrics.NewPodMetricsFactory(&backendmetrics.FakePodMetricsClient{}, period),
+		datalayer.NewTestRuntime(t, period),
+	}
+	for _, epf := range factories {
+		t.Run("group lifecycle", func(t *testing.T) {
+			ctx := context.Background()
+			ds := NewDatastore(t.Context(), epf, 0)
+			t.Cleanup(ds.Clear)
+			assert.NoError(t, ds.PoolSet(ctx, fake.NewFakeClient(), pooltuil.InferencePoolToEndpointPool(inferencePool)))
+
+			// The leader only receives traffic once all the workers are ready; the workers never do.
+			ds.PodUpdateOrAddIfNotExist(ctx, leader)
+			ds.PodUpdateOrAddIfNotExist(ctx, worker1)
+			assert.Empty(t, podNames(ds))
+			ds.PodUpdateOrAddIfNotExist(ctx, worker2)
+			assert.Equal(t, []string{leader.Name}, podNames(ds))
+
+			// A worker that is not ready anymore excludes the whole group, until it is ready again.
+			ds.PodDelete(worker1.Name)
+			assert.Empty(t, podNames(ds))
+			ds.PodUpdateOrAddIfNotExist(ctx, worker1)
+			assert.Equal(t, []string{leader.Name}, podNames(ds))
+
+			ds.PodDelete(leader.Name)
+			assert.Empty(t, podNames(ds))
+		})
+
+		t.Run("resync", func(t *testing.T) {
+			ctx := context.Background()
+			fakeClient := fake.NewFakeClient(leader, worker1, worker2)
+			ds := NewDatastore(t.Context(), epf, 0)
+			t.Cleanup(ds.Clear)
+			assert.NoError(t, ds.PoolSet(ctx, fakeClient, pooltuil.InferencePoolToEndpointPool(inferencePool)))
+			assert.Equal(t, []string{leader.Name}, podNames(ds))
+		})
+
+		t.Run("leader only pool", func(t *testing.T) {
+			// Pools selecting the leaders only do not see the workers, and handle the leaders as individual pods.
+			ctx := context.Background()
+			pool := pooltuil.InferencePoolToEndpointPool(inferencePool)
+			pool.Selector = map[string]string{workerIndexLabel: "0"}
+			ds := NewDatastore(t.Context(), epf, 0)
+			t.Cleanup(ds.Clear)
+			assert.NoError(t, ds.PoolSet(ctx, fake.NewFakeClient(), pool))
+			ds.PodUpdateOrAddIfNotExist(ctx, leader)
+			assert.Equal(t, []string{leader.Name}, podNames(ds))
+		})
+	}
+}
+
+func TestParseStaticEndpoints(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    []StaticEndpoint
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			data: `
+endpoints:
+- name: remote-0
+  address: 10.1.0.1
+  port: 8000
+  metricsAddress: 10.1.0.1:9090
+  labels:
+    site: remote
+- name: remote-1
+  address: 10.1.0.2
+  port: 8000`,
+			want: []StaticEndpoint{
+				{Name: "remote-0", Address: "10.1.0.1", Port: 8000, MetricsAddress: "10.1.0.1:9090",
+					Labels: map[string]string{"site": "remote"}},
+				{Name: "remote-1", Address: "10.1.0.2", Port: 8000},
+			},
+		},
+		{name: "duplicate name", data: `{"endpoints": [{"name": "a", "address": "10.1.0.1", "port": 8000}, {"name": "a", "address": "10.1.0.2", "port": 8000}]}`, wantErr: true},
+		{name: "invalid name", data: `{"endpoints": [{"name": "A_1", "address": "10.1.0.1", "port": 8000}]}`, wantErr: true},
+		{name: "hostname address", data: `{"endpoints": [{"name": "a", "address": "vllm.example.com", "port": 8000}]}`, wantErr: true},
+		{name: "invalid port", data: `{"endpoints": [{"name": "a", "address": "10.1.0.1", "port": 0}]}`, wantErr: true},
+		{name: "invalid metrics address", data: `{"endpoints": [{"name": "a", "address": "10.1.0.1", "port": 8000, "metricsAddress": "10.1.0.1"}]}`, wantErr: true},
+		{name: "unknown field", data: `{"endpoints": [{"name": "a", "address": "10.1.0.1", "port": 8000, "weight": 2}]}`, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseStaticEndpoints([]byte(test.data))
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestStaticEndpoints(t *testing.T) {
+	ctx := context.Background()
+	static := []StaticEndpoint{
+		{Name: "remote-0", Address: "10.1.0.1", Port: 8000},
+		{Name: "remote-1", Address: "10.1.0.2", Port: 8000, MetricsAddress: "10.1.0.2:9090"},
+	}
+	endpoints := func(ds Datastore) map[string]*fwkdl.EndpointMetadata {
+		res := map[string]*fwkdl.EndpointMetadata{}
+		for _, ep := range ds.PodList(AllPodsPredicate) {
+			res[ep.GetMetadata().NamespacedName.Name] = ep.GetMetadata()
+		}
+		return res
+	}
+
+	epf := datalayer.NewTestRuntime(t, time.Second)
+	ds := NewDatastore(t.Context(), epf, 0)
+	t.Cleanup(func() { ds.StaticEndpointsSet(ctx, "default", nil) })
+	t.Cleanup(ds.Clear)
+	ds.StaticEndpointsSet(ctx, "default", static)
+
+	got := endpoints(ds)
+	assert.Len(t, got, 2)
+	assert.Equal(t, "10.1.0.1:8000", got["remote-0"].GetMetricsHost(), "metrics are scraped from the serving port by default")
+	assert.Equal(t, "10.1.0.2:9090", got["remote-1"].GetMetricsHost())
+	assert.Equal(t, "default", got["remote-0"].NamespacedName.Namespace)
+
+	// Static endpoints survive the pool resyncs, the pod events and the pool deletion.
+	assert.NoError(t, ds.PoolSet(ctx, fake.NewFakeClient(), pooltuil.InferencePoolToEndpointPool(inferencePool)))
+	ds.PodUpdateOrAddIfNotExist(ctx, pod1)
+	assert.Len(t, endpoints(ds), 3)
+	ds.PodDelete("remote-0")
+	assert.Len(t, endpoints(ds), 3)
+	ds.Clear()
+	assert.Len(t, endpoints(ds), 2)
+
+	// Replacing the static endpoints removes the missing ones.
+	ds.StaticEndpointsSet(ctx, "default", static[1:])
+	assert.Len(t, endpoints(ds), 1)
+	assert.Contains(t, endpoints(ds), "remote-1")
+}
+
+func TestEndpointSliceDiscovery(t *testing.T) {
+	ctx := context.Background()
+	slice := func(name, service string, ports []int32, endpoints ...discoveryv1.Endpoint) *discoveryv1.EndpointSlice {
+		slicePorts := []discoveryv1.EndpointPort{}
+		for _, port := range ports {
+			slicePorts = append(slicePorts, discoveryv1.EndpointPort{Name: ptr.To("http"), Port: ptr.To(port)})
+		}
+		return &discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels:    map[string]string{discoveryv1.LabelServiceName: service},
+			},
+			AddressType: discoveryv1.AddressTypeIPv4,
+			Ports:       slicePorts,
+			Endpoints:   endpoints,
+		}
+	}
+	endpoint := func(pod, address string, ready *bool) discoveryv1.Endpoint {
+		return discoveryv1.Endpoint{
+			Addresses:  []string{address},
+			Conditions: discoveryv1.EndpointConditions{Ready: ready},
+			TargetRef:  &corev1.ObjectReference{Kind: "Pod", Name: pod},
+		}
+	}
+	pool := pooltuil.InferencePoolToEndpointPool(inferencePoolMultiTarget)
+	pool.Namespace = "default"
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		// The named target port of the Service resolves to 8000 for pod1 and pod2, and to 8001 for pod3.
+		slice("vllm-a", "vllm", []int32{8000},
+			endpoint("pod1", "10.0.0.1", nil),
+			endpoint("pod2", "10.0.0.2", ptr.To(true)),
+			endpoint("terminating", "10.0.0.9", ptr.To(false))),
+		slice("vllm-b", "vllm", []int32{8001}, endpoint("pod3", "10.0.0.3", nil)),
+		slice("other", "other", []int32{8000}, endpoint("other", "10.0.0.4", nil)),
+	).Build()
+
+	epf := datalayer.NewTestRuntime(t, time.Second)
+	ds := NewDatastore(t.Context(), epf, 0).WithEndpointSlices("vllm")
+	t.Cleanup(ds.Clear)
+	assert.NoError(t, ds.PoolSet(ctx, fakeClient, pool))
+
+	endpoints := func() map[string]string {
+		res := map[string]string{}
+		for _, ep := range ds.PodList(AllPodsPredicate) {
+			res[ep.GetMetadata().NamespacedName.Name] = net.JoinHostPort(ep.GetMetadata().Address, ep.GetMetadata().Port)
+		}
+		return res
+	}
+	assert.Equal(t, map[string]string{
+		"pod1-rank-0": "10.0.0.1:8000",
+		"pod2-rank-0": "10.0.0.2:8000",
+		"pod3-rank-1": "10.0.0.3:8001",
+	}, endpoints())
+
+	// Endpoints that are not ready anymore are removed on resync.
+	updated := slice("vllm-a", "vllm", []int32{8000}, endpoint("pod1", "10.0.0.1", ptr.To(false)))
+	existing := &discoveryv1.EndpointSlice{}
+	assert.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: "vllm-a", Namespace: "default"}, existing))
+	updated.ResourceVersion = existing.ResourceVersion
+	assert.NoError(t, fakeClient.Update(ctx, updated))
+	assert.NoError(t, ds.EndpointsResync(ctx, fakeClient))
+	assert.Equal(t, map[string]string{"pod3-rank-1": "10.0.0.3:8001"}, endpoints())
+}
+
+// recordingSubscriber records the changes of the endpoints it is called with.
+type recordingSubscriber struct {
+	changes []string
+}
+
+func (s *recordingSubscriber) TypedName() fwkplugin.TypedName {
+	return fwkplugin.TypedName{Type: "recording-subscriber", Name: "recording-subscriber"}
+}
+
+func (s *recordingSubscriber) OnEndpointChange(_ context.Context, change fwkdl.EndpointChange) {
+	s.changes = append(s.changes, change.Type.String()+" "+change.Endpoint.GetMetadata().NamespacedName.Name)
+}
+
+func TestSubscribe(t *testing.T) {
+	ctx := context.Background()
+	epf := datalayer.NewTestRuntime(t, time.Second)
+	ds := NewDatastore(t.Context(), epf, 0)
+	t.Cleanup(func() { ds.StaticEndpointsSet(ctx, "default", nil) })
+	t.Cleanup(ds.Clear)
+	ds.StaticEndpointsSet(ctx, "default", []StaticEndpoint{{Name: "remote-0", Address: "10.1.0.1", Port: 8000}})
+
+	subscriber := &recordingSubscriber{}
+	unsubscribe := ds.Subscribe(ctx, subscriber)
+	assert.Equal(t, []string{"added remote-0"}, subscriber.changes, "the current endpoints are replayed on subscription")
+
+	subscriber.changes = nil
+	static := []StaticEndpoint{
+		{Name: "remote-0", Address: "10.1.0.1", Port: 8000, MetricsAddress: "10.1.0.1:9090"},
+		{Name: "remote-1", Address: "10.1.0.2", Port: 8000},
+	}
+	ds.StaticEndpointsSet(ctx, "default", static)
+	assert.Equal(t, []string{"updated remote-0", "added remote-1"}, subscriber.changes)
+
+	subscriber.changes = nil
+	ds.StaticEndpointsSet(ctx, "default", static)
+	assert.Empty(t, subscriber.changes, "unchanged endpoints are not notified")
+
+	subscriber.changes = nil
+	assert.NoError(t, ds.PoolSet(ctx, fake.NewFakeClient(), pooltuil.InferencePoolToEndpointPool(inferencePool)))
+	ds.PodUpdateOrAddIfNotExist(ctx, pod1)
+	ds.PodDelete(pod1.Name)
+	podEndpoint := createEndpointNamespacedName(pod1, 0).Name
+	assert.Equal(t, []string{"added " + podEndpoint, "deleted " + podEndpoint}, subscriber.changes)
+
+	subscriber.changes = nil
+	unsubscribe()
+	ds.StaticEndpointsSet(ctx, "default", nil)
+	assert.Empty(t, subscriber.changes, "no changes are notified after unsubscribing")
+}
+
+func TestPodRecreation(t *testing.T) {
+	ctx := context.Background()
+	pod := func(name string, uid types.UID, ip string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, UID: uid},
+			Status:     corev1.PodStatus{PodIP: ip},
+		}
+	}
+	endpoint := func(ds Datastore, name string) fwkdl.Endpoint {
+		for _, ep := range ds.PodList(AllPodsPredicate) {
+			if ep.GetMetadata().PodName == name {
+				return ep
+			}
+		}
+		return nil
+	}
+
+	epf := datalayer.NewTestRuntime(t, time.Second)
+	ds := NewDatastore(t.Context(), epf, 0)
+	t.Cleanup(ds.Clear)
+	assert.NoError(t, ds.PoolSet(ctx, fake.NewFakeClient(), pooltuil.InferencePoolToEndpointPool(inferencePool)))
+	subscriber := &recordingSubscriber{}
+	ds.Subscribe(ctx, subscriber)
+	podEndpoint := createEndpointNamespacedName(pod1, 0).Name
+
+	ds.PodUpdateOrAddIfNotExist(ctx, pod("pod1", "uid-1", "10.0.0.1"))
+	original := endpoint(ds, "pod1")
+	assert.NotNil(t, original)
+	assert.True(t, ds.PodUpdateOrAddIfNotExist(ctx, pod("pod1", "uid-1", "10.0.0.1")))
+	assert.Same(t, original, endpoint(ds, "pod1"), "updates of the same pod keep its endpoint")
+
+	subscriber.changes = nil
+	assert.False(t, ds.PodUpdateOrAddIfNotExist(ctx, pod("pod1", "uid-2", "10.0.0.1")))
+	recreated := endpoint(ds, "pod1")
+	assert.NotSame(t, original, recreated, "a pod recreated with the same name gets a new endpoint")
+	assert.Equal(t, types.UID("uid-2"), recreated.GetMetadata().PodUID)
+	assert.Equal(t, []string{"deleted " + podEndpoint, "added " + podEndpoint}, subscriber.changes)
+
+	assert.False(t, ds.PodUpdateOrAddIfNotExist(ctx, pod("pod1", "", "10.0.0.2")))
+	assert.NotSame(t, recreated, endpoint(ds, "pod1"), "a pod with another address gets a new endpoint")
+
+	// A new pod reusing the address of a pod whose deletion was not observed yet replaces its endpoint.
+	subscriber.changes = nil
+	ds.PodUpdateOrAddIfNotExist(ctx, pod("pod2", "uid-3", "10.0.0.2"))
+	assert.Nil(t, endpoint(ds, "pod1"))
+	assert.NotNil(t, endpoint(ds, "pod2"))
+	assert.Equal(t, []string{"deleted " + podEndpoint, "added " + createEndpointNamespacedName(pod2, 0).Name},
+		subscriber.changes)
+}
+
+func TestNodeLabels(t *testing.T) {
+	ctx := context.Background()
+	const zoneLabel, gpuLabel = "topology.kubernetes.io/zone", "nvidia.com/gpu.product"
+	node := func(labels map[string]string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1", Labels: labels}}
+	}
+	pod := pod1.DeepCopy()
+	pod.Spec.NodeName = "node1"
+	nodeLabels := func(ds Datastore) map[string]string {
+		endpoints := ds.PodList(AllPodsPredicate)
+		assert.Len(t, endpoints, 1)
+		assert.Equal(t, "node1", endpoints[0].GetMetadata().NodeName)
+		return endpoints[0].GetMetadata().NodeLabels
+	}
+
+	epf := datalayer.NewTestRuntime(t, time.Second)
+	ds := NewDatastore(t.Context(), epf, 0).WithNodeLabels([]string{zoneLabel, gpuLabel})
+	t.Cleanup(ds.Clear)
+	assert.NoError(t, ds.PoolSet(ctx, fake.NewFakeClient(), pooltuil.InferencePoolToEndpointPool(inferencePool)))
+	subscriber := &recordingSubscriber{}
+	ds.Subscribe(ctx, subscriber)
+
+	ds.NodeUpdateOrAdd(ctx, node(map[string]string{zoneLabel: "us-east1-a", gpuLabel: "H100", "other": "value"}))
+	ds.PodUpdateOrAddIfNotExist(ctx, pod)
+	assert.Equal(t, map[string]string{zoneLabel: "us-east1-a", gpuLabel: "H100"}, nodeLabels(ds),
+		"the selected node labels are set on the endpoints")
+
+	subscriber.changes = nil
+	ds.NodeUpdateOrAdd(ctx, node(map[string]string{zoneLabel: "us-east1-b", gpuLabel: "H100"}))
+	assert.Equal(t, map[string]string{zoneLabel: "us-east1-b", gpuLabel: "H100"}, nodeLabels(ds))
+	ds.NodeUpdateOrAdd(ctx, node(map[string]string{zoneLabel: "us-east1-b", gpuLabel: "H100", "other": "changed"}))
+	assert.Len(t, subscriber.changes, 1, "only the changes of the selected labels update the endpoints")
+
+	ds.NodeDelete("node1")
+	assert.Nil(t, nodeLabels(ds))
+
+	// Without selected labels, the nodes are ignored.
+	ds = NewDatastore(t.Context(), datalayer.NewTestRuntime(t, time.Second), 0)
+	t.Cleanup(ds.Clear)
+	assert.NoError(t, ds.PoolSet(ctx, fake.NewFakeClient(), pooltuil.InferencePoolToEndpointPool(inferencePool)))
+	ds.NodeUpdateOrAdd(ctx, node(map[string]string{zoneLabel: "us-east1-a"}))
+	ds.PodUpdateOrAddIfNotExist(ctx, pod)
+	assert.Nil(t, nodeLabels(ds))
+}
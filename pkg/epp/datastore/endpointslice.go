@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// WithEndpointSlices makes the datastore discover the endpoints of the pool from the EndpointSlices of the given
+// Service instead of from its pods, which avoids watching all the pods of the namespace in large clusters.
+func (ds *datastore) WithEndpointSlices(serviceName string) *datastore {
+	ds.endpointSliceService = serviceName
+	return ds
+}
+
+// EndpointsResync resyncs the endpoints of the pool, e.g. after a change of the EndpointSlices of its Service.
+func (ds *datastore) EndpointsResync(ctx context.Context, reader client.Reader) error {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	if ds.pool == nil {
+		return nil
+	}
+	return ds.podResyncAll(ctx, reader)
+}
+
+// listPods lists the pods of the pool, or the ready endpoints of the EndpointSlices of its Service, as pods.
+func (ds *datastore) listPods(ctx context.Context, reader client.Reader) ([]corev1.Pod, error) {
+	if ds.endpointSliceService == "" {
+		podList := &corev1.PodList{}
+		if err := reader.List(ctx, podList, &client.ListOptions{
+			LabelSelector: labels.SelectorFromSet(ds.pool.Selector),
+			Namespace:     ds.pool.Namespace,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to list pods - %w", err)
+		}
+		return podList.Items, nil
+	}
+
+	sliceList := &discoveryv1.EndpointSliceList{}
+	if err := reader.List(ctx, sliceList, client.InNamespace(ds.pool.Namespace),
+		client.MatchingLabels{discoveryv1.LabelServiceName: ds.endpointSliceService}); err != nil {
+		return nil, fmt.Errorf("failed to list endpoint slices - %w", err)
+	}
+	return endpointSlicePods(sliceList.Items), nil
+}
+
+// endpointSlicePods converts the ready endpoints of the given EndpointSlices to ready pods, whose active ports are the
+// ports of their slice. The ports of the slices are the target ports of the Service resolved for their endpoints, so
+// that named target ports are matched against the target ports of the pool by number. The pods are named after the
+// target reference of their endpoint, run on the node of their endpoint, and have no labels.
+func endpointSlicePods(slices []discoveryv1.EndpointSlice) []corev1.Pod {
+	pods := map[string]*corev1.Pod{}
+	names := []string{}
+	for _, slice := range slices {
+		if slice.AddressType != discoveryv1.AddressTypeIPv4 && slice.AddressType != discoveryv1.AddressTypeIPv6 {
+			continue
+		}
+		ports := []string{}
+		for _, port := range slice.Ports {
+			if port.Port != nil {
+				ports = append(ports, strconv.Itoa(int(*port.Port)))
+			}
+		}
+		for _, endpoint := range slice.Endpoints {
+			// A nil ready condition means ready, and terminating endpoints are never ready.
+			if (endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready) || len(endpoint.Addresses) == 0 {
+				continue
+			}
+			name := endpointSlicePodName(endpoint)
+			pod, ok := pods[name]
+			if !ok {
+				pod = &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: slice.Namespace, UID: endpointSlicePodUID(endpoint),
+						Annotations: map[string]string{}},
+					Spec: corev1.PodSpec{NodeName: ptr.Deref(endpoint.NodeName, "")},
+					Status: corev1.PodStatus{
+						PodIP:      endpoint.Addresses[0],
+						Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+					},
+				}
+				pods[name] = pod
+				names = append(names, name)
+			}
+			// The endpoints of a pod are split across slices when the ports of the Service resolve differently.
+			active := ports
+			if existing := pod.Annotations[activePortsAnnotation]; existing != "" {
+				active = append(strings.Split(existing, ","), ports...)
+			}
+			pod.Annotations[activePortsAnnotation] = strings.Join(active, ",")
+		}
+	}
+	res := make([]corev1.Pod, 0, len(names))
+	for _, name := range names {
+		res = append(res, *pods[name])
+	}
+	return res
+}
+
+// endpointSlicePodUID returns the UID of the target pod of the given endpoint, empty for the endpoints that are not
+// pods.
+func endpointSlicePodUID(endpoint discoveryv1.Endpoint) types.UID {
+	if endpoint.TargetRef != nil && endpoint.TargetRef.Kind == "Pod" {
+		return endpoint.TargetRef.UID
+	}
+	return ""
+}
+
+// endpointSlicePodName returns the name of the pod of the given endpoint: the name of its target pod, or its hostname
+// or address for the endpoints that are not pods.
+func endpointSlicePodName(endpoint discoveryv1.Endpoint) string {
+	if endpoint.TargetRef != nil && endpoint.TargetRef.Kind == "Pod" {
+		return endpoint.TargetRef.Name
+	}
+	if endpoint.Hostname != nil && *endpoint.Hostname != "" {
+		return *endpoint.Hostname
+	}
+	return strings.NewReplacer(".", "-", ":", "-").Replace(endpoint.Addresses[0])
+}
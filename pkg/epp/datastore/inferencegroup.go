@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datastore
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+)
+
+const (
+	// groupKeyLabel identifies the multi-node inference group, e.g. the LeaderWorkerSet replica, of a pod. The pods of
+	// a group serve a single model instance with tensor or pipeline parallelism across nodes.
+	groupKeyLabel = "leaderworkerset.sigs.k8s.io/group-key"
+	// workerIndexLabel is the index of a pod in its multi-node inference group, "0" for the leader.
+	workerIndexLabel = "leaderworkerset.sigs.k8s.io/worker-index"
+	// groupSizeAnnotation is the number of pods of a multi-node inference group, set on the leader.
+	groupSizeAnnotation = "leaderworkerset.sigs.k8s.io/size"
+
+	leaderWorkerIndex = "0"
+)
+
+// inferenceGroup tracks the ready pods of a multi-node inference group.
+type inferenceGroup struct {
+	// leader is the last version of the leader pod, nil while it is not ready.
+	leader *corev1.Pod
+	// size is the number of pods of the group, 0 if unknown.
+	size int
+	// readyWorkers are the names of the ready worker pods.
+	readyWorkers sets.Set[string]
+}
+
+// healthy returns whether all the pods of the group are ready. Groups of unknown size are healthy once their leader is
+// ready.
+func (g *inferenceGroup) healthy() bool {
+	return g.leader != nil && (g.size == 0 || g.readyWorkers.Len() >= g.size-1)
+}
+
+// inferenceGroups tracks the multi-node inference groups of the pool. A group is a single schedulable unit: only its
+// leader is scraped and receives traffic, and only while all the pods of the group are ready, since the model instance
+// cannot serve when any of its members is down.
+type inferenceGroups struct {
+	// mu serializes the updates of the groups and of the endpoints of their leaders.
+	mu sync.Mutex
+	// key: "<namespace>/<group key>"
+	groups map[string]*inferenceGroup
+	// podGroups maps the names of the group pods to their group, since pods are deleted by name.
+	podGroups map[string]string
+}
+
+func newInferenceGroups() *inferenceGroups {
+	return &inferenceGroups{groups: map[string]*inferenceGroup{}, podGroups: map[string]string{}}
+}
+
+func (g *inferenceGroups) reset() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.groups = map[string]*inferenceGroup{}
+	g.podGroups = map[string]string{}
+}
+
+// isGroupMember returns whether the given pod belongs to a multi-node inference group of the given pool. Pools whose
+// selector pins the worker index, e.g. to select the leaders only, do not see the workers; their pods are handled
+// individually.
+func isGroupMember(pod *corev1.Pod, pool *datalayer.EndpointPool) bool {
+	if _, ok := pool.Selector[workerIndexLabel]; ok {
+		return false
+	}
+	return pod.Labels[groupKeyLabel] != "" && pod.Labels[workerIndexLabel] != ""
+}
+
+// isGroupLeader returns 1 for the leaders of multi-node inference groups, 0 for the other pods.
+func isGroupLeader(pod *corev1.Pod) int {
+	if pod.Labels[groupKeyLabel] != "" && pod.Labels[workerIndexLabel] == leaderWorkerIndex {
+		return 1
+	}
+	return 0
+}
+
+// groupPodUpdateOrAdd records the given ready group pod, and adds or removes the endpoints of the group leader
+// according to the health of the group. It returns false if the endpoints of the leader were added.
+func (ds *datastore) groupPodUpdateOrAdd(ctx context.Context, pod *corev1.Pod, pool *datalayer.EndpointPool) bool {
+	ds.groups.mu.Lock()
+	defer ds.groups.mu.Unlock()
+
+	id := pod.Namespace + "/" + pod.Labels[groupKeyLabel]
+	group, ok := ds.groups.groups[id]
+	if !ok {
+		group = &inferenceGroup{readyWorkers: sets.New[string]()}
+		ds.groups.groups[id] = group
+	}
+	ds.groups.podGroups[pod.Name] = id
+	if pod.Labels[workerIndexLabel] == leaderWorkerIndex {
+		group.leader = pod
+		if size, err := strconv.Atoi(pod.Annotations[groupSizeAnnotation]); err == nil && size > 0 {
+			group.size = size
+		}
+	} else {
+		group.readyWorkers.Insert(pod.Name)
+	}
+
+	if group.leader == nil {
+		return true
+	}
+	if !group.healthy() {
+		log.FromContext(ctx).V(logutil.DEBUG).Info("Inference group is not fully ready, leader will not receive traffic",
+			"group", id, "leader", group.leader.Name, "size", group.size, "readyWorkers", group.readyWorkers.Len())
+		ds.podDeleteEndpoints(group.leader.Name)
+		return true
+	}
+	return ds.podUpdateOrAddIfNotExist(ctx, group.leader, pool)
+}
+
+// groupPodDelete records that the given group pod is not ready anymore, and removes the endpoints of the group leader.
+// It returns false if the pod does not belong to a group.
+func (ds *datastore) groupPodDelete(podName string) bool {
+	ds.groups.mu.Lock()
+	defer ds.groups.mu.Unlock()
+
+	id, ok := ds.groups.podGroups[podName]
+	if !ok {
+		return false
+	}
+	delete(ds.groups.podGroups, podName)
+	group := ds.groups.groups[id]
+	if group.leader != nil && group.leader.Name == podName {
+		group.leader = nil
+	} else {
+		group.readyWorkers.Delete(podName)
+		if group.leader != nil {
+			ds.podDeleteEndpoints(group.leader.Name)
+		}
+	}
+	ds.podDeleteEndpoints(podName)
+	if group.leader == nil && group.readyWorkers.Len() == 0 {
+		delete(ds.groups.groups, id)
+	}
+	return true
+}
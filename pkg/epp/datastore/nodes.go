@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datastore
+
+import (
+	"context"
+	"maps"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// nodeLabels caches the selected labels of the nodes, so that they can be set on the metadata of the endpoints of the
+// pods running on them.
+type nodeLabels struct {
+	// keys are the keys of the node labels set on the endpoints, none to disable the node label enrichment.
+	keys []string
+	mu   sync.RWMutex
+	// key: node name, value: the selected labels of the node
+	nodes map[string]map[string]string
+}
+
+// WithNodeLabels sets the labels of the given keys of the nodes the pods run on, e.g. their zone, GPU model or
+// instance type, on the metadata of their endpoints, so that plugins can use the topology and hardware of the
+// endpoints without watching the nodes themselves.
+func (ds *datastore) WithNodeLabels(keys []string) *datastore {
+	ds.nodeLabels.keys = keys
+	return ds
+}
+
+// NodeUpdateOrAdd caches the selected labels of the given node, and updates the endpoints of its pods if they changed.
+func (ds *datastore) NodeUpdateOrAdd(ctx context.Context, node *corev1.Node) {
+	if len(ds.nodeLabels.keys) == 0 {
+		return
+	}
+	selected := map[string]string{}
+	for _, key := range ds.nodeLabels.keys {
+		if value, ok := node.Labels[key]; ok {
+			selected[key] = value
+		}
+	}
+
+	ds.nodeLabels.mu.Lock()
+	current, ok := ds.nodeLabels.nodes[node.Name]
+	if ok && maps.Equal(current, selected) {
+		ds.nodeLabels.mu.Unlock()
+		return
+	}
+	ds.nodeLabels.nodes[node.Name] = selected
+	ds.nodeLabels.mu.Unlock()
+
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("Node labels updated", "node", node.Name, "labels", selected)
+	ds.nodeEndpointsUpdate(node.Name)
+}
+
+// NodeDelete forgets the labels of the given node, and removes them from the endpoints of its pods.
+func (ds *datastore) NodeDelete(nodeName string) {
+	if len(ds.nodeLabels.keys) == 0 {
+		return
+	}
+	ds.nodeLabels.mu.Lock()
+	_, ok := ds.nodeLabels.nodes[nodeName]
+	delete(ds.nodeLabels.nodes, nodeName)
+	ds.nodeLabels.mu.Unlock()
+	if ok {
+		ds.nodeEndpointsUpdate(nodeName)
+	}
+}
+
+// nodeEndpointsUpdate sets the current labels of the given node on the endpoints of its pods.
+func (ds *datastore) nodeEndpointsUpdate(nodeName string) {
+	labels := ds.nodeLabelsGet(nodeName)
+	ds.pods.Range(func(_, v any) bool {
+		ep := v.(fwkdl.Endpoint)
+		if metadata := ep.GetMetadata(); metadata.NodeName == nodeName {
+			updated := metadata.Clone()
+			updated.NodeLabels = labels
+			ds.endpointUpdate(ep, updated)
+		}
+		return true
+	})
+}
+
+// nodeLabelsGet returns a copy of the selected labels of the given node, nil if unknown.
+func (ds *datastore) nodeLabelsGet(nodeName string) map[string]string {
+	if len(ds.nodeLabels.keys) == 0 || nodeName == "" {
+		return nil
+	}
+	ds.nodeLabels.mu.RLock()
+	defer ds.nodeLabels.mu.RUnlock()
+	return maps.Clone(ds.nodeLabels.nodes[nodeName])
+}
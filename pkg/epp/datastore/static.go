@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net"
+	"os"
+	"strconv"
+
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// StaticEndpoint is a model server that is not discovered from the pods of the pool, e.g. running outside of the
+// cluster or behind another discovery mechanism.
+type StaticEndpoint struct {
+	// Name identifies the endpoint. It must be a valid DNS subdomain, unique among the static endpoints, and must not
+	// collide with the names of the pods of the pool.
+	Name string `json:"name"`
+	// Address is the IP address of the model server.
+	Address string `json:"address"`
+	// Port is the port serving the inference traffic.
+	Port int `json:"port"`
+	// MetricsAddress is the "<host>:<port>" the metrics are scraped from. Default: "<address>:<port>". The scheme and
+	// path of the metrics are those of the metrics data source.
+	MetricsAddress string `json:"metricsAddress,omitempty"`
+	// Labels are the labels of the endpoint, as pods have, e.g. for the filters selecting endpoints by label.
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// StaticEndpoints is the content of the static endpoints file.
+type StaticEndpoints struct {
+	Endpoints []StaticEndpoint `json:"endpoints"`
+}
+
+// LoadStaticEndpoints reads and validates the static endpoints of the given YAML or JSON file.
+func LoadStaticEndpoints(path string) ([]StaticEndpoint, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read static endpoints file - %w", err)
+	}
+	return ParseStaticEndpoints(data)
+}
+
+// ParseStaticEndpoints parses and validates the given static endpoints, in YAML or JSON.
+func ParseStaticEndpoints(data []byte) ([]StaticEndpoint, error) {
+	var static StaticEndpoints
+	if err := yaml.UnmarshalStrict(data, &static); err != nil {
+		return nil, fmt.Errorf("failed to parse static endpoints - %w", err)
+	}
+	names := sets.New[string]()
+	for _, endpoint := range static.Endpoints {
+		if err := endpoint.validate(); err != nil {
+			return nil, fmt.Errorf("invalid static endpoint %q - %w", endpoint.Name, err)
+		}
+		if names.Has(endpoint.Name) {
+			return nil, fmt.Errorf("duplicate static endpoint %q", endpoint.Name)
+		}
+		names.Insert(endpoint.Name)
+	}
+	return static.Endpoints, nil
+}
+
+func (e *StaticEndpoint) validate() error {
+	if errs := validation.IsDNS1123Subdomain(e.Name); len(errs) > 0 {
+		return fmt.Errorf("invalid name: %v", errs)
+	}
+	if net.ParseIP(e.Address) == nil {
+		return fmt.Errorf("address must be an IP address, got %q", e.Address)
+	}
+	if e.Port <= 0 || e.Port > 65535 {
+		return fmt.Errorf("invalid port %d", e.Port)
+	}
+	if e.MetricsAddress != "" {
+		if _, _, err := net.SplitHostPort(e.MetricsAddress); err != nil {
+			return fmt.Errorf("metricsAddress must be <host>:<port> - %w", err)
+		}
+	}
+	if errs := metav1validation.ValidateLabels(e.Labels, field.NewPath("labels")); len(errs) > 0 {
+		return errs.ToAggregate()
+	}
+	return nil
+}
+
+// metadata returns the metadata of the endpoint, in the given namespace.
+func (e *StaticEndpoint) metadata(namespace string) *fwkdl.EndpointMetadata {
+	metricsAddress := e.MetricsAddress
+	if metricsAddress == "" {
+		metricsAddress = net.JoinHostPort(e.Address, strconv.Itoa(e.Port))
+	}
+	labels := make(map[string]string, len(e.Labels))
+	maps.Copy(labels, e.Labels)
+	return &fwkdl.EndpointMetadata{
+		NamespacedName: types.NamespacedName{Name: e.Name, Namespace: namespace},
+		PodName:        e.Name,
+		Address:        e.Address,
+		Port:           strconv.Itoa(e.Port),
+		MetricsHost:    metricsAddress,
+		Labels:         labels,
+	}
+}
+
+// StaticEndpointsSet replaces the static endpoints of the datastore by the given ones, in the given namespace. Static
+// endpoints are served alongside the endpoints of the pods of the pool; they are not affected by the pod events, the
+// pool resyncs or Clear.
+func (ds *datastore) StaticEndpointsSet(ctx context.Context, namespace string, endpoints []StaticEndpoint) {
+	logger := log.FromContext(ctx)
+	ds.staticMu.Lock()
+	defer ds.staticMu.Unlock()
+
+	current := sets.New[types.NamespacedName]()
+	for _, endpoint := range endpoints {
+		metadata := endpoint.metadata(namespace)
+		current.Insert(metadata.NamespacedName)
+		if existing, ok := ds.pods.Load(metadata.NamespacedName); ok {
+			ds.endpointUpdate(existing.(fwkdl.Endpoint), metadata)
+			continue
+		}
+		ep := ds.epf.NewEndpoint(ds.parentCtx, metadata, ds)
+		if ep == nil {
+			continue
+		}
+		ds.endpointStore(metadata.NamespacedName, ep)
+		logger.V(logutil.DEFAULT).Info("Static endpoint added", "name", metadata.NamespacedName,
+			"address", metadata.Address, "port", metadata.Port)
+	}
+	for name := range ds.staticEndpoints.Difference(current) {
+		if ep, ok := ds.pods.Load(name); ok {
+			ds.endpointDelete(name, ep.(fwkdl.Endpoint))
+			ds.epf.ReleaseEndpoint(ep.(fwkdl.Endpoint))
+			logger.V(logutil.DEFAULT).Info("Static endpoint removed", "name", name)
+		}
+	}
+	ds.staticEndpoints = current
+}
+
+// isStatic returns whether the endpoint of the given name is a static endpoint.
+func (ds *datastore) isStatic(name types.NamespacedName) bool {
+	ds.staticMu.RLock()
+	defer ds.staticMu.RUnlock()
+	return ds.staticEndpoints.Has(name)
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datastore
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// subscription is the subscription of an EndpointSubscriber to the changes of the endpoints.
+type subscription struct {
+	ctx        context.Context
+	subscriber fwkdl.EndpointSubscriber
+}
+
+// subscriptions tracks the subscriptions to the changes of the endpoints.
+type subscriptions struct {
+	// mu is held for reading while an endpoint is stored or deleted and its subscribers notified, and for writing while
+	// a subscriber is added and the current endpoints replayed to it, so that it sees each change exactly once.
+	mu   sync.RWMutex
+	subs []*subscription
+}
+
+// Subscribe subscribes the given subscriber to the changes of the endpoints, after calling it with an EndpointAdded
+// change for each current endpoint. The given context is passed to the subscriber on each change. The returned
+// function cancels the subscription.
+func (ds *datastore) Subscribe(ctx context.Context, subscriber fwkdl.EndpointSubscriber) func() {
+	ds.subscriptions.mu.Lock()
+	defer ds.subscriptions.mu.Unlock()
+
+	sub := &subscription{ctx: ctx, subscriber: subscriber}
+	ds.pods.Range(func(_, v any) bool {
+		subscriber.OnEndpointChange(ctx, fwkdl.EndpointChange{Type: fwkdl.EndpointAdded, Endpoint: v.(fwkdl.Endpoint)})
+		return true
+	})
+	ds.subscriptions.subs = append(ds.subscriptions.subs, sub)
+
+	return func() {
+		ds.subscriptions.mu.Lock()
+		defer ds.subscriptions.mu.Unlock()
+		ds.subscriptions.subs = slices.DeleteFunc(ds.subscriptions.subs, func(s *subscription) bool { return s == sub })
+	}
+}
+
+// endpointStore adds the given endpoint and notifies the subscribers.
+func (ds *datastore) endpointStore(name types.NamespacedName, ep fwkdl.Endpoint) {
+	ds.subscriptions.mu.RLock()
+	defer ds.subscriptions.mu.RUnlock()
+	ds.pods.Store(name, ep)
+	ds.notify(fwkdl.EndpointAdded, ep)
+}
+
+// endpointDelete removes the given endpoint and notifies the subscribers. The caller releases the endpoint.
+func (ds *datastore) endpointDelete(name any, ep fwkdl.Endpoint) {
+	ds.subscriptions.mu.RLock()
+	defer ds.subscriptions.mu.RUnlock()
+	ds.pods.Delete(name)
+	ds.notify(fwkdl.EndpointDeleted, ep)
+}
+
+// endpointUpdate updates the metadata of the given endpoint, and notifies the subscribers if it changed.
+func (ds *datastore) endpointUpdate(ep fwkdl.Endpoint, metadata *fwkdl.EndpointMetadata) {
+	ds.subscriptions.mu.RLock()
+	defer ds.subscriptions.mu.RUnlock()
+	changed := !reflect.DeepEqual(ep.GetMetadata(), metadata)
+	ep.UpdateMetadata(metadata)
+	if changed {
+		ds.notify(fwkdl.EndpointUpdated, ep)
+	}
+}
+
+// notify calls the subscribers with the given change. The caller holds ds.subscriptions.mu for reading.
+func (ds *datastore) notify(changeType fwkdl.EndpointChangeType, ep fwkdl.Endpoint) {
+	for _, sub := range ds.subscriptions.subs {
+		sub.subscriber.OnEndpointChange(sub.ctx, fwkdl.EndpointChange{Type: changeType, Endpoint: ep})
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package debugstate implements the debug state API of the EPP, which dumps the endpoints known to the datastore with
+// their latest metrics, a summary of the in-memory state of the plugins, e.g. the prefix cache index, and the loaded
+// configuration. It helps explaining routing decisions without reconstructing the EPP state from its logs.
+package debugstate
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	configapi "sigs.k8s.io/gateway-api-inference-extension/apix/config/v1alpha1"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+// State is the state of the EPP, as served by the debug state API.
+type State struct {
+	// Endpoints are the endpoints known to the datastore, sorted by name.
+	Endpoints []Endpoint `json:"endpoints"`
+	// Plugins are the loaded plugins, sorted by name.
+	Plugins []Plugin `json:"plugins"`
+	// Config is the loaded configuration.
+	Config *configapi.EndpointPickerConfig `json:"config,omitempty"`
+}
+
+// Endpoint is the state of a single endpoint.
+type Endpoint struct {
+	// Name is the endpoint name, in the form "<namespace>/<name>".
+	Name     string            `json:"name"`
+	Pod      string            `json:"pod"`
+	Address  string            `json:"address"`
+	Port     string            `json:"port"`
+	NodeName string            `json:"nodeName,omitempty"`
+	Labels   map[string]string `json:"labels,omitempty"`
+	// Metrics are the latest metrics scraped from the model server, nil if none were scraped yet.
+	Metrics *Metrics `json:"metrics,omitempty"`
+}
+
+// Metrics is the metrics snapshot of a single endpoint.
+type Metrics struct {
+	WaitingQueueSize    int            `json:"waitingQueueSize"`
+	RunningRequestsSize int            `json:"runningRequestsSize"`
+	KVCacheUsagePercent float64        `json:"kvCacheUsagePercent"`
+	ActiveModels        map[string]int `json:"activeModels,omitempty"`
+	WaitingModels       map[string]int `json:"waitingModels,omitempty"`
+	// AdapterRunningRequests and AdapterWaitingRequests are the per-adapter request counts, for the model servers
+	// exposing them.
+	AdapterRunningRequests map[string]int `json:"adapterRunningRequests,omitempty"`
+	AdapterWaitingRequests map[string]int `json:"adapterWaitingRequests,omitempty"`
+	MaxConcurrency         int            `json:"maxConcurrency,omitempty"`
+	UpdateTime             time.Time      `json:"updateTime"`
+}
+
+// Plugin is the state of a single plugin.
+type Plugin struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+	// State is the summary of the plugin state, for the plugins implementing fwkplugin.DebugStatePlugin.
+	State any `json:"state,omitempty"`
+}
+
+// EndpointLister lists the endpoints matching a predicate, as the datastore does.
+type EndpointLister interface {
+	PodList(predicate func(fwkdl.Endpoint) bool) []fwkdl.Endpoint
+}
+
+// Dumper assembles the State of the EPP.
+type Dumper struct {
+	endpoints EndpointLister
+	config    *configapi.EndpointPickerConfig
+	plugins   []fwkplugin.Plugin
+}
+
+// NewDumper returns a Dumper of the endpoints of the given lister, of the given configuration and of the given
+// plugins.
+func NewDumper(endpoints EndpointLister, config *configapi.EndpointPickerConfig, plugins ...fwkplugin.Plugin) *Dumper {
+	return &Dumper{endpoints: endpoints, config: config, plugins: plugins}
+}
+
+// Dump returns the current state of the EPP.
+func (d *Dumper) Dump() *State {
+	state := &State{Endpoints: []Endpoint{}, Plugins: make([]Plugin, 0, len(d.plugins)), Config: d.config}
+	for _, endpoint := range d.endpoints.PodList(func(fwkdl.Endpoint) bool { return true }) {
+		metadata := endpoint.GetMetadata()
+		if metadata == nil {
+			continue
+		}
+		dumped := Endpoint{
+			Name:     metadata.NamespacedName.String(),
+			Pod:      metadata.PodName,
+			Address:  metadata.Address,
+			Port:     metadata.Port,
+			NodeName: metadata.NodeName,
+			Labels:   metadata.Labels,
+		}
+		if metrics := endpoint.GetMetrics(); metrics != nil && !metrics.UpdateTime.IsZero() {
+			dumped.Metrics = &Metrics{
+				WaitingQueueSize:       metrics.WaitingQueueSize,
+				RunningRequestsSize:    metrics.RunningRequestsSize,
+				KVCacheUsagePercent:    metrics.KVCacheUsagePercent,
+				ActiveModels:           metrics.ActiveModels,
+				WaitingModels:          metrics.WaitingModels,
+				AdapterRunningRequests: metrics.AdapterRunningRequests,
+				AdapterWaitingRequests: metrics.AdapterWaitingRequests,
+				MaxConcurrency:         metrics.MaxConcurrency,
+				UpdateTime:             metrics.UpdateTime,
+			}
+		}
+		state.Endpoints = append(state.Endpoints, dumped)
+	}
+	slices.SortFunc(state.Endpoints, func(a, b Endpoint) int { return strings.Compare(a.Name, b.Name) })
+
+	for _, plugin := range d.plugins {
+		dumped := Plugin{Type: plugin.TypedName().Type, Name: plugin.TypedName().Name}
+		if debuggable, ok := plugin.(fwkplugin.DebugStatePlugin); ok {
+			dumped.State = debuggable.DebugState()
+		}
+		state.Plugins = append(state.Plugins, dumped)
+	}
+	slices.SortFunc(state.Plugins, func(a, b Plugin) int { return strings.Compare(a.Name, b.Name) })
+	return state
+}
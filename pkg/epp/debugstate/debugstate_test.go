@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debugstate
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	configapi "sigs.k8s.io/gateway-api-inference-extension/apix/config/v1alpha1"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+type endpointList []fwkdl.Endpoint
+
+func (l endpointList) PodList(predicate func(fwkdl.Endpoint) bool) []fwkdl.Endpoint {
+	var res []fwkdl.Endpoint
+	for _, endpoint := range l {
+		if predicate(endpoint) {
+			res = append(res, endpoint)
+		}
+	}
+	return res
+}
+
+type testPlugin struct {
+	typedName fwkplugin.TypedName
+}
+
+func (p *testPlugin) TypedName() fwkplugin.TypedName {
+	return p.typedName
+}
+
+// debugPlugin is a DebugStatePlugin whose state is a single counter.
+type debugPlugin struct {
+	testPlugin
+	count int
+}
+
+func (p *debugPlugin) DebugState() any {
+	return map[string]int{"count": p.count}
+}
+
+func TestDump(t *testing.T) {
+	updateTime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	metrics := fwkdl.NewMetrics()
+	metrics.WaitingQueueSize = 2
+	metrics.KVCacheUsagePercent = 0.5
+	metrics.UpdateTime = updateTime
+	endpoints := endpointList{
+		fwkdl.NewEndpoint(&fwkdl.EndpointMetadata{
+			NamespacedName: k8stypes.NamespacedName{Namespace: "default", Name: "pod-b-rank-0"},
+			PodName:        "pod-b",
+			Address:        "10.0.0.2",
+			Port:           "8000",
+			NodeName:       "node-1",
+		}, metrics),
+		fwkdl.NewEndpoint(&fwkdl.EndpointMetadata{
+			NamespacedName: k8stypes.NamespacedName{Namespace: "default", Name: "pod-a-rank-0"},
+			PodName:        "pod-a",
+			Address:        "10.0.0.1",
+			Port:           "8000",
+		}, fwkdl.NewMetrics()),
+	}
+	config := &configapi.EndpointPickerConfig{Plugins: []configapi.PluginSpec{{Name: "counter", Type: "counter"}}}
+	dumper := NewDumper(endpoints, config,
+		&testPlugin{typedName: fwkplugin.TypedName{Type: "picker", Name: "picker"}},
+		&debugPlugin{testPlugin: testPlugin{typedName: fwkplugin.TypedName{Type: "counter", Name: "counter"}}, count: 3})
+
+	assert.Equal(t, &State{
+		Endpoints: []Endpoint{
+			{Name: "default/pod-a-rank-0", Pod: "pod-a", Address: "10.0.0.1", Port: "8000"},
+			{
+				Name:     "default/pod-b-rank-0",
+				Pod:      "pod-b",
+				Address:  "10.0.0.2",
+				Port:     "8000",
+				NodeName: "node-1",
+				Metrics: &Metrics{
+					WaitingQueueSize:    2,
+					KVCacheUsagePercent: 0.5,
+					ActiveModels:        map[string]int{},
+					WaitingModels:       map[string]int{},
+					UpdateTime:          updateTime,
+				},
+			},
+		},
+		Plugins: []Plugin{
+			{Type: "counter", Name: "counter", State: map[string]int{"count": 3}},
+			{Type: "picker", Name: "picker"},
+		},
+		Config: config,
+	}, dumper.Dump())
+}
+
+func TestHandler(t *testing.T) {
+	handler := NewHandler(NewDumper(endpointList{}, nil))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, HandlerPath, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	state := &State{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), state))
+	assert.Empty(t, state.Endpoints)
+	assert.Empty(t, state.Plugins)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, HandlerPath, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package decisionaudit writes the audit records of the scheduling decisions of the EPP as JSON lines, e.g. for the
+// compliance review of the placement of the requests or the offline tuning of the scheduling profiles.
+package decisionaudit
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/go-logr/logr"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling"
+)
+
+// Stdout is the destination writing the audit records to the standard output.
+const Stdout = "stdout"
+
+// Logger writes each audit record as a line of JSON.
+type Logger struct {
+	logger logr.Logger
+
+	mu      sync.Mutex
+	encoder *json.Encoder
+}
+
+// Open returns a Logger writing to the given destination: Stdout, or the path of a file the records are appended to.
+// The file is kept open for the lifetime of the EPP.
+func Open(destination string, logger logr.Logger) (*Logger, error) {
+	if destination == Stdout {
+		return NewLogger(os.Stdout, logger), nil
+	}
+	file, err := os.OpenFile(destination, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the decision audit log %q: %w", destination, err)
+	}
+	return NewLogger(file, logger), nil
+}
+
+// NewLogger returns a Logger writing to the given writer. Write errors are logged with the given logger.
+func NewLogger(w io.Writer, logger logr.Logger) *Logger {
+	return &Logger{logger: logger, encoder: json.NewEncoder(w)}
+}
+
+// AuditDecision implements scheduling.DecisionAuditSink.
+func (l *Logger) AuditDecision(audit *scheduling.DecisionAudit) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.encoder.Encode(audit); err != nil {
+		l.logger.Error(err, "Failed to write the decision audit record", "requestID", audit.RequestID)
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package decisionaudit
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling"
+)
+
+func TestLoggerWritesJSONLines(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger(&buf, logr.Discard())
+
+	logger.AuditDecision(&scheduling.DecisionAudit{RequestID: "r1", Model: "m", Chosen: "default/pod1"})
+	logger.AuditDecision(&scheduling.DecisionAudit{RequestID: "r2", Model: "m", Error: "no endpoints"})
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	var audit scheduling.DecisionAudit
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &audit))
+	assert.Equal(t, "r1", audit.RequestID)
+	assert.Equal(t, "default/pod1", audit.Chosen)
+	assert.NotContains(t, lines[0], `"error"`, "empty fields must be omitted")
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &audit))
+	assert.Equal(t, "no endpoints", audit.Error)
+}
+
+func TestOpenAppendsToFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	require.NoError(t, os.WriteFile(path, []byte("{}\n"), 0o644))
+
+	logger, err := Open(path, logr.Discard())
+	require.NoError(t, err)
+	logger.AuditDecision(&scheduling.DecisionAudit{RequestID: "r1"})
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	require.Len(t, lines, 2, "records must be appended to the existing file")
+	assert.Contains(t, lines[1], `"requestId":"r1"`)
+
+	_, err = Open(filepath.Join(t.TempDir(), "missing", "audit.log"), logr.Discard())
+	assert.Error(t, err)
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package decisioncompare compares the scheduling decisions of a canary EPP against the decisions of the active EPP.
+//
+// The canary is deployed as a second ext-proc filter, after the filter of the active EPP, in observability mode: the
+// proxy sends it a copy of the traffic and ignores its responses. The request headers it receives carry the endpoint
+// picked by the active EPP, which the canary compares with its own decision.
+package decisioncompare
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+// Result is the result of a decision comparison.
+type Result string
+
+const (
+	// ResultAgree indicates that both EPPs picked the same primary endpoint.
+	ResultAgree Result = "agree"
+	// ResultDisagree indicates that the EPPs picked different primary endpoints.
+	ResultDisagree Result = "disagree"
+	// ResultMissingActive indicates that the request did not carry the decision of the active EPP.
+	ResultMissingActive Result = "missing_active"
+	// ResultCanaryError indicates that the canary failed to schedule the request.
+	ResultCanaryError Result = "canary_error"
+	// ResultSkipped indicates that the request could not be compared, e.g. because it was mirrored without a body.
+	ResultSkipped Result = "skipped"
+)
+
+// Decision holds the decisions of the active and canary EPPs for a request.
+type Decision struct {
+	RequestID string
+	Model     string
+	// Active is the destination endpoint list picked by the active EPP.
+	Active string
+	// Canary is the destination endpoint list picked by this EPP.
+	Canary string
+	// Err is the error returned by this EPP while handling the request, if any.
+	Err error
+	// Skipped is set when the request could not be scheduled for comparison.
+	Skipped bool
+}
+
+// Divergence is a sample of a request for which the decisions differ.
+type Divergence struct {
+	Timestamp time.Time `json:"timestamp"`
+	RequestID string    `json:"requestId,omitempty"`
+	Model     string    `json:"model,omitempty"`
+	Result    Result    `json:"result"`
+	Active    string    `json:"active,omitempty"`
+	Canary    string    `json:"canary,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// Comparer compares decisions, records the results and keeps the latest divergences.
+type Comparer struct {
+	mu          sync.Mutex
+	counts      map[Result]int64
+	divergences []Divergence // ring buffer of the latest divergences
+	next        int
+	full        bool
+}
+
+// NewComparer returns a Comparer keeping the given number of divergence samples.
+func NewComparer(samples int) *Comparer {
+	return &Comparer{
+		counts:      map[Result]int64{},
+		divergences: make([]Divergence, max(samples, 0)),
+	}
+}
+
+// Compare compares the given decisions and records the result.
+func (c *Comparer) Compare(ctx context.Context, decision Decision) Result {
+	result := compare(decision)
+	metrics.RecordDecisionCompare(decision.Model, string(result))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.counts[result]++
+	if result == ResultAgree || result == ResultSkipped || len(c.divergences) == 0 {
+		return result
+	}
+
+	divergence := Divergence{
+		Timestamp: time.Now(),
+		RequestID: decision.RequestID,
+		Model:     decision.Model,
+		Result:    result,
+		Active:    decision.Active,
+		Canary:    decision.Canary,
+	}
+	if decision.Err != nil {
+		divergence.Error = decision.Err.Error()
+	}
+	c.divergences[c.next] = divergence
+	c.next = (c.next + 1) % len(c.divergences)
+	c.full = c.full || c.next == 0
+	log.FromContext(ctx).V(logutil.DEBUG).Info("Scheduling decision diverges from the active EPP",
+		"result", result, "active", decision.Active, "canary", decision.Canary)
+	return result
+}
+
+// Summary is a snapshot of the comparison results.
+type Summary struct {
+	Counts map[Result]int64 `json:"counts"`
+	// Divergences are the latest divergences, oldest first.
+	Divergences []Divergence `json:"divergences"`
+}
+
+// Summary returns a snapshot of the comparison results.
+func (c *Comparer) Summary() Summary {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	summary := Summary{Counts: make(map[Result]int64, len(c.counts)), Divergences: []Divergence{}}
+	for result, count := range c.counts {
+		summary.Counts[result] = count
+	}
+	if c.full {
+		summary.Divergences = append(summary.Divergences, c.divergences[c.next:]...)
+	}
+	summary.Divergences = append(summary.Divergences, c.divergences[:c.next]...)
+	return summary
+}
+
+func compare(decision Decision) Result {
+	switch {
+	case decision.Skipped:
+		return ResultSkipped
+	case decision.Active == "":
+		return ResultMissingActive
+	case decision.Err != nil || decision.Canary == "":
+		return ResultCanaryError
+	case primary(decision.Active) == primary(decision.Canary):
+		return ResultAgree
+	default:
+		return ResultDisagree
+	}
+}
+
+// primary returns the primary endpoint of a comma-separated destination endpoint list.
+func primary(endpoints string) string {
+	first, _, _ := strings.Cut(endpoints, ",")
+	return strings.TrimSpace(first)
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package decisioncompare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name     string
+		decision Decision
+		want     Result
+	}{
+		{
+			name:     "same primary endpoint",
+			decision: Decision{Active: "10.0.0.1:8000,10.0.0.2:8000", Canary: "10.0.0.1:8000"},
+			want:     ResultAgree,
+		},
+		{
+			name:     "different primary endpoint",
+			decision: Decision{Active: "10.0.0.1:8000", Canary: "10.0.0.2:8000,10.0.0.1:8000"},
+			want:     ResultDisagree,
+		},
+		{
+			name:     "no active decision",
+			decision: Decision{Canary: "10.0.0.1:8000"},
+			want:     ResultMissingActive,
+		},
+		{
+			name:     "canary failed",
+			decision: Decision{Active: "10.0.0.1:8000", Err: errors.New("no candidates")},
+			want:     ResultCanaryError,
+		},
+		{
+			name:     "skipped",
+			decision: Decision{Skipped: true},
+			want:     ResultSkipped,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, NewComparer(1).Compare(context.Background(), test.decision))
+		})
+	}
+}
+
+func TestSummaryKeepsLatestDivergences(t *testing.T) {
+	comparer := NewComparer(2)
+	comparer.Compare(context.Background(), Decision{RequestID: "1", Active: "a", Canary: "b"})
+	comparer.Compare(context.Background(), Decision{RequestID: "2", Active: "a", Canary: "a"})
+	comparer.Compare(context.Background(), Decision{RequestID: "3", Active: "a", Canary: "c"})
+	comparer.Compare(context.Background(), Decision{RequestID: "4", Canary: "c"})
+
+	summary := comparer.Summary()
+	assert.Equal(t, map[Result]int64{ResultAgree: 1, ResultDisagree: 2, ResultMissingActive: 1}, summary.Counts)
+	require.Len(t, summary.Divergences, 2)
+	assert.Equal(t, "3", summary.Divergences[0].RequestID)
+	assert.Equal(t, "4", summary.Divergences[1].RequestID)
+}
+
+func TestHandler(t *testing.T) {
+	comparer := NewComparer(1)
+	comparer.Compare(context.Background(), Decision{RequestID: "1", Active: "a", Canary: "b"})
+	handler := NewHandler(comparer)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, HandlerPath, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	summary := Summary{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &summary))
+	assert.Equal(t, int64(1), summary.Counts[ResultDisagree])
+	require.Len(t, summary.Divergences, 1)
+	assert.Equal(t, "b", summary.Divergences[0].Canary)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, HandlerPath, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package exclusion implements time-bounded exclusion of endpoints from scheduling.
+//
+// External systems (for example a GPU health daemon that detects ECC errors) can exclude an endpoint for a bounded
+// duration together with a reason. Exclusions expire automatically; an expired exclusion is never honored even if the
+// background sweep has not yet removed it.
+package exclusion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+const (
+	// DefaultMaxDuration is the default upper bound on the duration of a single exclusion.
+	DefaultMaxDuration = time.Hour
+
+	// sweepInterval dictates how often expired exclusions are removed and their metrics cleared.
+	sweepInterval = time.Second
+)
+
+// Exclusion describes a single endpoint exclusion.
+type Exclusion struct {
+	// Target is either the namespaced name of an endpoint or of a pod. Excluding a pod excludes all of its endpoints.
+	Target types.NamespacedName `json:"target"`
+	// Reason is a free-form, human readable explanation of the exclusion.
+	Reason string `json:"reason"`
+	// Source optionally identifies the external system that requested the exclusion.
+	Source string `json:"source,omitempty"`
+	// CreatedAt is the time the exclusion was (last) set.
+	CreatedAt time.Time `json:"createdAt"`
+	// ExpiresAt is the time after which the exclusion is no longer honored.
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// Store holds the set of active endpoint exclusions. It is safe for concurrent use.
+type Store struct {
+	clock       clock.WithTicker
+	maxDuration time.Duration
+
+	mu         sync.RWMutex
+	exclusions map[types.NamespacedName]Exclusion
+}
+
+// NewStore creates a new exclusion store. Durations requested through Exclude are capped by maxDuration; a
+// non-positive maxDuration selects DefaultMaxDuration.
+func NewStore(maxDuration time.Duration) *Store {
+	return newStoreWithClock(maxDuration, &clock.RealClock{})
+}
+
+func newStoreWithClock(maxDuration time.Duration, clk clock.WithTicker) *Store {
+	if maxDuration <= 0 {
+		maxDuration = DefaultMaxDuration
+	}
+	return &Store{
+		clock:       clk,
+		maxDuration: maxDuration,
+		exclusions:  make(map[types.NamespacedName]Exclusion),
+	}
+}
+
+// Exclude excludes the given target from scheduling for the given duration. Excluding an already excluded target
+// replaces the previous exclusion.
+func (s *Store) Exclude(target types.NamespacedName, duration time.Duration, reason, source string) (Exclusion, error) {
+	if target.Name == "" || target.Namespace == "" {
+		return Exclusion{}, errors.New("target namespace and name must not be empty")
+	}
+	if duration <= 0 {
+		return Exclusion{}, errors.New("duration must be positive")
+	}
+	if duration > s.maxDuration {
+		return Exclusion{}, fmt.Errorf("duration %s exceeds the maximum allowed duration %s", duration, s.maxDuration)
+	}
+	if reason == "" {
+		return Exclusion{}, errors.New("reason must not be empty")
+	}
+
+	now := s.clock.Now()
+	exclusion := Exclusion{
+		Target:    target,
+		Reason:    reason,
+		Source:    source,
+		CreatedAt: now,
+		ExpiresAt: now.Add(duration),
+	}
+
+	s.mu.Lock()
+	s.exclusions[target] = exclusion
+	s.mu.Unlock()
+
+	metrics.RecordEndpointExclusion(target.Namespace, target.Name, source)
+	return exclusion, nil
+}
+
+// Remove lifts the exclusion of the given target. It returns false if the target was not excluded.
+func (s *Store) Remove(target types.NamespacedName) bool {
+	s.mu.Lock()
+	_, found := s.exclusions[target]
+	delete(s.exclusions, target)
+	s.mu.Unlock()
+
+	if found {
+		metrics.RecordEndpointExclusionLifted(target.Namespace, target.Name, metrics.EndpointExclusionRemoved)
+	}
+	return found
+}
+
+// List returns all active, non-expired exclusions ordered by target.
+func (s *Store) List() []Exclusion {
+	now := s.clock.Now()
+
+	s.mu.RLock()
+	res := make([]Exclusion, 0, len(s.exclusions))
+	for _, exclusion := range s.exclusions {
+		if now.Before(exclusion.ExpiresAt) {
+			res = append(res, exclusion)
+		}
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Target.String() < res[j].Target.String()
+	})
+	return res
+}
+
+// IsExcluded returns true if the endpoint, or the pod backing it, has an active exclusion.
+func (s *Store) IsExcluded(endpoint *fwkdl.EndpointMetadata) bool {
+	if endpoint == nil {
+		return false
+	}
+	now := s.clock.Now()
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if len(s.exclusions) == 0 {
+		return false
+	}
+	if exclusion, ok := s.exclusions[endpoint.NamespacedName]; ok && now.Before(exclusion.ExpiresAt) {
+		return true
+	}
+	podName := types.NamespacedName{Namespace: endpoint.NamespacedName.Namespace, Name: endpoint.PodName}
+	if exclusion, ok := s.exclusions[podName]; ok && now.Before(exclusion.ExpiresAt) {
+		return true
+	}
+	return false
+}
+
+// Run periodically removes expired exclusions until the context is cancelled.
+func (s *Store) Run(ctx context.Context) {
+	logger := log.FromContext(ctx).WithName("endpoint-exclusion")
+	ticker := s.clock.NewTicker(sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.V(logutil.DEFAULT).Info("Shutting down endpoint exclusion sweep")
+			return
+		case <-ticker.C():
+			for _, exclusion := range s.sweep() {
+				logger.V(logutil.DEFAULT).Info("Endpoint exclusion expired", "target", exclusion.Target,
+					"reason", exclusion.Reason, "source", exclusion.Source)
+			}
+		}
+	}
+}
+
+// sweep removes all expired exclusions and returns them.
+func (s *Store) sweep() []Exclusion {
+	now := s.clock.Now()
+
+	s.mu.Lock()
+	expired := []Exclusion{}
+	for target, exclusion := range s.exclusions {
+		if !now.Before(exclusion.ExpiresAt) {
+			expired = append(expired, exclusion)
+			delete(s.exclusions, target)
+		}
+	}
+	s.mu.Unlock()
+
+	for _, exclusion := range expired {
+		metrics.RecordEndpointExclusionLifted(exclusion.Target.Namespace, exclusion.Target.Name, metrics.EndpointExclusionExpired)
+	}
+	return expired
+}
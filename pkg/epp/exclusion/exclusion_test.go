@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exclusion
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	testclock "k8s.io/utils/clock/testing"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+func endpoint(pod string, rank string) *fwkdl.EndpointMetadata {
+	return &fwkdl.EndpointMetadata{
+		NamespacedName: types.NamespacedName{Namespace: "default", Name: pod + "-rank-" + rank},
+		PodName:        pod,
+	}
+}
+
+func TestStore_ExcludeAndExpire(t *testing.T) {
+	clk := testclock.NewFakeClock(time.Now())
+	store := newStoreWithClock(time.Hour, clk)
+
+	_, err := store.Exclude(types.NamespacedName{Namespace: "default", Name: "pod-a"}, time.Minute, "ECC errors", "gpu-health")
+	require.NoError(t, err)
+	_, err = store.Exclude(types.NamespacedName{Namespace: "default", Name: "pod-b-rank-1"}, 2*time.Minute, "drain", "")
+	require.NoError(t, err)
+
+	assert.True(t, store.IsExcluded(endpoint("pod-a", "0")), "all ranks of an excluded pod should be excluded")
+	assert.True(t, store.IsExcluded(endpoint("pod-a", "1")), "all ranks of an excluded pod should be excluded")
+	assert.False(t, store.IsExcluded(endpoint("pod-b", "0")), "only the excluded rank should be excluded")
+	assert.True(t, store.IsExcluded(endpoint("pod-b", "1")))
+	assert.False(t, store.IsExcluded(nil))
+	assert.Len(t, store.List(), 2)
+
+	clk.Step(time.Minute)
+	assert.False(t, store.IsExcluded(endpoint("pod-a", "0")), "exclusion should not be honored after expiry")
+	assert.Len(t, store.List(), 1, "expired exclusions should not be listed")
+
+	expired := store.sweep()
+	require.Len(t, expired, 1)
+	assert.Equal(t, "pod-a", expired[0].Target.Name)
+
+	assert.True(t, store.Remove(types.NamespacedName{Namespace: "default", Name: "pod-b-rank-1"}))
+	assert.False(t, store.Remove(types.NamespacedName{Namespace: "default", Name: "pod-b-rank-1"}))
+	assert.False(t, store.IsExcluded(endpoint("pod-b", "1")))
+	assert.Empty(t, store.List())
+}
+
+func TestStore_ExcludeValidation(t *testing.T) {
+	store := NewStore(10 * time.Minute)
+	target := types.NamespacedName{Namespace: "default", Name: "pod-a"}
+
+	tests := []struct {
+		name     string
+		target   types.NamespacedName
+		duration time.Duration
+		reason   string
+	}{
+		{name: "missing namespace", target: types.NamespacedName{Name: "pod-a"}, duration: time.Minute, reason: "r"},
+		{name: "non-positive duration", target: target, duration: 0, reason: "r"},
+		{name: "duration above maximum", target: target, duration: time.Hour, reason: "r"},
+		{name: "missing reason", target: target, duration: time.Minute},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := store.Exclude(test.target, test.duration, test.reason, "")
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestHandler(t *testing.T) {
+	store := NewStore(time.Hour)
+	handler := NewHandler(store)
+
+	serve := func(method, target, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(method, target, strings.NewReader(body)))
+		return rec
+	}
+
+	rec := serve(http.MethodPost, HandlerPath, `{"target":"default/pod-a","duration":"5m","reason":"ECC errors","source":"gpu-health"}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.True(t, store.IsExcluded(endpoint("pod-a", "0")))
+
+	rec = serve(http.MethodPost, HandlerPath, `{"target":"pod-a","duration":"5m","reason":"r"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = serve(http.MethodPost, HandlerPath, `{"target":"default/pod-a","duration":"forever","reason":"r"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = serve(http.MethodPost, HandlerPath, `{"target":"default/pod-a","duration":"5m","reason":"r","unknown":1}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = serve(http.MethodGet, HandlerPath, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "ECC errors")
+
+	rec = serve(http.MethodDelete, HandlerPath+"?target=default/pod-a", "")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.False(t, store.IsExcluded(endpoint("pod-a", "0")))
+	rec = serve(http.MethodDelete, HandlerPath+"?target=default/pod-a", "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = serve(http.MethodPut, HandlerPath, "")
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exclusion
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// HandlerPath is the path on which the exclusion admin API is served.
+	HandlerPath = "/admin/v1/endpoint-exclusions"
+
+	// maxRequestBytes bounds the size of an exclusion request body.
+	maxRequestBytes = 64 * 1024
+)
+
+// ExcludeRequest is the body of a POST request to the exclusion admin API.
+type ExcludeRequest struct {
+	// Target is the endpoint or pod to exclude, in the form "<namespace>/<name>".
+	Target string `json:"target"`
+	// Duration is the exclusion duration, in Go duration format (e.g. "90s", "10m").
+	Duration string `json:"duration"`
+	// Reason is a free-form, human readable explanation of the exclusion.
+	Reason string `json:"reason"`
+	// Source optionally identifies the system requesting the exclusion.
+	Source string `json:"source,omitempty"`
+}
+
+// NewHandler returns an http.Handler serving the exclusion admin API:
+//
+//	GET    - lists active exclusions.
+//	POST   - creates or replaces an exclusion, see ExcludeRequest.
+//	DELETE - lifts the exclusion of the target given by the "target" query parameter.
+func NewHandler(store *Store) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, store.List())
+		case http.MethodPost:
+			handleExclude(store, w, r)
+		case http.MethodDelete:
+			target, err := parseTarget(r.URL.Query().Get("target"))
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if !store.Remove(target) {
+				http.Error(w, fmt.Sprintf("no exclusion found for %q", target), http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.Header().Set("Allow", strings.Join([]string{http.MethodGet, http.MethodPost, http.MethodDelete}, ", "))
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+func handleExclude(store *Store, w http.ResponseWriter, r *http.Request) {
+	req := ExcludeRequest{}
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBytes))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("failed to decode request body - %v", err), http.StatusBadRequest)
+		return
+	}
+	target, err := parseTarget(req.Target)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	duration, err := time.ParseDuration(req.Duration)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid duration %q - %v", req.Duration, err), http.StatusBadRequest)
+		return
+	}
+	exclusion, err := store.Exclude(target, duration, req.Reason, req.Source)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	writeJSON(w, http.StatusOK, exclusion)
+}
+
+// parseTarget parses a "<namespace>/<name>" string into a NamespacedName.
+func parseTarget(target string) (types.NamespacedName, error) {
+	namespace, name, found := strings.Cut(target, "/")
+	if !found || namespace == "" || name == "" || strings.Contains(name, "/") {
+		return types.NamespacedName{}, fmt.Errorf("invalid target %q, expected <namespace>/<name>", target)
+	}
+	return types.NamespacedName{Namespace: namespace, Name: name}, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
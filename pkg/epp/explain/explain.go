@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package explain re-runs the scheduling cycle of a captured request against the current endpoints in dry-run mode, and
+// explains the decision: which endpoints each filter eliminated, how each scorer scored the rest, and which endpoint
+// was picked.
+package explain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	reqcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/request"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/contracts"
+	fwkrh "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling"
+)
+
+// Request is a captured request to explain the scheduling of.
+type Request struct {
+	// Headers are the request headers, e.g. the scheduling hints.
+	Headers map[string]string `json:"headers,omitempty"`
+	// Body is the request body, e.g. an OpenAI chat completions request.
+	Body string `json:"body"`
+	// Metadata is the Envoy metadata of the request, used to locate its candidate endpoints.
+	Metadata map[string]any `json:"metadata,omitempty"`
+}
+
+// Scheduler explains a scheduling decision.
+type Scheduler interface {
+	Explain(ctx context.Context, request *fwksched.InferenceRequest, candidateEndpoints []fwksched.Endpoint) *scheduling.DecisionAudit
+}
+
+// Explainer explains the scheduling decisions of captured requests.
+type Explainer struct {
+	parser     fwkrh.Parser
+	scheduler  Scheduler
+	candidates contracts.EndpointCandidates
+}
+
+// NewExplainer returns an Explainer parsing the requests with the given parser, and scheduling them with the given
+// scheduler on the current candidate endpoints.
+func NewExplainer(parser fwkrh.Parser, scheduler Scheduler, candidates contracts.EndpointCandidates) *Explainer {
+	return &Explainer{parser: parser, scheduler: scheduler, candidates: candidates}
+}
+
+// Explain parses the given request and returns the audit record of its dry-run scheduling. The model rewrites of the
+// pool are not applied: the request is scheduled for the model of its body.
+func (e *Explainer) Explain(ctx context.Context, request Request) (*scheduling.DecisionAudit, error) {
+	if request.Body == "" {
+		return nil, errors.New("the request body is required")
+	}
+	headers := request.Headers
+	if headers == nil {
+		headers = map[string]string{}
+	}
+	body, err := e.parser.ParseRequest(ctx, []byte(request.Body), headers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the request body - %w", err)
+	}
+	requestID := headers[reqcommon.RequestIdHeaderKey]
+	if requestID == "" {
+		requestID = "explain"
+	}
+	schedulingRequest := &fwksched.InferenceRequest{
+		RequestId:        requestID,
+		TargetModel:      modelName(body),
+		Body:             body,
+		Headers:          headers,
+		RequestSizeBytes: len(request.Body),
+	}
+
+	located := e.candidates.Locate(ctx, request.Metadata)
+	endpoints := make([]fwksched.Endpoint, len(located))
+	for i, endpoint := range located {
+		endpoints[i] = fwksched.NewEndpoint(endpoint.GetMetadata(), endpoint.GetMetrics(), endpoint.GetAttributes())
+	}
+	return e.scheduler.Explain(ctx, schedulingRequest, endpoints), nil
+}
+
+// modelName returns the model name of the given request body.
+func modelName(body *fwkrh.InferenceRequestBody) string {
+	if payload, ok := body.Payload.(fwkrh.PayloadMap); ok {
+		model, _ := payload["model"].(string)
+		return model
+	}
+	return body.Model
+}
+
+// Render writes the human-readable explanation of the given audit record.
+func Render(w io.Writer, audit *scheduling.DecisionAudit) {
+	fmt.Fprintf(w, "Request %s for model %q: %d candidate endpoints\n", audit.RequestID, audit.Model, len(audit.Candidates))
+	for _, name := range slices.Sorted(maps.Keys(audit.Profiles)) {
+		profile := audit.Profiles[name]
+		fmt.Fprintf(w, "\nProfile %q:\n", name)
+		remaining := len(audit.Candidates)
+		for _, filter := range profile.Filters {
+			remaining -= len(filter.Eliminated)
+			if len(filter.Eliminated) == 0 {
+				fmt.Fprintf(w, "  filter %s: eliminated none (%d remaining)\n", filter.Plugin, remaining)
+				continue
+			}
+			fmt.Fprintf(w, "  filter %s: eliminated %s (%d remaining)\n", filter.Plugin,
+				strings.Join(filter.Eliminated, ", "), remaining)
+		}
+		totals := map[string]float64{}
+		for _, scorer := range profile.Scorers {
+			fmt.Fprintf(w, "  scorer %s (weight %g):\n", scorer.Plugin, scorer.Weight)
+			for _, endpoint := range slices.Sorted(maps.Keys(scorer.Scores)) {
+				fmt.Fprintf(w, "    %s: %.3f\n", endpoint, scorer.Scores[endpoint])
+				totals[endpoint] += scorer.Scores[endpoint] * scorer.Weight
+			}
+		}
+		if len(totals) > 0 {
+			fmt.Fprintf(w, "  weighted scores:\n")
+			for _, endpoint := range slices.Sorted(maps.Keys(totals)) {
+				fmt.Fprintf(w, "    %s: %.3f\n", endpoint, totals[endpoint])
+			}
+		}
+		if len(profile.Picked) > 0 {
+			fmt.Fprintf(w, "  picked: %s\n", strings.Join(profile.Picked, ", "))
+		}
+	}
+
+	fmt.Fprintln(w)
+	if audit.Error != "" {
+		fmt.Fprintf(w, "Scheduling failed: %s\n", audit.Error)
+		return
+	}
+	fmt.Fprintf(w, "Chosen endpoint: %s\n", audit.Chosen)
+}
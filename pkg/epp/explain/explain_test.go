@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package explain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requesthandling/parsers/openai"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling"
+)
+
+const completionsBody = `{"model": "llama", "prompt": "hello"}`
+
+type fakeCandidates struct {
+	endpoints []fwkdl.Endpoint
+}
+
+func (c fakeCandidates) Locate(_ context.Context, _ map[string]any) []fwkdl.Endpoint {
+	return c.endpoints
+}
+
+// recordingScheduler returns a fixed audit record, recording the request and the endpoints it explained.
+type recordingScheduler struct {
+	request   *fwksched.InferenceRequest
+	endpoints []fwksched.Endpoint
+}
+
+func (s *recordingScheduler) Explain(_ context.Context, request *fwksched.InferenceRequest,
+	endpoints []fwksched.Endpoint) *scheduling.DecisionAudit {
+	s.request, s.endpoints = request, endpoints
+	return testAudit(request.RequestId)
+}
+
+func testAudit(requestID string) *scheduling.DecisionAudit {
+	return &scheduling.DecisionAudit{
+		RequestID:  requestID,
+		Model:      "llama",
+		Candidates: []string{"default/pod1", "default/pod2", "default/pod3"},
+		Profiles: map[string]*scheduling.ProfileAudit{
+			"default": {
+				Filters: []scheduling.FilterAudit{{Plugin: "lora/lora-filter", Eliminated: []string{"default/pod3"}}},
+				Scorers: []scheduling.ScorerAudit{
+					{Plugin: "kv/kv-cache-scorer", Weight: 2, Scores: map[string]float64{"default/pod1": 0.5, "default/pod2": 0.25}},
+					{Plugin: "queue/queue-scorer", Weight: 1, Scores: map[string]float64{"default/pod1": 0, "default/pod2": 1}},
+				},
+				Picked: []string{"default/pod1"},
+			},
+		},
+		Chosen: "default/pod1",
+	}
+}
+
+func newTestExplainer() (*Explainer, *recordingScheduler) {
+	scheduler := &recordingScheduler{}
+	candidates := fakeCandidates{endpoints: []fwkdl.Endpoint{
+		fwkdl.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: types.NamespacedName{Namespace: "default", Name: "pod1"}}, nil),
+		fwkdl.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: types.NamespacedName{Namespace: "default", Name: "pod2"}}, nil),
+	}}
+	return NewExplainer(openai.NewOpenAIParser(), scheduler, candidates), scheduler
+}
+
+func TestExplain(t *testing.T) {
+	explainer, scheduler := newTestExplainer()
+
+	audit, err := explainer.Explain(context.Background(), Request{
+		Headers: map[string]string{"x-request-id": "req-1"},
+		Body:    completionsBody,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "req-1", audit.RequestID)
+	require.NotNil(t, scheduler.request)
+	assert.Equal(t, "llama", scheduler.request.TargetModel)
+	assert.Equal(t, len(completionsBody), scheduler.request.RequestSizeBytes)
+	assert.Len(t, scheduler.endpoints, 2)
+
+	_, err = explainer.Explain(context.Background(), Request{Body: completionsBody})
+	require.NoError(t, err)
+	assert.Equal(t, "explain", scheduler.request.RequestId, "requests without an ID must be given one")
+
+	_, err = explainer.Explain(context.Background(), Request{})
+	assert.Error(t, err)
+	_, err = explainer.Explain(context.Background(), Request{Body: "not json"})
+	assert.Error(t, err)
+}
+
+func TestRender(t *testing.T) {
+	var buf bytes.Buffer
+	Render(&buf, testAudit("req-1"))
+	assert.Equal(t, `Request req-1 for model "llama": 3 candidate endpoints
+
+Profile "default":
+  filter lora/lora-filter: eliminated default/pod3 (2 remaining)
+  scorer kv/kv-cache-scorer (weight 2):
+    default/pod1: 0.500
+    default/pod2: 0.250
+  scorer queue/queue-scorer (weight 1):
+    default/pod1: 0.000
+    default/pod2: 1.000
+  weighted scores:
+    default/pod1: 1.000
+    default/pod2: 1.500
+  picked: default/pod1
+
+Chosen endpoint: default/pod1
+`, buf.String())
+
+	buf.Reset()
+	Render(&buf, &scheduling.DecisionAudit{RequestID: "req-2", Error: "no endpoints available"})
+	assert.Contains(t, buf.String(), "Scheduling failed: no endpoints available")
+}
+
+func TestHandler(t *testing.T) {
+	explainer, _ := newTestExplainer()
+	handler := NewHandler(explainer)
+	encoded, err := json.Marshal(Request{Headers: map[string]string{"x-request-id": "req-1"}, Body: completionsBody})
+	require.NoError(t, err)
+	body := string(encoded)
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, HandlerPath, strings.NewReader(body)))
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "Chosen endpoint: default/pod1")
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, HandlerPath+"?format=json", strings.NewReader(body)))
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"chosen":"default/pod1"`)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, HandlerPath, strings.NewReader(`{"unknown": 1}`)))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, HandlerPath, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package featuregate provides the feature gates of the EPP, which let experimental subsystems ship disabled by
+// default and be toggled per deployment, through the featureGates section of the configuration or the --feature-gates
+// flag.
+//
+// Subsystems register their gates at startup, before the configuration is loaded, and check them with Enabled.
+package featuregate
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Stage is the maturity of a feature.
+type Stage string
+
+const (
+	// Alpha features are experimental, disabled by default, and may change or be removed without notice.
+	Alpha Stage = "Alpha"
+	// Beta features are well tested and may be enabled by default.
+	Beta Stage = "Beta"
+	// Deprecated features will be removed in a future release.
+	Deprecated Stage = "Deprecated"
+)
+
+// Spec describes a feature gate.
+type Spec struct {
+	// Default is whether the feature is enabled when neither the configuration nor the flags set its gate.
+	Default bool
+	// Stage is the maturity of the feature.
+	Stage Stage
+}
+
+var (
+	mu sync.RWMutex
+	// specs are the registered gates.
+	specs = map[string]Spec{}
+	// overrides are the gates set by the --feature-gates flag, which take precedence over the configuration.
+	overrides = map[string]bool{}
+	// applied is the state of the gates resolved from the loaded configuration, nil until a configuration is loaded.
+	applied map[string]bool
+)
+
+// Register registers a feature gate. Registering a gate again replaces its spec.
+func Register(name string, spec Spec) {
+	mu.Lock()
+	defer mu.Unlock()
+	specs[name] = spec
+}
+
+// Lookup returns the spec of the given gate, and whether it is registered.
+func Lookup(name string) (Spec, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	spec, ok := specs[name]
+	return spec, ok
+}
+
+// Registered returns the names of the registered gates, sorted.
+func Registered() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	names := make([]string, 0, len(specs))
+	for name := range specs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// ParseGate parses a gate setting of the form "name", which enables the gate, or "name=true|false".
+func ParseGate(setting string) (string, bool, error) {
+	name, value, found := strings.Cut(strings.TrimSpace(setting), "=")
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", false, fmt.Errorf("missing feature gate name in %q", setting)
+	}
+	if !found {
+		return name, true, nil
+	}
+	enabled, err := strconv.ParseBool(strings.TrimSpace(value))
+	if err != nil {
+		return "", false, fmt.Errorf("invalid value of feature gate %q: %w", name, err)
+	}
+	return name, enabled, nil
+}
+
+// ParseOverrides parses the value of the --feature-gates flag, a comma-separated list of gate settings, e.g.
+// "flowControl=true,enableLegacyMetrics=false". Gate names are not checked against the registered gates.
+func ParseOverrides(value string) (map[string]bool, error) {
+	gates := map[string]bool{}
+	if strings.TrimSpace(value) == "" {
+		return gates, nil
+	}
+	for setting := range strings.SplitSeq(value, ",") {
+		name, enabled, err := ParseGate(setting)
+		if err != nil {
+			return nil, err
+		}
+		gates[name] = enabled
+	}
+	return gates, nil
+}
+
+// SetOverrides sets the gates set by the --feature-gates flag. It fails if a gate is not registered.
+func SetOverrides(gates map[string]bool) error {
+	mu.Lock()
+	defer mu.Unlock()
+	for name := range gates {
+		if _, ok := specs[name]; !ok {
+			return fmt.Errorf("feature gate '%s' is unknown or unregistered", name)
+		}
+	}
+	overrides = make(map[string]bool, len(gates))
+	for name, enabled := range gates {
+		overrides[name] = enabled
+	}
+	return nil
+}
+
+// Validate checks that the given gate settings, from the featureGates section of the configuration, are well formed
+// and refer to registered gates.
+func Validate(settings []string) error {
+	mu.RLock()
+	defer mu.RUnlock()
+	for _, setting := range settings {
+		name, _, err := ParseGate(setting)
+		if err != nil {
+			return err
+		}
+		if _, ok := specs[name]; !ok {
+			return fmt.Errorf("feature gate '%s' is unknown or unregistered", name)
+		}
+	}
+	return nil
+}
+
+// Resolve returns the state of all the registered gates given the settings of the featureGates section of the
+// configuration: the default of each gate, overridden by the configuration, itself overridden by the flags. Invalid
+// settings are ignored; they are reported by Validate.
+func Resolve(settings []string) map[string]bool {
+	mu.RLock()
+	defer mu.RUnlock()
+	gates := make(map[string]bool, len(specs))
+	for name, spec := range specs {
+		gates[name] = spec.Default
+	}
+	for _, setting := range settings {
+		if name, enabled, err := ParseGate(setting); err == nil {
+			gates[name] = enabled
+		}
+	}
+	for name, enabled := range overrides {
+		gates[name] = enabled
+	}
+	return gates
+}
+
+// Apply sets the state of the gates returned by Enabled, once resolved from the loaded configuration.
+func Apply(gates map[string]bool) {
+	mu.Lock()
+	defer mu.Unlock()
+	applied = make(map[string]bool, len(gates))
+	for name, enabled := range gates {
+		applied[name] = enabled
+	}
+}
+
+// Enabled returns whether the given feature is enabled. Before a configuration is loaded, it returns the default of
+// the gate, overridden by the flags. Unregistered gates are disabled.
+func Enabled(name string) bool {
+	mu.RLock()
+	defer mu.RUnlock()
+	if enabled, ok := applied[name]; ok {
+		return enabled
+	}
+	if enabled, ok := overrides[name]; ok {
+		return enabled
+	}
+	return specs[name].Default
+}
+
+// reset clears the registered gates and their state, for tests.
+func reset() {
+	mu.Lock()
+	defer mu.Unlock()
+	specs = map[string]Spec{}
+	overrides = map[string]bool{}
+	applied = nil
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package featuregate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOverrides(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    map[string]bool
+		wantErr bool
+	}{
+		{name: "empty", value: "", want: map[string]bool{}},
+		{name: "bare name enables", value: "a", want: map[string]bool{"a": true}},
+		{name: "values", value: "a=true, b=false", want: map[string]bool{"a": true, "b": false}},
+		{name: "missing name", value: "=true", wantErr: true},
+		{name: "invalid value", value: "a=maybe", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseOverrides(test.value)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	t.Cleanup(reset)
+	reset()
+	Register("alpha", Spec{Stage: Alpha})
+	Register("beta", Spec{Default: true, Stage: Beta})
+	Register("other", Spec{Stage: Alpha})
+
+	assert.Equal(t, []string{"alpha", "beta", "other"}, Registered())
+	assert.False(t, Enabled("alpha"))
+	assert.True(t, Enabled("beta"), "defaults apply before a configuration is loaded")
+	assert.False(t, Enabled("unknown"))
+
+	assert.NoError(t, Validate([]string{"alpha", "beta=false"}))
+	assert.Error(t, Validate([]string{"unknown"}))
+	assert.Error(t, Validate([]string{"alpha=maybe"}))
+
+	assert.Equal(t, map[string]bool{"alpha": true, "beta": false, "other": false},
+		Resolve([]string{"alpha", "beta=false"}))
+
+	assert.Error(t, SetOverrides(map[string]bool{"unknown": true}))
+	require.NoError(t, SetOverrides(map[string]bool{"alpha": false, "other": true}))
+	assert.True(t, Enabled("other"), "flags apply before a configuration is loaded")
+	gates := Resolve([]string{"alpha", "beta=false"})
+	assert.Equal(t, map[string]bool{"alpha": false, "beta": false, "other": true}, gates,
+		"flags take precedence over the configuration")
+
+	Apply(gates)
+	assert.False(t, Enabled("alpha"))
+	assert.False(t, Enabled("beta"))
+	assert.True(t, Enabled("other"))
+}
@@ -183,6 +183,7 @@ func (r *benchRequest) FlowKey() flowcontrol.FlowKey                   { return
 func (r *benchRequest) ByteSize() uint64                               { return r.byteSize }
 func (r *benchRequest) InitialEffectiveTTL() time.Duration             { return 5 * time.Minute }
 func (r *benchRequest) ID() string                                     { return "bench-req" }
+func (r *benchRequest) FamilyID() string                               { return "" }
 func (r *benchRequest) GetMetadata() map[string]any                    { return nil }
 func (r *benchRequest) InferencePoolName() string                      { return "bench-pool" }
 func (r *benchRequest) ModelName() string                              { return "bench-model" }
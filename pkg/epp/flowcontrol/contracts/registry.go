@@ -17,6 +17,8 @@ limitations under the License.
 package contracts
 
 import (
+	"time"
+
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/flowcontrol"
 )
 
@@ -58,6 +60,9 @@ type FlowRegistryObserver interface {
 
 	// ShardStats returns a near-consistent slice of statistics snapshots, one for each `RegistryShard`.
 	ShardStats() []ShardStats
+
+	// QueuedItems returns a near-consistent snapshot of the items currently queued across all shards.
+	QueuedItems() []QueuedItem
 }
 
 // FlowRegistryDataPlane defines the high-throughput, request-path interface for the registry.
@@ -213,6 +218,19 @@ type ShardStats struct {
 	PerPriorityBandStats map[int]PriorityBandStats
 }
 
+// QueuedItem describes an item currently queued in the `FlowRegistry`.
+// It is a read-only data object representing a snapshot of the item at the time it was taken.
+type QueuedItem struct {
+	// RequestID is the ID of the queued request.
+	RequestID string
+	// FlowKey identifies the flow the item is queued in, i.e. its fairness ID and priority.
+	FlowKey flowcontrol.FlowKey
+	// EnqueueTime is the time at which the item entered the Flow Control layer.
+	EnqueueTime time.Time
+	// ByteSize is the byte size of the item.
+	ByteSize uint64
+}
+
 // PriorityBandStats holds aggregated statistics for a single priority band.
 // It is a read-only data object representing a near-consistent snapshot of the priority band's state.
 type PriorityBandStats struct {
@@ -30,6 +30,8 @@ const (
 	defaultProcessorReconciliationInterval = 5 * time.Second
 	// defaultEnqueueChannelBufferSize is the default size of a worker's incoming request buffer.
 	defaultEnqueueChannelBufferSize = 100
+	// defaultFamilyIdleTimeout is the default duration without requests after which a request family is forgotten.
+	defaultFamilyIdleTimeout = 10 * time.Minute
 )
 
 // Config holds the configuration for the `FlowController`.
@@ -39,7 +41,8 @@ type Config struct {
 	// Optional: If zero, no TTL is applied by default and we rely solely on request context cancellation.
 	DefaultRequestTTL time.Duration
 
-	// ExpiryCleanupInterval is the interval at which each shard processor scans its queues for expired items.
+	// ExpiryCleanupInterval is the interval at which each shard processor scans its queues for expired items, and
+	// records the saturation of the slices of the pool.
 	// Optional: Defaults to `defaultExpiryCleanupInterval` (1 second).
 	ExpiryCleanupInterval time.Duration
 
@@ -53,6 +56,38 @@ type Config struct {
 	// serial execution loop and allowing the system to handle short bursts of traffic without blocking.
 	// Optional: Defaults to `defaultEnqueueChannelBufferSize` (100).
 	EnqueueChannelBufferSize int
+
+	// FamilyBudget bounds the capacity consumed by each family of requests.
+	// Optional: The zero value does not bound request families.
+	FamilyBudget FamilyBudget
+
+	// StarvationThreshold is the queueing time after which a request is dispatched ahead of the requests of the higher
+	// priority bands, protecting the lower priority bands from starvation.
+	// Optional: If zero, requests are dispatched strictly by priority.
+	StarvationThreshold time.Duration
+
+	// Preemption lets a request arriving while the queues are at capacity evict the newest queued requests of the
+	// sheddable priority bands below its own.
+	// Optional: If false, the requests arriving while the queues are at capacity are rejected.
+	Preemption bool
+}
+
+// FamilyBudget is the budget shared by the requests of a family, e.g. the calls fanned out by a single agent task.
+// Requests without a family ID are not bounded.
+type FamilyBudget struct {
+	// MaxTokens is the maximum number of prompt tokens admitted for a family. Zero means unbounded.
+	MaxTokens int64
+	// MaxDuration is the duration, from the first request of a family, after which its requests are rejected. Zero means
+	// unbounded.
+	MaxDuration time.Duration
+	// IdleTimeout is the duration without requests after which a family is forgotten, and its budget reset.
+	// Optional: If zero, `defaultFamilyIdleTimeout` (10 minutes) is used.
+	IdleTimeout time.Duration
+}
+
+// enabled returns whether the budget bounds the request families.
+func (b FamilyBudget) enabled() bool {
+	return b.MaxTokens > 0 || b.MaxDuration > 0
 }
 
 // ConfigOption is a functional option for configuring the FlowController.
@@ -65,6 +100,25 @@ func NewConfigFromAPI(apiConfig *configapi.FlowControlConfig) (*Config, error) {
 		if apiConfig.DefaultRequestTTL != nil {
 			opts = append(opts, WithDefaultRequestTTL(apiConfig.DefaultRequestTTL.Duration))
 		}
+		if apiBudget := apiConfig.RequestFamilyBudget; apiBudget != nil {
+			budget := FamilyBudget{}
+			if apiBudget.MaxTokens != nil {
+				budget.MaxTokens = *apiBudget.MaxTokens
+			}
+			if apiBudget.MaxDuration != nil {
+				budget.MaxDuration = apiBudget.MaxDuration.Duration
+			}
+			if apiBudget.IdleTimeout != nil {
+				budget.IdleTimeout = apiBudget.IdleTimeout.Duration
+			}
+			opts = append(opts, WithFamilyBudget(budget))
+		}
+		if apiConfig.StarvationThreshold != nil {
+			opts = append(opts, WithStarvationThreshold(apiConfig.StarvationThreshold.Duration))
+		}
+		if apiConfig.Preemption != nil {
+			opts = append(opts, WithPreemption(*apiConfig.Preemption))
+		}
 	}
 	return NewConfig(opts...)
 }
@@ -115,6 +169,27 @@ func WithEnqueueChannelBufferSize(size int) ConfigOption {
 	}
 }
 
+// WithFamilyBudget sets the budget shared by the requests of a family.
+func WithFamilyBudget(budget FamilyBudget) ConfigOption {
+	return func(c *Config) {
+		c.FamilyBudget = budget
+	}
+}
+
+// WithStarvationThreshold sets the queueing time after which a request is dispatched ahead of the higher priority bands.
+func WithStarvationThreshold(d time.Duration) ConfigOption {
+	return func(c *Config) {
+		c.StarvationThreshold = d
+	}
+}
+
+// WithPreemption sets whether the requests arriving while the queues are at capacity preempt queued sheddable requests.
+func WithPreemption(enabled bool) ConfigOption {
+	return func(c *Config) {
+		c.Preemption = enabled
+	}
+}
+
 // validate checks the configuration for validity.
 func (c *Config) validate() error {
 	if c.DefaultRequestTTL < 0 {
@@ -129,5 +204,17 @@ func (c *Config) validate() error {
 	if c.EnqueueChannelBufferSize < 0 {
 		return fmt.Errorf("EnqueueChannelBufferSize cannot be negative, but got %d", c.EnqueueChannelBufferSize)
 	}
+	if c.FamilyBudget.MaxTokens < 0 {
+		return fmt.Errorf("FamilyBudget.MaxTokens cannot be negative, but got %d", c.FamilyBudget.MaxTokens)
+	}
+	if c.FamilyBudget.MaxDuration < 0 {
+		return fmt.Errorf("FamilyBudget.MaxDuration cannot be negative, but got %v", c.FamilyBudget.MaxDuration)
+	}
+	if c.FamilyBudget.IdleTimeout < 0 {
+		return fmt.Errorf("FamilyBudget.IdleTimeout cannot be negative, but got %v", c.FamilyBudget.IdleTimeout)
+	}
+	if c.StarvationThreshold < 0 {
+		return fmt.Errorf("StarvationThreshold cannot be negative, but got %v", c.StarvationThreshold)
+	}
 	return nil
 }
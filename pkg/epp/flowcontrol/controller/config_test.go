@@ -23,6 +23,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	configapi "sigs.k8s.io/gateway-api-inference-extension/apix/config/v1alpha1"
 )
@@ -104,6 +105,20 @@ func TestNewConfig(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "NegativeFamilyBudget_ShouldError",
+			opts: []ConfigOption{
+				WithFamilyBudget(FamilyBudget{MaxTokens: -1}),
+			},
+			expectErr: true,
+		},
+		{
+			name: "NegativeStarvationThreshold_ShouldError",
+			opts: []ConfigOption{
+				WithStarvationThreshold(-time.Second),
+			},
+			expectErr: true,
+		},
 		{
 			name: "InvalidEnqueueChannelBufferSize_ShouldError",
 			opts: []ConfigOption{
@@ -183,6 +198,36 @@ func TestNewConfigFromAPI(t *testing.T) {
 				assert.Equal(t, time.Duration(0), cfg.DefaultRequestTTL, "Explicit 0s TTL should be respected")
 			},
 		},
+		{
+			name: "RequestFamilyBudget_ShouldBeTranslated",
+			apiConfig: &configapi.FlowControlConfig{
+				RequestFamilyBudget: &configapi.RequestFamilyBudgetConfig{
+					MaxTokens:   ptr.To[int64](100_000),
+					MaxDuration: &metav1.Duration{Duration: 5 * time.Minute},
+				},
+			},
+			assertion: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, FamilyBudget{MaxTokens: 100_000, MaxDuration: 5 * time.Minute}, cfg.FamilyBudget)
+			},
+		},
+		{
+			name: "StarvationThreshold_ShouldBeTranslated",
+			apiConfig: &configapi.FlowControlConfig{
+				StarvationThreshold: &metav1.Duration{Duration: 30 * time.Second},
+			},
+			assertion: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, 30*time.Second, cfg.StarvationThreshold)
+			},
+		},
+		{
+			name: "Preemption_ShouldBeTranslated",
+			apiConfig: &configapi.FlowControlConfig{
+				Preemption: ptr.To(true),
+			},
+			assertion: func(t *testing.T, cfg *Config) {
+				assert.True(t, cfg.Preemption)
+			},
+		},
 		{
 			name: "InvalidConfig_NegativeRequestTTL_ShouldError",
 			apiConfig: &configapi.FlowControlConfig{
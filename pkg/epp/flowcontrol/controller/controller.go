@@ -118,6 +118,9 @@ type FlowController struct {
 	// It is the controller's source of truth for the worker pool.
 	workers sync.Map // key: shard ID (string); value: *managedWorker
 
+	// families enforces the budget shared by the requests of a family.
+	families *familyBudgets
+
 	// wg waits for all worker goroutines to terminate during shutdown.
 	wg sync.WaitGroup
 }
@@ -151,6 +154,7 @@ func NewFlowController(
 		clock:              deps.Clock,
 		logger:             log.FromContext(ctx).WithName("flow-controller"),
 		parentCtx:          ctx,
+		families:           newFamilyBudgets(config.FamilyBudget, deps.Clock),
 	}
 
 	fc.shardProcessorFactory = func(
@@ -164,7 +168,7 @@ func NewFlowController(
 		enqueueChannelBufferSize int,
 		logger logr.Logger,
 	) shardProcessor {
-		return internal.NewShardProcessor(
+		processor := internal.NewShardProcessor(
 			ctx,
 			poolName,
 			shard,
@@ -176,6 +180,9 @@ func NewFlowController(
 			enqueueChannelBufferSize,
 			logger,
 		)
+		processor.SetStarvationThreshold(config.StarvationThreshold)
+		processor.SetPreemption(config.Preemption)
+		return processor
 	}
 
 	go fc.run(ctx)
@@ -199,6 +206,7 @@ func (fc *FlowController) run(ctx context.Context) {
 			return
 		case <-ticker.C():
 			fc.reconcileProcessors()
+			fc.families.sweep()
 		}
 	}
 }
@@ -242,6 +250,13 @@ func (fc *FlowController) EnqueueAndWait(
 		req.InferencePoolName(),
 		req.ModelName(), req.TargetModelName(), reqBytes)
 
+	// Charge the request to the budget of its family before it takes any capacity. The charge is refunded unless the
+	// request is dispatched.
+	refund, err := fc.families.reserve(req)
+	if err != nil {
+		return types.QueueOutcomeRejectedCapacity, fmt.Errorf("%w: %w", types.ErrRejected, err)
+	}
+
 	// 1. Create the derived context that governs this request's lifecycle (Parent Cancellation + TTL).
 	reqCtx, cancel, enqueueTime := fc.createRequestContext(ctx, req)
 	defer cancel()
@@ -250,7 +265,7 @@ func (fc *FlowController) EnqueueAndWait(
 
 	// 2. Acquire a lease for the Flow.
 	// We hold this lease for the entire duration of the request (Distribution + Queueing).
-	err := fc.registry.WithConnection(flowKey, func(conn contracts.ActiveFlowConnection) error {
+	err = fc.registry.WithConnection(flowKey, func(conn contracts.ActiveFlowConnection) error {
 		// 3. Enter the distribution loop to find a home for the request.
 		// This loop is responsible for retrying on ErrShardDraining.
 		// We can safely retry within this loop using the same 'conn' object because conn.ActiveShards() provides a live
@@ -298,12 +313,25 @@ func (fc *FlowController) EnqueueAndWait(
 	// return a valid rejection outcome.
 	// In the success case (where the closure ran), finalOutcome is set inside the closure.
 	if err != nil && finalOutcome == types.QueueOutcomeNotYetFinalized {
-		return types.QueueOutcomeRejectedOther, fmt.Errorf("%w: %w", types.ErrRejected, err)
+		finalOutcome, err = types.QueueOutcomeRejectedOther, fmt.Errorf("%w: %w", types.ErrRejected, err)
+	}
+	if finalOutcome != types.QueueOutcomeDispatched {
+		refund()
 	}
 
 	return finalOutcome, err
 }
 
+// RecordUsage accounts the tokens actually consumed by a dispatched request, as reported in its response: they are
+// recorded per flow, and replace the estimate charged to the budget of its family, which is otherwise only estimated
+// from the request size.
+func (fc *FlowController) RecordUsage(req flowcontrol.FlowControlRequest, promptTokens, completionTokens int) {
+	flowKey := req.FlowKey()
+	metrics.RecordFlowControlTokens(flowKey.ID, strconv.Itoa(flowKey.Priority), req.InferencePoolName(), req.ModelName(),
+		req.TargetModelName(), promptTokens, completionTokens)
+	fc.families.settle(req, int64(promptTokens+completionTokens))
+}
+
 var errNoShards = errors.New("no viable active shards available")
 
 // tryDistribution handles a single attempt to select a shard and submit a request.
@@ -324,7 +352,7 @@ func (fc *FlowController) tryDistribution(
 	}
 
 	// We must create a fresh FlowItem on each attempt as finalization is per-lifecycle.
-	item := internal.NewItem(req, effectiveTTL, enqueueTime)
+	item := internal.NewItem(req, effectiveTTL, enqueueTime, fc.clock)
 
 	candidates, err := fc.selectDistributionCandidates(conn)
 	if err != nil {
@@ -412,6 +412,21 @@ func TestFlowController_EnqueueAndWait(t *testing.T) {
 				"outcome should be QueueOutcomeRejectedCapacity when no shards exist for the flow")
 		})
 
+		t.Run("OnFamilyBudgetExhausted", func(t *testing.T) {
+			t.Parallel()
+			h := newUnitHarness(t, t.Context(), &Config{FamilyBudget: FamilyBudget{MaxTokens: 100}}, nil)
+
+			req := newTestRequest(defaultFlowKey)
+			req.FamilyIDV = "task-1"
+			req.ByteSizeV = 800 // ~200 tokens.
+			outcome, err := h.fc.EnqueueAndWait(context.Background(), req)
+			require.Error(t, err, "EnqueueAndWait must reject requests exceeding the budget of their family")
+			assert.ErrorIs(t, err, types.ErrRejected, "error should wrap ErrRejected")
+			assert.ErrorIs(t, err, types.ErrFamilyBudgetExhausted, "error should wrap ErrFamilyBudgetExhausted")
+			assert.Equal(t, types.QueueOutcomeRejectedCapacity, outcome,
+				"outcome should be QueueOutcomeRejectedCapacity when the family budget is exhausted")
+		})
+
 		t.Run("OnRegistryConnectionError", func(t *testing.T) {
 			t.Parallel()
 			mockRegistry := &mockRegistryClient{}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/types"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/flowcontrol"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+const (
+	familyRejectionReasonTokens   = "tokens"
+	familyRejectionReasonDuration = "duration"
+)
+
+// family is the budget consumed by a family of requests.
+type family struct {
+	// firstSeen is the time the first request of the family was received.
+	firstSeen time.Time
+	// lastSeen is the time the last request of the family was received.
+	lastSeen time.Time
+	// tokens are the tokens of the admitted requests of the family, including the ones still queued: the estimated
+	// prompt tokens until the response reports the prompt and completion tokens actually consumed.
+	tokens int64
+}
+
+// familyBudgets enforces the FamilyBudget of the request families, so that a single agent task fanning out into many
+// calls cannot consume unbounded pool capacity.
+type familyBudgets struct {
+	budget FamilyBudget
+	clock  clock.PassiveClock
+
+	mu       sync.Mutex
+	families map[string]*family
+}
+
+func newFamilyBudgets(budget FamilyBudget, clk clock.PassiveClock) *familyBudgets {
+	if budget.IdleTimeout == 0 {
+		budget.IdleTimeout = defaultFamilyIdleTimeout
+	}
+	return &familyBudgets{budget: budget, clock: clk, families: map[string]*family{}}
+}
+
+// reserve charges the estimated prompt tokens of the request to the budget of its family. It returns a function
+// refunding them, to be called if the request is not dispatched, or an error wrapping types.ErrFamilyBudgetExhausted if
+// the family exhausted its budget. Requests without a family are not charged.
+func (f *familyBudgets) reserve(req flowcontrol.FlowControlRequest) (func(), error) {
+	id := req.FamilyID()
+	if id == "" || !f.budget.enabled() {
+		return func() {}, nil
+	}
+	tokens := estimateTokens(req)
+	now := f.clock.Now()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	fam, ok := f.families[id]
+	if !ok || now.Sub(fam.lastSeen) > f.budget.IdleTimeout {
+		fam = &family{firstSeen: now}
+		f.families[id] = fam
+	}
+	fam.lastSeen = now
+
+	if f.budget.MaxDuration > 0 && now.Sub(fam.firstSeen) > f.budget.MaxDuration {
+		metrics.RecordFlowControlFamilyBudgetRejection(req.InferencePoolName(), familyRejectionReasonDuration)
+		return nil, fmt.Errorf("%w: family %q exceeded its duration of %s", types.ErrFamilyBudgetExhausted, id,
+			f.budget.MaxDuration)
+	}
+	if f.budget.MaxTokens > 0 && fam.tokens+tokens > f.budget.MaxTokens {
+		metrics.RecordFlowControlFamilyBudgetRejection(req.InferencePoolName(), familyRejectionReasonTokens)
+		return nil, fmt.Errorf("%w: family %q would exceed its budget of %d tokens", types.ErrFamilyBudgetExhausted, id,
+			f.budget.MaxTokens)
+	}
+	fam.tokens += tokens
+
+	return func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		fam.tokens -= tokens
+	}, nil
+}
+
+// settle replaces the estimated prompt tokens charged to the family of the dispatched request with the tokens it
+// actually consumed, prompt and completion, once they are reported in its response.
+func (f *familyBudgets) settle(req flowcontrol.FlowControlRequest, actualTokens int64) {
+	id := req.FamilyID()
+	if id == "" || !f.budget.enabled() {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if fam, ok := f.families[id]; ok {
+		fam.tokens += actualTokens - estimateTokens(req)
+	}
+}
+
+// sweep forgets the families idle for longer than the idle timeout.
+func (f *familyBudgets) sweep() {
+	now := f.clock.Now()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for id, fam := range f.families {
+		if now.Sub(fam.lastSeen) > f.budget.IdleTimeout {
+			delete(f.families, id)
+		}
+	}
+}
+
+// estimateTokens estimates the prompt tokens of the request, from the token count hint of its body if any, otherwise at
+// ~4 bytes per token.
+func estimateTokens(req flowcontrol.FlowControlRequest) int64 {
+	if inferenceRequest := req.InferenceRequest(); inferenceRequest != nil && inferenceRequest.Body != nil {
+		if hint := inferenceRequest.Body.InputTokenCountHint(); hint >= 0 {
+			return int64(hint)
+		}
+	}
+	return int64(req.ByteSize() / 4)
+}
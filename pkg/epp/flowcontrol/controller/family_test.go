@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	testclock "k8s.io/utils/clock/testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/types"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/flowcontrol/mocks"
+)
+
+func newFamilyRequest(familyID string, tokens uint64) *mocks.MockFlowControlRequest {
+	req := newTestRequest(defaultFlowKey)
+	req.FamilyIDV = familyID
+	req.ByteSizeV = tokens * 4
+	return req
+}
+
+func TestFamilyBudgets_Tokens(t *testing.T) {
+	t.Parallel()
+	clk := testclock.NewFakeClock(time.Now())
+	families := newFamilyBudgets(FamilyBudget{MaxTokens: 100}, clk)
+
+	refund, err := families.reserve(newFamilyRequest("task-1", 60))
+	require.NoError(t, err)
+	_, err = families.reserve(newFamilyRequest("task-1", 60))
+	assert.ErrorIs(t, err, types.ErrFamilyBudgetExhausted, "the family should not exceed its token budget")
+
+	_, err = families.reserve(newFamilyRequest("task-2", 60))
+	assert.NoError(t, err, "families should have separate budgets")
+	_, err = families.reserve(newFamilyRequest("", 1000))
+	assert.NoError(t, err, "requests without a family should not be bounded")
+
+	refund()
+	_, err = families.reserve(newFamilyRequest("task-1", 60))
+	assert.NoError(t, err, "refunded tokens should be available again")
+}
+
+func TestFamilyBudgets_Settle(t *testing.T) {
+	t.Parallel()
+	clk := testclock.NewFakeClock(time.Now())
+	families := newFamilyBudgets(FamilyBudget{MaxTokens: 100}, clk)
+
+	first := newFamilyRequest("task-1", 60)
+	_, err := families.reserve(first)
+	require.NoError(t, err)
+	families.settle(first, 20)
+	second := newFamilyRequest("task-1", 60)
+	_, err = families.reserve(second)
+	assert.NoError(t, err, "tokens overestimated at admission should be available again once settled")
+
+	families.settle(second, 90)
+	_, err = families.reserve(newFamilyRequest("task-1", 1))
+	assert.ErrorIs(t, err, types.ErrFamilyBudgetExhausted,
+		"the prompt and completion tokens actually consumed should be charged to the family")
+
+	families.settle(newFamilyRequest("unknown", 10), 1000) // settling an unknown family is a no-op
+	_, err = families.reserve(newFamilyRequest("unknown", 10))
+	assert.NoError(t, err)
+}
+
+func TestFamilyBudgets_Duration(t *testing.T) {
+	t.Parallel()
+	clk := testclock.NewFakeClock(time.Now())
+	families := newFamilyBudgets(FamilyBudget{MaxDuration: time.Minute, IdleTimeout: 5 * time.Minute}, clk)
+
+	_, err := families.reserve(newFamilyRequest("task-1", 10))
+	require.NoError(t, err)
+	clk.Step(time.Minute)
+	_, err = families.reserve(newFamilyRequest("task-1", 10))
+	assert.NoError(t, err, "the family should be admitted up to its duration")
+	clk.Step(time.Second)
+	_, err = families.reserve(newFamilyRequest("task-1", 10))
+	assert.ErrorIs(t, err, types.ErrFamilyBudgetExhausted, "the family should not exceed its duration")
+}
+
+func TestFamilyBudgets_IdleTimeout(t *testing.T) {
+	t.Parallel()
+	clk := testclock.NewFakeClock(time.Now())
+	families := newFamilyBudgets(FamilyBudget{MaxTokens: 100, IdleTimeout: time.Minute}, clk)
+
+	_, err := families.reserve(newFamilyRequest("task-1", 100))
+	require.NoError(t, err)
+	_, err = families.reserve(newFamilyRequest("task-1", 1))
+	require.ErrorIs(t, err, types.ErrFamilyBudgetExhausted)
+
+	clk.Step(time.Minute + time.Second)
+	_, err = families.reserve(newFamilyRequest("task-1", 100))
+	assert.NoError(t, err, "the budget of an idle family should be reset")
+
+	clk.Step(time.Minute + time.Second)
+	families.sweep()
+	assert.Empty(t, families.families, "idle families should be swept")
+}
+
+func TestFamilyBudgets_Disabled(t *testing.T) {
+	t.Parallel()
+	families := newFamilyBudgets(FamilyBudget{}, testclock.NewFakeClock(time.Now()))
+
+	_, err := families.reserve(newFamilyRequest("task-1", 1_000_000))
+	assert.NoError(t, err)
+	assert.Empty(t, families.families, "families should not be tracked without a budget")
+}
@@ -25,6 +25,8 @@ import (
 	"sync/atomic"
 	"time"
 
+	"k8s.io/utils/clock"
+
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/types"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/flowcontrol"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
@@ -76,17 +78,27 @@ type FlowItem struct {
 
 	// onceFinalize ensures the finalization logic runs exactly once per lifecycle.
 	onceFinalize sync.Once
+
+	// clock measures the queue duration of the item on finalization.
+	clock clock.PassiveClock
 }
 
 var _ flowcontrol.QueueItemAccessor = &FlowItem{}
 
-// NewItem allocates and initializes a new FlowItem for a request lifecycle.
-func NewItem(req flowcontrol.FlowControlRequest, effectiveTTL time.Duration, enqueueTime time.Time) *FlowItem {
+// NewItem allocates and initializes a new FlowItem for a request lifecycle. The enqueue time must come from the given
+// clock.
+func NewItem(
+	req flowcontrol.FlowControlRequest,
+	effectiveTTL time.Duration,
+	enqueueTime time.Time,
+	clk clock.PassiveClock,
+) *FlowItem {
 	return &FlowItem{
 		enqueueTime:     enqueueTime,
 		effectiveTTL:    effectiveTTL,
 		originalRequest: req,
 		done:            make(chan *FinalState, 1),
+		clock:           clk,
 	}
 }
 
@@ -157,18 +169,34 @@ func (fi *FlowItem) finalizeInternal(outcome types.QueueOutcome, err error) {
 	// Atomically store the pointer. This is the critical memory barrier that publishes the state safely.
 	fi.finalState.Store(finalState)
 
-	duration := time.Since(fi.enqueueTime)
+	duration := fi.clock.Since(fi.enqueueTime)
 	flowKey := fi.originalRequest.FlowKey()
 	metrics.RecordFlowControlRequestQueueDuration(
 		flowKey.ID, strconv.Itoa(flowKey.Priority), outcome.String(),
 		fi.originalRequest.InferencePoolName(),
 		fi.OriginalRequest().ModelName(), fi.OriginalRequest().TargetModelName(),
 		duration)
+	if isRejection(outcome) {
+		metrics.RecordFlowControlRejection(fi.originalRequest.InferencePoolName(), flowKey.ID,
+			strconv.Itoa(flowKey.Priority), outcome.String())
+	}
 
 	fi.done <- finalState
 	close(fi.done)
 }
 
+// isRejection returns whether the outcome is a failure of the Flow Control layer to serve the request. Requests cancelled
+// by their client are not rejections, and preemptions are recorded by the processor.
+func isRejection(outcome types.QueueOutcome) bool {
+	switch outcome {
+	case types.QueueOutcomeRejectedCapacity, types.QueueOutcomeRejectedOther, types.QueueOutcomeEvictedTTL,
+		types.QueueOutcomeEvictedOther:
+		return true
+	default:
+		return false
+	}
+}
+
 // inferOutcome determines the correct QueueOutcome and Error based on the cause of finalization and whether the item
 // was already admitted to a queue.
 func inferOutcome(cause error, isQueued bool) (types.QueueOutcome, error) {
@@ -24,6 +24,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/utils/clock"
 
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/types"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/flowcontrol"
@@ -35,7 +36,7 @@ func TestFlowItem_New(t *testing.T) {
 	req := mocks.NewMockFlowControlRequest(100, "req-1", flowcontrol.FlowKey{})
 
 	enqueueTime := time.Now()
-	item := NewItem(req, time.Minute, enqueueTime)
+	item := NewItem(req, time.Minute, enqueueTime, clock.RealClock{})
 
 	require.NotNil(t, item, "NewItem should not return a nil item")
 	assert.Equal(t, enqueueTime, item.EnqueueTime(), "EnqueueTime should be populated")
@@ -119,7 +120,7 @@ func TestFlowItem_Finalize_Idempotency(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
-			item := NewItem(req, time.Minute, now)
+			item := NewItem(req, time.Minute, now, clock.RealClock{})
 
 			// First call
 			tc.firstCall(item)
@@ -220,7 +221,7 @@ func TestFlowItem_Finalize_InferOutcome(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 			req := mocks.NewMockFlowControlRequest(100, "req-1", flowcontrol.FlowKey{})
-			item := NewItem(req, time.Minute, now)
+			item := NewItem(req, time.Minute, now, clock.RealClock{})
 			if tc.isQueued {
 				item.SetHandle(&mocks.MockQueueItemHandle{})
 			}
@@ -235,3 +236,27 @@ func TestFlowItem_Finalize_InferOutcome(t *testing.T) {
 		})
 	}
 }
+
+func TestIsRejection(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		outcome types.QueueOutcome
+		expect  bool
+	}{
+		{outcome: types.QueueOutcomeDispatched, expect: false},
+		{outcome: types.QueueOutcomeRejectedCapacity, expect: true},
+		{outcome: types.QueueOutcomeRejectedOther, expect: true},
+		{outcome: types.QueueOutcomeEvictedTTL, expect: true},
+		{outcome: types.QueueOutcomeEvictedOther, expect: true},
+		{outcome: types.QueueOutcomeEvictedContextCancelled, expect: false},
+		{outcome: types.QueueOutcomeEvictedPreempted, expect: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.outcome.String(), func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expect, isRejection(tc.outcome))
+		})
+	}
+}
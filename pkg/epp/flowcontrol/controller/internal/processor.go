@@ -31,8 +31,10 @@ import (
 	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/contracts"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/types"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/flowcontrol"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+	requtil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/request"
 )
 
 // maxCleanupWorkers caps the number of concurrent workers for background cleanup tasks. This prevents a single shard
@@ -43,6 +45,15 @@ const maxCleanupWorkers = 4
 // internal buffer is momentarily full and cannot accept new work.
 var ErrProcessorBusy = errors.New("shard processor is busy")
 
+// knownSaturationReasons lists the label values of the pool saturated metric.
+var knownSaturationReasons = func() []string {
+	reasons := make([]string, 0, len(flowcontrol.SaturationReasons))
+	for _, reason := range flowcontrol.SaturationReasons {
+		reasons = append(reasons, string(reason))
+	}
+	return reasons
+}()
+
 // ShardProcessor is the core worker of the FlowController.
 //
 // It is paired one-to-one with a RegistryShard instance and is responsible for all request lifecycle operations on that
@@ -74,6 +85,14 @@ type ShardProcessor struct {
 	cleanupSweepInterval time.Duration
 	logger               logr.Logger
 
+	// starvationThreshold is the queueing time after which an item is dispatched ahead of the higher priority bands;
+	// zero dispatches strictly by priority.
+	starvationThreshold time.Duration
+
+	// preemption lets an item arriving while the queues are at capacity evict the newest queued items of the sheddable
+	// bands below its own.
+	preemption bool
+
 	// lifecycleCtx controls the processor's lifetime. Monitored by Submit* methods for safe shutdown.
 	lifecycleCtx context.Context
 
@@ -113,6 +132,18 @@ func NewShardProcessor(
 	}
 }
 
+// SetStarvationThreshold sets the queueing time after which an item is dispatched ahead of the higher priority bands.
+// Zero, the default, dispatches strictly by priority. It must be called before Run.
+func (sp *ShardProcessor) SetStarvationThreshold(threshold time.Duration) {
+	sp.starvationThreshold = threshold
+}
+
+// SetPreemption sets whether an item arriving while the queues are at capacity evicts the newest queued items of the
+// sheddable bands below its own. It must be called before Run.
+func (sp *ShardProcessor) SetPreemption(enabled bool) {
+	sp.preemption = enabled
+}
+
 // Submit attempts a non-blocking handoff of an item to the processor's internal enqueue channel.
 //
 // Ownership Contract:
@@ -222,14 +253,14 @@ func (sp *ShardProcessor) enqueue(item *FlowItem) {
 	priorityStr := strconv.Itoa(key.Priority)
 	outcome := item.FinalState()
 
-	startTime := time.Now()
+	startTime := sp.clock.Now()
 
 	defer func() {
 		outcomeStr := "NotYetFinalized"
 		if fs := item.FinalState(); fs != nil {
 			outcomeStr = fs.Outcome.String()
 		}
-		metrics.RecordFlowControlRequestEnqueueDuration(key.ID, priorityStr, outcomeStr, time.Since(startTime))
+		metrics.RecordFlowControlRequestEnqueueDuration(key.ID, priorityStr, outcomeStr, sp.clock.Since(startTime))
 	}()
 
 	// --- Optimistic External Finalization Check ---
@@ -261,7 +292,7 @@ func (sp *ShardProcessor) enqueue(item *FlowItem) {
 
 	// --- Capacity Check ---
 	// This check is safe because it is performed by the single-writer Run goroutine.
-	if !sp.hasCapacity(key.Priority, req.ByteSize()) {
+	if !sp.hasCapacity(key.Priority, req.ByteSize()) && !sp.preempt(key.Priority, req.ByteSize()) {
 		sp.logger.V(logutil.DEBUG).Info("Rejecting request, queue at capacity",
 			"flowKey", key, "reqID", req.ID(), "reqByteSize", req.ByteSize())
 		item.FinalizeWithOutcome(types.QueueOutcomeRejectedCapacity, fmt.Errorf("%w: %w",
@@ -308,6 +339,106 @@ func (sp *ShardProcessor) hasCapacity(priority int, itemByteSize uint64) bool {
 	return true
 }
 
+// preempt evicts the newest queued items of the sheddable bands below the given priority, lowest band first, until the
+// shard has the capacity for an item of the given priority and byte size. Only the shard-wide capacity can be freed
+// this way: it evicts nothing and returns false if preemption is disabled, if the band of the item is itself at
+// capacity, or if evicting all the eligible items would not free enough capacity.
+func (sp *ShardProcessor) preempt(priority int, itemByteSize uint64) bool {
+	if !sp.preemption {
+		return false
+	}
+	stats := sp.shard.Stats()
+	bandStats, ok := stats.PerPriorityBandStats[priority]
+	if !ok || (bandStats.CapacityBytes > 0 && bandStats.ByteSize+itemByteSize > bandStats.CapacityBytes) ||
+		(bandStats.CapacityRequests > 0 && bandStats.Len+1 > bandStats.CapacityRequests) {
+		return false
+	}
+
+	var excessBytes, excessLen uint64
+	if stats.TotalCapacityBytes > 0 && stats.TotalByteSize+itemByteSize > stats.TotalCapacityBytes {
+		excessBytes = stats.TotalByteSize + itemByteSize - stats.TotalCapacityBytes
+	}
+	if stats.TotalCapacityRequests > 0 && stats.TotalLen+1 > stats.TotalCapacityRequests {
+		excessLen = stats.TotalLen + 1 - stats.TotalCapacityRequests
+	}
+
+	// Eligible bands, lowest priority first.
+	var victims []int
+	var freeableBytes, freeableLen uint64
+	priorities := sp.shard.AllOrderedPriorityLevels()
+	for i := len(priorities) - 1; i >= 0 && priorities[i] < priority; i-- {
+		if !requtil.IsSheddable(priorities[i]) {
+			continue
+		}
+		victims = append(victims, priorities[i])
+		freeableBytes += stats.PerPriorityBandStats[priorities[i]].ByteSize
+		freeableLen += stats.PerPriorityBandStats[priorities[i]].Len
+	}
+	if freeableBytes < excessBytes || freeableLen < excessLen {
+		return false
+	}
+
+	for _, victim := range victims {
+		band, err := sp.shard.PriorityBandAccessor(victim)
+		if err != nil {
+			continue
+		}
+		for excessBytes > 0 || excessLen > 0 {
+			item := newestTail(band)
+			if item == nil {
+				break
+			}
+			size := item.OriginalRequest().ByteSize()
+			if !sp.evictPreempted(item, priority) {
+				break
+			}
+			excessBytes -= min(excessBytes, size)
+			excessLen -= min(excessLen, 1)
+		}
+	}
+	return sp.hasCapacity(priority, itemByteSize)
+}
+
+// newestTail returns the most recently enqueued tail among the queues of the given band, or nil if the band is empty.
+func newestTail(band flowcontrol.PriorityBandAccessor) flowcontrol.QueueItemAccessor {
+	var newest flowcontrol.QueueItemAccessor
+	band.IterateQueues(func(queue flowcontrol.FlowQueueAccessor) bool {
+		tail := queue.PeekTail()
+		if tail != nil && (newest == nil || tail.EnqueueTime().After(newest.EnqueueTime())) {
+			newest = tail
+		}
+		return true
+	})
+	return newest
+}
+
+// evictPreempted removes the given item from its queue and finalizes it as preempted by an item of the given priority.
+// It returns false if the item could not be removed.
+func (sp *ShardProcessor) evictPreempted(itemAcc flowcontrol.QueueItemAccessor, priority int) bool {
+	req := itemAcc.OriginalRequest()
+	managedQ, err := sp.shard.ManagedQueue(req.FlowKey())
+	if err != nil {
+		sp.logger.Error(err, "Failed to get ManagedQueue of preempted item", "flowKey", req.FlowKey(), "reqID", req.ID())
+		return false
+	}
+	removedItemAcc, err := managedQ.Remove(itemAcc.Handle())
+	if err != nil {
+		sp.logger.V(logutil.DEBUG).Info("Failed to remove preempted item (likely already finalized and swept).",
+			"flowKey", req.FlowKey(), "reqID", req.ID(), "error", err)
+		return false
+	}
+	removedItem := removedItemAcc.(*FlowItem)
+	// Items already finalized externally only release their capacity.
+	if removedItem.FinalState() == nil {
+		removedItem.FinalizeWithOutcome(types.QueueOutcomeEvictedPreempted,
+			fmt.Errorf("%w: %w", types.ErrEvicted, types.ErrPreempted))
+		metrics.RecordFlowControlPreemption(sp.poolName, req.FlowKey().ID, req.FlowKey().Priority)
+		sp.logger.V(logutil.DEBUG).Info("Item preempted by a higher priority item.",
+			"flowKey", req.FlowKey(), "reqID", req.ID(), "preemptingPriority", priority)
+	}
+	return true
+}
+
 // dispatchCycle attempts to dispatch a single item by iterating through priority bands from highest to lowest.
 // It applies the configured policies for each band to select an item and then attempts to dispatch it.
 // It returns true if an item was successfully dispatched, and false otherwise.
@@ -320,27 +451,49 @@ func (sp *ShardProcessor) hasCapacity(priority int, itemByteSize uint64) bool {
 // blocking to respect the policy's decision and prevent priority inversion, where dispatching lower-priority work might
 // exacerbate the saturation affecting the high-priority item.
 func (sp *ShardProcessor) dispatchCycle(ctx context.Context) bool {
-	dispatchCycleStart := time.Now()
+	dispatchCycleStart := sp.clock.Now()
 	defer func() {
-		metrics.RecordFlowControlDispatchCycleDuration(time.Since(dispatchCycleStart))
+		metrics.RecordFlowControlDispatchCycleDuration(sp.clock.Since(dispatchCycleStart))
 	}()
 
 	pool := sp.endpointCandidates.Locate(ctx, nil)
-	saturation := sp.saturationDetector.Saturation(ctx, pool)
+	saturation, reason := flowcontrol.SaturationWithReason(ctx, sp.saturationDetector, pool)
 
-	// Record pool saturation metric
+	// Record pool saturation metrics
 	metrics.RecordFlowControlPoolSaturation(sp.poolName, saturation)
+	metrics.RecordFlowControlPoolSaturated(sp.poolName, saturation >= 1.0, string(reason), knownSaturationReasons)
 
 	priorities := sp.shard.AllOrderedPriorityLevels()
 	ceilings := sp.usageLimitPolicy.ComputeLimit(ctx, saturation, priorities)
 
+	// --- Starvation Protection ---
+	// A band holding an item queued longer than the starvation threshold is served ahead of the higher priority bands, as
+	// long as it is not saturated. The band's fairness policy still selects the item, so stateful policies account for
+	// the dispatch.
+	if band := sp.selectStarvedBand(priorities, ceilings, saturation); band != nil {
+		item, err := sp.selectItem(ctx, band)
+		if err != nil {
+			sp.logger.Error(err, "Failed to select item from starved priority band", "priority", band.Priority())
+		} else if item != nil {
+			req := item.OriginalRequest()
+			if err := sp.dispatchItem(item); err != nil {
+				sp.logger.Error(err, "Failed to dispatch starved item", "flowKey", req.FlowKey(), "reqID", req.ID())
+			} else {
+				sp.logger.V(logutil.DEBUG).Info("Starved priority band served ahead of higher priority bands.",
+					"flowKey", req.FlowKey(), "reqID", req.ID(), "queued", sp.clock.Since(item.EnqueueTime()))
+				metrics.RecordFlowControlStarvationDispatch(sp.poolName, req.FlowKey().Priority)
+				return true
+			}
+		}
+	}
+
 	for i, priority := range priorities {
 		// --- Viability Check (Saturation/HoL Blocking) ---
 		// Check before selecting an item: if we are already saturated for this priority, stop immediately.
 		usageLimit := ceilings[i]
 		if saturation >= usageLimit {
 			sp.logger.V(logutil.DEBUG).Info("Priority band is saturated; enforcing HoL blocking.",
-				"priority", priority, "usageLimit", usageLimit)
+				"priority", priority, "usageLimit", usageLimit, "reason", reason)
 			// Stop the dispatch cycle entirely to respect strict policy decision and prevent priority inversion where
 			// lower-priority work might exacerbate the saturation affecting high-priority work.
 			return false
@@ -374,6 +527,39 @@ func (sp *ShardProcessor) dispatchCycle(ctx context.Context) bool {
 	return false
 }
 
+// selectStarvedBand returns the highest priority band below the top one holding an item queued longer than the
+// starvation threshold, skipping the bands whose usage limit is reached. It returns nil when starvation protection is
+// disabled or no item is starved.
+func (sp *ShardProcessor) selectStarvedBand(
+	priorities []int,
+	ceilings []float64,
+	saturation float64,
+) flowcontrol.PriorityBandAccessor {
+	if sp.starvationThreshold <= 0 {
+		return nil
+	}
+	// The top band is never starved, as it is served first by the strict priority dispatch.
+	for i := 1; i < len(priorities); i++ {
+		if saturation >= ceilings[i] {
+			continue
+		}
+		band, err := sp.shard.PriorityBandAccessor(priorities[i])
+		if err != nil {
+			continue
+		}
+		starved := false
+		band.IterateQueues(func(queue flowcontrol.FlowQueueAccessor) bool {
+			head := queue.PeekHead()
+			starved = head != nil && sp.clock.Since(head.EnqueueTime()) > sp.starvationThreshold
+			return !starved
+		})
+		if starved {
+			return band
+		}
+	}
+	return nil
+}
+
 // selectItem applies the configured fairness and ordering policies to select a single item.
 func (sp *ShardProcessor) selectItem(
 	ctx context.Context,
@@ -420,7 +606,7 @@ func (sp *ShardProcessor) dispatchItem(itemAcc flowcontrol.QueueItemAccessor) er
 }
 
 // runCleanupSweep starts a background goroutine that periodically scans all queues for externally finalized items
-// ("zombie" items) and removes them in batches.
+// ("zombie" items) and removes them in batches, and records the saturation of the slices of the pool.
 func (sp *ShardProcessor) runCleanupSweep(ctx context.Context) {
 	defer sp.wg.Done()
 	logger := sp.logger.WithName("runCleanupSweep")
@@ -436,10 +622,19 @@ func (sp *ShardProcessor) runCleanupSweep(ctx context.Context) {
 			return
 		case <-ticker.C():
 			sp.sweepFinalizedItems()
+			sp.recordSliceSaturation(ctx)
 		}
 	}
 }
 
+// recordSliceSaturation records the saturation of each slice of the pool. It runs on the cleanup sweep rather than on
+// every dispatch cycle, as it calls the saturation detector once per slice.
+func (sp *ShardProcessor) recordSliceSaturation(ctx context.Context) {
+	for slice, endpoints := range fwkdl.GroupBySlice(sp.endpointCandidates.Locate(ctx, nil)) {
+		metrics.RecordFlowControlSliceSaturation(sp.poolName, slice, sp.saturationDetector.Saturation(ctx, endpoints))
+	}
+}
+
 // sweepFinalizedItems performs a single scan of all queues, removing finalized items in batch and releasing their
 // memory.
 func (sp *ShardProcessor) sweepFinalizedItems() {
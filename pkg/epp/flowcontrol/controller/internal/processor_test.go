@@ -193,7 +193,7 @@ func (h *testHarness) waitForFinalization(item *FlowItem) (types.QueueOutcome, e
 func (h *testHarness) newTestItem(id string, key flowcontrol.FlowKey, ttl time.Duration) *FlowItem {
 	h.t.Helper()
 	req := fwmocks.NewMockFlowControlRequest(100, id, key)
-	return NewItem(req, ttl, h.clock.Now())
+	return NewItem(req, ttl, h.clock.Now(), h.clock)
 }
 
 // addQueue centrally registers a new mock queue for a given flow, ensuring all harness components are aware of it.
@@ -657,6 +657,86 @@ func TestShardProcessor(t *testing.T) {
 			}
 		})
 
+		t.Run("preempt", func(t *testing.T) {
+			t.Parallel()
+			keyHigh := flowcontrol.FlowKey{ID: "flow-high", Priority: 10}
+
+			// setup queues two items in the band of the given priority, under a shard capacity of two requests, and
+			// returns them oldest first.
+			setup := func(h *testHarness, lowPriority int) []*FlowItem {
+				keyLow := flowcontrol.FlowKey{ID: "flow-low", Priority: lowPriority}
+				h.addQueue(keyHigh)
+				qLow := h.addQueue(keyLow)
+				var items []*FlowItem
+				for i := range 2 {
+					item := h.newTestItem(fmt.Sprintf("req-low-%d", i), keyLow, testTTL)
+					require.NoError(t, qLow.Add(item))
+					items = append(items, item)
+					h.clock.Step(time.Second)
+				}
+				h.StatsFunc = func() contracts.ShardStats {
+					stats := contracts.ShardStats{
+						TotalCapacityRequests: 2,
+						PerPriorityBandStats:  map[int]contracts.PriorityBandStats{},
+					}
+					for key, q := range h.queues {
+						stats.TotalLen += uint64(q.Len())
+						stats.TotalByteSize += q.ByteSize()
+						stats.PerPriorityBandStats[key.Priority] = contracts.PriorityBandStats{
+							Len: uint64(q.Len()), ByteSize: q.ByteSize(),
+						}
+					}
+					return stats
+				}
+				return items
+			}
+
+			t.Run("should evict the newest sheddable item to make room", func(t *testing.T) {
+				t.Parallel()
+				h := newTestHarness(t, testCleanupTick)
+				h.processor.SetPreemption(true)
+				low := setup(h, -5)
+
+				item := h.newTestItem("req-high", keyHigh, testTTL)
+				h.processor.enqueue(item)
+
+				assert.Nil(t, item.FinalState(), "The high priority item should be enqueued")
+				assert.Equal(t, 1, h.queues[keyHigh].Len())
+				require.NotNil(t, low[1].FinalState(), "The newest low priority item should be preempted")
+				assert.Equal(t, types.QueueOutcomeEvictedPreempted, low[1].FinalState().Outcome)
+				assert.ErrorIs(t, low[1].FinalState().Err, types.ErrPreempted)
+				assert.ErrorIs(t, low[1].FinalState().Err, types.ErrEvicted)
+				assert.Nil(t, low[0].FinalState(), "The oldest low priority item should still be queued")
+			})
+
+			t.Run("should reject when preemption is disabled", func(t *testing.T) {
+				t.Parallel()
+				h := newTestHarness(t, testCleanupTick)
+				low := setup(h, -5)
+
+				item := h.newTestItem("req-high", keyHigh, testTTL)
+				h.processor.enqueue(item)
+
+				require.NotNil(t, item.FinalState())
+				assert.Equal(t, types.QueueOutcomeRejectedCapacity, item.FinalState().Outcome)
+				assert.Nil(t, low[1].FinalState(), "No item should be preempted")
+			})
+
+			t.Run("should not preempt non-sheddable items", func(t *testing.T) {
+				t.Parallel()
+				h := newTestHarness(t, testCleanupTick)
+				h.processor.SetPreemption(true)
+				low := setup(h, 0)
+
+				item := h.newTestItem("req-high", keyHigh, testTTL)
+				h.processor.enqueue(item)
+
+				require.NotNil(t, item.FinalState())
+				assert.Equal(t, types.QueueOutcomeRejectedCapacity, item.FinalState().Outcome)
+				assert.Nil(t, low[1].FinalState(), "No item should be preempted")
+			})
+		})
+
 		t.Run("hasCapacity", func(t *testing.T) {
 			t.Parallel()
 			testCases := []struct {
@@ -1057,6 +1137,84 @@ func TestShardProcessor(t *testing.T) {
 				}
 				assert.Equal(t, 0, qLow.Len(), "Low-priority queue should be empty")
 			})
+
+			t.Run("should dispatch starved lower priority items ahead of higher priority ones", func(t *testing.T) {
+				t.Parallel()
+				// --- ARRANGE ---
+				h := newTestHarness(t, testCleanupTick)
+				h.processor.SetStarvationThreshold(time.Minute)
+				keyHigh := flowcontrol.FlowKey{ID: "flow-high", Priority: 20}
+				keyLow := flowcontrol.FlowKey{ID: "flow-low", Priority: 10}
+				qHigh := h.addQueue(keyHigh)
+				qLow := h.addQueue(keyLow)
+
+				itemLow := h.newTestItem("req-low", keyLow, testTTL)
+				require.NoError(t, qLow.Add(itemLow))
+				for i := range 2 {
+					require.NoError(t, qHigh.Add(h.newTestItem(fmt.Sprintf("req-high-%d", i), keyHigh, testTTL)))
+				}
+
+				// --- ACT & ASSERT ---
+				// Before the threshold, the high-priority band is served first.
+				require.True(t, h.processor.dispatchCycle(context.Background()))
+				assert.Equal(t, 1, qHigh.Len(), "High-priority item should be dispatched first")
+				assert.Equal(t, 1, qLow.Len(), "Low-priority item should still be queued")
+
+				// Past the threshold, the low-priority item jumps ahead of the remaining high-priority one.
+				h.clock.Step(time.Minute + time.Second)
+				require.True(t, h.processor.dispatchCycle(context.Background()))
+				assert.Equal(t, types.QueueOutcomeDispatched, itemLow.FinalState().Outcome,
+					"Starved low-priority item should be dispatched")
+				assert.Equal(t, 1, qHigh.Len(), "High-priority item should still be queued")
+			})
+
+			t.Run("should select starved items through the band's fairness policy", func(t *testing.T) {
+				t.Parallel()
+				// --- ARRANGE ---
+				h := newTestHarness(t, testCleanupTick)
+				h.processor.SetStarvationThreshold(time.Minute)
+				keyHigh := flowcontrol.FlowKey{ID: "flow-high", Priority: 20}
+				keyLowA := flowcontrol.FlowKey{ID: "flow-low-a", Priority: 10}
+				keyLowB := flowcontrol.FlowKey{ID: "flow-low-b", Priority: 10}
+				qHigh := h.addQueue(keyHigh)
+				qLowA := h.addQueue(keyLowA)
+				qLowB := h.addQueue(keyLowB)
+
+				// The oldest starved item is in flow A, but the policy favors flow B.
+				itemLowA := h.newTestItem("req-low-a", keyLowA, testTTL)
+				require.NoError(t, qLowA.Add(itemLowA))
+				h.clock.Step(time.Second)
+				itemLowB := h.newTestItem("req-low-b", keyLowB, testTTL)
+				require.NoError(t, qLowB.Add(itemLowB))
+				require.NoError(t, qHigh.Add(h.newTestItem("req-high", keyHigh, testTTL)))
+
+				var pickedPriorities []int
+				h.fairnessPolicyPick = func(
+					_ context.Context,
+					flowGroup flowcontrol.PriorityBandAccessor,
+				) (flowcontrol.FlowQueueAccessor, error) {
+					pickedPriorities = append(pickedPriorities, flowGroup.Priority())
+					var selected flowcontrol.FlowQueueAccessor
+					flowGroup.IterateQueues(func(fqa flowcontrol.FlowQueueAccessor) bool {
+						if fqa.Len() > 0 && (selected == nil || fqa.FlowKey() == keyLowB) {
+							selected = fqa
+						}
+						return true
+					})
+					return selected, nil
+				}
+
+				// --- ACT ---
+				h.clock.Step(time.Minute + time.Second)
+				require.True(t, h.processor.dispatchCycle(context.Background()))
+
+				// --- ASSERT ---
+				assert.Equal(t, []int{10}, pickedPriorities, "The starved band's fairness policy should pick the item")
+				assert.Equal(t, types.QueueOutcomeDispatched, itemLowB.FinalState().Outcome,
+					"The item picked by the fairness policy should be dispatched")
+				assert.Nil(t, itemLowA.FinalState(), "The item not picked by the fairness policy should still be queued")
+				assert.Equal(t, 1, qHigh.Len(), "High-priority item should still be queued")
+			})
 		})
 
 		t.Run("dispatchItem", func(t *testing.T) {
@@ -1166,6 +1324,30 @@ func TestShardProcessor(t *testing.T) {
 				assert.Nil(t, item.FinalState(), "Item should not be finalized")
 			})
 
+			t.Run("should compute the saturation of the slices on the sweep only", func(t *testing.T) {
+				t.Parallel()
+				// --- ARRANGE ---
+				h := newTestHarness(t, testCleanupTick)
+				slice := func(name string) fwkdl.Endpoint {
+					return &metrics.FakePodMetrics{
+						Metadata: &fwkdl.EndpointMetadata{Labels: map[string]string{fwkdl.SliceLabel: name}},
+					}
+				}
+				h.endpointCandidates.Candidates = []fwkdl.Endpoint{slice("a"), slice("a"), slice("b")}
+				var calls []int
+				h.saturationDetector.SaturationFunc = func(_ context.Context, endpoints []fwkdl.Endpoint) float64 {
+					calls = append(calls, len(endpoints))
+					return 0
+				}
+
+				// --- ACT & ASSERT ---
+				h.processor.dispatchCycle(h.ctx)
+				assert.Equal(t, []int{3}, calls, "The dispatch cycle should only compute the saturation of the pool")
+				calls = nil
+				h.processor.recordSliceSaturation(h.ctx)
+				assert.ElementsMatch(t, []int{2, 1}, calls, "The sweep should compute the saturation of each slice")
+			})
+
 			t.Run("should evict all items on shutdown", func(t *testing.T) {
 				t.Parallel()
 				// --- ARRANGE ---
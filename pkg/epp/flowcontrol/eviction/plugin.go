@@ -19,8 +19,8 @@ package eviction
 import (
 	"context"
 	"net"
-	"time"
 
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
@@ -40,6 +40,8 @@ type RequestEvictor struct {
 	queue            *EvictionQueue
 	evictor          Evictor
 	evictionRegistry *EvictionRegistry
+	// clock stamps the dispatch time of the tracked requests.
+	clock clock.PassiveClock
 }
 
 // NewRequestEvictor creates a RequestEvictor with the given policies and evictor.
@@ -52,6 +54,7 @@ func NewRequestEvictor(
 		queue:            NewEvictionQueue(ordering, filter),
 		evictor:          evictor,
 		evictionRegistry: NewEvictionRegistry(),
+		clock:            clock.RealClock{},
 	}
 }
 
@@ -93,7 +96,7 @@ func (p *RequestEvictor) PreRequest(
 	item := &flowcontrol.EvictionItem{
 		RequestID:      requestID,
 		Priority:       request.Objectives.Priority,
-		DispatchTime:   time.Now(),
+		DispatchTime:   p.clock.Now(),
 		TargetURL:      "http://" + net.JoinHostPort(metadata.GetIPAddress(), metadata.GetPort()),
 		Request:        request,
 		TargetEndpoint: metadata,
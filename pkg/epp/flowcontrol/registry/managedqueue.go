@@ -162,6 +162,20 @@ func (mq *managedQueue) Cleanup(predicate contracts.PredicateFunc) []flowcontrol
 	return cleanedItems
 }
 
+// items returns a snapshot of the items in the queue, without removing them.
+func (mq *managedQueue) items() []flowcontrol.QueueItemAccessor {
+	mq.mu.Lock()
+	defer mq.mu.Unlock()
+
+	var items []flowcontrol.QueueItemAccessor
+	// The predicate never matches: Cleanup visits every item under the queue lock and removes none.
+	mq.queue.Cleanup(func(item flowcontrol.QueueItemAccessor) bool {
+		items = append(items, item)
+		return false
+	})
+	return items
+}
+
 // Drain wraps the underlying SafeQueue.Drain and updates statistics.
 func (mq *managedQueue) Drain() []flowcontrol.QueueItemAccessor {
 	mq.mu.Lock()
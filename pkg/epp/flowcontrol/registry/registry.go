@@ -340,6 +340,11 @@ func (fr *FlowRegistry) ShardStats() []contracts.ShardStats {
 	return shardStats
 }
 
+// QueuedItems returns a snapshot of the items currently queued, across all internal shards.
+func (fr *FlowRegistry) QueuedItems() []contracts.QueuedItem {
+	return fr.shard.queuedItems()
+}
+
 // --- Garbage Collection ---
 
 // executeGCCycle orchestrates the periodic GC of Idle flows, idle priority bands, and Drained shards.
@@ -270,6 +270,36 @@ func TestFlowRegistry_Stats(t *testing.T) {
 	assert.Equal(t, globalStats.TotalByteSize, totalShardBytes, "Sum of shard byte sizes must equal global byte size")
 }
 
+func TestFlowRegistry_QueuedItems(t *testing.T) {
+	t.Parallel()
+
+	h := newRegistryTestHarness(t, harnessOptions{initialShardCount: 1})
+	keyHigh := flowcontrol.FlowKey{ID: "high-pri-flow", Priority: highPriority}
+	keyLow := flowcontrol.FlowKey{ID: "low-pri-flow", Priority: lowPriority}
+	h.openConnectionOnFlow(keyHigh)
+	h.openConnectionOnFlow(keyLow)
+	assert.Empty(t, h.fr.QueuedItems(), "No items should be queued initially")
+
+	mqHigh, _ := h.fr.shard.ManagedQueue(keyHigh)
+	mqLow, _ := h.fr.shard.ManagedQueue(keyLow)
+	itemHigh := mocks.NewMockQueueItemAccessor(10, "req1", keyHigh)
+	require.NoError(t, mqHigh.Add(itemHigh), "Adding item to queue should not fail")
+	require.NoError(t, mqLow.Add(mocks.NewMockQueueItemAccessor(30, "req2", keyLow)),
+		"Adding item to queue should not fail")
+
+	queued := h.fr.QueuedItems()
+	require.Len(t, queued, 2, "Should return all queued items")
+	assert.ElementsMatch(t, []string{"req1", "req2"}, []string{queued[0].RequestID, queued[1].RequestID})
+	for _, item := range queued {
+		if item.RequestID == "req1" {
+			assert.Equal(t, keyHigh, item.FlowKey, "FlowKey should be the one of the item's flow")
+			assert.Equal(t, uint64(10), item.ByteSize, "ByteSize should be the one of the item")
+			assert.Equal(t, itemHigh.EnqueueTime(), item.EnqueueTime, "EnqueueTime should be the one of the item")
+		}
+	}
+	assert.Equal(t, uint64(2), h.fr.Stats().TotalLen, "Listing the queued items must not remove them")
+}
+
 // --- Garbage Collection Tests ---
 
 func TestFlowRegistry_GarbageCollection(t *testing.T) {
@@ -296,6 +296,30 @@ func (s *registryShard) Stats() contracts.ShardStats {
 	return stats
 }
 
+// queuedItems returns a snapshot of the items queued on this shard.
+// We acquire a Read Lock to ensure that the set of queues of each priority band remains stable during the iteration.
+func (s *registryShard) queuedItems() []contracts.QueuedItem {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var queued []contracts.QueuedItem
+	s.priorityBands.Range(func(_, value any) bool {
+		for _, mq := range value.(*priorityBand).queues {
+			for _, item := range mq.items() {
+				req := item.OriginalRequest()
+				queued = append(queued, contracts.QueuedItem{
+					RequestID:   req.ID(),
+					FlowKey:     req.FlowKey(),
+					EnqueueTime: item.EnqueueTime(),
+					ByteSize:    req.ByteSize(),
+				})
+			}
+		}
+		return true
+	})
+	return queued
+}
+
 //  --- Internal Administrative/Lifecycle Methods ---
 
 // synchronizeFlow is the internal administrative method for creating a flow instance on this shard.
@@ -45,6 +45,10 @@ var (
 var (
 	// ErrQueueAtCapacity indicates that a request could not be enqueued because queue capacity limits were met.
 	ErrQueueAtCapacity = errors.New("queue at capacity")
+
+	// ErrFamilyBudgetExhausted indicates that a request was rejected because the family of requests it belongs to
+	// exhausted its token or time budget.
+	ErrFamilyBudgetExhausted = errors.New("request family budget exhausted")
 )
 
 // --- Post-Enqueue Eviction Errors ---
@@ -59,6 +63,10 @@ var (
 	// `FlowControlRequest.Context()`) was cancelled. This error typically wraps the underlying `context.Canceled` or
 	// `context.DeadlineExceeded` error.
 	ErrContextCancelled = errors.New("request context cancelled")
+
+	// ErrPreempted indicates a request was evicted to make room for a request of higher priority while the queues were
+	// at capacity.
+	ErrPreempted = errors.New("request preempted by a higher priority request")
 )
 
 // --- General `controller.FlowController` Errors ---
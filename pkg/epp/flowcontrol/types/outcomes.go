@@ -65,6 +65,11 @@ const (
 	// The specific underlying cause can be determined from the associated error (e.g., controller shutdown while the item
 	// was queued), which will be wrapped by `ErrEvicted`.
 	QueueOutcomeEvictedOther
+
+	// QueueOutcomeEvictedPreempted indicates eviction from a queue to make room for a request of higher priority while
+	// the queues were at capacity.
+	// The associated error will wrap `ErrPreempted` (and `ErrEvicted`).
+	QueueOutcomeEvictedPreempted
 )
 
 // String returns a human-readable string representation of the QueueOutcome.
@@ -84,6 +89,8 @@ func (o QueueOutcome) String() string {
 		return "EvictedContextCancelled"
 	case QueueOutcomeEvictedOther:
 		return "EvictedOther"
+	case QueueOutcomeEvictedPreempted:
+		return "EvictedPreempted"
 	default:
 		// Return the integer value for unknown outcomes to aid in debugging.
 		return "UnknownOutcome(" + strconv.Itoa(int(o)) + ")"
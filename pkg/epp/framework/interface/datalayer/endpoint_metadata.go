@@ -27,10 +27,17 @@ import (
 type EndpointMetadata struct {
 	NamespacedName types.NamespacedName
 	PodName        string
-	Address        string
-	Port           string
-	MetricsHost    string
-	Labels         map[string]string
+	// PodUID is the UID of the pod, empty if unknown. It tells a pod apart from a recreated pod of the same name.
+	PodUID      types.UID
+	Address     string
+	Port        string
+	MetricsHost string
+	Labels      map[string]string
+	// NodeName is the name of the node the pod runs on, empty if unknown.
+	NodeName string
+	// NodeLabels are the labels of the node the pod runs on selected by the datastore, e.g. its zone, GPU model or
+	// instance type.
+	NodeLabels map[string]string
 }
 
 // String returns a string representation of the endpoint.
@@ -49,16 +56,24 @@ func (p *EndpointMetadata) Clone() *EndpointMetadata {
 
 	clonedLabels := make(map[string]string, len(p.Labels))
 	maps.Copy(clonedLabels, p.Labels)
+	var clonedNodeLabels map[string]string
+	if p.NodeLabels != nil {
+		clonedNodeLabels = make(map[string]string, len(p.NodeLabels))
+		maps.Copy(clonedNodeLabels, p.NodeLabels)
+	}
 	return &EndpointMetadata{
 		NamespacedName: types.NamespacedName{
 			Name:      p.NamespacedName.Name,
 			Namespace: p.NamespacedName.Namespace,
 		},
 		PodName:     p.PodName,
+		PodUID:      p.PodUID,
 		Address:     p.Address,
 		Port:        p.Port,
 		MetricsHost: p.MetricsHost,
 		Labels:      clonedLabels,
+		NodeName:    p.NodeName,
+		NodeLabels:  clonedNodeLabels,
 	}
 }
 
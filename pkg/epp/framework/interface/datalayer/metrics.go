@@ -36,6 +36,16 @@ type Metrics struct {
 	CacheBlockSize          int
 	// Number of GPU blocks in the model server for KV Cache.
 	CacheNumBlocks int
+	// MaxConcurrency is the maximum number of requests the model server runs concurrently (e.g. vLLM max-num-seqs).
+	// Zero means the capacity is unknown.
+	MaxConcurrency int
+	// Precision is the precision or quantization of the served model weights (e.g. "bf16", "fp8", "awq"), as reported
+	// by the model server. Empty means the precision is unknown.
+	Precision string
+	// AdapterRunningRequests and AdapterWaitingRequests are the number of running and waiting requests of each LoRA
+	// adapter, for the model servers exposing per-adapter request metrics. They are nil otherwise.
+	AdapterRunningRequests map[string]int
+	AdapterWaitingRequests map[string]int
 
 	// UpdateTime records the last time when the metrics were updated.
 	UpdateTime time.Time
@@ -77,6 +87,10 @@ func (m *Metrics) Clone() *Metrics {
 		KvCacheMaxTokenCapacity: m.KvCacheMaxTokenCapacity,
 		CacheBlockSize:          m.CacheBlockSize,
 		CacheNumBlocks:          m.CacheNumBlocks,
+		MaxConcurrency:          m.MaxConcurrency,
+		Precision:               m.Precision,
+		AdapterRunningRequests:  maps.Clone(m.AdapterRunningRequests),
+		AdapterWaitingRequests:  maps.Clone(m.AdapterWaitingRequests),
 		UpdateTime:              m.UpdateTime,
 	}
 }
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import "strings"
+
+// PrecisionLabel is the pod label declaring the precision or quantization of the model weights served by the
+// endpoint (e.g. "bf16", "fp8", "awq"). It takes precedence over the precision reported by the model server.
+const PrecisionLabel = "inference.networking.k8s.io/precision"
+
+// fullPrecisions are the precisions considered to be full precision.
+var fullPrecisions = map[string]bool{
+	"full":     true,
+	"fp32":     true,
+	"float32":  true,
+	"fp16":     true,
+	"float16":  true,
+	"half":     true,
+	"bf16":     true,
+	"bfloat16": true,
+	"auto":     true,
+}
+
+// Precision returns the precision of the model weights served by the endpoint, from the PrecisionLabel pod label if
+// set, or as reported by the model server otherwise. It returns "" if the precision is unknown.
+func Precision(endpoint interface {
+	GetMetadata() *EndpointMetadata
+	GetMetrics() *Metrics
+}) string {
+	if metadata := endpoint.GetMetadata(); metadata != nil && metadata.Labels[PrecisionLabel] != "" {
+		return strings.ToLower(metadata.Labels[PrecisionLabel])
+	}
+	if metrics := endpoint.GetMetrics(); metrics != nil {
+		return strings.ToLower(metrics.Precision)
+	}
+	return ""
+}
+
+// IsQuantized returns whether the given precision, as returned by Precision, denotes quantized model weights. Unknown
+// precisions are assumed to be full precision, as a model is served at its native precision unless configured otherwise.
+func IsQuantized(precision string) bool {
+	return precision != "" && !fullPrecisions[precision]
+}
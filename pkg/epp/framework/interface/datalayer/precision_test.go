@@ -0,0 +1,38 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrecision(t *testing.T) {
+	labeled := NewEndpoint(&EndpointMetadata{Labels: map[string]string{PrecisionLabel: "FP8"}}, &Metrics{Precision: "bf16"})
+	reported := NewEndpoint(&EndpointMetadata{}, &Metrics{Precision: "awq"})
+	unknown := NewEndpoint(&EndpointMetadata{}, nil)
+
+	assert.Equal(t, "fp8", Precision(labeled), "the label takes precedence over the reported precision")
+	assert.Equal(t, "awq", Precision(reported))
+	assert.Empty(t, Precision(unknown))
+
+	assert.True(t, IsQuantized("fp8"))
+	assert.True(t, IsQuantized("awq"))
+	assert.False(t, IsQuantized("bf16"))
+	assert.False(t, IsQuantized(""), "unknown precisions are assumed to be full precision")
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+// SliceLabel is the pod label grouping the endpoints of a pool into named slices. A pool composed of several
+// Deployments with different configurations (e.g. tensor-parallel sizes or quantizations) sets a distinct value of
+// this label in the pod template of each Deployment.
+const SliceLabel = "inference.networking.k8s.io/slice"
+
+// Slice returns the name of the slice the endpoint belongs to, or "" if the endpoint does not belong to a slice.
+func (e *EndpointMetadata) Slice() string {
+	if e == nil {
+		return ""
+	}
+	return e.Labels[SliceLabel]
+}
+
+// GroupBySlice groups the given endpoints by slice. Endpoints not belonging to a slice are omitted. It returns nil if
+// none of the endpoints belongs to a slice.
+func GroupBySlice[E interface{ GetMetadata() *EndpointMetadata }](endpoints []E) map[string][]E {
+	var slices map[string][]E
+	for _, endpoint := range endpoints {
+		slice := endpoint.GetMetadata().Slice()
+		if slice == "" {
+			continue
+		}
+		if slices == nil {
+			slices = map[string][]E{}
+		}
+		slices[slice] = append(slices[slice], endpoint)
+	}
+	return slices
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestGroupBySlice(t *testing.T) {
+	newEndpoint := func(name, slice string) Endpoint {
+		meta := &EndpointMetadata{NamespacedName: types.NamespacedName{Name: name}, Labels: map[string]string{}}
+		if slice != "" {
+			meta.Labels[SliceLabel] = slice
+		}
+		return NewEndpoint(meta, nil)
+	}
+	tp8a, tp8b, tp2, unsliced := newEndpoint("a", "tp8"), newEndpoint("b", "tp8"), newEndpoint("c", "tp2"), newEndpoint("d", "")
+
+	assert.Equal(t, "tp8", tp8a.GetMetadata().Slice())
+	assert.Empty(t, unsliced.GetMetadata().Slice())
+	assert.Equal(t, map[string][]Endpoint{"tp8": {tp8a, tp8b}, "tp2": {tp2}},
+		GroupBySlice([]Endpoint{tp8a, unsliced, tp2, tp8b}))
+	assert.Nil(t, GroupBySlice([]Endpoint{unsliced}))
+}
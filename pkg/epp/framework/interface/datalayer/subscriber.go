@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+// EndpointChangeType identifies the type of change of an endpoint of the datastore.
+type EndpointChangeType int
+
+const (
+	// EndpointAdded is fired when an endpoint is added to the datastore.
+	EndpointAdded EndpointChangeType = iota
+	// EndpointUpdated is fired when the metadata of an endpoint of the datastore changes.
+	EndpointUpdated
+	// EndpointDeleted is fired when an endpoint is removed from the datastore.
+	EndpointDeleted
+)
+
+func (t EndpointChangeType) String() string {
+	switch t {
+	case EndpointAdded:
+		return "added"
+	case EndpointUpdated:
+		return "updated"
+	case EndpointDeleted:
+		return "deleted"
+	default:
+		return "unknown"
+	}
+}
+
+// EndpointChange carries a change of an endpoint of the datastore.
+type EndpointChange struct {
+	Type     EndpointChangeType
+	Endpoint Endpoint
+}
+
+// EndpointSubscriber is implemented by the plugins that track the endpoints of the pool, e.g. to index or evict
+// per-endpoint state, to be called back on each change of the endpoints instead of listing them on each cycle.
+//
+// On subscription, the subscriber is called with an EndpointAdded change for each current endpoint, so that it starts
+// from the current state. Changes are delivered synchronously by the goroutine making them: OnEndpointChange must be
+// fast, safe for concurrent use, and must not subscribe or unsubscribe.
+type EndpointSubscriber interface {
+	plugin.Plugin
+	// OnEndpointChange is called on each change of the endpoints of the datastore.
+	OnEndpointChange(ctx context.Context, change EndpointChange)
+}
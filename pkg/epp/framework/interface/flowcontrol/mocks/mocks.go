@@ -33,6 +33,7 @@ type MockFlowControlRequest struct {
 	ReceivedTimestampV   time.Time
 	InitialEffectiveTTLV time.Duration
 	IDV                  string
+	FamilyIDV            string
 	MetadataV            map[string]any
 	InferencePoolNameV   string
 	ModelNameV           string
@@ -71,6 +72,7 @@ func (m *MockFlowControlRequest) InferenceRequest() *scheduling.InferenceRequest
 func (m *MockFlowControlRequest) ReceivedTimestamp() time.Time       { return m.ReceivedTimestampV }
 func (m *MockFlowControlRequest) InitialEffectiveTTL() time.Duration { return m.InitialEffectiveTTLV }
 func (m *MockFlowControlRequest) ID() string                         { return m.IDV }
+func (m *MockFlowControlRequest) FamilyID() string                   { return m.FamilyIDV }
 func (m *MockFlowControlRequest) GetMetadata() map[string]any        { return m.MetadataV }
 func (m *MockFlowControlRequest) InferencePoolName() string          { return m.InferencePoolNameV }
 func (m *MockFlowControlRequest) ModelName() string                  { return m.ModelNameV }
@@ -136,6 +136,39 @@ type SaturationDetector interface {
 	Saturation(ctx context.Context, endpoints []datalayer.Endpoint) float64
 }
 
+// SaturationReason identifies the constraint that saturates the pool.
+type SaturationReason string
+
+const (
+	// SaturationReasonEndpointCapacity indicates that the endpoints of the pool are at their aggregate capacity.
+	SaturationReasonEndpointCapacity SaturationReason = "endpoint_capacity"
+	// SaturationReasonPoolConcurrencyCeiling indicates that the pool reached its absolute concurrency ceiling,
+	// regardless of the number of endpoints.
+	SaturationReasonPoolConcurrencyCeiling SaturationReason = "pool_concurrency_ceiling"
+)
+
+// SaturationReasons lists all the known saturation reasons.
+var SaturationReasons = []SaturationReason{SaturationReasonEndpointCapacity, SaturationReasonPoolConcurrencyCeiling}
+
+// ReasonedSaturationDetector is an optional extension of SaturationDetector for detectors enforcing several
+// constraints, reporting which one saturates the pool.
+type ReasonedSaturationDetector interface {
+	SaturationDetector
+
+	// SaturationWithReason returns the same value as Saturation, along with the constraint that determines it.
+	SaturationWithReason(ctx context.Context, endpoints []datalayer.Endpoint) (float64, SaturationReason)
+}
+
+// SaturationWithReason returns the saturation computed by the given detector, and the constraint that determines it.
+// Detectors that do not implement ReasonedSaturationDetector are assumed to only track endpoint capacity.
+func SaturationWithReason(ctx context.Context, sd SaturationDetector,
+	endpoints []datalayer.Endpoint) (float64, SaturationReason) {
+	if rsd, ok := sd.(ReasonedSaturationDetector); ok {
+		return rsd.SaturationWithReason(ctx, endpoints)
+	}
+	return sd.Saturation(ctx, endpoints), SaturationReasonEndpointCapacity
+}
+
 // UsageLimitPolicy computes the usage limit of a priority band dynamically.
 //
 // The goal of this policy is to enable adaptive capacity management by gating lower-priority traffic
@@ -56,6 +56,11 @@ type FlowControlRequest interface {
 	// the internal, opaque `QueueItemHandle`.
 	ID() string
 
+	// FamilyID returns the ID of the family of requests this request belongs to, e.g. the calls fanned out by a single
+	// agent task, or an empty string. The requests of a family share the family budget of the
+	// `controller.FlowController`.
+	FamilyID() string
+
 	// GetMetadata returns the opaque metadata associated with the request (e.g., header-derived context, subset filters).
 	// This data is passed transparently to components like contracts.EndpointCandidates to resolve resources (endpoint candidates)
 	// lazily during the dispatch cycle.
@@ -32,6 +32,9 @@ type Handle interface {
 
 	// PodList lists pods.
 	PodList() []types.NamespacedName
+
+	// SharedStore returns the store the plugins can use to keep data across requests.
+	SharedStore() *SharedStore
 }
 
 // HandlePlugins defines a set of APIs to work with instantiated plugins
@@ -56,7 +59,8 @@ type PodListFunc func() []types.NamespacedName
 type eppHandle struct {
 	ctx context.Context
 	HandlePlugins
-	podList PodListFunc
+	podList     PodListFunc
+	sharedStore *SharedStore
 }
 
 // Context returns a context the plugins can use, if they need one
@@ -98,13 +102,19 @@ func (h *eppHandle) PodList() []types.NamespacedName {
 	return h.podList()
 }
 
+// SharedStore returns the store the plugins can use to keep data across requests.
+func (h *eppHandle) SharedStore() *SharedStore {
+	return h.sharedStore
+}
+
 func NewEppHandle(ctx context.Context, podList PodListFunc) Handle {
 	return &eppHandle{
 		ctx: ctx,
 		HandlePlugins: &eppHandlePlugins{
 			plugins: map[string]Plugin{},
 		},
-		podList: podList,
+		podList:     podList,
+		sharedStore: NewSharedStore(ctx, DefaultSharedStoreTTL, DefaultSharedStoreCapacity),
 	}
 }
 
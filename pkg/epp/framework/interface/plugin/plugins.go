@@ -16,6 +16,11 @@ limitations under the License.
 
 package plugin
 
+import (
+	"context"
+	"encoding/json"
+)
+
 // Plugin defines the interface for a plugin.
 // This interface should be embedded in all plugins across the code.
 type Plugin interface {
@@ -40,3 +45,31 @@ type ProducerPlugin interface {
 	// the data type of the key (represented as data with default value casted as any field).
 	Produces() map[string]any
 }
+
+// DependentPlugin defines the interface for a consumer that cannot work without some of the data it consumes, e.g. a
+// scorer whose scores would all be zero. The configuration fails to load if any of the required data keys is not
+// produced by another plugin, or is produced by a plugin running after it.
+type DependentPlugin interface {
+	ConsumerPlugin
+	// Requires returns the data keys, among the consumed ones, that must be produced by another plugin.
+	Requires() []string
+}
+
+// PeerStatePlugin defines the interface for a plugin whose in-memory state can be handed off to another EPP
+// replica. A replica starting up (e.g. when the EPP deployment scales out) imports the state exported by an existing
+// replica before it becomes ready, instead of starting cold.
+type PeerStatePlugin interface {
+	Plugin
+	// ExportState returns a JSON encoded snapshot of the plugin state.
+	ExportState() (json.RawMessage, error)
+	// ImportState merges a snapshot exported by a plugin of the same type into the plugin state.
+	ImportState(ctx context.Context, state json.RawMessage) error
+}
+
+// DebugStatePlugin defines the interface for a plugin exposing a summary of its in-memory state, e.g. the size of its
+// indexes, on the debug state API of the EPP.
+type DebugStatePlugin interface {
+	Plugin
+	// DebugState returns a JSON serializable summary of the plugin state.
+	DebugState() any
+}
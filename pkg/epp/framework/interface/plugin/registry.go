@@ -27,6 +27,17 @@ type FactoryFunc func(name string, parameters json.RawMessage, handle Handle) (P
 // Register is a static function that can be called to register plugin factory functions.
 func Register(pluginType string, factory FactoryFunc) {
 	Registry[pluginType] = factory
+	delete(ParameterSchemaRegistry, pluginType)
+}
+
+// RegisterWithSchema registers a plugin factory function along with the JSON schema of the plugin parameters. The
+// config loader validates the parameters of the configured plugins against their schema before instantiating them,
+// so that unknown fields, wrong types and out-of-range values are reported precisely instead of being ignored or
+// failing in the factory. The schema is a JSON schema (draft 4, as used by OpenAPI), e.g. with
+// "additionalProperties": false to reject unknown fields.
+func RegisterWithSchema(pluginType string, factory FactoryFunc, schema string) {
+	Register(pluginType, factory)
+	ParameterSchemaRegistry[pluginType] = schema
 }
 
 // RegisterAsDefaultProducer registers a factory for the given plugin type and records it as the
@@ -41,6 +52,10 @@ func RegisterAsDefaultProducer(pluginType string, factory FactoryFunc, key strin
 // Registry is a mapping from plugin type to Factory function.
 var Registry map[string]FactoryFunc = map[string]FactoryFunc{}
 
+// ParameterSchemaRegistry is a mapping from plugin type to the JSON schema of the plugin parameters.
+// Populated via RegisterWithSchema.
+var ParameterSchemaRegistry = map[string]string{}
+
 // DefaultProducerRegistry maps a data key to the plugin type that is the default producer for it.
 // Populated via RegisterAsDefaultProducer.
 var DefaultProducerRegistry = map[string]string{}
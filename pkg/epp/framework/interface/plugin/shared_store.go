@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jellydator/ttlcache/v3"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+)
+
+const (
+	// DefaultSharedStoreTTL is the default time to live of the SharedStore entries.
+	DefaultSharedStoreTTL = 10 * time.Minute
+	// DefaultSharedStoreCapacity is the default maximum number of SharedStore entries.
+	DefaultSharedStoreCapacity = 100_000
+)
+
+// StoreScope is the scope of a SharedStore entry.
+type StoreScope string
+
+const (
+	// StoreScopeGlobal entries are shared by all the requests. Their scope key is ignored.
+	StoreScopeGlobal StoreScope = "global"
+	// StoreScopePod entries are shared by the requests served by a pod, identified by its "<namespace>/<name>".
+	StoreScopePod StoreScope = "pod"
+	// StoreScopeSession entries are shared by the requests of a session, identified by the session ID.
+	StoreScopeSession StoreScope = "session"
+)
+
+type sharedStoreKey struct {
+	scope    StoreScope
+	scopeKey string
+	key      StateKey
+}
+
+// SharedStore provides a mechanism for plugins to store and retrieve data across requests, e.g. the affinity of a
+// session or the moving averages of a pod, instead of each plugin maintaining its own map. Unlike PluginState, whose
+// data lives for a single request, the data stored in SharedStore is scoped globally, per pod or per session.
+//
+// Entries expire after their TTL and, when the store is full, the least recently used entries are evicted, so that the
+// store stays bounded whatever the number of pods and sessions seen. Plugins should prefix their keys with their
+// plugin type, as the store is shared by all the plugins.
+type SharedStore struct {
+	cache *ttlcache.Cache[sharedStoreKey, StateData]
+	// mu serializes the writes, so that Update is atomic with respect to the other writes.
+	mu sync.Mutex
+}
+
+// NewSharedStore initializes a new SharedStore with the given default TTL and capacity, in number of entries, and
+// returns its pointer. Non-positive values select the defaults. Expired entries are cleaned until the given context
+// is done.
+func NewSharedStore(ctx context.Context, ttl time.Duration, capacity int) *SharedStore {
+	if ttl <= 0 {
+		ttl = DefaultSharedStoreTTL
+	}
+	if capacity <= 0 {
+		capacity = DefaultSharedStoreCapacity
+	}
+	store := &SharedStore{
+		cache: ttlcache.New(
+			ttlcache.WithTTL[sharedStoreKey, StateData](ttl),
+			ttlcache.WithCapacity[sharedStoreKey, StateData](uint64(capacity)),
+		),
+	}
+	go store.cleanup(ctx)
+	return store
+}
+
+// Read retrieves the data with the given key in the given scope. If the key is not present or expired, ErrNotFound
+// is returned. Reading an entry does not extend its TTL.
+func (s *SharedStore) Read(scope StoreScope, scopeKey string, key StateKey) (StateData, error) {
+	item := s.cache.Get(newSharedStoreKey(scope, scopeKey, key), ttlcache.WithDisableTouchOnHit[sharedStoreKey, StateData]())
+	if item == nil {
+		return nil, ErrNotFound
+	}
+	return item.Value(), nil
+}
+
+// Write stores the given data with the given key in the given scope, for the given TTL. A non-positive TTL selects the
+// default TTL of the store.
+func (s *SharedStore) Write(scope StoreScope, scopeKey string, key StateKey, val StateData, ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cache.Set(newSharedStoreKey(scope, scopeKey, key), val, storeTTL(ttl))
+}
+
+// Update atomically replaces the data with the given key in the given scope by the result of the given function,
+// which receives the current data or nil if the key is not present. The function must not access the store. The TTL
+// of the entry is reset to the given TTL; a non-positive TTL selects the default TTL of the store.
+func (s *SharedStore) Update(scope StoreScope, scopeKey string, key StateKey, ttl time.Duration,
+	update func(StateData) StateData) StateData {
+	storeKey := newSharedStoreKey(scope, scopeKey, key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var current StateData
+	if item := s.cache.Get(storeKey, ttlcache.WithDisableTouchOnHit[sharedStoreKey, StateData]()); item != nil {
+		current = item.Value()
+	}
+	updated := update(current)
+	s.cache.Set(storeKey, updated, storeTTL(ttl))
+	return updated
+}
+
+// Delete deletes the data with the given key in the given scope.
+func (s *SharedStore) Delete(scope StoreScope, scopeKey string, key StateKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cache.Delete(newSharedStoreKey(scope, scopeKey, key))
+}
+
+// DeleteScope deletes all the data of the given scope, e.g. of a pod that was deleted.
+func (s *SharedStore) DeleteScope(scope StoreScope, scopeKey string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, storeKey := range s.cache.Keys() {
+		if storeKey.scope == scope && storeKey.scopeKey == scopeKey {
+			s.cache.Delete(storeKey)
+		}
+	}
+}
+
+// Len returns the number of entries in the store, including the expired entries that were not cleaned yet.
+func (s *SharedStore) Len() int {
+	return s.cache.Len()
+}
+
+// cleanup periodically deletes the expired entries.
+func (s *SharedStore) cleanup(ctx context.Context) {
+	ticker := time.NewTicker(cleanupInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.FromContext(ctx).V(logutil.DEFAULT).Info("Shutting down shared store cleanup")
+			return
+		case <-ticker.C:
+			s.cache.DeleteExpired()
+		}
+	}
+}
+
+func newSharedStoreKey(scope StoreScope, scopeKey string, key StateKey) sharedStoreKey {
+	if scope == StoreScopeGlobal {
+		scopeKey = ""
+	}
+	return sharedStoreKey{scope: scope, scopeKey: scopeKey, key: key}
+}
+
+func storeTTL(ttl time.Duration) time.Duration {
+	if ttl <= 0 {
+		return ttlcache.DefaultTTL
+	}
+	return ttl
+}
+
+// ReadSharedStoreKey retrieves the data with the given key in the given scope from SharedStore and asserts it to type
+// T. Returns an error if the key is not found or the type assertion fails.
+func ReadSharedStoreKey[T StateData](store *SharedStore, scope StoreScope, scopeKey string, key StateKey) (T, error) {
+	var zero T
+
+	raw, err := store.Read(scope, scopeKey, key)
+	if err != nil {
+		return zero, err
+	}
+
+	val, ok := raw.(T)
+	if !ok {
+		return zero, fmt.Errorf("unexpected type for key %q: got %T", key, raw)
+	}
+
+	return val, nil
+}
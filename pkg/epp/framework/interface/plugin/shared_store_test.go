@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// counterData implements the StateData interface for testing purposes.
+type counterData struct {
+	count int
+}
+
+func (d *counterData) Clone() StateData {
+	return &counterData{count: d.count}
+}
+
+func TestSharedStore_Scopes(t *testing.T) {
+	store := NewSharedStore(t.Context(), time.Minute, 100)
+	key := StateKey("test/key")
+
+	store.Write(StoreScopePod, "default/pod1", key, &pluginTestData{value: "pod1"}, 0)
+	store.Write(StoreScopePod, "default/pod2", key, &pluginTestData{value: "pod2"}, 0)
+	store.Write(StoreScopeSession, "default/pod1", key, &pluginTestData{value: "session"}, 0)
+	store.Write(StoreScopeGlobal, "ignored", key, &pluginTestData{value: "global"}, 0)
+
+	data, err := ReadSharedStoreKey[*pluginTestData](store, StoreScopePod, "default/pod1", key)
+	require.NoError(t, err)
+	assert.Equal(t, "pod1", data.value)
+	data, err = ReadSharedStoreKey[*pluginTestData](store, StoreScopeSession, "default/pod1", key)
+	require.NoError(t, err)
+	assert.Equal(t, "session", data.value, "scopes do not share their entries")
+	data, err = ReadSharedStoreKey[*pluginTestData](store, StoreScopeGlobal, "", key)
+	require.NoError(t, err)
+	assert.Equal(t, "global", data.value, "the scope key of global entries is ignored")
+
+	_, err = ReadSharedStoreKey[*counterData](store, StoreScopeGlobal, "", key)
+	assert.Error(t, err, "type mismatch")
+
+	store.DeleteScope(StoreScopePod, "default/pod1")
+	_, err = store.Read(StoreScopePod, "default/pod1", key)
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = store.Read(StoreScopePod, "default/pod2", key)
+	assert.NoError(t, err)
+
+	store.Delete(StoreScopePod, "default/pod2", key)
+	_, err = store.Read(StoreScopePod, "default/pod2", key)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestSharedStore_Bounds(t *testing.T) {
+	store := NewSharedStore(t.Context(), time.Minute, 2)
+	key := StateKey("test/key")
+
+	// Entries expire after their TTL.
+	store.Write(StoreScopeSession, "short", key, &pluginTestData{}, time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+	_, err := store.Read(StoreScopeSession, "short", key)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	// The least recently used entries are evicted when the store is full.
+	store.Write(StoreScopeSession, "a", key, &pluginTestData{}, 0)
+	store.Write(StoreScopeSession, "b", key, &pluginTestData{}, 0)
+	store.Write(StoreScopeSession, "c", key, &pluginTestData{}, 0)
+	assert.Equal(t, 2, store.Len())
+	_, err = store.Read(StoreScopeSession, "a", key)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestSharedStore_ConcurrentUpdates(t *testing.T) {
+	store := NewSharedStore(t.Context(), 0, 0)
+	key := StateKey("test/counter")
+
+	var wg sync.WaitGroup
+	for i := range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			store.Update(StoreScopePod, fmt.Sprintf("default/pod%d", i%2), key, 0, func(current StateData) StateData {
+				if current == nil {
+					return &counterData{count: 1}
+				}
+				return &counterData{count: current.(*counterData).count + 1}
+			})
+		}()
+	}
+	wg.Wait()
+
+	for _, pod := range []string{"default/pod0", "default/pod1"} {
+		data, err := ReadSharedStoreKey[*counterData](store, StoreScopePod, pod, key)
+		require.NoError(t, err)
+		assert.Equal(t, 25, data.count)
+	}
+}
@@ -25,10 +25,14 @@ import (
 )
 
 const (
+	PrepareDataExtensionPoint       = "PrepareData"
+	AdmitRequestExtensionPoint      = "AdmitRequest"
 	PreRequestExtensionPoint        = "PreRequest"
+	RequestMutationExtensionPoint   = "RequestMutation"
 	ResponseReceivedExtensionPoint  = "ResponseReceived"
 	ResponseStreamingExtensionPoint = "ResponseStreaming"
 	ResponseCompleteExtensionPoint  = "ResponseComplete"
+	PostResponseExtensionPoint      = "PostResponse"
 )
 
 // PreRequest is called by the director after a getting result from scheduling layer and
@@ -38,6 +42,19 @@ type PreRequest interface {
 	PreRequest(ctx context.Context, request *types.InferenceRequest, schedulingResult *types.SchedulingResult)
 }
 
+// RequestMutator is called by the director after the PreRequest plugins, to mutate the request before it is sent to the
+// selected model server, e.g. to inject routing hints in its headers or to rewrite its model field. RequestMutators
+// run in the order configured in the requestControl section of the configuration, each one seeing the mutations of the
+// previous ones.
+//
+// Headers are mutated through the given mutation. The body can be mutated through the PayloadMap of request.Body, if
+// the request was parsed into one; other payloads are forwarded as is. If an error is returned, the request is not
+// sent and the error is returned to the client if it is an inference error.
+type RequestMutator interface {
+	plugin.Plugin
+	MutateRequest(ctx context.Context, request *types.InferenceRequest, mutation *RequestMutation) error
+}
+
 // ResponseHeaderProcessor is called by the director after the response headers are successfully received
 // which indicates the beginning of the response handling by the model server.
 // The given pod argument is the pod that served the request.
@@ -64,6 +81,17 @@ type ResponseBodyProcessor interface {
 	ResponseBody(ctx context.Context, request *types.InferenceRequest, response *Response, targetEndpoint *datalayer.EndpointMetadata)
 }
 
+// PostResponse is called by the director exactly once per scheduled request, when the response completes or the
+// stream ends, after the ResponseBodyProcessor plugins. It is also called when the request failed or was abandoned
+// by its client after being scheduled. The given pod argument is the pod that served the request.
+//
+// It is the hook for plugins maintaining state from the outcome of requests, such as latency estimators,
+// session-affinity stores or usage exporters.
+type PostResponse interface {
+	plugin.Plugin
+	PostResponse(ctx context.Context, request *types.InferenceRequest, response *CompletedResponse, targetEndpoint *datalayer.EndpointMetadata)
+}
+
 // DataProducer is implemented by data producers which produce data from different sources.
 // PrepareRequestData is called by the director before scheduling requests.
 type DataProducer interface {
@@ -17,11 +17,26 @@ limitations under the License.
 package requestcontrol
 
 import (
+	"time"
+
 	"google.golang.org/protobuf/types/known/structpb"
 
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
 	requesthandling "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
 )
 
+// RequestMutation contains the mutations of the headers of the request sent to the model server, to be filled by the
+// RequestMutator plugins.
+type RequestMutation struct {
+	// TargetEndpoint is the endpoint selected by the primary profile, to which the request is sent.
+	TargetEndpoint *datalayer.EndpointMetadata
+	// SetHeaders are the headers to set on the request. System-owned headers, such as the destination endpoint header,
+	// cannot be set.
+	SetHeaders map[string]string
+	// RemoveHeaders are the headers to remove from the request.
+	RemoveHeaders []string
+}
+
 // Response contains information from the response received to be passed to the Response requestcontrol plugins
 type Response struct {
 	// RequestId is the Envoy generated Id for the request being processed
@@ -32,6 +47,12 @@ type Response struct {
 	StartOfStream bool
 	// EndOfStream when true indicates that this invocation contains the last chunk of the response
 	EndOfStream bool
+	// Abandoned when true, together with EndOfStream, indicates that the response did not complete because the client
+	// disconnected. Plugins may use it to cancel the request on the model server.
+	Abandoned bool
+	// Evicted when true, together with EndOfStream, indicates that the request was evicted by flow control after it
+	// was dispatched, while the model server may still be serving it.
+	Evicted bool
 	// ReqMetadata is a map of metadata that can be passed from Envoy.
 	// It is populated with Envoy's dynamic metadata when ext_proc is processing ProcessingRequest_ResponseHeaders.
 	// Currently, this is only used by conformance test.
@@ -42,3 +63,27 @@ type Response struct {
 	// metadata when processing ProcessingResponse_RequestHeaders.
 	DynamicMetadata *structpb.Struct
 }
+
+// CompletedResponse contains the outcome of a completed response, to be passed to the PostResponse plugins.
+type CompletedResponse struct {
+	// RequestId is the Envoy generated Id for the request being processed
+	RequestId string
+	// Headers is a map of the response headers. Nil if the response headers were not received.
+	Headers map[string]string
+	// Trailers is a map of the response trailers. Nil if the response had no trailers.
+	Trailers map[string]string
+	// Token usage counts parsed from the response body.
+	Usage requesthandling.Usage
+	// Failed indicates that the model server responded with an error status.
+	Failed bool
+	// Abandoned indicates that the response did not complete because the client disconnected.
+	Abandoned bool
+	// TTFT is the time from the reception of the request to the first chunk of the response body. Zero if no response
+	// body was received.
+	TTFT time.Duration
+	// InterTokenLatency is the mean time between the token events of a streamed response. Zero if the response was not
+	// streamed or had fewer than two token events.
+	InterTokenLatency time.Duration
+	// Latency is the time from the reception of the request to the completion of the response.
+	Latency time.Duration
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requesthandling
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// AnthropicMessagesRequest is a structured representation of the fields we parse out of the Anthropic /v1/messages
+// request body. For detailed body fields, please refer to https://docs.anthropic.com/en/api/messages.
+// This struct includes fields usable for plugins and scheduling decisions - and not the entire
+// API spec.
+type AnthropicMessagesRequest struct {
+	// System is the optional system prompt, a string or an array of text blocks.
+	System AnthropicContent `json:"system,omitzero"`
+	// Messages are the input messages, alternating between the user and assistant roles.
+	Messages []AnthropicMessage `json:"messages,omitempty"`
+	// Tools are the definitions of the tools the model may use.
+	Tools []any `json:"tools,omitempty"`
+	// MaxTokens is the maximum number of tokens to generate.
+	MaxTokens int `json:"max_tokens,omitempty"`
+}
+
+func (r *AnthropicMessagesRequest) String() string {
+	if r == nil {
+		return nilStr
+	}
+	return fmt.Sprintf("{MessagesCount: %d, MaxTokens: %d}", len(r.Messages), r.MaxTokens)
+}
+
+// PlainText returns a plain-text representation of the prompt: the system prompt, the tool definitions and the
+// messages, in the order the model server renders them.
+func (r *AnthropicMessagesRequest) PlainText() string {
+	var sb strings.Builder
+	if text := r.System.PlainText(); text != "" {
+		sb.WriteString(text)
+		sb.WriteString(" ")
+	}
+	if len(r.Tools) > 0 {
+		b, _ := json.Marshal(r.Tools)
+		sb.Write(b)
+		sb.WriteString(" ")
+	}
+	for _, msg := range r.Messages {
+		if text := msg.Content.PlainText(); text != "" {
+			sb.WriteString(text)
+			sb.WriteString(" ")
+		}
+	}
+	return sb.String()
+}
+
+// AnthropicMessage represents a single message in an Anthropic messages request.
+type AnthropicMessage struct {
+	// Role is the message role, 'user' or 'assistant'.
+	Role string `json:"role,omitempty"`
+	// Content is the content of the message.
+	Content AnthropicContent `json:"content"`
+}
+
+// AnthropicContent is the content of a message or the system prompt: a string or an array of content blocks.
+type AnthropicContent struct {
+	Raw    string
+	Blocks []AnthropicContentBlock
+}
+
+// AnthropicContentBlock is a content block of a message, e.g. text, image, tool_use or tool_result.
+type AnthropicContentBlock struct {
+	Type string `json:"type"`
+	// Text is the text of a text block.
+	Text string `json:"text,omitempty"`
+	// Source is the source of an image or document block.
+	Source any `json:"source,omitempty"`
+	// ID, Name and Input describe the tool call of a tool_use block.
+	ID    string `json:"id,omitempty"`
+	Name  string `json:"name,omitempty"`
+	Input any    `json:"input,omitempty"`
+	// ToolUseID and Content describe the result of a tool call in a tool_result block.
+	ToolUseID string `json:"tool_use_id,omitempty"`
+	Content   any    `json:"content,omitempty"`
+}
+
+func (c *AnthropicContent) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err == nil {
+		c.Raw = str
+		return nil
+	}
+	var blocks []AnthropicContentBlock
+	if err := json.Unmarshal(data, &blocks); err == nil {
+		c.Blocks = blocks
+		return nil
+	}
+	return errors.New("content format not supported")
+}
+
+func (c AnthropicContent) MarshalJSON() ([]byte, error) {
+	if c.Raw != "" {
+		return json.Marshal(c.Raw)
+	}
+	if c.Blocks != nil {
+		return json.Marshal(c.Blocks)
+	}
+	return json.Marshal("")
+}
+
+// IsZero reports whether the content is empty.
+func (c AnthropicContent) IsZero() bool {
+	return c.Raw == "" && len(c.Blocks) == 0
+}
+
+// PlainText returns the text of the content, including the inputs of the tool calls and the tool results, which are
+// rendered in the prompt as well.
+func (c AnthropicContent) PlainText() string {
+	if c.Raw != "" {
+		return c.Raw
+	}
+	var sb strings.Builder
+	for _, block := range c.Blocks {
+		switch block.Type {
+		case "text":
+			sb.WriteString(block.Text)
+			sb.WriteString(" ")
+		case "tool_use":
+			b, _ := json.Marshal(block.Input)
+			sb.Write(b)
+			sb.WriteString(" ")
+		case "tool_result":
+			if text, ok := block.Content.(string); ok {
+				sb.WriteString(text)
+			} else {
+				b, _ := json.Marshal(block.Content)
+				sb.Write(b)
+			}
+			sb.WriteString(" ")
+		}
+	}
+	return sb.String()
+}
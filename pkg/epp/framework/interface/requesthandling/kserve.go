@@ -0,0 +1,49 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requesthandling
+
+import "fmt"
+
+// KServeInferRequest is a structured representation of the fields we parse out of a ModelInferRequest of the KServe v2
+// gRPC inference protocol, as served by Triton. For detailed fields, please refer to
+// https://github.com/kserve/open-inference-protocol/blob/main/specification/protocol/inference_grpc.md.
+// This struct includes fields usable for plugins and scheduling decisions - and not the entire
+// API spec.
+type KServeInferRequest struct {
+	// ModelName is the name of the model to use for inferencing.
+	ModelName string
+	// ModelVersion is the optional version of the model.
+	ModelVersion string
+	// ID is the optional identifier of the request.
+	ID string
+	// Inputs are the input tensors of the request, without their contents.
+	Inputs []KServeInferInput
+}
+
+// KServeInferInput describes an input tensor of a KServe v2 inference request.
+type KServeInferInput struct {
+	Name     string
+	Datatype string
+	Shape    []int64
+}
+
+func (r *KServeInferRequest) String() string {
+	if r == nil {
+		return nilStr
+	}
+	return fmt.Sprintf("{ModelName: %s, InputsCount: %d}", r.ModelName, len(r.Inputs))
+}
@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"strings"
 
 	"google.golang.org/protobuf/proto"
@@ -45,6 +46,30 @@ type PayloadMap map[string]any
 func (PayloadMap) isRequestPayload() {}
 func (PayloadMap) IsParsed() bool    { return true }
 
+// MaxTokensFields are the request body fields holding the requested completion length, in order of precedence.
+var MaxTokensFields = []string{"max_completion_tokens", "max_tokens", "max_output_tokens"}
+
+// maxRequestedTokens bounds the requested completion lengths, so that the sums of token counts cannot overflow.
+const maxRequestedTokens = math.MaxInt32
+
+// MaxTokens returns the body field holding the requested completion length and its value, clamped between 0 and
+// math.MaxInt32 as clients may send any number. It returns an empty field if the request does not request a
+// completion length.
+func (p PayloadMap) MaxTokens() (string, int) {
+	for _, field := range MaxTokensFields {
+		switch value := p[field].(type) {
+		case float64:
+			if !(value > 0) {
+				return field, 0
+			}
+			return field, int(min(value, maxRequestedTokens))
+		case int:
+			return field, min(max(value, 0), maxRequestedTokens)
+		}
+	}
+	return "", 0
+}
+
 // PayloadProto represents a gRPC request body unmarshaled into a proto.Message.
 type PayloadProto struct {
 	proto.Message
@@ -61,7 +86,7 @@ func (RawPayload) IsParsed() bool    { return false }
 
 // InferenceRequestBody contains the request-body fields that we parse out as user input,
 // to be used in forming scheduling decisions.
-// An InferenceRequestBody must contain exactly one of CompletionsRequest, ChatCompletionsRequest, ResponsesRequest, ConversationsRequest, or EmbeddingsRequest.
+// An InferenceRequestBody must contain exactly one of CompletionsRequest, ChatCompletionsRequest, ResponsesRequest, ConversationsRequest, EmbeddingsRequest, AnthropicMessagesRequest, or KServeInferRequest.
 type InferenceRequestBody struct {
 	// CompletionsRequest is the representation of the OpenAI /v1/completions request body.
 	Completions *CompletionsRequest `json:"completions,omitempty"`
@@ -73,6 +98,10 @@ type InferenceRequestBody struct {
 	Conversations *ConversationsRequest `json:"conversations,omitempty"`
 	// EmbeddingsRequest is the representation of the OpenAI /v1/embeddings request body.
 	Embeddings *EmbeddingsRequest `json:"embeddings,omitempty"`
+	// AnthropicMessagesRequest is the representation of the Anthropic /v1/messages request body.
+	AnthropicMessages *AnthropicMessagesRequest `json:"anthropic_messages,omitempty"`
+	// KServeInferRequest is the representation of the KServe v2 gRPC ModelInferRequest.
+	KServeInfer *KServeInferRequest `json:"kserve_infer,omitempty"`
 	// Payload contains the unmarshaled request payload or raw bytes.
 	// If the payload is unmarshaled, we can perform advanced processing (like prefix cache aware routing).
 	// If it remains as raw bytes, such processing may not be supported.
@@ -81,6 +110,10 @@ type InferenceRequestBody struct {
 	// It is nil when the request was not already tokenized.
 	TokenizedPrompt *TokenizedPrompt `json:"-"`
 
+	// Model is the model name extracted by parsers of payloads that are not a PayloadMap, e.g. gRPC payloads. The model
+	// name of a PayloadMap is read from, and rewritten in, its "model" field.
+	Model string `json:"-"`
+
 	// Stream indicates whether the request specifies a streaming response (e.g., via a stream field).
 	// This typically implies the model server's response will be streamed.
 	Stream bool `json:"-"`
@@ -119,12 +152,23 @@ func (r *InferenceRequestBody) PromptText() string {
 		return r.Completions.Prompt.PlainText()
 	case r.ChatCompletions != nil:
 		var sb strings.Builder
+		// The tool definitions and calls are rendered in the prompt by the chat template as well.
+		if len(r.ChatCompletions.Tools) > 0 {
+			b, _ := json.Marshal(r.ChatCompletions.Tools)
+			sb.Write(b)
+			sb.WriteString(" ")
+		}
 		for _, msg := range r.ChatCompletions.Messages {
 			text := msg.Content.PlainText()
 			if text != "" {
 				sb.WriteString(text)
 				sb.WriteString(" ")
 			}
+			if len(msg.ToolCalls) > 0 {
+				b, _ := json.Marshal(msg.ToolCalls)
+				sb.Write(b)
+				sb.WriteString(" ")
+			}
 		}
 		return sb.String()
 	case r.Responses != nil:
@@ -138,6 +182,8 @@ func (r *InferenceRequestBody) PromptText() string {
 		return string(b)
 	case r.Embeddings != nil:
 		return r.Embeddings.Input.PlainText()
+	case r.AnthropicMessages != nil:
+		return r.AnthropicMessages.PlainText()
 	default:
 		return ""
 	}
@@ -217,6 +263,24 @@ func parseArrayInput(v []any, errorPrefix string) (arrayInputResult, error) {
 			uint32s[i] = uint32(flt)
 		}
 		return arrayInputResult{TokenIDs: uint32s}, nil
+	case []any:
+		// Batches of token ID arrays are flattened, as only their total token count matters for scheduling.
+		var tokenIDs []uint32
+		for _, val := range v {
+			batch, ok := val.([]any)
+			if !ok {
+				return arrayInputResult{}, fmt.Errorf("%s: mixed types in array", errorPrefix)
+			}
+			res, err := parseArrayInput(batch, errorPrefix)
+			if err != nil {
+				return arrayInputResult{}, err
+			}
+			if len(res.Strings) > 0 {
+				return arrayInputResult{}, fmt.Errorf("%s: nested arrays must contain token IDs", errorPrefix)
+			}
+			tokenIDs = append(tokenIDs, res.TokenIDs...)
+		}
+		return arrayInputResult{TokenIDs: tokenIDs}, nil
 	default:
 		return arrayInputResult{}, fmt.Errorf("%s: unsupported array element type", errorPrefix)
 	}
@@ -440,6 +504,12 @@ type Message struct {
 	Role string `json:"role,omitempty"`
 	// Content defines text of this message
 	Content Content `json:"content"`
+	// Name is the optional name of the participant.
+	Name string `json:"name,omitempty"`
+	// ToolCalls are the tool calls generated by the model in an assistant message.
+	ToolCalls []any `json:"tool_calls,omitempty"`
+	// ToolCallID is the tool call that a tool message responds to.
+	ToolCallID string `json:"tool_call_id,omitempty"`
 }
 
 type Content struct {
@@ -459,6 +529,13 @@ type ImageBlock struct {
 	Url string `json:"url,omitempty"`
 }
 
+// UnmarshalJSON accepts both the object form of the spec and the bare URL string some clients send.
+func (b *ImageBlock) UnmarshalJSON(data []byte) error {
+	url, err := unmarshalURLBlock(data)
+	b.Url = url
+	return err
+}
+
 type AudioBlock struct {
 	Data   string `json:"data,omitempty"`
 	Format string `json:"format,omitempty"`
@@ -468,6 +545,28 @@ type VideoBlock struct {
 	Url string `json:"url,omitempty"`
 }
 
+// UnmarshalJSON accepts both the object form and a bare URL string.
+func (b *VideoBlock) UnmarshalJSON(data []byte) error {
+	url, err := unmarshalURLBlock(data)
+	b.Url = url
+	return err
+}
+
+// unmarshalURLBlock returns the URL of a media content block, given as a string or as an object with a url field.
+func unmarshalURLBlock(data []byte) (string, error) {
+	var url string
+	if err := json.Unmarshal(data, &url); err == nil {
+		return url, nil
+	}
+	var block struct {
+		Url string `json:"url"`
+	}
+	if err := json.Unmarshal(data, &block); err != nil {
+		return "", errors.New("media block: must be a URL string or an object")
+	}
+	return block.Url, nil
+}
+
 // UnmarshalJSON allow use both format
 func (mc *Content) UnmarshalJSON(data []byte) error {
 	// Raw format
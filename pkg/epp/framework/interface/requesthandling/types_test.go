@@ -57,6 +57,39 @@ func TestLLMRequestBody_PromptText(t *testing.T) {
 			},
 			expected: "Hello, how are you? ",
 		},
+		{
+			name: "chat completions with tools and tool calls",
+			body: &InferenceRequestBody{
+				ChatCompletions: &ChatCompletionsRequest{
+					Tools: []any{map[string]any{"type": "function"}},
+					Messages: []Message{
+						{Role: "user", Content: Content{Raw: "Weather?"}},
+						{Role: "assistant", ToolCalls: []any{map[string]any{"id": "call_1"}}},
+						{Role: "tool", ToolCallID: "call_1", Content: Content{Raw: "sunny"}},
+					},
+				},
+			},
+			expected: `[{"type":"function"}] Weather? [{"id":"call_1"}] sunny `,
+		},
+		{
+			name: "anthropic messages with system prompt and tool use",
+			body: &InferenceRequestBody{
+				AnthropicMessages: &AnthropicMessagesRequest{
+					System: AnthropicContent{Raw: "Be brief."},
+					Messages: []AnthropicMessage{
+						{Role: "user", Content: AnthropicContent{Raw: "Weather?"}},
+						{Role: "assistant", Content: AnthropicContent{Blocks: []AnthropicContentBlock{
+							{Type: "text", Text: "Checking."},
+							{Type: "tool_use", ID: "toolu_1", Input: map[string]any{"city": "Paris"}},
+						}}},
+						{Role: "user", Content: AnthropicContent{Blocks: []AnthropicContentBlock{
+							{Type: "tool_result", ToolUseID: "toolu_1", Content: "sunny"},
+						}}},
+					},
+				},
+			},
+			expected: `Be brief. Weather? Checking. {"city":"Paris"}  sunny  `,
+		},
 		{
 			name: "chat completions with multiple messages",
 			body: &InferenceRequestBody{
@@ -193,9 +226,9 @@ func TestPrompt_UnmarshalJSON(t *testing.T) {
 		},
 
 		{
-			name:    "array of arrays of integers prompt is rejected for now",
-			input:   `[[1,2],[3,4]]`,
-			wantErr: true,
+			name:  "array of arrays of integers prompt is flattened",
+			input: `[[1,2],[3,4]]`,
+			want:  Prompt{TokenIDs: []uint32{1, 2, 3, 4}},
 		},
 
 		{
@@ -253,8 +286,13 @@ func TestEmbeddingsInput_UnmarshalJSON(t *testing.T) {
 		},
 
 		{
-			name:    "array of arrays of integers input is rejected for now",
-			input:   `[[1,2],[3,4]]`,
+			name:  "array of arrays of integers input is flattened",
+			input: `[[1,2],[3,4]]`,
+			want:  EmbeddingsInput{TokenIDs: []uint32{1, 2, 3, 4}},
+		},
+		{
+			name:    "array of arrays of strings input is rejected",
+			input:   `[["hello"],["world"]]`,
 			wantErr: true,
 		},
 
@@ -371,3 +409,37 @@ func TestPrompt_MarshalJSON(t *testing.T) {
 	empty, _ := Prompt{}.MarshalJSON()
 	assert.Equal(t, `""`, string(empty))
 }
+
+func TestPayloadMap_MaxTokens(t *testing.T) {
+	tests := []struct {
+		name          string
+		payload       PayloadMap
+		expectedField string
+		expectedValue int
+	}{
+		{name: "not requested", payload: PayloadMap{"model": "m"}},
+		{name: "max_tokens", payload: PayloadMap{"max_tokens": float64(128)}, expectedField: "max_tokens", expectedValue: 128},
+		{
+			name:          "max_completion_tokens takes precedence",
+			payload:       PayloadMap{"max_tokens": float64(128), "max_completion_tokens": 64},
+			expectedField: "max_completion_tokens",
+			expectedValue: 64,
+		},
+		{name: "non-numeric value ignored", payload: PayloadMap{"max_tokens": "128"}},
+		{name: "negative value clamped", payload: PayloadMap{"max_tokens": float64(-1000000)}, expectedField: "max_tokens"},
+		{name: "negative int clamped", payload: PayloadMap{"max_output_tokens": -5}, expectedField: "max_output_tokens"},
+		{
+			name:          "out of range value clamped",
+			payload:       PayloadMap{"max_tokens": 1e300},
+			expectedField: "max_tokens",
+			expectedValue: maxRequestedTokens,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			field, value := test.payload.MaxTokens()
+			assert.Equal(t, test.expectedField, field)
+			assert.Equal(t, test.expectedValue, value)
+		})
+	}
+}
@@ -55,6 +55,17 @@ type ProfileHandler interface {
 		profileResults map[string]*ProfileRunResult) (*SchedulingResult, error)
 }
 
+// ProfileResultsProcessor defines the extension point combining the outputs of the profiles run for a request, after
+// the ProfileHandler processed them. For example, a processor may add the targets of a secondary profile to the
+// targets of the primary profile, or pick the endpoints of the different stages of disaggregated serving from
+// different profiles. Processors run in the order of their declaration in the configuration, each one receiving the
+// result of the previous one.
+type ProfileResultsProcessor interface {
+	plugin.Plugin
+	ProcessProfileResults(ctx context.Context, cycleState *CycleState, request *InferenceRequest,
+		result *SchedulingResult) (*SchedulingResult, error)
+}
+
 // Filter defines the interface for filtering a list of pods based on context.
 type Filter interface {
 	plugin.Plugin
@@ -71,8 +82,26 @@ type Scorer interface {
 	Score(ctx context.Context, cycleState *CycleState, request *InferenceRequest, pods []Endpoint) map[Endpoint]float64
 }
 
+// CacheableScorer is a Scorer whose score of an endpoint only depends on the metrics of that endpoint and on the class
+// of the request, so that the scheduler can cache its scores by endpoint and request class until the metrics of the
+// endpoint are updated, instead of scoring again at each cycle. Scorers normalizing their scores across the candidate
+// endpoints, or reading endpoint attributes or cycle state, must not implement it.
+type CacheableScorer interface {
+	Scorer
+	// RequestClass returns the class of the given request: requests of the same class get the same score on an
+	// endpoint with the same metrics.
+	RequestClass(request *InferenceRequest) string
+}
+
 // Picker picks the final pod(s) to send the request to.
 type Picker interface {
 	plugin.Plugin
 	Pick(ctx context.Context, cycleState *CycleState, scoredPods []*ScoredEndpoint) *ProfileRunResult
 }
+
+// RequestAwarePicker is a Picker whose choice depends on the request being scheduled. When a profile's picker
+// implements it, PickForRequest is called instead of Pick.
+type RequestAwarePicker interface {
+	Picker
+	PickForRequest(ctx context.Context, cycleState *CycleState, request *InferenceRequest, scoredPods []*ScoredEndpoint) *ProfileRunResult
+}
@@ -19,7 +19,9 @@ package scheduling
 import (
 	"context"
 	"fmt"
+	"net"
 	"reflect"
+	"time"
 
 	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
 	fwkrh "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
@@ -38,8 +40,68 @@ type MultiModalFeature = fwkrh.MultiModalFeature
 // RequestObjectives represents the scheduling objectives parsed from the InferenceObjectiveSpec, to be used in scheduling decisions.
 type RequestObjectives struct {
 	Priority int
+	// TTFT is the time to first token objective of the request. Zero means no objective was declared.
+	TTFT time.Duration
+	// TPOT is the average time per output token objective of the request. Zero means no objective was declared.
+	TPOT time.Duration
+	// Precision is the model precision requirement of the request. Empty means the request has no requirement.
+	Precision PrecisionRequirement
+	// Idempotent is whether the request can be sent to the model servers more than once, e.g. to fallback
+	// destinations or redundantly.
+	Idempotent bool
+}
+
+// Names of the scheduling hints, as allowed by the configuration of the EPP.
+const (
+	// HintPinEndpoint pins the request to a single endpoint.
+	HintPinEndpoint = "pin-endpoint"
+	// HintExcludeEndpoints excludes endpoints from the candidates of the request.
+	HintExcludeEndpoints = "exclude-endpoints"
+	// HintForceProfile schedules the request with a single profile, bypassing the profile handler.
+	HintForceProfile = "force-profile"
+	// HintDisableCacheAffinity disables the cache-affinity filters and scorers for the request.
+	HintDisableCacheAffinity = "disable-cache-affinity"
+)
+
+// SchedulingHintNames are the names of all the scheduling hints.
+var SchedulingHintNames = []string{HintPinEndpoint, HintExcludeEndpoints, HintForceProfile, HintDisableCacheAffinity}
+
+// SchedulingHints are the placement hints of a request, declared through its headers, e.g. by debugging or
+// traffic-shaping tooling. Only the hints allowed by the configuration of the EPP are parsed.
+type SchedulingHints struct {
+	// PinEndpoint is the endpoint the request must be served by, as its name, namespace/name or address:port. Empty
+	// means the request is not pinned.
+	PinEndpoint string
+	// ExcludeEndpoints are the endpoints the request must not be served by, as names, namespace/names or addresses:ports.
+	ExcludeEndpoints []string
+	// Profile is the only profile the request is scheduled with. Empty means the profile handler picks the profiles.
+	Profile string
+	// DisableCacheAffinity disables the cache-affinity filters and scorers for the request, e.g. to measure the
+	// latency of a cold cache.
+	DisableCacheAffinity bool
+}
+
+// EndpointMatches returns whether the given endpoint reference, a name, namespace/name or address:port, designates
+// the endpoint of the given metadata.
+func EndpointMatches(ref string, metadata *fwkdl.EndpointMetadata) bool {
+	if metadata == nil {
+		return false
+	}
+	return ref == metadata.NamespacedName.Name || ref == metadata.NamespacedName.String() ||
+		ref == net.JoinHostPort(metadata.GetIPAddress(), metadata.GetPort())
 }
 
+// PrecisionRequirement is the model precision requirement of a request, for pools serving the same model at several
+// precisions.
+type PrecisionRequirement string
+
+const (
+	// PrecisionFull requires the request to be served by a full-precision endpoint.
+	PrecisionFull PrecisionRequirement = "Full"
+	// PrecisionPreferQuantized prefers serving the request by a quantized endpoint.
+	PrecisionPreferQuantized PrecisionRequirement = "PreferQuantized"
+)
+
 // InferenceRequest is a structured representation of the fields we parse out of the InferenceRequest body.
 type InferenceRequest struct {
 	// RequestId is the Envoy generated Id for the request being processed
@@ -57,6 +119,10 @@ type InferenceRequest struct {
 	RequestSizeBytes int
 	// SchedulingResult captures the scheduling decisions made during the cycle.
 	SchedulingResult *SchedulingResult
+	// Hints are the placement hints of the request allowed by the configuration.
+	Hints SchedulingHints
+	// FairnessID identifies the tenant of the request, e.g. for fairness and rate limiting.
+	FairnessID string
 }
 
 func (r *InferenceRequest) String() string {
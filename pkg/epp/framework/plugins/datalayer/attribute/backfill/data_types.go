@@ -0,0 +1,44 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backfill
+
+import (
+	"time"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+const (
+	SyntheticMetricsKey = "SyntheticMetricsKey"
+)
+
+// SyntheticMetrics flags an endpoint whose queue and KV cache metrics were synthesized from the dispatch and
+// completion accounting of the EPP, because its last scrape is stale.
+type SyntheticMetrics struct {
+	// LastScrape is the time of the last successful scrape of the endpoint.
+	LastScrape time.Time
+	// InFlightDelta is the change of the number of in-flight requests of the endpoint since its last scrape.
+	InFlightDelta int64
+}
+
+func (s *SyntheticMetrics) Clone() fwkdl.Cloneable {
+	if s == nil {
+		return nil
+	}
+	clone := *s
+	return &clone
+}
@@ -28,6 +28,9 @@ const (
 type InFlightLoad struct {
 	Tokens   int64
 	Requests int64
+
+	// RequestOutputTokens is the estimated completion length of the request being scheduled, 0 if unknown.
+	RequestOutputTokens int64
 }
 
 func (l *InFlightLoad) Clone() fwkdl.Cloneable {
@@ -37,5 +40,7 @@ func (l *InFlightLoad) Clone() fwkdl.Cloneable {
 	return &InFlightLoad{
 		Tokens:   l.Tokens,
 		Requests: l.Requests,
+
+		RequestOutputTokens: l.RequestOutputTokens,
 	}
 }
@@ -0,0 +1,48 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fingerprint
+
+import (
+	"time"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+const (
+	PerformanceFingerprintKey = "PerformanceFingerprintKey"
+)
+
+// PerformanceFingerprint captures the measured performance of an endpoint for the context length of the request
+// being scheduled.
+type PerformanceFingerprint struct {
+	// TokensPerSecond is the measured decode throughput of a request, in output tokens per second. Zero if unknown.
+	TokensPerSecond float64
+	// TTFT is the typical time to first token. Zero if unknown.
+	TTFT time.Duration
+	// WarmUp is the time the endpoint took to serve its first successful request after it was added. Zero if unknown.
+	WarmUp time.Duration
+	// Samples is the number of responses the throughput and TTFT were measured from.
+	Samples int64
+}
+
+func (f *PerformanceFingerprint) Clone() fwkdl.Cloneable {
+	if f == nil {
+		return nil
+	}
+	clone := *f
+	return &clone
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orca
+
+import (
+	"maps"
+	"time"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+const (
+	LoadReportKey = "OrcaLoadReportKey"
+)
+
+// LoadReport is the last ORCA (Open Request Cost Aggregation) load report received from an endpoint, in the headers or
+// trailers of one of its responses.
+type LoadReport struct {
+	// ReceivedAt is the time the report was received.
+	ReceivedAt time.Time
+	// CPUUtilization is the CPU utilization of the endpoint.
+	CPUUtilization float64
+	// MemUtilization is the memory utilization of the endpoint.
+	MemUtilization float64
+	// ApplicationUtilization is the utilization of the endpoint as defined by the model server.
+	ApplicationUtilization float64
+	// RPSFractional is the rate of requests served by the endpoint, per second.
+	RPSFractional float64
+	// Utilization holds the named utilizations of the endpoint, in [0, 1].
+	Utilization map[string]float64
+	// NamedMetrics holds the other named metrics of the endpoint.
+	NamedMetrics map[string]float64
+}
+
+func (r *LoadReport) Clone() fwkdl.Cloneable {
+	if r == nil {
+		return nil
+	}
+	clone := *r
+	clone.Utilization = maps.Clone(r.Utilization)
+	clone.NamedMetrics = maps.Clone(r.NamedMetrics)
+	return &clone
+}
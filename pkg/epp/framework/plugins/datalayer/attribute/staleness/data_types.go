@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package staleness
+
+import (
+	"time"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+const (
+	MetricsAgeKey = "MetricsAgeKey"
+)
+
+// MetricsAge is the age of the metrics of a candidate endpoint when a request is scheduled, so that the filters and
+// scorers can tell stale metrics apart from fresh ones.
+type MetricsAge struct {
+	// LastScrape is the time of the last successful scrape of the endpoint, zero if it was never scraped.
+	LastScrape time.Time
+	// Age is the time elapsed since the last successful scrape of the endpoint, zero if it was never scraped.
+	Age time.Duration
+	// Stale is whether the age exceeds the metrics staleness threshold of the EPP, or the endpoint was never scraped.
+	Stale bool
+}
+
+func (a *MetricsAge) Clone() fwkdl.Cloneable {
+	if a == nil {
+		return nil
+	}
+	clone := *a
+	return &clone
+}
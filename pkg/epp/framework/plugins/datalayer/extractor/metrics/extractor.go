@@ -43,15 +43,24 @@ const (
 	MaxActiveModelsKey     = "MaxActiveModels"
 	ActiveModelsKey        = "ActiveModels"
 	WaitingModelsKey       = "WaitingModels"
-	UpdateTimeKey          = "UpdateTime"
+	// AdapterRunningRequestsKey and AdapterWaitingRequestsKey are the per-adapter request counts.
+	AdapterRunningRequestsKey = "AdapterRunningRequests"
+	AdapterWaitingRequestsKey = "AdapterWaitingRequests"
+	UpdateTimeKey             = "UpdateTime"
+	MaxConcurrencyKey         = "MaxConcurrency"
+	PrecisionKey              = "Precision"
 
 	// LoRA metrics based on MSP
 	LoraInfoRunningAdaptersMetricName = "running_lora_adapters"
 	LoraInfoWaitingAdaptersMetricName = "waiting_lora_adapters"
 	LoraInfoMaxAdaptersMetricName     = "max_lora"
+	// LoraAdapterLabelName is the default label naming the adapter of the per-adapter request metrics.
+	LoraAdapterLabelName = "lora_name"
 
 	CacheConfigBlockSizeInfoMetricName = "block_size"
 	CacheConfigNumGPUBlocksMetricName  = "num_gpu_blocks"
+
+	PrecisionInfoQuantizationLabelName = "quantization"
 )
 
 // Extractor implements the metrics extraction based on the model
@@ -86,6 +95,16 @@ func (ext *Extractor) TypedName() fwkplugin.TypedName {
 	return ext.typedName
 }
 
+// ReportsMaxConcurrency returns whether the mapping of any engine extracts the max concurrency of the model servers.
+func (ext *Extractor) ReportsMaxConcurrency() bool {
+	for _, mapping := range ext.registry.mappings {
+		if mapping.MaxConcurrency != nil {
+			return true
+		}
+	}
+	return false
+}
+
 // ExpectedType defines the type expected by the metrics.Extractor - a
 // parsed output from a Prometheus metrics endpoint.
 func (ext *Extractor) ExpectedInputType() reflect.Type {
@@ -130,10 +149,25 @@ func (ext *Extractor) Extract(ctx context.Context, data any, ep fwkdl.Endpoint)
 	}
 
 	if spec := mapping.KVCacheUtilization; spec != nil { // extract KV cache usage
-		if metric, err := spec.getLatestMetric(families); err != nil {
+		if metric, err := spec.getLatestMetric(families); err == nil {
+			clone.KVCacheUsagePercent = extractValue(metric)
+			updated = true
+		} else if usage, ok := kvUsageFromBlocks(mapping, families); ok {
+			clone.KVCacheUsagePercent = usage
+			updated = true
+		} else {
+			errs = append(errs, err)
+		}
+	} else if usage, ok := kvUsageFromBlocks(mapping, families); ok {
+		clone.KVCacheUsagePercent = usage
+		updated = true
+	}
+
+	if spec := mapping.KVUsedTokens; spec != nil && mapping.KVCacheUtilization == nil && mapping.KVTokenCapacity > 0 {
+		if metric, err := spec.getLatestMetric(families); err != nil { // derive KV cache usage from the tokens in use
 			errs = append(errs, err)
 		} else {
-			clone.KVCacheUsagePercent = extractValue(metric)
+			clone.KVCacheUsagePercent = min(extractValue(metric)/float64(mapping.KVTokenCapacity), 1)
 			updated = true
 		}
 	}
@@ -143,7 +177,29 @@ func (ext *Extractor) Extract(ctx context.Context, data any, ep fwkdl.Endpoint)
 		if err != nil {
 			errs = append(errs, err)
 		} else if metric != nil {
-			populateLoRAMetrics(clone, metric, &errs)
+			populateLoRAMetrics(clone, metric, loraLabels(mapping), &errs)
+			updated = true
+		}
+	}
+
+	adapterLabel := mapping.LoraAdapterLabel
+	if adapterLabel == "" {
+		adapterLabel = LoraAdapterLabelName
+	}
+	if spec := mapping.LoraRunningRequests; spec != nil { // extract per-adapter running requests
+		if requests, err := spec.adapterRequests(families, adapterLabel); err != nil {
+			errs = append(errs, err)
+		} else {
+			clone.AdapterRunningRequests = requests
+			updated = true
+		}
+	}
+
+	if spec := mapping.LoraWaitingRequests; spec != nil { // extract per-adapter waiting requests
+		if requests, err := spec.adapterRequests(families, adapterLabel); err != nil {
+			errs = append(errs, err)
+		} else {
+			clone.AdapterWaitingRequests = requests
 			updated = true
 		}
 	}
@@ -184,6 +240,29 @@ func (ext *Extractor) Extract(ctx context.Context, data any, ep fwkdl.Endpoint)
 		}
 	}
 
+	if spec := mapping.MaxConcurrency; spec != nil { // extract advertised max concurrency as direct gauge value
+		if metric, err := spec.getLatestMetric(families); err != nil {
+			errs = append(errs, err)
+		} else {
+			clone.MaxConcurrency = int(extractValue(metric))
+			updated = true
+		}
+	}
+
+	if spec := mapping.PrecisionInfo; spec != nil { // extract the served precision (label)
+		metric, err := spec.getLatestMetric(families)
+		if err != nil {
+			errs = append(errs, err)
+		} else if metric != nil {
+			precisionLabel := mapping.PrecisionLabel
+			if precisionLabel == "" {
+				precisionLabel = PrecisionInfoQuantizationLabelName
+			}
+			clone.Precision = labelValue(metric, precisionLabel)
+			updated = true
+		}
+	}
+
 	logger := log.FromContext(ctx).WithValues("endpoint", ep.GetMetadata().NamespacedName)
 	if updated {
 		clone.UpdateTime = time.Now()
@@ -197,6 +276,23 @@ func (ext *Extractor) Extract(ctx context.Context, data any, ep fwkdl.Endpoint)
 	return nil
 }
 
+// kvUsageFromBlocks derives the KV cache usage from the KV cache blocks in use and the total KV cache blocks, for
+// engines that do not report it, or not in all their versions. It returns false if the blocks are not reported.
+func kvUsageFromBlocks(mapping *Mapping, families sourcemetrics.PrometheusMetricMap) (float64, bool) {
+	if mapping.KVUsedBlocks == nil || mapping.CacheNumBlocks == nil {
+		return 0, false
+	}
+	used, err := mapping.KVUsedBlocks.getLatestMetric(families)
+	if err != nil {
+		return 0, false
+	}
+	total, err := mapping.CacheNumBlocks.getLatestMetric(families)
+	if err != nil || extractValue(total) <= 0 {
+		return 0, false
+	}
+	return min(extractValue(used)/extractValue(total), 1), true
+}
+
 // getEngineTypeFromEndpoint extracts the engine type from endpoint metadata labels.
 func getEngineTypeFromEndpoint(ep fwkdl.Endpoint, labelKey string) string {
 	meta := ep.GetMetadata()
@@ -212,18 +308,43 @@ func getEngineTypeFromEndpoint(ep fwkdl.Endpoint, labelKey string) string {
 	return engineType
 }
 
+// loraInfoLabels are the names of the labels of the LoRA info metric.
+type loraInfoLabels struct {
+	running, waiting, max string
+}
+
+// loraLabels returns the names of the labels of the LoRA info metric of the mapping, defaulting to those of the model
+// server protocol.
+func loraLabels(mapping *Mapping) loraInfoLabels {
+	labels := loraInfoLabels{
+		running: mapping.LoraRunningAdaptersLabel,
+		waiting: mapping.LoraWaitingAdaptersLabel,
+		max:     mapping.LoraMaxAdaptersLabel,
+	}
+	if labels.running == "" {
+		labels.running = LoraInfoRunningAdaptersMetricName
+	}
+	if labels.waiting == "" {
+		labels.waiting = LoraInfoWaitingAdaptersMetricName
+	}
+	if labels.max == "" {
+		labels.max = LoraInfoMaxAdaptersMetricName
+	}
+	return labels
+}
+
 // populateLoRAMetrics updates the metrics with LoRA adapter info from the metric labels.
-func populateLoRAMetrics(clone *fwkdl.Metrics, metric *dto.Metric, errs *[]error) {
+func populateLoRAMetrics(clone *fwkdl.Metrics, metric *dto.Metric, labels loraInfoLabels, errs *[]error) {
 	clone.ActiveModels = map[string]int{}
 	clone.WaitingModels = map[string]int{}
 
 	for _, label := range metric.GetLabel() {
 		switch label.GetName() {
-		case LoraInfoRunningAdaptersMetricName:
+		case labels.running:
 			addAdapters(clone.ActiveModels, label.GetValue())
-		case LoraInfoWaitingAdaptersMetricName:
+		case labels.waiting:
 			addAdapters(clone.WaitingModels, label.GetValue())
-		case LoraInfoMaxAdaptersMetricName:
+		case labels.max:
 			if label.GetValue() != "" {
 				if val, err := strconv.Atoi(label.GetValue()); err == nil {
 					clone.MaxActiveModels = val
@@ -262,6 +383,16 @@ func populateCacheInfoMetrics(clone *fwkdl.Metrics, metric *dto.Metric, blockSiz
 	}
 }
 
+// labelValue returns the value of the given label of the metric, or "" if the metric has no such label.
+func labelValue(metric *dto.Metric, name string) string {
+	for _, label := range metric.GetLabel() {
+		if label.GetName() == name {
+			return label.GetValue()
+		}
+	}
+	return ""
+}
+
 // addAdapters splits a comma-separated adapter list and stores keys with default value 0.
 func addAdapters(m map[string]int, csv string) {
 	for name := range strings.SplitSeq(csv, ",") {
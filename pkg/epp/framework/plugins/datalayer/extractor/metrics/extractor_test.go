@@ -430,6 +430,324 @@ func TestDirectGaugeSpecExtraction(t *testing.T) {
 	}
 }
 
+func TestKVUsedTokensExtraction(t *testing.T) {
+	tests := []struct {
+		name       string
+		capacity   int
+		usedTokens float64
+		wantUsage  float64
+	}{
+		{name: "utilization derived from capacity", capacity: 1000, usedTokens: 250, wantUsage: 0.25},
+		{name: "utilization capped at 1", capacity: 1000, usedTokens: 2000, wantUsage: 1},
+		{name: "no capacity", capacity: 0, usedTokens: 250, wantUsage: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := NewMappingRegistry()
+			mapping, err := NewMappingFromConfig(MappingConfig{
+				Queue:           "tgi_queue_size",
+				Running:         "tgi_batch_current_size",
+				KVUsedTokens:    "tgi_batch_current_max_tokens",
+				KVTokenCapacity: tt.capacity,
+			})
+			if err != nil {
+				t.Fatalf("failed to create mapping: %v", err)
+			}
+			if err := registry.Register(DefaultEngineType, mapping); err != nil {
+				t.Fatalf("failed to register mapping: %v", err)
+			}
+			extractor, _ := NewCoreMetricsExtractor(registry, "")
+
+			data := sourcemetrics.PrometheusMetricMap{
+				"tgi_queue_size": &dto.MetricFamily{
+					Type:   dto.MetricType_GAUGE.Enum(),
+					Metric: []*dto.Metric{{Gauge: &dto.Gauge{Value: ptr.To(3.0)}}},
+				},
+				"tgi_batch_current_size": &dto.MetricFamily{
+					Type:   dto.MetricType_GAUGE.Enum(),
+					Metric: []*dto.Metric{{Gauge: &dto.Gauge{Value: ptr.To(8.0)}}},
+				},
+				"tgi_batch_current_max_tokens": &dto.MetricFamily{
+					Type:   dto.MetricType_GAUGE.Enum(),
+					Metric: []*dto.Metric{{Gauge: &dto.Gauge{Value: ptr.To(tt.usedTokens)}}},
+				},
+			}
+
+			ep := fwkdl.NewEndpoint(nil, nil)
+			if err := extractor.Extract(context.Background(), data, ep); err != nil {
+				t.Fatalf("unexpected extraction error: %v", err)
+			}
+			if ep.GetMetrics().WaitingQueueSize != 3 {
+				t.Errorf("expected WaitingQueueSize 3, got %d", ep.GetMetrics().WaitingQueueSize)
+			}
+			if ep.GetMetrics().RunningRequestsSize != 8 {
+				t.Errorf("expected RunningRequestsSize 8, got %d", ep.GetMetrics().RunningRequestsSize)
+			}
+			if ep.GetMetrics().KVCacheUsagePercent != tt.wantUsage {
+				t.Errorf("expected KVCacheUsagePercent %f, got %f", tt.wantUsage, ep.GetMetrics().KVCacheUsagePercent)
+			}
+		})
+	}
+}
+
+func TestTritonFallbackExtraction(t *testing.T) {
+	gauge := func(labelName, labelValue string, value float64) *dto.Metric {
+		return &dto.Metric{
+			Label: []*dto.LabelPair{{Name: proto.String(labelName), Value: proto.String(labelValue)}},
+			Gauge: &dto.Gauge{Value: ptr.To(value)},
+		}
+	}
+	tests := []struct {
+		name      string
+		requests  []*dto.Metric
+		blocks    []*dto.Metric
+		wantQueue int
+		wantUsage float64
+	}{
+		{
+			name:     "batch manager stats",
+			requests: []*dto.Metric{gauge("request_type", "waiting", 4), gauge("request_type", "scheduled", 8)},
+			blocks: []*dto.Metric{gauge("kv_cache_block_type", "fraction", 0.42), gauge("kv_cache_block_type", "used", 10),
+				gauge("kv_cache_block_type", "max", 100)},
+			wantQueue: 4,
+			wantUsage: 0.42,
+		},
+		{
+			name:      "older backend",
+			requests:  []*dto.Metric{gauge("request_type", "scheduled", 8)},
+			blocks:    []*dto.Metric{gauge("kv_cache_block_type", "used", 25), gauge("kv_cache_block_type", "max", 100)},
+			wantQueue: 2,
+			wantUsage: 0.25,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := defaultExtractorParams()
+			params.DefaultEngine = "triton-tensorrt-llm"
+			extractor, err := newCoreMetricsExtractorPlugin(context.Background(), "test", params)
+			if err != nil {
+				t.Fatalf("failed to create extractor: %v", err)
+			}
+
+			data := sourcemetrics.PrometheusMetricMap{
+				"nv_inference_pending_request_count": &dto.MetricFamily{
+					Type:   dto.MetricType_GAUGE.Enum(),
+					Metric: []*dto.Metric{{Gauge: &dto.Gauge{Value: ptr.To(2.0)}}},
+				},
+				"nv_trt_llm_request_metrics": &dto.MetricFamily{
+					Type:   dto.MetricType_GAUGE.Enum(),
+					Metric: tt.requests,
+				},
+				"nv_trt_llm_kv_cache_block_metrics": &dto.MetricFamily{
+					Type:   dto.MetricType_GAUGE.Enum(),
+					Metric: append(tt.blocks, gauge("kv_cache_block_type", "tokens_per", 64)),
+				},
+			}
+
+			ep := fwkdl.NewEndpoint(nil, nil)
+			if err := extractor.Extract(context.Background(), data, ep); err != nil {
+				t.Fatalf("unexpected extraction error: %v", err)
+			}
+			if ep.GetMetrics().WaitingQueueSize != tt.wantQueue {
+				t.Errorf("expected WaitingQueueSize %d, got %d", tt.wantQueue, ep.GetMetrics().WaitingQueueSize)
+			}
+			if ep.GetMetrics().RunningRequestsSize != 8 {
+				t.Errorf("expected RunningRequestsSize 8, got %d", ep.GetMetrics().RunningRequestsSize)
+			}
+			if ep.GetMetrics().KVCacheUsagePercent != tt.wantUsage {
+				t.Errorf("expected KVCacheUsagePercent %f, got %f", tt.wantUsage, ep.GetMetrics().KVCacheUsagePercent)
+			}
+		})
+	}
+}
+
+func TestCustomEngineMappingExtraction(t *testing.T) {
+	params := defaultExtractorParams()
+	params.DefaultEngine = "custom"
+	params.EngineConfigs = []engineConfigParams{{
+		Name:                         "custom",
+		QueuedRequestsSpec:           "custom:queue_size or custom:num_waiting",
+		RunningRequestsSpec:          "custom:num_running",
+		KVUsageSpec:                  "custom:kv_usage",
+		LoRASpec:                     "custom:adapters",
+		LoRARunningAdaptersLabelName: "running",
+		LoRAWaitingAdaptersLabelName: "waiting",
+		LoRAMaxAdaptersLabelName:     "max",
+	}}
+	extractor, err := newCoreMetricsExtractorPlugin(context.Background(), "test", params)
+	if err != nil {
+		t.Fatalf("failed to create extractor: %v", err)
+	}
+
+	gauge := func(value float64) *dto.MetricFamily {
+		return &dto.MetricFamily{
+			Type:   dto.MetricType_GAUGE.Enum(),
+			Metric: []*dto.Metric{{Gauge: &dto.Gauge{Value: ptr.To(value)}}},
+		}
+	}
+	data := sourcemetrics.PrometheusMetricMap{
+		"custom:num_waiting": gauge(3),
+		"custom:num_running": gauge(5),
+		"custom:kv_usage":    gauge(0.5),
+		"custom:adapters": &dto.MetricFamily{
+			Type: dto.MetricType_GAUGE.Enum(),
+			Metric: []*dto.Metric{{
+				Label: []*dto.LabelPair{
+					{Name: proto.String("running"), Value: proto.String("a1,a2")},
+					{Name: proto.String("waiting"), Value: proto.String("a3")},
+					{Name: proto.String("max"), Value: proto.String("4")},
+				},
+				Gauge: &dto.Gauge{Value: ptr.To(1.0)},
+			}},
+		},
+	}
+
+	ep := fwkdl.NewEndpoint(nil, nil)
+	if err := extractor.Extract(context.Background(), data, ep); err != nil {
+		t.Fatalf("unexpected extraction error: %v", err)
+	}
+	got := ep.GetMetrics()
+	if got.WaitingQueueSize != 3 {
+		t.Errorf("expected WaitingQueueSize 3 from the alternative metric, got %d", got.WaitingQueueSize)
+	}
+	if len(got.ActiveModels) != 2 || len(got.WaitingModels) != 1 || got.MaxActiveModels != 4 {
+		t.Errorf("unexpected LoRA metrics: active %v, waiting %v, max %d", got.ActiveModels, got.WaitingModels,
+			got.MaxActiveModels)
+	}
+}
+
+func TestMaxConcurrencyExtraction(t *testing.T) {
+	ctx := context.Background()
+
+	registry := NewMappingRegistry()
+	mapping, err := NewMappingFromConfig(MappingConfig{
+		Queue:          "vllm:num_requests_waiting",
+		MaxConcurrency: "vllm:max_num_seqs",
+	})
+	if err != nil {
+		t.Fatalf("failed to create mapping: %v", err)
+	}
+	if err := registry.Register(DefaultEngineType, mapping); err != nil {
+		t.Fatalf("failed to register mapping: %v", err)
+	}
+
+	extractor, _ := NewCoreMetricsExtractor(registry, "")
+
+	data := sourcemetrics.PrometheusMetricMap{
+		"vllm:num_requests_waiting": &dto.MetricFamily{
+			Type:   dto.MetricType_GAUGE.Enum(),
+			Metric: []*dto.Metric{{Gauge: &dto.Gauge{Value: ptr.To(7.0)}}},
+		},
+		"vllm:max_num_seqs": &dto.MetricFamily{
+			Type:   dto.MetricType_GAUGE.Enum(),
+			Metric: []*dto.Metric{{Gauge: &dto.Gauge{Value: ptr.To(256.0)}}},
+		},
+	}
+
+	ep := fwkdl.NewEndpoint(nil, nil)
+	if err := extractor.Extract(ctx, data, ep); err != nil {
+		t.Fatalf("unexpected extraction error: %v", err)
+	}
+
+	if ep.GetMetrics().MaxConcurrency != 256 {
+		t.Errorf("expected MaxConcurrency 256, got %d", ep.GetMetrics().MaxConcurrency)
+	}
+	if ep.GetMetrics().WaitingQueueSize != 7 {
+		t.Errorf("expected WaitingQueueSize 7, got %d", ep.GetMetrics().WaitingQueueSize)
+	}
+}
+
+func TestAdapterRequestsExtraction(t *testing.T) {
+	ctx := context.Background()
+
+	registry := NewMappingRegistry()
+	mapping, err := NewMappingFromConfig(MappingConfig{
+		LoraRunningRequests: "vllm:lora_num_requests{state=running}",
+		LoraWaitingRequests: "vllm:lora_num_requests{state=waiting}",
+		LoraAdapterLabel:    "adapter",
+	})
+	if err != nil {
+		t.Fatalf("failed to create mapping: %v", err)
+	}
+	if err := registry.Register(DefaultEngineType, mapping); err != nil {
+		t.Fatalf("failed to register mapping: %v", err)
+	}
+
+	extractor, _ := NewCoreMetricsExtractor(registry, "")
+
+	series := func(state, adapter string, value float64) *dto.Metric {
+		labels := []*dto.LabelPair{{Name: proto.String("state"), Value: proto.String(state)}}
+		if adapter != "" {
+			labels = append(labels, &dto.LabelPair{Name: proto.String("adapter"), Value: proto.String(adapter)})
+		}
+		return &dto.Metric{Label: labels, Gauge: &dto.Gauge{Value: ptr.To(value)}}
+	}
+	data := sourcemetrics.PrometheusMetricMap{
+		"vllm:lora_num_requests": &dto.MetricFamily{
+			Type: dto.MetricType_GAUGE.Enum(),
+			Metric: []*dto.Metric{
+				series("running", "sql-lora", 3),
+				series("running", "chat-lora", 1),
+				series("waiting", "sql-lora", 5),
+				series("waiting", "", 9), // no adapter, ignored
+			},
+		},
+	}
+
+	ep := fwkdl.NewEndpoint(nil, nil)
+	if err := extractor.Extract(ctx, data, ep); err != nil {
+		t.Fatalf("unexpected extraction error: %v", err)
+	}
+
+	if diff := cmp.Diff(map[string]int{"sql-lora": 3, "chat-lora": 1}, ep.GetMetrics().AdapterRunningRequests); diff != "" {
+		t.Errorf("unexpected AdapterRunningRequests (-want +got): %s", diff)
+	}
+	if diff := cmp.Diff(map[string]int{"sql-lora": 5}, ep.GetMetrics().AdapterWaitingRequests); diff != "" {
+		t.Errorf("unexpected AdapterWaitingRequests (-want +got): %s", diff)
+	}
+
+	if err := extractor.Extract(ctx, sourcemetrics.PrometheusMetricMap{}, ep); err == nil {
+		t.Error("expected an error when the per-adapter metrics are not reported")
+	}
+}
+
+func TestPrecisionExtraction(t *testing.T) {
+	ctx := context.Background()
+
+	registry := NewMappingRegistry()
+	mapping, err := NewMappingFromConfig(MappingConfig{
+		PrecisionInfo:  "vllm:model_config_info",
+		PrecisionLabel: "dtype",
+	})
+	if err != nil {
+		t.Fatalf("failed to create mapping: %v", err)
+	}
+	if err := registry.Register(DefaultEngineType, mapping); err != nil {
+		t.Fatalf("failed to register mapping: %v", err)
+	}
+
+	extractor, _ := NewCoreMetricsExtractor(registry, "")
+
+	data := sourcemetrics.PrometheusMetricMap{
+		"vllm:model_config_info": &dto.MetricFamily{
+			Type: dto.MetricType_GAUGE.Enum(),
+			Metric: []*dto.Metric{{
+				Label: []*dto.LabelPair{{Name: proto.String("dtype"), Value: proto.String("fp8")}},
+				Gauge: &dto.Gauge{Value: ptr.To(1.0)},
+			}},
+		},
+	}
+
+	ep := fwkdl.NewEndpoint(nil, nil)
+	if err := extractor.Extract(ctx, data, ep); err != nil {
+		t.Fatalf("unexpected extraction error: %v", err)
+	}
+
+	if ep.GetMetrics().Precision != "fp8" {
+		t.Errorf("expected Precision fp8, got %q", ep.GetMetrics().Precision)
+	}
+}
+
 func TestCoreMetricsExtractorFactoryDefaultEngine(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -468,6 +786,28 @@ func TestCoreMetricsExtractorFactoryDefaultEngine(t *testing.T) {
 			wantErr:      false,
 			checkDefault: "trtllm-serve",
 		},
+		{
+			name: "defaultEngine tgi",
+			params: map[string]any{
+				"defaultEngine": "tgi",
+			},
+			wantErr:      false,
+			checkDefault: "tgi",
+		},
+		{
+			name: "negative kvTokenCapacity",
+			params: map[string]any{
+				"engineConfigs": []map[string]any{
+					{
+						"name":             "tgi",
+						"kvUsedTokensSpec": "tgi_batch_current_max_tokens",
+						"kvTokenCapacity":  -1,
+					},
+				},
+			},
+			wantErr:     true,
+			errContains: "must not be negative",
+		},
 		{
 			name: "defaultEngine not found",
 			params: map[string]any{
@@ -33,7 +33,10 @@ const (
 
 // Configuration parameters for metrics data source and extractor.
 type (
-	// engineConfigParams holds metric specifications for a specific engine type.
+	// engineConfigParams holds metric specifications for a specific engine type. A metric specification is a PromQL
+	// instant vector selector, e.g. metric_name{label=value}. Alternative selectors can be chained with the PromQL
+	// "or" operator, e.g. new_metric_name or old_metric_name, to follow metric renames across engine versions: the
+	// first one reported by a model server is used.
 	engineConfigParams struct {
 		// Name is the engine type identifier.
 		Name string `json:"name"`
@@ -43,8 +46,33 @@ type (
 		RunningRequestsSpec string `json:"runningRequestsSpec"`
 		// KVUsageSpec defines the metric specification string for retrieving KV cache usage.
 		KVUsageSpec string `json:"kvUsageSpec"`
+		// KVUsedBlocksSpec defines the metric specification string for retrieving the number of KV cache blocks in
+		// use, for engines that do not report their KV cache utilization in all their versions (e.g. Triton TRT-LLM).
+		// The utilization is derived from CacheNumBlocksSpec when the KVUsageSpec metric is not reported.
+		KVUsedBlocksSpec string `json:"kvUsedBlocksSpec,omitempty"`
+		// KVUsedTokensSpec defines the metric specification string for retrieving the number of tokens in the KV
+		// cache, for engines that do not report their KV cache utilization (e.g. TGI). The utilization is derived
+		// from KVTokenCapacity. Ignored if KVUsageSpec is set.
+		KVUsedTokensSpec string `json:"kvUsedTokensSpec,omitempty"`
+		// KVTokenCapacity is the number of tokens the KV cache of each model server holds, e.g. the
+		// --max-batch-total-tokens of TGI. The KV cache utilization is not reported if it is not set.
+		KVTokenCapacity int `json:"kvTokenCapacity,omitempty"`
 		// LoRASpec defines the metric specification string for retrieving LoRA availability.
 		LoRASpec string `json:"loraSpec"`
+		// LoRARunningAdaptersLabelName, LoRAWaitingAdaptersLabelName and LoRAMaxAdaptersLabelName override the label
+		// names used to extract the running adapters, the waiting adapters and the max adapters from LoRASpec.
+		// Default to "running_lora_adapters", "waiting_lora_adapters" and "max_lora" if empty.
+		LoRARunningAdaptersLabelName string `json:"loraRunningAdaptersLabelName,omitempty"`
+		LoRAWaitingAdaptersLabelName string `json:"loraWaitingAdaptersLabelName,omitempty"`
+		LoRAMaxAdaptersLabelName     string `json:"loraMaxAdaptersLabelName,omitempty"`
+		// LoRARunningRequestsSpec and LoRAWaitingRequestsSpec define the metric specification strings for retrieving
+		// the number of running and waiting requests of each LoRA adapter, for engines exposing one series per
+		// adapter. Used by adapter-aware scorers to consider the contention of an adapter.
+		LoRARunningRequestsSpec string `json:"loraRunningRequestsSpec,omitempty"`
+		LoRAWaitingRequestsSpec string `json:"loraWaitingRequestsSpec,omitempty"`
+		// LoRAAdapterLabelName overrides the label naming the adapter of the per-adapter request metrics.
+		// Defaults to "lora_name" if empty.
+		LoRAAdapterLabelName string `json:"loraAdapterLabelName,omitempty"`
 		// CacheInfoSpec defines the metric specification string for retrieving KV cache configuration
 		// from an info-style gauge where block_size and num_gpu_blocks are label values.
 		CacheInfoSpec string `json:"cacheInfoSpec"`
@@ -60,6 +88,16 @@ type (
 		// CacheNumBlocksSpec defines the metric specification string for retrieving num GPU blocks directly
 		// as a gauge value (alternative to CacheInfoSpec labels). Used by engines like Triton TRT-LLM.
 		CacheNumBlocksSpec string `json:"cacheNumBlocksSpec,omitempty"`
+		// MaxConcurrencySpec defines the metric specification string for retrieving the maximum number of
+		// concurrently running requests (e.g. max-num-seqs) as a gauge value. Used for capacity normalization.
+		MaxConcurrencySpec string `json:"maxConcurrencySpec,omitempty"`
+		// PrecisionInfoSpec defines the metric specification string for retrieving the precision or quantization of
+		// the served model weights from an info-style gauge where it is a label value. Used by precision-aware routing
+		// when the pods do not set the "inference.networking.k8s.io/precision" label.
+		PrecisionInfoSpec string `json:"precisionInfoSpec,omitempty"`
+		// PrecisionLabelName overrides the label name used to extract the precision from PrecisionInfoSpec.
+		// Defaults to "quantization" if empty.
+		PrecisionLabelName string `json:"precisionLabelName,omitempty"`
 	}
 
 	// modelServerExtractorParams holds the configuration parameters for the core metrics extractor plugin.
@@ -71,18 +109,18 @@ type (
 		// Can be any engine name from EngineConfigs. Defaults to "vllm".
 		DefaultEngine string `json:"defaultEngine"`
 		// EngineConfigs defines metric specifications for specific engine types.
-		// Built-in configs (vLLM, SGLang, trtllm-serve, triton-tensorrt-llm) are automatically appended if not explicitly defined.
+		// Built-in configs (vLLM, SGLang, trtllm-serve, triton-tensorrt-llm, TGI) are automatically appended if not explicitly defined.
 		EngineConfigs []engineConfigParams `json:"engineConfigs"`
 	}
 )
 
-// Default engine configurations for vLLM, SGLang, trtllm-serve, and triton-tensorrt-llm.
+// Default engine configurations for vLLM, SGLang, trtllm-serve, triton-tensorrt-llm and TGI.
 var defaultEngineConfigs = []engineConfigParams{
 	{
 		Name:                "vllm",
 		QueuedRequestsSpec:  "vllm:num_requests_waiting",
 		RunningRequestsSpec: "vllm:num_requests_running",
-		KVUsageSpec:         "vllm:kv_cache_usage_perc",
+		KVUsageSpec:         "vllm:kv_cache_usage_perc or vllm:gpu_cache_usage_perc", // renamed in vLLM v0.10
 		LoRASpec:            "vllm:lora_requests_info",
 		CacheInfoSpec:       "vllm:cache_config_info",
 	},
@@ -107,15 +145,30 @@ var defaultEngineConfigs = []engineConfigParams{
 		CacheNumBlocksSpec:  "trtllm_kv_cache_max_blocks",
 	},
 	{
+		// Older TensorRT-LLM backends report neither the waiting requests of the batch manager nor the fraction of KV
+		// cache blocks in use: the queue depth then falls back to the requests pending in Triton, and the KV cache
+		// utilization is derived from the used and max blocks.
 		Name:                "triton-tensorrt-llm",
-		QueuedRequestsSpec:  "nv_trt_llm_request_metrics{request_type=waiting}",
+		QueuedRequestsSpec:  "nv_trt_llm_request_metrics{request_type=waiting} or nv_inference_pending_request_count",
 		RunningRequestsSpec: "nv_trt_llm_request_metrics{request_type=scheduled}",
 		KVUsageSpec:         "nv_trt_llm_kv_cache_block_metrics{kv_cache_block_type=fraction}",
+		KVUsedBlocksSpec:    "nv_trt_llm_kv_cache_block_metrics{kv_cache_block_type=used}",
 		LoRASpec:            "",
 		CacheInfoSpec:       "",
 		CacheBlockSizeSpec:  "nv_trt_llm_kv_cache_block_metrics{kv_cache_block_type=tokens_per}",
 		CacheNumBlocksSpec:  "nv_trt_llm_kv_cache_block_metrics{kv_cache_block_type=max}",
 	},
+	{
+		// TGI reports the tokens of its current batch but not its KV cache capacity, which must be configured to
+		// derive the KV cache utilization.
+		Name:                "tgi",
+		QueuedRequestsSpec:  "tgi_queue_size",
+		RunningRequestsSpec: "tgi_batch_current_size",
+		KVUsageSpec:         "",
+		KVUsedTokensSpec:    "tgi_batch_current_max_tokens",
+		LoRASpec:            "",
+		CacheInfoSpec:       "",
+	},
 }
 
 // defaultEngineName is the default engine used when defaultEngine is not specified.
@@ -183,12 +236,24 @@ func newCoreMetricsExtractorPlugin(ctx context.Context, name string, params *mod
 			Queue:               engineConfig.QueuedRequestsSpec,
 			Running:             engineConfig.RunningRequestsSpec,
 			KVUsage:             engineConfig.KVUsageSpec,
+			KVUsedTokens:        engineConfig.KVUsedTokensSpec,
+			KVTokenCapacity:     engineConfig.KVTokenCapacity,
+			KVUsedBlocks:        engineConfig.KVUsedBlocksSpec,
 			Lora:                engineConfig.LoRASpec,
+			LoraRunningLabel:    engineConfig.LoRARunningAdaptersLabelName,
+			LoraWaitingLabel:    engineConfig.LoRAWaitingAdaptersLabelName,
+			LoraMaxLabel:        engineConfig.LoRAMaxAdaptersLabelName,
+			LoraRunningRequests: engineConfig.LoRARunningRequestsSpec,
+			LoraWaitingRequests: engineConfig.LoRAWaitingRequestsSpec,
+			LoraAdapterLabel:    engineConfig.LoRAAdapterLabelName,
 			CacheInfo:           engineConfig.CacheInfoSpec,
 			CacheBlockSizeLabel: engineConfig.CacheBlockSizeLabelName,
 			CacheNumBlocksLabel: engineConfig.CacheNumBlocksLabelName,
 			CacheBlockSize:      engineConfig.CacheBlockSizeSpec,
 			CacheNumBlocks:      engineConfig.CacheNumBlocksSpec,
+			MaxConcurrency:      engineConfig.MaxConcurrencySpec,
+			PrecisionInfo:       engineConfig.PrecisionInfoSpec,
+			PrecisionLabel:      engineConfig.PrecisionLabelName,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to create mapping for engine %q: %w", engineConfig.Name, err)
@@ -17,7 +17,9 @@ limitations under the License.
 package metrics
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	dto "github.com/prometheus/client_model/go"
 
@@ -43,13 +45,28 @@ func parseStringToLoRASpec(spec string) (*LoRASpec, error) {
 	return &LoRASpec{Spec: baseSpec}, nil
 }
 
-// getLatestMetric retrieves the latest LoRA metric based on Spec.
+// getLatestMetric retrieves the latest LoRA metric based on Spec, or on its
+// fallback if the metric is not reported.
+func (spec *LoRASpec) getLatestMetric(families sourcemetrics.PrometheusMetricMap) (*dto.Metric, error) {
+	metric, err := spec.getLatestMatchingMetric(families)
+	if err != nil && spec.Fallback != nil {
+		fallback, fallbackErr := (&LoRASpec{Spec: spec.Fallback}).getLatestMetric(families)
+		if fallbackErr != nil {
+			return nil, errors.Join(err, fallbackErr)
+		}
+		return fallback, nil
+	}
+	return metric, err
+}
+
+// getLatestMatchingMetric retrieves the latest LoRA metric matching the Spec,
+// ignoring its fallback.
 // We can't use the standard Spec method since, in the case of
 // LoRA (i.e., `vllm:lora_requests_info`), each label key-value pair permutation
 // generates new series and only most recent should be used. The value of each
 // series is its creation timestamp so we can retrieve the latest by sorting on
 // that the value first.
-func (spec *LoRASpec) getLatestMetric(families sourcemetrics.PrometheusMetricMap) (*dto.Metric, error) {
+func (spec *LoRASpec) getLatestMatchingMetric(families sourcemetrics.PrometheusMetricMap) (*dto.Metric, error) {
 	family, err := extractFamily(spec.Spec, families)
 	if err != nil {
 		return nil, err
@@ -58,9 +75,13 @@ func (spec *LoRASpec) getLatestMetric(families sourcemetrics.PrometheusMetricMap
 	var latest *dto.Metric
 	var recent float64 = -1
 
+	now := time.Now()
 	for _, metric := range family.GetMetric() {
 		if spec.labelsMatch(metric.GetLabel()) {
 			value := extractValue(metric) // metric value is its creation timestamp
+			if value > 0 && isFuture(time.UnixMilli(int64(value*1000)), now) {
+				value = 0
+			}
 			if value > recent {
 				recent = value
 				latest = metric
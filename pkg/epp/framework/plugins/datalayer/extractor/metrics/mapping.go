@@ -29,6 +29,29 @@ type Mapping struct {
 	TotalRunningRequests *Spec
 	KVCacheUtilization   *Spec
 	LoraRequestInfo      *LoRASpec
+	// LoraRunningAdaptersLabel, LoraWaitingAdaptersLabel and LoraMaxAdaptersLabel
+	// allow engines to use different label names for the LoraRequestInfo metric.
+	// If empty, default to "running_lora_adapters", "waiting_lora_adapters" and
+	// "max_lora".
+	LoraRunningAdaptersLabel string
+	LoraWaitingAdaptersLabel string
+	LoraMaxAdaptersLabel     string
+	// LoraRunningRequests and LoraWaitingRequests are used for engines that expose the number of running and waiting
+	// requests of each LoRA adapter, as one series per adapter. LoraAdapterLabel is the label naming the adapter of
+	// these series. If empty, defaults to "lora_name".
+	LoraRunningRequests *Spec
+	LoraWaitingRequests *Spec
+	LoraAdapterLabel    string
+	// KVUsedTokens and KVTokenCapacity are used for engines that do not report
+	// their KV cache utilization but the number of tokens in use (e.g. TGI); the
+	// utilization is then derived from the configured token capacity. They are
+	// ignored if KVCacheUtilization is set.
+	KVUsedTokens    *Spec
+	KVTokenCapacity int
+	// KVUsedBlocks is used for engines that report the number of KV cache blocks
+	// in use (e.g. Triton TRT-LLM); the utilization is then derived from the
+	// CacheNumBlocks metric when KVCacheUtilization is not set or not reported.
+	KVUsedBlocks *Spec
 	// CacheInfo is used for info-style gauge metrics where block_size and
 	// num_gpu_blocks are exposed as label values (e.g. vLLM, trtllm-serve, SGLang).
 	CacheInfo *Spec
@@ -42,6 +65,15 @@ type Mapping struct {
 	// (e.g. Triton TRT-LLM).
 	CacheBlockSize *Spec
 	CacheNumBlocks *Spec
+	// MaxConcurrency is used to retrieve the advertised maximum number of
+	// concurrently running requests (e.g. max-num-seqs) as a gauge value.
+	MaxConcurrency *Spec
+	// PrecisionInfo is used for info-style gauge metrics where the precision or quantization of the served model
+	// weights is exposed as a label value.
+	PrecisionInfo *Spec
+	// PrecisionLabel allows engines to use a different label name for the PrecisionInfo metric. If empty, defaults
+	// to "quantization".
+	PrecisionLabel string
 }
 
 // MappingConfig holds the string-based configuration used to build a Mapping.
@@ -49,12 +81,24 @@ type MappingConfig struct {
 	Queue               string
 	Running             string
 	KVUsage             string
+	KVUsedTokens        string
+	KVTokenCapacity     int
+	KVUsedBlocks        string
 	Lora                string
+	LoraRunningLabel    string
+	LoraWaitingLabel    string
+	LoraMaxLabel        string
+	LoraRunningRequests string
+	LoraWaitingRequests string
+	LoraAdapterLabel    string
 	CacheInfo           string
 	CacheBlockSizeLabel string
 	CacheNumBlocksLabel string
 	CacheBlockSize      string
 	CacheNumBlocks      string
+	MaxConcurrency      string
+	PrecisionInfo       string
+	PrecisionLabel      string
 }
 
 // String returns a human-readable representation of the Mapping, listing which specs are disabled (nil).
@@ -108,10 +152,29 @@ func NewMappingFromConfig(cfg MappingConfig) (*Mapping, error) {
 	if err != nil {
 		errs = append(errs, err)
 	}
+	kvUsedTokensSpec, err := parseStringToSpec(cfg.KVUsedTokens)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	if cfg.KVTokenCapacity < 0 {
+		errs = append(errs, fmt.Errorf("KV token capacity must not be negative, got %d", cfg.KVTokenCapacity))
+	}
+	kvUsedBlocksSpec, err := parseStringToSpec(cfg.KVUsedBlocks)
+	if err != nil {
+		errs = append(errs, err)
+	}
 	loraSpec, err := parseStringToLoRASpec(cfg.Lora)
 	if err != nil {
 		errs = append(errs, err)
 	}
+	loraRunningRequestsSpec, err := parseStringToSpec(cfg.LoraRunningRequests)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	loraWaitingRequestsSpec, err := parseStringToSpec(cfg.LoraWaitingRequests)
+	if err != nil {
+		errs = append(errs, err)
+	}
 	cacheInfoSpec, err := parseStringToSpec(cfg.CacheInfo)
 	if err != nil {
 		errs = append(errs, err)
@@ -125,18 +188,40 @@ func NewMappingFromConfig(cfg MappingConfig) (*Mapping, error) {
 		errs = append(errs, err)
 	}
 
+	maxConcurrencySpec, err := parseStringToSpec(cfg.MaxConcurrency)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	precisionInfoSpec, err := parseStringToSpec(cfg.PrecisionInfo)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
 	if len(errs) != 0 {
 		return nil, errors.Join(errs...)
 	}
 	return &Mapping{
-		TotalQueuedRequests:  queueSpec,
-		TotalRunningRequests: runningSpec,
-		KVCacheUtilization:   kvusageSpec,
-		LoraRequestInfo:      loraSpec,
-		CacheInfo:            cacheInfoSpec,
-		CacheBlockSizeLabel:  cfg.CacheBlockSizeLabel,
-		CacheNumBlocksLabel:  cfg.CacheNumBlocksLabel,
-		CacheBlockSize:       cacheBlockSizeSpec,
-		CacheNumBlocks:       cacheNumBlocksSpec,
+		TotalQueuedRequests:      queueSpec,
+		TotalRunningRequests:     runningSpec,
+		KVCacheUtilization:       kvusageSpec,
+		KVUsedTokens:             kvUsedTokensSpec,
+		KVTokenCapacity:          cfg.KVTokenCapacity,
+		KVUsedBlocks:             kvUsedBlocksSpec,
+		LoraRequestInfo:          loraSpec,
+		LoraRunningAdaptersLabel: cfg.LoraRunningLabel,
+		LoraWaitingAdaptersLabel: cfg.LoraWaitingLabel,
+		LoraMaxAdaptersLabel:     cfg.LoraMaxLabel,
+		LoraRunningRequests:      loraRunningRequestsSpec,
+		LoraWaitingRequests:      loraWaitingRequestsSpec,
+		LoraAdapterLabel:         cfg.LoraAdapterLabel,
+		CacheInfo:                cacheInfoSpec,
+		CacheBlockSizeLabel:      cfg.CacheBlockSizeLabel,
+		CacheNumBlocksLabel:      cfg.CacheNumBlocksLabel,
+		CacheBlockSize:           cacheBlockSizeSpec,
+		CacheNumBlocks:           cacheNumBlocksSpec,
+		MaxConcurrency:           maxConcurrencySpec,
+		PrecisionInfo:            precisionInfoSpec,
+		PrecisionLabel:           cfg.PrecisionLabel,
 	}, nil
 }
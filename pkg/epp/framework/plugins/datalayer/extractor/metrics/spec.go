@@ -21,23 +21,34 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	dto "github.com/prometheus/client_model/go"
 	"github.com/prometheus/prometheus/model/labels"
 	"github.com/prometheus/prometheus/promql/parser"
 
 	sourcemetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/source/metrics"
+	eppmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/timing"
 )
 
+// timeAnomalySource is the source the time anomalies of the scraped metrics are recorded under.
+const timeAnomalySource = "metrics-scrape"
+
 // Spec represents a single metric's specification.
 type Spec struct {
 	Name   string            // the metric's name
 	Labels map[string]string // maps metric's label name to value
+	// Fallback is the specification used when the metric is not reported, e.g. by the versions of the model server
+	// predating a metric rename. It is set by specifications of the form "metric_name or other_metric_name".
+	Fallback *Spec
 }
 
 // parseStringToSpec converts a string to a metrics.Spec.
 // Inputs are expected in PromQL Instant Vector Selector syntax:
 // metric_name{label1=value1,label2=value2}, where labels are optional.
+// Alternative selectors can be chained with the PromQL "or" operator, e.g.
+// new_metric_name or old_metric_name: the first one reported is used.
 func parseStringToSpec(spec string) (*Spec, error) {
 	if spec == "" {
 		return nil, nil // allow empty string to represent the nil Spec
@@ -50,22 +61,45 @@ func parseStringToSpec(spec string) (*Spec, error) {
 	if err != nil {
 		return nil, err
 	}
+	return exprToSpec(expr, spec)
+}
 
-	// cast to prometheus' VectorSelector to extract metric name and labels
-	if vs, ok := expr.(*parser.VectorSelector); ok {
+// exprToSpec converts a parsed specification to a metrics.Spec.
+func exprToSpec(expr parser.Expr, spec string) (*Spec, error) {
+	switch e := expr.(type) {
+	case *parser.ParenExpr:
+		return exprToSpec(e.Expr, spec)
+	case *parser.BinaryExpr:
+		if e.Op != parser.LOR {
+			return nil, fmt.Errorf("only the \"or\" operator is supported in metric specification: %q", spec)
+		}
+		first, err := exprToSpec(e.LHS, spec)
+		if err != nil {
+			return nil, err
+		}
+		last := first
+		for last.Fallback != nil {
+			last = last.Fallback
+		}
+		if last.Fallback, err = exprToSpec(e.RHS, spec); err != nil {
+			return nil, err
+		}
+		return first, nil
+	case *parser.VectorSelector:
+		// cast to prometheus' VectorSelector to extract metric name and labels
 		metricLabels := make(map[string]string)
-		for _, matcher := range vs.LabelMatchers {
+		for _, matcher := range e.LabelMatchers {
 			// do not insert pseudo labels (such as __name__, __meta_*, etc.)
 			if matcher.Type == labels.MatchEqual && !strings.HasPrefix(matcher.Name, "__") {
 				metricLabels[matcher.Name] = matcher.Value
 			}
 		}
 
-		if vs.Name == "" {
+		if e.Name == "" {
 			return nil, fmt.Errorf("empty metric name in specification: %q", spec)
 		}
 		return &Spec{
-			Name:   vs.Name,
+			Name:   e.Name,
 			Labels: metricLabels,
 		}, nil
 	}
@@ -98,8 +132,21 @@ func extractFamily(spec *Spec, families sourcemetrics.PrometheusMetricMap) (*dto
 	return family, nil
 }
 
-// getLatestMetric retrieves the latest metric based on Spec.
+// getLatestMetric retrieves the latest metric based on Spec, or on its fallback if the metric is not reported.
 func (spec *Spec) getLatestMetric(families sourcemetrics.PrometheusMetricMap) (*dto.Metric, error) {
+	metric, err := spec.getLatestMatchingMetric(families)
+	if err != nil && spec.Fallback != nil {
+		fallback, fallbackErr := spec.Fallback.getLatestMetric(families)
+		if fallbackErr != nil {
+			return nil, errors.Join(err, fallbackErr)
+		}
+		return fallback, nil
+	}
+	return metric, err
+}
+
+// getLatestMatchingMetric retrieves the latest metric matching the Spec, ignoring its fallback.
+func (spec *Spec) getLatestMatchingMetric(families sourcemetrics.PrometheusMetricMap) (*dto.Metric, error) {
 	family, err := extractFamily(spec, families)
 	if err != nil {
 		return nil, err
@@ -108,9 +155,13 @@ func (spec *Spec) getLatestMetric(families sourcemetrics.PrometheusMetricMap) (*
 	var latest *dto.Metric
 	var recent int64 = -1
 
+	now := time.Now()
 	for _, metric := range family.GetMetric() {
 		if spec.labelsMatch(metric.GetLabel()) {
 			ts := metric.GetTimestampMs()
+			if ts > 0 && isFuture(time.UnixMilli(ts), now) {
+				ts = 0
+			}
 			if ts > recent {
 				recent = ts
 				latest = metric
@@ -125,6 +176,33 @@ func (spec *Spec) getLatestMetric(families sourcemetrics.PrometheusMetricMap) (*
 	return latest, nil
 }
 
+// adapterRequests sums the values of the metrics matching the Spec, or its fallback if the metric is not reported, by
+// the value of their adapter label. Metrics without an adapter label are ignored.
+func (spec *Spec) adapterRequests(families sourcemetrics.PrometheusMetricMap, adapterLabel string) (map[string]int, error) {
+	family, err := extractFamily(spec, families)
+	if err != nil {
+		if spec.Fallback != nil {
+			requests, fallbackErr := spec.Fallback.adapterRequests(families, adapterLabel)
+			if fallbackErr != nil {
+				return nil, errors.Join(err, fallbackErr)
+			}
+			return requests, nil
+		}
+		return nil, err
+	}
+
+	requests := map[string]int{}
+	for _, metric := range family.GetMetric() {
+		if !spec.labelsMatch(metric.GetLabel()) {
+			continue
+		}
+		if adapter := labelValue(metric, adapterLabel); adapter != "" {
+			requests[adapter] += int(extractValue(metric))
+		}
+	}
+	return requests, nil
+}
+
 // labelsMatch checks if metric labels match the specification labels.
 func (spec *Spec) labelsMatch(metricLabels []*dto.LabelPair) bool {
 	if len(spec.Labels) == 0 {
@@ -160,3 +238,14 @@ func extractValue(metric *dto.Metric) float64 {
 	}
 	return 0
 }
+
+// isFuture returns whether the timestamp of a sample is ahead of now by more than the tolerated clock skew, and
+// records the anomaly if so. Such a sample, e.g. of a model server with a skewed clock, would otherwise shadow the
+// more recent samples until the clocks catch up: its timestamp is not trusted.
+func isFuture(ts, now time.Time) bool {
+	if anomaly := timing.CheckTimestamp(ts, now); anomaly != "" {
+		eppmetrics.RecordTimeAnomaly(timeAnomalySource, string(anomaly))
+		return true
+	}
+	return false
+}
@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
@@ -188,6 +189,32 @@ func TestStringToMetricSpec(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:  "alternatives",
+			input: "new_metric{label1=value1} or old_metric or (older_metric)",
+			want: &Spec{
+				Name:   "new_metric",
+				Labels: map[string]string{"label1": "value1"},
+				Fallback: &Spec{
+					Name:     "old_metric",
+					Labels:   map[string]string{},
+					Fallback: &Spec{Name: "older_metric", Labels: map[string]string{}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "unsupported operator",
+			input:   "my_metric and other_metric",
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "invalid alternative",
+			input:   "my_metric or {label=val}",
+			want:    nil,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -222,6 +249,10 @@ func TestGetMetric(t *testing.T) {
 			makeMetric(map[string]string{}, 5.0, 3000),
 			makeMetric(map[string]string{}, 6.0, 1000),
 		),
+		"metric5": makeMetricFamily("metric5",
+			makeMetric(map[string]string{}, 7.0, time.Now().Add(time.Hour).UnixMilli()),
+			makeMetric(map[string]string{}, 8.0, 1000),
+		),
 	}
 
 	tests := []struct {
@@ -310,6 +341,41 @@ func TestGetMetric(t *testing.T) {
 			expected:  5.0,
 			wantError: false,
 		},
+		{
+			name: "get metric timestamped in the future",
+			spec: Spec{
+				Name: "metric5",
+			},
+			expected:  8.0, // the timestamp of a metric ahead of the EPP clock is not trusted
+			wantError: false,
+		},
+		{
+			name: "get metric, first alternative reported",
+			spec: Spec{
+				Name:     "metric3",
+				Fallback: &Spec{Name: "metric5"},
+			},
+			expected:  5.0,
+			wantError: false,
+		},
+		{
+			name: "get metric, fallback reported",
+			spec: Spec{
+				Name:     "metric4",
+				Fallback: &Spec{Name: "metric1", Labels: map[string]string{"label1": "value2"}},
+			},
+			expected:  2.0,
+			wantError: false,
+		},
+		{
+			name: "get metric, no alternative reported",
+			spec: Spec{
+				Name:     "metric4",
+				Fallback: &Spec{Name: "empty_metric"},
+			},
+			expected:  -1,
+			wantError: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	"k8s.io/client-go/transport"
+)
+
+// ClientAuth configures how the client verifies the endpoints and authenticates to them, for endpoints serving their
+// data only over authenticated HTTPS.
+type ClientAuth struct {
+	// CAFile is the path of the PEM bundle of the certificate authorities verifying the endpoint certificates. Setting
+	// it enables the verification of the endpoint certificates.
+	CAFile string `json:"caFile,omitempty"`
+	// ServerName is the name verified in the endpoint certificates, which are otherwise verified against the endpoint
+	// IP address. Setting it enables the verification of the endpoint certificates.
+	ServerName string `json:"serverName,omitempty"`
+	// CertFile and KeyFile are the paths of the PEM client certificate and key presented to the endpoints. They are
+	// reloaded on every handshake, so that rotated certificates are picked up.
+	CertFile string `json:"certFile,omitempty"`
+	KeyFile  string `json:"keyFile,omitempty"`
+	// BearerTokenFile is the path of the token sent as a bearer token to the endpoints, e.g. a projected
+	// service-account token. It is reloaded periodically.
+	BearerTokenFile string `json:"bearerTokenFile,omitempty"`
+}
+
+// IsZero returns whether no authentication is configured.
+func (a ClientAuth) IsZero() bool {
+	return a == ClientAuth{}
+}
+
+// verifiesCertificates returns whether the endpoint certificates are verified regardless of the insecure skip
+// verification setting.
+func (a ClientAuth) verifiesCertificates() bool {
+	return a.CAFile != "" || a.ServerName != ""
+}
+
+func (a ClientAuth) validate(scheme string) error {
+	if (a.CertFile == "") != (a.KeyFile == "") {
+		return errors.New("certFile and keyFile must be set together")
+	}
+	if scheme != "https" && (a.verifiesCertificates() || a.CertFile != "") {
+		return fmt.Errorf("caFile, serverName, certFile and keyFile require the https scheme, got %q", scheme)
+	}
+	return nil
+}
+
+// tlsConfig returns the TLS configuration of the client.
+func (a ClientAuth) tlsConfig(skipCertVerification bool) (*tls.Config, error) {
+	config := &tls.Config{
+		InsecureSkipVerify: skipCertVerification && !a.verifiesCertificates(),
+		ServerName:         a.ServerName,
+	}
+	if a.CAFile != "" {
+		pem, err := os.ReadFile(a.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate found in CA file %q", a.CAFile)
+		}
+	}
+	if a.CertFile != "" {
+		// Fail fast on a missing or invalid client certificate, rather than on the first scrape.
+		if _, err := tls.LoadX509KeyPair(a.CertFile, a.KeyFile); err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		config.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, err := tls.LoadX509KeyPair(a.CertFile, a.KeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load client certificate: %w", err)
+			}
+			return &cert, nil
+		}
+	}
+	return config, nil
+}
+
+// newAuthClient returns a client verifying the endpoints and authenticating to them as configured.
+func newAuthClient(scheme string, auth ClientAuth, skipCertVerification bool) (*client, error) {
+	if err := auth.validate(scheme); err != nil {
+		return nil, err
+	}
+	httpTransport := baseTransport.Clone()
+	if scheme == "https" {
+		tlsConfig, err := auth.tlsConfig(skipCertVerification)
+		if err != nil {
+			return nil, err
+		}
+		httpTransport.TLSClientConfig = tlsConfig
+	}
+	var roundTripper http.RoundTripper = httpTransport
+	if auth.BearerTokenFile != "" {
+		var err error
+		roundTripper, err = transport.NewBearerAuthWithRefreshRoundTripper("", auth.BearerTokenFile, httpTransport)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read bearer token file: %w", err)
+		}
+	}
+	return &client{Client: http.Client{Timeout: timeout, Transport: roundTripper}}, nil
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// writeClientCert writes a self-signed client certificate and its key to the given directory.
+func writeClientCert(t *testing.T, dir string) (*x509.Certificate, string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "epp"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		IsCA:         true,
+
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+	return cert, certFile, keyFile
+}
+
+func TestClientAuth(t *testing.T) {
+	dir := t.TempDir()
+	clientCert, certFile, keyFile := writeClientCert(t, dir)
+	tokenFile := filepath.Join(dir, "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("secret-token"), 0o600))
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = io.WriteString(w, "ok")
+	}))
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	server.StartTLS()
+	defer server.Close()
+
+	caFile := filepath.Join(dir, "ca.crt")
+	require.NoError(t, os.WriteFile(caFile,
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0o600))
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	endpoint := fwkdl.NewEndpoint(&fwkdl.EndpointMetadata{
+		NamespacedName: types.NamespacedName{Name: "pod1", Namespace: "default"},
+		MetricsHost:    serverURL.Host,
+	}, nil)
+	parser := func(r io.Reader) (any, error) {
+		body, err := io.ReadAll(r)
+		return string(body), err
+	}
+
+	tests := []struct {
+		name    string
+		auth    ClientAuth
+		wantErr bool
+	}{
+		{
+			name: "mTLS with bearer token",
+			auth: ClientAuth{CAFile: caFile, CertFile: certFile, KeyFile: keyFile, BearerTokenFile: tokenFile},
+		},
+		{
+			name:    "missing bearer token",
+			auth:    ClientAuth{CAFile: caFile, CertFile: certFile, KeyFile: keyFile},
+			wantErr: true,
+		},
+		{
+			name:    "missing client certificate",
+			auth:    ClientAuth{CAFile: caFile, BearerTokenFile: tokenFile},
+			wantErr: true,
+		},
+		{
+			name:    "unverified server certificate",
+			auth:    ClientAuth{ServerName: "model-server.example", CertFile: certFile, KeyFile: keyFile, BearerTokenFile: tokenFile},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			source, err := NewHTTPDataSource("https", "/metrics", true, "test-http", "test-source", parser,
+				reflect.TypeFor[string]())
+			require.NoError(t, err)
+			source, err = source.WithClientAuth(test.auth, true)
+			require.NoError(t, err)
+
+			data, err := source.Poll(t.Context(), endpoint)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "ok", data)
+		})
+	}
+}
+
+func TestClientAuthValidation(t *testing.T) {
+	dir := t.TempDir()
+	_, certFile, keyFile := writeClientCert(t, dir)
+
+	tests := []struct {
+		name   string
+		scheme string
+		auth   ClientAuth
+	}{
+		{name: "certificate without key", scheme: "https", auth: ClientAuth{CertFile: certFile}},
+		{name: "TLS settings with http", scheme: "http", auth: ClientAuth{CertFile: certFile, KeyFile: keyFile}},
+		{name: "missing CA file", scheme: "https", auth: ClientAuth{CAFile: filepath.Join(dir, "missing.crt")}},
+		{name: "CA file without certificates", scheme: "https", auth: ClientAuth{CAFile: keyFile}},
+		{name: "missing bearer token file", scheme: "http", auth: ClientAuth{BearerTokenFile: filepath.Join(dir, "missing")}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := newAuthClient(test.scheme, test.auth, false)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -68,6 +68,17 @@ func NewHTTPDataSource(scheme string, path string, skipCertVerification bool, pl
 	return dataSrc, nil
 }
 
+// WithClientAuth configures the data source to verify the endpoints and authenticate to them as given. Verifying the
+// endpoint certificates, with a CA file or a server name, overrides skipCertVerification.
+func (dataSrc *HTTPDataSource) WithClientAuth(auth ClientAuth, skipCertVerification bool) (*HTTPDataSource, error) {
+	authClient, err := newAuthClient(dataSrc.scheme, auth, skipCertVerification)
+	if err != nil {
+		return nil, err
+	}
+	dataSrc.client = authClient
+	return dataSrc, nil
+}
+
 // TypedName returns the data source type and name.
 func (dataSrc *HTTPDataSource) TypedName() fwkplugin.TypedName {
 	return dataSrc.typedName
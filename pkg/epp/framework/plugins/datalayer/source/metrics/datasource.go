@@ -48,6 +48,9 @@ type metricsDatasourceParams struct {
 	Path string `json:"path"`
 	// InsecureSkipVerify defines whether model server certificate should be verified or not.
 	InsecureSkipVerify bool `json:"insecureSkipVerify"`
+	// ClientAuth configures the CA verifying the model server certificates, the client certificate and the bearer
+	// token presented to the model servers.
+	http.ClientAuth
 }
 
 // NewHTTPMetricsDataSource constructs a MetricsDataSource with the given scheme and path.
@@ -72,8 +75,12 @@ func MetricsDataSourceFactory(name string, parameters json.RawMessage, handle fw
 		}
 	}
 
-	return http.NewHTTPDataSource(cfg.Scheme, cfg.Path, cfg.InsecureSkipVerify, MetricsDataSourceType,
+	source, err := http.NewHTTPDataSource(cfg.Scheme, cfg.Path, cfg.InsecureSkipVerify, MetricsDataSourceType,
 		name, parseMetrics, PrometheusMetricType)
+	if err != nil || cfg.ClientAuth.IsZero() {
+		return source, err
+	}
+	return source.WithClientAuth(cfg.ClientAuth, cfg.InsecureSkipVerify)
 }
 
 // These flags are registered in options.go (server package) and marked as deprecated there.
@@ -50,3 +50,16 @@ func TestDatasource(t *testing.T) {
 	_, err = source.Poll(ctx, endpoint)
 	assert.NotNil(t, err, "expected to fail polling for metrics")
 }
+
+func TestDatasourceFactoryClientAuth(t *testing.T) {
+	_, err := MetricsDataSourceFactory("metrics-data-source",
+		[]byte(`{"scheme": "https", "caFile": "/nonexistent/ca.crt"}`), nil)
+	assert.ErrorContains(t, err, "CA file", "expected the client auth parameters to be applied")
+
+	_, err = MetricsDataSourceFactory("metrics-data-source",
+		[]byte(`{"scheme": "http", "certFile": "/etc/tls.crt", "keyFile": "/etc/tls.key"}`), nil)
+	assert.Error(t, err, "expected to fail with a client certificate over http")
+
+	_, err = MetricsDataSourceFactory("metrics-data-source", []byte(`{"scheme": "https"}`), nil)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package wfq implements a weighted fair queuing fairness policy that shares the dispatch opportunities of a priority
+// band between its flows, typically tenants, in proportion to configured weights.
+//
+// For detailed documentation, see README.md.
+package wfq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sync"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/flowcontrol"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+// WeightedFairQueuingFairnessPolicyType is the registration type for the weighted fair queuing fairness policy.
+const WeightedFairQueuingFairnessPolicyType = "weighted-fair-queuing-fairness-policy"
+
+const (
+	// CostRequests charges each dispatch a cost of one.
+	CostRequests = "requests"
+	// CostBytes charges each dispatch the byte size of the dispatched request.
+	CostBytes = "bytes"
+)
+
+// config is the configuration of the policy.
+type config struct {
+	// Weights maps the flow IDs, i.e. the fairness IDs of the requests, to their weights.
+	Weights map[string]float64 `json:"weights,omitempty"`
+	// DefaultWeight is the weight of the flows without an entry in Weights. Defaults to 1.
+	DefaultWeight *float64 `json:"defaultWeight,omitempty"`
+	// Cost is what a dispatch is charged: requests (the default) or bytes.
+	Cost string `json:"cost,omitempty"`
+}
+
+// WeightedFairQueuingFairnessPolicyFactory is the factory function for the weighted fair queuing fairness policy.
+func WeightedFairQueuingFairnessPolicyFactory(name string, rawConfig json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	cfg := config{}
+	if len(rawConfig) > 0 {
+		if err := json.Unmarshal(rawConfig, &cfg); err != nil {
+			return nil, fmt.Errorf("failed to parse weighted fair queuing fairness policy config: %w", err)
+		}
+	}
+	return newWFQ(name, cfg)
+}
+
+// wfq implements the FairnessPolicy interface with start-time fair queuing: each flow accumulates a virtual time,
+// advanced by the cost of its dispatches divided by its weight, and the non-empty flow with the lowest virtual time is
+// picked.
+type wfq struct {
+	name          string
+	weights       map[string]float64
+	defaultWeight float64
+	costBytes     bool
+}
+
+func newWFQ(name string, cfg config) (*wfq, error) {
+	if name == "" {
+		name = WeightedFairQueuingFairnessPolicyType
+	}
+	p := &wfq{name: name, weights: cfg.Weights, defaultWeight: 1}
+	if cfg.DefaultWeight != nil {
+		p.defaultWeight = *cfg.DefaultWeight
+	}
+	if p.defaultWeight <= 0 {
+		return nil, fmt.Errorf("defaultWeight must be positive, got %v", p.defaultWeight)
+	}
+	for id, weight := range cfg.Weights {
+		if weight <= 0 {
+			return nil, fmt.Errorf("weight of flow %q must be positive, got %v", id, weight)
+		}
+	}
+	switch cfg.Cost {
+	case "", CostRequests:
+	case CostBytes:
+		p.costBytes = true
+	default:
+		return nil, fmt.Errorf("unknown cost %q, expected %q or %q", cfg.Cost, CostRequests, CostBytes)
+	}
+	return p, nil
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *wfq) TypedName() fwkplugin.TypedName {
+	return fwkplugin.TypedName{
+		Type: WeightedFairQueuingFairnessPolicyType,
+		Name: p.name,
+	}
+}
+
+// wfqState holds the virtual times of the flows of a specific priority band.
+// It is initialized via NewState and stored on the PriorityBandAccessor.
+type wfqState struct {
+	mu sync.Mutex
+	// virtualTime is the virtual time of the band, the start time of the last pick. Flows becoming active again start
+	// from it, rather than from the virtual time they had when they went idle, so that idling earns no credit.
+	virtualTime float64
+	// flowTimes are the virtual times of the flows of the band.
+	flowTimes map[flowcontrol.FlowKey]float64
+}
+
+// NewState initializes the policy state for a specific priority band.
+func (p *wfq) NewState(_ context.Context) any {
+	return &wfqState{flowTimes: map[flowcontrol.FlowKey]float64{}}
+}
+
+// weight returns the weight of a flow.
+func (p *wfq) weight(key flowcontrol.FlowKey) float64 {
+	if weight, ok := p.weights[key.ID]; ok {
+		return weight
+	}
+	return p.defaultWeight
+}
+
+// Pick selects the non-empty flow queue with the lowest virtual time from the given priority band, and charges it the
+// cost of its head item.
+func (p *wfq) Pick(
+	_ context.Context,
+	flowGroup flowcontrol.PriorityBandAccessor,
+) (flowcontrol.FlowQueueAccessor, error) {
+	if flowGroup == nil {
+		return nil, nil
+	}
+
+	v := flowGroup.PolicyState()
+	s, ok := v.(*wfqState)
+	if !ok {
+		return nil, fmt.Errorf("invalid state type for WFQ policy: expected *wfqState, got %T", v)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := flowGroup.FlowKeys()
+	active := make(map[flowcontrol.FlowKey]bool, len(keys))
+	var picked flowcontrol.FlowQueueAccessor
+	var pickedKey flowcontrol.FlowKey
+	pickedStart := math.Inf(1)
+	for _, key := range keys {
+		active[key] = true
+		queue := flowGroup.Queue(key.ID)
+		if queue == nil || queue.Len() == 0 {
+			continue
+		}
+		start := max(s.flowTimes[key], s.virtualTime)
+		// Ties are broken on the flow keys for determinism.
+		if start < pickedStart || (start == pickedStart && key.Compare(pickedKey) < 0) {
+			picked, pickedKey, pickedStart = queue, key, start
+		}
+	}
+	// Forget the flows removed from the band.
+	for key := range s.flowTimes {
+		if !active[key] {
+			delete(s.flowTimes, key)
+		}
+	}
+	if picked == nil {
+		return nil, nil
+	}
+
+	cost := 1.0
+	if p.costBytes {
+		if head := picked.PeekHead(); head != nil {
+			cost = float64(head.OriginalRequest().ByteSize())
+		}
+	}
+	s.virtualTime = pickedStart
+	s.flowTimes[pickedKey] = pickedStart + cost/p.weight(pickedKey)
+	return picked, nil
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wfq
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/flowcontrol"
+	frameworkmocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/flowcontrol/mocks"
+)
+
+// newBand returns a band holding the given flows, each with a non-empty queue whose head item has the given byte size.
+func newBand(state any, sizes map[string]uint64) (*frameworkmocks.MockPriorityBandAccessor, map[string]*frameworkmocks.MockFlowQueueAccessor) {
+	queues := map[string]*frameworkmocks.MockFlowQueueAccessor{}
+	keys := []flowcontrol.FlowKey{}
+	for id, size := range sizes {
+		key := flowcontrol.FlowKey{ID: id}
+		keys = append(keys, key)
+		queues[id] = &frameworkmocks.MockFlowQueueAccessor{
+			LenV:     1,
+			FlowKeyV: key,
+			PeekHeadV: &frameworkmocks.MockQueueItemAccessor{
+				OriginalRequestV: frameworkmocks.NewMockFlowControlRequest(size, id+"-req", key),
+			},
+		}
+	}
+	band := &frameworkmocks.MockPriorityBandAccessor{
+		PolicyStateV: state,
+		FlowKeysFunc: func() []flowcontrol.FlowKey { return keys },
+		QueueFunc: func(id string) flowcontrol.FlowQueueAccessor {
+			if q, ok := queues[id]; ok {
+				return q
+			}
+			return nil
+		},
+	}
+	return band, queues
+}
+
+// pickCounts returns how many times each flow was picked over the given number of picks.
+func pickCounts(t *testing.T, policy *wfq, band flowcontrol.PriorityBandAccessor, picks int) map[string]int {
+	t.Helper()
+	counts := map[string]int{}
+	for range picks {
+		selected, err := policy.Pick(context.Background(), band)
+		require.NoError(t, err)
+		require.NotNil(t, selected)
+		counts[selected.FlowKey().ID]++
+	}
+	return counts
+}
+
+func TestWFQ_Factory(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		config  string
+		wantErr bool
+	}{
+		{name: "no config", config: ""},
+		{name: "weights and cost", config: `{"weights": {"tenant-a": 3}, "defaultWeight": 0.5, "cost": "bytes"}`},
+		{name: "non positive weight", config: `{"weights": {"tenant-a": 0}}`, wantErr: true},
+		{name: "non positive default weight", config: `{"defaultWeight": -1}`, wantErr: true},
+		{name: "unknown cost", config: `{"cost": "tokens"}`, wantErr: true},
+		{name: "malformed", config: `{"weights": []}`, wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			plugin, err := WeightedFairQueuingFairnessPolicyFactory("test-wfq", json.RawMessage(test.config), nil)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "test-wfq", plugin.TypedName().Name)
+			assert.Equal(t, WeightedFairQueuingFairnessPolicyType, plugin.TypedName().Type)
+		})
+	}
+}
+
+func TestWFQ_Pick_SharesInProportionToWeights(t *testing.T) {
+	t.Parallel()
+	policy, err := newWFQ("", config{Weights: map[string]float64{"tenant-a": 3}})
+	require.NoError(t, err)
+	band, _ := newBand(policy.NewState(context.Background()), map[string]uint64{"tenant-a": 100, "tenant-b": 100})
+
+	assert.Equal(t, map[string]int{"tenant-a": 300, "tenant-b": 100}, pickCounts(t, policy, band, 400))
+}
+
+func TestWFQ_Pick_ChargesBytes(t *testing.T) {
+	t.Parallel()
+	policy, err := newWFQ("", config{Cost: CostBytes})
+	require.NoError(t, err)
+	// The requests of tenant-a are four times as large, so it is picked four times less often.
+	band, _ := newBand(policy.NewState(context.Background()), map[string]uint64{"tenant-a": 400, "tenant-b": 100})
+
+	assert.Equal(t, map[string]int{"tenant-a": 20, "tenant-b": 80}, pickCounts(t, policy, band, 100))
+}
+
+func TestWFQ_Pick_IdleFlowEarnsNoCredit(t *testing.T) {
+	t.Parallel()
+	policy, err := newWFQ("", config{})
+	require.NoError(t, err)
+	band, queues := newBand(policy.NewState(context.Background()), map[string]uint64{"tenant-a": 100, "tenant-b": 100})
+
+	// tenant-b is idle while tenant-a is served alone.
+	queues["tenant-b"].LenV = 0
+	assert.Equal(t, map[string]int{"tenant-a": 50}, pickCounts(t, policy, band, 50))
+
+	// Once tenant-b is active again, the two alternate rather than tenant-b catching up on its idle time.
+	queues["tenant-b"].LenV = 1
+	assert.Equal(t, map[string]int{"tenant-a": 5, "tenant-b": 5}, pickCounts(t, policy, band, 10))
+}
+
+func TestWFQ_Pick_EmptyBand(t *testing.T) {
+	t.Parallel()
+	policy, err := newWFQ("", config{})
+	require.NoError(t, err)
+	band, queues := newBand(policy.NewState(context.Background()), map[string]uint64{"tenant-a": 100})
+	queues["tenant-a"].LenV = 0
+
+	selected, err := policy.Pick(context.Background(), band)
+	assert.NoError(t, err)
+	assert.Nil(t, selected)
+
+	selected, err = policy.Pick(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Nil(t, selected)
+}
+
+func TestWFQ_Pick_InvalidState(t *testing.T) {
+	t.Parallel()
+	policy, err := newWFQ("", config{})
+	require.NoError(t, err)
+	band, _ := newBand("not a state", map[string]uint64{"tenant-a": 100})
+
+	_, err = policy.Pick(context.Background(), band)
+	assert.Error(t, err)
+}
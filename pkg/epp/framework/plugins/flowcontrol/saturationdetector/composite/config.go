@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package composite
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// Policy defines how the signals of an endpoint are combined into its saturation score.
+type Policy string
+
+const (
+	// PolicyAny saturates an endpoint as soon as any of its signals reaches its threshold: the score is the highest
+	// signal ratio.
+	PolicyAny Policy = "any"
+	// PolicyAll saturates an endpoint only once all of its signals reach their threshold: the score is the lowest signal
+	// ratio.
+	PolicyAll Policy = "all"
+	// PolicyWeighted scores an endpoint with the weighted average of its signal ratios.
+	PolicyWeighted Policy = "weighted"
+)
+
+// Default configuration values
+const (
+	// DefaultPolicy is the default policy combining the signals.
+	DefaultPolicy = PolicyAny
+	// DefaultMetricsStalenessThreshold defines how old metrics can be before they are considered stale.
+	DefaultMetricsStalenessThreshold time.Duration = 200 * time.Millisecond
+	// DefaultSmoothing is the default weight of a new response in the moving averages of the observed signals.
+	DefaultSmoothing float64 = 0.2
+	// defaultWeight is the default weight of a signal under the weighted policy.
+	defaultWeight float64 = 1.0
+)
+
+// defaultSignals are the signals evaluated when none is configured, matching the utilization detector.
+var defaultSignals = []apiSignal{{Type: SignalQueueDepth}, {Type: SignalKVCacheUtilization}}
+
+// apiSignal represents the external configuration of a signal.
+type apiSignal struct {
+	// Type is the name of the signal, one of "queueDepth", "kvCacheUtilization", "ttft" or "errorRate".
+	Type string `json:"type"`
+
+	// Threshold is the value of the signal at which an endpoint is saturated. Defaults to 5 for queueDepth, 0.8 for
+	// kvCacheUtilization, 2000 (milliseconds) for ttft and 0.1 for errorRate.
+	Threshold *float64 `json:"threshold,omitempty"`
+
+	// Weight is the weight of the signal under the weighted policy. Defaults to 1.
+	Weight *float64 `json:"weight,omitempty"`
+}
+
+// apiConfig represents the external configuration schema for the composite detector.
+//
+// It is designed to be deserialized from JSON via the plugin's raw parameters.
+type apiConfig struct {
+	// Signals are the signals evaluated on each endpoint. Each signal is scored as the ratio of its value to its
+	// threshold. Defaults to the queueDepth and kvCacheUtilization signals.
+	Signals []apiSignal `json:"signals,omitempty"`
+
+	// Policy defines how the signal ratios of an endpoint are combined into its saturation score, one of "any", "all"
+	// or "weighted". Defaults to "any".
+	Policy *Policy `json:"policy,omitempty"`
+
+	// MetricsStalenessThreshold defines how old an endpoint's metrics can be before they are considered stale. When a
+	// scraped signal is configured, stale endpoints are treated as 100% saturated.
+	//
+	// Defaults to 200ms if unset.
+	MetricsStalenessThreshold *metav1.Duration `json:"metricsStalenessThreshold,omitempty"`
+
+	// Smoothing is the weight, in (0.0, 1.0], of a new response in the exponentially weighted moving averages of the
+	// observed signals (ttft and errorRate). Higher values react faster to changes but are noisier.
+	//
+	// Defaults to 0.2 if unset.
+	Smoothing *float64 `json:"smoothing,omitempty"`
+}
+
+// Config is the internal, fully-validated configuration used by the detector.
+type Config struct {
+	Signals                   []signal
+	Policy                    Policy
+	MetricsStalenessThreshold time.Duration
+	Smoothing                 float64
+}
+
+// buildConfig applies the configuration lifecycle (defaulting and validation) and translates the
+// external schema into the internal domain model.
+// The provided apiConfig is copied to prevent mutation side-effects.
+func buildConfig(apiCfg *apiConfig) (*Config, error) {
+	var safeCfg apiConfig
+	if apiCfg != nil {
+		safeCfg = *apiCfg
+		safeCfg.Signals = append([]apiSignal(nil), apiCfg.Signals...)
+	}
+
+	applyDefaults(&safeCfg)
+
+	if err := validateConfig(&safeCfg); err != nil {
+		return nil, fmt.Errorf("invalid composite detector configuration: %w", err)
+	}
+
+	signals := make([]signal, 0, len(safeCfg.Signals))
+	for _, s := range safeCfg.Signals {
+		signals = append(signals, signal{
+			signalDefinition: signalDefinitions[s.Type],
+			name:             s.Type,
+			threshold:        *s.Threshold,
+			weight:           *s.Weight,
+		})
+	}
+	return &Config{
+		Signals:                   signals,
+		Policy:                    *safeCfg.Policy,
+		MetricsStalenessThreshold: safeCfg.MetricsStalenessThreshold.Duration,
+		Smoothing:                 *safeCfg.Smoothing,
+	}, nil
+}
+
+// applyDefaults populates unset fields in the external configuration with their standard defaults.
+func applyDefaults(cfg *apiConfig) {
+	if len(cfg.Signals) == 0 {
+		cfg.Signals = append([]apiSignal(nil), defaultSignals...)
+	}
+	for i := range cfg.Signals {
+		s := &cfg.Signals[i]
+		if def, ok := signalDefinitions[s.Type]; ok && s.Threshold == nil {
+			s.Threshold = ptr.To(def.defaultThreshold)
+		}
+		if s.Weight == nil {
+			s.Weight = ptr.To(defaultWeight)
+		}
+	}
+	if cfg.Policy == nil {
+		cfg.Policy = ptr.To(DefaultPolicy)
+	}
+	if cfg.MetricsStalenessThreshold == nil {
+		cfg.MetricsStalenessThreshold = &metav1.Duration{Duration: DefaultMetricsStalenessThreshold}
+	}
+	if cfg.Smoothing == nil {
+		cfg.Smoothing = ptr.To(DefaultSmoothing)
+	}
+}
+
+// validateConfig checks the constraints of the fully defaulted configuration.
+// It aggregates all validation failures rather than failing on the first error.
+func validateConfig(cfg *apiConfig) error {
+	var errs []error
+
+	seen := make(map[string]bool, len(cfg.Signals))
+	for _, s := range cfg.Signals {
+		def, ok := signalDefinitions[s.Type]
+		if !ok {
+			errs = append(errs, fmt.Errorf("unknown signal %q", s.Type))
+			continue
+		}
+		if seen[s.Type] {
+			errs = append(errs, fmt.Errorf("signal %q is configured more than once", s.Type))
+		}
+		seen[s.Type] = true
+		if *s.Threshold <= 0 {
+			errs = append(errs, fmt.Errorf("threshold of signal %q must be strictly positive, got %v", s.Type, *s.Threshold))
+		} else if def.maxThreshold > 0 && *s.Threshold > def.maxThreshold {
+			errs = append(errs, fmt.Errorf("threshold of signal %q must be at most %v, got %v",
+				s.Type, def.maxThreshold, *s.Threshold))
+		}
+		if *s.Weight < 0 {
+			errs = append(errs, fmt.Errorf("weight of signal %q must be non-negative, got %v", s.Type, *s.Weight))
+		}
+	}
+	switch *cfg.Policy {
+	case PolicyAny, PolicyAll:
+	case PolicyWeighted:
+		var total float64
+		for _, s := range cfg.Signals {
+			if s.Weight != nil {
+				total += *s.Weight
+			}
+		}
+		if total <= 0 {
+			errs = append(errs, errors.New("the weighted policy requires at least one signal with a positive weight"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("policy must be one of %q, %q or %q, got %q",
+			PolicyAny, PolicyAll, PolicyWeighted, *cfg.Policy))
+	}
+	if cfg.MetricsStalenessThreshold.Duration <= 0 {
+		errs = append(errs, fmt.Errorf("metricsStalenessThreshold must be strictly positive, got %v",
+			cfg.MetricsStalenessThreshold.Duration))
+	}
+	if *cfg.Smoothing <= 0 || *cfg.Smoothing > 1 {
+		errs = append(errs, fmt.Errorf("smoothing must be in (0.0, 1.0], got %v", *cfg.Smoothing))
+	}
+
+	return errors.Join(errs...)
+}
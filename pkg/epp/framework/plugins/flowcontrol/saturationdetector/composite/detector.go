@@ -0,0 +1,231 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package composite implements a saturation detector combining pluggable endpoint signals, scraped (queue depth, KV
+// cache utilization) or observed on the responses (time to first token, error rate), with a configurable policy.
+//
+// For detailed configuration, see the package README.
+package composite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/flowcontrol"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+const (
+	// CompositeDetectorType is the unique identifier for this plugin.
+	CompositeDetectorType = "composite-detector"
+)
+
+// CompositeDetectorFactory instantiates the detector plugin using the provided JSON parameters.
+func CompositeDetectorFactory(
+	name string,
+	params json.RawMessage,
+	handle fwkplugin.Handle,
+) (fwkplugin.Plugin, error) {
+	var apiCfg apiConfig
+	if len(params) > 0 {
+		if err := json.Unmarshal(params, &apiCfg); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal composite detector config: %w", err)
+		}
+	}
+	cfg, err := buildConfig(&apiCfg)
+	if err != nil {
+		return nil, err
+	}
+	return NewDetector(name, *cfg, log.FromContext(handle.Context())), nil
+}
+
+var (
+	_ flowcontrol.SaturationDetector = &Detector{}
+	_ requestcontrol.PostResponse    = &Detector{}
+	_ datalayer.EndpointSubscriber   = &Detector{}
+)
+
+// Detector determines system saturation by combining the configured signals of the given candidate endpoints.
+type Detector struct {
+	config    Config
+	typedName fwkplugin.TypedName
+	now       func() time.Time
+	// observed reports whether any configured signal is observed on the responses.
+	observed bool
+
+	mu           sync.Mutex
+	observations map[k8stypes.NamespacedName]observation
+}
+
+// NewDetector creates a new instance of the Composite Detector.
+func NewDetector(name string, cfg Config, logger logr.Logger) *Detector {
+	typedName := fwkplugin.TypedName{
+		Type: CompositeDetectorType,
+		Name: name,
+	}
+
+	signals := make([]string, 0, len(cfg.Signals))
+	observed := false
+	for _, s := range cfg.Signals {
+		signals = append(signals, s.name)
+		observed = observed || !s.scraped
+	}
+	logger.WithName(typedName.String()).V(logutil.DEFAULT).Info("Creating new CompositeDetector",
+		"signals", signals,
+		"policy", cfg.Policy,
+		"metricsStalenessThreshold", cfg.MetricsStalenessThreshold.String(),
+		"smoothing", cfg.Smoothing)
+
+	return &Detector{
+		config:       cfg,
+		typedName:    typedName,
+		now:          time.Now,
+		observed:     observed,
+		observations: make(map[k8stypes.NamespacedName]observation),
+	}
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (d *Detector) TypedName() fwkplugin.TypedName {
+	return d.typedName
+}
+
+// Saturation calculates the saturation level of the pool.
+//
+// It returns an aggregate saturation signal where:
+//
+//	Saturation = Average(EndpointScore)
+//
+// The score of an endpoint combines the ratios of its signals to their thresholds with the configured policy: the
+// highest ratio for "any", the lowest for "all" and the weighted average for "weighted".
+func (d *Detector) Saturation(_ context.Context, candidates []datalayer.Endpoint) float64 {
+	if len(candidates) == 0 {
+		return 1.0
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var totalScore float64
+	for _, e := range candidates {
+		totalScore += d.score(e)
+	}
+	return totalScore / float64(len(candidates))
+}
+
+// score returns the saturation score of an endpoint. It must be called with the lock held.
+//
+// Endpoints with missing or stale metrics are fully saturated when a scraped signal is configured. Observed signals
+// which were not observed yet on the endpoint are ignored, and an endpoint without any known signal is idle.
+func (d *Detector) score(e datalayer.Endpoint) float64 {
+	metrics := e.GetMetrics()
+	stale := metrics == nil || d.now().Sub(metrics.UpdateTime) > d.config.MetricsStalenessThreshold
+	var obs observation
+	if metadata := e.GetMetadata(); metadata != nil {
+		obs = d.observations[metadata.NamespacedName]
+	}
+
+	var score, totalWeight float64
+	known := false
+	for _, s := range d.config.Signals {
+		if s.scraped && stale {
+			return 1.0
+		}
+		value, ok := s.value(metrics, obs)
+		if !ok {
+			continue
+		}
+		ratio := value / s.threshold
+		switch d.config.Policy {
+		case PolicyAny:
+			if !known || ratio > score {
+				score = ratio
+			}
+		case PolicyAll:
+			if !known || ratio < score {
+				score = ratio
+			}
+		case PolicyWeighted:
+			score += ratio * s.weight
+			totalWeight += s.weight
+		}
+		known = true
+	}
+	if d.config.Policy == PolicyWeighted {
+		if totalWeight == 0 {
+			return 0
+		}
+		return score / totalWeight
+	}
+	return score
+}
+
+// PostResponse updates the observed signals of the endpoint which served the response. Responses abandoned by their
+// clients are ignored, unless they failed.
+func (d *Detector) PostResponse(ctx context.Context, _ *framework.InferenceRequest, response *requestcontrol.CompletedResponse,
+	targetEndpoint *datalayer.EndpointMetadata) {
+	if !d.observed || response == nil || targetEndpoint == nil || (response.Abandoned && !response.Failed) {
+		return
+	}
+
+	failed := 0.0
+	if response.Failed {
+		failed = 1.0
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	obs := d.observations[targetEndpoint.NamespacedName]
+	obs.errorRate = d.smooth(obs.errorRate, failed, obs.hasResponses)
+	obs.hasResponses = true
+	if response.TTFT > 0 {
+		obs.ttft = d.smooth(obs.ttft, float64(response.TTFT.Milliseconds()), obs.hasTTFT)
+		obs.hasTTFT = true
+	}
+	d.observations[targetEndpoint.NamespacedName] = obs
+	log.FromContext(ctx).V(logutil.TRACE).Info("Updated the observed signals of an endpoint",
+		"endpoint", targetEndpoint.NamespacedName, "ttftMs", obs.ttft, "errorRate", obs.errorRate)
+}
+
+// OnEndpointChange drops the observed signals of the endpoints leaving the pool, so that they do not accumulate with
+// the churn of the pods. The signals of the endpoints joining the pool are reset as well, so that a pod recreated with
+// the same name does not inherit the signals of its predecessor from responses received after its deletion.
+func (d *Detector) OnEndpointChange(_ context.Context, change datalayer.EndpointChange) {
+	if change.Type == datalayer.EndpointUpdated || change.Endpoint.GetMetadata() == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.observations, change.Endpoint.GetMetadata().NamespacedName)
+}
+
+// smooth returns the exponentially weighted moving average updated with the given sample. The first sample initializes
+// the average.
+func (d *Detector) smooth(average, sample float64, initialized bool) float64 {
+	if !initialized {
+		return sample
+	}
+	return average + d.config.Smoothing*(sample-average)
+}
@@ -0,0 +1,285 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package composite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+)
+
+func makeEndpoint(name string, queueDepth int, kvUsage float64, updateTime time.Time) fwkdl.Endpoint {
+	meta := &fwkdl.EndpointMetadata{
+		NamespacedName: types.NamespacedName{Name: name, Namespace: "ns1"},
+	}
+	metrics := fwkdl.NewMetrics()
+	metrics.WaitingQueueSize = queueDepth
+	metrics.KVCacheUsagePercent = kvUsage
+	metrics.UpdateTime = updateTime
+	return fwkdl.NewEndpoint(meta, metrics)
+}
+
+func newTestDetector(t *testing.T, apiCfg apiConfig, now time.Time) *Detector {
+	t.Helper()
+	cfg, err := buildConfig(&apiCfg)
+	require.NoError(t, err)
+	d := NewDetector("test-composite-detector", *cfg, logr.Discard())
+	d.now = func() time.Time { return now }
+	return d
+}
+
+func TestCompositeDetectorFactory(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		configJSON []byte
+		wantError  bool
+	}{
+		{
+			name:       "empty config applies defaults",
+			configJSON: []byte(`{}`),
+		},
+		{
+			name: "valid configuration",
+			configJSON: []byte(`{"policy": "weighted", "signals": [{"type": "queueDepth", "threshold": 10, "weight": 2},
+				{"type": "ttft", "threshold": 1500}, {"type": "errorRate"}]}`),
+		},
+		{
+			name:       "invalid schema",
+			configJSON: []byte(`{"signals": "queueDepth"}`),
+			wantError:  true,
+		},
+		{
+			name:       "unknown signal",
+			configJSON: []byte(`{"signals": [{"type": "gpuTemperature"}]}`),
+			wantError:  true,
+		},
+		{
+			name:       "duplicate signal",
+			configJSON: []byte(`{"signals": [{"type": "queueDepth"}, {"type": "queueDepth", "threshold": 10}]}`),
+			wantError:  true,
+		},
+		{
+			name:       "non-positive threshold",
+			configJSON: []byte(`{"signals": [{"type": "ttft", "threshold": 0}]}`),
+			wantError:  true,
+		},
+		{
+			name:       "fraction threshold above one",
+			configJSON: []byte(`{"signals": [{"type": "kvCacheUtilization", "threshold": 1.5}]}`),
+			wantError:  true,
+		},
+		{
+			name:       "negative weight",
+			configJSON: []byte(`{"signals": [{"type": "queueDepth", "weight": -1}]}`),
+			wantError:  true,
+		},
+		{
+			name:       "weighted policy without weight",
+			configJSON: []byte(`{"policy": "weighted", "signals": [{"type": "queueDepth", "weight": 0}]}`),
+			wantError:  true,
+		},
+		{
+			name:       "unknown policy",
+			configJSON: []byte(`{"policy": "majority"}`),
+			wantError:  true,
+		},
+		{
+			name:       "invalid smoothing",
+			configJSON: []byte(`{"smoothing": 1.5}`),
+			wantError:  true,
+		},
+		{
+			name:       "invalid metrics staleness",
+			configJSON: []byte(`{"metricsStalenessThreshold": "0s"}`),
+			wantError:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			plugin, err := CompositeDetectorFactory("test-composite-detector", tc.configJSON,
+				fwkplugin.NewEppHandle(t.Context(), func() []types.NamespacedName { return nil }))
+			if tc.wantError {
+				require.Error(t, err, "Expected initialization to fail on invalid configuration")
+				require.Nil(t, plugin, "Plugin must be nil when initialization fails")
+			} else {
+				require.NoError(t, err, "Expected initialization to succeed with valid configuration")
+				require.NotNil(t, plugin, "Plugin must not be nil on success")
+			}
+		})
+	}
+}
+
+func TestDetector_Saturation(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	scraped := []apiSignal{
+		{Type: SignalQueueDepth, Threshold: ptr.To(10.0), Weight: ptr.To(3.0)},
+		{Type: SignalKVCacheUtilization, Threshold: ptr.To(0.8), Weight: ptr.To(1.0)},
+	}
+
+	tests := []struct {
+		name      string
+		config    apiConfig
+		endpoints []fwkdl.Endpoint
+		want      float64
+	}{
+		{
+			name:   "no candidates is saturated",
+			config: apiConfig{},
+			want:   1.0,
+		},
+		{
+			name:   "any policy uses the most constrained signal",
+			config: apiConfig{Signals: scraped, Policy: ptr.To(PolicyAny)},
+			// Queue ratio 0.5, KV cache ratio 0.75.
+			endpoints: []fwkdl.Endpoint{makeEndpoint("pod1", 5, 0.6, now)},
+			want:      0.75,
+		},
+		{
+			name:      "all policy uses the least constrained signal",
+			config:    apiConfig{Signals: scraped, Policy: ptr.To(PolicyAll)},
+			endpoints: []fwkdl.Endpoint{makeEndpoint("pod1", 5, 0.6, now)},
+			want:      0.5,
+		},
+		{
+			name:      "weighted policy averages the signals",
+			config:    apiConfig{Signals: scraped, Policy: ptr.To(PolicyWeighted)},
+			endpoints: []fwkdl.Endpoint{makeEndpoint("pod1", 5, 0.6, now)},
+			// (0.5*3 + 0.75*1) / 4.
+			want: 0.5625,
+		},
+		{
+			name:   "scores are averaged across endpoints",
+			config: apiConfig{Signals: scraped},
+			endpoints: []fwkdl.Endpoint{
+				makeEndpoint("pod1", 20, 0.0, now),
+				makeEndpoint("pod2", 0, 0.0, now),
+			},
+			want: 1.0,
+		},
+		{
+			name:      "stale metrics saturate the endpoint",
+			config:    apiConfig{Signals: scraped},
+			endpoints: []fwkdl.Endpoint{makeEndpoint("pod1", 0, 0.0, now.Add(-time.Second))},
+			want:      1.0,
+		},
+		{
+			name:      "stale metrics are ignored without scraped signals",
+			config:    apiConfig{Signals: []apiSignal{{Type: SignalTTFT}}},
+			endpoints: []fwkdl.Endpoint{makeEndpoint("pod1", 0, 0.0, now.Add(-time.Second))},
+			want:      0.0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			d := newTestDetector(t, tc.config, now)
+			require.InDelta(t, tc.want, d.Saturation(context.Background(), tc.endpoints), 1e-9)
+		})
+	}
+}
+
+func TestDetector_ObservedSignals(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	endpoint := makeEndpoint("pod1", 0, 0.0, now)
+
+	t.Run("ttft is smoothed across responses", func(t *testing.T) {
+		t.Parallel()
+		d := newTestDetector(t, apiConfig{
+			Signals:   []apiSignal{{Type: SignalTTFT, Threshold: ptr.To(1000.0)}},
+			Smoothing: ptr.To(0.5),
+		}, now)
+		require.Zero(t, d.Saturation(context.Background(), []fwkdl.Endpoint{endpoint}),
+			"An endpoint without observed responses should be idle")
+
+		d.PostResponse(context.Background(), nil, &requestcontrol.CompletedResponse{TTFT: 2 * time.Second}, endpoint.GetMetadata())
+		require.InDelta(t, 2.0, d.Saturation(context.Background(), []fwkdl.Endpoint{endpoint}), 1e-9)
+
+		d.PostResponse(context.Background(), nil, &requestcontrol.CompletedResponse{TTFT: time.Second}, endpoint.GetMetadata())
+		require.InDelta(t, 1.5, d.Saturation(context.Background(), []fwkdl.Endpoint{endpoint}), 1e-9)
+	})
+
+	t.Run("error rate counts failed responses", func(t *testing.T) {
+		t.Parallel()
+		d := newTestDetector(t, apiConfig{
+			Signals:   []apiSignal{{Type: SignalErrorRate, Threshold: ptr.To(0.5)}},
+			Smoothing: ptr.To(0.5),
+		}, now)
+
+		d.PostResponse(context.Background(), nil, &requestcontrol.CompletedResponse{}, endpoint.GetMetadata())
+		d.PostResponse(context.Background(), nil, &requestcontrol.CompletedResponse{Failed: true}, endpoint.GetMetadata())
+		require.InDelta(t, 1.0, d.Saturation(context.Background(), []fwkdl.Endpoint{endpoint}), 1e-9)
+
+		d.PostResponse(context.Background(), nil, &requestcontrol.CompletedResponse{Abandoned: true}, endpoint.GetMetadata())
+		require.InDelta(t, 1.0, d.Saturation(context.Background(), []fwkdl.Endpoint{endpoint}), 1e-9,
+			"Abandoned responses should be ignored")
+	})
+
+	t.Run("signals are dropped when the endpoint leaves the pool", func(t *testing.T) {
+		t.Parallel()
+		d := newTestDetector(t, apiConfig{
+			Signals:   []apiSignal{{Type: SignalTTFT, Threshold: ptr.To(1000.0)}},
+			Smoothing: ptr.To(0.5),
+		}, now)
+		d.PostResponse(context.Background(), nil, &requestcontrol.CompletedResponse{TTFT: 2 * time.Second}, endpoint.GetMetadata())
+
+		d.OnEndpointChange(context.Background(), fwkdl.EndpointChange{Type: fwkdl.EndpointUpdated, Endpoint: endpoint})
+		require.InDelta(t, 2.0, d.Saturation(context.Background(), []fwkdl.Endpoint{endpoint}), 1e-9,
+			"Updates should keep the observed signals")
+
+		d.OnEndpointChange(context.Background(), fwkdl.EndpointChange{Type: fwkdl.EndpointDeleted, Endpoint: endpoint})
+		require.Empty(t, d.observations)
+
+		// A response received after the deletion does not leak into a pod recreated with the same name.
+		d.PostResponse(context.Background(), nil, &requestcontrol.CompletedResponse{TTFT: 2 * time.Second}, endpoint.GetMetadata())
+		d.OnEndpointChange(context.Background(), fwkdl.EndpointChange{Type: fwkdl.EndpointAdded, Endpoint: endpoint})
+		require.Zero(t, d.Saturation(context.Background(), []fwkdl.Endpoint{endpoint}))
+	})
+
+	t.Run("observed signals combine with scraped signals", func(t *testing.T) {
+		t.Parallel()
+		d := newTestDetector(t, apiConfig{
+			Signals: []apiSignal{
+				{Type: SignalQueueDepth, Threshold: ptr.To(10.0)},
+				{Type: SignalTTFT, Threshold: ptr.To(1000.0)},
+			},
+			Policy: ptr.To(PolicyAll),
+		}, now)
+		busy := makeEndpoint("pod1", 20, 0.0, now)
+		require.InDelta(t, 2.0, d.Saturation(context.Background(), []fwkdl.Endpoint{busy}), 1e-9,
+			"Unobserved signals should be ignored")
+
+		d.PostResponse(context.Background(), nil, &requestcontrol.CompletedResponse{TTFT: 500 * time.Millisecond}, busy.GetMetadata())
+		require.InDelta(t, 0.5, d.Saturation(context.Background(), []fwkdl.Endpoint{busy}), 1e-9)
+	})
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package composite
+
+import (
+	"time"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// Supported signals.
+const (
+	// SignalQueueDepth is the waiting queue size scraped from the endpoint.
+	SignalQueueDepth = "queueDepth"
+	// SignalKVCacheUtilization is the KV cache utilization, in [0.0, 1.0], scraped from the endpoint.
+	SignalKVCacheUtilization = "kvCacheUtilization"
+	// SignalTTFT is the time to first token, in milliseconds, observed on the responses of the endpoint.
+	SignalTTFT = "ttft"
+	// SignalErrorRate is the fraction of failed responses, in [0.0, 1.0], observed on the responses of the endpoint.
+	SignalErrorRate = "errorRate"
+)
+
+// observation is the state of an endpoint observed on its responses, smoothed with an exponentially weighted moving
+// average.
+type observation struct {
+	ttft      float64
+	errorRate float64
+	// hasTTFT and hasResponses report whether a TTFT and a response were observed at all.
+	hasTTFT      bool
+	hasResponses bool
+}
+
+// signalDefinition describes how a signal is read from an endpoint.
+type signalDefinition struct {
+	// defaultThreshold is the value at which the signal saturates the endpoint when no threshold is configured.
+	defaultThreshold float64
+	// maxThreshold bounds the thresholds of the signals expressed as fractions. Zero means unbounded.
+	maxThreshold float64
+	// scraped reports whether the signal is read from the scraped metrics of the endpoint, which must then be fresh.
+	scraped bool
+	// value returns the current value of the signal for an endpoint, and false if it is unknown.
+	value func(metrics *fwkdl.Metrics, obs observation) (float64, bool)
+}
+
+// signalDefinitions is the registry of the supported signals, keyed by name.
+var signalDefinitions = map[string]signalDefinition{
+	SignalQueueDepth: {
+		defaultThreshold: 5,
+		scraped:          true,
+		value: func(metrics *fwkdl.Metrics, _ observation) (float64, bool) {
+			return float64(metrics.WaitingQueueSize), true
+		},
+	},
+	SignalKVCacheUtilization: {
+		defaultThreshold: 0.8,
+		maxThreshold:     1.0,
+		scraped:          true,
+		value: func(metrics *fwkdl.Metrics, _ observation) (float64, bool) {
+			return metrics.KVCacheUsagePercent, true
+		},
+	},
+	SignalTTFT: {
+		defaultThreshold: float64((2 * time.Second).Milliseconds()),
+		value: func(_ *fwkdl.Metrics, obs observation) (float64, bool) {
+			return obs.ttft, obs.hasTTFT
+		},
+	},
+	SignalErrorRate: {
+		defaultThreshold: 0.1,
+		maxThreshold:     1.0,
+		value: func(_ *fwkdl.Metrics, obs observation) (float64, bool) {
+			return obs.errorRate, obs.hasResponses
+		},
+	},
+}
+
+// signal is a configured signal.
+type signal struct {
+	signalDefinition
+	name      string
+	threshold float64
+	weight    float64
+}
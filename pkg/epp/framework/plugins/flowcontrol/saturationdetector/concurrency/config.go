@@ -74,6 +74,34 @@ type apiConfig struct {
 	//
 	// Defaults to 1000000 if unset.
 	MaxTokenConcurrency *int64 `json:"maxTokenConcurrency,omitempty"`
+
+	// MaxPoolConcurrency defines an absolute ceiling on the total number of requests in flight across
+	// the pool, independent of the number of endpoints.
+	//
+	// This protects dependencies shared by all endpoints (e.g., a KV-cache service or shared storage)
+	// that do not scale with the pool. PoolSaturation is the greater of the endpoint-based saturation
+	// and Total Inflight Requests / MaxPoolConcurrency, so the Flow Controller queues traffic beyond the
+	// ceiling. The ceiling always counts requests, including in "tokens" mode, and does not affect the
+	// per-endpoint Filter.
+	//
+	// Defaults to 0 (no ceiling) if unset.
+	MaxPoolConcurrency *int64 `json:"maxPoolConcurrency,omitempty"`
+
+	// Slices overrides the endpoint capacity for the endpoints of the given slices, keyed by slice name.
+	//
+	// Pools composed of several Deployments with different configurations (e.g., tensor-parallel sizes)
+	// group their endpoints into slices through the "inference.networking.k8s.io/slice" pod label. The
+	// endpoints of a slice use the capacity configured for the slice, both in the PoolSaturation
+	// calculation and in the per-endpoint Filter; the other endpoints use the global capacity.
+	Slices map[string]sliceAPIConfig `json:"slices,omitempty"`
+}
+
+// sliceAPIConfig represents the capacity overrides of a slice. Unset fields default to the global value.
+type sliceAPIConfig struct {
+	// MaxConcurrency overrides MaxConcurrency for the endpoints of the slice.
+	MaxConcurrency *int64 `json:"maxConcurrency,omitempty"`
+	// MaxTokenConcurrency overrides MaxTokenConcurrency for the endpoints of the slice.
+	MaxTokenConcurrency *int64 `json:"maxTokenConcurrency,omitempty"`
 }
 
 // concurrencyMode is the concurrency detection mode.
@@ -103,6 +131,9 @@ type config struct {
 	headroom            float64
 	mode                concurrencyMode
 	maxTokenConcurrency int64
+	maxPoolConcurrency  int64
+	// sliceCapacities holds the per-endpoint capacity of the configured slices, in units of the mode.
+	sliceCapacities map[string]int64
 }
 
 // buildConfig applies the configuration lifecycle (defaulting and validation) and translates the
@@ -120,12 +151,28 @@ func buildConfig(apiCfg *apiConfig) (*config, error) {
 		return nil, fmt.Errorf("invalid concurrency detector configuration: %w", err)
 	}
 
-	return &config{
+	cfg := &config{
 		maxConcurrency:      *safeCfg.MaxConcurrency,
 		headroom:            *safeCfg.Headroom,
 		mode:                *safeCfg.ConcurrencyMode,
 		maxTokenConcurrency: *safeCfg.MaxTokenConcurrency,
-	}, nil
+		maxPoolConcurrency:  *safeCfg.MaxPoolConcurrency,
+	}
+	if len(safeCfg.Slices) > 0 {
+		cfg.sliceCapacities = make(map[string]int64, len(safeCfg.Slices))
+		for slice, sliceCfg := range safeCfg.Slices {
+			cfg.sliceCapacities[slice] = cfg.capacity(sliceCfg.MaxConcurrency, sliceCfg.MaxTokenConcurrency)
+		}
+	}
+	return cfg, nil
+}
+
+// capacity returns the per-endpoint capacity in units of the mode, falling back to the global limits for unset values.
+func (c *config) capacity(maxConcurrency, maxTokenConcurrency *int64) int64 {
+	if c.mode == modeTokens {
+		return ptr.Deref(maxTokenConcurrency, c.maxTokenConcurrency)
+	}
+	return ptr.Deref(maxConcurrency, c.maxConcurrency)
 }
 
 // applyDefaults populates unset fields in the external configuration with their standard defaults.
@@ -142,6 +189,9 @@ func applyDefaults(cfg *apiConfig) {
 	if cfg.MaxTokenConcurrency == nil {
 		cfg.MaxTokenConcurrency = ptr.To(defaultMaxTokenConcurrency)
 	}
+	if cfg.MaxPoolConcurrency == nil {
+		cfg.MaxPoolConcurrency = ptr.To(int64(0))
+	}
 }
 
 // validateConfig checks the constraints of the fully defaulted configuration.
@@ -158,6 +208,21 @@ func validateConfig(cfg *apiConfig) error {
 	if cfg.MaxTokenConcurrency != nil && *cfg.MaxTokenConcurrency <= 0 {
 		errs = append(errs, fmt.Errorf("maxTokenConcurrency must be strictly positive, got %d", *cfg.MaxTokenConcurrency))
 	}
+	if cfg.MaxPoolConcurrency != nil && *cfg.MaxPoolConcurrency < 0 {
+		errs = append(errs, fmt.Errorf("maxPoolConcurrency must be non-negative, got %d", *cfg.MaxPoolConcurrency))
+	}
+
+	for slice, sliceCfg := range cfg.Slices {
+		if slice == "" {
+			errs = append(errs, errors.New("slice name must not be empty"))
+		}
+		if sliceCfg.MaxConcurrency != nil && *sliceCfg.MaxConcurrency <= 0 {
+			errs = append(errs, fmt.Errorf("maxConcurrency of slice %q must be strictly positive, got %d", slice, *sliceCfg.MaxConcurrency))
+		}
+		if sliceCfg.MaxTokenConcurrency != nil && *sliceCfg.MaxTokenConcurrency <= 0 {
+			errs = append(errs, fmt.Errorf("maxTokenConcurrency of slice %q must be strictly positive, got %d", slice, *sliceCfg.MaxTokenConcurrency))
+		}
+	}
 
 	if cfg.ConcurrencyMode != nil {
 		switch *cfg.ConcurrencyMode {
@@ -60,8 +60,9 @@ func ConcurrencyDetectorFactory(
 }
 
 var (
-	_ framework.Filter               = &detector{}
-	_ flowcontrol.SaturationDetector = &detector{}
+	_ framework.Filter                       = &detector{}
+	_ flowcontrol.SaturationDetector         = &detector{}
+	_ flowcontrol.ReasonedSaturationDetector = &detector{}
 )
 
 // detector implements a saturation detector and scheduling filter based on active request concurrency.
@@ -82,6 +83,8 @@ func newDetector(name string, cfg config, logger logr.Logger) *detector {
 		"mode", cfg.mode,
 		"maxConcurrency", cfg.maxConcurrency,
 		"maxTokenConcurrency", cfg.maxTokenConcurrency,
+		"maxPoolConcurrency", cfg.maxPoolConcurrency,
+		"sliceCapacities", cfg.sliceCapacities,
 		"headroom", cfg.headroom)
 
 	if cfg.headroom > 1.0 {
@@ -107,6 +110,10 @@ func (d *detector) Consumes() map[string]any {
 	}
 }
 
+func (d *detector) Requires() []string {
+	return []string{attrconcurrency.InFlightLoadKey}
+}
+
 func (d *detector) getLoad(m datalayer.AttributeMap) *attrconcurrency.InFlightLoad {
 	if val, ok := m.Get(attrconcurrency.InFlightLoadKey); ok {
 		if load, ok := val.(*attrconcurrency.InFlightLoad); ok {
@@ -121,29 +128,43 @@ func (d *detector) getLoad(m datalayer.AttributeMap) *attrconcurrency.InFlightLo
 // It returns an aggregate saturation signal where:
 //
 //	Saturation = Total Inflight Requests / Total MaxConcurrency Capacity.
-func (d *detector) Saturation(_ context.Context, endpoints []datalayer.Endpoint) float64 {
-	var totalInflight, totalCapacity int64
+//
+// When MaxPoolConcurrency is set, the saturation is raised to Total Inflight Requests / MaxPoolConcurrency if greater.
+func (d *detector) Saturation(ctx context.Context, endpoints []datalayer.Endpoint) float64 {
+	saturation, _ := d.SaturationWithReason(ctx, endpoints)
+	return saturation
+}
+
+// SaturationWithReason returns the saturation of the pool, and whether it is determined by the aggregate capacity of
+// the endpoints or by the pool concurrency ceiling.
+func (d *detector) SaturationWithReason(_ context.Context, endpoints []datalayer.Endpoint) (float64, flowcontrol.SaturationReason) {
+	var totalInflight, totalCapacity, totalRequests int64
 	for _, e := range endpoints {
 		if e.GetMetadata() == nil {
 			continue
 		}
 
 		load := d.getLoad(e.GetAttributes())
+		totalRequests += load.Requests
+		totalCapacity += d.endpointCapacity(e.GetMetadata())
 
 		if d.config.mode == modeTokens {
 			totalInflight += load.Tokens
-			totalCapacity += d.config.maxTokenConcurrency
 		} else {
 			totalInflight += load.Requests
-			totalCapacity += d.config.maxConcurrency
 		}
 	}
 
-	if totalCapacity == 0 {
-		return 1.0
+	saturation := 1.0
+	if totalCapacity > 0 {
+		saturation = float64(totalInflight) / float64(totalCapacity)
 	}
-
-	return float64(totalInflight) / float64(totalCapacity)
+	if d.config.maxPoolConcurrency > 0 {
+		if ceilingSaturation := float64(totalRequests) / float64(d.config.maxPoolConcurrency); ceilingSaturation > saturation {
+			return ceilingSaturation, flowcontrol.SaturationReasonPoolConcurrencyCeiling
+		}
+	}
+	return saturation, flowcontrol.SaturationReasonEndpointCapacity
 }
 
 // Filter blocks traffic to specific endpoints that are physically saturated or exceeding their safety limits.
@@ -158,15 +179,9 @@ func (d *detector) Filter(
 	// Pre-allocate assuming most endpoints will pass the filter to minimize allocations.
 	filtered := make([]framework.Endpoint, 0, len(endpoints))
 
-	var limit int64
-	if d.config.mode == modeTokens {
-		limit = int64(float64(d.config.maxTokenConcurrency) * (1.0 + d.config.headroom))
-	} else {
-		limit = int64(float64(d.config.maxConcurrency) * (1.0 + d.config.headroom))
-	}
-
 	for _, e := range endpoints {
 		load := d.getLoad(e)
+		limit := int64(float64(d.endpointCapacity(e.GetMetadata())) * (1.0 + d.config.headroom))
 
 		if d.config.mode == modeTokens {
 			if load.Tokens < limit {
@@ -180,3 +195,15 @@ func (d *detector) Filter(
 	}
 	return filtered
 }
+
+// endpointCapacity returns the ideal capacity of the endpoint in units of the mode, taking the capacity of its slice
+// into account.
+func (d *detector) endpointCapacity(metadata *datalayer.EndpointMetadata) int64 {
+	if capacity, ok := d.config.sliceCapacities[metadata.Slice()]; ok {
+		return capacity
+	}
+	if d.config.mode == modeTokens {
+		return d.config.maxTokenConcurrency
+	}
+	return d.config.maxConcurrency
+}
@@ -25,8 +25,10 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/flowcontrol"
 	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
 	fwkrh "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
@@ -105,6 +107,21 @@ func TestConcurrencyDetectorFactory(t *testing.T) {
 			configJSON: []byte(`{"maxTokenConcurrency": 0}`),
 			wantError:  true,
 		},
+		{
+			name:       "invalid max pool concurrency",
+			configJSON: []byte(`{"maxPoolConcurrency": -1}`),
+			wantError:  true,
+		},
+		{
+			name:       "valid slice capacities",
+			configJSON: []byte(`{"maxConcurrency": 50, "slices": {"tp8": {"maxConcurrency": 200}}}`),
+			wantError:  false,
+		},
+		{
+			name:       "invalid slice max concurrency",
+			configJSON: []byte(`{"slices": {"tp8": {"maxConcurrency": 0}}}`),
+			wantError:  true,
+		},
 		{
 			name:       "invalid headroom",
 			configJSON: []byte(`{"headroom": -0.5}`),
@@ -294,6 +311,100 @@ func TestDetector_Saturation(t *testing.T) {
 	}
 }
 
+// TestDetector_PoolConcurrencyCeiling verifies that the pool ceiling caps saturation independently of the endpoint
+// count, and that the binding constraint is reported.
+func TestDetector_PoolConcurrencyCeiling(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		config         config
+		endpointLoad   map[string]int
+		wantSaturation float64
+		wantReason     flowcontrol.SaturationReason
+	}{
+		{
+			name:           "endpoint_capacity_binding",
+			config:         config{mode: modeRequests, maxConcurrency: 10, maxPoolConcurrency: 100},
+			endpointLoad:   map[string]int{"endpoint-a": 8, "endpoint-b": 8},
+			wantSaturation: 0.8,
+			wantReason:     flowcontrol.SaturationReasonEndpointCapacity,
+		},
+		{
+			name:           "pool_ceiling_binding",
+			config:         config{mode: modeRequests, maxConcurrency: 10, maxPoolConcurrency: 8},
+			endpointLoad:   map[string]int{"endpoint-a": 5, "endpoint-b": 5},
+			wantSaturation: 1.25,
+			wantReason:     flowcontrol.SaturationReasonPoolConcurrencyCeiling,
+		},
+		{
+			name:           "pool_ceiling_counts_requests_in_token_mode",
+			config:         config{mode: modeTokens, maxTokenConcurrency: 1000, maxPoolConcurrency: 4},
+			endpointLoad:   map[string]int{"endpoint-a": 2},
+			wantSaturation: 0.5,
+			wantReason:     flowcontrol.SaturationReasonPoolConcurrencyCeiling,
+		},
+		{
+			name:           "no_ceiling",
+			config:         config{mode: modeRequests, maxConcurrency: 10},
+			endpointLoad:   map[string]int{"endpoint-a": 5},
+			wantSaturation: 0.5,
+			wantReason:     flowcontrol.SaturationReasonEndpointCapacity,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			reg := newLocalRegistry()
+			ctx := context.Background()
+			detector := newDetector("test-detector", tc.config, logr.Discard())
+
+			candidates := make([]datalayer.Endpoint, 0, len(tc.endpointLoad))
+			for endpointName, load := range tc.endpointLoad {
+				driveLoad(ctx, reg, detector, endpointName, load)
+				candidates = append(candidates, newFakeEndpoint(reg, endpointName))
+			}
+
+			gotSaturation, gotReason := detector.SaturationWithReason(ctx, candidates)
+			require.InDelta(t, tc.wantSaturation, gotSaturation, 1e-6, "Saturation result mismatch")
+			require.Equal(t, tc.wantReason, gotReason)
+			require.InDelta(t, gotSaturation, detector.Saturation(ctx, candidates), 1e-6)
+		})
+	}
+}
+
+// TestDetector_SliceCapacities verifies that the endpoints of a configured slice use the capacity of the slice, both for
+// saturation and filtering.
+func TestDetector_SliceCapacities(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	reg := newLocalRegistry()
+	cfg, err := buildConfig(&apiConfig{
+		MaxConcurrency: ptr.To(int64(10)),
+		Slices:         map[string]sliceAPIConfig{"tp8": {MaxConcurrency: ptr.To(int64(30))}},
+	})
+	require.NoError(t, err)
+	detector := newDetector("test-detector", *cfg, logr.Discard())
+
+	large := newFakeEndpoint(reg, "large")
+	large.GetMetadata().Labels = map[string]string{datalayer.SliceLabel: "tp8"}
+	small := newFakeEndpoint(reg, "small")
+	driveLoad(ctx, reg, detector, "large", 20)
+	driveLoad(ctx, reg, detector, "small", 10)
+
+	// (20 + 10) / (30 + 10)
+	require.InDelta(t, 0.75, detector.Saturation(ctx, []datalayer.Endpoint{large, small}), 1e-6)
+	require.InDelta(t, 20.0/30.0, detector.Saturation(ctx, []datalayer.Endpoint{large}), 1e-6)
+
+	largeCandidate := newStubSchedulingEndpoint(reg, "large")
+	largeCandidate.metadata.Labels = map[string]string{datalayer.SliceLabel: "tp8"}
+	kept := detector.Filter(ctx, nil, nil, []schedulingtypes.Endpoint{largeCandidate, newStubSchedulingEndpoint(reg, "small")})
+	require.Len(t, kept, 1, "only the endpoint below the capacity of its slice should pass the filter")
+	require.Equal(t, largeCandidate, kept[0])
+}
+
 // TestDetector_Lifecycle verifies the full state transition cycle.
 func TestDetector_Lifecycle(t *testing.T) {
 	t.Parallel()
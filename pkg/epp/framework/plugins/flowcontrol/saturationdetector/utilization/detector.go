@@ -69,6 +69,7 @@ var (
 type Detector struct {
 	config    Config
 	typedName fwkplugin.TypedName
+	now       func() time.Time
 }
 
 // NewDetector creates a new instance of the Utilization Detector.
@@ -95,6 +96,7 @@ func NewDetector(name string, cfg Config, logger logr.Logger) *Detector {
 	return &Detector{
 		config:    cfg,
 		typedName: typedName,
+		now:       time.Now,
 	}
 }
 
@@ -121,7 +123,7 @@ func (d *Detector) Saturation(_ context.Context, candidates []datalayer.Endpoint
 	for _, e := range candidates {
 		metrics := e.GetMetrics()
 
-		if metrics == nil || time.Since(metrics.UpdateTime) > d.config.MetricsStalenessThreshold {
+		if metrics == nil || d.now().Sub(metrics.UpdateTime) > d.config.MetricsStalenessThreshold {
 			totalScore += 1.0
 			continue
 		}
@@ -153,7 +155,7 @@ func (d *Detector) Filter(
 
 	for _, endpoint := range endpoints {
 		metrics := endpoint.GetMetrics()
-		if metrics == nil || time.Since(metrics.UpdateTime) > d.config.MetricsStalenessThreshold {
+		if metrics == nil || d.now().Sub(metrics.UpdateTime) > d.config.MetricsStalenessThreshold {
 			continue
 		}
 
@@ -263,6 +263,14 @@ func TestDetector_Saturation(t *testing.T) {
 			},
 			wantSaturation: 1.0,
 		},
+		{
+			name: "Metrics age exactly at staleness threshold",
+			pods: []fwkdl.Endpoint{
+				// Q=1/5 (0.2). KV=0.1/0.9 (0.11).
+				makePodMetric("pod1", 1, 0.1, baseTime.Add(-100*time.Millisecond)),
+			},
+			wantSaturation: 0.2,
+		},
 		{
 			name: "Metrics age just over staleness threshold",
 			pods: []fwkdl.Endpoint{
@@ -275,6 +283,7 @@ func TestDetector_Saturation(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			detector := NewDetector("test-detector", *config, logr.Discard())
+			detector.now = func() time.Time { return baseTime }
 
 			got := detector.Saturation(context.Background(), tc.pods)
 			require.InDelta(t, tc.wantSaturation, got, 1e-4, "Saturation mismatch")
@@ -365,6 +374,7 @@ func TestDetector_Filter(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			detector := NewDetector("test-detector", *config, logr.Discard())
+			detector.now = func() time.Time { return baseTime }
 			got := detector.Filter(context.Background(), nil, nil, tc.endpoints)
 			require.Len(t, got, tc.wantLen)
 		})
@@ -0,0 +1,220 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package contextwindow provides an admitter validating that the prompt and the requested completion length of a
+// request fit in the context window of its target model, before the request is scheduled.
+package contextwindow
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	fwkrh "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+const (
+	PluginType = "context-window-admitter"
+
+	// DefaultContextWindowLabel is the endpoint label holding the context window of the served model, in tokens.
+	DefaultContextWindowLabel = "inference.networking.k8s.io/context-window"
+
+	// PolicyReject rejects requests whose prompt and requested completion length exceed the context window.
+	PolicyReject = "reject"
+	// PolicyClamp lowers the requested completion length of such requests to fit the context window. Requests whose
+	// prompt alone exceeds the context window are rejected.
+	PolicyClamp = "clamp"
+
+	actionClamped  = "clamped"
+	actionRejected = "rejected"
+)
+
+// ParameterSchema is the JSON schema of the parameters of the plugin.
+const ParameterSchema = `{
+  "type": "object",
+  "additionalProperties": false,
+  "properties": {
+    "models": {"type": "object", "additionalProperties": {"type": "integer", "minimum": 1}},
+    "contextWindowLabel": {"type": "string"},
+    "policy": {"type": "string", "enum": ["reject", "clamp"]},
+    "charactersPerToken": {"type": "number", "exclusiveMinimum": true, "minimum": 0}
+  }
+}`
+
+var _ requestcontrol.Admitter = &Plugin{}
+
+type Config struct {
+	// Models maps model names to their context window, in tokens. Configured context windows take precedence over
+	// discovered ones.
+	Models map[string]int `json:"models,omitempty"`
+
+	// ContextWindowLabel is the endpoint label from which the context window is discovered for models that are not
+	// configured. When candidate endpoints disagree, the smallest context window is used. Default:
+	// inference.networking.k8s.io/context-window.
+	ContextWindowLabel string `json:"contextWindowLabel,omitempty"`
+
+	// Policy is the action taken on requests exceeding the context window, either "reject" or "clamp".
+	// Default: reject.
+	Policy string `json:"policy,omitempty"`
+
+	// CharactersPerToken is used to estimate the prompt length of requests that are not tokenized. Default: 4.
+	CharactersPerToken float64 `json:"charactersPerToken,omitempty"`
+}
+
+var DefaultConfig = Config{
+	ContextWindowLabel: DefaultContextWindowLabel,
+	Policy:             PolicyReject,
+	CharactersPerToken: 4,
+}
+
+func (c *Config) validate() error {
+	for model, window := range c.Models {
+		if window <= 0 {
+			return fmt.Errorf("context window of model %q must be > 0, got %d", model, window)
+		}
+	}
+	if c.Policy != PolicyReject && c.Policy != PolicyClamp {
+		return fmt.Errorf("policy must be %q or %q, got %q", PolicyReject, PolicyClamp, c.Policy)
+	}
+	if c.CharactersPerToken <= 0 {
+		return errors.New("charactersPerToken must be > 0")
+	}
+	return nil
+}
+
+// Plugin is an admitter enforcing the context window of the target model. Without it, requests that cannot fit are
+// only rejected by the model server, after they already went through queueing and scheduling and took a slot on
+// the selected endpoint.
+//
+// The prompt length is taken from the tokenized prompt when available, and estimated from the prompt text otherwise.
+type Plugin struct {
+	typedName fwkplugin.TypedName
+	config    Config
+}
+
+// Factory creates a new context window admitter from the given parameters.
+func Factory(name string, rawParameters json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	config := DefaultConfig
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal config for %s: %w", PluginType, err)
+		}
+	}
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config for %s: %w", PluginType, err)
+	}
+	return New(config).WithName(name), nil
+}
+
+// New creates a new context window admitter.
+func New(config Config) *Plugin {
+	return &Plugin{
+		typedName: fwkplugin.TypedName{Type: PluginType, Name: PluginType},
+		config:    config,
+	}
+}
+
+func (p *Plugin) WithName(name string) *Plugin {
+	p.typedName.Name = name
+	return p
+}
+
+func (p *Plugin) TypedName() fwkplugin.TypedName {
+	return p.typedName
+}
+
+// AdmitRequest rejects requests that do not fit in the context window of their target model, or clamps their
+// requested completion length when the policy allows it. Requests are admitted when the context window or the prompt
+// length is unknown.
+func (p *Plugin) AdmitRequest(ctx context.Context, request *framework.InferenceRequest, endpoints []framework.Endpoint) error {
+	if request == nil || request.Body == nil {
+		return nil
+	}
+	window := p.contextWindow(request.TargetModel, endpoints)
+	if window <= 0 {
+		return nil
+	}
+	promptTokens := p.promptTokens(request.Body)
+	payload, _ := request.Body.Payload.(fwkrh.PayloadMap)
+	field, maxTokens := payload.MaxTokens()
+	if promptTokens+maxTokens <= window && promptTokens < window {
+		return nil
+	}
+
+	logger := log.FromContext(ctx).V(logutil.DEBUG)
+	if p.config.Policy == PolicyClamp && promptTokens < window && field != "" {
+		clamped := window - promptTokens
+		payload[field] = clamped
+		metrics.RecordContextWindowEnforcement(request.TargetModel, actionClamped)
+		logger.Info("Clamped requested completion length to the context window", "contextWindow", window,
+			"promptTokens", promptTokens, "field", field, "requested", maxTokens, "clamped", clamped)
+		return nil
+	}
+
+	metrics.RecordContextWindowEnforcement(request.TargetModel, actionRejected)
+	logger.Info("Rejecting request exceeding the context window", "contextWindow", window,
+		"promptTokens", promptTokens, "maxTokens", maxTokens)
+	return errcommon.Error{
+		Code: errcommon.BadRequest,
+		Msg: fmt.Sprintf("This model's maximum context length is %d tokens. However, you requested %d tokens "+
+			"(%d in the prompt, %d in the completion). Please reduce the length of the prompt or completion.",
+			window, promptTokens+maxTokens, promptTokens, maxTokens),
+	}
+}
+
+// contextWindow returns the context window of the given model, or 0 if it is unknown.
+func (p *Plugin) contextWindow(model string, endpoints []framework.Endpoint) int {
+	if window, ok := p.config.Models[model]; ok {
+		return window
+	}
+	window := 0
+	for _, endpoint := range endpoints {
+		metadata := endpoint.GetMetadata()
+		if metadata == nil {
+			continue
+		}
+		value, err := strconv.Atoi(metadata.Labels[p.config.ContextWindowLabel])
+		if err != nil || value <= 0 {
+			continue
+		}
+		if window == 0 || value < window {
+			window = value
+		}
+	}
+	return window
+}
+
+// promptTokens returns the exact prompt length when known, or an estimate from the prompt text otherwise.
+func (p *Plugin) promptTokens(body *fwkrh.InferenceRequestBody) int {
+	if body.TokenizedPrompt != nil {
+		return len(body.TokenizedPrompt.TokenIDs)
+	}
+	if hint := body.InputTokenCountHint(); hint >= 0 {
+		return hint
+	}
+	return int(math.Round(float64(len(body.PromptText())) / p.config.CharactersPerToken))
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package contextwindow
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkrh "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+const model = "my-model"
+
+func makeEndpoint(name string, labels map[string]string) framework.Endpoint {
+	return framework.NewEndpoint(
+		&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: name}, Labels: labels},
+		&fwkdl.Metrics{},
+		nil,
+	)
+}
+
+// makeRequest returns a completions request with a prompt of promptTokens tokens (at 4 characters per token).
+func makeRequest(promptTokens int, payload fwkrh.PayloadMap) *framework.InferenceRequest {
+	return &framework.InferenceRequest{
+		TargetModel: model,
+		Body: &fwkrh.InferenceRequestBody{
+			Completions: &fwkrh.CompletionsRequest{Prompt: fwkrh.Prompt{Raw: strings.Repeat("abcd", promptTokens)}},
+			Payload:     payload,
+		},
+	}
+}
+
+func TestAdmitRequest(t *testing.T) {
+	labeled := []framework.Endpoint{
+		makeEndpoint("pod-a", map[string]string{DefaultContextWindowLabel: "2000"}),
+		makeEndpoint("pod-b", map[string]string{DefaultContextWindowLabel: "1000"}),
+		makeEndpoint("pod-c", nil),
+	}
+
+	tests := []struct {
+		name        string
+		config      Config
+		request     *framework.InferenceRequest
+		endpoints   []framework.Endpoint
+		wantErr     bool
+		wantPayload fwkrh.PayloadMap
+	}{
+		{
+			name:        "unknown context window",
+			config:      DefaultConfig,
+			request:     makeRequest(5000, fwkrh.PayloadMap{"max_tokens": float64(100)}),
+			endpoints:   []framework.Endpoint{makeEndpoint("pod-a", nil)},
+			wantPayload: fwkrh.PayloadMap{"max_tokens": float64(100)},
+		},
+		{
+			name:        "fits discovered context window",
+			config:      DefaultConfig,
+			request:     makeRequest(800, fwkrh.PayloadMap{"max_tokens": float64(200)}),
+			endpoints:   labeled,
+			wantPayload: fwkrh.PayloadMap{"max_tokens": float64(200)},
+		},
+		{
+			name:      "exceeds smallest discovered context window",
+			config:    DefaultConfig,
+			request:   makeRequest(800, fwkrh.PayloadMap{"max_tokens": float64(201)}),
+			endpoints: labeled,
+			wantErr:   true,
+		},
+		{
+			name:        "configured context window takes precedence",
+			config:      Config{Models: map[string]int{model: 4000}, ContextWindowLabel: DefaultContextWindowLabel, Policy: PolicyReject, CharactersPerToken: 4},
+			request:     makeRequest(800, fwkrh.PayloadMap{"max_tokens": float64(3000)}),
+			endpoints:   labeled,
+			wantPayload: fwkrh.PayloadMap{"max_tokens": float64(3000)},
+		},
+		{
+			name:      "prompt alone exceeds context window",
+			config:    DefaultConfig,
+			request:   makeRequest(1000, fwkrh.PayloadMap{}),
+			endpoints: labeled,
+			wantErr:   true,
+		},
+		{
+			name:        "clamp policy lowers max tokens",
+			config:      Config{ContextWindowLabel: DefaultContextWindowLabel, Policy: PolicyClamp, CharactersPerToken: 4},
+			request:     makeRequest(800, fwkrh.PayloadMap{"max_completion_tokens": float64(500), "max_tokens": float64(500)}),
+			endpoints:   labeled,
+			wantPayload: fwkrh.PayloadMap{"max_completion_tokens": 200, "max_tokens": float64(500)},
+		},
+		{
+			name:      "clamp policy rejects when the prompt alone exceeds context window",
+			config:    Config{ContextWindowLabel: DefaultContextWindowLabel, Policy: PolicyClamp, CharactersPerToken: 4},
+			request:   makeRequest(1200, fwkrh.PayloadMap{"max_tokens": float64(10)}),
+			endpoints: labeled,
+			wantErr:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			plugin := New(test.config)
+			err := plugin.AdmitRequest(context.Background(), test.request, test.endpoints)
+			if test.wantErr {
+				require.Error(t, err)
+				assert.Equal(t, errcommon.BadRequest, errcommon.CanonicalCode(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.wantPayload, test.request.Body.Payload)
+		})
+	}
+}
+
+func TestPromptTokens(t *testing.T) {
+	plugin := New(DefaultConfig)
+
+	tokenized := &fwkrh.InferenceRequestBody{
+		Completions:     &fwkrh.CompletionsRequest{Prompt: fwkrh.Prompt{Raw: "a long prompt"}},
+		TokenizedPrompt: &fwkrh.TokenizedPrompt{TokenIDs: []uint32{1, 2, 3}},
+	}
+	assert.Equal(t, 3, plugin.promptTokens(tokenized))
+
+	tokenIDs := &fwkrh.InferenceRequestBody{Completions: &fwkrh.CompletionsRequest{Prompt: fwkrh.Prompt{TokenIDs: []uint32{1, 2}}}}
+	assert.Equal(t, 2, plugin.promptTokens(tokenIDs))
+
+	chat := &fwkrh.InferenceRequestBody{ChatCompletions: &fwkrh.ChatCompletionsRequest{
+		Messages: []fwkrh.Message{{Role: "user", Content: fwkrh.Content{Raw: strings.Repeat("x", 39)}}},
+	}}
+	assert.Equal(t, 10, plugin.promptTokens(chat))
+}
+
+func TestFactory(t *testing.T) {
+	plugin, err := Factory("cw", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "cw", plugin.TypedName().Name)
+	assert.Equal(t, DefaultConfig, plugin.(*Plugin).config)
+
+	plugin, err = Factory("cw", json.RawMessage(`{"models": {"m": 8192}, "policy": "clamp"}`), nil)
+	require.NoError(t, err)
+	assert.Equal(t, 8192, plugin.(*Plugin).config.Models["m"])
+	assert.Equal(t, DefaultContextWindowLabel, plugin.(*Plugin).config.ContextWindowLabel)
+
+	_, err = Factory("cw", json.RawMessage(`{"policy": "truncate"}`), nil)
+	assert.Error(t, err)
+	_, err = Factory("cw", json.RawMessage(`{"models": {"m": 0}}`), nil)
+	assert.Error(t, err)
+}
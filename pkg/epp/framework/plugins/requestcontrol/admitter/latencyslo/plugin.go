@@ -90,6 +90,11 @@ func (p *LatencyAdmission) Consumes() map[string]any {
 	}
 }
 
+// Requires declares that this plugin cannot admit requests without latency prediction data.
+func (p *LatencyAdmission) Requires() []string {
+	return []string{attrlatency.LatencyPredictionInfoKey}
+}
+
 // AdmitRequest rejects sheddable requests if no endpoint can serve them within SLO.
 //
 // Reject only when ALL of:
@@ -0,0 +1,206 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package maxtokens provides an admitter shaping the requests that do not request a completion length, by giving them
+// one based on the current headroom of the pool and on the objective class of the request.
+package maxtokens
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	fwkrh "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+const (
+	PluginType = "max-tokens-shaper"
+
+	// DefaultField is the default request body field the completion length is injected in.
+	DefaultField = "max_tokens"
+)
+
+// ParameterSchema is the JSON schema of the parameters of the plugin.
+const ParameterSchema = `{
+  "type": "object",
+  "additionalProperties": false,
+  "required": ["classes"],
+  "properties": {
+    "classes": {
+      "type": "array",
+      "minItems": 1,
+      "items": {
+        "type": "object",
+        "additionalProperties": false,
+        "required": ["minTokens", "maxTokens"],
+        "properties": {
+          "minPriority": {"type": "integer"},
+          "minTokens": {"type": "integer", "minimum": 1},
+          "maxTokens": {"type": "integer", "minimum": 1}
+        }
+      }
+    },
+    "field": {"type": "string", "minLength": 1}
+  }
+}`
+
+var _ requestcontrol.Admitter = &Plugin{}
+
+// Class bounds the completion length injected in the requests of the objectives of at least a given priority.
+type Class struct {
+	// MinPriority is the lowest objective priority of the class.
+	MinPriority int `json:"minPriority"`
+	// MinTokens is the completion length injected when the pool has no headroom left.
+	MinTokens int `json:"minTokens"`
+	// MaxTokens is the completion length injected when the pool is idle.
+	MaxTokens int `json:"maxTokens"`
+}
+
+type Config struct {
+	// Classes bound the injected completion length by objective priority. A request belongs to the class of highest
+	// MinPriority not above its priority; requests belonging to no class are not shaped.
+	Classes []Class `json:"classes"`
+
+	// Field is the request body field the completion length is injected in. Default: max_tokens.
+	Field string `json:"field,omitempty"`
+}
+
+var DefaultConfig = Config{
+	Field: DefaultField,
+}
+
+func (c *Config) validate() error {
+	if len(c.Classes) == 0 {
+		return errors.New("at least one class is required")
+	}
+	priorities := map[int]bool{}
+	for _, class := range c.Classes {
+		if priorities[class.MinPriority] {
+			return fmt.Errorf("duplicate class of minPriority %d", class.MinPriority)
+		}
+		priorities[class.MinPriority] = true
+		if class.MinTokens <= 0 || class.MaxTokens < class.MinTokens {
+			return fmt.Errorf("class of minPriority %d must have 0 < minTokens <= maxTokens, got %d and %d",
+				class.MinPriority, class.MinTokens, class.MaxTokens)
+		}
+	}
+	if c.Field == "" {
+		return errors.New("field must not be empty")
+	}
+	return nil
+}
+
+// Plugin is an admitter injecting a completion length in the requests that do not request one. Without a requested
+// completion length, a request generates until the end of sequence or the context window, which bounds neither its
+// load on the model server nor its latency; under high load, the shaped requests are bounded more tightly to leave
+// room for the others.
+//
+// The headroom of the pool is the mean over the candidate endpoints of their free KV cache, counting the endpoints
+// with queued requests as full.
+type Plugin struct {
+	typedName fwkplugin.TypedName
+	config    Config
+}
+
+// Factory creates a new max tokens shaper from the given parameters.
+func Factory(name string, rawParameters json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	config := DefaultConfig
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal config for %s: %w", PluginType, err)
+		}
+	}
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config for %s: %w", PluginType, err)
+	}
+	return New(config).WithName(name), nil
+}
+
+// New creates a new max tokens shaper.
+func New(config Config) *Plugin {
+	return &Plugin{
+		typedName: fwkplugin.TypedName{Type: PluginType, Name: PluginType},
+		config:    config,
+	}
+}
+
+func (p *Plugin) WithName(name string) *Plugin {
+	p.typedName.Name = name
+	return p
+}
+
+func (p *Plugin) TypedName() fwkplugin.TypedName {
+	return p.typedName
+}
+
+// AdmitRequest injects a completion length in the requests that do not request one, and always admits them.
+func (p *Plugin) AdmitRequest(ctx context.Context, request *framework.InferenceRequest, endpoints []framework.Endpoint) error {
+	if request == nil || request.Body == nil || len(endpoints) == 0 {
+		return nil
+	}
+	payload, ok := request.Body.Payload.(fwkrh.PayloadMap)
+	if !ok {
+		return nil
+	}
+	if field, _ := payload.MaxTokens(); field != "" {
+		return nil
+	}
+	class, ok := p.class(request.Objectives.Priority)
+	if !ok {
+		return nil
+	}
+	headroom := poolHeadroom(endpoints)
+	maxTokens := class.MinTokens + int(math.Round(headroom*float64(class.MaxTokens-class.MinTokens)))
+	payload[p.config.Field] = maxTokens
+	metrics.RecordMaxTokensShaped(request.TargetModel)
+	log.FromContext(ctx).V(logutil.DEBUG).Info("Shaped the completion length of the request", "field", p.config.Field,
+		"maxTokens", maxTokens, "headroom", headroom, "priority", request.Objectives.Priority)
+	return nil
+}
+
+// class returns the class of the given priority, if any.
+func (p *Plugin) class(priority int) (Class, bool) {
+	var res Class
+	found := false
+	for _, class := range p.config.Classes {
+		if class.MinPriority <= priority && (!found || class.MinPriority > res.MinPriority) {
+			res, found = class, true
+		}
+	}
+	return res, found
+}
+
+// poolHeadroom returns the headroom of the given endpoints, between 0 (full) and 1 (idle).
+func poolHeadroom(endpoints []framework.Endpoint) float64 {
+	total := 0.0
+	for _, endpoint := range endpoints {
+		m := endpoint.GetMetrics()
+		if m == nil || m.WaitingQueueSize > 0 {
+			continue
+		}
+		total += min(max(1-m.KVCacheUsagePercent, 0), 1)
+	}
+	return total / float64(len(endpoints))
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maxtokens
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkrh "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+func makeEndpoint(name string, kvCacheUsage float64, waiting int) framework.Endpoint {
+	return framework.NewEndpoint(
+		&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: name}},
+		&fwkdl.Metrics{KVCacheUsagePercent: kvCacheUsage, WaitingQueueSize: waiting},
+		nil,
+	)
+}
+
+func makeRequest(priority int, payload fwkrh.PayloadMap) *framework.InferenceRequest {
+	return &framework.InferenceRequest{
+		TargetModel: "my-model",
+		Body:        &fwkrh.InferenceRequestBody{Payload: payload},
+		Objectives:  framework.RequestObjectives{Priority: priority},
+	}
+}
+
+var testConfig = Config{
+	Classes: []Class{
+		{MinPriority: -100, MinTokens: 100, MaxTokens: 1100},
+		{MinPriority: 0, MinTokens: 500, MaxTokens: 4500},
+	},
+	Field: DefaultField,
+}
+
+func TestFactory(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  string
+		wantErr bool
+	}{
+		{name: "valid", params: `{"classes": [{"minPriority": 0, "minTokens": 256, "maxTokens": 4096}]}`},
+		{name: "custom field", params: `{"classes": [{"minTokens": 256, "maxTokens": 256}], "field": "max_completion_tokens"}`},
+		{name: "no class", params: ``, wantErr: true},
+		{name: "min above max", params: `{"classes": [{"minTokens": 512, "maxTokens": 256}]}`, wantErr: true},
+		{name: "zero min", params: `{"classes": [{"minTokens": 0, "maxTokens": 256}]}`, wantErr: true},
+		{name: "duplicate class", params: `{"classes": [{"minTokens": 1, "maxTokens": 2}, {"minTokens": 1, "maxTokens": 2}]}`,
+			wantErr: true},
+		{name: "empty field", params: `{"classes": [{"minTokens": 1, "maxTokens": 2}], "field": ""}`, wantErr: true},
+		{name: "malformed json", params: `{`, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := Factory("shaper", json.RawMessage(test.params), nil)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "shaper", p.TypedName().Name)
+			assert.Equal(t, PluginType, p.TypedName().Type)
+		})
+	}
+}
+
+func TestAdmitRequest(t *testing.T) {
+	idle := []framework.Endpoint{makeEndpoint("pod-a", 0, 0), makeEndpoint("pod-b", 0, 0)}
+	// Half of the KV cache of pod-a is free, pod-b has queued requests: the headroom is 0.25.
+	loaded := []framework.Endpoint{makeEndpoint("pod-a", 0.5, 0), makeEndpoint("pod-b", 0.1, 3)}
+	full := []framework.Endpoint{makeEndpoint("pod-a", 1, 0), makeEndpoint("pod-b", 1.2, 0)}
+
+	tests := []struct {
+		name        string
+		request     *framework.InferenceRequest
+		endpoints   []framework.Endpoint
+		wantPayload fwkrh.PayloadMap
+	}{
+		{
+			name:        "idle pool",
+			request:     makeRequest(0, fwkrh.PayloadMap{"prompt": "hi"}),
+			endpoints:   idle,
+			wantPayload: fwkrh.PayloadMap{"prompt": "hi", "max_tokens": 4500},
+		},
+		{
+			name:        "loaded pool",
+			request:     makeRequest(0, fwkrh.PayloadMap{}),
+			endpoints:   loaded,
+			wantPayload: fwkrh.PayloadMap{"max_tokens": 1500},
+		},
+		{
+			name:        "full pool",
+			request:     makeRequest(0, fwkrh.PayloadMap{}),
+			endpoints:   full,
+			wantPayload: fwkrh.PayloadMap{"max_tokens": 500},
+		},
+		{
+			name:        "lower class",
+			request:     makeRequest(-1, fwkrh.PayloadMap{}),
+			endpoints:   loaded,
+			wantPayload: fwkrh.PayloadMap{"max_tokens": 350},
+		},
+		{
+			name:        "higher priority uses highest class below it",
+			request:     makeRequest(10, fwkrh.PayloadMap{}),
+			endpoints:   full,
+			wantPayload: fwkrh.PayloadMap{"max_tokens": 500},
+		},
+		{
+			name:        "no class",
+			request:     makeRequest(-1000, fwkrh.PayloadMap{}),
+			endpoints:   idle,
+			wantPayload: fwkrh.PayloadMap{},
+		},
+		{
+			name:        "requested max tokens",
+			request:     makeRequest(0, fwkrh.PayloadMap{"max_tokens": float64(20)}),
+			endpoints:   full,
+			wantPayload: fwkrh.PayloadMap{"max_tokens": float64(20)},
+		},
+		{
+			name:        "requested max completion tokens",
+			request:     makeRequest(0, fwkrh.PayloadMap{"max_completion_tokens": float64(20)}),
+			endpoints:   full,
+			wantPayload: fwkrh.PayloadMap{"max_completion_tokens": float64(20)},
+		},
+		{
+			name:        "no endpoints",
+			request:     makeRequest(0, fwkrh.PayloadMap{}),
+			wantPayload: fwkrh.PayloadMap{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := New(testConfig)
+			require.NoError(t, p.AdmitRequest(context.Background(), test.request, test.endpoints))
+			assert.Equal(t, test.wantPayload, test.request.Body.Payload)
+		})
+	}
+}
+
+func TestAdmitRequestWithoutPayloadMap(t *testing.T) {
+	p := New(testConfig)
+	request := &framework.InferenceRequest{Body: &fwkrh.InferenceRequestBody{}}
+	assert.NoError(t, p.AdmitRequest(context.Background(), request, []framework.Endpoint{makeEndpoint("pod-a", 0, 0)}))
+	assert.Nil(t, request.Body.Payload)
+	assert.NoError(t, p.AdmitRequest(context.Background(), nil, nil))
+}
@@ -0,0 +1,352 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ratelimit provides an admitter enforcing request and token rate limits per model and per tenant, with token
+// buckets.
+package ratelimit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	fwkrh "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+const (
+	PluginType = "token-bucket-rate-limiter"
+
+	// Each matches every value of a selector with a separate bucket per value.
+	Each = "*"
+
+	// ResourceRequests is the resource limited by the requests per second of a limit.
+	ResourceRequests = "requests"
+	// ResourceTokens is the resource limited by the tokens per minute of a limit.
+	ResourceTokens = "tokens"
+
+	// sweepInterval is the interval at which the full buckets, which are equivalent to new ones, are dropped.
+	sweepInterval = time.Minute
+)
+
+// ParameterSchema is the JSON schema of the parameters of the plugin.
+const ParameterSchema = `{
+  "type": "object",
+  "additionalProperties": false,
+  "required": ["limits"],
+  "properties": {
+    "limits": {
+      "type": "array",
+      "minItems": 1,
+      "items": {
+        "type": "object",
+        "additionalProperties": false,
+        "properties": {
+          "model": {"type": "string"},
+          "tenant": {"type": "string"},
+          "requestsPerSecond": {"type": "number", "minimum": 0},
+          "requestBurst": {"type": "integer", "minimum": 0},
+          "tokensPerMinute": {"type": "integer", "minimum": 0}
+        }
+      }
+    },
+    "charactersPerToken": {"type": "number", "exclusiveMinimum": true, "minimum": 0}
+  }
+}`
+
+var _ requestcontrol.Admitter = &Plugin{}
+
+// Limit is a request and token budget shared by the requests matching its model and tenant selectors. A selector
+// that is empty matches all the requests with a single shared bucket, Each matches all the requests with a separate
+// bucket per value, and any other value matches only the requests of that value.
+type Limit struct {
+	// Model selects the target models of the requests.
+	Model string `json:"model,omitempty"`
+	// Tenant selects the fairness IDs of the requests.
+	Tenant string `json:"tenant,omitempty"`
+	// RequestsPerSecond is the sustained request rate. Zero does not limit the requests.
+	RequestsPerSecond float64 `json:"requestsPerSecond,omitempty"`
+	// RequestBurst is the number of requests admitted at once above the sustained rate. Default: RequestsPerSecond
+	// rounded up.
+	RequestBurst int `json:"requestBurst,omitempty"`
+	// TokensPerMinute is the sustained token rate, which is also the burst. Zero does not limit the tokens.
+	TokensPerMinute int64 `json:"tokensPerMinute,omitempty"`
+}
+
+type Config struct {
+	// Limits are the budgets enforced; a request is admitted only if all the limits it matches admit it.
+	Limits []Limit `json:"limits"`
+
+	// CharactersPerToken is used to estimate the prompt length of requests that are not tokenized. Default: 4.
+	CharactersPerToken float64 `json:"charactersPerToken,omitempty"`
+}
+
+var DefaultConfig = Config{
+	CharactersPerToken: 4,
+}
+
+func (c *Config) validate() error {
+	if len(c.Limits) == 0 {
+		return errors.New("at least one limit is required")
+	}
+	for i, limit := range c.Limits {
+		if limit.RequestsPerSecond < 0 || limit.RequestBurst < 0 || limit.TokensPerMinute < 0 {
+			return fmt.Errorf("limit %d must not have negative rates or burst", i)
+		}
+		if limit.RequestsPerSecond == 0 && limit.TokensPerMinute == 0 {
+			return fmt.Errorf("limit %d must have requestsPerSecond or tokensPerMinute", i)
+		}
+	}
+	if c.CharactersPerToken <= 0 {
+		return errors.New("charactersPerToken must be > 0")
+	}
+	return nil
+}
+
+// Plugin is an admitter rejecting the requests exceeding the request and token budgets of their model and tenant with
+// a 429 and the rate limit headers. Enforcing the token budgets in EPP, rather than in a separate gateway filter, uses
+// the prompt lengths already known from the parsed and tokenized requests.
+//
+// A request is charged one request and its estimated token count, i.e. its prompt length plus its requested
+// completion length, from the buckets of all the limits it matches, and only if all of them admit it.
+type Plugin struct {
+	typedName fwkplugin.TypedName
+	config    Config
+	clock     clock.PassiveClock
+
+	mu        sync.Mutex
+	buckets   map[bucketKey]*bucket
+	lastSweep time.Time
+}
+
+// bucketKey identifies the bucket of a limit for a model and a tenant.
+type bucketKey struct {
+	limit    int
+	resource string
+	model    string
+	tenant   string
+}
+
+// Factory creates a new token bucket rate limiter from the given parameters.
+func Factory(name string, rawParameters json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	config := DefaultConfig
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal config for %s: %w", PluginType, err)
+		}
+	}
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config for %s: %w", PluginType, err)
+	}
+	return New(config).WithName(name), nil
+}
+
+// New creates a new token bucket rate limiter.
+func New(config Config) *Plugin {
+	return newWithClock(config, clock.RealClock{})
+}
+
+func newWithClock(config Config, clk clock.PassiveClock) *Plugin {
+	return &Plugin{
+		typedName: fwkplugin.TypedName{Type: PluginType, Name: PluginType},
+		config:    config,
+		clock:     clk,
+		buckets:   map[bucketKey]*bucket{},
+		lastSweep: clk.Now(),
+	}
+}
+
+func (p *Plugin) WithName(name string) *Plugin {
+	p.typedName.Name = name
+	return p
+}
+
+func (p *Plugin) TypedName() fwkplugin.TypedName {
+	return p.typedName
+}
+
+// AdmitRequest admits the request if all the limits it matches have the budget for it, and charges them; otherwise it
+// rejects the request with the status of the limit that replenishes last.
+func (p *Plugin) AdmitRequest(ctx context.Context, request *framework.InferenceRequest, _ []framework.Endpoint) error {
+	if request == nil {
+		return nil
+	}
+	tokens := p.tokens(request.Body)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := p.clock.Now()
+	p.sweep(now)
+
+	type charge struct {
+		bucket *bucket
+		cost   float64
+	}
+	var charges []charge
+	var denial *errcommon.RateLimit
+	for i, limit := range p.config.Limits {
+		model, ok := selectValue(limit.Model, request.TargetModel)
+		if !ok {
+			continue
+		}
+		tenant, ok := selectValue(limit.Tenant, request.FairnessID)
+		if !ok {
+			continue
+		}
+		if limit.RequestsPerSecond > 0 {
+			burst := limit.RequestBurst
+			if burst == 0 {
+				burst = int(math.Ceil(limit.RequestsPerSecond))
+			}
+			b := p.bucket(bucketKey{limit: i, resource: ResourceRequests, model: model, tenant: tenant}, float64(burst),
+				limit.RequestsPerSecond, now)
+			charges = append(charges, charge{bucket: b, cost: 1})
+			if wait := b.wait(1); wait > 0 && (denial == nil || wait > denial.Reset) {
+				denial = b.status(ResourceRequests, wait)
+			}
+		}
+		if limit.TokensPerMinute > 0 {
+			b := p.bucket(bucketKey{limit: i, resource: ResourceTokens, model: model, tenant: tenant},
+				float64(limit.TokensPerMinute), float64(limit.TokensPerMinute)/60, now)
+			// A request larger than the whole budget is admitted once the bucket is full, rather than never. The cost is
+			// never negative, so that a request cannot refill the bucket.
+			cost := min(max(float64(tokens), 0), b.capacity)
+			charges = append(charges, charge{bucket: b, cost: cost})
+			if wait := b.wait(cost); wait > 0 && (denial == nil || wait > denial.Reset) {
+				denial = b.status(ResourceTokens, wait)
+			}
+		}
+	}
+
+	if denial != nil {
+		metrics.RecordRateLimitedRequest(request.TargetModel, denial.Resource)
+		log.FromContext(ctx).V(logutil.DEBUG).Info("Rate limited the request", "resource", denial.Resource,
+			"fairnessID", request.FairnessID, "tokens", tokens, "reset", denial.Reset)
+		return errcommon.Error{
+			Code:       errcommon.ResourceExhausted,
+			Msg:        fmt.Sprintf("rate limit of %s exceeded, retry in %s", denial.Resource, denial.Reset.Round(time.Millisecond)),
+			RetryAfter: denial.Reset,
+			RateLimit:  denial,
+		}
+	}
+	for _, c := range charges {
+		c.bucket.level -= c.cost
+	}
+	return nil
+}
+
+// bucket returns the bucket of the given key refilled up to now, creating a full one if there is none.
+func (p *Plugin) bucket(key bucketKey, capacity, rate float64, now time.Time) *bucket {
+	b, ok := p.buckets[key]
+	if !ok {
+		b = &bucket{capacity: capacity, rate: rate, level: capacity, updated: now}
+		p.buckets[key] = b
+	}
+	b.refill(now)
+	return b
+}
+
+// sweep drops the buckets that are full, which are equivalent to new ones, so that the buckets of the values of Each
+// selectors that are no longer seen do not accumulate. It must be called with the lock held.
+func (p *Plugin) sweep(now time.Time) {
+	if now.Sub(p.lastSweep) < sweepInterval {
+		return
+	}
+	p.lastSweep = now
+	for key, b := range p.buckets {
+		if b.refill(now); b.level >= b.capacity {
+			delete(p.buckets, key)
+		}
+	}
+}
+
+// tokens returns the estimated token count of the request: its prompt length plus its requested completion length.
+func (p *Plugin) tokens(body *fwkrh.InferenceRequestBody) int {
+	if body == nil {
+		return 0
+	}
+	var tokens int
+	switch {
+	case body.TokenizedPrompt != nil:
+		tokens = len(body.TokenizedPrompt.TokenIDs)
+	case body.InputTokenCountHint() >= 0:
+		tokens = body.InputTokenCountHint()
+	default:
+		tokens = int(math.Round(float64(len(body.PromptText())) / p.config.CharactersPerToken))
+	}
+	if payload, ok := body.Payload.(fwkrh.PayloadMap); ok {
+		_, maxTokens := payload.MaxTokens()
+		tokens += maxTokens
+	}
+	return tokens
+}
+
+// selectValue returns the bucket value of the given request value for the given selector, and whether the selector
+// matches it.
+func selectValue(selector, value string) (string, bool) {
+	switch selector {
+	case "":
+		return "", true
+	case Each:
+		return value, true
+	default:
+		return value, selector == value
+	}
+}
+
+// bucket is a token bucket, replenished continuously at its rate up to its capacity.
+type bucket struct {
+	capacity float64
+	rate     float64
+	level    float64
+	updated  time.Time
+}
+
+func (b *bucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.updated).Seconds(); elapsed > 0 {
+		b.level = min(b.capacity, b.level+elapsed*b.rate)
+	}
+	b.updated = now
+}
+
+// wait returns the duration until the bucket holds the given cost, or 0 if it already does.
+func (b *bucket) wait(cost float64) time.Duration {
+	if b.level >= cost {
+		return 0
+	}
+	return time.Duration(math.Ceil((cost - b.level) / b.rate * float64(time.Second)))
+}
+
+func (b *bucket) status(resource string, reset time.Duration) *errcommon.RateLimit {
+	return &errcommon.RateLimit{
+		Resource:  resource,
+		Limit:     int64(b.capacity),
+		Remaining: int64(max(b.level, 0)),
+		Reset:     reset,
+	}
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	fwkrh "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+func makeRequest(model, tenant string, maxTokens int) *framework.InferenceRequest {
+	return &framework.InferenceRequest{
+		TargetModel: model,
+		FairnessID:  tenant,
+		Body: &fwkrh.InferenceRequestBody{
+			TokenizedPrompt: &fwkrh.TokenizedPrompt{TokenIDs: make([]uint32, 100)},
+			Payload:         fwkrh.PayloadMap{"max_tokens": float64(maxTokens)},
+		},
+	}
+}
+
+func TestFactory(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  string
+		wantErr bool
+	}{
+		{name: "requests", params: `{"limits": [{"model": "*", "requestsPerSecond": 10}]}`},
+		{name: "tokens", params: `{"limits": [{"tenant": "*", "tokensPerMinute": 100000}], "charactersPerToken": 3}`},
+		{name: "no limit", params: ``, wantErr: true},
+		{name: "no rate", params: `{"limits": [{"model": "*"}]}`, wantErr: true},
+		{name: "negative rate", params: `{"limits": [{"requestsPerSecond": -1}]}`, wantErr: true},
+		{name: "zero characters per token", params: `{"limits": [{"requestsPerSecond": 1}], "charactersPerToken": 0}`,
+			wantErr: true},
+		{name: "malformed json", params: `{`, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := Factory("limiter", json.RawMessage(test.params), nil)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "limiter", p.TypedName().Name)
+			assert.Equal(t, PluginType, p.TypedName().Type)
+		})
+	}
+}
+
+// rateLimit returns the rate limit status of the given admission error, failing if it is not a rate limit rejection.
+func rateLimit(t *testing.T, err error) *errcommon.RateLimit {
+	t.Helper()
+	var e errcommon.Error
+	require.True(t, errors.As(err, &e), "expected a rate limit rejection, got %v", err)
+	assert.Equal(t, errcommon.ResourceExhausted, e.Code)
+	require.NotNil(t, e.RateLimit)
+	assert.Equal(t, e.RateLimit.Reset, e.RetryAfter)
+	return e.RateLimit
+}
+
+func TestRequestRateLimit(t *testing.T) {
+	clk := clocktesting.NewFakePassiveClock(time.Now())
+	p := newWithClock(Config{Limits: []Limit{{RequestsPerSecond: 2, RequestBurst: 3}}, CharactersPerToken: 4}, clk)
+	ctx := context.Background()
+
+	for range 3 {
+		require.NoError(t, p.AdmitRequest(ctx, makeRequest("m", "", 0), nil))
+	}
+	limit := rateLimit(t, p.AdmitRequest(ctx, makeRequest("m", "", 0), nil))
+	assert.Equal(t, &errcommon.RateLimit{Resource: ResourceRequests, Limit: 3, Remaining: 0, Reset: 500 * time.Millisecond},
+		limit)
+
+	clk.SetTime(clk.Now().Add(500 * time.Millisecond))
+	require.NoError(t, p.AdmitRequest(ctx, makeRequest("m", "", 0), nil))
+	assert.Error(t, p.AdmitRequest(ctx, makeRequest("m", "", 0), nil))
+}
+
+func TestTokenRateLimit(t *testing.T) {
+	clk := clocktesting.NewFakePassiveClock(time.Now())
+	p := newWithClock(Config{Limits: []Limit{{TokensPerMinute: 600}}, CharactersPerToken: 4}, clk)
+	ctx := context.Background()
+
+	// Each request costs its 100 prompt tokens plus its requested completion length.
+	require.NoError(t, p.AdmitRequest(ctx, makeRequest("m", "", 300), nil))
+	limit := rateLimit(t, p.AdmitRequest(ctx, makeRequest("m", "", 300), nil))
+	assert.Equal(t, &errcommon.RateLimit{Resource: ResourceTokens, Limit: 600, Remaining: 200, Reset: 20 * time.Second},
+		limit)
+	require.NoError(t, p.AdmitRequest(ctx, makeRequest("m", "", 100), nil))
+
+	// A request larger than the whole budget is admitted once the bucket is full.
+	clk.SetTime(clk.Now().Add(time.Minute))
+	require.NoError(t, p.AdmitRequest(ctx, makeRequest("m", "", 10000), nil))
+}
+
+func TestInvalidMaxTokens(t *testing.T) {
+	clk := clocktesting.NewFakePassiveClock(time.Now())
+	p := newWithClock(Config{Limits: []Limit{{TokensPerMinute: 600}}, CharactersPerToken: 4}, clk)
+	ctx := context.Background()
+
+	// A negative completion length costs nothing rather than refilling the bucket: only the prompt tokens are charged.
+	for range 6 {
+		require.NoError(t, p.AdmitRequest(ctx, makeRequest("m", "", -1000000), nil))
+	}
+	limit := rateLimit(t, p.AdmitRequest(ctx, makeRequest("m", "", -1000000), nil))
+	assert.Equal(t, int64(0), limit.Remaining)
+
+	// An out of range completion length costs the whole budget.
+	clk.SetTime(clk.Now().Add(time.Minute))
+	request := makeRequest("m", "", 0)
+	request.Body.Payload = fwkrh.PayloadMap{"max_tokens": 1e300}
+	require.NoError(t, p.AdmitRequest(ctx, request, nil))
+	limit = rateLimit(t, p.AdmitRequest(ctx, makeRequest("m", "", 0), nil))
+	assert.Equal(t, int64(0), limit.Remaining)
+}
+
+func TestSelectors(t *testing.T) {
+	clk := clocktesting.NewFakePassiveClock(time.Now())
+	p := newWithClock(Config{
+		Limits: []Limit{
+			{Model: "big", Tenant: Each, RequestsPerSecond: 1},
+			{Tenant: "batch", RequestsPerSecond: 1, RequestBurst: 2},
+		},
+		CharactersPerToken: 4,
+	}, clk)
+	ctx := context.Background()
+
+	// Each tenant of the big model has its own bucket.
+	require.NoError(t, p.AdmitRequest(ctx, makeRequest("big", "a", 0), nil))
+	require.NoError(t, p.AdmitRequest(ctx, makeRequest("big", "b", 0), nil))
+	assert.Error(t, p.AdmitRequest(ctx, makeRequest("big", "a", 0), nil))
+	// Other models are not limited, except for the batch tenant.
+	for range 3 {
+		require.NoError(t, p.AdmitRequest(ctx, makeRequest("small", "a", 0), nil))
+	}
+	require.NoError(t, p.AdmitRequest(ctx, makeRequest("small", "batch", 0), nil))
+	// A rejected request is not charged to the limits that admitted it.
+	assert.Error(t, p.AdmitRequest(ctx, makeRequest("big", "a", 0), nil))
+	require.NoError(t, p.AdmitRequest(ctx, makeRequest("big", "batch", 0), nil))
+	assert.Error(t, p.AdmitRequest(ctx, makeRequest("small", "batch", 0), nil))
+}
+
+func TestSweepDropsFullBuckets(t *testing.T) {
+	clk := clocktesting.NewFakePassiveClock(time.Now())
+	p := newWithClock(Config{Limits: []Limit{{Tenant: Each, RequestsPerSecond: 1}}, CharactersPerToken: 4}, clk)
+	ctx := context.Background()
+
+	for _, tenant := range []string{"a", "b", "c"} {
+		require.NoError(t, p.AdmitRequest(ctx, makeRequest("m", tenant, 0), nil))
+	}
+	assert.Len(t, p.buckets, 3)
+
+	clk.SetTime(clk.Now().Add(sweepInterval))
+	require.NoError(t, p.AdmitRequest(ctx, makeRequest("m", "d", 0), nil))
+	assert.Len(t, p.buckets, 1)
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package backendabort provides a plugin cancelling dispatched requests that will not be delivered, e.g. abandoned by
+// their clients or evicted by flow control, on model servers exposing an abort API.
+package backendabort
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+const (
+	PluginType = "backend-abort"
+
+	// AbortOnAbandoned aborts the requests whose client disconnected before the response completed.
+	AbortOnAbandoned = "abandoned"
+	// AbortOnEvicted aborts the requests evicted by flow control after they were dispatched.
+	AbortOnEvicted = "evicted"
+)
+
+var _ requestcontrol.ResponseBodyProcessor = &Plugin{}
+
+type Config struct {
+	// Path is the path of the abort API on the model server. Default: /abort_request.
+	Path string `json:"path,omitempty"`
+	// RequestIDField is the field of the abort request body holding the ID of the request to abort. The model server
+	// must identify requests by the x-request-id header. Default: rid.
+	RequestIDField string `json:"requestIdField,omitempty"`
+	// TimeoutMs is the timeout of abort calls, in milliseconds. Default: 1000.
+	TimeoutMs int `json:"timeoutMs,omitempty"`
+	// AbortOn are the events on which dispatched requests are aborted: abandoned and/or evicted. Default: [abandoned].
+	AbortOn []string `json:"abortOn,omitempty"`
+}
+
+var DefaultConfig = Config{
+	Path:           "/abort_request",
+	RequestIDField: "rid",
+	TimeoutMs:      1000,
+	AbortOn:        []string{AbortOnAbandoned},
+}
+
+func (c *Config) validate() error {
+	if !strings.HasPrefix(c.Path, "/") {
+		return fmt.Errorf("path must start with '/', got %q", c.Path)
+	}
+	if c.RequestIDField == "" {
+		return errors.New("requestIdField must not be empty")
+	}
+	if c.TimeoutMs <= 0 {
+		return errors.New("timeoutMs must be > 0")
+	}
+	if len(c.AbortOn) == 0 {
+		return errors.New("abortOn must not be empty")
+	}
+	for _, event := range c.AbortOn {
+		if event != AbortOnAbandoned && event != AbortOnEvicted {
+			return fmt.Errorf("abortOn must contain %s or %s, got %q", AbortOnAbandoned, AbortOnEvicted, event)
+		}
+	}
+	return nil
+}
+
+// Plugin cancels dispatched requests that will not be delivered on the model server that serves them, through its
+// abort API.
+//
+// When a client disconnects, or when flow control evicts a request after it was dispatched, the proxy resets the
+// upstream connection, but model servers may keep generating tokens until they notice it, or until the request
+// completes for servers that do not watch their connections. The abort call frees the slot of the request on the model
+// server immediately, instead of once the wasted generation finishes.
+type Plugin struct {
+	typedName fwkplugin.TypedName
+	config    Config
+	abortOn   map[string]bool
+	client    *http.Client
+	wg        sync.WaitGroup // Used for waiting on async abort calls in tests.
+}
+
+// Factory creates a new backend abort plugin from the given parameters.
+func Factory(name string, rawParameters json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	config := DefaultConfig
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal config for %s: %w", PluginType, err)
+		}
+	}
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config for %s: %w", PluginType, err)
+	}
+	return New(config).WithName(name), nil
+}
+
+// New creates a new backend abort plugin.
+func New(config Config) *Plugin {
+	abortOn := make(map[string]bool, len(config.AbortOn))
+	for _, event := range config.AbortOn {
+		abortOn[event] = true
+	}
+	return &Plugin{
+		typedName: fwkplugin.TypedName{Type: PluginType, Name: PluginType},
+		config:    config,
+		abortOn:   abortOn,
+		client:    &http.Client{Timeout: time.Duration(config.TimeoutMs) * time.Millisecond},
+	}
+}
+
+func (p *Plugin) WithName(name string) *Plugin {
+	p.typedName.Name = name
+	return p
+}
+
+func (p *Plugin) TypedName() fwkplugin.TypedName {
+	return p.typedName
+}
+
+// ResponseBody calls the abort API of the target endpoint when the response did not complete because of one of the
+// configured events. The call is made asynchronously and its failures are only logged.
+func (p *Plugin) ResponseBody(ctx context.Context, _ *framework.InferenceRequest, response *requestcontrol.Response,
+	targetEndpoint *fwkdl.EndpointMetadata) {
+	if response == nil || !response.EndOfStream || response.RequestId == "" || targetEndpoint == nil {
+		return
+	}
+	var cause string
+	switch {
+	case response.Abandoned && p.abortOn[AbortOnAbandoned]:
+		cause = metrics.BackendAbortAbandoned
+	case response.Evicted && p.abortOn[AbortOnEvicted]:
+		cause = metrics.BackendAbortEvicted
+	default:
+		return
+	}
+	logger := log.FromContext(ctx).WithValues("endpoint", targetEndpoint.NamespacedName, "cause", cause)
+	p.wg.Go(func() {
+		err := p.abort(targetEndpoint, response.RequestId)
+		metrics.RecordBackendAbort(cause, err == nil)
+		if err != nil {
+			logger.V(logutil.DEFAULT).Error(err, "Failed to abort request on the model server")
+			return
+		}
+		logger.V(logutil.VERBOSE).Info("Aborted request on the model server")
+	})
+}
+
+func (p *Plugin) abort(endpoint *fwkdl.EndpointMetadata, requestID string) error {
+	body, err := json.Marshal(map[string]string{p.config.RequestIDField: requestID})
+	if err != nil {
+		return err
+	}
+	url := "http://" + net.JoinHostPort(endpoint.GetIPAddress(), endpoint.GetPort()) + p.config.Path
+	resp, err := p.client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backendabort
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+)
+
+func TestResponseBody(t *testing.T) {
+	var mu sync.Mutex
+	var aborted []map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/abort_request", r.URL.Path)
+		body := map[string]string{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		mu.Lock()
+		aborted = append(aborted, body)
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	host, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+	endpoint := &fwkdl.EndpointMetadata{Address: host, Port: port}
+
+	tests := []struct {
+		name        string
+		abortOn     []string
+		response    *requestcontrol.Response
+		wantAborted []map[string]string
+	}{
+		{
+			name:     "intermediate chunk",
+			response: &requestcontrol.Response{RequestId: "req-1"},
+		},
+		{
+			name:     "completed response",
+			response: &requestcontrol.Response{RequestId: "req-1", EndOfStream: true},
+		},
+		{
+			name:        "abandoned response",
+			response:    &requestcontrol.Response{RequestId: "req-1", EndOfStream: true, Abandoned: true},
+			wantAborted: []map[string]string{{"rid": "req-1"}},
+		},
+		{
+			name:     "evicted response, evictions not aborted",
+			response: &requestcontrol.Response{RequestId: "req-1", EndOfStream: true, Evicted: true},
+		},
+		{
+			name:        "evicted response",
+			abortOn:     []string{AbortOnAbandoned, AbortOnEvicted},
+			response:    &requestcontrol.Response{RequestId: "req-1", EndOfStream: true, Evicted: true},
+			wantAborted: []map[string]string{{"rid": "req-1"}},
+		},
+		{
+			name:     "abandoned response, abandonments not aborted",
+			abortOn:  []string{AbortOnEvicted},
+			response: &requestcontrol.Response{RequestId: "req-1", EndOfStream: true, Abandoned: true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mu.Lock()
+			aborted = nil
+			mu.Unlock()
+			config := DefaultConfig
+			if test.abortOn != nil {
+				config.AbortOn = test.abortOn
+			}
+			plugin := New(config)
+			plugin.ResponseBody(context.Background(), nil, test.response, endpoint)
+			plugin.wg.Wait()
+			mu.Lock()
+			defer mu.Unlock()
+			assert.Equal(t, test.wantAborted, aborted)
+		})
+	}
+}
+
+func TestFactory(t *testing.T) {
+	plugin, err := Factory("abort", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "abort", plugin.TypedName().Name)
+	assert.Equal(t, DefaultConfig, plugin.(*Plugin).config)
+
+	plugin, err = Factory("abort", json.RawMessage(`{"path": "/v1/abort", "requestIdField": "request_id"}`), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "/v1/abort", plugin.(*Plugin).config.Path)
+	assert.Equal(t, 1000, plugin.(*Plugin).config.TimeoutMs)
+
+	plugin, err = Factory("abort", json.RawMessage(`{"abortOn": ["evicted"]}`), nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{AbortOnEvicted: true}, plugin.(*Plugin).abortOn)
+
+	_, err = Factory("abort", json.RawMessage(`{"path": "abort"}`), nil)
+	assert.Error(t, err)
+	_, err = Factory("abort", json.RawMessage(`{"timeoutMs": -1}`), nil)
+	assert.Error(t, err)
+	_, err = Factory("abort", json.RawMessage(`{"abortOn": ["completed"]}`), nil)
+	assert.Error(t, err)
+}
@@ -120,8 +120,26 @@ func getUserInputBytes(request *scheduling.InferenceRequest) ([]byte, error) {
 		return json.Marshal(combined)
 
 	case request.Body.ChatCompletions != nil:
+		// Chat templates render the tool definitions ahead of the messages, so they are part of the prefix.
+		if len(request.Body.ChatCompletions.Tools) > 0 {
+			return json.Marshal([]map[string]interface{}{
+				{"tools": request.Body.ChatCompletions.Tools},
+				{"messages": request.Body.ChatCompletions.Messages},
+			})
+		}
 		return json.Marshal(request.Body.ChatCompletions.Messages)
 
+	case request.Body.AnthropicMessages != nil:
+		var combined []map[string]interface{}
+		if !request.Body.AnthropicMessages.System.IsZero() {
+			combined = append(combined, map[string]interface{}{"system": request.Body.AnthropicMessages.System})
+		}
+		if len(request.Body.AnthropicMessages.Tools) > 0 {
+			combined = append(combined, map[string]interface{}{"tools": request.Body.AnthropicMessages.Tools})
+		}
+		combined = append(combined, map[string]interface{}{"messages": request.Body.AnthropicMessages.Messages})
+		return json.Marshal(combined)
+
 	case request.Body.Completions != nil:
 		return []byte(request.Body.Completions.Prompt.PlainText()), nil
 
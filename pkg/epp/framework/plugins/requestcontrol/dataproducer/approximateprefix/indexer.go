@@ -33,6 +33,7 @@ type indexer struct {
 	mu             sync.RWMutex
 	hashToPods     map[blockHash]podSet                         // the lookup data structure to find pods that have the blockHash cached
 	podToLRU       map[ServerID]*lru.Cache[blockHash, struct{}] // key is pod namespacedName, value is an LRU cache
+	podToLRUSize   map[ServerID]int                             // capacity of each pod's LRU cache
 	defaultLRUSize int
 }
 
@@ -41,6 +42,7 @@ func newIndexer(ctx context.Context, defaultLRUSize int) indexerInterface {
 	i := &indexer{
 		hashToPods:     make(map[blockHash]podSet),
 		podToLRU:       make(map[ServerID]*lru.Cache[blockHash, struct{}]),
+		podToLRUSize:   make(map[ServerID]int),
 		defaultLRUSize: defaultLRUSize,
 	}
 
@@ -63,12 +65,19 @@ func (i *indexer) Add(hashes []blockHash, pod server) {
 		// We ignore the error since the only possible error is if size <= 0.
 		newLRU, _ := lru.NewWithEvict(lruSize, i.makeEvictionFn(pod.ServerID))
 		i.podToLRU[pod.ServerID] = newLRU
+		i.podToLRUSize[pod.ServerID] = lruSize
 		lruForPod = newLRU
 	}
 
 	// Add to LRU (may evict)
+	evicted := 0
 	for _, hash := range hashes {
-		lruForPod.Add(hash, struct{}{})
+		if lruForPod.Add(hash, struct{}{}) {
+			evicted++
+		}
+	}
+	if evicted > 0 {
+		metrics.RecordPrefixCacheEvictions(pod.ServerID.Namespace, pod.ServerID.Name, evicted)
 	}
 
 	// Update hashToPods
@@ -139,6 +148,7 @@ func (i *indexer) reportOnce(ctx context.Context) {
 	for pod, lruCache := range i.podToLRU {
 		size := lruCache.Len()
 		totalEntries += size
+		metrics.RecordPrefixCacheEndpointEntries(pod.Namespace, pod.Name, size)
 		if size > maxPodEntries {
 			maxPodEntries = size
 			maxPodName = pod
@@ -179,6 +189,8 @@ func (i *indexer) RemovePod(pod ServerID) {
 	}
 
 	delete(i.podToLRU, pod)
+	delete(i.podToLRUSize, pod)
+	metrics.DeletePrefixCacheEndpoint(pod.Namespace, pod.Name)
 }
 
 // Pods returns the list of all pods currently tracked in the indexer.
@@ -192,3 +204,33 @@ func (i *indexer) Pods() []ServerID {
 	}
 	return pods
 }
+
+// Snapshot returns the cached hashes of all pods, from the least to the most recently used.
+func (i *indexer) Snapshot() []podSnapshot {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	snapshot := make([]podSnapshot, 0, len(i.podToLRU))
+	for pod, lruCache := range i.podToLRU {
+		snapshot = append(snapshot, podSnapshot{
+			Pod:     pod.String(),
+			LRUSize: i.podToLRUSize[pod],
+			Hashes:  lruCache.Keys(),
+		})
+	}
+	return snapshot
+}
+
+// Stats returns a summary of the indexer state.
+func (i *indexer) Stats() indexStats {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	stats := indexStats{Hashes: len(i.hashToPods), Pods: make(map[string]podStats, len(i.podToLRU))}
+	for pod, lruCache := range i.podToLRU {
+		entries := lruCache.Len()
+		stats.Entries += entries
+		stats.Pods[pod.String()] = podStats{Entries: entries, LRUSize: i.podToLRUSize[pod]}
+	}
+	return stats
+}
@@ -52,6 +52,24 @@ func TestIndexer_AddAndGet(t *testing.T) {
 	assert.Empty(t, servers, "Cache should not contain non-existent hash")
 }
 
+func TestIndexer_Stats(t *testing.T) {
+	pod1 := server{ServerID: ServerID{Namespace: "default", Name: "server1"}, NumOfGPUBlocks: 3}
+	pod2 := server{ServerID: ServerID{Namespace: "default", Name: "server2"}, NumOfGPUBlocks: 4}
+	i := newIndexer(context.Background(), 3).(*indexer)
+
+	i.Add([]blockHash{1, 2, 3}, pod1)
+	i.Add([]blockHash{2, 3}, pod2)
+
+	assert.Equal(t, indexStats{
+		Hashes:  3,
+		Entries: 5,
+		Pods: map[string]podStats{
+			"default/server1": {Entries: 3, LRUSize: 3},
+			"default/server2": {Entries: 2, LRUSize: 4},
+		},
+	}, i.Stats())
+}
+
 func TestIndexer_RemovePodAndEviction(t *testing.T) {
 	const indexerSize = 10
 
@@ -17,15 +17,18 @@ limitations under the License.
 package approximateprefix
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
 	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
@@ -40,6 +43,9 @@ const (
 var (
 	_ requestcontrol.DataProducer = &prepareData{}
 	_ requestcontrol.PreRequest   = &prepareData{}
+	_ plugin.PeerStatePlugin      = &prepareData{}
+	_ plugin.DebugStatePlugin     = &prepareData{}
+	_ fwkdl.EndpointSubscriber    = &prepareData{}
 )
 
 // prepareData is a plugin that prepares data consumed by approx prefix cache aware scheduling.
@@ -49,6 +55,9 @@ type prepareData struct {
 	indexerInst indexerInterface
 	pluginState *plugin.PluginState
 	wg          sync.WaitGroup // Used for waiting on async cache updates in tests.
+	// warmUp records and primes the popular prefixes, nil if the priming is disabled.
+	warmUp *warmUp
+	prime  func()
 }
 
 // TypedName returns the type and name of the plugin.
@@ -81,6 +90,9 @@ func newPrepareData(ctx context.Context, config config, handle plugin.Handle) (*
 	if config.MaxPrefixTokensToMatch < 0 {
 		return nil, fmt.Errorf("invalid configuration: MaxPrefixTokensToMatch must be >= 0 (current value: %d)", config.MaxPrefixTokensToMatch)
 	}
+	if config.WarmUp.PopularPrefixes < 0 {
+		return nil, fmt.Errorf("invalid configuration: WarmUp.PopularPrefixes must be >= 0 (current value: %d)", config.WarmUp.PopularPrefixes)
+	}
 	indexer := newIndexer(ctx, config.LRUCapacityPerServer)
 
 	p := &prepareData{
@@ -92,6 +104,10 @@ func newPrepareData(ctx context.Context, config config, handle plugin.Handle) (*
 		indexerInst: indexer,
 		pluginState: plugin.NewPluginState(ctx),
 	}
+	if config.WarmUp.PopularPrefixes > 0 {
+		p.warmUp = newWarmUp(config.WarmUp)
+		p.prime = func() { p.primePopularPrefixes(ctx) }
+	}
 
 	if handle != nil {
 		go p.CleanUpInactivePods(ctx, handle)
@@ -126,6 +142,71 @@ func (p *prepareData) CleanUpInactivePods(ctx context.Context, handle plugin.Han
 	}
 }
 
+// OnEndpointChange removes the prefix hashes of the deleted endpoints, so that they are not attributed to a pod
+// recreated with the same name before the periodic cleanup of the inactive pods.
+func (p *prepareData) OnEndpointChange(ctx context.Context, change fwkdl.EndpointChange) {
+	if p.warmUp != nil {
+		p.warmUp.onEndpointChange(change, p.prime)
+	}
+	if change.Type != fwkdl.EndpointDeleted {
+		return
+	}
+	pod := ServerID(change.Endpoint.GetMetadata().NamespacedName)
+	p.indexerInst.RemovePod(pod)
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("Removed deleted pod", "pod", pod)
+}
+
+// exportedState is the exported state of the plugin when the popular prefixes are recorded. Otherwise, only the pods
+// are exported, as a list.
+type exportedState struct {
+	Pods            []podSnapshot   `json:"pods"`
+	PopularPrefixes []popularPrefix `json:"popularPrefixes"`
+}
+
+// ExportState returns the prefix hashes cached on each pod, so that a new EPP replica starts with the same prefix
+// cache affinity as this one, and the popular prefixes if they are recorded.
+func (p *prepareData) ExportState() (json.RawMessage, error) {
+	if p.warmUp == nil {
+		return json.Marshal(p.indexerInst.Snapshot())
+	}
+	return json.Marshal(exportedState{Pods: p.indexerInst.Snapshot(), PopularPrefixes: p.warmUp.recorder.top()})
+}
+
+// DebugState returns a summary of the indexer state.
+func (p *prepareData) DebugState() any {
+	return p.indexerInst.Stats()
+}
+
+// ImportState adds the prefix hashes exported by another replica to the indexer.
+func (p *prepareData) ImportState(ctx context.Context, state json.RawMessage) error {
+	imported := exportedState{}
+	if trimmed := bytes.TrimSpace(state); len(trimmed) > 0 && trimmed[0] == '{' {
+		if err := json.Unmarshal(state, &imported); err != nil {
+			return err
+		}
+	} else if err := json.Unmarshal(state, &imported.Pods); err != nil {
+		return err
+	}
+	snapshot := imported.Pods
+	for _, pod := range snapshot {
+		namespace, name, found := strings.Cut(pod.Pod, "/")
+		if !found {
+			return fmt.Errorf("invalid pod %q, expected <namespace>/<name>", pod.Pod)
+		}
+		p.indexerInst.Add(pod.Hashes, server{
+			ServerID:       ServerID{Namespace: namespace, Name: name},
+			NumOfGPUBlocks: pod.LRUSize,
+		})
+	}
+	if p.warmUp != nil {
+		p.warmUp.recorder.merge(imported.PopularPrefixes)
+		p.warmUp.reschedule(p.prime)
+	}
+	log.FromContext(ctx).V(logutil.DEFAULT).Info("Imported prefix cache indexer state", "pods", len(snapshot),
+		"popularPrefixes", len(imported.PopularPrefixes))
+	return nil
+}
+
 // indexer returns the shared indexer.
 func (p *prepareData) indexer() indexerInterface {
 	return p.indexerInst
@@ -194,6 +275,7 @@ func (p *prepareData) PreRequest(ctx context.Context, request *framework.Inferen
 		for _, s := range servers {
 			p.indexerInst.Add(state.PrefixHashes, s)
 		}
+		p.recordPopularPrefix(request, state.PrefixHashes, primaryProfileResult.TargetEndpoints)
 	})
 
 	// Record metrics.
@@ -202,6 +284,21 @@ func (p *prepareData) PreRequest(ctx context.Context, request *framework.Inferen
 	blockSize := p.GetBlockSize(primaryProfileResult.TargetEndpoints)
 	avgChars := averageCharactersPerToken
 	metrics.RecordPrefixCacheMatch(matchLen*blockSize*avgChars, total*blockSize*avgChars)
+	endpoint := targetEndpoint.GetMetadata().NamespacedName
+	metrics.RecordPrefixCacheBlocksMatch(request.TargetModel, endpoint.Namespace, endpoint.Name, matchLen, total)
+}
+
+// recordPopularPrefix records the prefix of the request when the popular prefixes are recorded. The requests with a
+// cache salt are not recorded, as their prefixes are not shared.
+func (p *prepareData) recordPopularPrefix(request *framework.InferenceRequest, hashes []blockHash, endpoints []framework.Endpoint) {
+	if p.warmUp == nil || request.Body == nil || request.Body.CacheSalt() != "" {
+		return
+	}
+	var prompt *warmUpPrompt
+	if p.config.WarmUp.Generate {
+		prompt = newWarmUpPrompt(request)
+	}
+	p.warmUp.recorder.record(request.TargetModel, hashes, prompt, p.GetBlockSize(endpoints)*averageCharactersPerToken)
 }
 
 func (p *prepareData) makeserver(targetEndpoint framework.Endpoint) server {
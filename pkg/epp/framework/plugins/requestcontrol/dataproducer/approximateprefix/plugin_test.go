@@ -127,6 +127,52 @@ func TestPreRequest(t *testing.T) {
 	}
 }
 
+func TestExportImportState(t *testing.T) {
+	config := config{
+		BlockSizeTokens:        1,
+		MaxPrefixBlocksToMatch: defaultMaxPrefixBlocks,
+		LRUCapacityPerServer:   defaultLRUCapacityPerServer,
+	}
+	source, _ := newPrepareData(context.Background(), config, nil)
+	pod := server{ServerID: ServerID{Namespace: "default", Name: "pod1"}, NumOfGPUBlocks: 2}
+	source.indexer().Add([]blockHash{1, 2, 3}, pod)
+
+	state, err := source.ExportState()
+	assert.NoError(t, err)
+
+	target, _ := newPrepareData(context.Background(), config, nil)
+	assert.NoError(t, target.ImportState(context.Background(), state))
+	assert.Empty(t, target.indexer().Get(1), "evicted hashes should not be handed off")
+	assert.Contains(t, target.indexer().Get(2), pod.ServerID)
+	assert.Contains(t, target.indexer().Get(3), pod.ServerID)
+
+	// The LRU size and recency order are preserved: adding a hash evicts the least recently used one.
+	target.indexer().Add([]blockHash{4}, server{ServerID: pod.ServerID})
+	assert.Empty(t, target.indexer().Get(2))
+	assert.Contains(t, target.indexer().Get(3), pod.ServerID)
+
+	assert.Error(t, target.ImportState(context.Background(), []byte(`[{"pod": "pod1", "hashes": [1]}]`)))
+}
+
+func TestOnEndpointChange(t *testing.T) {
+	config := config{
+		BlockSizeTokens:        1,
+		MaxPrefixBlocksToMatch: defaultMaxPrefixBlocks,
+		LRUCapacityPerServer:   defaultLRUCapacityPerServer,
+	}
+	p, _ := newPrepareData(context.Background(), config, nil)
+	pod := server{ServerID: ServerID{Namespace: "default", Name: "pod1"}, NumOfGPUBlocks: 2}
+	p.indexer().Add([]blockHash{1, 2}, pod)
+	endpoint := fwkdl.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName(pod.ServerID)}, nil)
+
+	p.OnEndpointChange(context.Background(), fwkdl.EndpointChange{Type: fwkdl.EndpointUpdated, Endpoint: endpoint})
+	assert.Contains(t, p.indexer().Get(1), pod.ServerID, "updated endpoints keep their prefix hashes")
+
+	p.OnEndpointChange(context.Background(), fwkdl.EndpointChange{Type: fwkdl.EndpointDeleted, Endpoint: endpoint})
+	assert.Empty(t, p.indexer().Get(1), "deleted endpoints lose their prefix hashes")
+	assert.Empty(t, p.indexer().Pods())
+}
+
 func TestPrepareDataValidation(t *testing.T) {
 	validConfigs := []config{{
 		AutoTune:        false,
@@ -547,6 +593,35 @@ func TestMaxPrefixTokensToMatch(t *testing.T) {
 	assert.Equal(t, 3, len(state2.PrefixHashes), "should fall back to MaxPrefixBlocksToMatch when MaxPrefixTokensToMatch is 0")
 }
 
+func TestHashPromptChatCompletionsTools(t *testing.T) {
+	chatRequest := func(tools []any, messages ...fwkrh.Message) *fwksched.InferenceRequest {
+		return &fwksched.InferenceRequest{
+			TargetModel: "test-model",
+			Body: &fwkrh.InferenceRequestBody{
+				ChatCompletions: &fwkrh.ChatCompletionsRequest{Tools: tools, Messages: messages},
+			},
+		}
+	}
+	user := fwkrh.Message{Role: "user", Content: fwkrh.Content{Raw: "What is the weather in Paris?"}}
+	weather := []any{map[string]any{"type": "function", "function": map[string]any{"name": "get_weather"}}}
+	search := []any{map[string]any{"type": "function", "function": map[string]any{"name": "search"}}}
+
+	withWeather := hashPrompt(context.Background(), chatRequest(weather, user), 1, 1000)
+	assert.NotEmpty(t, withWeather)
+	withSearch := hashPrompt(context.Background(), chatRequest(search, user), 1, 1000)
+	assert.NotEqual(t, withWeather[len(withWeather)-1], withSearch[len(withSearch)-1],
+		"the tool definitions should be part of the prefix")
+
+	// Successive tool calling turns extend the prefix of the conversation.
+	call := fwkrh.Message{Role: "assistant", ToolCalls: []any{map[string]any{"id": "call_1"}}}
+	result := fwkrh.Message{Role: "tool", ToolCallID: "call_1", Content: fwkrh.Content{Raw: "sunny"}}
+	turn := hashPrompt(context.Background(), chatRequest(weather, user, call, result), 1, 1000)
+	otherTurn := hashPrompt(context.Background(), chatRequest(weather, user,
+		fwkrh.Message{Role: "assistant", ToolCalls: []any{map[string]any{"id": "call_2"}}}, result), 1, 1000)
+	assert.Greater(t, len(turn), len(withWeather))
+	assert.NotEqual(t, turn, otherTurn, "the tool calls should be part of the prefix")
+}
+
 // BenchmarkPrefixPluginStress is a stress test using prompts of increasing length.
 func BenchmarkPrefixPluginStress(b *testing.B) {
 	config := config{
@@ -30,6 +30,34 @@ type indexerInterface interface {
 	Add(hashes []blockHash, server server)
 	RemovePod(server ServerID)
 	Pods() []ServerID
+	Snapshot() []podSnapshot
+	Stats() indexStats
+}
+
+// podSnapshot is the exported indexer state of a single pod, handed off to other EPP replicas.
+type podSnapshot struct {
+	// Pod is the pod namespacedName, in the form "<namespace>/<name>".
+	Pod string `json:"pod"`
+	// LRUSize is the capacity of the pod's LRU cache.
+	LRUSize int `json:"lruSize"`
+	// Hashes are the cached hashes, from the least to the most recently used.
+	Hashes []blockHash `json:"hashes"`
+}
+
+// indexStats summarizes the indexer state on the debug state API.
+type indexStats struct {
+	// Hashes is the number of distinct cached hashes.
+	Hashes int `json:"hashes"`
+	// Entries is the number of cached hashes summed over the pods.
+	Entries int `json:"entries"`
+	// Pods are the number of cached hashes and the LRU capacity of each pod, by pod namespacedName.
+	Pods map[string]podStats `json:"pods"`
+}
+
+// podStats summarizes the indexer state of a single pod.
+type podStats struct {
+	Entries int `json:"entries"`
+	LRUSize int `json:"lruSize"`
 }
 
 // podSet holds a set of pods that may have a specific prefix hash.
@@ -129,6 +157,8 @@ type config struct {
 	MaxPrefixTokensToMatch int `json:"maxPrefixTokensToMatch"`
 	// Max capacity size of the LRU indexer in number of entries per server (pod).
 	LRUCapacityPerServer int `json:"lruCapacityPerServer"`
+	// WarmUp configures the priming of the prefix cache with the popular prompt prefixes after restarts.
+	WarmUp warmUpConfig `json:"warmUp"`
 }
 
 // defaultConfig provides sensible defaults for the prefix cache plugins.
@@ -0,0 +1,357 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approximateprefix
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+const (
+	// warmUpSettleDelay is the delay after the last endpoint addition before the popular prefixes are primed, so that
+	// they are spread over all the endpoints of a pool coming up.
+	warmUpSettleDelay = 5 * time.Second
+	// warmUpGenerationTimeout bounds each warm-up generation.
+	warmUpGenerationTimeout = 30 * time.Second
+	// candidatePrefixesPerPopularPrefix is the number of prefixes tracked for each popular prefix kept, so that the
+	// popular prefixes are found among the prefixes that are not popular yet.
+	candidatePrefixesPerPopularPrefix = 4
+)
+
+// warmUpConfig configures the priming of the prefix cache with the popular prompt prefixes.
+type warmUpConfig struct {
+	// PopularPrefixes is the number of most popular prompt prefixes recorded, persisted with the state of the plugin,
+	// and primed on the endpoints when the EPP or the pool restarts. 0 disables the priming.
+	PopularPrefixes int `json:"popularPrefixes"`
+	// Generate sends a one-token warm-up generation of each primed prefix to its endpoint, so that the model server
+	// caches it, in addition to inserting the prefix in the index. The text of the prefixes of the completions and
+	// chat completions requests is then recorded and persisted, not only their hashes.
+	Generate bool `json:"generate"`
+}
+
+// popularPrefix is a prompt prefix shared by the requests whose first block hashes the same.
+type popularPrefix struct {
+	// Model is the target model of the requests.
+	Model string `json:"model"`
+	// Hashes are the block hashes of the prefix, the longest common prefix of those of the requests.
+	Hashes []blockHash `json:"hashes"`
+	// Count is the number of requests recorded with the prefix.
+	Count int64 `json:"count"`
+	// Prompt is the text of the prefix, only recorded with warm-up generations.
+	Prompt *warmUpPrompt `json:"prompt,omitempty"`
+}
+
+// warmUpPrompt is the text of a prefix, in the format of the requests it was recorded from.
+type warmUpPrompt struct {
+	// Completion is the prefix of the prompt of completions requests.
+	Completion string `json:"completion,omitempty"`
+	// Messages are the leading messages of chat completions requests, within the prefix.
+	Messages []json.RawMessage `json:"messages,omitempty"`
+	// MessageEnds are the offsets in the hashed input at which each of Messages ends.
+	MessageEnds []int `json:"messageEnds,omitempty"`
+}
+
+// newWarmUpPrompt returns the text of the request to record for warm-up generations, nil if its format is not
+// supported.
+func newWarmUpPrompt(request *framework.InferenceRequest) *warmUpPrompt {
+	switch {
+	case request.Body.Completions != nil:
+		return &warmUpPrompt{Completion: request.Body.Completions.Prompt.PlainText()}
+	case request.Body.ChatCompletions != nil:
+		prompt := &warmUpPrompt{}
+		end := 1 // opening bracket of the marshalled messages
+		for _, message := range request.Body.ChatCompletions.Messages {
+			raw, err := json.Marshal(message)
+			if err != nil {
+				return nil
+			}
+			end += len(raw)
+			prompt.Messages = append(prompt.Messages, raw)
+			prompt.MessageEnds = append(prompt.MessageEnds, end)
+			end++ // separator
+		}
+		return prompt
+	default:
+		return nil
+	}
+}
+
+// truncate returns the part of the prompt within the first length bytes of the hashed input, nil if empty.
+func (w *warmUpPrompt) truncate(length int) *warmUpPrompt {
+	if w == nil {
+		return nil
+	}
+	if w.Messages == nil {
+		text := w.Completion[:min(length, len(w.Completion))]
+		for len(text) > 0 && !utf8.ValidString(text) {
+			text = text[:len(text)-1]
+		}
+		if text == "" {
+			return nil
+		}
+		return &warmUpPrompt{Completion: text}
+	}
+	n := 0
+	for n < len(w.MessageEnds) && w.MessageEnds[n] <= length {
+		n++
+	}
+	if n == 0 {
+		return nil
+	}
+	return &warmUpPrompt{Messages: w.Messages[:n], MessageEnds: w.MessageEnds[:n]}
+}
+
+// prefixRecorder keeps the most popular prompt prefixes with the space-saving algorithm: when full, the least popular
+// prefix is replaced by the new one, which inherits its count.
+type prefixRecorder struct {
+	mu       sync.Mutex
+	size     int
+	capacity int
+	prefixes map[blockHash]*popularPrefix // keyed by the first block hash
+}
+
+func newPrefixRecorder(size int) *prefixRecorder {
+	return &prefixRecorder{
+		size:     size,
+		capacity: size * candidatePrefixesPerPopularPrefix,
+		prefixes: make(map[blockHash]*popularPrefix),
+	}
+}
+
+// record records a request with the given block hashes. blockChars is the size of the blocks in bytes of the hashed
+// input, used to truncate the prompt to the common prefix.
+func (r *prefixRecorder) record(model string, hashes []blockHash, prompt *warmUpPrompt, blockChars int) {
+	if len(hashes) == 0 {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if prefix, ok := r.prefixes[hashes[0]]; ok {
+		prefix.Count++
+		common := 0
+		for common < min(len(prefix.Hashes), len(hashes)) && prefix.Hashes[common] == hashes[common] {
+			common++
+		}
+		prefix.Hashes = prefix.Hashes[:common]
+		prefix.Prompt = prefix.Prompt.truncate(common * blockChars)
+		return
+	}
+	r.add(&popularPrefix{
+		Model:  model,
+		Hashes: slices.Clone(hashes),
+		Count:  1,
+		Prompt: prompt.truncate(len(hashes) * blockChars),
+	})
+}
+
+// add adds a new prefix, replacing the least popular one when full. Must be called with mu held.
+func (r *prefixRecorder) add(prefix *popularPrefix) {
+	if len(r.prefixes) >= r.capacity {
+		var leastKey blockHash
+		var least *popularPrefix
+		for key, candidate := range r.prefixes {
+			if least == nil || candidate.Count < least.Count {
+				leastKey, least = key, candidate
+			}
+		}
+		delete(r.prefixes, leastKey)
+		prefix.Count += least.Count
+	}
+	r.prefixes[prefix.Hashes[0]] = prefix
+}
+
+// top returns the most popular prefixes, from the most to the least popular.
+func (r *prefixRecorder) top() []popularPrefix {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	prefixes := make([]popularPrefix, 0, len(r.prefixes))
+	for _, prefix := range r.prefixes {
+		prefixes = append(prefixes, *prefix)
+	}
+	sort.Slice(prefixes, func(i, j int) bool {
+		if prefixes[i].Count != prefixes[j].Count {
+			return prefixes[i].Count > prefixes[j].Count
+		}
+		return prefixes[i].Hashes[0] < prefixes[j].Hashes[0]
+	})
+	return prefixes[:min(len(prefixes), r.size)]
+}
+
+// merge adds the prefixes recorded by another EPP replica or before a restart, unless already recorded.
+func (r *prefixRecorder) merge(prefixes []popularPrefix) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, prefix := range prefixes {
+		if len(prefix.Hashes) == 0 {
+			continue
+		}
+		if _, ok := r.prefixes[prefix.Hashes[0]]; ok {
+			continue
+		}
+		r.add(&prefix)
+	}
+}
+
+// warmUp primes the popular prefixes on the endpoints when endpoints are added, once the pool settles.
+type warmUp struct {
+	config   warmUpConfig
+	recorder *prefixRecorder
+	client   *http.Client
+
+	mu        sync.Mutex
+	endpoints map[ServerID]*fwkdl.EndpointMetadata
+	timer     *time.Timer
+}
+
+func newWarmUp(config warmUpConfig) *warmUp {
+	return &warmUp{
+		config:    config,
+		recorder:  newPrefixRecorder(config.PopularPrefixes),
+		client:    &http.Client{Timeout: warmUpGenerationTimeout},
+		endpoints: make(map[ServerID]*fwkdl.EndpointMetadata),
+	}
+}
+
+// onEndpointChange tracks the endpoints of the pool, and schedules a priming when one is added.
+func (w *warmUp) onEndpointChange(change fwkdl.EndpointChange, prime func()) {
+	metadata := change.Endpoint.GetMetadata()
+	if metadata == nil {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	switch change.Type {
+	case fwkdl.EndpointDeleted:
+		delete(w.endpoints, ServerID(metadata.NamespacedName))
+		return
+	case fwkdl.EndpointAdded:
+		w.endpoints[ServerID(metadata.NamespacedName)] = metadata
+		w.schedule(prime)
+	default:
+		w.endpoints[ServerID(metadata.NamespacedName)] = metadata
+	}
+}
+
+// schedule (re)schedules a priming after the settle delay. Must be called with mu held.
+func (w *warmUp) schedule(prime func()) {
+	if w.timer != nil {
+		w.timer.Stop()
+	}
+	w.timer = time.AfterFunc(warmUpSettleDelay, prime)
+}
+
+// reschedule schedules a priming if endpoints are known, e.g. once popular prefixes are imported.
+func (w *warmUp) reschedule(prime func()) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if len(w.endpoints) > 0 {
+		w.schedule(prime)
+	}
+}
+
+// sortedEndpoints returns the current endpoints, sorted by name.
+func (w *warmUp) sortedEndpoints() []*fwkdl.EndpointMetadata {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	endpoints := make([]*fwkdl.EndpointMetadata, 0, len(w.endpoints))
+	for _, endpoint := range w.endpoints {
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Slice(endpoints, func(i, j int) bool {
+		return endpoints[i].NamespacedName.String() < endpoints[j].NamespacedName.String()
+	})
+	return endpoints
+}
+
+// primePopularPrefixes inserts the popular prefixes cached on none of the endpoints in the index, spreading them over
+// the endpoints, and sends their warm-up generations if enabled.
+func (p *prepareData) primePopularPrefixes(ctx context.Context) {
+	logger := log.FromContext(ctx).WithName("prefix-warm-up")
+	endpoints := p.warmUp.sortedEndpoints()
+	if len(endpoints) == 0 {
+		return
+	}
+	primed := 0
+	for _, prefix := range p.warmUp.recorder.top() {
+		if len(p.indexerInst.Get(prefix.Hashes[len(prefix.Hashes)-1])) > 0 {
+			continue // still cached on an endpoint
+		}
+		endpoint := endpoints[primed%len(endpoints)]
+		primed++
+		p.indexerInst.Add(prefix.Hashes, server{
+			ServerID:       ServerID(endpoint.NamespacedName),
+			NumOfGPUBlocks: p.config.LRUCapacityPerServer,
+		})
+		if p.config.WarmUp.Generate && prefix.Prompt != nil {
+			if err := p.warmUp.generate(ctx, endpoint, prefix); err != nil {
+				logger.V(logutil.DEBUG).Info("Warm-up generation failed", "endpoint", endpoint.NamespacedName,
+					"error", err.Error())
+			}
+		}
+	}
+	if primed > 0 {
+		logger.V(logutil.DEFAULT).Info("Primed popular prefixes", "prefixes", primed, "endpoints", len(endpoints),
+			"generate", p.config.WarmUp.Generate)
+	}
+}
+
+// generate sends a one-token generation of the prefix to the endpoint.
+func (w *warmUp) generate(ctx context.Context, endpoint *fwkdl.EndpointMetadata, prefix popularPrefix) error {
+	path := "/v1/completions"
+	body := map[string]any{"model": prefix.Model, "max_tokens": 1}
+	if prefix.Prompt.Messages != nil {
+		path = "/v1/chat/completions"
+		body["messages"] = prefix.Prompt.Messages
+	} else {
+		body["prompt"] = prefix.Prompt.Completion
+	}
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	url := "http://" + net.JoinHostPort(endpoint.GetIPAddress(), endpoint.GetPort()) + path
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	response, err := w.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d", response.StatusCode)
+	}
+	return nil
+}
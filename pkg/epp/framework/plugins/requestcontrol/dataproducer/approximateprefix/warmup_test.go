@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approximateprefix
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkrh "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+func TestPrefixRecorder(t *testing.T) {
+	recorder := newPrefixRecorder(2)
+	recorder.record("m", []blockHash{1, 2, 3}, &warmUpPrompt{Completion: "aaaabbbbcccc"}, 4)
+	recorder.record("m", []blockHash{1, 2, 4}, &warmUpPrompt{Completion: "aaaabbbbdddd"}, 4)
+	recorder.record("m", []blockHash{5, 6}, nil, 4)
+	recorder.record("m", []blockHash{7}, nil, 4)
+	recorder.record("m", []blockHash{7}, nil, 4)
+	recorder.record("m", []blockHash{7}, nil, 4)
+
+	top := recorder.top()
+	require.Len(t, top, 2)
+	assert.Equal(t, []blockHash{7}, top[0].Hashes)
+	assert.Equal(t, int64(3), top[0].Count)
+	assert.Equal(t, []blockHash{1, 2}, top[1].Hashes, "the prefix should be the common prefix of the requests")
+	assert.Equal(t, int64(2), top[1].Count)
+	assert.Equal(t, &warmUpPrompt{Completion: "aaaabbbb"}, top[1].Prompt)
+}
+
+func TestPrefixRecorder_ReplacesLeastPopular(t *testing.T) {
+	recorder := newPrefixRecorder(1) // tracks 4 candidates
+	for hash := range 4 {
+		for range hash + 1 {
+			recorder.record("m", []blockHash{blockHash(hash)}, nil, 4)
+		}
+	}
+	recorder.record("m", []blockHash{10}, nil, 4)
+
+	assert.Len(t, recorder.prefixes, 4)
+	assert.NotContains(t, recorder.prefixes, blockHash(0))
+	assert.Equal(t, int64(2), recorder.prefixes[10].Count, "the new prefix should inherit the count of the replaced one")
+}
+
+func TestWarmUpPromptTruncate(t *testing.T) {
+	request := &fwksched.InferenceRequest{Body: &fwkrh.InferenceRequestBody{
+		ChatCompletions: &fwkrh.ChatCompletionsRequest{Messages: []fwkrh.Message{
+			{Role: "system", Content: fwkrh.Content{Raw: "You are a helpful assistant."}},
+			{Role: "user", Content: fwkrh.Content{Raw: "Tell me a joke."}},
+		}},
+	}}
+	prompt := newWarmUpPrompt(request)
+	require.NotNil(t, prompt)
+	input, err := getUserInputBytes(request)
+	require.NoError(t, err)
+	assert.Equal(t, len(input)-1, prompt.MessageEnds[1], "message ends should be offsets in the hashed input")
+
+	assert.Len(t, prompt.truncate(len(input)).Messages, 2)
+	assert.Len(t, prompt.truncate(prompt.MessageEnds[1]-1).Messages, 1)
+	assert.Nil(t, prompt.truncate(prompt.MessageEnds[0]-1))
+
+	completion := &warmUpPrompt{Completion: "héllo"}
+	assert.Equal(t, "h", completion.truncate(2).Completion, "truncation should not split a character")
+	assert.Nil(t, completion.truncate(0))
+}
+
+func TestPrimePopularPrefixes(t *testing.T) {
+	var mu sync.Mutex
+	prompts := []string{}
+	modelServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]any{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, "/v1/completions", r.URL.Path)
+		assert.Equal(t, 1.0, body["max_tokens"])
+		prompts = append(prompts, body["prompt"].(string))
+	}))
+	defer modelServer.Close()
+	host, port, err := net.SplitHostPort(modelServer.Listener.Addr().String())
+	require.NoError(t, err)
+
+	config := config{
+		BlockSizeTokens:        1,
+		MaxPrefixBlocksToMatch: defaultMaxPrefixBlocks,
+		LRUCapacityPerServer:   defaultLRUCapacityPerServer,
+		WarmUp:                 warmUpConfig{PopularPrefixes: 2, Generate: true},
+	}
+	ctx := context.Background()
+	source, err := newPrepareData(ctx, config, nil)
+	require.NoError(t, err)
+	endpoint := fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "old"}},
+		fwkdl.NewMetrics(), fwkdl.NewAttributes())
+	for _, prompt := range []string{"aaaabbbb", "aaaacccc", "ddddeeee"} {
+		request := &fwksched.InferenceRequest{
+			RequestId:   uuid.NewString(),
+			TargetModel: "test-model",
+			Body:        &fwkrh.InferenceRequestBody{Completions: &fwkrh.CompletionsRequest{Prompt: fwkrh.Prompt{Raw: prompt}}},
+		}
+		require.NoError(t, source.PrepareRequestData(ctx, request, []fwksched.Endpoint{endpoint}))
+		source.PreRequest(ctx, request, &fwksched.SchedulingResult{
+			PrimaryProfileName: "default",
+			ProfileResults:     map[string]*fwksched.ProfileRunResult{"default": {TargetEndpoints: []fwksched.Endpoint{endpoint}}},
+		})
+		source.wg.Wait()
+	}
+	state, err := source.ExportState()
+	require.NoError(t, err)
+
+	// The pool restarted: the endpoint of the recorded prefixes is gone.
+	target, err := newPrepareData(ctx, config, nil)
+	require.NoError(t, err)
+	require.NoError(t, target.ImportState(ctx, state))
+	target.OnEndpointChange(ctx, fwkdl.EndpointChange{Type: fwkdl.EndpointDeleted, Endpoint: fwkdl.NewEndpoint(
+		endpoint.GetMetadata(), nil)})
+	for _, name := range []string{"pod1", "pod2"} {
+		target.OnEndpointChange(ctx, fwkdl.EndpointChange{Type: fwkdl.EndpointAdded, Endpoint: fwkdl.NewEndpoint(
+			&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: name}, Address: host, Port: port}, nil)})
+	}
+	target.primePopularPrefixes(ctx)
+
+	top := target.warmUp.recorder.top()
+	require.Len(t, top, 2)
+	assert.Equal(t, podSet{{Name: "pod1"}: {}}, target.indexerInst.Get(top[0].Hashes[len(top[0].Hashes)-1]))
+	assert.Equal(t, podSet{{Name: "pod2"}: {}}, target.indexerInst.Get(top[1].Hashes[len(top[1].Hashes)-1]))
+	mu.Lock()
+	assert.Equal(t, []string{"aaaa", "ddddeeee"}, prompts)
+	mu.Unlock()
+
+	// The prefixes still cached are not primed again.
+	target.primePopularPrefixes(ctx)
+	mu.Lock()
+	assert.Len(t, prompts, 2)
+	mu.Unlock()
+}
+
+func TestImportLegacyState(t *testing.T) {
+	config := defaultConfig
+	config.WarmUp.PopularPrefixes = 1
+	p, err := newPrepareData(context.Background(), config, nil)
+	require.NoError(t, err)
+	require.NoError(t, p.ImportState(context.Background(), json.RawMessage(`[{"pod":"default/pod1","lruSize":10,"hashes":[1]}]`)))
+	assert.Equal(t, podSet{{Namespace: "default", Name: "pod1"}: {}}, p.indexerInst.Get(1))
+}
@@ -0,0 +1,333 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fingerprint provides a data producer maintaining a performance fingerprint per endpoint (decode throughput
+// and TTFT by context length, and warm-up time), handed off between EPP replicas and persisted across restarts of the
+// EPP so that a restarted EPP does not have to relearn the speed of the endpoints from scratch.
+package fingerprint
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	attrfingerprint "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/attribute/fingerprint"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/timing"
+)
+
+const (
+	FingerprintProducerType = "performance-fingerprint-producer"
+
+	// unboundedBucket is the key of the bucket of the context lengths above the largest configured bound.
+	unboundedBucket = "+Inf"
+
+	// timeAnomalySource is the source the time anomalies of the fingerprints are recorded under.
+	timeAnomalySource = "fingerprint"
+	// maxTTFT is the longest plausible TTFT. Longer ones, e.g. measured across a VM pause, are clamped to it.
+	maxTTFT = 10 * time.Minute
+	// maxTokensPerSecond is the highest plausible decode throughput of a request. Higher ones, e.g. of responses
+	// whose decode was measured across a jump of the clock, are clamped to it.
+	maxTokensPerSecond = 10000
+)
+
+var (
+	_ requestcontrol.DataProducer = &Plugin{}
+	_ requestcontrol.PostResponse = &Plugin{}
+	_ fwkdl.EndpointExtractor     = &Plugin{}
+	_ fwkplugin.PeerStatePlugin   = &Plugin{}
+)
+
+type Config struct {
+	// MaxAgeSeconds is the duration after which the fingerprint of an endpoint that served no request is discarded,
+	// e.g. because the endpoint no longer exists. Default: 86400.
+	MaxAgeSeconds int `json:"maxAgeSeconds,omitempty"`
+
+	// Smoothing is the weight of a new measurement in the exponentially weighted moving averages of the throughput
+	// and TTFT, in (0, 1]. Default: 0.1.
+	Smoothing float64 `json:"smoothing,omitempty"`
+
+	// ContextLengthBuckets are the ascending upper bounds, in prompt tokens, of the context length buckets the
+	// throughput and TTFT are measured by. Default: [1024, 4096, 16384].
+	ContextLengthBuckets []int `json:"contextLengthBuckets,omitempty"`
+}
+
+var DefaultConfig = Config{
+	MaxAgeSeconds:        86400,
+	Smoothing:            0.1,
+	ContextLengthBuckets: []int{1024, 4096, 16384},
+}
+
+func (c *Config) validate() error {
+	if c.MaxAgeSeconds <= 0 {
+		return fmt.Errorf("maxAgeSeconds must be > 0, got %d", c.MaxAgeSeconds)
+	}
+	if c.Smoothing <= 0 || c.Smoothing > 1 {
+		return fmt.Errorf("smoothing must be in (0, 1], got %v", c.Smoothing)
+	}
+	if len(c.ContextLengthBuckets) == 0 {
+		return errors.New("contextLengthBuckets must not be empty")
+	}
+	for i, bound := range c.ContextLengthBuckets {
+		if bound <= 0 || (i > 0 && bound <= c.ContextLengthBuckets[i-1]) {
+			return fmt.Errorf("contextLengthBuckets must be positive and strictly ascending, got %v", c.ContextLengthBuckets)
+		}
+	}
+	return nil
+}
+
+// Plugin maintains a performance fingerprint per endpoint, learned from the completed responses: the decode
+// throughput and the TTFT by context length bucket, and the time the endpoint took to serve its first successful
+// request after it was added. The fingerprint matching the context length of the request is published as an endpoint
+// attribute for the scorers, e.g. the latency scorer, to use while no latency prediction is available.
+//
+// The fingerprints are keyed by endpoint name and exported as peer state, so that a new EPP replica, or an EPP
+// restarted during peak traffic with a persistence driver configured, starts with the speeds learned before.
+type Plugin struct {
+	typedName fwkplugin.TypedName
+	config    Config
+	now       func() time.Time
+
+	mu           sync.Mutex
+	fingerprints map[string]*endpointFingerprint
+	// added is the time each endpoint with an unknown warm-up time was added.
+	added map[string]time.Time
+}
+
+func Factory(name string, rawParameters json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	config := DefaultConfig
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal config for %s: %w", FingerprintProducerType, err)
+		}
+	}
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config for %s: %w", FingerprintProducerType, err)
+	}
+
+	return New(config).WithName(name), nil
+}
+
+// New creates a new performance fingerprint producer.
+func New(config Config) *Plugin {
+	return &Plugin{
+		typedName:    fwkplugin.TypedName{Type: FingerprintProducerType, Name: FingerprintProducerType},
+		config:       config,
+		now:          time.Now,
+		fingerprints: map[string]*endpointFingerprint{},
+		added:        map[string]time.Time{},
+	}
+}
+
+// WithName sets the name of the plugin.
+func (p *Plugin) WithName(name string) *Plugin {
+	p.typedName.Name = name
+	return p
+}
+
+func (p *Plugin) TypedName() fwkplugin.TypedName {
+	return p.typedName
+}
+
+func (p *Plugin) Produces() map[string]any {
+	return map[string]any{
+		attrfingerprint.PerformanceFingerprintKey: attrfingerprint.PerformanceFingerprint{},
+	}
+}
+
+func (p *Plugin) Consumes() map[string]any {
+	return nil
+}
+
+// ExpectedInputType defines the type expected by the extractor.
+func (p *Plugin) ExpectedInputType() reflect.Type {
+	return fwkdl.EndpointEventReflectType
+}
+
+// Extract transforms the raw data into structured attributes (not used for notifications).
+func (p *Plugin) Extract(context.Context, any, fwkdl.Endpoint) error {
+	return nil
+}
+
+// ExtractEndpoint records the time the endpoints without a known warm-up time are added, to measure it. The
+// fingerprint of a deleted endpoint is kept until it expires, as a pod recreated under the same name likely performs
+// the same.
+func (p *Plugin) ExtractEndpoint(_ context.Context, event fwkdl.EndpointEvent) error {
+	if event.Endpoint == nil {
+		return nil
+	}
+	id := event.Endpoint.GetMetadata().NamespacedName.String()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if event.Type == fwkdl.EventDelete {
+		delete(p.added, id)
+		return nil
+	}
+	if fp, ok := p.fingerprints[id]; ok && fp.WarmUpMs > 0 {
+		return nil
+	}
+	if _, ok := p.added[id]; !ok {
+		p.added[id] = p.now()
+	}
+	return nil
+}
+
+// PrepareRequestData publishes the fingerprint of each endpoint for the context length bucket of the request.
+func (p *Plugin) PrepareRequestData(_ context.Context, request *framework.InferenceRequest, endpoints []framework.Endpoint) error {
+	bucket := p.bucket(estimatePromptTokens(request))
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, endpoint := range endpoints {
+		fp, ok := p.fingerprints[endpoint.GetMetadata().NamespacedName.String()]
+		if !ok {
+			continue
+		}
+		attr := &attrfingerprint.PerformanceFingerprint{WarmUp: time.Duration(fp.WarmUpMs) * time.Millisecond}
+		if stats, ok := fp.Buckets[bucket]; ok {
+			attr.TokensPerSecond = stats.TokensPerSecond
+			attr.TTFT = time.Duration(stats.TTFTMs * float64(time.Millisecond))
+			attr.Samples = stats.Samples
+		}
+		endpoint.Put(attrfingerprint.PerformanceFingerprintKey, attr)
+	}
+	return nil
+}
+
+// PostResponse updates the fingerprint of the endpoint that served the request. The TTFT and the decode throughput
+// are only measured from successful streamed responses, for which the first chunk marks the first token.
+//
+// Negative and absurd TTFTs and throughputs are clamped and recorded as time anomalies, so that a single skewed
+// measurement does not poison the fingerprint of the endpoint.
+func (p *Plugin) PostResponse(ctx context.Context, request *framework.InferenceRequest, response *requestcontrol.CompletedResponse,
+	targetEndpoint *fwkdl.EndpointMetadata) {
+	if response == nil || targetEndpoint == nil || response.Failed || response.Abandoned {
+		return
+	}
+	id := targetEndpoint.NamespacedName.String()
+	promptTokens := response.Usage.PromptTokens
+	if promptTokens <= 0 {
+		promptTokens = estimatePromptTokens(request)
+	}
+	bucket := p.bucket(promptTokens)
+	streamed := request != nil && request.Body != nil && request.Body.Stream
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := p.now()
+	fp, ok := p.fingerprints[id]
+	if !ok {
+		fp = &endpointFingerprint{Buckets: map[string]*bucketStats{}}
+		p.fingerprints[id] = fp
+	}
+	fp.Updated = now
+	if added, ok := p.added[id]; ok {
+		fp.WarmUpMs = max(now.Sub(added).Milliseconds(), 1)
+		delete(p.added, id)
+	}
+	if !streamed {
+		return
+	}
+	ttft, anomaly := timing.ClampDuration(response.TTFT, maxTTFT)
+	p.recordAnomaly(ctx, id, "ttft", anomaly)
+	if ttft <= 0 {
+		return
+	}
+
+	stats, ok := fp.Buckets[bucket]
+	if !ok {
+		stats = &bucketStats{}
+		fp.Buckets[bucket] = stats
+	}
+	stats.TTFTMs = p.average(stats.TTFTMs, float64(ttft)/float64(time.Millisecond), stats.Samples)
+	// The first token is produced with the first chunk, the remaining ones during the decode.
+	if response.Usage.CompletionTokens > 1 {
+		decode := response.Latency - response.TTFT
+		if decode < 0 {
+			p.recordAnomaly(ctx, id, "decode", timing.Negative)
+		} else if decode > 0 {
+			tokensPerSecond, anomaly := timing.ClampRate(float64(response.Usage.CompletionTokens-1)/decode.Seconds(),
+				maxTokensPerSecond)
+			p.recordAnomaly(ctx, id, "tokensPerSecond", anomaly)
+			stats.TokensPerSecond = p.average(stats.TokensPerSecond, tokensPerSecond, stats.Samples)
+		}
+	} else if response.InterTokenLatency > 0 {
+		// Without usage, e.g. streams not requesting it, the decode rate is taken from the cadence of the token events.
+		tokensPerSecond, anomaly := timing.ClampRate(1/response.InterTokenLatency.Seconds(), maxTokensPerSecond)
+		p.recordAnomaly(ctx, id, "tokensPerSecond", anomaly)
+		stats.TokensPerSecond = p.average(stats.TokensPerSecond, tokensPerSecond, stats.Samples)
+	}
+	stats.Samples++
+}
+
+// recordAnomaly records the time anomaly of a measurement of the given endpoint, if any.
+func (p *Plugin) recordAnomaly(ctx context.Context, id, measurement string, anomaly timing.Anomaly) {
+	if anomaly == "" {
+		return
+	}
+	metrics.RecordTimeAnomaly(timeAnomalySource, string(anomaly))
+	log.FromContext(ctx).V(logutil.DEBUG).Info("Clamped an anomalous performance measurement", "endpoint", id,
+		"measurement", measurement, "anomaly", anomaly)
+}
+
+// average returns the exponentially weighted moving average of a value after a new measurement. The first
+// measurement initializes the average.
+func (p *Plugin) average(current, measured float64, samples int64) float64 {
+	if samples == 0 || current == 0 {
+		return measured
+	}
+	return (1-p.config.Smoothing)*current + p.config.Smoothing*measured
+}
+
+// bucket returns the key of the context length bucket of the given number of prompt tokens.
+func (p *Plugin) bucket(promptTokens int) string {
+	i, _ := slices.BinarySearch(p.config.ContextLengthBuckets, promptTokens)
+	if i == len(p.config.ContextLengthBuckets) {
+		return unboundedBucket
+	}
+	return strconv.Itoa(p.config.ContextLengthBuckets[i])
+}
+
+// estimatePromptTokens estimates the number of prompt tokens of the request, at ~4 characters per token.
+func estimatePromptTokens(request *framework.InferenceRequest) int {
+	switch {
+	case request == nil:
+		return 0
+	case request.Body != nil && request.Body.InputTokenCountHint() >= 0:
+		return request.Body.InputTokenCountHint()
+	case request.RequestSizeBytes > 0:
+		return request.RequestSizeBytes / 4
+	case request.Body != nil:
+		return int(math.Round(float64(len(request.Body.PromptText())) / 4))
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fingerprint
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	fwkrh "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	attrfingerprint "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/attribute/fingerprint"
+)
+
+func newEndpoint(name string) framework.Endpoint {
+	return framework.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Namespace: "default", Name: name}},
+		&fwkdl.Metrics{}, nil)
+}
+
+// newRequest returns a request of about the given number of prompt tokens.
+func newRequest(promptTokens int, stream bool) *framework.InferenceRequest {
+	return &framework.InferenceRequest{
+		RequestId:        "req",
+		Body:             &fwkrh.InferenceRequestBody{Stream: stream},
+		RequestSizeBytes: promptTokens * 4,
+	}
+}
+
+// serve reports a successful response of the endpoint: 101 completion tokens, the first one after ttft and the
+// remaining ones after another second.
+func serve(p *Plugin, endpoint framework.Endpoint, request *framework.InferenceRequest, ttft time.Duration) {
+	p.PostResponse(context.Background(), request, &requestcontrol.CompletedResponse{
+		Usage:   fwkrh.Usage{CompletionTokens: 101},
+		TTFT:    ttft,
+		Latency: ttft + time.Second,
+	}, endpoint.GetMetadata())
+}
+
+func fingerprintOf(t *testing.T, p *Plugin, endpoint framework.Endpoint, request *framework.InferenceRequest) *attrfingerprint.PerformanceFingerprint {
+	t.Helper()
+	require.NoError(t, p.PrepareRequestData(context.Background(), request, []framework.Endpoint{endpoint}))
+	raw, ok := endpoint.Get(attrfingerprint.PerformanceFingerprintKey)
+	if !ok {
+		return nil
+	}
+	return raw.(*attrfingerprint.PerformanceFingerprint)
+}
+
+func TestFactory(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  string
+		wantErr bool
+	}{
+		{name: "defaults", params: ``},
+		{name: "custom", params: `{"maxAgeSeconds": 3600, "smoothing": 0.5, "contextLengthBuckets": [512, 2048]}`},
+		{name: "invalid smoothing", params: `{"smoothing": 1.5}`, wantErr: true},
+		{name: "invalid max age", params: `{"maxAgeSeconds": -1}`, wantErr: true},
+		{name: "unordered buckets", params: `{"contextLengthBuckets": [2048, 512]}`, wantErr: true},
+		{name: "malformed json", params: `{`, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := Factory("fingerprint", json.RawMessage(test.params), nil)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "fingerprint", p.TypedName().Name)
+			assert.Equal(t, FingerprintProducerType, p.TypedName().Type)
+		})
+	}
+}
+
+func TestFingerprintLearning(t *testing.T) {
+	p := New(DefaultConfig)
+	endpoint := newEndpoint("pod1")
+	short, long := newRequest(100, true), newRequest(10000, true)
+
+	assert.Nil(t, fingerprintOf(t, p, endpoint, short), "no fingerprint before the first response")
+
+	serve(p, endpoint, short, 200*time.Millisecond)
+	fp := fingerprintOf(t, p, endpoint, short)
+	require.NotNil(t, fp)
+	assert.InDelta(t, 100, fp.TokensPerSecond, 1e-9)
+	assert.Equal(t, 200*time.Millisecond, fp.TTFT)
+	assert.Equal(t, int64(1), fp.Samples)
+
+	// The averages move towards the new measurements by the smoothing factor.
+	serve(p, endpoint, short, 400*time.Millisecond)
+	fp = fingerprintOf(t, p, endpoint, short)
+	assert.Equal(t, 220*time.Millisecond, fp.TTFT)
+	assert.Equal(t, int64(2), fp.Samples)
+
+	// Another context length bucket has no measurement yet.
+	fp = fingerprintOf(t, p, endpoint, long)
+	require.NotNil(t, fp)
+	assert.Zero(t, fp.TokensPerSecond)
+	assert.Zero(t, fp.Samples)
+}
+
+func TestFingerprintInterTokenLatency(t *testing.T) {
+	p := New(DefaultConfig)
+	endpoint := newEndpoint("pod1")
+	request := newRequest(100, true)
+
+	// Streams not requesting usage are measured by the cadence of their token events.
+	p.PostResponse(context.Background(), request, &requestcontrol.CompletedResponse{
+		TTFT:              200 * time.Millisecond,
+		InterTokenLatency: 20 * time.Millisecond,
+		Latency:           time.Second,
+	}, endpoint.GetMetadata())
+	fp := fingerprintOf(t, p, endpoint, request)
+	require.NotNil(t, fp)
+	assert.InDelta(t, 50, fp.TokensPerSecond, 1e-9)
+	assert.Equal(t, 200*time.Millisecond, fp.TTFT)
+}
+
+func TestFingerprintIgnoredResponses(t *testing.T) {
+	p := New(DefaultConfig)
+	endpoint := newEndpoint("pod1")
+	request := newRequest(100, true)
+
+	p.PostResponse(context.Background(), request, &requestcontrol.CompletedResponse{Failed: true, TTFT: time.Second,
+		Latency: 2 * time.Second, Usage: fwkrh.Usage{CompletionTokens: 10}}, endpoint.GetMetadata())
+	p.PostResponse(context.Background(), request, &requestcontrol.CompletedResponse{Abandoned: true, TTFT: time.Second,
+		Latency: 2 * time.Second, Usage: fwkrh.Usage{CompletionTokens: 10}}, endpoint.GetMetadata())
+	assert.Nil(t, fingerprintOf(t, p, endpoint, request), "failed and abandoned responses are not measured")
+
+	// The first chunk of a non-streamed response is not the first token.
+	serve(p, endpoint, newRequest(100, false), time.Second)
+	fp := fingerprintOf(t, p, endpoint, request)
+	require.NotNil(t, fp)
+	assert.Zero(t, fp.Samples)
+}
+
+func TestFingerprintTimeAnomalies(t *testing.T) {
+	p := New(DefaultConfig)
+	endpoint := newEndpoint("pod1")
+	request := newRequest(100, true)
+
+	// A TTFT longer than the latency has a negative decode, which is not measured.
+	p.PostResponse(context.Background(), request, &requestcontrol.CompletedResponse{TTFT: 2 * time.Second,
+		Latency: time.Second, Usage: fwkrh.Usage{CompletionTokens: 10}}, endpoint.GetMetadata())
+	fp := fingerprintOf(t, p, endpoint, request)
+	require.NotNil(t, fp)
+	assert.Equal(t, 2*time.Second, fp.TTFT)
+	assert.Zero(t, fp.TokensPerSecond)
+
+	// Absurd TTFTs and throughputs are clamped.
+	p = New(DefaultConfig)
+	p.PostResponse(context.Background(), request, &requestcontrol.CompletedResponse{TTFT: 24 * time.Hour,
+		Latency: 24*time.Hour + time.Millisecond, Usage: fwkrh.Usage{CompletionTokens: 1001}}, endpoint.GetMetadata())
+	fp = fingerprintOf(t, p, endpoint, request)
+	require.NotNil(t, fp)
+	assert.Equal(t, maxTTFT, fp.TTFT)
+	assert.InDelta(t, maxTokensPerSecond, fp.TokensPerSecond, 1e-9)
+
+	// Negative TTFTs are not measured.
+	p = New(DefaultConfig)
+	serve(p, endpoint, request, -time.Second)
+	fp = fingerprintOf(t, p, endpoint, request)
+	require.NotNil(t, fp)
+	assert.Zero(t, fp.Samples)
+}
+
+func TestFingerprintWarmUp(t *testing.T) {
+	p := New(DefaultConfig)
+	now := time.Now()
+	p.now = func() time.Time { return now }
+	endpoint := newEndpoint("pod1")
+	event := fwkdl.EndpointEvent{Type: fwkdl.EventAddOrUpdate, Endpoint: fwkdl.NewEndpoint(endpoint.GetMetadata(), nil)}
+
+	require.NoError(t, p.ExtractEndpoint(context.Background(), event))
+	now = now.Add(5 * time.Second)
+	serve(p, endpoint, newRequest(100, true), 100*time.Millisecond)
+	assert.Equal(t, 5*time.Second, fingerprintOf(t, p, endpoint, newRequest(100, true)).WarmUp)
+
+	// Updates of a warmed up endpoint do not measure the warm-up again.
+	require.NoError(t, p.ExtractEndpoint(context.Background(), event))
+	now = now.Add(time.Minute)
+	serve(p, endpoint, newRequest(100, true), 100*time.Millisecond)
+	assert.Equal(t, 5*time.Second, fingerprintOf(t, p, endpoint, newRequest(100, true)).WarmUp)
+}
+
+func TestFingerprintState(t *testing.T) {
+	endpoint, stale := newEndpoint("pod1"), newEndpoint("pod2")
+	request := newRequest(100, true)
+
+	p := New(DefaultConfig)
+	serve(p, endpoint, request, 200*time.Millisecond)
+	serve(p, stale, request, 200*time.Millisecond)
+	p.fingerprints[stale.GetMetadata().NamespacedName.String()].Updated = time.Now().Add(-48 * time.Hour)
+	state, err := p.ExportState()
+	require.NoError(t, err)
+
+	// A new plugin starts with the exported fingerprints, except the stale ones.
+	imported := New(DefaultConfig)
+	require.NoError(t, imported.ImportState(context.Background(), state))
+	fp := fingerprintOf(t, imported, endpoint, request)
+	require.NotNil(t, fp)
+	assert.InDelta(t, 100, fp.TokensPerSecond, 1e-9)
+	assert.Equal(t, 200*time.Millisecond, fp.TTFT)
+	assert.Nil(t, fingerprintOf(t, imported, stale, request))
+
+	// Fingerprints the plugin already has are kept.
+	serve(imported, endpoint, request, 400*time.Millisecond)
+	require.NoError(t, imported.ImportState(context.Background(), state))
+	assert.Equal(t, int64(2), fingerprintOf(t, imported, endpoint, request).Samples)
+
+	// Fingerprints updated in the future, e.g. before a backward step of the clock, are imported as updated now, so
+	// that they still expire.
+	id := endpoint.GetMetadata().NamespacedName.String()
+	p.fingerprints[id].Updated = time.Now().Add(48 * time.Hour)
+	state, err = p.ExportState()
+	require.NoError(t, err)
+	imported = New(DefaultConfig)
+	require.NoError(t, imported.ImportState(context.Background(), state))
+	require.Contains(t, imported.fingerprints, id)
+	assert.False(t, imported.fingerprints[id].Updated.After(time.Now()))
+
+	// A state of another version is discarded, a corrupted one is an error.
+	imported = New(DefaultConfig)
+	require.NoError(t, imported.ImportState(context.Background(), json.RawMessage(`{"version": 0, "fingerprints": {"default/pod1": {}}}`)))
+	assert.Empty(t, imported.fingerprints)
+	assert.Error(t, imported.ImportState(context.Background(), json.RawMessage(`{`)))
+}
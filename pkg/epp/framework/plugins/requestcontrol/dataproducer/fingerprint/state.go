@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fingerprint
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/timing"
+)
+
+// stateVersion is the version of the exported state format. A state of another version is discarded.
+const stateVersion = 1
+
+// state is the exported state of the plugin.
+type state struct {
+	Version      int                             `json:"version"`
+	Fingerprints map[string]*endpointFingerprint `json:"fingerprints"`
+}
+
+// endpointFingerprint is the performance fingerprint of an endpoint.
+type endpointFingerprint struct {
+	// Buckets are the measurements by context length bucket, keyed by the upper bound of the bucket.
+	Buckets map[string]*bucketStats `json:"buckets"`
+	// WarmUpMs is the time the endpoint took to serve its first successful request after it was added, in
+	// milliseconds. Zero if unknown.
+	WarmUpMs int64 `json:"warmUpMs,omitempty"`
+	// Updated is the time of the last response served by the endpoint.
+	Updated time.Time `json:"updated"`
+}
+
+// bucketStats are the measurements of an endpoint for a context length bucket.
+type bucketStats struct {
+	TokensPerSecond float64 `json:"tokensPerSecond"`
+	TTFTMs          float64 `json:"ttftMs"`
+	Samples         int64   `json:"samples"`
+}
+
+// ExportState returns the fingerprints of the endpoints that served a request within the max age.
+func (p *Plugin) ExportState() (json.RawMessage, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.prune()
+	return json.Marshal(state{Version: stateVersion, Fingerprints: p.fingerprints})
+}
+
+// ImportState adds the fingerprints exported by another replica, or persisted before a restart, to the ones of the
+// plugin. Fingerprints the plugin already has are kept, as they are at least as recent. A state of another version is
+// discarded: the fingerprints are then learned from scratch.
+func (p *Plugin) ImportState(ctx context.Context, raw json.RawMessage) error {
+	var s state
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return err
+	}
+	if s.Version != stateVersion {
+		log.FromContext(ctx).V(logutil.DEFAULT).Info("Discarded performance fingerprints of another version",
+			"version", s.Version)
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := p.now()
+	for id, fp := range s.Fingerprints {
+		if _, ok := p.fingerprints[id]; ok || fp == nil {
+			continue
+		}
+		if fp.Buckets == nil {
+			fp.Buckets = map[string]*bucketStats{}
+		}
+		// A fingerprint updated in the future, e.g. before a backward step of the clock, would never expire.
+		if anomaly := timing.CheckTimestamp(fp.Updated, now); anomaly != "" {
+			metrics.RecordTimeAnomaly(timeAnomalySource, string(anomaly))
+			fp.Updated = now
+		}
+		p.fingerprints[id] = fp
+	}
+	p.prune()
+	log.FromContext(ctx).V(logutil.DEFAULT).Info("Imported performance fingerprints", "endpoints", len(p.fingerprints))
+	return nil
+}
+
+// prune discards the fingerprints of the endpoints that served no request within the max age. Must be called with
+// the lock held.
+func (p *Plugin) prune() {
+	cutoff := p.now().Add(-time.Duration(p.config.MaxAgeSeconds) * time.Second)
+	for id, fp := range p.fingerprints {
+		if fp.Updated.Before(cutoff) {
+			delete(p.fingerprints, id)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inflightload
+
+import (
+	"math"
+	"sync"
+)
+
+// minPriorSamples is the number of completions of a model observed before its learned completion length is used.
+const minPriorSamples = 10
+
+// completionModel is the completion length model learned for a model: a linear regression of the completion length
+// on the input length, fitted online from exponentially weighted moments, so that the prediction follows both the
+// prompt of the request and the recent traffic of the model.
+type completionModel struct {
+	// Samples is the number of completions observed.
+	Samples int64 `json:"samples"`
+	// MeanInput is the exponentially weighted mean of the input lengths, in tokens.
+	MeanInput float64 `json:"meanInput"`
+	// MeanCompletion is the exponentially weighted mean of the completion lengths, in tokens.
+	MeanCompletion float64 `json:"meanCompletion"`
+	// VarInput is the exponentially weighted variance of the input lengths.
+	VarInput float64 `json:"varInput"`
+	// CovInputCompletion is the exponentially weighted covariance of the input and completion lengths.
+	CovInputCompletion float64 `json:"covInputCompletion"`
+	// MeanAbsoluteError is the exponentially weighted mean absolute error of the predictions made for the observed
+	// completions once the model was calibrated, in tokens.
+	MeanAbsoluteError float64 `json:"meanAbsoluteError"`
+}
+
+// slope returns the increase of the completion length per input token. It is never negative, so that a noisy fit
+// cannot predict shorter completions for longer prompts.
+func (m *completionModel) slope() float64 {
+	if m.VarInput <= 0 {
+		return 0
+	}
+	return max(m.CovInputCompletion/m.VarInput, 0)
+}
+
+// predict returns the completion length predicted for the given input length, at least one token.
+func (m *completionModel) predict(inputTokens int64) float64 {
+	return max(m.MeanCompletion+m.slope()*(float64(inputTokens)-m.MeanInput), 1)
+}
+
+// completionPredictor learns the completion length of the requests of each model online, from the input lengths of
+// the requests and the completion lengths reported by the model servers. It is safe for concurrent use; a nil
+// completionPredictor learns nothing.
+type completionPredictor struct {
+	learningRate float64
+
+	mu     sync.RWMutex
+	models map[string]*completionModel
+}
+
+func newCompletionPredictor(learningRate float64) *completionPredictor {
+	return &completionPredictor{
+		learningRate: learningRate,
+		models:       map[string]*completionModel{},
+	}
+}
+
+// predict returns the completion length predicted for a request of the given model and input length, if enough
+// completions of the model were observed.
+func (p *completionPredictor) predict(model string, inputTokens int64) (float64, bool) {
+	if p == nil {
+		return 0, false
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	m, ok := p.models[model]
+	if !ok || m.Samples < minPriorSamples {
+		return 0, false
+	}
+	return m.predict(inputTokens), true
+}
+
+// observe records the input and completion lengths of a completed request of the given model. The first completions
+// are weighted evenly, so that the moments do not start biased towards the first observed lengths.
+func (p *completionPredictor) observe(model string, inputTokens int64, completionTokens int) {
+	if p == nil || completionTokens <= 0 {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	m, ok := p.models[model]
+	if !ok {
+		m = &completionModel{}
+		p.models[model] = m
+	}
+	x, y := float64(inputTokens), float64(completionTokens)
+	if m.Samples >= minPriorSamples {
+		m.MeanAbsoluteError += p.learningRate * (math.Abs(m.predict(inputTokens)-y) - m.MeanAbsoluteError)
+	}
+	m.Samples++
+	rate := max(p.learningRate, 1/float64(m.Samples))
+	dx, dy := x-m.MeanInput, y-m.MeanCompletion
+	m.MeanInput += rate * dx
+	m.MeanCompletion += rate * dy
+	m.VarInput = (1 - rate) * (m.VarInput + rate*dx*dx)
+	m.CovInputCompletion = (1 - rate) * (m.CovInputCompletion + rate*dx*dy)
+}
+
+// snapshot returns a copy of the learned completion length models, keyed by model.
+func (p *completionPredictor) snapshot() map[string]completionModel {
+	if p == nil {
+		return nil
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	res := make(map[string]completionModel, len(p.models))
+	for model, m := range p.models {
+		res[model] = *m
+	}
+	return res
+}
@@ -19,11 +19,16 @@ package inflightload
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"path"
 	"reflect"
 	"sync"
 	"sync/atomic"
+	"time"
 
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+
 	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
 	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
@@ -35,14 +40,62 @@ import (
 const (
 	InFlightLoadProducerType = "inflight-load-producer"
 	profilePrefill           = "prefill"
+
+	// peerBaselineDecay is the duration over which the in-flight load imported from a peer replica decays to zero,
+	// while this replica builds up its own view of the load.
+	peerBaselineDecay = 30 * time.Second
 )
 
-func InFlightLoadProducerFactory(name string, _ json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+type Config struct {
+	// ReasoningModels are the patterns, in the path.Match syntax, of the names of the reasoning models, e.g.
+	// "deepseek-r1*". Their completion length is estimated with ReasoningOutputRatio until it is learned.
+	ReasoningModels []string `json:"reasoningModels,omitempty"`
+	// ReasoningOutputRatio is the ratio of output to input tokens of the reasoning models. Default: 8.
+	ReasoningOutputRatio float64 `json:"reasoningOutputRatio,omitempty"`
+	// CompletionLengthLearningRate is the weight of a completion in the completion length learned for each model, in
+	// [0, 1]. 0 disables the learning. Default: 0.05.
+	CompletionLengthLearningRate *float64 `json:"completionLengthLearningRate,omitempty"`
+}
+
+var DefaultConfig = Config{
+	ReasoningOutputRatio:         8,
+	CompletionLengthLearningRate: ptr.To(0.05),
+}
+
+func (c *Config) validate() error {
+	for _, pattern := range c.ReasoningModels {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid reasoning model pattern %q: %w", pattern, err)
+		}
+	}
+	if c.ReasoningOutputRatio <= 0 {
+		return fmt.Errorf("reasoningOutputRatio must be positive, got %f", c.ReasoningOutputRatio)
+	}
+	if rate := ptr.Deref(c.CompletionLengthLearningRate, 0); rate < 0 || rate > 1 {
+		return fmt.Errorf("completionLengthLearningRate must be in [0, 1], got %f", rate)
+	}
+	return nil
+}
+
+func InFlightLoadProducerFactory(name string, rawParameters json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	config := DefaultConfig
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal config for %s: %w", InFlightLoadProducerType, err)
+		}
+	}
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config for %s: %w", InFlightLoadProducerType, err)
+	}
+	estimator := NewModelTokenEstimator(config.ReasoningModels, config.ReasoningOutputRatio,
+		ptr.Deref(config.CompletionLengthLearningRate, 0))
 	return &InFlightLoadProducer{
 		typedName:      fwkplugin.TypedName{Type: InFlightLoadProducerType, Name: name},
 		requestTracker: newConcurrencyTracker(),
 		tokenTracker:   newConcurrencyTracker(),
-		tokenEstimator: NewSimpleTokenEstimator(),
+		tokenEstimator: estimator,
+		modelEstimator: estimator,
+		now:            time.Now,
 	}, nil
 }
 
@@ -51,6 +104,8 @@ var (
 	_ requestcontrol.ResponseBodyProcessor = &InFlightLoadProducer{}
 	_ requestcontrol.DataProducer          = &InFlightLoadProducer{}
 	_ datalayer.EndpointExtractor          = &InFlightLoadProducer{}
+	_ fwkplugin.PeerStatePlugin            = &InFlightLoadProducer{}
+	_ fwkplugin.DebugStatePlugin           = &InFlightLoadProducer{}
 )
 
 type InFlightLoadProducer struct {
@@ -58,6 +113,33 @@ type InFlightLoadProducer struct {
 	requestTracker *concurrencyTracker
 	tokenTracker   *concurrencyTracker
 	tokenEstimator TokenEstimator
+	// modelEstimator, when set, is the tokenEstimator, learning the completion length of each model from the
+	// completed requests.
+	modelEstimator *ModelTokenEstimator
+	// estimates are the tokens added to the in-flight load of their endpoints by the dispatched requests, keyed by
+	// request ID, so that the same tokens are released on completion whatever the estimator learned meanwhile.
+	estimates sync.Map
+	// peerBaseline is the in-flight load imported from a peer replica, if any.
+	peerBaseline atomic.Pointer[peerBaseline]
+	now          func() time.Time
+}
+
+// inFlightState is the exported in-flight load, keyed by endpoint.
+type inFlightState struct {
+	Requests map[string]int64 `json:"requests"`
+	Tokens   map[string]int64 `json:"tokens"`
+}
+
+// peerBaseline is the in-flight load imported from a peer replica. It decays linearly to zero over
+// peerBaselineDecay after its import.
+type peerBaseline struct {
+	state    inFlightState
+	imported time.Time
+}
+
+// weight returns the fraction of the imported load still applied at the given time.
+func (b *peerBaseline) weight(now time.Time) float64 {
+	return max(0, 1-float64(now.Sub(b.imported))/float64(peerBaselineDecay))
 }
 
 func (p *InFlightLoadProducer) TypedName() fwkplugin.TypedName {
@@ -87,14 +169,57 @@ func (p *InFlightLoadProducer) ExtractEndpoint(ctx context.Context, event datala
 	return nil
 }
 
-func (p *InFlightLoadProducer) PrepareRequestData(_ context.Context, _ *framework.InferenceRequest, endpoints []framework.Endpoint) error {
+func (p *InFlightLoadProducer) PrepareRequestData(_ context.Context, request *framework.InferenceRequest, endpoints []framework.Endpoint) error {
+	var outputTokens int64
+	if p.modelEstimator != nil && request != nil {
+		if inputTokens := p.modelEstimator.estimateInput(request); inputTokens > 0 {
+			outputTokens = p.modelEstimator.EstimateOutput(request.TargetModel, inputTokens, requestedMaxTokens(request))
+		}
+	}
+	baseline := p.peerBaseline.Load()
+	weight := 0.0
+	if baseline != nil {
+		weight = baseline.weight(p.now())
+		if weight == 0 {
+			p.peerBaseline.CompareAndSwap(baseline, nil)
+		}
+	}
 	for _, e := range endpoints {
 		endpointID := e.GetMetadata().NamespacedName.String()
-		e.Put(attrconcurrency.InFlightLoadKey, &attrconcurrency.InFlightLoad{
+		load := &attrconcurrency.InFlightLoad{
 			Tokens:   p.tokenTracker.get(endpointID),
 			Requests: p.requestTracker.get(endpointID),
-		})
+
+			RequestOutputTokens: outputTokens,
+		}
+		if weight > 0 {
+			load.Tokens += int64(weight * float64(baseline.state.Tokens[endpointID]))
+			load.Requests += int64(weight * float64(baseline.state.Requests[endpointID]))
+		}
+		e.Put(attrconcurrency.InFlightLoadKey, load)
+	}
+	return nil
+}
+
+// ExportState returns the in-flight load tracked by this replica.
+func (p *InFlightLoadProducer) ExportState() (json.RawMessage, error) {
+	return json.Marshal(inFlightState{
+		Requests: p.requestTracker.snapshot(),
+		Tokens:   p.tokenTracker.snapshot(),
+	})
+}
+
+// ImportState imports the in-flight load tracked by a peer replica. The peer's requests complete on the peer, so
+// their load cannot be tracked here: it is applied as a baseline decaying to zero over peerBaselineDecay, bridging
+// the time this replica needs to observe the load of its own requests.
+func (p *InFlightLoadProducer) ImportState(ctx context.Context, state json.RawMessage) error {
+	baseline := &peerBaseline{imported: p.now()}
+	if err := json.Unmarshal(state, &baseline.state); err != nil {
+		return err
 	}
+	p.peerBaseline.Store(baseline)
+	log.FromContext(ctx).V(logutil.DEFAULT).Info("Imported in-flight load from peer", "endpoints", len(baseline.state.Requests),
+		"decay", peerBaselineDecay)
 	return nil
 }
 
@@ -103,6 +228,10 @@ func (p *InFlightLoadProducer) PreRequest(_ context.Context, request *framework.
 		return
 	}
 
+	tokens := p.tokenEstimator.Estimate(request)
+	if request != nil {
+		p.estimates.Store(request.RequestId, tokens)
+	}
 	for _, profileResult := range result.ProfileResults {
 		if profileResult == nil || len(profileResult.TargetEndpoints) == 0 {
 			continue
@@ -114,7 +243,6 @@ func (p *InFlightLoadProducer) PreRequest(_ context.Context, request *framework.
 		}
 		eid := endpoint.GetMetadata().NamespacedName.String()
 		p.requestTracker.inc(eid)
-		tokens := p.tokenEstimator.Estimate(request)
 		p.tokenTracker.add(eid, tokens)
 	}
 }
@@ -155,6 +283,10 @@ func (p *InFlightLoadProducer) ResponseBody(
 			}
 			p.release(profileResult.TargetEndpoints[0], request)
 		}
+		p.estimates.Delete(request.RequestId)
+		if p.modelEstimator != nil && !resp.Abandoned && !resp.Evicted {
+			p.modelEstimator.Observe(request, resp.Usage.CompletionTokens)
+		}
 	}
 }
 
@@ -164,8 +296,19 @@ func (p *InFlightLoadProducer) release(endpoint framework.Endpoint, request *fra
 	}
 	eid := endpoint.GetMetadata().NamespacedName.String()
 	p.requestTracker.dec(eid)
-	tokens := p.tokenEstimator.Estimate(request)
-	p.tokenTracker.add(eid, -tokens)
+	tokens, ok := p.estimates.Load(request.RequestId)
+	if !ok {
+		tokens = p.tokenEstimator.Estimate(request)
+	}
+	p.tokenTracker.add(eid, -tokens.(int64))
+}
+
+// DebugState returns the completion length learned for each model.
+func (p *InFlightLoadProducer) DebugState() any {
+	if p.modelEstimator == nil {
+		return nil
+	}
+	return map[string]any{"completionLengths": p.modelEstimator.predictor.snapshot()}
 }
 
 func (p *InFlightLoadProducer) Produces() map[string]any {
@@ -236,6 +379,18 @@ func (ct *concurrencyTracker) add(endpointID string, delta int64) {
 	ct.counts[endpointID] = counter
 }
 
+// snapshot returns a copy of the counts.
+func (ct *concurrencyTracker) snapshot() map[string]int64 {
+	ct.mu.RLock()
+	defer ct.mu.RUnlock()
+
+	counts := make(map[string]int64, len(ct.counts))
+	for endpointID, counter := range ct.counts {
+		counts[endpointID] = counter.Load()
+	}
+	return counts
+}
+
 func (ct *concurrencyTracker) dec(endpointID string) {
 	ct.add(endpointID, -1)
 }
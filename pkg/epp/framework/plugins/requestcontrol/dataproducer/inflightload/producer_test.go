@@ -20,6 +20,7 @@ import (
 	"context"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/types"
@@ -60,6 +61,48 @@ func TestInFlightLoadProducer_PrepareRequestData(t *testing.T) {
 	require.Equal(t, int64(500), load.Tokens)
 }
 
+func TestInFlightLoadProducer_ExportImportState(t *testing.T) {
+	t.Parallel()
+
+	endpointName := "peer-endpoint"
+	endpointID := fullEndpointName(endpointName)
+	peer := &InFlightLoadProducer{
+		requestTracker: newConcurrencyTracker(),
+		tokenTracker:   newConcurrencyTracker(),
+	}
+	peer.requestTracker.add(endpointID, 4)
+	peer.tokenTracker.add(endpointID, 400)
+	state, err := peer.ExportState()
+	require.NoError(t, err)
+
+	now := time.Now()
+	producer := &InFlightLoadProducer{
+		requestTracker: newConcurrencyTracker(),
+		tokenTracker:   newConcurrencyTracker(),
+		now:            func() time.Time { return now },
+	}
+	producer.requestTracker.add(endpointID, 1)
+	require.NoError(t, producer.ImportState(context.Background(), state))
+
+	// Half way through the decay, half of the imported load is applied on top of the local load.
+	now = now.Add(peerBaselineDecay / 2)
+	endpoints := []schedulingtypes.Endpoint{newStubSchedulingEndpoint(endpointName)}
+	require.NoError(t, producer.PrepareRequestData(context.Background(), nil, endpoints))
+	val, _ := endpoints[0].Get(attrconcurrency.InFlightLoadKey)
+	load := val.(*attrconcurrency.InFlightLoad)
+	require.Equal(t, int64(3), load.Requests)
+	require.Equal(t, int64(200), load.Tokens)
+
+	// Once decayed, the baseline is dropped.
+	now = now.Add(peerBaselineDecay / 2)
+	require.NoError(t, producer.PrepareRequestData(context.Background(), nil, endpoints))
+	val, _ = endpoints[0].Get(attrconcurrency.InFlightLoadKey)
+	load = val.(*attrconcurrency.InFlightLoad)
+	require.Equal(t, int64(1), load.Requests)
+	require.Equal(t, int64(0), load.Tokens)
+	require.Nil(t, producer.peerBaseline.Load())
+}
+
 func TestInFlightLoadProducer_Lifecycle(t *testing.T) {
 	t.Parallel()
 
@@ -88,6 +131,54 @@ func TestInFlightLoadProducer_Lifecycle(t *testing.T) {
 	require.Equal(t, int64(0), producer.tokenTracker.get(endpointID))
 }
 
+func TestInFlightLoadProducer_CompletionLengthLearning(t *testing.T) {
+	t.Parallel()
+
+	plugin, err := InFlightLoadProducerFactory("test", []byte(`{"completionLengthLearningRate": 1}`), nil)
+	require.NoError(t, err)
+	producer := plugin.(*InFlightLoadProducer)
+	ctx := context.Background()
+	endpointName := "learning-endpoint"
+	endpointID := fullEndpointName(endpointName)
+	res := makeSchedulingResult(endpointName)
+
+	// Dispatched before the completion length is learned: 4 input + 6 output tokens.
+	pending := makeTokenRequest("pending", "1234567890123456")
+	producer.PreRequest(ctx, pending, res)
+	require.Equal(t, int64(10), producer.tokenTracker.get(endpointID))
+
+	for i := 0; i < minPriorSamples; i++ {
+		req := makeTokenRequest("done", "1234567890123456")
+		req.SchedulingResult = res
+		producer.PreRequest(ctx, req, res)
+		producer.ResponseBody(ctx, req, &requestcontrol.Response{EndOfStream: true,
+			Usage: fwkrh.Usage{CompletionTokens: 100}}, nil)
+	}
+
+	// The learned completion length is published, and the pending request still releases the tokens it added.
+	endpoints := []schedulingtypes.Endpoint{newStubSchedulingEndpoint(endpointName)}
+	require.NoError(t, producer.PrepareRequestData(ctx, makeTokenRequest("next", "1234567890123456"), endpoints))
+	val, _ := endpoints[0].Get(attrconcurrency.InFlightLoadKey)
+	require.Equal(t, int64(100), val.(*attrconcurrency.InFlightLoad).RequestOutputTokens)
+	require.Equal(t, int64(10), producer.tokenTracker.get(endpointID))
+	pending.SchedulingResult = res
+	producer.ResponseBody(ctx, pending, &requestcontrol.Response{EndOfStream: true}, nil)
+	require.Equal(t, int64(0), producer.tokenTracker.get(endpointID))
+}
+
+func TestInFlightLoadProducerFactory_InvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	for _, params := range []string{
+		`{"reasoningModels": ["[invalid"]}`,
+		`{"reasoningOutputRatio": -1}`,
+		`{"completionLengthLearningRate": 2}`,
+	} {
+		_, err := InFlightLoadProducerFactory("test", []byte(params), nil)
+		require.Error(t, err, params)
+	}
+}
+
 func TestInFlightLoadProducer_MultiPodLifecycle(t *testing.T) {
 	t.Parallel()
 
@@ -18,7 +18,9 @@ package inflightload
 
 import (
 	"math"
+	"path"
 
+	fwkrh "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
 	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
 )
 
@@ -46,27 +48,120 @@ func NewSimpleTokenEstimator() TokenEstimator {
 // to avoid allocations. Otherwise, input tokens are estimated from prompt/message character count
 // using CharactersPerToken; output tokens are estimated as inputTokens * OutputRatio.
 func (e *SimpleTokenEstimator) Estimate(request *framework.InferenceRequest) int64 {
+	inputTokens := e.estimateInput(request)
+	if inputTokens == 0 {
+		return 0
+	}
+	outputTokens := int64(math.Round(float64(inputTokens) * e.OutputRatio))
+	return inputTokens + outputTokens
+}
+
+// estimateInput returns the estimated number of input tokens of the request, 0 if unknown.
+func (e *SimpleTokenEstimator) estimateInput(request *framework.InferenceRequest) int64 {
 	if request == nil {
 		return 0
 	}
 	// Prefer request body size when available: avoids PlainText() and reduces GC pressure.
-	var inputTokens int64
 	switch {
 	case request.RequestSizeBytes > 0:
-		inputTokens = max(int64(request.RequestSizeBytes)/4, 1)
+		return max(int64(request.RequestSizeBytes)/4, 1)
 	case request.Body != nil:
 		hint := request.Body.InputTokenCountHint()
 		if hint >= 0 {
-			inputTokens = int64(hint)
-		} else {
-			// Fallback: character count from prompt text across all API types
-			// (completions, chat/completions, responses, conversations).
-			chars := len(request.Body.PromptText())
-			inputTokens = int64(math.Max(1, math.Round(float64(chars)/e.CharactersPerToken)))
+			return int64(hint)
 		}
+		// Fallback: character count from prompt text across all API types
+		// (completions, chat/completions, responses, conversations).
+		chars := len(request.Body.PromptText())
+		return int64(math.Max(1, math.Round(float64(chars)/e.CharactersPerToken)))
 	default:
 		return 0
 	}
-	outputTokens := int64(math.Round(float64(inputTokens) * e.OutputRatio))
-	return inputTokens + outputTokens
+}
+
+// requestedMaxTokens returns the completion length requested by the request, 0 if it does not specify one.
+func requestedMaxTokens(request *framework.InferenceRequest) int64 {
+	if request == nil || request.Body == nil {
+		return 0
+	}
+	payload, _ := request.Body.Payload.(fwkrh.PayloadMap)
+	_, maxTokens := payload.MaxTokens()
+	return int64(maxTokens)
+}
+
+// ModelTokenEstimator estimates the input tokens like the SimpleTokenEstimator, and the output tokens from the
+// completion length model learned online for the target model of the request, which predicts the completion length
+// from the input length. Until enough completions of a model are observed, the output tokens are estimated from the
+// input tokens, with ReasoningOutputRatio instead of OutputRatio for the reasoning models, whose thinking tokens make
+// their completions much longer than their prompts. Either way, the estimate never exceeds the completion length
+// requested by the request.
+type ModelTokenEstimator struct {
+	SimpleTokenEstimator
+	// ReasoningOutputRatio is the ratio of output to input tokens of the reasoning models.
+	ReasoningOutputRatio float64
+
+	// reasoningModels are the path.Match patterns of the names of the reasoning models.
+	reasoningModels []string
+	// predictor is nil when the completion lengths are not learned.
+	predictor *completionPredictor
+}
+
+// NewModelTokenEstimator returns a ModelTokenEstimator for the given reasoning model patterns, learning the
+// completion length of each model with the given learning rate, or not at all if it is 0.
+func NewModelTokenEstimator(reasoningModels []string, reasoningOutputRatio, learningRate float64) *ModelTokenEstimator {
+	e := &ModelTokenEstimator{
+		SimpleTokenEstimator: *NewSimpleTokenEstimator().(*SimpleTokenEstimator),
+		ReasoningOutputRatio: reasoningOutputRatio,
+		reasoningModels:      reasoningModels,
+	}
+	if learningRate > 0 {
+		e.predictor = newCompletionPredictor(learningRate)
+	}
+	return e
+}
+
+// Estimate returns the total estimated token count (input + output) for the request.
+func (e *ModelTokenEstimator) Estimate(request *framework.InferenceRequest) int64 {
+	inputTokens := e.estimateInput(request)
+	if inputTokens == 0 {
+		return 0
+	}
+	return inputTokens + e.EstimateOutput(request.TargetModel, inputTokens, requestedMaxTokens(request))
+}
+
+// EstimateOutput returns the estimated number of output tokens of a request of the given model and number of input
+// tokens, capped by the given requested completion length unless it is 0.
+func (e *ModelTokenEstimator) EstimateOutput(model string, inputTokens, maxTokens int64) int64 {
+	predicted, ok := e.predictor.predict(model, inputTokens)
+	if !ok {
+		ratio := e.OutputRatio
+		if e.IsReasoningModel(model) {
+			ratio = e.ReasoningOutputRatio
+		}
+		predicted = float64(inputTokens) * ratio
+	}
+	outputTokens := int64(math.Round(predicted))
+	if maxTokens > 0 {
+		outputTokens = min(outputTokens, maxTokens)
+	}
+	return outputTokens
+}
+
+// IsReasoningModel returns whether the given model is a configured reasoning model.
+func (e *ModelTokenEstimator) IsReasoningModel(model string) bool {
+	for _, pattern := range e.reasoningModels {
+		if ok, _ := path.Match(pattern, model); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Observe records the completion length of a completed request, whose input tokens are estimated like at dispatch,
+// so that the learned model relates the completion lengths to the estimates it is queried with.
+func (e *ModelTokenEstimator) Observe(request *framework.InferenceRequest, completionTokens int) {
+	if request == nil {
+		return
+	}
+	e.predictor.observe(request.TargetModel, e.estimateInput(request), completionTokens)
 }
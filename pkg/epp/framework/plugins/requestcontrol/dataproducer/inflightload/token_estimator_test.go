@@ -276,3 +276,67 @@ func TestSimpleTokenEstimator_Estimate_CustomConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestModelTokenEstimator_Estimate(t *testing.T) {
+	estimator := NewModelTokenEstimator([]string{"deepseek-r1*"}, 8, 0.5)
+	request := func(model string) *framework.InferenceRequest {
+		return &framework.InferenceRequest{TargetModel: model, RequestSizeBytes: 400}
+	}
+
+	// 100 input tokens, with the default output ratio of 1.5 or the reasoning one of 8.
+	require.Equal(t, int64(250), estimator.Estimate(request("llama")))
+	require.Equal(t, int64(900), estimator.Estimate(request("deepseek-r1-distill")))
+	require.True(t, estimator.IsReasoningModel("deepseek-r1"))
+	require.False(t, estimator.IsReasoningModel("llama"))
+
+	// The learned completion length is used once enough completions are observed.
+	for i := 0; i < minPriorSamples-1; i++ {
+		estimator.Observe(request("llama"), 1000)
+	}
+	require.Equal(t, int64(250), estimator.Estimate(request("llama")))
+	estimator.Observe(request("llama"), 1000)
+	require.Equal(t, int64(1100), estimator.Estimate(request("llama")))
+	estimator.Observe(request("llama"), 2000)
+	require.Equal(t, int64(1600), estimator.Estimate(request("llama")))
+	require.Equal(t, int64(900), estimator.Estimate(request("deepseek-r1")))
+
+	// Without learning, completions are ignored.
+	static := NewModelTokenEstimator(nil, 8, 0)
+	for i := 0; i < minPriorSamples; i++ {
+		static.Observe(request("llama"), 1000)
+	}
+	require.Equal(t, int64(250), static.Estimate(request("llama")))
+}
+
+func TestModelTokenEstimator_PredictsFromInputLength(t *testing.T) {
+	estimator := NewModelTokenEstimator(nil, 8, 0.05)
+	request := func(inputTokens int) *framework.InferenceRequest {
+		return &framework.InferenceRequest{TargetModel: "llama", RequestSizeBytes: 4 * inputTokens}
+	}
+
+	// The completions are 2 tokens per input token plus 50.
+	for i := 0; i < 200; i++ {
+		inputTokens := 100 + 100*(i%5)
+		estimator.Observe(request(inputTokens), 50+2*inputTokens)
+	}
+	require.InDelta(t, 250, estimator.EstimateOutput("llama", 100, 0), 1)
+	require.InDelta(t, 1050, estimator.EstimateOutput("llama", 500, 0), 1)
+	require.InDelta(t, 2050, estimator.EstimateOutput("llama", 1000, 0), 1)
+	require.Less(t, estimator.predictor.snapshot()["llama"].MeanAbsoluteError, 1.0)
+
+	// The prediction never exceeds the requested completion length.
+	require.Equal(t, int64(300), estimator.EstimateOutput("llama", 500, 300))
+}
+
+func TestModelTokenEstimator_CapsByRequestedMaxTokens(t *testing.T) {
+	estimator := NewModelTokenEstimator([]string{"deepseek-r1*"}, 8, 0)
+	request := func(payload fwkrh.PayloadMap) *framework.InferenceRequest {
+		return &framework.InferenceRequest{TargetModel: "deepseek-r1", RequestSizeBytes: 400,
+			Body: &fwkrh.InferenceRequestBody{Payload: payload}}
+	}
+
+	require.Equal(t, int64(900), estimator.Estimate(request(fwkrh.PayloadMap{})))
+	require.Equal(t, int64(300), estimator.Estimate(request(fwkrh.PayloadMap{"max_tokens": float64(200)})))
+	require.Equal(t, int64(150), estimator.Estimate(request(fwkrh.PayloadMap{"max_completion_tokens": 50,
+		"max_tokens": float64(200)})))
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metricsbackfill provides a data producer backfilling the queue and KV cache metrics of the endpoints whose
+// scrapes are stale, from the dispatch and completion accounting of the EPP, so that a brief metrics outage does not
+// make the endpoints look as loaded as they were at their last scrape until it ends.
+package metricsbackfill
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	attrbackfill "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/attribute/backfill"
+	attrconcurrency "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/attribute/concurrency"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+const (
+	MetricsBackfillProducerType = "metrics-backfill-producer"
+
+	// observationKey is the SharedStore key of the last scrape of each endpoint, in the pod scope.
+	observationKey = fwkplugin.StateKey(MetricsBackfillProducerType + "/observation")
+)
+
+var (
+	_ requestcontrol.DataProducer = &Plugin{}
+	_ requestcontrol.PreRequest   = &Plugin{}
+	_ fwkplugin.DependentPlugin   = &Plugin{}
+)
+
+type Config struct {
+	// StalenessThresholdMs is the age of the last scrape of an endpoint from which its metrics are backfilled.
+	// Default: 2000.
+	StalenessThresholdMs int `json:"stalenessThresholdMs,omitempty"`
+}
+
+var DefaultConfig = Config{
+	StalenessThresholdMs: 2000,
+}
+
+func (c *Config) validate() error {
+	if c.StalenessThresholdMs <= 0 {
+		return fmt.Errorf("stalenessThresholdMs must be > 0, got %d", c.StalenessThresholdMs)
+	}
+	return nil
+}
+
+// observation is the last scrape of an endpoint, with the number of requests the EPP had in flight on the endpoint
+// when the scrape was first seen.
+type observation struct {
+	updateTime time.Time
+	waiting    int
+	running    int
+	kvCache    float64
+	inFlight   int64
+}
+
+func (o *observation) Clone() fwkplugin.StateData {
+	clone := *o
+	return &clone
+}
+
+// Plugin backfills the metrics of the endpoints whose last scrape is older than the staleness threshold. The requests
+// dispatched to an endpoint since its last scrape are added to its queue if it was queuing, or to its running
+// requests otherwise; the completed ones are removed from its queue first, as they free running slots for the queued
+// requests. The KV cache utilization is scaled with the running requests.
+//
+// Endpoints whose metrics were backfilled carry the SyntheticMetrics attribute, and the scheduling decisions made on
+// them are counted, so that the decisions made on approximate data can be told apart.
+type Plugin struct {
+	typedName          fwkplugin.TypedName
+	stalenessThreshold time.Duration
+	store              *fwkplugin.SharedStore
+	now                func() time.Time
+}
+
+func Factory(name string, rawParameters json.RawMessage, handle fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	config := DefaultConfig
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal config for %s: %w", MetricsBackfillProducerType, err)
+		}
+	}
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config for %s: %w", MetricsBackfillProducerType, err)
+	}
+
+	return New(config, handle.SharedStore()).WithName(name), nil
+}
+
+// New creates a new metrics backfill producer, keeping the last scrape of the endpoints in the given store.
+func New(config Config, store *fwkplugin.SharedStore) *Plugin {
+	return &Plugin{
+		typedName:          fwkplugin.TypedName{Type: MetricsBackfillProducerType, Name: MetricsBackfillProducerType},
+		stalenessThreshold: time.Duration(config.StalenessThresholdMs) * time.Millisecond,
+		store:              store,
+		now:                time.Now,
+	}
+}
+
+// WithName sets the name of the plugin.
+func (p *Plugin) WithName(name string) *Plugin {
+	p.typedName.Name = name
+	return p
+}
+
+func (p *Plugin) TypedName() fwkplugin.TypedName {
+	return p.typedName
+}
+
+func (p *Plugin) Produces() map[string]any {
+	return map[string]any{
+		attrbackfill.SyntheticMetricsKey: attrbackfill.SyntheticMetrics{},
+	}
+}
+
+func (p *Plugin) Consumes() map[string]any {
+	return map[string]any{
+		attrconcurrency.InFlightLoadKey: attrconcurrency.InFlightLoad{},
+	}
+}
+
+func (p *Plugin) Requires() []string {
+	return []string{attrconcurrency.InFlightLoadKey}
+}
+
+// PrepareRequestData records the fresh scrapes of the endpoints and backfills the metrics of the stale ones. The
+// metrics of the endpoints are copies owned by the request, so the backfilled values are not seen by other requests.
+func (p *Plugin) PrepareRequestData(ctx context.Context, _ *framework.InferenceRequest, endpoints []framework.Endpoint) error {
+	now := p.now()
+	for _, endpoint := range endpoints {
+		m := endpoint.GetMetrics()
+		if m == nil || m.UpdateTime.IsZero() || endpoint.GetMetadata() == nil {
+			continue
+		}
+		inFlight := int64(0)
+		if val, ok := endpoint.Get(attrconcurrency.InFlightLoadKey); ok {
+			if load, ok := val.(*attrconcurrency.InFlightLoad); ok {
+				inFlight = load.Requests
+			}
+		}
+		// The in-flight requests are counted the first time a scrape is seen, so that the requests dispatched since
+		// are those the scrape does not account for.
+		scopeKey := endpoint.GetMetadata().NamespacedName.String()
+		obs := p.store.Update(fwkplugin.StoreScopePod, scopeKey, observationKey, 0, func(current fwkplugin.StateData) fwkplugin.StateData {
+			if current, ok := current.(*observation); ok && current.updateTime.Equal(m.UpdateTime) {
+				return current
+			}
+			return &observation{updateTime: m.UpdateTime, waiting: m.WaitingQueueSize, running: m.RunningRequestsSize,
+				kvCache: m.KVCacheUsagePercent, inFlight: inFlight}
+		}).(*observation)
+
+		if now.Sub(m.UpdateTime) <= p.stalenessThreshold {
+			continue
+		}
+		delta := inFlight - obs.inFlight
+		backfill(m, obs, delta)
+		endpoint.Put(attrbackfill.SyntheticMetricsKey, &attrbackfill.SyntheticMetrics{LastScrape: m.UpdateTime, InFlightDelta: delta})
+		log.FromContext(ctx).V(logutil.TRACE).Info("Backfilled stale endpoint metrics", "endpoint", scopeKey,
+			"lastScrape", m.UpdateTime, "inFlightDelta", delta, "waiting", m.WaitingQueueSize,
+			"running", m.RunningRequestsSize, "kvCache", m.KVCacheUsagePercent)
+	}
+	return nil
+}
+
+// backfill sets the queue and KV cache metrics of the given endpoint metrics from its last scrape and the change of its
+// in-flight requests since.
+func backfill(m *fwkdl.Metrics, obs *observation, delta int64) {
+	waiting, running := int64(obs.waiting), int64(obs.running)
+	switch {
+	case delta >= 0 && waiting > 0:
+		waiting += delta
+	case delta >= 0:
+		running += delta
+	default:
+		// The completed requests free running slots, which are taken by the queued requests first.
+		drained := min(-delta, waiting)
+		waiting -= drained
+		running = max(running+delta+drained, 0)
+	}
+	m.WaitingQueueSize = int(waiting)
+	m.RunningRequestsSize = int(running)
+	if obs.running > 0 {
+		m.KVCacheUsagePercent = min(max(obs.kvCache*float64(running)/float64(obs.running), 0), 1)
+	}
+}
+
+// PreRequest counts the scheduling decisions made on backfilled metrics.
+func (p *Plugin) PreRequest(ctx context.Context, request *framework.InferenceRequest, result *framework.SchedulingResult) {
+	if result == nil {
+		return
+	}
+	profileResult := result.ProfileResults[result.PrimaryProfileName]
+	if profileResult == nil || len(profileResult.TargetEndpoints) == 0 {
+		return
+	}
+	endpoint := profileResult.TargetEndpoints[0]
+	if _, ok := endpoint.Get(attrbackfill.SyntheticMetricsKey); !ok {
+		return
+	}
+	metrics.RecordSyntheticMetricsDecision(request.TargetModel)
+	log.FromContext(ctx).V(logutil.DEBUG).Info("Request scheduled on backfilled endpoint metrics",
+		"endpoint", endpoint.GetMetadata().NamespacedName)
+}
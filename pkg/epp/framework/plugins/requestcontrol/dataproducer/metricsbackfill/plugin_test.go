@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metricsbackfill
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	attrbackfill "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/attribute/backfill"
+	attrconcurrency "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/attribute/concurrency"
+	"sigs.k8s.io/gateway-api-inference-extension/test/utils"
+)
+
+func TestFactory(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  string
+		wantErr bool
+	}{
+		{name: "defaults", params: ``},
+		{name: "custom threshold", params: `{"stalenessThresholdMs": 500}`},
+		{name: "non-positive threshold", params: `{"stalenessThresholdMs": -1}`, wantErr: true},
+		{name: "malformed json", params: `{`, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := Factory("backfill", json.RawMessage(test.params), utils.NewTestHandle(t.Context()))
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "backfill", p.TypedName().Name)
+		})
+	}
+}
+
+// makeEndpoint returns the scheduling endpoint of a request, with the given scrape and in-flight requests.
+func makeEndpoint(scrape fwkdl.Metrics, inFlight int64) framework.Endpoint {
+	endpoint := framework.NewEndpoint(
+		&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Namespace: "default", Name: "pod1"}},
+		&scrape, nil)
+	endpoint.Put(attrconcurrency.InFlightLoadKey, &attrconcurrency.InFlightLoad{Requests: inFlight})
+	return endpoint
+}
+
+func TestPrepareRequestData(t *testing.T) {
+	scrapeTime := time.Now()
+	tests := []struct {
+		name        string
+		scrape      fwkdl.Metrics
+		inFlight    []int64
+		wantWaiting int
+		wantRunning int
+		wantKVCache float64
+	}{
+		{
+			name:        "dispatched requests queue behind a queuing endpoint",
+			scrape:      fwkdl.Metrics{WaitingQueueSize: 2, RunningRequestsSize: 8, KVCacheUsagePercent: 0.8},
+			inFlight:    []int64{10, 13},
+			wantWaiting: 5,
+			wantRunning: 8,
+			wantKVCache: 0.8,
+		},
+		{
+			name:        "dispatched requests run on a non-queuing endpoint",
+			scrape:      fwkdl.Metrics{RunningRequestsSize: 4, KVCacheUsagePercent: 0.4},
+			inFlight:    []int64{4, 6},
+			wantRunning: 6,
+			wantKVCache: 0.6,
+		},
+		{
+			name:        "completed requests drain the queue first",
+			scrape:      fwkdl.Metrics{WaitingQueueSize: 2, RunningRequestsSize: 8, KVCacheUsagePercent: 0.8},
+			inFlight:    []int64{10, 5},
+			wantRunning: 5,
+			wantKVCache: 0.5,
+		},
+		{
+			name:        "KV cache is clamped",
+			scrape:      fwkdl.Metrics{RunningRequestsSize: 2, KVCacheUsagePercent: 0.9},
+			inFlight:    []int64{2, 4},
+			wantRunning: 4,
+			wantKVCache: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := New(DefaultConfig, fwkplugin.NewSharedStore(t.Context(), 0, 0))
+			test.scrape.UpdateTime = scrapeTime
+
+			// The scrape is fresh when first seen.
+			p.now = func() time.Time { return scrapeTime }
+			fresh := makeEndpoint(test.scrape, test.inFlight[0])
+			require.NoError(t, p.PrepareRequestData(context.Background(), nil, []framework.Endpoint{fresh}))
+			assert.Equal(t, test.scrape.WaitingQueueSize, fresh.GetMetrics().WaitingQueueSize)
+			_, synthetic := fresh.Get(attrbackfill.SyntheticMetricsKey)
+			assert.False(t, synthetic)
+
+			// No scrape succeeded since.
+			p.now = func() time.Time { return scrapeTime.Add(10 * time.Second) }
+			stale := makeEndpoint(test.scrape, test.inFlight[1])
+			require.NoError(t, p.PrepareRequestData(context.Background(), nil, []framework.Endpoint{stale}))
+			assert.Equal(t, test.wantWaiting, stale.GetMetrics().WaitingQueueSize)
+			assert.Equal(t, test.wantRunning, stale.GetMetrics().RunningRequestsSize)
+			assert.InDelta(t, test.wantKVCache, stale.GetMetrics().KVCacheUsagePercent, 1e-9)
+			val, synthetic := stale.Get(attrbackfill.SyntheticMetricsKey)
+			require.True(t, synthetic)
+			assert.Equal(t, test.inFlight[1]-test.inFlight[0], val.(*attrbackfill.SyntheticMetrics).InFlightDelta)
+		})
+	}
+}
+
+func TestPrepareRequestData_NewScrape(t *testing.T) {
+	p := New(DefaultConfig, fwkplugin.NewSharedStore(t.Context(), 0, 0))
+	start := time.Now()
+	p.now = func() time.Time { return start.Add(10 * time.Second) }
+
+	// A scrape first seen stale is the baseline of the later requests.
+	scrape := fwkdl.Metrics{RunningRequestsSize: 4, UpdateTime: start}
+	endpoint := makeEndpoint(scrape, 4)
+	require.NoError(t, p.PrepareRequestData(context.Background(), nil, []framework.Endpoint{endpoint}))
+	assert.Equal(t, 4, endpoint.GetMetrics().RunningRequestsSize)
+
+	// A new scrape replaces the baseline.
+	scrape = fwkdl.Metrics{RunningRequestsSize: 1, UpdateTime: start.Add(5 * time.Second)}
+	endpoint = makeEndpoint(scrape, 6)
+	require.NoError(t, p.PrepareRequestData(context.Background(), nil, []framework.Endpoint{endpoint}))
+	assert.Equal(t, 1, endpoint.GetMetrics().RunningRequestsSize)
+	endpoint = makeEndpoint(scrape, 8)
+	require.NoError(t, p.PrepareRequestData(context.Background(), nil, []framework.Endpoint{endpoint}))
+	assert.Equal(t, 3, endpoint.GetMetrics().RunningRequestsSize)
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orcaload
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	orcav3 "github.com/cncf/xds/go/xds/data/orca/v3"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	attrorca "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/attribute/orca"
+)
+
+const (
+	// LoadReportHeader is the response header, or trailer, carrying the load reports in the TEXT, JSON or BIN format,
+	// e.g. "TEXT cpu_utilization=0.3, named_metrics.kv_cache_usage_perc=0.8".
+	LoadReportHeader = "endpoint-load-metrics"
+	// LoadReportBinHeader is the response header, or trailer, carrying the base64 encoded binary load reports.
+	LoadReportBinHeader = "endpoint-load-metrics-bin"
+)
+
+// parseLoadReport parses the load report carried by the given headers, received at the given time. It returns nil if
+// the headers carry no load report.
+func parseLoadReport(headers map[string]string, receivedAt time.Time) (*attrorca.LoadReport, error) {
+	if value, ok := headers[LoadReportBinHeader]; ok {
+		report, err := parseBinary(value)
+		if err != nil {
+			return nil, err
+		}
+		return toLoadReport(report, receivedAt), nil
+	}
+	value, ok := headers[LoadReportHeader]
+	if !ok {
+		return nil, nil
+	}
+	format, payload, _ := strings.Cut(strings.TrimSpace(value), " ")
+	var report *orcav3.OrcaLoadReport
+	var err error
+	switch strings.ToUpper(format) {
+	case "TEXT":
+		report, err = parseText(payload)
+	case "JSON":
+		report = &orcav3.OrcaLoadReport{}
+		err = protojson.Unmarshal([]byte(payload), report)
+	case "BIN":
+		report, err = parseBinary(payload)
+	default:
+		return nil, fmt.Errorf("unknown load report format %q", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s load report: %w", strings.ToUpper(format), err)
+	}
+	return toLoadReport(report, receivedAt), nil
+}
+
+func parseBinary(value string) (*orcav3.OrcaLoadReport, error) {
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode binary load report: %w", err)
+	}
+	report := &orcav3.OrcaLoadReport{}
+	if err := proto.Unmarshal(raw, report); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal binary load report: %w", err)
+	}
+	return report, nil
+}
+
+// parseText parses the comma-separated key=value pairs of a TEXT load report. The keys of the named metrics, request
+// costs and utilizations are prefixed with "named_metrics.", "request_cost." and "utilization.".
+func parseText(payload string) (*orcav3.OrcaLoadReport, error) {
+	report := &orcav3.OrcaLoadReport{}
+	for _, pair := range strings.Split(payload, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		key, rawValue, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid pair %q, must be key=value", pair)
+		}
+		value, err := strconv.ParseFloat(strings.TrimSpace(rawValue), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of %q: %w", key, err)
+		}
+		key = strings.TrimSpace(key)
+		switch {
+		case key == "cpu_utilization":
+			report.CpuUtilization = value
+		case key == "mem_utilization":
+			report.MemUtilization = value
+		case key == "application_utilization":
+			report.ApplicationUtilization = value
+		case key == "rps_fractional":
+			report.RpsFractional = value
+		case key == "eps":
+			report.Eps = value
+		case strings.HasPrefix(key, "named_metrics."):
+			if report.NamedMetrics == nil {
+				report.NamedMetrics = map[string]float64{}
+			}
+			report.NamedMetrics[strings.TrimPrefix(key, "named_metrics.")] = value
+		case strings.HasPrefix(key, "utilization."):
+			if report.Utilization == nil {
+				report.Utilization = map[string]float64{}
+			}
+			report.Utilization[strings.TrimPrefix(key, "utilization.")] = value
+		case strings.HasPrefix(key, "request_cost."):
+			if report.RequestCost == nil {
+				report.RequestCost = map[string]float64{}
+			}
+			report.RequestCost[strings.TrimPrefix(key, "request_cost.")] = value
+		default:
+			// Unknown keys are ignored, for forward compatibility with new fields.
+		}
+	}
+	return report, nil
+}
+
+func toLoadReport(report *orcav3.OrcaLoadReport, receivedAt time.Time) *attrorca.LoadReport {
+	return &attrorca.LoadReport{
+		ReceivedAt:             receivedAt,
+		CPUUtilization:         report.GetCpuUtilization(),
+		MemUtilization:         report.GetMemUtilization(),
+		ApplicationUtilization: report.GetApplicationUtilization(),
+		RPSFractional:          report.GetRpsFractional(),
+		Utilization:            report.GetUtilization(),
+		NamedMetrics:           report.GetNamedMetrics(),
+	}
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package orcaload provides a data producer ingesting the ORCA (Open Request Cost Aggregation) load reports the model
+// servers attach to the headers or trailers of their responses, so that the load of the endpoints is updated on every
+// response, without the lag of the metrics scrapes.
+package orcaload
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	attrorca "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/attribute/orca"
+)
+
+const (
+	OrcaLoadProducerType = "orca-load-producer"
+
+	// reportKey is the SharedStore key of the last load report of each endpoint, in the pod scope.
+	reportKey = fwkplugin.StateKey(OrcaLoadProducerType + "/report")
+)
+
+var (
+	_ requestcontrol.DataProducer            = &Plugin{}
+	_ requestcontrol.ResponseHeaderProcessor = &Plugin{}
+	_ requestcontrol.PostResponse            = &Plugin{}
+)
+
+type Config struct {
+	// WaitingQueueSizeMetric is the named metric, or named utilization, of the load reports carrying the number of
+	// requests queued by the model server. Default: "num_requests_waiting".
+	WaitingQueueSizeMetric string `json:"waitingQueueSizeMetric,omitempty"`
+	// RunningRequestsSizeMetric is the named metric, or named utilization, of the load reports carrying the number of
+	// requests run by the model server. Default: "num_requests_running".
+	RunningRequestsSizeMetric string `json:"runningRequestsSizeMetric,omitempty"`
+	// KVCacheUsagePercentMetric is the named metric, or named utilization, of the load reports carrying the fraction
+	// of the KV cache in use, in [0, 1]. Default: "kv_cache_usage_perc".
+	KVCacheUsagePercentMetric string `json:"kvCacheUsagePercentMetric,omitempty"`
+	// MaxReportAgeMs is the age from which the last load report of an endpoint is no longer applied. Default: 5000.
+	MaxReportAgeMs int `json:"maxReportAgeMs,omitempty"`
+}
+
+var DefaultConfig = Config{
+	WaitingQueueSizeMetric:    "num_requests_waiting",
+	RunningRequestsSizeMetric: "num_requests_running",
+	KVCacheUsagePercentMetric: "kv_cache_usage_perc",
+	MaxReportAgeMs:            5000,
+}
+
+func (c *Config) validate() error {
+	if c.WaitingQueueSizeMetric == "" || c.RunningRequestsSizeMetric == "" || c.KVCacheUsagePercentMetric == "" {
+		return errors.New("the load report metrics must not be empty")
+	}
+	if c.MaxReportAgeMs <= 0 {
+		return fmt.Errorf("maxReportAgeMs must be > 0, got %d", c.MaxReportAgeMs)
+	}
+	return nil
+}
+
+// storedReport is the last load report of an endpoint, in the SharedStore.
+type storedReport struct {
+	report *attrorca.LoadReport
+}
+
+func (r *storedReport) Clone() fwkplugin.StateData {
+	return &storedReport{report: r.report.Clone().(*attrorca.LoadReport)}
+}
+
+// Plugin records the load reports of the responses of each endpoint, and applies the last one to the metrics of the
+// endpoint when it is newer than the last scrape: the queue, running requests and KV cache metrics of the endpoint
+// are replaced with those carried by the report, when present. The whole report is also put on the endpoints as the
+// LoadReport attribute, for the scorers using the other utilizations or named metrics.
+//
+// The load reports can supplement the scrapes, or replace them when the model servers are not scraped.
+type Plugin struct {
+	typedName fwkplugin.TypedName
+	config    Config
+	maxAge    time.Duration
+	store     *fwkplugin.SharedStore
+	now       func() time.Time
+}
+
+func Factory(name string, rawParameters json.RawMessage, handle fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	config := DefaultConfig
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal config for %s: %w", OrcaLoadProducerType, err)
+		}
+	}
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config for %s: %w", OrcaLoadProducerType, err)
+	}
+
+	return New(config, handle.SharedStore()).WithName(name), nil
+}
+
+// New creates a new ORCA load producer, keeping the last load report of the endpoints in the given store.
+func New(config Config, store *fwkplugin.SharedStore) *Plugin {
+	return &Plugin{
+		typedName: fwkplugin.TypedName{Type: OrcaLoadProducerType, Name: OrcaLoadProducerType},
+		config:    config,
+		maxAge:    time.Duration(config.MaxReportAgeMs) * time.Millisecond,
+		store:     store,
+		now:       time.Now,
+	}
+}
+
+// WithName sets the name of the plugin.
+func (p *Plugin) WithName(name string) *Plugin {
+	p.typedName.Name = name
+	return p
+}
+
+func (p *Plugin) TypedName() fwkplugin.TypedName {
+	return p.typedName
+}
+
+func (p *Plugin) Produces() map[string]any {
+	return map[string]any{
+		attrorca.LoadReportKey: attrorca.LoadReport{},
+	}
+}
+
+func (p *Plugin) Consumes() map[string]any {
+	return nil
+}
+
+// ResponseHeader records the load report carried by the response headers, if any.
+func (p *Plugin) ResponseHeader(ctx context.Context, _ *framework.InferenceRequest, response *requestcontrol.Response,
+	targetEndpoint *fwkdl.EndpointMetadata) {
+	if response != nil {
+		p.record(ctx, response.Headers, targetEndpoint)
+	}
+}
+
+// PostResponse records the load report carried by the response trailers, if any.
+func (p *Plugin) PostResponse(ctx context.Context, _ *framework.InferenceRequest, response *requestcontrol.CompletedResponse,
+	targetEndpoint *fwkdl.EndpointMetadata) {
+	if response != nil {
+		p.record(ctx, response.Trailers, targetEndpoint)
+	}
+}
+
+// record records the load report carried by the given headers as the last report of the given endpoint.
+func (p *Plugin) record(ctx context.Context, headers map[string]string, targetEndpoint *fwkdl.EndpointMetadata) {
+	if targetEndpoint == nil || len(headers) == 0 {
+		return
+	}
+	report, err := parseLoadReport(headers, p.now())
+	if err != nil {
+		log.FromContext(ctx).V(logutil.DEBUG).Info("Ignoring invalid ORCA load report", "endpoint",
+			targetEndpoint.NamespacedName, "error", err.Error())
+		return
+	}
+	if report == nil {
+		return
+	}
+	p.store.Write(fwkplugin.StoreScopePod, targetEndpoint.NamespacedName.String(), reportKey, &storedReport{report: report}, p.maxAge)
+}
+
+// PrepareRequestData applies the last load report of the endpoints to their metrics, when it is newer than their last
+// scrape. The metrics of the endpoints are copies owned by the request.
+func (p *Plugin) PrepareRequestData(ctx context.Context, _ *framework.InferenceRequest, endpoints []framework.Endpoint) error {
+	now := p.now()
+	for _, endpoint := range endpoints {
+		if endpoint.GetMetadata() == nil {
+			continue
+		}
+		stored, err := fwkplugin.ReadSharedStoreKey[*storedReport](p.store, fwkplugin.StoreScopePod,
+			endpoint.GetMetadata().NamespacedName.String(), reportKey)
+		if err != nil || now.Sub(stored.report.ReceivedAt) > p.maxAge {
+			continue
+		}
+		report := stored.report
+		endpoint.Put(attrorca.LoadReportKey, report.Clone())
+		m := endpoint.GetMetrics()
+		if m == nil || !report.ReceivedAt.After(m.UpdateTime) {
+			continue
+		}
+		if value, ok := p.lookup(report, p.config.WaitingQueueSizeMetric); ok {
+			m.WaitingQueueSize = int(value)
+		}
+		if value, ok := p.lookup(report, p.config.RunningRequestsSizeMetric); ok {
+			m.RunningRequestsSize = int(value)
+		}
+		if value, ok := p.lookup(report, p.config.KVCacheUsagePercentMetric); ok {
+			m.KVCacheUsagePercent = min(max(value, 0), 1)
+		}
+		m.UpdateTime = report.ReceivedAt
+		log.FromContext(ctx).V(logutil.TRACE).Info("Applied ORCA load report", "endpoint",
+			endpoint.GetMetadata().NamespacedName, "receivedAt", report.ReceivedAt, "waiting", m.WaitingQueueSize,
+			"running", m.RunningRequestsSize, "kvCache", m.KVCacheUsagePercent)
+	}
+	return nil
+}
+
+// lookup returns the value of the given metric in the given report, looked up in the named metrics, then in the named
+// utilizations.
+func (p *Plugin) lookup(report *attrorca.LoadReport, name string) (float64, bool) {
+	if value, ok := report.NamedMetrics[name]; ok {
+		return value, true
+	}
+	value, ok := report.Utilization[name]
+	return value, ok
+}
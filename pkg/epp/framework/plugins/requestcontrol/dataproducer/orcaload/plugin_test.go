@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orcaload
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	orcav3 "github.com/cncf/xds/go/xds/data/orca/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	attrorca "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/attribute/orca"
+	"sigs.k8s.io/gateway-api-inference-extension/test/utils"
+)
+
+func TestFactory(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  string
+		wantErr bool
+	}{
+		{name: "defaults", params: ``},
+		{name: "custom metrics", params: `{"kvCacheUsagePercentMetric": "kv_cache_utilization", "maxReportAgeMs": 1000}`},
+		{name: "empty metric", params: `{"waitingQueueSizeMetric": ""}`, wantErr: true},
+		{name: "non-positive max age", params: `{"maxReportAgeMs": -1}`, wantErr: true},
+		{name: "malformed json", params: `{`, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := Factory("orca", json.RawMessage(test.params), utils.NewTestHandle(t.Context()))
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "orca", p.TypedName().Name)
+		})
+	}
+}
+
+func TestParseLoadReport(t *testing.T) {
+	receivedAt := time.Now()
+	binary, err := proto.Marshal(&orcav3.OrcaLoadReport{CpuUtilization: 0.5, NamedMetrics: map[string]float64{"num_requests_waiting": 3}})
+	require.NoError(t, err)
+	encoded := base64.StdEncoding.EncodeToString(binary)
+
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    *attrorca.LoadReport
+		wantErr bool
+	}{
+		{
+			name:    "no report",
+			headers: map[string]string{"content-type": "application/json"},
+		},
+		{
+			name: "text",
+			headers: map[string]string{LoadReportHeader: "TEXT cpu_utilization=0.3, mem_utilization=0.4, " +
+				"named_metrics.num_requests_waiting=2, utilization.kv_cache_usage_perc=0.8, unknown=1"},
+			want: &attrorca.LoadReport{ReceivedAt: receivedAt, CPUUtilization: 0.3, MemUtilization: 0.4,
+				NamedMetrics: map[string]float64{"num_requests_waiting": 2},
+				Utilization:  map[string]float64{"kv_cache_usage_perc": 0.8}},
+		},
+		{
+			name:    "json",
+			headers: map[string]string{LoadReportHeader: `JSON {"application_utilization": 0.7, "named_metrics": {"num_requests_running": 5}}`},
+			want: &attrorca.LoadReport{ReceivedAt: receivedAt, ApplicationUtilization: 0.7,
+				NamedMetrics: map[string]float64{"num_requests_running": 5}},
+		},
+		{
+			name:    "bin format",
+			headers: map[string]string{LoadReportHeader: "BIN " + encoded},
+			want: &attrorca.LoadReport{ReceivedAt: receivedAt, CPUUtilization: 0.5,
+				NamedMetrics: map[string]float64{"num_requests_waiting": 3}},
+		},
+		{
+			name:    "bin header",
+			headers: map[string]string{LoadReportBinHeader: encoded},
+			want: &attrorca.LoadReport{ReceivedAt: receivedAt, CPUUtilization: 0.5,
+				NamedMetrics: map[string]float64{"num_requests_waiting": 3}},
+		},
+		{
+			name:    "unknown format",
+			headers: map[string]string{LoadReportHeader: "YAML cpu_utilization: 0.3"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid text value",
+			headers: map[string]string{LoadReportHeader: "TEXT cpu_utilization=high"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid binary",
+			headers: map[string]string{LoadReportBinHeader: "not base64!"},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := parseLoadReport(test.headers, receivedAt)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestPlugin(t *testing.T) {
+	now := time.Now()
+	store := fwkplugin.NewSharedStore(t.Context(), 0, 0)
+	plugin := New(DefaultConfig, store)
+	plugin.now = func() time.Time { return now }
+	metadata := &fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Namespace: "default", Name: "pod1"}}
+	makeEndpoint := func(updateTime time.Time) framework.Endpoint {
+		return framework.NewEndpoint(metadata, &fwkdl.Metrics{WaitingQueueSize: 10, RunningRequestsSize: 8,
+			KVCacheUsagePercent: 0.9, UpdateTime: updateTime}, nil)
+	}
+
+	// Without a report, the scraped metrics are kept.
+	endpoint := makeEndpoint(now.Add(-time.Second))
+	require.NoError(t, plugin.PrepareRequestData(context.Background(), nil, []framework.Endpoint{endpoint}))
+	assert.Equal(t, 10, endpoint.GetMetrics().WaitingQueueSize)
+	_, ok := endpoint.Get(attrorca.LoadReportKey)
+	assert.False(t, ok)
+
+	// A report in the response headers newer than the scrape replaces the reported metrics.
+	plugin.ResponseHeader(context.Background(), nil, &requestcontrol.Response{Headers: map[string]string{
+		LoadReportHeader: "TEXT named_metrics.num_requests_waiting=1, utilization.kv_cache_usage_perc=0.4"}}, metadata)
+	endpoint = makeEndpoint(now.Add(-time.Second))
+	require.NoError(t, plugin.PrepareRequestData(context.Background(), nil, []framework.Endpoint{endpoint}))
+	assert.Equal(t, 1, endpoint.GetMetrics().WaitingQueueSize)
+	assert.Equal(t, 8, endpoint.GetMetrics().RunningRequestsSize)
+	assert.Equal(t, 0.4, endpoint.GetMetrics().KVCacheUsagePercent)
+	assert.Equal(t, now, endpoint.GetMetrics().UpdateTime)
+	val, ok := endpoint.Get(attrorca.LoadReportKey)
+	require.True(t, ok)
+	assert.Equal(t, now, val.(*attrorca.LoadReport).ReceivedAt)
+
+	// A scrape newer than the report is kept.
+	endpoint = makeEndpoint(now.Add(time.Millisecond))
+	require.NoError(t, plugin.PrepareRequestData(context.Background(), nil, []framework.Endpoint{endpoint}))
+	assert.Equal(t, 10, endpoint.GetMetrics().WaitingQueueSize)
+
+	// A report in the response trailers replaces the previous one.
+	now = now.Add(time.Second)
+	plugin.PostResponse(context.Background(), nil, &requestcontrol.CompletedResponse{Trailers: map[string]string{
+		LoadReportHeader: "TEXT named_metrics.num_requests_running=2"}}, metadata)
+	endpoint = makeEndpoint(now.Add(-time.Second))
+	require.NoError(t, plugin.PrepareRequestData(context.Background(), nil, []framework.Endpoint{endpoint}))
+	assert.Equal(t, 10, endpoint.GetMetrics().WaitingQueueSize)
+	assert.Equal(t, 2, endpoint.GetMetrics().RunningRequestsSize)
+
+	// Reports older than the max age are no longer applied.
+	now = now.Add(10 * time.Second)
+	endpoint = makeEndpoint(now.Add(-time.Minute))
+	require.NoError(t, plugin.PrepareRequestData(context.Background(), nil, []framework.Endpoint{endpoint}))
+	assert.Equal(t, 8, endpoint.GetMetrics().RunningRequestsSize)
+}
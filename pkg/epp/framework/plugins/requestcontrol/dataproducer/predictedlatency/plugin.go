@@ -269,6 +269,9 @@ type predictedLatencyCtx struct {
 	avgTPOTSLO float64
 
 	predictionsForScheduling map[string]endpointPredictionResult
+	// predictedViolation is why the request is predicted to violate its latency objectives on its target endpoint,
+	// empty if it is not.
+	predictedViolation string
 
 	prefillTokensAtDispatch          int64
 	prefillTokensAtDispatchOnPrefill int64
@@ -41,6 +41,15 @@ type endpointPredictionResult struct {
 	PrefixCacheScore float64 // Prefix cache score for the pod
 }
 
+const (
+	// predictedViolationCapacity marks the requests for which no candidate endpoint was predicted to meet the latency
+	// objectives: the pool lacks capacity.
+	predictedViolationCapacity = "capacity"
+	// predictedViolationScheduling marks the requests scheduled on an endpoint predicted to violate the latency
+	// objectives while another candidate endpoint was predicted to meet them.
+	predictedViolationScheduling = "scheduling"
+)
+
 // generatePredictions creates prediction results for all candidate pods
 func (s *PredictedLatency) generatePredictions(ctx context.Context, predictedLatencyCtx *predictedLatencyCtx, candidateEndpoints []schedulingtypes.Endpoint) ([]endpointPredictionResult, error) {
 	logger := log.FromContext(ctx)
@@ -165,6 +174,31 @@ func (s *PredictedLatency) validatePrediction(
 	return
 }
 
+// meetsObjectives returns whether the endpoint is predicted to meet the latency objectives declared by the request.
+func (r endpointPredictionResult) meetsObjectives(predictedLatencyCtx *predictedLatencyCtx) bool {
+	return (predictedLatencyCtx.ttftSLO <= 0 || r.TTFTValid) && (predictedLatencyCtx.avgTPOTSLO <= 0 || r.TPOTValid)
+}
+
+// classifyPredictedViolation returns why the request is predicted to violate its latency objectives on the target
+// endpoint: predictedViolationCapacity if no candidate endpoint was predicted to meet them, and
+// predictedViolationScheduling if another one was. It returns an empty string if the request declared no objective,
+// if the target endpoint is predicted to meet them, or if there is no prediction for the target endpoint.
+func classifyPredictedViolation(predictedLatencyCtx *predictedLatencyCtx, targetName string) string {
+	if predictedLatencyCtx.ttftSLO <= 0 && predictedLatencyCtx.avgTPOTSLO <= 0 {
+		return ""
+	}
+	target, ok := predictedLatencyCtx.predictionsForScheduling[targetName]
+	if !ok || target.meetsObjectives(predictedLatencyCtx) {
+		return ""
+	}
+	for _, pred := range predictedLatencyCtx.predictionsForScheduling {
+		if pred.meetsObjectives(predictedLatencyCtx) {
+			return predictedViolationScheduling
+		}
+	}
+	return predictedViolationCapacity
+}
+
 // hasPrefillRole returns true if the endpoint has the prefill role label set.
 func hasPrefillRole(roleLabel string, endpoint schedulingtypes.Endpoint) bool {
 	if roleLabel == "" {
@@ -229,3 +229,62 @@ func TestUpdateRequestContextWithPredictions(t *testing.T) {
 	assert.Equal(t, 50.0, ctx.predictionsForScheduling["pod1"].TTFT)
 	assert.Equal(t, 80.0, ctx.predictionsForScheduling["pod2"].TTFT)
 }
+
+func TestClassifyPredictedViolation(t *testing.T) {
+	meets := endpointPredictionResult{TTFTValid: true, TPOTValid: true, IsValid: true}
+	slowTTFT := endpointPredictionResult{TTFTValid: false, TPOTValid: true}
+	slowTPOT := endpointPredictionResult{TTFTValid: true, TPOTValid: false}
+
+	tests := []struct {
+		name        string
+		ttftSLO     float64
+		tpotSLO     float64
+		predictions map[string]endpointPredictionResult
+		want        string
+	}{
+		{
+			name:        "no objective",
+			predictions: map[string]endpointPredictionResult{"pod1": slowTTFT},
+			want:        "",
+		},
+		{
+			name:        "target meets the objectives",
+			ttftSLO:     100,
+			tpotSLO:     50,
+			predictions: map[string]endpointPredictionResult{"pod1": meets, "pod2": slowTTFT},
+			want:        "",
+		},
+		{
+			name:        "no prediction for the target",
+			ttftSLO:     100,
+			predictions: map[string]endpointPredictionResult{"pod2": slowTTFT},
+			want:        "",
+		},
+		{
+			name:        "another endpoint meets the objectives",
+			ttftSLO:     100,
+			tpotSLO:     50,
+			predictions: map[string]endpointPredictionResult{"pod1": slowTPOT, "pod2": meets},
+			want:        predictedViolationScheduling,
+		},
+		{
+			name:        "no endpoint meets the objectives",
+			ttftSLO:     100,
+			tpotSLO:     50,
+			predictions: map[string]endpointPredictionResult{"pod1": slowTTFT, "pod2": slowTPOT},
+			want:        predictedViolationCapacity,
+		},
+		{
+			name:        "only the declared objective is checked",
+			tpotSLO:     50,
+			predictions: map[string]endpointPredictionResult{"pod1": slowTTFT},
+			want:        "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &predictedLatencyCtx{ttftSLO: tt.ttftSLO, avgTPOTSLO: tt.tpotSLO, predictionsForScheduling: tt.predictions}
+			assert.Equal(t, tt.want, classifyPredictedViolation(ctx, "pod1"))
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	"google.golang.org/protobuf/types/known/structpb"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -34,6 +35,13 @@ import (
 	latencypredictor "sigs.k8s.io/gateway-api-inference-extension/sidecars/latencypredictorasync"
 )
 
+const (
+	// predictedViolationMetadataNamespace and predictedViolationMetadataKey locate the predicted violation of the latency
+	// objectives of a request in the dynamic metadata of its response.
+	predictedViolationMetadataNamespace = "envoy.lb"
+	predictedViolationMetadataKey       = "predicted-slo-violation"
+)
+
 var _ requestcontrol.PreRequest = &PredictedLatency{}
 var _ requestcontrol.ResponseHeaderProcessor = &PredictedLatency{}
 var _ requestcontrol.ResponseBodyProcessor = &PredictedLatency{}
@@ -108,6 +116,7 @@ func (t *PredictedLatency) PreRequest(ctx context.Context, request *schedulingty
 	predictedLatencyCtx.decodeTokensAtDispatch = 0
 
 	processPreRequestForLatencyPrediction(ctx, predictedLatencyCtx)
+	recordPredictedViolation(ctx, predictedLatencyCtx, id)
 }
 
 func (t *PredictedLatency) ResponseHeader(ctx context.Context, request *schedulingtypes.InferenceRequest, response *requestcontrol.Response, targetMetadata *fwkdl.EndpointMetadata) {
@@ -215,6 +224,10 @@ func (t *PredictedLatency) ResponseBody(ctx context.Context, request *scheduling
 			t.decrementEndpointCounter(&t.prefillTokensInFlight, decodePodKey, int64(predictedLatencyCtx.inputTokenCount))
 		}
 
+		if predictedLatencyCtx.predictedViolation != "" {
+			setPredictedViolationMetadata(response, predictedLatencyCtx.predictedViolation)
+		}
+
 		id := request.Headers[reqcommon.RequestIdHeaderKey]
 		t.removeRequestFromQueue(id, predictedLatencyCtx)
 		t.deletePredictedLatencyContextForRequest(request)
@@ -250,6 +263,44 @@ func processPreRequestForLatencyPrediction(ctx context.Context, predictedLatency
 	predictedLatencyCtx.lastTokenTimestamp = time.Now()
 }
 
+// recordPredictedViolation flags the request if it is predicted to violate its latency objectives on its target
+// endpoint, so that capacity problems can be told apart from scheduling problems: the violation is counted, logged
+// and reported in the dynamic metadata of the response.
+func recordPredictedViolation(ctx context.Context, predictedLatencyCtx *predictedLatencyCtx, requestID string) {
+	targetName := predictedLatencyCtx.targetMetadata.NamespacedName.Name
+	predictedLatencyCtx.predictedViolation = classifyPredictedViolation(predictedLatencyCtx, targetName)
+	if predictedLatencyCtx.predictedViolation == "" {
+		return
+	}
+	pred := predictedLatencyCtx.predictionsForScheduling[targetName]
+	metrics.RecordRequestPredictedSLOViolation(predictedLatencyCtx.incomingModelName,
+		predictedLatencyCtx.schedulingRequest.TargetModel, predictedLatencyCtx.predictedViolation)
+	log.FromContext(ctx).V(logutil.DEFAULT).Info("Request scheduled on an endpoint predicted to violate its latency objectives",
+		"requestID", requestID, "endpoint", targetName, "reason", predictedLatencyCtx.predictedViolation,
+		"predictedTTFT", pred.TTFT, "ttftSLO", predictedLatencyCtx.ttftSLO,
+		"predictedTPOT", pred.TPOT, "tpotSLO", predictedLatencyCtx.avgTPOTSLO)
+}
+
+// setPredictedViolationMetadata reports the predicted violation of the latency objectives of the request in the
+// dynamic metadata of the response, e.g. for access logs.
+func setPredictedViolationMetadata(response *requestcontrol.Response, reason string) {
+	if response.DynamicMetadata == nil {
+		response.DynamicMetadata = &structpb.Struct{}
+	}
+	if response.DynamicMetadata.Fields == nil {
+		response.DynamicMetadata.Fields = make(map[string]*structpb.Value)
+	}
+	namespace := response.DynamicMetadata.Fields[predictedViolationMetadataNamespace].GetStructValue()
+	if namespace == nil {
+		namespace = &structpb.Struct{}
+		response.DynamicMetadata.Fields[predictedViolationMetadataNamespace] = structpb.NewStructValue(namespace)
+	}
+	if namespace.Fields == nil {
+		namespace.Fields = make(map[string]*structpb.Value)
+	}
+	namespace.Fields[predictedViolationMetadataKey] = structpb.NewStringValue(reason)
+}
+
 // processFirstTokenForLatencyPrediction records actual TTFT, trains, predicts first TPOT.
 func processFirstTokenForLatencyPrediction(
 	ctx context.Context,
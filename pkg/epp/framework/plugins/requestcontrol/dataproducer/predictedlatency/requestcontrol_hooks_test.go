@@ -28,6 +28,7 @@ import (
 	"github.com/jellydator/ttlcache/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	reqcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/request"
 	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
@@ -557,6 +558,38 @@ func TestPredictedLatency_StreamingMode_ResponseBody_FinalToken_Success(t *testi
 	assert.Equal(t, 0, queue.Len())
 }
 
+func TestPredictedLatency_PredictedViolation(t *testing.T) {
+	router := createTestRouter()
+	router.latencypredictor = new(mockPredictor)
+
+	ctx := context.Background()
+	endpoint := createTestEndpoint("test-pod", 1, 1, 1)
+	request := createTestInferenceRequest("test", 100, 50)
+
+	predictedLatencyCtx := newPredictedLatencyContext(request)
+	predictedLatencyCtx.ttftSLO = 100
+	predictedLatencyCtx.avgTPOTSLO = 50
+	predictedLatencyCtx.predictionsForScheduling = map[string]endpointPredictionResult{
+		"test-pod":  {TTFT: 150, TTFTValid: false, TPOTValid: true},
+		"other-pod": {TTFT: 200, TTFTValid: false, TPOTValid: true},
+	}
+	router.setPredictedLatencyContextForRequest(request, predictedLatencyCtx)
+
+	router.PreRequest(ctx, request, createTestSchedulingResult(endpoint.GetMetadata()))
+	assert.Equal(t, predictedViolationCapacity, predictedLatencyCtx.predictedViolation)
+
+	// The predicted violation is reported in the dynamic metadata of the response, next to the existing metadata.
+	response := &requestcontrol.Response{EndOfStream: true, DynamicMetadata: &structpb.Struct{Fields: map[string]*structpb.Value{
+		predictedViolationMetadataNamespace: structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+			"other": structpb.NewNumberValue(1),
+		}}),
+	}}}
+	router.ResponseBody(ctx, request, response, endpoint.GetMetadata())
+	fields := response.DynamicMetadata.Fields[predictedViolationMetadataNamespace].GetStructValue().GetFields()
+	assert.Equal(t, predictedViolationCapacity, fields[predictedViolationMetadataKey].GetStringValue())
+	assert.Equal(t, 1.0, fields["other"].GetNumberValue())
+}
+
 func TestPredictedLatency_StreamingMode_ResponseBody_FinalToken_NilPredictor(t *testing.T) {
 	router := createTestRouter()
 	router.latencypredictor = nil
@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package headers provides a plugin setting and removing headers of the requests sent to the model servers, e.g. to
+// inject the selected pod or other routing hints.
+package headers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+const PluginType = "header-mutator"
+
+var _ requestcontrol.RequestMutator = &Plugin{}
+
+type Config struct {
+	// Set maps the headers to set on the request to their value. Values are Go templates executed on TemplateData,
+	// e.g. "{{ .PodName }}". A header whose value renders empty is not set.
+	Set map[string]string `json:"set,omitempty"`
+	// Remove lists the headers to remove from the request.
+	Remove []string `json:"remove,omitempty"`
+}
+
+func (c *Config) validate() error {
+	if len(c.Set) == 0 && len(c.Remove) == 0 {
+		return errors.New("at least one header to set or remove must be configured")
+	}
+	for key := range c.Set {
+		if key == "" {
+			return errors.New("set must not contain an empty header name")
+		}
+	}
+	for _, key := range c.Remove {
+		if key == "" {
+			return errors.New("remove must not contain an empty header name")
+		}
+	}
+	return nil
+}
+
+// TemplateData is the data the header value templates are executed on.
+type TemplateData struct {
+	// RequestID is the ID of the request.
+	RequestID string
+	// Model is the target model of the request.
+	Model string
+	// PodName, Namespace, Address and Port identify the endpoint the request is sent to.
+	PodName   string
+	Namespace string
+	Address   string
+	Port      string
+	// Labels are the labels of the endpoint the request is sent to.
+	Labels map[string]string
+	// Headers are the headers of the request.
+	Headers map[string]string
+}
+
+// Plugin sets and removes headers of the requests sent to the model servers. The values of the headers set are
+// templates, so that they can carry the scheduling decision, e.g. the selected pod, as routing hints.
+type Plugin struct {
+	typedName fwkplugin.TypedName
+	remove    []string
+	set       map[string]*template.Template
+}
+
+// Factory creates a new header mutator plugin from the given parameters.
+func Factory(name string, rawParameters json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	config := Config{}
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal config for %s: %w", PluginType, err)
+		}
+	}
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config for %s: %w", PluginType, err)
+	}
+	p, err := New(config)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config for %s: %w", PluginType, err)
+	}
+	return p.WithName(name), nil
+}
+
+// New creates a new header mutator plugin. It returns an error if a header value is not a valid template.
+func New(config Config) (*Plugin, error) {
+	set := make(map[string]*template.Template, len(config.Set))
+	for key, value := range config.Set {
+		tmpl, err := template.New(key).Option("missingkey=zero").Parse(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of header %q: %w", key, err)
+		}
+		set[key] = tmpl
+	}
+	return &Plugin{
+		typedName: fwkplugin.TypedName{Type: PluginType, Name: PluginType},
+		remove:    config.Remove,
+		set:       set,
+	}, nil
+}
+
+func (p *Plugin) WithName(name string) *Plugin {
+	p.typedName.Name = name
+	return p
+}
+
+func (p *Plugin) TypedName() fwkplugin.TypedName {
+	return p.typedName
+}
+
+// MutateRequest removes the configured headers, then sets the configured ones with their rendered values.
+func (p *Plugin) MutateRequest(_ context.Context, request *framework.InferenceRequest, mutation *requestcontrol.RequestMutation) error {
+	mutation.RemoveHeaders = append(mutation.RemoveHeaders, p.remove...)
+	for _, key := range p.remove {
+		for existing := range mutation.SetHeaders {
+			if strings.EqualFold(existing, key) {
+				delete(mutation.SetHeaders, existing)
+			}
+		}
+	}
+	if len(p.set) == 0 {
+		return nil
+	}
+	if mutation.SetHeaders == nil {
+		mutation.SetHeaders = map[string]string{}
+	}
+
+	data := TemplateData{}
+	if request != nil {
+		data.RequestID = request.RequestId
+		data.Model = request.TargetModel
+		data.Headers = request.Headers
+	}
+	if endpoint := mutation.TargetEndpoint; endpoint != nil {
+		data.PodName = endpoint.PodName
+		data.Namespace = endpoint.NamespacedName.Namespace
+		data.Address = endpoint.Address
+		data.Port = endpoint.Port
+		data.Labels = endpoint.Labels
+	}
+
+	var value strings.Builder
+	for key, tmpl := range p.set {
+		value.Reset()
+		if err := tmpl.Execute(&value, data); err != nil {
+			return fmt.Errorf("failed to render the value of header %q: %w", key, err)
+		}
+		if value.Len() > 0 {
+			mutation.SetHeaders[key] = value.String()
+		}
+	}
+	return nil
+}
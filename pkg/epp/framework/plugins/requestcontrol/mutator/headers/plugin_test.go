@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package headers
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+func TestFactory(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  string
+		wantErr bool
+	}{
+		{name: "set and remove", params: `{"set": {"x-target-pod": "{{ .PodName }}"}, "remove": ["x-internal"]}`},
+		{name: "no header", params: `{}`, wantErr: true},
+		{name: "empty header name", params: `{"remove": [""]}`, wantErr: true},
+		{name: "invalid template", params: `{"set": {"x-target-pod": "{{ .PodName"}}`, wantErr: true},
+		{name: "malformed json", params: `{`, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := Factory("headers", json.RawMessage(test.params), nil)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "headers", p.TypedName().Name)
+		})
+	}
+}
+
+func TestMutateRequest(t *testing.T) {
+	p, err := New(Config{
+		Set: map[string]string{
+			"x-target-pod":  "{{ .Namespace }}/{{ .PodName }}",
+			"x-target-zone": `{{ index .Labels "topology.kubernetes.io/zone" }}`,
+			"x-model":       "{{ .Model }}",
+			"x-tenant":      `{{ index .Headers "x-tenant" }}`,
+			"x-empty":       `{{ index .Labels "missing" }}`,
+		},
+		Remove: []string{"x-internal", "X-Previous"},
+	})
+	require.NoError(t, err)
+
+	request := &framework.InferenceRequest{
+		RequestId:   "req",
+		TargetModel: "llama",
+		Headers:     map[string]string{"x-tenant": "team-a"},
+	}
+	mutation := &requestcontrol.RequestMutation{
+		TargetEndpoint: &fwkdl.EndpointMetadata{
+			NamespacedName: k8stypes.NamespacedName{Namespace: "default", Name: "pod1-rank-0"},
+			PodName:        "pod1",
+			Labels:         map[string]string{"topology.kubernetes.io/zone": "us-east1-b"},
+		},
+		// Set by a previous mutator, then removed.
+		SetHeaders: map[string]string{"x-previous": "value"},
+	}
+
+	require.NoError(t, p.MutateRequest(context.Background(), request, mutation))
+	assert.Equal(t, map[string]string{
+		"x-target-pod":  "default/pod1",
+		"x-target-zone": "us-east1-b",
+		"x-model":       "llama",
+		"x-tenant":      "team-a",
+	}, mutation.SetHeaders)
+	assert.Equal(t, []string{"x-internal", "X-Previous"}, mutation.RemoveHeaders)
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package modelalias provides a plugin rewriting the model field of the requests sent to the model servers, for
+// models served under another name than the one requested.
+package modelalias
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	fwkrh "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+const (
+	PluginType = "model-alias-mutator"
+
+	modelField = "model"
+)
+
+var _ requestcontrol.RequestMutator = &Plugin{}
+
+type Config struct {
+	// Aliases maps target models to the name the model servers serve them under.
+	Aliases map[string]string `json:"aliases"`
+}
+
+func (c *Config) validate() error {
+	if len(c.Aliases) == 0 {
+		return errors.New("aliases must not be empty")
+	}
+	for model, alias := range c.Aliases {
+		if model == "" || alias == "" {
+			return fmt.Errorf("aliases must not contain empty model names, got %q: %q", model, alias)
+		}
+	}
+	return nil
+}
+
+// Plugin rewrites the model field of the body of the requests sent to the model servers, from the target model to
+// the name the model servers serve it under. It only applies to requests whose body was parsed into a map; other
+// requests are sent as is.
+//
+// The target model used for scheduling, metrics and the other plugins is not changed.
+type Plugin struct {
+	typedName fwkplugin.TypedName
+	config    Config
+}
+
+// Factory creates a new model alias mutator plugin from the given parameters.
+func Factory(name string, rawParameters json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	config := Config{}
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal config for %s: %w", PluginType, err)
+		}
+	}
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config for %s: %w", PluginType, err)
+	}
+	return New(config).WithName(name), nil
+}
+
+// New creates a new model alias mutator plugin.
+func New(config Config) *Plugin {
+	return &Plugin{
+		typedName: fwkplugin.TypedName{Type: PluginType, Name: PluginType},
+		config:    config,
+	}
+}
+
+func (p *Plugin) WithName(name string) *Plugin {
+	p.typedName.Name = name
+	return p
+}
+
+func (p *Plugin) TypedName() fwkplugin.TypedName {
+	return p.typedName
+}
+
+// MutateRequest rewrites the model field of the request body with the alias of the target model, if any.
+func (p *Plugin) MutateRequest(ctx context.Context, request *framework.InferenceRequest, _ *requestcontrol.RequestMutation) error {
+	if request == nil || request.Body == nil {
+		return nil
+	}
+	alias, ok := p.config.Aliases[request.TargetModel]
+	if !ok {
+		return nil
+	}
+	payload, ok := request.Body.Payload.(fwkrh.PayloadMap)
+	if !ok {
+		log.FromContext(ctx).V(logutil.DEBUG).Info("Cannot rewrite the model of a request with an unparsed body",
+			"model", request.TargetModel, "alias", alias)
+		return nil
+	}
+	payload[modelField] = alias
+	return nil
+}
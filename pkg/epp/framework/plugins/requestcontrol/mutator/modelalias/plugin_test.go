@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modelalias
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	fwkrh "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+func TestFactory(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  string
+		wantErr bool
+	}{
+		{name: "aliases", params: `{"aliases": {"llama": "meta-llama/Llama-3.1-8B-Instruct"}}`},
+		{name: "no alias", params: `{}`, wantErr: true},
+		{name: "empty alias", params: `{"aliases": {"llama": ""}}`, wantErr: true},
+		{name: "malformed json", params: `{`, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := Factory("alias", json.RawMessage(test.params), nil)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "alias", p.TypedName().Name)
+		})
+	}
+}
+
+func TestMutateRequest(t *testing.T) {
+	p := New(Config{Aliases: map[string]string{"llama": "meta-llama/Llama-3.1-8B-Instruct"}})
+
+	tests := []struct {
+		name      string
+		model     string
+		payload   fwkrh.RequestPayload
+		wantModel any
+	}{
+		{
+			name:      "aliased model",
+			model:     "llama",
+			payload:   fwkrh.PayloadMap{"model": "llama"},
+			wantModel: "meta-llama/Llama-3.1-8B-Instruct",
+		},
+		{
+			name:      "model without alias",
+			model:     "mistral",
+			payload:   fwkrh.PayloadMap{"model": "mistral"},
+			wantModel: "mistral",
+		},
+		{
+			name:    "unparsed body",
+			model:   "llama",
+			payload: fwkrh.RawPayload(`{"model": "llama"}`),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := &framework.InferenceRequest{TargetModel: test.model, Body: &fwkrh.InferenceRequestBody{Payload: test.payload}}
+			require.NoError(t, p.MutateRequest(context.Background(), request, &requestcontrol.RequestMutation{}))
+			if payload, ok := test.payload.(fwkrh.PayloadMap); ok {
+				assert.Equal(t, test.wantModel, payload["model"])
+			}
+			assert.Equal(t, test.model, request.TargetModel, "the target model must not change")
+		})
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package responseheaders provides a plugin stamping the responses with the scheduling decision, i.e. the endpoint,
+// the profile and the objective of the request, for the routing tiers downstream of the gateway.
+package responseheaders
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metadata"
+	requtil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/request"
+)
+
+const (
+	PluginType = "response-header-stamper"
+
+	// digestBytes is the number of bytes of the digests of the redacted values.
+	digestBytes = 8
+)
+
+var _ requestcontrol.ResponseHeaderProcessor = &Plugin{}
+
+type Config struct {
+	// EndpointHeader is the response header stamped with the "<namespace>/<name>" of the endpoint that served the
+	// request. Empty disables it.
+	EndpointHeader string `json:"endpointHeader,omitempty"`
+	// ProfileHeader is the response header stamped with the primary scheduling profile of the request. Empty disables
+	// it.
+	ProfileHeader string `json:"profileHeader,omitempty"`
+	// ObjectiveHeader is the response header stamped with the InferenceObjective of the request. Empty disables it.
+	ObjectiveHeader string `json:"objectiveHeader,omitempty"`
+	// Objectives restricts the stamping to the requests of the given InferenceObjectives. Default: all the requests.
+	Objectives []string `json:"objectives,omitempty"`
+	// Redact stamps opaque digests of the values instead of the values, so that the downstream tiers can implement
+	// affinity on them without learning the topology of the pool. Default: false.
+	Redact bool `json:"redact,omitempty"`
+	// RedactionKey is the key of the HMAC digests of the redacted values. Without a key, the digests of values of low
+	// cardinality, such as pod names, can be reversed by enumeration.
+	RedactionKey string `json:"redactionKey,omitempty"`
+}
+
+func (c *Config) validate() error {
+	headers := []string{c.EndpointHeader, c.ProfileHeader, c.ObjectiveHeader}
+	if !slices.ContainsFunc(headers, func(header string) bool { return header != "" }) {
+		return errors.New("at least one of endpointHeader, profileHeader and objectiveHeader must be set")
+	}
+	for _, header := range headers {
+		if requtil.IsSystemOwnedHeader(header) {
+			return fmt.Errorf("header %q is system-owned and cannot be stamped", header)
+		}
+	}
+	if c.RedactionKey != "" && !c.Redact {
+		return errors.New("redactionKey requires redact")
+	}
+	return nil
+}
+
+// Plugin stamps the responses with the endpoint that served the request, its primary scheduling profile and its
+// InferenceObjective, so that CDNs, API gateways and client-side routers downstream can implement their own affinity
+// or debugging without parsing the logs of the EPP.
+type Plugin struct {
+	typedName  fwkplugin.TypedName
+	config     Config
+	objectives map[string]bool
+}
+
+// Factory creates a new response header stamper plugin from the given parameters.
+func Factory(name string, rawParameters json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	config := Config{}
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal config for %s: %w", PluginType, err)
+		}
+	}
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config for %s: %w", PluginType, err)
+	}
+	return New(config).WithName(name), nil
+}
+
+// New creates a new response header stamper plugin.
+func New(config Config) *Plugin {
+	var objectives map[string]bool
+	if len(config.Objectives) > 0 {
+		objectives = make(map[string]bool, len(config.Objectives))
+		for _, objective := range config.Objectives {
+			objectives[objective] = true
+		}
+	}
+	return &Plugin{
+		typedName:  fwkplugin.TypedName{Type: PluginType, Name: PluginType},
+		config:     config,
+		objectives: objectives,
+	}
+}
+
+func (p *Plugin) WithName(name string) *Plugin {
+	p.typedName.Name = name
+	return p
+}
+
+func (p *Plugin) TypedName() fwkplugin.TypedName {
+	return p.typedName
+}
+
+// ResponseHeader stamps the configured headers on the response of the requests of the configured objectives. Headers
+// whose value is unknown, e.g. the objective of a request that declared none, are not stamped.
+func (p *Plugin) ResponseHeader(_ context.Context, request *framework.InferenceRequest, response *requestcontrol.Response,
+	targetEndpoint *fwkdl.EndpointMetadata) {
+	if request == nil || response == nil || response.Headers == nil {
+		return
+	}
+	objective := request.Headers[metadata.ObjectiveKey]
+	if p.objectives != nil && !p.objectives[objective] {
+		return
+	}
+	if targetEndpoint != nil {
+		p.stamp(response, p.config.EndpointHeader, targetEndpoint.NamespacedName.String())
+	}
+	if request.SchedulingResult != nil {
+		p.stamp(response, p.config.ProfileHeader, request.SchedulingResult.PrimaryProfileName)
+	}
+	p.stamp(response, p.config.ObjectiveHeader, objective)
+}
+
+func (p *Plugin) stamp(response *requestcontrol.Response, header, value string) {
+	if header == "" || value == "" {
+		return
+	}
+	if p.config.Redact {
+		value = p.digest(value)
+	}
+	response.Headers[header] = value
+}
+
+// digest returns an opaque, stable digest of the given value.
+func (p *Plugin) digest(value string) string {
+	mac := hmac.New(sha256.New, []byte(p.config.RedactionKey))
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil)[:digestBytes])
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package responseheaders
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metadata"
+)
+
+func TestResponseHeader(t *testing.T) {
+	endpoint := &fwkdl.EndpointMetadata{NamespacedName: types.NamespacedName{Namespace: "default", Name: "pod1"}}
+	allHeaders := Config{EndpointHeader: "x-endpoint", ProfileHeader: "x-profile", ObjectiveHeader: "x-objective"}
+	redacted := allHeaders
+	redacted.Redact = true
+	redacted.RedactionKey = "key"
+
+	tests := []struct {
+		name      string
+		config    Config
+		objective string
+		want      map[string]string
+	}{
+		{
+			name:      "all headers",
+			config:    allHeaders,
+			objective: "chat",
+			want:      map[string]string{"x-endpoint": "default/pod1", "x-profile": "decode", "x-objective": "chat"},
+		},
+		{
+			name:   "no objective",
+			config: allHeaders,
+			want:   map[string]string{"x-endpoint": "default/pod1", "x-profile": "decode"},
+		},
+		{
+			name:      "objective stamped",
+			config:    Config{EndpointHeader: "x-endpoint", Objectives: []string{"chat"}},
+			objective: "chat",
+			want:      map[string]string{"x-endpoint": "default/pod1"},
+		},
+		{
+			name:      "objective not stamped",
+			config:    Config{EndpointHeader: "x-endpoint", Objectives: []string{"chat"}},
+			objective: "batch",
+			want:      map[string]string{},
+		},
+		{
+			name:      "redacted",
+			config:    redacted,
+			objective: "chat",
+			want: map[string]string{
+				"x-endpoint":  New(redacted).digest("default/pod1"),
+				"x-profile":   New(redacted).digest("decode"),
+				"x-objective": New(redacted).digest("chat"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := &framework.InferenceRequest{
+				Headers:          map[string]string{},
+				SchedulingResult: &framework.SchedulingResult{PrimaryProfileName: "decode"},
+			}
+			if test.objective != "" {
+				request.Headers[metadata.ObjectiveKey] = test.objective
+			}
+			response := &requestcontrol.Response{Headers: map[string]string{}}
+			New(test.config).ResponseHeader(context.Background(), request, response, endpoint)
+			assert.Equal(t, test.want, response.Headers)
+		})
+	}
+}
+
+func TestDigest(t *testing.T) {
+	plugin := New(Config{EndpointHeader: "x-endpoint", Redact: true, RedactionKey: "key"})
+	digest := plugin.digest("default/pod1")
+	assert.Len(t, digest, 2*digestBytes)
+	assert.Equal(t, digest, plugin.digest("default/pod1"), "digests are stable")
+	assert.NotEqual(t, digest, plugin.digest("default/pod2"))
+	assert.NotEqual(t, digest, New(Config{EndpointHeader: "x-endpoint", Redact: true}).digest("default/pod1"),
+		"digests depend on the key")
+}
+
+func TestFactory(t *testing.T) {
+	plugin, err := Factory("stamper", json.RawMessage(`{"endpointHeader": "x-endpoint", "objectives": ["chat"]}`), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "stamper", plugin.TypedName().Name)
+	assert.Equal(t, map[string]bool{"chat": true}, plugin.(*Plugin).objectives)
+
+	_, err = Factory("stamper", nil, nil)
+	assert.Error(t, err, "no header")
+	_, err = Factory("stamper", json.RawMessage(`{"endpointHeader": "x-gateway-destination-endpoint"}`), nil)
+	assert.Error(t, err, "system-owned header")
+	_, err = Factory("stamper", json.RawMessage(`{"endpointHeader": "x-endpoint", "redactionKey": "key"}`), nil)
+	assert.Error(t, err, "redaction key without redaction")
+}
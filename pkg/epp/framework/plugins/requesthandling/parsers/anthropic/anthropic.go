@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package anthropic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	v1 "sigs.k8s.io/gateway-api-inference-extension/api/v1"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	fwkrh "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
+)
+
+const (
+	AnthropicParserType = "anthropic-parser"
+
+	streamingRespPrefix = "data:"
+	// messageDeltaEvent is the streamed event carrying the cumulative usage of the response.
+	messageDeltaEvent = "message_delta"
+
+	contentType     = "content-type"
+	eventStreamType = "text/event-stream"
+)
+
+// compile-time type validation
+var _ fwkrh.Parser = &AnthropicParser{}
+
+// AnthropicParser implements the fwkrh.Parser interface for the Anthropic Messages API
+// https://docs.anthropic.com/en/api/messages
+type AnthropicParser struct {
+	typedName fwkplugin.TypedName
+}
+
+// NewAnthropicParser creates a new AnthropicParser.
+func NewAnthropicParser() *AnthropicParser {
+	return &AnthropicParser{
+		typedName: fwkplugin.TypedName{
+			Type: AnthropicParserType,
+			Name: AnthropicParserType,
+		},
+	}
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *AnthropicParser) TypedName() fwkplugin.TypedName {
+	return p.typedName
+}
+
+func (p *AnthropicParser) SupportedAppProtocols() []v1.AppProtocol {
+	return []v1.AppProtocol{v1.AppProtocolH2C, v1.AppProtocolHTTP}
+}
+
+func AnthropicParserPluginFactory(name string, _ json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	return NewAnthropicParser().WithName(name), nil
+}
+
+func (p *AnthropicParser) WithName(name string) *AnthropicParser {
+	p.typedName.Name = name
+	return p
+}
+
+// ParseRequest parses the messages request body. The model name is kept in the payload map, where it can be
+// rewritten.
+func (p *AnthropicParser) ParseRequest(ctx context.Context, body []byte, headers map[string]string) (*fwkrh.InferenceRequestBody, error) {
+	bodyMap := make(map[string]any)
+	if err := json.Unmarshal(body, &bodyMap); err != nil {
+		return nil, fmt.Errorf("error unmarshaling request bodyMap: %w", err)
+	}
+	var messages fwkrh.AnthropicMessagesRequest
+	if err := json.Unmarshal(body, &messages); err != nil || len(messages.Messages) == 0 {
+		return nil, errors.New("invalid messages request: must have valid messages field")
+	}
+	extractedBody := &fwkrh.InferenceRequestBody{
+		AnthropicMessages: &messages,
+		Payload:           fwkrh.PayloadMap(bodyMap),
+	}
+	if stream, ok := bodyMap["stream"].(bool); ok && stream {
+		extractedBody.Stream = true
+	}
+	return extractedBody, nil
+}
+
+// ParseResponse extracts usage metadata from the response.
+// It automatically detects and handles both standard JSON responses and SSE streams.
+func (p *AnthropicParser) ParseResponse(ctx context.Context, body []byte, headers map[string]string, _ bool) (*fwkrh.ParsedResponse, error) {
+	if len(body) == 0 {
+		return nil, nil
+	}
+
+	for k, v := range headers {
+		if strings.ToLower(k) == contentType && strings.Contains(strings.ToLower(v), eventStreamType) {
+			return &fwkrh.ParsedResponse{Usage: extractUsageStreaming(string(body))}, nil
+		}
+	}
+
+	var response struct {
+		Usage *anthropicUsage `json:"usage"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+	if response.Usage == nil {
+		return &fwkrh.ParsedResponse{}, nil
+	}
+	return &fwkrh.ParsedResponse{Usage: response.Usage.toUsage()}, nil
+}
+
+// anthropicUsage is the usage of a messages response. The input tokens exclude the tokens read from or written to the
+// prompt cache.
+type anthropicUsage struct {
+	InputTokens              int `json:"input_tokens"`
+	OutputTokens             int `json:"output_tokens"`
+	CacheCreationInputTokens int `json:"cache_creation_input_tokens"`
+	CacheReadInputTokens     int `json:"cache_read_input_tokens"`
+}
+
+func (u *anthropicUsage) toUsage() *fwkrh.Usage {
+	promptTokens := u.InputTokens + u.CacheCreationInputTokens + u.CacheReadInputTokens
+	usage := &fwkrh.Usage{
+		PromptTokens:     promptTokens,
+		CompletionTokens: u.OutputTokens,
+		TotalTokens:      promptTokens + u.OutputTokens,
+	}
+	if u.CacheReadInputTokens > 0 {
+		usage.PromptTokenDetails = &fwkrh.PromptTokenDetails{CachedTokens: u.CacheReadInputTokens}
+	}
+	return usage
+}
+
+// extractUsageStreaming extracts the usage of a streamed response from its message_delta event, whose usage is
+// cumulative:
+//
+//	event: message_delta
+//	data: {"type":"message_delta","delta":{"stop_reason":"end_turn"},"usage":{"input_tokens":25,"output_tokens":15}}
+//
+// The usage of the message_start event is ignored, as its output tokens are not final.
+func extractUsageStreaming(responseText string) *fwkrh.Usage {
+	for line := range strings.SplitSeq(responseText, "\n") {
+		content, found := strings.CutPrefix(line, streamingRespPrefix)
+		if !found {
+			continue
+		}
+		var event struct {
+			Type  string          `json:"type"`
+			Usage *anthropicUsage `json:"usage"`
+		}
+		if err := json.Unmarshal([]byte(strings.TrimSpace(content)), &event); err != nil {
+			continue
+		}
+		if event.Type == messageDeltaEvent && event.Usage != nil {
+			return event.Usage.toUsage()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package anthropic
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	fwkrh "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
+)
+
+func TestAnthropicParser_ParseRequest(t *testing.T) {
+	parser := NewAnthropicParser()
+	headers := map[string]string{":path": "/v1/messages"}
+
+	tests := []struct {
+		name    string
+		body    map[string]any
+		want    *fwkrh.AnthropicMessagesRequest
+		stream  bool
+		wantErr bool
+	}{
+		{
+			name: "string contents",
+			body: map[string]any{
+				"model":      "claude",
+				"max_tokens": 1024,
+				"system":     "You are a helpful assistant.",
+				"messages":   []any{map[string]any{"role": "user", "content": "Hello"}},
+			},
+			want: &fwkrh.AnthropicMessagesRequest{
+				System:    fwkrh.AnthropicContent{Raw: "You are a helpful assistant."},
+				Messages:  []fwkrh.AnthropicMessage{{Role: "user", Content: fwkrh.AnthropicContent{Raw: "Hello"}}},
+				MaxTokens: 1024,
+			},
+		},
+		{
+			name: "content blocks with tool use",
+			body: map[string]any{
+				"model":      "claude",
+				"max_tokens": 1024,
+				"stream":     true,
+				"system":     []any{map[string]any{"type": "text", "text": "Be brief."}},
+				"tools":      []any{map[string]any{"name": "get_weather"}},
+				"messages": []any{
+					map[string]any{"role": "user", "content": []any{
+						map[string]any{"type": "text", "text": "Weather in Paris?"},
+						map[string]any{"type": "image", "source": map[string]any{"type": "url", "url": "https://example.com/a.png"}},
+					}},
+					map[string]any{"role": "assistant", "content": []any{
+						map[string]any{"type": "tool_use", "id": "toolu_1", "name": "get_weather", "input": map[string]any{"city": "Paris"}},
+					}},
+					map[string]any{"role": "user", "content": []any{
+						map[string]any{"type": "tool_result", "tool_use_id": "toolu_1", "content": "sunny"},
+					}},
+				},
+			},
+			want: &fwkrh.AnthropicMessagesRequest{
+				System: fwkrh.AnthropicContent{Blocks: []fwkrh.AnthropicContentBlock{{Type: "text", Text: "Be brief."}}},
+				Tools:  []any{map[string]any{"name": "get_weather"}},
+				Messages: []fwkrh.AnthropicMessage{
+					{Role: "user", Content: fwkrh.AnthropicContent{Blocks: []fwkrh.AnthropicContentBlock{
+						{Type: "text", Text: "Weather in Paris?"},
+						{Type: "image", Source: map[string]any{"type": "url", "url": "https://example.com/a.png"}},
+					}}},
+					{Role: "assistant", Content: fwkrh.AnthropicContent{Blocks: []fwkrh.AnthropicContentBlock{
+						{Type: "tool_use", ID: "toolu_1", Name: "get_weather", Input: map[string]any{"city": "Paris"}},
+					}}},
+					{Role: "user", Content: fwkrh.AnthropicContent{Blocks: []fwkrh.AnthropicContentBlock{
+						{Type: "tool_result", ToolUseID: "toolu_1", Content: "sunny"},
+					}}},
+				},
+				MaxTokens: 1024,
+			},
+			stream: true,
+		},
+		{
+			name:    "missing messages",
+			body:    map[string]any{"model": "claude", "max_tokens": 1024},
+			wantErr: true,
+		},
+		{
+			name:    "invalid content",
+			body:    map[string]any{"model": "claude", "messages": []any{map[string]any{"role": "user", "content": 1}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := json.Marshal(tt.body)
+			require.NoError(t, err)
+			got, err := parser.ParseRequest(context.Background(), body, headers)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if diff := cmp.Diff(tt.want, got.AnthropicMessages); diff != "" {
+				t.Errorf("ParseRequest() mismatch (-want +got):\n%s", diff)
+			}
+			assert.Equal(t, tt.stream, got.Stream)
+			assert.Equal(t, "claude", got.Payload.(fwkrh.PayloadMap)["model"], "the model should be kept in the payload")
+		})
+	}
+}
+
+func TestAnthropicParser_ParseResponse(t *testing.T) {
+	parser := NewAnthropicParser()
+	streamHeaders := map[string]string{"content-type": "text/event-stream; charset=utf-8"}
+
+	tests := []struct {
+		name    string
+		body    string
+		headers map[string]string
+		want    *fwkrh.Usage
+	}{
+		{
+			name: "message",
+			body: `{"type":"message","content":[{"type":"text","text":"Hi"}],` +
+				`"usage":{"input_tokens":10,"output_tokens":5,"cache_read_input_tokens":20,"cache_creation_input_tokens":2}}`,
+			want: &fwkrh.Usage{PromptTokens: 32, CompletionTokens: 5, TotalTokens: 37,
+				PromptTokenDetails: &fwkrh.PromptTokenDetails{CachedTokens: 20}},
+		},
+		{
+			name: "message without usage",
+			body: `{"type":"message"}`,
+		},
+		{
+			name: "message start is ignored",
+			body: "event: message_start\n" +
+				`data: {"type":"message_start","message":{"usage":{"input_tokens":25,"output_tokens":1}}}` + "\n\n",
+			headers: streamHeaders,
+		},
+		{
+			name: "message delta",
+			body: "event: content_block_stop\ndata: {\"type\":\"content_block_stop\",\"index\":0}\n\n" +
+				"event: message_delta\n" +
+				`data: {"type":"message_delta","delta":{"stop_reason":"end_turn"},"usage":{"input_tokens":25,"output_tokens":15}}` + "\n\n" +
+				"event: message_stop\ndata: {\"type\":\"message_stop\"}\n\n",
+			headers: streamHeaders,
+			want:    &fwkrh.Usage{PromptTokens: 25, CompletionTokens: 15, TotalTokens: 40},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parser.ParseResponse(context.Background(), []byte(tt.body), tt.headers, true)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.Usage)
+		})
+	}
+
+	got, err := parser.ParseResponse(context.Background(), nil, streamHeaders, true)
+	assert.NoError(t, err)
+	assert.Nil(t, got, "an empty body should not be parsed")
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kservegrpc
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	v1 "sigs.k8s.io/gateway-api-inference-extension/api/v1"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	fwkrh "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
+)
+
+const (
+	KServeGRPCParserType = "kserve-grpc-parser"
+
+	gRPCPayloadHeaderLen = 5
+
+	methodPathKey        = ":path"
+	modelInferPath       = "/inference.GRPCInferenceService/ModelInfer"
+	modelStreamInferPath = "/inference.GRPCInferenceService/ModelStreamInfer"
+)
+
+// Field numbers of the ModelInferRequest and InferInputTensor messages of the KServe v2 protocol, see
+// https://github.com/kserve/open-inference-protocol/blob/main/specification/protocol/open_inference_grpc.proto.
+const (
+	modelInferRequestModelName    protowire.Number = 1
+	modelInferRequestModelVersion protowire.Number = 2
+	modelInferRequestID           protowire.Number = 3
+	modelInferRequestInputs       protowire.Number = 5
+
+	inferInputTensorName     protowire.Number = 1
+	inferInputTensorDatatype protowire.Number = 2
+	inferInputTensorShape    protowire.Number = 3
+)
+
+// compile-time type validation
+var _ fwkrh.Parser = &KServeGRPCParser{}
+
+// KServeGRPCParser implements the fwkrh.Parser interface for the KServe v2 gRPC inference protocol, spoken by Triton
+// and the KServe model servers. The requests are decoded from their wire format, so that no generated code is needed.
+type KServeGRPCParser struct {
+	typedName fwkplugin.TypedName
+}
+
+// NewKServeGRPCParser creates a new KServeGRPCParser.
+func NewKServeGRPCParser() *KServeGRPCParser {
+	return &KServeGRPCParser{
+		typedName: fwkplugin.TypedName{
+			Type: KServeGRPCParserType,
+			Name: KServeGRPCParserType,
+		},
+	}
+}
+
+func KServeGRPCParserPluginFactory(name string, _ json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	return NewKServeGRPCParser().WithName(name), nil
+}
+
+func (p *KServeGRPCParser) WithName(name string) *KServeGRPCParser {
+	p.typedName.Name = name
+	return p
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *KServeGRPCParser) TypedName() fwkplugin.TypedName {
+	return p.typedName
+}
+
+func (p *KServeGRPCParser) SupportedAppProtocols() []v1.AppProtocol {
+	return []v1.AppProtocol{v1.AppProtocolH2C}
+}
+
+// ParseRequest parses the ModelInferRequest of the gRPC request body. For ModelStreamInfer, the first request of the
+// stream is parsed.
+func (p *KServeGRPCParser) ParseRequest(ctx context.Context, body []byte, headers map[string]string) (*fwkrh.InferenceRequestBody, error) {
+	path := headers[methodPathKey]
+	if path != modelInferPath && path != modelStreamInferPath {
+		return nil, fmt.Errorf("unsupported gRPC path: %s", path)
+	}
+	payload, compressed, err := parseGrpcPayload(body)
+	if err != nil {
+		return nil, err
+	}
+	if compressed {
+		return nil, errors.New("compressed kserve gRPC payload is not supported")
+	}
+	request, err := decodeModelInferRequest(payload)
+	if err != nil {
+		return nil, fmt.Errorf("parsing ModelInferRequest: %w", err)
+	}
+	if request.ModelName == "" {
+		return nil, errors.New("invalid ModelInferRequest: must have model_name field")
+	}
+	log.FromContext(ctx).V(logutil.TRACE).Info("parsed ModelInferRequest", "model", request.ModelName,
+		"inputs", len(request.Inputs))
+	return &fwkrh.InferenceRequestBody{
+		KServeInfer: request,
+		Model:       request.ModelName,
+		Payload:     fwkrh.RawPayload(body),
+		Stream:      path == modelStreamInferPath,
+	}, nil
+}
+
+// ParseResponse returns nil, as the responses of the protocol report no usage.
+func (p *KServeGRPCParser) ParseResponse(ctx context.Context, body []byte, headers map[string]string, endofStream bool) (*fwkrh.ParsedResponse, error) {
+	return nil, nil
+}
+
+// parseGrpcPayload extracts the message payload and its compression status from a gRPC frame.
+// A standard gRPC frame consists of a 1-byte compression flag, a 4-byte message length,
+// and the actual message payload.
+func parseGrpcPayload(data []byte) ([]byte, bool, error) {
+	if len(data) < gRPCPayloadHeaderLen {
+		return nil, false, fmt.Errorf("invalid gRPC frame: expected at least %d bytes for header, got %d", gRPCPayloadHeaderLen, len(data))
+	}
+	isCompressed := data[0] == 1
+	msgLen := binary.BigEndian.Uint32(data[1:5])
+	if uint32(len(data)) < gRPCPayloadHeaderLen+msgLen {
+		return nil, false, fmt.Errorf("incomplete gRPC payload: header indicates %d bytes, but only %d bytes are available", msgLen, uint32(len(data))-gRPCPayloadHeaderLen)
+	}
+	return data[gRPCPayloadHeaderLen : gRPCPayloadHeaderLen+msgLen], isCompressed, nil
+}
+
+// decodeModelInferRequest decodes the fields of a ModelInferRequest used for scheduling, skipping the others, e.g. the
+// tensor contents.
+func decodeModelInferRequest(b []byte) (*fwkrh.KServeInferRequest, error) {
+	request := &fwkrh.KServeInferRequest{}
+	err := decodeFields(b, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		if typ != protowire.BytesType {
+			return nil
+		}
+		switch num {
+		case modelInferRequestModelName:
+			request.ModelName = string(value)
+		case modelInferRequestModelVersion:
+			request.ModelVersion = string(value)
+		case modelInferRequestID:
+			request.ID = string(value)
+		case modelInferRequestInputs:
+			input, err := decodeInferInputTensor(value)
+			if err != nil {
+				return err
+			}
+			request.Inputs = append(request.Inputs, input)
+		}
+		return nil
+	})
+	return request, err
+}
+
+func decodeInferInputTensor(b []byte) (fwkrh.KServeInferInput, error) {
+	input := fwkrh.KServeInferInput{}
+	err := decodeFields(b, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		switch {
+		case num == inferInputTensorName && typ == protowire.BytesType:
+			input.Name = string(value)
+		case num == inferInputTensorDatatype && typ == protowire.BytesType:
+			input.Datatype = string(value)
+		case num == inferInputTensorShape && typ == protowire.BytesType:
+			// Packed repeated int64.
+			for len(value) > 0 {
+				dim, n := protowire.ConsumeVarint(value)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				input.Shape = append(input.Shape, int64(dim))
+				value = value[n:]
+			}
+		case num == inferInputTensorShape && typ == protowire.VarintType:
+			dim, _ := protowire.ConsumeVarint(value)
+			input.Shape = append(input.Shape, int64(dim))
+		}
+		return nil
+	})
+	return input, err
+}
+
+// decodeFields calls the given function with the number, type and value of each field of the encoded message. The
+// value of length-delimited fields is their content, and the encoded value of the other fields.
+func decodeFields(b []byte, field func(num protowire.Number, typ protowire.Type, value []byte) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		var value []byte
+		if typ == protowire.BytesType {
+			value, n = protowire.ConsumeBytes(b)
+		} else {
+			n = protowire.ConsumeFieldValue(num, typ, b)
+			if n >= 0 {
+				value = b[:n]
+			}
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		if err := field(num, typ, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
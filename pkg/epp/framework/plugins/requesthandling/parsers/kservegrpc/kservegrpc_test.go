@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kservegrpc
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	fwkrh "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
+)
+
+// encodeModelInferRequest encodes a ModelInferRequest with a single FP32 input tensor, its parameters and its raw
+// contents, framed as a gRPC message.
+func encodeModelInferRequest(modelName string, shape ...int64) []byte {
+	var tensor []byte
+	tensor = protowire.AppendTag(tensor, inferInputTensorName, protowire.BytesType)
+	tensor = protowire.AppendString(tensor, "INPUT0")
+	tensor = protowire.AppendTag(tensor, inferInputTensorDatatype, protowire.BytesType)
+	tensor = protowire.AppendString(tensor, "FP32")
+	var packed []byte
+	for _, dim := range shape {
+		packed = protowire.AppendVarint(packed, uint64(dim))
+	}
+	tensor = protowire.AppendTag(tensor, inferInputTensorShape, protowire.BytesType)
+	tensor = protowire.AppendBytes(tensor, packed)
+
+	var msg []byte
+	if modelName != "" {
+		msg = protowire.AppendTag(msg, modelInferRequestModelName, protowire.BytesType)
+		msg = protowire.AppendString(msg, modelName)
+	}
+	msg = protowire.AppendTag(msg, modelInferRequestModelVersion, protowire.BytesType)
+	msg = protowire.AppendString(msg, "1")
+	msg = protowire.AppendTag(msg, modelInferRequestID, protowire.BytesType)
+	msg = protowire.AppendString(msg, "req-1")
+	// An unknown varint field and the raw input contents are skipped.
+	msg = protowire.AppendTag(msg, 100, protowire.VarintType)
+	msg = protowire.AppendVarint(msg, 42)
+	msg = protowire.AppendTag(msg, modelInferRequestInputs, protowire.BytesType)
+	msg = protowire.AppendBytes(msg, tensor)
+	msg = protowire.AppendTag(msg, 7, protowire.BytesType)
+	msg = protowire.AppendBytes(msg, make([]byte, 16))
+
+	frame := make([]byte, gRPCPayloadHeaderLen, gRPCPayloadHeaderLen+len(msg))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(msg)))
+	return append(frame, msg...)
+}
+
+func TestKServeGRPCParser_ParseRequest(t *testing.T) {
+	parser := NewKServeGRPCParser()
+	body := encodeModelInferRequest("densenet", 1, 3, 224, 224)
+
+	got, err := parser.ParseRequest(context.Background(), body, map[string]string{methodPathKey: modelInferPath})
+	require.NoError(t, err)
+	assert.Equal(t, &fwkrh.KServeInferRequest{
+		ModelName:    "densenet",
+		ModelVersion: "1",
+		ID:           "req-1",
+		Inputs:       []fwkrh.KServeInferInput{{Name: "INPUT0", Datatype: "FP32", Shape: []int64{1, 3, 224, 224}}},
+	}, got.KServeInfer)
+	assert.Equal(t, "densenet", got.Model)
+	assert.Equal(t, fwkrh.RawPayload(body), got.Payload, "the request should be forwarded as is")
+	assert.False(t, got.Stream)
+
+	got, err = parser.ParseRequest(context.Background(), body, map[string]string{methodPathKey: modelStreamInferPath})
+	require.NoError(t, err)
+	assert.True(t, got.Stream)
+
+	tests := []struct {
+		name string
+		path string
+		body []byte
+	}{
+		{name: "unsupported path", path: "/inference.GRPCInferenceService/ServerLive", body: body},
+		{name: "missing model name", path: modelInferPath, body: encodeModelInferRequest("", 1)},
+		{name: "truncated frame", path: modelInferPath, body: body[:len(body)-1]},
+		{name: "compressed frame", path: modelInferPath, body: append([]byte{1}, body[1:]...)},
+		{name: "malformed message", path: modelInferPath, body: []byte{0, 0, 0, 0, 2, 0x0a, 0x05}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := parser.ParseRequest(context.Background(), test.body, map[string]string{methodPathKey: test.path})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestKServeGRPCParser_ParseResponse(t *testing.T) {
+	got, err := NewKServeGRPCParser().ParseResponse(context.Background(), []byte{0, 0, 0, 0, 0}, nil, true)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+}
@@ -783,6 +783,93 @@ func TestOpenAIParser_ParseRequest(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name:    "chat completions request with tool calls",
+			headers: map[string]string{":path": "/v1/chat/completions"},
+			body: map[string]any{
+				"model": "test",
+				"tools": []any{map[string]any{"type": "function", "function": map[string]any{"name": "get_weather"}}},
+				"messages": []any{
+					map[string]any{"role": "user", "content": "What is the weather in Paris?"},
+					map[string]any{"role": "assistant", "content": nil, "tool_calls": []any{
+						map[string]any{"id": "call_1", "type": "function",
+							"function": map[string]any{"name": "get_weather", "arguments": `{"city":"Paris"}`}},
+					}},
+					map[string]any{"role": "tool", "tool_call_id": "call_1", "content": "sunny"},
+				},
+				"stream": true,
+			},
+			want: &fwkrh.InferenceRequestBody{
+				ChatCompletions: &fwkrh.ChatCompletionsRequest{
+					Tools: []any{map[string]any{"type": "function", "function": map[string]any{"name": "get_weather"}}},
+					Messages: []fwkrh.Message{
+						{Role: "user", Content: fwkrh.Content{Raw: "What is the weather in Paris?"}},
+						{Role: "assistant", ToolCalls: []any{
+							map[string]any{"id": "call_1", "type": "function",
+								"function": map[string]any{"name": "get_weather", "arguments": `{"city":"Paris"}`}},
+						}},
+						{Role: "tool", ToolCallID: "call_1", Content: fwkrh.Content{Raw: "sunny"}},
+					},
+				},
+				Payload: fwkrh.PayloadMap{
+					"model": "test",
+					"tools": []any{map[string]any{"type": "function", "function": map[string]any{"name": "get_weather"}}},
+					"messages": []any{
+						map[string]any{"role": "user", "content": "What is the weather in Paris?"},
+						map[string]any{"role": "assistant", "content": nil, "tool_calls": []any{
+							map[string]any{"id": "call_1", "type": "function",
+								"function": map[string]any{"name": "get_weather", "arguments": `{"city":"Paris"}`}},
+						}},
+						map[string]any{"role": "tool", "tool_call_id": "call_1", "content": "sunny"},
+					},
+					"stream": true,
+				},
+				Stream: true,
+			},
+		},
+		{
+			name:    "chat completions request with image URL given as a string",
+			headers: map[string]string{":path": "/v1/chat/completions"},
+			body: map[string]any{
+				"model": "test",
+				"messages": []any{map[string]any{"role": "user", "content": []any{
+					map[string]any{"type": "text", "text": "Describe"},
+					map[string]any{"type": "image_url", "image_url": "https://example.com/cat.png"},
+				}}},
+			},
+			want: &fwkrh.InferenceRequestBody{
+				ChatCompletions: &fwkrh.ChatCompletionsRequest{
+					Messages: []fwkrh.Message{{Role: "user", Content: fwkrh.Content{Structured: []fwkrh.ContentBlock{
+						{Type: "text", Text: "Describe"},
+						{Type: "image_url", ImageURL: fwkrh.ImageBlock{Url: "https://example.com/cat.png"}},
+					}}}},
+				},
+				Payload: fwkrh.PayloadMap{
+					"model": "test",
+					"messages": []any{map[string]any{"role": "user", "content": []any{
+						map[string]any{"type": "text", "text": "Describe"},
+						map[string]any{"type": "image_url", "image_url": "https://example.com/cat.png"},
+					}}},
+				},
+			},
+		},
+		{
+			name:    "embeddings request with batches of token IDs",
+			headers: map[string]string{":path": "/v1/embeddings"},
+			body: map[string]any{
+				"model": "text-embedding-3-small",
+				"input": []any{[]any{1, 2}, []any{3}},
+			},
+			want: &fwkrh.InferenceRequestBody{
+				Embeddings: &fwkrh.EmbeddingsRequest{
+					Input: fwkrh.EmbeddingsInput{TokenIDs: []uint32{1, 2, 3}},
+				},
+				Payload: fwkrh.PayloadMap{
+					"model": "text-embedding-3-small",
+					"input": []any{[]any{float64(1), float64(2)}, []any{float64(3)}},
+				},
+			},
+		},
 		{
 			name:    "embeddings request with null input",
 			headers: map[string]string{":path": "/v1/embeddings"},
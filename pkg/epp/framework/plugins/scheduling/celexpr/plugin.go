@@ -0,0 +1,320 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package celexpr provides a filter and a scorer whose logic is a CEL expression evaluated against the request and
+// each candidate endpoint, so that simple predicates and scoring functions can be configured without writing and
+// building a Go plugin.
+package celexpr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+const (
+	FilterType = "cel-filter"
+	ScorerType = "cel-scorer"
+
+	// costLimit bounds the cost of an evaluation, so that an expression cannot stall the scheduling.
+	costLimit = 100_000
+)
+
+// ParameterSchema is the JSON schema of the parameters of the filter and of the scorer.
+const ParameterSchema = `{
+  "type": "object",
+  "additionalProperties": false,
+  "required": ["expression"],
+  "properties": {
+    "expression": {"type": "string", "minLength": 1},
+    "category": {"type": "string", "enum": ["Affinity", "Distribution", "Balance"]}
+  }
+}`
+
+var (
+	_ framework.Filter = &Filter{}
+	_ framework.Scorer = &Scorer{}
+)
+
+type Config struct {
+	// Expression is the CEL expression evaluated for each candidate endpoint, with the "request" and "endpoint"
+	// variables. It returns whether to keep the endpoint for the filter, and the score of the endpoint, between 0 and
+	// 1, for the scorer.
+	Expression string `json:"expression"`
+
+	// Category is the category of the scorer: Affinity, Distribution or Balance. Default: Distribution.
+	Category framework.ScorerCategory `json:"category,omitempty"`
+}
+
+var DefaultConfig = Config{
+	Category: framework.Distribution,
+}
+
+func (c *Config) validate() error {
+	if c.Expression == "" {
+		return errors.New("expression must not be empty")
+	}
+	switch c.Category {
+	case framework.Affinity, framework.Distribution, framework.Balance:
+	default:
+		return fmt.Errorf("category must be one of %s, %s or %s, got %q", framework.Affinity, framework.Distribution,
+			framework.Balance, c.Category)
+	}
+	return nil
+}
+
+func parseConfig(pluginType string, rawParameters json.RawMessage) (Config, error) {
+	config := DefaultConfig
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return config, fmt.Errorf("failed to unmarshal config for %s: %w", pluginType, err)
+		}
+	}
+	if err := config.validate(); err != nil {
+		return config, fmt.Errorf("invalid config for %s: %w", pluginType, err)
+	}
+	return config, nil
+}
+
+// compile compiles the given expression, which must return one of the given types.
+func compile(expression string, outputTypes ...*cel.Type) (cel.Program, error) {
+	env, err := cel.NewEnv(
+		cel.Variable("request", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("endpoint", cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("failed to compile expression: %w", issues.Err())
+	}
+	validOutput := false
+	for _, outputType := range append(outputTypes, cel.DynType) {
+		if ast.OutputType().IsExactType(outputType) {
+			validOutput = true
+		}
+	}
+	if !validOutput {
+		return nil, fmt.Errorf("expression must return %v, got %v", outputTypes, ast.OutputType())
+	}
+	program, err := env.Program(ast, cel.CostLimit(costLimit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create program for expression: %w", err)
+	}
+	return program, nil
+}
+
+// requestVariable returns the "request" variable of the expressions.
+func requestVariable(request *framework.InferenceRequest) map[string]any {
+	if request == nil {
+		return map[string]any{}
+	}
+	headers := request.Headers
+	if headers == nil {
+		headers = map[string]string{}
+	}
+	return map[string]any{
+		"id":        request.RequestId,
+		"model":     request.TargetModel,
+		"headers":   headers,
+		"priority":  request.Objectives.Priority,
+		"sizeBytes": request.RequestSizeBytes,
+	}
+}
+
+// endpointVariable returns the "endpoint" variable of the expressions.
+func endpointVariable(endpoint framework.Endpoint) map[string]any {
+	variable := map[string]any{}
+	if metadata := endpoint.GetMetadata(); metadata != nil {
+		labels := metadata.Labels
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		nodeLabels := metadata.NodeLabels
+		if nodeLabels == nil {
+			nodeLabels = map[string]string{}
+		}
+		variable["name"] = metadata.NamespacedName.String()
+		variable["pod"] = metadata.PodName
+		variable["namespace"] = metadata.NamespacedName.Namespace
+		variable["address"] = metadata.Address
+		variable["labels"] = labels
+		variable["nodeLabels"] = nodeLabels
+	}
+	if metrics := endpoint.GetMetrics(); metrics != nil {
+		variable["metrics"] = map[string]any{
+			"waitingQueueSize":       metrics.WaitingQueueSize,
+			"runningRequestsSize":    metrics.RunningRequestsSize,
+			"kvCacheUsagePercent":    metrics.KVCacheUsagePercent,
+			"activeModels":           modelCounts(metrics.ActiveModels),
+			"waitingModels":          modelCounts(metrics.WaitingModels),
+			"adapterRunningRequests": modelCounts(metrics.AdapterRunningRequests),
+			"adapterWaitingRequests": modelCounts(metrics.AdapterWaitingRequests),
+		}
+	}
+	return variable
+}
+
+func modelCounts(models map[string]int) map[string]int {
+	if models == nil {
+		return map[string]int{}
+	}
+	return models
+}
+
+// Filter keeps the candidate endpoints for which its expression returns true.
+type Filter struct {
+	typedName  fwkplugin.TypedName
+	expression string
+	program    cel.Program
+}
+
+// FilterFactory creates a new CEL filter from the given parameters.
+func FilterFactory(name string, rawParameters json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	config, err := parseConfig(FilterType, rawParameters)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := NewFilter(config)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config for %s: %w", FilterType, err)
+	}
+	return filter.WithName(name), nil
+}
+
+// NewFilter creates a new CEL filter. The expression must return a bool.
+func NewFilter(config Config) (*Filter, error) {
+	program, err := compile(config.Expression, cel.BoolType)
+	if err != nil {
+		return nil, err
+	}
+	return &Filter{
+		typedName:  fwkplugin.TypedName{Type: FilterType, Name: FilterType},
+		expression: config.Expression,
+		program:    program,
+	}, nil
+}
+
+func (f *Filter) WithName(name string) *Filter {
+	f.typedName.Name = name
+	return f
+}
+
+func (f *Filter) TypedName() fwkplugin.TypedName {
+	return f.typedName
+}
+
+// Filter keeps the endpoints for which the expression returns true. Endpoints for which the evaluation fails are kept,
+// so that a faulty expression does not take the pool out of service.
+func (f *Filter) Filter(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest,
+	endpoints []framework.Endpoint) []framework.Endpoint {
+	requestVar := requestVariable(request)
+	filtered := make([]framework.Endpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		value, _, err := f.program.Eval(map[string]any{"request": requestVar, "endpoint": endpointVariable(endpoint)})
+		if err != nil {
+			log.FromContext(ctx).V(logutil.DEBUG).Info("Failed to evaluate CEL filter expression, keeping endpoint",
+				"expression", f.expression, "endpoint", endpoint.GetMetadata(), "error", err.Error())
+			filtered = append(filtered, endpoint)
+			continue
+		}
+		if keep, ok := value.Value().(bool); !ok || keep {
+			filtered = append(filtered, endpoint)
+		}
+	}
+	return filtered
+}
+
+// Scorer scores the candidate endpoints with its expression.
+type Scorer struct {
+	typedName  fwkplugin.TypedName
+	expression string
+	program    cel.Program
+	category   framework.ScorerCategory
+}
+
+// ScorerFactory creates a new CEL scorer from the given parameters.
+func ScorerFactory(name string, rawParameters json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	config, err := parseConfig(ScorerType, rawParameters)
+	if err != nil {
+		return nil, err
+	}
+	scorer, err := NewScorer(config)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config for %s: %w", ScorerType, err)
+	}
+	return scorer.WithName(name), nil
+}
+
+// NewScorer creates a new CEL scorer. The expression must return a double or an int.
+func NewScorer(config Config) (*Scorer, error) {
+	program, err := compile(config.Expression, cel.DoubleType, cel.IntType)
+	if err != nil {
+		return nil, err
+	}
+	return &Scorer{
+		typedName:  fwkplugin.TypedName{Type: ScorerType, Name: ScorerType},
+		expression: config.Expression,
+		program:    program,
+		category:   config.Category,
+	}, nil
+}
+
+func (s *Scorer) WithName(name string) *Scorer {
+	s.typedName.Name = name
+	return s
+}
+
+func (s *Scorer) TypedName() fwkplugin.TypedName {
+	return s.typedName
+}
+
+func (s *Scorer) Category() framework.ScorerCategory {
+	return s.category
+}
+
+// Score returns the result of the expression for each endpoint, clamped to [0, 1]. Endpoints for which the evaluation
+// fails score 0.
+func (s *Scorer) Score(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest,
+	endpoints []framework.Endpoint) map[framework.Endpoint]float64 {
+	requestVar := requestVariable(request)
+	scores := make(map[framework.Endpoint]float64, len(endpoints))
+	for _, endpoint := range endpoints {
+		scores[endpoint] = 0
+		value, _, err := s.program.Eval(map[string]any{"request": requestVar, "endpoint": endpointVariable(endpoint)})
+		if err != nil {
+			log.FromContext(ctx).V(logutil.DEBUG).Info("Failed to evaluate CEL scorer expression",
+				"expression", s.expression, "endpoint", endpoint.GetMetadata(), "error", err.Error())
+			continue
+		}
+		switch score := value.Value().(type) {
+		case float64:
+			scores[endpoint] = min(max(score, 0), 1)
+		case int64:
+			scores[endpoint] = min(max(float64(score), 0), 1)
+		}
+	}
+	return scores
+}
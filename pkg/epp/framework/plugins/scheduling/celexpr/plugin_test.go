@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package celexpr
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+func makeEndpoint(name string, labels map[string]string, kvCacheUsage float64, waiting int) framework.Endpoint {
+	return framework.NewEndpoint(
+		&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Namespace: "default", Name: name}, Labels: labels},
+		&fwkdl.Metrics{KVCacheUsagePercent: kvCacheUsage, WaitingQueueSize: waiting},
+		nil,
+	)
+}
+
+var testRequest = &framework.InferenceRequest{
+	TargetModel: "my-model",
+	Headers:     map[string]string{"x-tier": "premium"},
+	Objectives:  framework.RequestObjectives{Priority: 1},
+}
+
+func TestFactory(t *testing.T) {
+	tests := []struct {
+		name    string
+		factory func(string, json.RawMessage) error
+		params  string
+		wantErr bool
+	}{
+		{name: "filter", factory: filterFactory, params: `{"expression": "endpoint.metrics.waitingQueueSize < 10"}`},
+		{name: "scorer", factory: scorerFactory, params: `{"expression": "1.0 - endpoint.metrics.kvCacheUsagePercent"}`},
+		{name: "scorer with category", factory: scorerFactory, params: `{"expression": "1", "category": "Affinity"}`},
+		{name: "no expression", factory: filterFactory, params: ``, wantErr: true},
+		{name: "invalid category", factory: scorerFactory, params: `{"expression": "1", "category": "Random"}`,
+			wantErr: true},
+		{name: "syntax error", factory: filterFactory, params: `{"expression": "endpoint.labels["}`, wantErr: true},
+		{name: "filter returning a number", factory: filterFactory, params: `{"expression": "1"}`, wantErr: true},
+		{name: "scorer returning a string", factory: scorerFactory, params: `{"expression": "'high'"}`, wantErr: true},
+		{name: "undeclared variable", factory: filterFactory, params: `{"expression": "pod.ready"}`, wantErr: true},
+		{name: "malformed json", factory: filterFactory, params: `{`, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.factory("cel", json.RawMessage(test.params))
+			if test.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func filterFactory(name string, params json.RawMessage) error {
+	_, err := FilterFactory(name, params, nil)
+	return err
+}
+
+func scorerFactory(name string, params json.RawMessage) error {
+	_, err := ScorerFactory(name, params, nil)
+	return err
+}
+
+func TestFilter(t *testing.T) {
+	endpoints := []framework.Endpoint{
+		makeEndpoint("pod1", map[string]string{"tier": "premium"}, 0.2, 0),
+		makeEndpoint("pod2", map[string]string{"tier": "standard"}, 0.9, 5),
+		makeEndpoint("pod3", nil, 0.5, 0),
+	}
+	tests := []struct {
+		name       string
+		expression string
+		want       []string
+	}{
+		{
+			name:       "metrics",
+			expression: "endpoint.metrics.waitingQueueSize == 0",
+			want:       []string{"pod1", "pod3"},
+		},
+		{
+			name:       "request header matching pod label",
+			expression: "'tier' in endpoint.labels && endpoint.labels['tier'] == request.headers['x-tier']",
+			want:       []string{"pod1"},
+		},
+		{
+			name:       "request attributes",
+			expression: "request.model == 'my-model' && request.priority > 0 && endpoint.name != 'default/pod2'",
+			want:       []string{"pod1", "pod3"},
+		},
+		{
+			name:       "evaluation errors keep the endpoints",
+			expression: "endpoint.labels['tier'] == 'premium'",
+			want:       []string{"pod1", "pod3"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filter, err := NewFilter(Config{Expression: test.expression, Category: framework.Distribution})
+			require.NoError(t, err)
+			got := []string{}
+			for _, endpoint := range filter.Filter(context.Background(), nil, testRequest, endpoints) {
+				got = append(got, endpoint.GetMetadata().NamespacedName.Name)
+			}
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestScorer(t *testing.T) {
+	endpoints := []framework.Endpoint{
+		makeEndpoint("pod1", map[string]string{"tier": "premium"}, 0.2, 0),
+		makeEndpoint("pod2", nil, 0.9, 5),
+	}
+	tests := []struct {
+		name       string
+		expression string
+		want       []float64
+	}{
+		{
+			name:       "double",
+			expression: "1.0 - endpoint.metrics.kvCacheUsagePercent",
+			want:       []float64{0.8, 0.1},
+		},
+		{
+			name:       "int clamped",
+			expression: "endpoint.metrics.waitingQueueSize",
+			want:       []float64{0, 1},
+		},
+		{
+			name:       "evaluation errors score 0",
+			expression: "endpoint.labels['tier'] == 'premium' ? 1.0 : 0.5",
+			want:       []float64{1, 0},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			scorer, err := NewScorer(Config{Expression: test.expression, Category: framework.Distribution})
+			require.NoError(t, err)
+			scores := scorer.Score(context.Background(), nil, testRequest, endpoints)
+			for i, endpoint := range endpoints {
+				assert.InDelta(t, test.want[i], scores[endpoint], 1e-9, endpoint.GetMetadata().NamespacedName.Name)
+			}
+		})
+	}
+}
@@ -0,0 +1,293 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package adaptiveconcurrency provides a filter capping the in-flight requests of each endpoint to a concurrency limit
+// learned from the latency and errors of its responses, with additive increase and multiplicative decrease (AIMD).
+package adaptiveconcurrency
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+const (
+	PluginType = "adaptive-concurrency-filter"
+)
+
+var (
+	_ framework.Filter            = &Plugin{}
+	_ requestcontrol.Admitter     = &Plugin{}
+	_ requestcontrol.PreRequest   = &Plugin{}
+	_ requestcontrol.PostResponse = &Plugin{}
+	_ fwkdl.EndpointSubscriber    = &Plugin{}
+)
+
+type Config struct {
+	// InitialLimit is the concurrency limit of the endpoints before any response is observed. Default: 8.
+	InitialLimit int `json:"initialLimit,omitempty"`
+
+	// MinLimit is the lowest concurrency limit of an endpoint. Default: 1.
+	MinLimit int `json:"minLimit,omitempty"`
+
+	// MaxLimit is the highest concurrency limit of an endpoint. Default: 256.
+	MaxLimit int `json:"maxLimit,omitempty"`
+
+	// LatencyThresholdMs is the time to first token, in milliseconds, above which a response signals that its endpoint
+	// is overloaded. The total latency is used for responses without a body. Default: 2000.
+	LatencyThresholdMs int `json:"latencyThresholdMs,omitempty"`
+
+	// BackoffRatio is the factor the limit of an endpoint is multiplied by when it is overloaded. Default: 0.9.
+	BackoffRatio float64 `json:"backoffRatio,omitempty"`
+}
+
+var DefaultConfig = Config{
+	InitialLimit:       8,
+	MinLimit:           1,
+	MaxLimit:           256,
+	LatencyThresholdMs: 2000,
+	BackoffRatio:       0.9,
+}
+
+func (c *Config) validate() error {
+	if c.MinLimit <= 0 {
+		return fmt.Errorf("minLimit must be > 0, got %d", c.MinLimit)
+	}
+	if c.MaxLimit < c.MinLimit {
+		return fmt.Errorf("maxLimit must be >= minLimit, got %d and %d", c.MaxLimit, c.MinLimit)
+	}
+	if c.InitialLimit < c.MinLimit || c.InitialLimit > c.MaxLimit {
+		return fmt.Errorf("initialLimit must be within [minLimit, maxLimit], got %d", c.InitialLimit)
+	}
+	if c.LatencyThresholdMs <= 0 {
+		return fmt.Errorf("latencyThresholdMs must be > 0, got %d", c.LatencyThresholdMs)
+	}
+	if c.BackoffRatio <= 0 || c.BackoffRatio >= 1 {
+		return fmt.Errorf("backoffRatio must be in (0, 1), got %v", c.BackoffRatio)
+	}
+	return nil
+}
+
+// endpointState is the learned concurrency limit of an endpoint and its in-flight requests.
+type endpointState struct {
+	limit        float64
+	inFlight     int
+	lastDecrease time.Time
+}
+
+// dispatch is a request in flight on an endpoint.
+type dispatch struct {
+	endpoint   k8stypes.NamespacedName
+	dispatched time.Time
+}
+
+// Plugin caps the in-flight requests of each endpoint to a concurrency limit learned from the feedback of its
+// responses, so that endpoints are not overloaded between two scrapes of their metrics.
+//
+// The in-flight requests are counted from the dispatches and completions of the requests, rather than from the scraped
+// queue metrics. Every response within the latency threshold raises the limit of its endpoint by 1/limit, i.e. by one
+// per limit's worth of responses, and a response failing or above the latency threshold multiplies it by the backoff
+// ratio. The limit is decreased at most once per round trip: only the responses of the requests dispatched after the
+// last decrease can decrease it again.
+//
+// The plugin must be referenced as a filter in the scheduling profiles for the limits to be enforced. The requests for
+// which all the candidate endpoints are at their limit are rejected at admission with a 503.
+type Plugin struct {
+	typedName fwkplugin.TypedName
+	config    Config
+	now       func() time.Time
+
+	mu         sync.Mutex
+	endpoints  map[k8stypes.NamespacedName]*endpointState
+	dispatches map[string]dispatch
+}
+
+// Factory creates a new adaptive concurrency filter from the given parameters.
+func Factory(name string, rawParameters json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	config := DefaultConfig
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal config for %s: %w", PluginType, err)
+		}
+	}
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config for %s: %w", PluginType, err)
+	}
+	return New(config).WithName(name), nil
+}
+
+// New creates a new adaptive concurrency filter.
+func New(config Config) *Plugin {
+	return &Plugin{
+		typedName:  fwkplugin.TypedName{Type: PluginType, Name: PluginType},
+		config:     config,
+		now:        time.Now,
+		endpoints:  map[k8stypes.NamespacedName]*endpointState{},
+		dispatches: map[string]dispatch{},
+	}
+}
+
+// WithName sets the name of the plugin.
+func (p *Plugin) WithName(name string) *Plugin {
+	p.typedName.Name = name
+	return p
+}
+
+func (p *Plugin) TypedName() fwkplugin.TypedName {
+	return p.typedName
+}
+
+// AdmitRequest rejects the request if all the candidate endpoints are at their concurrency limit.
+func (p *Plugin) AdmitRequest(ctx context.Context, request *framework.InferenceRequest, endpoints []framework.Endpoint) error {
+	if len(endpoints) == 0 || len(p.available(endpoints)) > 0 {
+		return nil
+	}
+	log.FromContext(ctx).V(logutil.DEBUG).Info("Rejecting request, all the candidate endpoints are at their concurrency limit",
+		"endpoints", len(endpoints))
+	return errcommon.Error{
+		Code: errcommon.ServiceUnavailable,
+		Msg:  "all the candidate endpoints are at their adaptive concurrency limit",
+	}
+}
+
+// Filter keeps the endpoints below their concurrency limit. Endpoints are not filtered when they are all at their
+// limit, which only happens when their requests were admitted concurrently.
+func (p *Plugin) Filter(ctx context.Context, _ *framework.CycleState, _ *framework.InferenceRequest, endpoints []framework.Endpoint) []framework.Endpoint {
+	filtered := p.available(endpoints)
+	if len(filtered) == 0 {
+		return endpoints
+	}
+	log.FromContext(ctx).V(logutil.TRACE).Info("AdaptiveConcurrencyFilter: kept endpoints below their limit",
+		"kept", len(filtered), "total", len(endpoints))
+	return filtered
+}
+
+// PreRequest counts the request in flight on its primary target endpoint.
+func (p *Plugin) PreRequest(_ context.Context, request *framework.InferenceRequest, schedulingResult *framework.SchedulingResult) {
+	if request == nil || request.RequestId == "" || schedulingResult == nil {
+		return
+	}
+	result, ok := schedulingResult.ProfileResults[schedulingResult.PrimaryProfileName]
+	if !ok || result == nil || len(result.TargetEndpoints) == 0 || result.TargetEndpoints[0].GetMetadata() == nil {
+		return
+	}
+	endpoint := result.TargetEndpoints[0].GetMetadata().NamespacedName
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.state(endpoint).inFlight++
+	p.dispatches[request.RequestId] = dispatch{endpoint: endpoint, dispatched: p.now()}
+}
+
+// PostResponse releases the request and adjusts the concurrency limit of its endpoint from the outcome of the
+// response. Requests abandoned by their clients release their endpoint without adjusting its limit, unless they failed.
+func (p *Plugin) PostResponse(ctx context.Context, request *framework.InferenceRequest, response *requestcontrol.CompletedResponse,
+	_ *fwkdl.EndpointMetadata) {
+	if request == nil || response == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	d, ok := p.dispatches[request.RequestId]
+	if !ok {
+		return
+	}
+	delete(p.dispatches, request.RequestId)
+	state, ok := p.endpoints[d.endpoint]
+	if !ok { // the endpoint left the pool while the request was in flight
+		return
+	}
+	state.inFlight--
+
+	latency := response.TTFT
+	if latency == 0 {
+		latency = response.Latency
+	}
+	overloaded := response.Failed || latency > time.Duration(p.config.LatencyThresholdMs)*time.Millisecond
+	if response.Abandoned && !overloaded {
+		return
+	}
+	previous := state.limit
+	if !overloaded {
+		state.limit = min(state.limit+1/state.limit, float64(p.config.MaxLimit))
+	} else if d.dispatched.After(state.lastDecrease) {
+		state.limit = max(state.limit*p.config.BackoffRatio, float64(p.config.MinLimit))
+		state.lastDecrease = p.now()
+		log.FromContext(ctx).V(logutil.DEBUG).Info("Decreased the concurrency limit of an overloaded endpoint",
+			"endpoint", d.endpoint, "limit", state.limit, "failed", response.Failed, "latency", latency)
+	}
+	if math.Floor(state.limit) != math.Floor(previous) {
+		metrics.RecordEndpointConcurrencyLimit(d.endpoint.Namespace, d.endpoint.Name, math.Floor(state.limit))
+	}
+}
+
+// OnEndpointChange drops the state and the concurrency limit series of the deleted endpoints, so that they do not
+// accumulate with the churn of the pods, nor apply to a pod recreated with the same name.
+func (p *Plugin) OnEndpointChange(_ context.Context, change fwkdl.EndpointChange) {
+	if change.Type != fwkdl.EndpointDeleted || change.Endpoint.GetMetadata() == nil {
+		return
+	}
+	endpoint := change.Endpoint.GetMetadata().NamespacedName
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.endpoints[endpoint]; ok {
+		delete(p.endpoints, endpoint)
+		metrics.DeleteEndpointConcurrencyLimit(endpoint.Namespace, endpoint.Name)
+	}
+}
+
+// available returns the endpoints below their concurrency limit.
+func (p *Plugin) available(endpoints []framework.Endpoint) []framework.Endpoint {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	available := make([]framework.Endpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		metadata := endpoint.GetMetadata()
+		if metadata == nil {
+			continue
+		}
+		state, ok := p.endpoints[metadata.NamespacedName]
+		if !ok || float64(state.inFlight) < math.Floor(state.limit) {
+			available = append(available, endpoint)
+		}
+	}
+	return available
+}
+
+// state returns the state of the given endpoint, starting at the initial limit. It must be called with the lock held.
+func (p *Plugin) state(endpoint k8stypes.NamespacedName) *endpointState {
+	state, ok := p.endpoints[endpoint]
+	if !ok {
+		state = &endpointState{limit: float64(p.config.InitialLimit)}
+		p.endpoints[endpoint] = state
+		metrics.RecordEndpointConcurrencyLimit(endpoint.Namespace, endpoint.Name, state.limit)
+	}
+	return state
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adaptiveconcurrency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+func makeEndpoint(name string) framework.Endpoint {
+	meta := &fwkdl.EndpointMetadata{NamespacedName: types.NamespacedName{Name: name, Namespace: "default"}}
+	return framework.NewEndpoint(meta, &fwkdl.Metrics{}, fwkdl.NewAttributes())
+}
+
+func names(endpoints []framework.Endpoint) []string {
+	res := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		res = append(res, endpoint.GetMetadata().NamespacedName.Name)
+	}
+	return res
+}
+
+// testPlugin is a plugin whose clock is advanced by the tests.
+type testPlugin struct {
+	*Plugin
+	clock time.Time
+}
+
+func newTestPlugin(config Config) *testPlugin {
+	tp := &testPlugin{Plugin: New(config), clock: time.Now()}
+	tp.now = func() time.Time { return tp.clock }
+	return tp
+}
+
+// dispatch dispatches the request of the given ID to the given endpoint.
+func (tp *testPlugin) dispatch(id string, endpoint framework.Endpoint) *framework.InferenceRequest {
+	request := &framework.InferenceRequest{RequestId: id}
+	tp.PreRequest(context.Background(), request, &framework.SchedulingResult{
+		PrimaryProfileName: "default",
+		ProfileResults:     map[string]*framework.ProfileRunResult{"default": {TargetEndpoints: []framework.Endpoint{endpoint}}},
+	})
+	tp.clock = tp.clock.Add(time.Millisecond)
+	return request
+}
+
+func (tp *testPlugin) complete(request *framework.InferenceRequest, response *requestcontrol.CompletedResponse) {
+	tp.PostResponse(context.Background(), request, response, nil)
+	tp.clock = tp.clock.Add(time.Millisecond)
+}
+
+func (tp *testPlugin) limit(name string) float64 {
+	return tp.endpoints[types.NamespacedName{Name: name, Namespace: "default"}].limit
+}
+
+var (
+	fast   = &requestcontrol.CompletedResponse{TTFT: 100 * time.Millisecond}
+	slow   = &requestcontrol.CompletedResponse{TTFT: 5 * time.Second}
+	failed = &requestcontrol.CompletedResponse{Failed: true}
+)
+
+func TestFactory(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  string
+		wantErr bool
+	}{
+		{name: "defaults", params: ``},
+		{name: "valid", params: `{"initialLimit": 4, "minLimit": 2, "maxLimit": 64, "latencyThresholdMs": 500, "backoffRatio": 0.5}`},
+		{name: "initial below min", params: `{"initialLimit": 1, "minLimit": 2}`, wantErr: true},
+		{name: "max below min", params: `{"minLimit": 8, "maxLimit": 4, "initialLimit": 8}`, wantErr: true},
+		{name: "negative threshold", params: `{"latencyThresholdMs": -1}`, wantErr: true},
+		{name: "backoff ratio of 1", params: `{"backoffRatio": 1}`, wantErr: true},
+		{name: "malformed json", params: `{`, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := Factory("concurrency", json.RawMessage(test.params), nil)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "concurrency", p.TypedName().Name)
+		})
+	}
+}
+
+func TestFilterCapsInFlightRequests(t *testing.T) {
+	ctx := context.Background()
+	p := newTestPlugin(Config{InitialLimit: 2, MinLimit: 1, MaxLimit: 10, LatencyThresholdMs: 1000, BackoffRatio: 0.5})
+	a, b := makeEndpoint("a"), makeEndpoint("b")
+	endpoints := []framework.Endpoint{a, b}
+
+	assert.Equal(t, []string{"a", "b"}, names(p.Filter(ctx, nil, nil, endpoints)))
+	first := p.dispatch("1", a)
+	p.dispatch("2", a)
+	assert.Equal(t, []string{"b"}, names(p.Filter(ctx, nil, nil, endpoints)))
+	assert.NoError(t, p.AdmitRequest(ctx, nil, endpoints))
+
+	p.dispatch("3", b)
+	p.dispatch("4", b)
+	err := p.AdmitRequest(ctx, nil, endpoints)
+	var e errcommon.Error
+	require.True(t, errors.As(err, &e))
+	assert.Equal(t, errcommon.ServiceUnavailable, e.Code)
+	// Endpoints are not filtered out when all are at their limit.
+	assert.Equal(t, []string{"a", "b"}, names(p.Filter(ctx, nil, nil, endpoints)))
+
+	p.complete(first, fast)
+	assert.Equal(t, []string{"a"}, names(p.Filter(ctx, nil, nil, endpoints)))
+}
+
+func TestLimitAdjustment(t *testing.T) {
+	p := newTestPlugin(Config{InitialLimit: 4, MinLimit: 2, MaxLimit: 5, LatencyThresholdMs: 1000, BackoffRatio: 0.5})
+	a := makeEndpoint("a")
+
+	// Each response within the threshold raises the limit by 1/limit, up to the max limit.
+	p.complete(p.dispatch("1", a), fast)
+	assert.InDelta(t, 4.25, p.limit("a"), 1e-9)
+	for i := range 20 {
+		p.complete(p.dispatch(string(rune('a'+i)), a), fast)
+	}
+	assert.InDelta(t, 5, p.limit("a"), 1e-9)
+
+	// The requests dispatched before a decrease do not decrease the limit again.
+	inFlight := []*framework.InferenceRequest{p.dispatch("x", a), p.dispatch("y", a), p.dispatch("z", a)}
+	p.complete(inFlight[0], slow)
+	assert.InDelta(t, 2.5, p.limit("a"), 1e-9)
+	p.complete(inFlight[1], failed)
+	assert.InDelta(t, 2.5, p.limit("a"), 1e-9)
+	// Abandoned requests release their endpoint without adjusting its limit.
+	p.complete(inFlight[2], &requestcontrol.CompletedResponse{Abandoned: true, Latency: time.Millisecond})
+	assert.InDelta(t, 2.5, p.limit("a"), 1e-9)
+	assert.Zero(t, p.endpoints[types.NamespacedName{Name: "a", Namespace: "default"}].inFlight)
+
+	// The requests dispatched after a decrease decrease the limit again, down to the min limit.
+	p.complete(p.dispatch("w", a), failed)
+	assert.InDelta(t, 2, p.limit("a"), 1e-9)
+	assert.Empty(t, p.dispatches)
+}
+
+func TestEndpointDeletion(t *testing.T) {
+	p := newTestPlugin(Config{InitialLimit: 4, MinLimit: 2, MaxLimit: 5, LatencyThresholdMs: 1000, BackoffRatio: 0.5})
+	a := makeEndpoint("a")
+	inFlight := p.dispatch("1", a)
+	p.complete(p.dispatch("2", a), slow)
+	assert.InDelta(t, 2, p.limit("a"), 1e-9)
+	endpoint := fwkdl.NewEndpoint(a.GetMetadata(), nil)
+
+	// Updates keep the state of the endpoint.
+	p.OnEndpointChange(context.Background(), fwkdl.EndpointChange{Type: fwkdl.EndpointUpdated, Endpoint: endpoint})
+	assert.InDelta(t, 2, p.limit("a"), 1e-9)
+
+	// Deletions drop it, so that a pod recreated with the same name starts from the initial limit.
+	p.OnEndpointChange(context.Background(), fwkdl.EndpointChange{Type: fwkdl.EndpointDeleted, Endpoint: endpoint})
+	assert.Empty(t, p.endpoints)
+
+	// The requests in flight on a deleted endpoint do not recreate its state.
+	p.complete(inFlight, fast)
+	assert.Empty(t, p.endpoints)
+	assert.Empty(t, p.dispatches)
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package evalrunaffinity provides a filter that pins requests of an evaluation or benchmark run to a fixed subset
+// of endpoints for the duration of the run. Requests carry the run identifier in a header; requests without it are
+// not affected by the filter.
+package evalrunaffinity
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/jellydator/ttlcache/v3"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+const (
+	PluginType = "eval-run-affinity-filter"
+
+	// DefaultRunIDHeader is the request header carrying the evaluation run identifier.
+	DefaultRunIDHeader = "x-gateway-eval-run-id"
+)
+
+var (
+	_ framework.Filter          = &Plugin{}
+	_ requestcontrol.PreRequest = &Plugin{}
+)
+
+type Config struct {
+	// RunIDHeader is the request header carrying the evaluation run identifier. Default: x-gateway-eval-run-id.
+	RunIDHeader string `json:"runIdHeader,omitempty"`
+
+	// SubsetSize is the number of endpoints a run is pinned to. Default: 1.
+	SubsetSize int `json:"subsetSize,omitempty"`
+
+	// IdleTimeoutSeconds is the time after the last request of a run at which the run is considered finished and
+	// its pinned subset (and run metrics) are released. Default: 1800.
+	IdleTimeoutSeconds int `json:"idleTimeoutSeconds,omitempty"`
+
+	// MaxRuns is the maximum number of runs pinned at once. The run identifier is chosen by the clients, so the least
+	// recently used run is released when the limit is reached. Default: 10000.
+	MaxRuns int `json:"maxRuns,omitempty"`
+}
+
+var DefaultConfig = Config{
+	RunIDHeader:        DefaultRunIDHeader,
+	SubsetSize:         1,
+	IdleTimeoutSeconds: 1800,
+	MaxRuns:            10000,
+}
+
+// Plugin is a filter narrowing the candidates of a request belonging to an evaluation run to the endpoints pinned for
+// that run. The subset is selected with rendezvous hashing of the run identifier, so it is stable across EPP replicas
+// and does not reshuffle when unrelated endpoints join or leave the pool. Once selected, the subset is kept for the
+// duration of the run; a pinned endpoint is only replaced when it is no longer a candidate.
+type Plugin struct {
+	typedName fwkplugin.TypedName
+	config    Config
+
+	mu   sync.Mutex
+	runs *ttlcache.Cache[string, []string]
+}
+
+func Factory(name string, rawParameters json.RawMessage, handle fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	config := DefaultConfig
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal config: %w", err)
+		}
+	}
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
+	ctx := context.Background()
+	if handle != nil {
+		ctx = handle.Context()
+	}
+	return New(ctx, config).WithName(name), nil
+}
+
+func (c *Config) validate() error {
+	if c.RunIDHeader == "" {
+		return errors.New("runIdHeader must not be empty")
+	}
+	if c.SubsetSize <= 0 {
+		return fmt.Errorf("subsetSize must be > 0, got %d", c.SubsetSize)
+	}
+	if c.IdleTimeoutSeconds <= 0 {
+		return fmt.Errorf("idleTimeoutSeconds must be > 0, got %d", c.IdleTimeoutSeconds)
+	}
+	if c.MaxRuns <= 0 {
+		return fmt.Errorf("maxRuns must be > 0, got %d", c.MaxRuns)
+	}
+	return nil
+}
+
+// New creates a new eval run affinity filter. Pinned runs are expired in the background until ctx is cancelled.
+// A MaxRuns of zero defaults to DefaultConfig.MaxRuns.
+func New(ctx context.Context, config Config) *Plugin {
+	if config.MaxRuns <= 0 {
+		config.MaxRuns = DefaultConfig.MaxRuns
+	}
+	runs := ttlcache.New(
+		ttlcache.WithTTL[string, []string](time.Duration(config.IdleTimeoutSeconds)*time.Second),
+		ttlcache.WithCapacity[string, []string](uint64(config.MaxRuns)),
+	)
+	go runs.Start()
+	go func() {
+		<-ctx.Done()
+		runs.Stop()
+	}()
+
+	// Envoy delivers header keys in lowercase.
+	config.RunIDHeader = strings.ToLower(config.RunIDHeader)
+	return &Plugin{
+		typedName: fwkplugin.TypedName{Type: PluginType, Name: PluginType},
+		config:    config,
+		runs:      runs,
+	}
+}
+
+// WithName sets the name of the plugin.
+func (p *Plugin) WithName(name string) *Plugin {
+	p.typedName.Name = name
+	return p
+}
+
+func (p *Plugin) TypedName() fwkplugin.TypedName {
+	return p.typedName
+}
+
+// Filter narrows the candidates of an evaluation run request to the endpoints pinned for the run.
+func (p *Plugin) Filter(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest, endpoints []framework.Endpoint) []framework.Endpoint {
+	runID := p.runID(request)
+	if runID == "" || len(endpoints) == 0 {
+		return endpoints
+	}
+
+	candidates := make(map[string]framework.Endpoint, len(endpoints))
+	for _, endpoint := range endpoints {
+		candidates[endpoint.GetMetadata().NamespacedName.String()] = endpoint
+	}
+
+	p.mu.Lock()
+	var pinned []string
+	if item := p.runs.Get(runID); item != nil {
+		pinned = item.Value()
+	}
+	subset := make([]string, 0, p.config.SubsetSize)
+	for _, name := range pinned {
+		if _, ok := candidates[name]; ok {
+			subset = append(subset, name)
+		}
+	}
+	if len(subset) < p.config.SubsetSize {
+		subset = fillSubset(runID, subset, candidates, p.config.SubsetSize)
+		p.runs.Set(runID, subset, ttlcache.DefaultTTL)
+	}
+	p.mu.Unlock()
+
+	filtered := make([]framework.Endpoint, 0, len(subset))
+	for _, name := range subset {
+		filtered = append(filtered, candidates[name])
+	}
+	log.FromContext(ctx).V(logutil.DEBUG).Info("EvalRunAffinityFilter: narrowed to run subset",
+		"runID", runID, "subset", subset, "total", len(endpoints))
+	return filtered
+}
+
+// PreRequest counts the request of an evaluation run in the EPP metrics, and logs the endpoint serving it for the
+// accounting of the run.
+func (p *Plugin) PreRequest(ctx context.Context, request *framework.InferenceRequest, schedulingResult *framework.SchedulingResult) {
+	runID := p.runID(request)
+	if runID == "" || schedulingResult == nil {
+		return
+	}
+	result, ok := schedulingResult.ProfileResults[schedulingResult.PrimaryProfileName]
+	if !ok || result == nil || len(result.TargetEndpoints) == 0 {
+		return
+	}
+	target := result.TargetEndpoints[0].GetMetadata().NamespacedName
+	metrics.RecordEvalRunRequest(request.TargetModel, target.Namespace, target.Name)
+	log.FromContext(ctx).V(logutil.DEBUG).Info("EvalRunAffinityFilter: run request served",
+		"runID", runID, "endpoint", target)
+}
+
+func (p *Plugin) runID(request *framework.InferenceRequest) string {
+	if request == nil {
+		return ""
+	}
+	return request.Headers[p.config.RunIDHeader]
+}
+
+// fillSubset tops up the given subset up to size endpoints, choosing the candidates with the highest rendezvous hash
+// weight for the run that are not part of the subset yet.
+func fillSubset(runID string, subset []string, candidates map[string]framework.Endpoint, size int) []string {
+	inSubset := make(map[string]bool, len(subset))
+	for _, name := range subset {
+		inSubset[name] = true
+	}
+	type weighted struct {
+		name   string
+		weight uint64
+	}
+	ranked := make([]weighted, 0, len(candidates))
+	for name := range candidates {
+		if !inSubset[name] {
+			ranked = append(ranked, weighted{name: name, weight: xxhash.Sum64String(runID + "/" + name)})
+		}
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].weight != ranked[j].weight {
+			return ranked[i].weight > ranked[j].weight
+		}
+		return ranked[i].name < ranked[j].name
+	})
+	for i := 0; i < len(ranked) && len(subset) < size; i++ {
+		subset = append(subset, ranked[i].name)
+	}
+	return subset
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalrunaffinity
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+func makeEndpoints(names ...string) []framework.Endpoint {
+	endpoints := make([]framework.Endpoint, 0, len(names))
+	for _, name := range names {
+		meta := &fwkdl.EndpointMetadata{NamespacedName: types.NamespacedName{Name: name, Namespace: "default"}}
+		endpoints = append(endpoints, framework.NewEndpoint(meta, &fwkdl.Metrics{}, fwkdl.NewAttributes()))
+	}
+	return endpoints
+}
+
+func names(endpoints []framework.Endpoint) []string {
+	res := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		res = append(res, endpoint.GetMetadata().NamespacedName.Name)
+	}
+	return res
+}
+
+func evalRequest(runID string) *framework.InferenceRequest {
+	return &framework.InferenceRequest{Headers: map[string]string{DefaultRunIDHeader: runID}}
+}
+
+func TestFilter_NoRunID(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p := New(ctx, DefaultConfig)
+
+	endpoints := makeEndpoints("a", "b", "c")
+	assert.Len(t, p.Filter(ctx, nil, &framework.InferenceRequest{Headers: map[string]string{}}, endpoints), 3)
+	assert.Len(t, p.Filter(ctx, nil, nil, endpoints), 3)
+}
+
+func TestFilter_StickyForRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p := New(ctx, Config{RunIDHeader: "X-Gateway-Eval-Run-Id", SubsetSize: 2, IdleTimeoutSeconds: 60})
+
+	endpoints := makeEndpoints("a", "b", "c", "d", "e")
+	first := names(p.Filter(ctx, nil, evalRequest("run-1"), endpoints))
+	require.Len(t, first, 2)
+
+	// The subset does not change for subsequent requests of the run, even when the pool changes.
+	for range 10 {
+		assert.Equal(t, first, names(p.Filter(ctx, nil, evalRequest("run-1"), endpoints)))
+	}
+	grown := append(makeEndpoints("f", "g", "h"), endpoints...)
+	assert.Equal(t, first, names(p.Filter(ctx, nil, evalRequest("run-1"), grown)))
+
+	// A pinned endpoint leaving the pool is replaced, the remaining pinned endpoint is kept.
+	remaining := []framework.Endpoint{}
+	for _, endpoint := range endpoints {
+		if endpoint.GetMetadata().NamespacedName.Name != first[0] {
+			remaining = append(remaining, endpoint)
+		}
+	}
+	replaced := names(p.Filter(ctx, nil, evalRequest("run-1"), remaining))
+	require.Len(t, replaced, 2)
+	assert.Equal(t, first[1], replaced[0])
+	assert.NotContains(t, replaced, first[0])
+}
+
+func TestFilter_SubsetLargerThanPool(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p := New(ctx, Config{RunIDHeader: DefaultRunIDHeader, SubsetSize: 4, IdleTimeoutSeconds: 60})
+
+	assert.ElementsMatch(t, []string{"a", "b"}, names(p.Filter(ctx, nil, evalRequest("run-1"), makeEndpoints("a", "b"))))
+}
+
+func TestFilter_MaxRuns(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p := New(ctx, Config{RunIDHeader: DefaultRunIDHeader, SubsetSize: 1, IdleTimeoutSeconds: 60, MaxRuns: 2})
+
+	endpoints := makeEndpoints("a", "b", "c")
+	p.Filter(ctx, nil, evalRequest("run-1"), endpoints)
+	p.Filter(ctx, nil, evalRequest("run-2"), endpoints)
+	p.Filter(ctx, nil, evalRequest("run-1"), endpoints)
+	p.Filter(ctx, nil, evalRequest("run-3"), endpoints)
+
+	// The least recently used run is released to make room for the new one.
+	assert.Equal(t, 2, p.runs.Len())
+	assert.True(t, p.runs.Has("run-1"))
+	assert.False(t, p.runs.Has("run-2"))
+	assert.True(t, p.runs.Has("run-3"))
+}
+
+func TestFactory(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  string
+		wantErr bool
+	}{
+		{name: "defaults", params: ""},
+		{name: "valid", params: `{"runIdHeader":"x-run","subsetSize":3,"idleTimeoutSeconds":600}`},
+		{name: "invalid subset size", params: `{"subsetSize":-1}`, wantErr: true},
+		{name: "invalid idle timeout", params: `{"idleTimeoutSeconds":-1}`, wantErr: true},
+		{name: "invalid max runs", params: `{"maxRuns":-1}`, wantErr: true},
+		{name: "malformed", params: `{"subsetSize":"two"}`, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := Factory("eval", json.RawMessage(test.params), nil)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "eval", p.TypedName().Name)
+			assert.Equal(t, PluginType, p.TypedName().Type)
+		})
+	}
+}
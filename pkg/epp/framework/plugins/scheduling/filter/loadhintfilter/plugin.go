@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package loadhintfilter provides a filter dropping the endpoints whose model server pushed a load hint, e.g. of a
+// planned restart, ahead of the metrics reflecting it.
+package loadhintfilter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/loadhints"
+)
+
+const (
+	PluginType = "load-hint-filter"
+)
+
+var _ framework.Filter = &Plugin{}
+
+type Config struct {
+	// Kinds are the kinds of load hints dropping an endpoint: compaction-imminent, adapter-loading and
+	// restart-planned. Default: all the kinds.
+	Kinds []loadhints.Kind `json:"kinds,omitempty"`
+}
+
+func (c *Config) validate() error {
+	if c.Kinds != nil && len(c.Kinds) == 0 {
+		return errors.New("at least one kind is required")
+	}
+	for _, kind := range c.Kinds {
+		if !slices.Contains(loadhints.Kinds, kind) {
+			return fmt.Errorf("unknown kind %q, must be one of %v", kind, loadhints.Kinds)
+		}
+	}
+	return nil
+}
+
+// Plugin is a filter dropping the endpoints with active load hints. Endpoints loading an adapter are only dropped for
+// the requests targeting that adapter, which would wait for the load to complete. When all the endpoints have active
+// hints, none is dropped: hints are advisory, and a slower endpoint is better than a failed request.
+type Plugin struct {
+	typedName fwkplugin.TypedName
+	kinds     []loadhints.Kind
+	now       func() time.Time
+}
+
+// Factory creates a new load hint filter from the given parameters.
+func Factory(name string, rawParameters json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	config := Config{}
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal config for %s: %w", PluginType, err)
+		}
+	}
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config for %s: %w", PluginType, err)
+	}
+	return New(config).WithName(name), nil
+}
+
+// New creates a new load hint filter.
+func New(config Config) *Plugin {
+	kinds := config.Kinds
+	if len(kinds) == 0 {
+		kinds = loadhints.Kinds
+	}
+	return &Plugin{
+		typedName: fwkplugin.TypedName{Type: PluginType, Name: PluginType},
+		kinds:     kinds,
+		now:       time.Now,
+	}
+}
+
+func (p *Plugin) WithName(name string) *Plugin {
+	p.typedName.Name = name
+	return p
+}
+
+func (p *Plugin) TypedName() fwkplugin.TypedName {
+	return p.typedName
+}
+
+// Filter drops the endpoints with active load hints, unless all the endpoints have some.
+func (p *Plugin) Filter(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest,
+	endpoints []framework.Endpoint) []framework.Endpoint {
+	now := p.now()
+	targetModel := ""
+	if request != nil {
+		targetModel = request.TargetModel
+	}
+	filtered := make([]framework.Endpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		if !p.hinted(loadhints.FromEndpoint(endpoint), targetModel, now) {
+			filtered = append(filtered, endpoint)
+		}
+	}
+	if len(filtered) == 0 {
+		log.FromContext(ctx).V(logutil.DEBUG).Info("LoadHintFilter: all endpoints have load hints, keeping them all",
+			"total", len(endpoints))
+		return endpoints
+	}
+	log.FromContext(ctx).V(logutil.DEBUG).Info("LoadHintFilter: filtered endpoints with load hints",
+		"kept", len(filtered), "total", len(endpoints))
+	return filtered
+}
+
+// hinted returns whether the given hints drop their endpoint for a request of the given target model.
+func (p *Plugin) hinted(hints *loadhints.Hints, targetModel string, now time.Time) bool {
+	for _, kind := range p.kinds {
+		if kind == loadhints.KindAdapterLoading {
+			if targetModel != "" && hints.Has(kind, targetModel, now) {
+				return true
+			}
+			continue
+		}
+		if hints.Has(kind, "", now) {
+			return true
+		}
+	}
+	return false
+}
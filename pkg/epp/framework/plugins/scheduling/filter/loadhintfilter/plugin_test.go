@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadhintfilter
+
+import (
+	"encoding/json"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/peer"
+	"k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/loadhints"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/loadhints/api/gen"
+)
+
+type fakeDatastore struct {
+	endpoints []fwkdl.Endpoint
+}
+
+func (ds *fakeDatastore) PodList(predicate func(fwkdl.Endpoint) bool) []fwkdl.Endpoint {
+	res := []fwkdl.Endpoint{}
+	for _, ep := range ds.endpoints {
+		if predicate(ep) {
+			res = append(res, ep)
+		}
+	}
+	return res
+}
+
+func TestFactory(t *testing.T) {
+	_, err := Factory("f", nil, nil)
+	require.NoError(t, err)
+	_, err = Factory("f", json.RawMessage(`{"kinds": ["restart-planned"]}`), nil)
+	require.NoError(t, err)
+	_, err = Factory("f", json.RawMessage(`{"kinds": ["oom"]}`), nil)
+	assert.Error(t, err, "unknown kind")
+	_, err = Factory("f", json.RawMessage(`{"kinds": []}`), nil)
+	assert.Error(t, err, "no kind")
+}
+
+func TestFilter(t *testing.T) {
+	// Hints are pushed to the datalayer endpoints through the load hints server, as model servers do.
+	hints := map[string]*gen.LoadHint{
+		"restarting": {Kind: gen.HintKind_HINT_KIND_RESTART_PLANNED, DurationMs: 30_000},
+		"loading":    {Kind: gen.HintKind_HINT_KIND_ADAPTER_LOADING, Adapter: "sql-lora", DurationMs: 30_000},
+		"compacting": {Kind: gen.HintKind_HINT_KIND_COMPACTION_IMMINENT, DurationMs: 30_000},
+		"idle":       nil,
+	}
+	dlEndpoints := []fwkdl.Endpoint{}
+	for name := range hints {
+		port := int32(8000 + len(dlEndpoints))
+		dlEndpoints = append(dlEndpoints, fwkdl.NewEndpoint(&fwkdl.EndpointMetadata{
+			NamespacedName: types.NamespacedName{Name: name, Namespace: "default"},
+			Address:        "10.0.0.1",
+			Port:           strconv.Itoa(int(port)),
+		}, nil))
+	}
+	server := loadhints.NewServer(&fakeDatastore{endpoints: dlEndpoints}, time.Minute)
+	ctx := peer.NewContext(t.Context(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 43210}})
+	for _, ep := range dlEndpoints {
+		hint := hints[ep.GetMetadata().NamespacedName.Name]
+		if hint == nil {
+			continue
+		}
+		port, _ := strconv.Atoi(ep.GetMetadata().Port)
+		resp, err := server.Push(ctx, &gen.PushRequest{Address: "10.0.0.1", Port: int32(port), Hints: []*gen.LoadHint{hint}})
+		require.NoError(t, err)
+		require.True(t, resp.GetAccepted())
+	}
+	endpoints := func(names ...string) []framework.Endpoint {
+		res := []framework.Endpoint{}
+		for _, ep := range dlEndpoints {
+			for _, name := range names {
+				if ep.GetMetadata().NamespacedName.Name == name {
+					res = append(res, framework.NewEndpoint(ep.GetMetadata(), ep.GetMetrics(), ep.GetAttributes()))
+				}
+			}
+		}
+		return res
+	}
+	keptNames := func(endpoints []framework.Endpoint) []string {
+		res := []string{}
+		for _, ep := range endpoints {
+			res = append(res, ep.GetMetadata().NamespacedName.Name)
+		}
+		return res
+	}
+
+	tests := []struct {
+		name      string
+		config    Config
+		model     string
+		endpoints []framework.Endpoint
+		want      []string
+	}{
+		{
+			name:      "other model",
+			config:    Config{},
+			model:     "chat-lora",
+			endpoints: endpoints("loading", "compacting", "idle"),
+			want:      []string{"loading", "idle"},
+		},
+		{
+			name:      "adapter being loaded",
+			config:    Config{},
+			model:     "sql-lora",
+			endpoints: endpoints("loading", "compacting", "idle"),
+			want:      []string{"idle"},
+		},
+		{
+			name:      "configured kinds only",
+			config:    Config{Kinds: []loadhints.Kind{loadhints.KindAdapterLoading}},
+			model:     "sql-lora",
+			endpoints: endpoints("loading", "compacting", "idle"),
+			want:      []string{"compacting", "idle"},
+		},
+		{
+			name:      "all hinted",
+			config:    Config{},
+			model:     "sql-lora",
+			endpoints: endpoints("loading", "compacting"),
+			want:      []string{"loading", "compacting"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := New(test.config).Filter(t.Context(), nil, &framework.InferenceRequest{TargetModel: test.model}, test.endpoints)
+			assert.ElementsMatch(t, test.want, keptNames(got))
+		})
+	}
+}
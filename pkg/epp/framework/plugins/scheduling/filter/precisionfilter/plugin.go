@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Package precisionfilter provides a filter restricting requests requiring full precision to full-precision endpoints,
+// for pools serving the same model at several precisions.
+package precisionfilter
+
+import (
+	"context"
+	"encoding/json"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+const (
+	PluginType = "precision-filter"
+)
+
+var _ framework.Filter = &Plugin{}
+
+// Plugin is a filter keeping the full-precision endpoints for the requests requiring full precision. The precision of
+// an endpoint is read from the "inference.networking.k8s.io/precision" pod label, or as reported by the model server.
+// Endpoints of unknown precision are considered full precision.
+type Plugin struct {
+	typedName fwkplugin.TypedName
+}
+
+func Factory(name string, _ json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	return New().WithName(name), nil
+}
+
+// New creates a new precision filter.
+func New() *Plugin {
+	return &Plugin{typedName: fwkplugin.TypedName{Type: PluginType, Name: PluginType}}
+}
+
+// WithName sets the name of the plugin.
+func (p *Plugin) WithName(name string) *Plugin {
+	p.typedName.Name = name
+	return p
+}
+
+func (p *Plugin) TypedName() fwkplugin.TypedName {
+	return p.typedName
+}
+
+// Filter keeps the full-precision endpoints if the request requires full precision, and all endpoints otherwise.
+func (p *Plugin) Filter(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest, endpoints []framework.Endpoint) []framework.Endpoint {
+	if request == nil || request.Objectives.Precision != framework.PrecisionFull {
+		return endpoints
+	}
+	filtered := make([]framework.Endpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		if !fwkdl.IsQuantized(fwkdl.Precision(endpoint)) {
+			filtered = append(filtered, endpoint)
+		}
+	}
+	log.FromContext(ctx).V(logutil.DEBUG).Info("PrecisionFilter: filtered endpoints requiring full precision",
+		"kept", len(filtered), "total", len(endpoints))
+	return filtered
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package precisionfilter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+func makeEndpoint(name, labeled, reported string) framework.Endpoint {
+	labels := map[string]string{}
+	if labeled != "" {
+		labels[fwkdl.PrecisionLabel] = labeled
+	}
+	meta := &fwkdl.EndpointMetadata{NamespacedName: types.NamespacedName{Name: name, Namespace: "default"}, Labels: labels}
+	return framework.NewEndpoint(meta, &fwkdl.Metrics{Precision: reported}, fwkdl.NewAttributes())
+}
+
+func TestFilter(t *testing.T) {
+	endpoints := []framework.Endpoint{
+		makeEndpoint("bf16", "bf16", ""),
+		makeEndpoint("fp8", "fp8", ""),
+		makeEndpoint("awq-reported", "", "awq"),
+		makeEndpoint("unknown", "", ""),
+	}
+
+	tests := []struct {
+		name      string
+		precision framework.PrecisionRequirement
+		want      []string
+	}{
+		{name: "no requirement", want: []string{"bf16", "fp8", "awq-reported", "unknown"}},
+		{name: "prefer quantized", precision: framework.PrecisionPreferQuantized, want: []string{"bf16", "fp8", "awq-reported", "unknown"}},
+		{name: "full precision", precision: framework.PrecisionFull, want: []string{"bf16", "unknown"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := &framework.InferenceRequest{Objectives: framework.RequestObjectives{Precision: test.precision}}
+			got := []string{}
+			for _, endpoint := range New().Filter(context.Background(), nil, request, endpoints) {
+				got = append(got, endpoint.GetMetadata().NamespacedName.Name)
+			}
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
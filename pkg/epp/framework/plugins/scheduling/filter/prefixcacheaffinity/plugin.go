@@ -102,12 +102,17 @@ func (p *Plugin) TypedName() fwkplugin.TypedName {
 	return p.typedName
 }
 
-func (p *Plugin) Filter(ctx context.Context, _ *framework.CycleState, _ *framework.InferenceRequest, endpoints []framework.Endpoint) []framework.Endpoint {
+func (p *Plugin) Filter(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest, endpoints []framework.Endpoint) []framework.Endpoint {
 	logger := log.FromContext(ctx)
 
 	if len(endpoints) <= 1 || p.config.AffinityThreshold <= 0 {
 		return endpoints
 	}
+	if request != nil && request.Hints.DisableCacheAffinity {
+		logger.V(logutil.DEBUG).Info("PrefixCacheAffinityFilter: cache affinity disabled by the request, keeping all",
+			"total", len(endpoints))
+		return endpoints
+	}
 
 	// Exploration: skip the gate with configured probability.
 	if rand.Float64() < p.config.ExplorationProbability {
@@ -84,6 +84,17 @@ func TestFilter_NarrowToSticky(t *testing.T) {
 	assert.Equal(t, 2, len(result), "should narrow to sticky endpoints")
 }
 
+func TestFilter_CacheAffinityDisabledByRequest(t *testing.T) {
+	p := &Plugin{config: Config{AffinityThreshold: 0.80, ExplorationProbability: 0, MaxTTFTPenaltyMs: 5000}}
+	endpoints := []framework.Endpoint{
+		makeEndpoint("a", 90, 100),
+		makeEndpoint("b", 10, 50),
+	}
+	request := &framework.InferenceRequest{Hints: framework.SchedulingHints{DisableCacheAffinity: true}}
+	result := p.Filter(context.Background(), nil, request, endpoints)
+	assert.Equal(t, 2, len(result), "disabled cache affinity should keep all endpoints")
+}
+
 func TestFilter_TTFTPenaltyBreaksStickiness(t *testing.T) {
 	p := &Plugin{config: Config{AffinityThreshold: 0.80, ExplorationProbability: 0, MaxTTFTPenaltyMs: 100}}
 	endpoints := []framework.Endpoint{
@@ -0,0 +1,338 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package promptquarantine provides a plugin detecting prompt patterns that repeatedly cause backend errors or extreme
+// latency, and isolating the subsequent requests matching them on designated quarantine endpoints, or rejecting them,
+// to protect the rest of the pool.
+package promptquarantine
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/jellydator/ttlcache/v3"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+const (
+	PluginType = "prompt-quarantine-filter"
+
+	// DefaultQuarantineLabel is the pod label designating the quarantine endpoints, with the value "true".
+	DefaultQuarantineLabel = "inference.networking.k8s.io/quarantine"
+
+	// ActionIsolate routes the requests matching a quarantined pattern to the quarantine endpoints. Such requests are
+	// rejected when no quarantine endpoint is a candidate.
+	ActionIsolate = "isolate"
+	// ActionReject rejects the requests matching a quarantined pattern.
+	ActionReject = "reject"
+
+	actionQuarantined = "quarantined"
+	actionIsolated    = "isolated"
+	actionRejected    = "rejected"
+)
+
+var (
+	_ framework.Filter            = &Plugin{}
+	_ requestcontrol.Admitter     = &Plugin{}
+	_ requestcontrol.PostResponse = &Plugin{}
+)
+
+type Config struct {
+	// PrefixLength is the number of characters of the normalized prompt identifying a prompt pattern. Default: 512.
+	PrefixLength int `json:"prefixLength,omitempty"`
+
+	// WindowSeconds is the duration of the rolling window over which the failures of a pattern are counted.
+	// Default: 600.
+	WindowSeconds int `json:"windowSeconds,omitempty"`
+
+	// FailureThreshold is the number of failures of a pattern within the window from which it is quarantined.
+	// Default: 3.
+	FailureThreshold int `json:"failureThreshold,omitempty"`
+
+	// FailureRatio is the minimal ratio of failed requests of a pattern within the window for it to be quarantined,
+	// so that popular prompts are not quarantined for a few unrelated failures. Default: 0.5.
+	FailureRatio float64 `json:"failureRatio,omitempty"`
+
+	// LatencyThresholdMs is the request latency, in milliseconds, above which a request is counted as failed.
+	// Zero counts backend errors only. Default: 0.
+	LatencyThresholdMs int `json:"latencyThresholdMs,omitempty"`
+
+	// QuarantineSeconds is the duration for which a pattern stays quarantined. Default: 1800.
+	QuarantineSeconds int `json:"quarantineSeconds,omitempty"`
+
+	// Action is the action taken on the requests matching a quarantined pattern, either "isolate" or "reject".
+	// Default: isolate.
+	Action string `json:"action,omitempty"`
+
+	// QuarantineLabel is the pod label designating the quarantine endpoints, with the value "true". Other requests
+	// avoid the quarantine endpoints. Default: inference.networking.k8s.io/quarantine.
+	QuarantineLabel string `json:"quarantineLabel,omitempty"`
+}
+
+var DefaultConfig = Config{
+	PrefixLength:      512,
+	WindowSeconds:     600,
+	FailureThreshold:  3,
+	FailureRatio:      0.5,
+	QuarantineSeconds: 1800,
+	Action:            ActionIsolate,
+	QuarantineLabel:   DefaultQuarantineLabel,
+}
+
+func (c *Config) validate() error {
+	if c.PrefixLength <= 0 {
+		return fmt.Errorf("prefixLength must be > 0, got %d", c.PrefixLength)
+	}
+	if c.WindowSeconds <= 0 {
+		return fmt.Errorf("windowSeconds must be > 0, got %d", c.WindowSeconds)
+	}
+	if c.FailureThreshold <= 0 {
+		return fmt.Errorf("failureThreshold must be > 0, got %d", c.FailureThreshold)
+	}
+	if c.FailureRatio <= 0 || c.FailureRatio > 1 {
+		return fmt.Errorf("failureRatio must be in (0, 1], got %v", c.FailureRatio)
+	}
+	if c.LatencyThresholdMs < 0 {
+		return fmt.Errorf("latencyThresholdMs must be >= 0, got %d", c.LatencyThresholdMs)
+	}
+	if c.QuarantineSeconds <= 0 {
+		return fmt.Errorf("quarantineSeconds must be > 0, got %d", c.QuarantineSeconds)
+	}
+	if c.Action != ActionIsolate && c.Action != ActionReject {
+		return fmt.Errorf("action must be %q or %q, got %q", ActionIsolate, ActionReject, c.Action)
+	}
+	if c.Action == ActionIsolate && c.QuarantineLabel == "" {
+		return errors.New("quarantineLabel must not be empty when the action is isolate")
+	}
+	return nil
+}
+
+// patternStats are the failure stats of a prompt pattern within the current window.
+type patternStats struct {
+	windowStart time.Time
+	requests    int
+	failures    int
+}
+
+// Plugin detects prompt patterns that repeatedly cause backend errors or extreme latency, and quarantines them.
+//
+// A prompt pattern is identified by the target model and a hash of the normalized prefix of the prompt, so that
+// retries and minor variations of a problematic prompt map to the same pattern. The outcome of every request is
+// counted for its pattern over a rolling window, and a pattern is quarantined once its failures reach both the
+// failure threshold and the failure ratio. The subsequent requests matching a quarantined pattern are isolated on the
+// quarantine endpoints or rejected, until the quarantine expires.
+//
+// The plugin must be referenced as a filter in the scheduling profiles for the requests to be isolated.
+type Plugin struct {
+	typedName fwkplugin.TypedName
+	config    Config
+	now       func() time.Time
+
+	mu          sync.Mutex
+	stats       *ttlcache.Cache[uint64, *patternStats]
+	quarantined *ttlcache.Cache[uint64, struct{}]
+}
+
+func Factory(name string, rawParameters json.RawMessage, handle fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	config := DefaultConfig
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal config for %s: %w", PluginType, err)
+		}
+	}
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config for %s: %w", PluginType, err)
+	}
+
+	ctx := context.Background()
+	if handle != nil {
+		ctx = handle.Context()
+	}
+	return New(ctx, config).WithName(name), nil
+}
+
+// New creates a new prompt quarantine plugin. Expired stats and quarantines are removed in the background until ctx
+// is cancelled.
+func New(ctx context.Context, config Config) *Plugin {
+	stats := ttlcache.New(ttlcache.WithTTL[uint64, *patternStats](time.Duration(config.WindowSeconds) * time.Second))
+	quarantined := ttlcache.New(ttlcache.WithTTL[uint64, struct{}](time.Duration(config.QuarantineSeconds) * time.Second))
+	go stats.Start()
+	go quarantined.Start()
+	go func() {
+		<-ctx.Done()
+		stats.Stop()
+		quarantined.Stop()
+	}()
+
+	return &Plugin{
+		typedName:   fwkplugin.TypedName{Type: PluginType, Name: PluginType},
+		config:      config,
+		now:         time.Now,
+		stats:       stats,
+		quarantined: quarantined,
+	}
+}
+
+// WithName sets the name of the plugin.
+func (p *Plugin) WithName(name string) *Plugin {
+	p.typedName.Name = name
+	return p
+}
+
+func (p *Plugin) TypedName() fwkplugin.TypedName {
+	return p.typedName
+}
+
+// AdmitRequest rejects the requests matching a quarantined pattern, unless they can be isolated on a quarantine
+// endpoint.
+func (p *Plugin) AdmitRequest(ctx context.Context, request *framework.InferenceRequest, endpoints []framework.Endpoint) error {
+	pattern, ok := p.pattern(request)
+	if !ok || !p.quarantined.Has(pattern) {
+		return nil
+	}
+	logger := log.FromContext(ctx).V(logutil.DEBUG).WithValues("pattern", pattern)
+	if p.config.Action == ActionIsolate && len(p.quarantineEndpoints(endpoints)) > 0 {
+		metrics.RecordPromptQuarantine(request.TargetModel, actionIsolated)
+		logger.Info("Isolating request matching a quarantined prompt pattern")
+		return nil
+	}
+	metrics.RecordPromptQuarantine(request.TargetModel, actionRejected)
+	logger.Info("Rejecting request matching a quarantined prompt pattern")
+	return errcommon.Error{
+		Code: errcommon.Quarantined,
+		Msg:  "the request matches a prompt pattern quarantined for repeatedly failing model servers",
+	}
+}
+
+// Filter keeps the quarantine endpoints for the requests matching a quarantined pattern, and the other endpoints for
+// the other requests. Other requests are not filtered when there are only quarantine endpoints.
+func (p *Plugin) Filter(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest, endpoints []framework.Endpoint) []framework.Endpoint {
+	if pattern, ok := p.pattern(request); ok && p.quarantined.Has(pattern) {
+		filtered := p.quarantineEndpoints(endpoints)
+		log.FromContext(ctx).V(logutil.DEBUG).Info("PromptQuarantineFilter: isolated quarantined prompt pattern",
+			"pattern", pattern, "kept", len(filtered), "total", len(endpoints))
+		return filtered
+	}
+	filtered := make([]framework.Endpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		if !p.isQuarantineEndpoint(endpoint.GetMetadata()) {
+			filtered = append(filtered, endpoint)
+		}
+	}
+	if len(filtered) == 0 {
+		return endpoints
+	}
+	return filtered
+}
+
+// PostResponse counts the outcome of the request for its pattern. Requests abandoned by their clients are only
+// counted when they failed or exceeded the latency threshold.
+func (p *Plugin) PostResponse(ctx context.Context, request *framework.InferenceRequest, response *requestcontrol.CompletedResponse,
+	_ *fwkdl.EndpointMetadata) {
+	pattern, ok := p.pattern(request)
+	if !ok || response == nil {
+		return
+	}
+	failed := response.Failed
+	if threshold := time.Duration(p.config.LatencyThresholdMs) * time.Millisecond; threshold > 0 && response.Latency > threshold {
+		failed = true
+	}
+	if response.Abandoned && !failed {
+		return
+	}
+	p.observe(ctx, pattern, request.TargetModel, failed)
+}
+
+// observe counts the outcome of a request for its pattern, and quarantines the pattern once it crosses the
+// thresholds.
+func (p *Plugin) observe(ctx context.Context, pattern uint64, model string, failed bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := p.now()
+	window := time.Duration(p.config.WindowSeconds) * time.Second
+	var stats *patternStats
+	if item := p.stats.Get(pattern, ttlcache.WithDisableTouchOnHit[uint64, *patternStats]()); item != nil {
+		stats = item.Value()
+	}
+	if stats == nil || now.Sub(stats.windowStart) > window {
+		stats = &patternStats{windowStart: now}
+		p.stats.Set(pattern, stats, ttlcache.DefaultTTL)
+	}
+	stats.requests++
+	if !failed {
+		return
+	}
+	stats.failures++
+	if stats.failures < p.config.FailureThreshold || float64(stats.failures)/float64(stats.requests) < p.config.FailureRatio ||
+		p.quarantined.Has(pattern) {
+		return
+	}
+	p.quarantined.Set(pattern, struct{}{}, ttlcache.DefaultTTL)
+	metrics.RecordPromptQuarantine(model, actionQuarantined)
+	log.FromContext(ctx).V(logutil.DEFAULT).Info("Quarantined prompt pattern repeatedly failing model servers",
+		"pattern", pattern, "model", model, "failures", stats.failures, "requests", stats.requests,
+		"action", p.config.Action)
+}
+
+func (p *Plugin) quarantineEndpoints(endpoints []framework.Endpoint) []framework.Endpoint {
+	quarantine := make([]framework.Endpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		if p.isQuarantineEndpoint(endpoint.GetMetadata()) {
+			quarantine = append(quarantine, endpoint)
+		}
+	}
+	return quarantine
+}
+
+func (p *Plugin) isQuarantineEndpoint(metadata *fwkdl.EndpointMetadata) bool {
+	return metadata != nil && p.config.QuarantineLabel != "" && metadata.Labels[p.config.QuarantineLabel] == "true"
+}
+
+// pattern returns the prompt pattern of the request: a hash of its target model and of the normalized prefix of its
+// prompt. It returns false for requests without a prompt.
+func (p *Plugin) pattern(request *framework.InferenceRequest) (uint64, bool) {
+	if request == nil || request.Body == nil {
+		return 0, false
+	}
+	prompt := normalize(request.Body.PromptText(), p.config.PrefixLength)
+	if prompt == "" {
+		return 0, false
+	}
+	return xxhash.Sum64String(request.TargetModel + "\x00" + prompt), true
+}
+
+// normalize lowercases the prompt, collapses its whitespace and truncates it to the given number of characters.
+func normalize(prompt string, length int) string {
+	normalized := []rune(strings.ToLower(strings.Join(strings.Fields(prompt), " ")))
+	if len(normalized) > length {
+		normalized = normalized[:length]
+	}
+	return string(normalized)
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promptquarantine
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	fwkrh "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+func makeEndpoint(name string, quarantine bool) framework.Endpoint {
+	labels := map[string]string{}
+	if quarantine {
+		labels[DefaultQuarantineLabel] = "true"
+	}
+	meta := &fwkdl.EndpointMetadata{NamespacedName: types.NamespacedName{Name: name, Namespace: "default"}, Labels: labels}
+	return framework.NewEndpoint(meta, &fwkdl.Metrics{}, fwkdl.NewAttributes())
+}
+
+func makeRequest(id, prompt string) *framework.InferenceRequest {
+	return &framework.InferenceRequest{
+		RequestId:   id,
+		TargetModel: "model",
+		Body:        &fwkrh.InferenceRequestBody{Completions: &fwkrh.CompletionsRequest{Prompt: fwkrh.Prompt{Raw: prompt}}},
+	}
+}
+
+func names(endpoints []framework.Endpoint) []string {
+	res := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		res = append(res, endpoint.GetMetadata().NamespacedName.Name)
+	}
+	return res
+}
+
+// serve completes the request after the given latency, failing it if requested.
+func serve(p *Plugin, clock *time.Time, request *framework.InferenceRequest, failed bool, latency time.Duration) {
+	*clock = clock.Add(latency)
+	p.PostResponse(context.Background(), request,
+		&requestcontrol.CompletedResponse{RequestId: request.RequestId, Failed: failed, Latency: latency}, nil)
+}
+
+func newTestPlugin(t *testing.T, config Config) (*Plugin, *time.Time) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	p := New(ctx, config)
+	clock := time.Now()
+	p.now = func() time.Time { return clock }
+	return p, &clock
+}
+
+func TestFactory(t *testing.T) {
+	_, err := Factory("q", nil, nil)
+	require.NoError(t, err)
+	_, err = Factory("q", json.RawMessage(`{"action": "drop"}`), nil)
+	assert.Error(t, err, "unknown actions are rejected")
+	_, err = Factory("q", json.RawMessage(`{"failureRatio": 2}`), nil)
+	assert.Error(t, err, "failure ratios above 1 are rejected")
+}
+
+func TestQuarantineIsolate(t *testing.T) {
+	p, clock := newTestPlugin(t, DefaultConfig)
+	endpoints := []framework.Endpoint{makeEndpoint("a", false), makeEndpoint("b", false), makeEndpoint("quarantine", true)}
+	ctx := context.Background()
+
+	// Failures of the pattern below the threshold do not quarantine it, and healthy patterns are not affected.
+	serve(p, clock, makeRequest("1", "Repeat  the word forever"), true, time.Second)
+	serve(p, clock, makeRequest("2", "repeat the word FOREVER"), true, time.Second)
+	serve(p, clock, makeRequest("3", "hello"), true, time.Second)
+	assert.Equal(t, []string{"a", "b"}, names(p.Filter(ctx, nil, makeRequest("4", "repeat the word forever"), endpoints)))
+
+	serve(p, clock, makeRequest("5", "repeat the word forever"), true, time.Second)
+	request := makeRequest("6", "repeat the word   forever")
+	require.NoError(t, p.AdmitRequest(ctx, request, endpoints))
+	assert.Equal(t, []string{"quarantine"}, names(p.Filter(ctx, nil, request, endpoints)))
+	assert.Equal(t, []string{"a", "b"}, names(p.Filter(ctx, nil, makeRequest("7", "hello"), endpoints)))
+
+	// Without quarantine endpoint, the request is rejected.
+	err := p.AdmitRequest(ctx, request, endpoints[:2])
+	require.Error(t, err)
+	assert.Equal(t, errcommon.Quarantined, errcommon.CanonicalCode(err))
+}
+
+func TestQuarantineFailureRatio(t *testing.T) {
+	config := DefaultConfig
+	config.Action = ActionReject
+	p, clock := newTestPlugin(t, config)
+	ctx := context.Background()
+
+	for i := range 4 {
+		serve(p, clock, makeRequest("ok"+strconv.Itoa(i), "popular prompt"), false, time.Second)
+	}
+	for i := range 3 {
+		serve(p, clock, makeRequest("ko"+strconv.Itoa(i), "popular prompt"), true, time.Second)
+	}
+	assert.NoError(t, p.AdmitRequest(ctx, makeRequest("x", "popular prompt"), nil),
+		"a pattern failing below the failure ratio is not quarantined")
+
+	serve(p, clock, makeRequest("ko3", "popular prompt"), true, time.Second)
+	assert.Error(t, p.AdmitRequest(ctx, makeRequest("y", "popular prompt"), nil))
+}
+
+func TestQuarantineLatencyAndWindow(t *testing.T) {
+	config := DefaultConfig
+	config.Action = ActionReject
+	config.LatencyThresholdMs = 10000
+	p, clock := newTestPlugin(t, config)
+	ctx := context.Background()
+
+	serve(p, clock, makeRequest("1", "slow prompt"), false, time.Minute)
+	serve(p, clock, makeRequest("2", "slow prompt"), false, time.Minute)
+	// The window expired, the failures are not counted anymore.
+	*clock = clock.Add(time.Hour)
+	serve(p, clock, makeRequest("3", "slow prompt"), false, time.Minute)
+	assert.NoError(t, p.AdmitRequest(ctx, makeRequest("4", "slow prompt"), nil))
+
+	serve(p, clock, makeRequest("5", "slow prompt"), false, time.Minute)
+	serve(p, clock, makeRequest("6", "slow prompt"), false, time.Minute)
+	assert.Error(t, p.AdmitRequest(ctx, makeRequest("7", "slow prompt"), nil))
+}
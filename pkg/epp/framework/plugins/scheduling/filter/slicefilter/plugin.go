@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package slicefilter provides a filter that targets or avoids slices of the pool. Slices group the endpoints of a
+// pool composed of several Deployments through the "inference.networking.k8s.io/slice" pod label.
+package slicefilter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+const (
+	PluginType = "slice-filter"
+)
+
+var _ framework.Filter = &Plugin{}
+
+type Config struct {
+	// Include lists the slices the requests are restricted to. If empty, all slices are allowed, as well as the
+	// endpoints not belonging to a slice.
+	Include []string `json:"include,omitempty"`
+
+	// Exclude lists the slices the requests must avoid.
+	Exclude []string `json:"exclude,omitempty"`
+
+	// SliceHeader is an optional request header naming the slice a request targets. Requests carrying the header are
+	// restricted to the endpoints of that slice, within the slices allowed by Include and Exclude.
+	SliceHeader string `json:"sliceHeader,omitempty"`
+}
+
+// Plugin is a filter keeping the endpoints of the allowed slices, and the endpoints of the slice targeted by the
+// request, if any.
+type Plugin struct {
+	typedName fwkplugin.TypedName
+	config    Config
+	include   sets.Set[string]
+	exclude   sets.Set[string]
+}
+
+func Factory(name string, rawParameters json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	config := Config{}
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal config: %w", err)
+		}
+	}
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	return New(config).WithName(name), nil
+}
+
+func (c *Config) validate() error {
+	if len(c.Include) == 0 && len(c.Exclude) == 0 && c.SliceHeader == "" {
+		return errors.New("at least one of include, exclude or sliceHeader must be set")
+	}
+	if overlap := sets.New(c.Include...).Intersection(sets.New(c.Exclude...)); overlap.Len() > 0 {
+		return fmt.Errorf("slices %v are both included and excluded", sets.List(overlap))
+	}
+	return nil
+}
+
+// New creates a new slice filter.
+func New(config Config) *Plugin {
+	// Envoy delivers header keys in lowercase.
+	config.SliceHeader = strings.ToLower(config.SliceHeader)
+	return &Plugin{
+		typedName: fwkplugin.TypedName{Type: PluginType, Name: PluginType},
+		config:    config,
+		include:   sets.New(config.Include...),
+		exclude:   sets.New(config.Exclude...),
+	}
+}
+
+// WithName sets the name of the plugin.
+func (p *Plugin) WithName(name string) *Plugin {
+	p.typedName.Name = name
+	return p
+}
+
+func (p *Plugin) TypedName() fwkplugin.TypedName {
+	return p.typedName
+}
+
+// Filter keeps the endpoints of the allowed slices, restricted to the slice targeted by the request if any.
+func (p *Plugin) Filter(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest, endpoints []framework.Endpoint) []framework.Endpoint {
+	target := p.targetSlice(request)
+	filtered := make([]framework.Endpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		if p.allowed(endpoint.GetMetadata().Slice(), target) {
+			filtered = append(filtered, endpoint)
+		}
+	}
+	log.FromContext(ctx).V(logutil.DEBUG).Info("SliceFilter: filtered endpoints by slice",
+		"targetSlice", target, "kept", len(filtered), "total", len(endpoints))
+	return filtered
+}
+
+func (p *Plugin) allowed(slice, target string) bool {
+	switch {
+	case target != "" && slice != target:
+		return false
+	case p.include.Len() > 0 && !p.include.Has(slice):
+		return false
+	default:
+		return !p.exclude.Has(slice)
+	}
+}
+
+func (p *Plugin) targetSlice(request *framework.InferenceRequest) string {
+	if p.config.SliceHeader == "" || request == nil {
+		return ""
+	}
+	return request.Headers[p.config.SliceHeader]
+}
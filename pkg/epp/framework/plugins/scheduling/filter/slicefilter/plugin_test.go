@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package slicefilter
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+// makeEndpoints creates one endpoint per name, the slice of an endpoint being the prefix of its name before "-".
+func makeEndpoints(names ...string) []framework.Endpoint {
+	endpoints := make([]framework.Endpoint, 0, len(names))
+	for _, name := range names {
+		labels := map[string]string{}
+		if slice, _, found := strings.Cut(name, "-"); found {
+			labels[fwkdl.SliceLabel] = slice
+		}
+		meta := &fwkdl.EndpointMetadata{NamespacedName: types.NamespacedName{Name: name, Namespace: "default"}, Labels: labels}
+		endpoints = append(endpoints, framework.NewEndpoint(meta, &fwkdl.Metrics{}, fwkdl.NewAttributes()))
+	}
+	return endpoints
+}
+
+func names(endpoints []framework.Endpoint) []string {
+	res := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		res = append(res, endpoint.GetMetadata().NamespacedName.Name)
+	}
+	return res
+}
+
+func TestFactory(t *testing.T) {
+	_, err := Factory("f", json.RawMessage(`{"include": ["tp8"]}`), nil)
+	require.NoError(t, err)
+	_, err = Factory("f", json.RawMessage(`{}`), nil)
+	assert.Error(t, err, "a filter without any rule is rejected")
+	_, err = Factory("f", json.RawMessage(`{"include": ["tp8"], "exclude": ["tp8"]}`), nil)
+	assert.Error(t, err, "a slice cannot be both included and excluded")
+}
+
+func TestFilter(t *testing.T) {
+	endpoints := makeEndpoints("tp8-a", "tp8-b", "tp2-a", "fp8-a", "unsliced")
+
+	tests := []struct {
+		name    string
+		config  Config
+		headers map[string]string
+		want    []string
+	}{
+		{
+			name:   "include",
+			config: Config{Include: []string{"tp8", "tp2"}},
+			want:   []string{"tp8-a", "tp8-b", "tp2-a"},
+		},
+		{
+			name:   "exclude",
+			config: Config{Exclude: []string{"fp8"}},
+			want:   []string{"tp8-a", "tp8-b", "tp2-a", "unsliced"},
+		},
+		{
+			name:    "targeted by header",
+			config:  Config{SliceHeader: "X-Gateway-Slice"},
+			headers: map[string]string{"x-gateway-slice": "tp2"},
+			want:    []string{"tp2-a"},
+		},
+		{
+			name:    "no header",
+			config:  Config{SliceHeader: "X-Gateway-Slice"},
+			headers: map[string]string{},
+			want:    []string{"tp8-a", "tp8-b", "tp2-a", "fp8-a", "unsliced"},
+		},
+		{
+			name:    "targeted slice is excluded",
+			config:  Config{Exclude: []string{"fp8"}, SliceHeader: "x-gateway-slice"},
+			headers: map[string]string{"x-gateway-slice": "fp8"},
+			want:    []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := New(test.config).Filter(context.Background(), nil, &framework.InferenceRequest{Headers: test.headers}, endpoints)
+			assert.Equal(t, test.want, names(got))
+		})
+	}
+}
@@ -142,3 +142,7 @@ func (p *Plugin) Consumes() map[string]any {
 		attrlatency.LatencyPredictionInfoKey: attrlatency.LatencyPredictionInfo{},
 	}
 }
+
+func (p *Plugin) Requires() []string {
+	return []string{attrlatency.LatencyPredictionInfoKey}
+}
@@ -0,0 +1,737 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: scheduling_plugin.proto
+
+package gen
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The request being scheduled
+type Request struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	RequestId string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Target model after traffic split
+	TargetModel string            `protobuf:"bytes,2,opt,name=target_model,json=targetModel,proto3" json:"target_model,omitempty"`
+	Headers     map[string]string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Priority    int32             `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Size of the request body in bytes, zero if unknown
+	RequestSizeBytes int64 `protobuf:"varint,5,opt,name=request_size_bytes,json=requestSizeBytes,proto3" json:"request_size_bytes,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Request) Reset() {
+	*x = Request{}
+	mi := &file_scheduling_plugin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Request) ProtoMessage() {}
+
+func (x *Request) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduling_plugin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Request.ProtoReflect.Descriptor instead.
+func (*Request) Descriptor() ([]byte, []int) {
+	return file_scheduling_plugin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Request) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *Request) GetTargetModel() string {
+	if x != nil {
+		return x.TargetModel
+	}
+	return ""
+}
+
+func (x *Request) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *Request) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Request) GetRequestSizeBytes() int64 {
+	if x != nil {
+		return x.RequestSizeBytes
+	}
+	return 0
+}
+
+// Latest metrics scraped from the model server of an endpoint
+type EndpointMetrics struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RunningRequests int32                  `protobuf:"varint,1,opt,name=running_requests,json=runningRequests,proto3" json:"running_requests,omitempty"`
+	WaitingRequests int32                  `protobuf:"varint,2,opt,name=waiting_requests,json=waitingRequests,proto3" json:"waiting_requests,omitempty"`
+	// KV cache usage, between 0 and 1
+	KvCacheUsage float64 `protobuf:"fixed64,3,opt,name=kv_cache_usage,json=kvCacheUsage,proto3" json:"kv_cache_usage,omitempty"`
+	// Models and LoRA adapters loaded
+	ActiveModels []string `protobuf:"bytes,4,rep,name=active_models,json=activeModels,proto3" json:"active_models,omitempty"`
+	// Maximum number of requests run concurrently, zero if unknown
+	MaxConcurrency int32 `protobuf:"varint,5,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EndpointMetrics) Reset() {
+	*x = EndpointMetrics{}
+	mi := &file_scheduling_plugin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndpointMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndpointMetrics) ProtoMessage() {}
+
+func (x *EndpointMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduling_plugin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndpointMetrics.ProtoReflect.Descriptor instead.
+func (*EndpointMetrics) Descriptor() ([]byte, []int) {
+	return file_scheduling_plugin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EndpointMetrics) GetRunningRequests() int32 {
+	if x != nil {
+		return x.RunningRequests
+	}
+	return 0
+}
+
+func (x *EndpointMetrics) GetWaitingRequests() int32 {
+	if x != nil {
+		return x.WaitingRequests
+	}
+	return 0
+}
+
+func (x *EndpointMetrics) GetKvCacheUsage() float64 {
+	if x != nil {
+		return x.KvCacheUsage
+	}
+	return 0
+}
+
+func (x *EndpointMetrics) GetActiveModels() []string {
+	if x != nil {
+		return x.ActiveModels
+	}
+	return nil
+}
+
+func (x *EndpointMetrics) GetMaxConcurrency() int32 {
+	if x != nil {
+		return x.MaxConcurrency
+	}
+	return 0
+}
+
+// A candidate endpoint
+type Endpoint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique name of the endpoint, in the <namespace>/<name> form. Responses
+	// refer to endpoints by this name.
+	Name          string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string            `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Port          string            `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
+	Labels        map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Metrics       *EndpointMetrics  `protobuf:"bytes,5,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Endpoint) Reset() {
+	*x = Endpoint{}
+	mi := &file_scheduling_plugin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Endpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Endpoint) ProtoMessage() {}
+
+func (x *Endpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduling_plugin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Endpoint.ProtoReflect.Descriptor instead.
+func (*Endpoint) Descriptor() ([]byte, []int) {
+	return file_scheduling_plugin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Endpoint) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Endpoint) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Endpoint) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *Endpoint) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Endpoint) GetMetrics() *EndpointMetrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+// A candidate endpoint with its total score
+type ScoredEndpoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint      *Endpoint              `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoredEndpoint) Reset() {
+	*x = ScoredEndpoint{}
+	mi := &file_scheduling_plugin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoredEndpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoredEndpoint) ProtoMessage() {}
+
+func (x *ScoredEndpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduling_plugin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoredEndpoint.ProtoReflect.Descriptor instead.
+func (*ScoredEndpoint) Descriptor() ([]byte, []int) {
+	return file_scheduling_plugin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ScoredEndpoint) GetEndpoint() *Endpoint {
+	if x != nil {
+		return x.Endpoint
+	}
+	return nil
+}
+
+func (x *ScoredEndpoint) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type FilterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *Request               `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	Endpoints     []*Endpoint            `protobuf:"bytes,2,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterRequest) Reset() {
+	*x = FilterRequest{}
+	mi := &file_scheduling_plugin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterRequest) ProtoMessage() {}
+
+func (x *FilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduling_plugin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterRequest.ProtoReflect.Descriptor instead.
+func (*FilterRequest) Descriptor() ([]byte, []int) {
+	return file_scheduling_plugin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FilterRequest) GetRequest() *Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *FilterRequest) GetEndpoints() []*Endpoint {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+type FilterResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Names of the endpoints kept
+	Endpoints     []string `protobuf:"bytes,1,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterResponse) Reset() {
+	*x = FilterResponse{}
+	mi := &file_scheduling_plugin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterResponse) ProtoMessage() {}
+
+func (x *FilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduling_plugin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterResponse.ProtoReflect.Descriptor instead.
+func (*FilterResponse) Descriptor() ([]byte, []int) {
+	return file_scheduling_plugin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FilterResponse) GetEndpoints() []string {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+type ScoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *Request               `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	Endpoints     []*Endpoint            `protobuf:"bytes,2,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreRequest) Reset() {
+	*x = ScoreRequest{}
+	mi := &file_scheduling_plugin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreRequest) ProtoMessage() {}
+
+func (x *ScoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduling_plugin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreRequest.ProtoReflect.Descriptor instead.
+func (*ScoreRequest) Descriptor() ([]byte, []int) {
+	return file_scheduling_plugin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ScoreRequest) GetRequest() *Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *ScoreRequest) GetEndpoints() []*Endpoint {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+type ScoreResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Scores by endpoint name, between 0 and 1. Endpoints without a score get 0.
+	Scores        map[string]float64 `protobuf:"bytes,1,rep,name=scores,proto3" json:"scores,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreResponse) Reset() {
+	*x = ScoreResponse{}
+	mi := &file_scheduling_plugin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreResponse) ProtoMessage() {}
+
+func (x *ScoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduling_plugin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreResponse.ProtoReflect.Descriptor instead.
+func (*ScoreResponse) Descriptor() ([]byte, []int) {
+	return file_scheduling_plugin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ScoreResponse) GetScores() map[string]float64 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+type PickRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *Request               `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	Endpoints     []*ScoredEndpoint      `protobuf:"bytes,2,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickRequest) Reset() {
+	*x = PickRequest{}
+	mi := &file_scheduling_plugin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickRequest) ProtoMessage() {}
+
+func (x *PickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduling_plugin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickRequest.ProtoReflect.Descriptor instead.
+func (*PickRequest) Descriptor() ([]byte, []int) {
+	return file_scheduling_plugin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PickRequest) GetRequest() *Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *PickRequest) GetEndpoints() []*ScoredEndpoint {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+type PickResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Names of the endpoints picked, the first one being the primary target
+	Endpoints     []string `protobuf:"bytes,1,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickResponse) Reset() {
+	*x = PickResponse{}
+	mi := &file_scheduling_plugin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickResponse) ProtoMessage() {}
+
+func (x *PickResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduling_plugin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickResponse.ProtoReflect.Descriptor instead.
+func (*PickResponse) Descriptor() ([]byte, []int) {
+	return file_scheduling_plugin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PickResponse) GetEndpoints() []string {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+var File_scheduling_plugin_proto protoreflect.FileDescriptor
+
+const file_scheduling_plugin_proto_rawDesc = "" +
+	"\n" +
+	"\x17scheduling_plugin.proto\x12\x18epp.scheduling.plugin.v1\"\x9b\x02\n" +
+	"\aRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12!\n" +
+	"\ftarget_model\x18\x02 \x01(\tR\vtargetModel\x12H\n" +
+	"\aheaders\x18\x03 \x03(\v2..epp.scheduling.plugin.v1.Request.HeadersEntryR\aheaders\x12\x1a\n" +
+	"\bpriority\x18\x04 \x01(\x05R\bpriority\x12,\n" +
+	"\x12request_size_bytes\x18\x05 \x01(\x03R\x10requestSizeBytes\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xdb\x01\n" +
+	"\x0fEndpointMetrics\x12)\n" +
+	"\x10running_requests\x18\x01 \x01(\x05R\x0frunningRequests\x12)\n" +
+	"\x10waiting_requests\x18\x02 \x01(\x05R\x0fwaitingRequests\x12$\n" +
+	"\x0ekv_cache_usage\x18\x03 \x01(\x01R\fkvCacheUsage\x12#\n" +
+	"\ractive_models\x18\x04 \x03(\tR\factiveModels\x12'\n" +
+	"\x0fmax_concurrency\x18\x05 \x01(\x05R\x0emaxConcurrency\"\x94\x02\n" +
+	"\bEndpoint\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x12\n" +
+	"\x04port\x18\x03 \x01(\tR\x04port\x12F\n" +
+	"\x06labels\x18\x04 \x03(\v2..epp.scheduling.plugin.v1.Endpoint.LabelsEntryR\x06labels\x12C\n" +
+	"\ametrics\x18\x05 \x01(\v2).epp.scheduling.plugin.v1.EndpointMetricsR\ametrics\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"f\n" +
+	"\x0eScoredEndpoint\x12>\n" +
+	"\bendpoint\x18\x01 \x01(\v2\".epp.scheduling.plugin.v1.EndpointR\bendpoint\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\"\x8e\x01\n" +
+	"\rFilterRequest\x12;\n" +
+	"\arequest\x18\x01 \x01(\v2!.epp.scheduling.plugin.v1.RequestR\arequest\x12@\n" +
+	"\tendpoints\x18\x02 \x03(\v2\".epp.scheduling.plugin.v1.EndpointR\tendpoints\".\n" +
+	"\x0eFilterResponse\x12\x1c\n" +
+	"\tendpoints\x18\x01 \x03(\tR\tendpoints\"\x8d\x01\n" +
+	"\fScoreRequest\x12;\n" +
+	"\arequest\x18\x01 \x01(\v2!.epp.scheduling.plugin.v1.RequestR\arequest\x12@\n" +
+	"\tendpoints\x18\x02 \x03(\v2\".epp.scheduling.plugin.v1.EndpointR\tendpoints\"\x97\x01\n" +
+	"\rScoreResponse\x12K\n" +
+	"\x06scores\x18\x01 \x03(\v23.epp.scheduling.plugin.v1.ScoreResponse.ScoresEntryR\x06scores\x1a9\n" +
+	"\vScoresEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\x92\x01\n" +
+	"\vPickRequest\x12;\n" +
+	"\arequest\x18\x01 \x01(\v2!.epp.scheduling.plugin.v1.RequestR\arequest\x12F\n" +
+	"\tendpoints\x18\x02 \x03(\v2(.epp.scheduling.plugin.v1.ScoredEndpointR\tendpoints\",\n" +
+	"\fPickResponse\x12\x1c\n" +
+	"\tendpoints\x18\x01 \x03(\tR\tendpoints2\xa0\x02\n" +
+	"\x10SchedulingPlugin\x12[\n" +
+	"\x06Filter\x12'.epp.scheduling.plugin.v1.FilterRequest\x1a(.epp.scheduling.plugin.v1.FilterResponse\x12X\n" +
+	"\x05Score\x12&.epp.scheduling.plugin.v1.ScoreRequest\x1a'.epp.scheduling.plugin.v1.ScoreResponse\x12U\n" +
+	"\x04Pick\x12%.epp.scheduling.plugin.v1.PickRequest\x1a&.epp.scheduling.plugin.v1.PickResponseBeZcsigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/grpcplugin/api/genb\x06proto3"
+
+var (
+	file_scheduling_plugin_proto_rawDescOnce sync.Once
+	file_scheduling_plugin_proto_rawDescData []byte
+)
+
+func file_scheduling_plugin_proto_rawDescGZIP() []byte {
+	file_scheduling_plugin_proto_rawDescOnce.Do(func() {
+		file_scheduling_plugin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_scheduling_plugin_proto_rawDesc), len(file_scheduling_plugin_proto_rawDesc)))
+	})
+	return file_scheduling_plugin_proto_rawDescData
+}
+
+var file_scheduling_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_scheduling_plugin_proto_goTypes = []any{
+	(*Request)(nil),         // 0: epp.scheduling.plugin.v1.Request
+	(*EndpointMetrics)(nil), // 1: epp.scheduling.plugin.v1.EndpointMetrics
+	(*Endpoint)(nil),        // 2: epp.scheduling.plugin.v1.Endpoint
+	(*ScoredEndpoint)(nil),  // 3: epp.scheduling.plugin.v1.ScoredEndpoint
+	(*FilterRequest)(nil),   // 4: epp.scheduling.plugin.v1.FilterRequest
+	(*FilterResponse)(nil),  // 5: epp.scheduling.plugin.v1.FilterResponse
+	(*ScoreRequest)(nil),    // 6: epp.scheduling.plugin.v1.ScoreRequest
+	(*ScoreResponse)(nil),   // 7: epp.scheduling.plugin.v1.ScoreResponse
+	(*PickRequest)(nil),     // 8: epp.scheduling.plugin.v1.PickRequest
+	(*PickResponse)(nil),    // 9: epp.scheduling.plugin.v1.PickResponse
+	nil,                     // 10: epp.scheduling.plugin.v1.Request.HeadersEntry
+	nil,                     // 11: epp.scheduling.plugin.v1.Endpoint.LabelsEntry
+	nil,                     // 12: epp.scheduling.plugin.v1.ScoreResponse.ScoresEntry
+}
+var file_scheduling_plugin_proto_depIdxs = []int32{
+	10, // 0: epp.scheduling.plugin.v1.Request.headers:type_name -> epp.scheduling.plugin.v1.Request.HeadersEntry
+	11, // 1: epp.scheduling.plugin.v1.Endpoint.labels:type_name -> epp.scheduling.plugin.v1.Endpoint.LabelsEntry
+	1,  // 2: epp.scheduling.plugin.v1.Endpoint.metrics:type_name -> epp.scheduling.plugin.v1.EndpointMetrics
+	2,  // 3: epp.scheduling.plugin.v1.ScoredEndpoint.endpoint:type_name -> epp.scheduling.plugin.v1.Endpoint
+	0,  // 4: epp.scheduling.plugin.v1.FilterRequest.request:type_name -> epp.scheduling.plugin.v1.Request
+	2,  // 5: epp.scheduling.plugin.v1.FilterRequest.endpoints:type_name -> epp.scheduling.plugin.v1.Endpoint
+	0,  // 6: epp.scheduling.plugin.v1.ScoreRequest.request:type_name -> epp.scheduling.plugin.v1.Request
+	2,  // 7: epp.scheduling.plugin.v1.ScoreRequest.endpoints:type_name -> epp.scheduling.plugin.v1.Endpoint
+	12, // 8: epp.scheduling.plugin.v1.ScoreResponse.scores:type_name -> epp.scheduling.plugin.v1.ScoreResponse.ScoresEntry
+	0,  // 9: epp.scheduling.plugin.v1.PickRequest.request:type_name -> epp.scheduling.plugin.v1.Request
+	3,  // 10: epp.scheduling.plugin.v1.PickRequest.endpoints:type_name -> epp.scheduling.plugin.v1.ScoredEndpoint
+	4,  // 11: epp.scheduling.plugin.v1.SchedulingPlugin.Filter:input_type -> epp.scheduling.plugin.v1.FilterRequest
+	6,  // 12: epp.scheduling.plugin.v1.SchedulingPlugin.Score:input_type -> epp.scheduling.plugin.v1.ScoreRequest
+	8,  // 13: epp.scheduling.plugin.v1.SchedulingPlugin.Pick:input_type -> epp.scheduling.plugin.v1.PickRequest
+	5,  // 14: epp.scheduling.plugin.v1.SchedulingPlugin.Filter:output_type -> epp.scheduling.plugin.v1.FilterResponse
+	7,  // 15: epp.scheduling.plugin.v1.SchedulingPlugin.Score:output_type -> epp.scheduling.plugin.v1.ScoreResponse
+	9,  // 16: epp.scheduling.plugin.v1.SchedulingPlugin.Pick:output_type -> epp.scheduling.plugin.v1.PickResponse
+	14, // [14:17] is the sub-list for method output_type
+	11, // [11:14] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_scheduling_plugin_proto_init() }
+func file_scheduling_plugin_proto_init() {
+	if File_scheduling_plugin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_scheduling_plugin_proto_rawDesc), len(file_scheduling_plugin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_scheduling_plugin_proto_goTypes,
+		DependencyIndexes: file_scheduling_plugin_proto_depIdxs,
+		MessageInfos:      file_scheduling_plugin_proto_msgTypes,
+	}.Build()
+	File_scheduling_plugin_proto = out.File
+	file_scheduling_plugin_proto_goTypes = nil
+	file_scheduling_plugin_proto_depIdxs = nil
+}
@@ -0,0 +1,382 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package grpcplugin provides filter, scorer and picker plugins calling out to an out-of-process scheduling plugin over
+// gRPC, so that scheduling logic can be written in other languages than Go. The service the plugin servers implement
+// is defined in api/proto/scheduling_plugin.proto.
+package grpcplugin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/grpcplugin/api/gen"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/picker"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/picker/maxscore"
+)
+
+const (
+	PluginType = "grpc-plugin"
+
+	// KindFilter, KindScorer and KindPicker are the scheduling stages an out-of-process plugin can be called from.
+	KindFilter = "filter"
+	KindScorer = "scorer"
+	KindPicker = "picker"
+
+	// FailurePolicyIgnore ignores the failed calls: a filter keeps all the endpoints, a scorer scores none and a
+	// picker falls back to picking the endpoint of highest score.
+	FailurePolicyIgnore = "Ignore"
+	// FailurePolicyFail fails the scheduling of the request on failed calls of a filter or a picker. A scorer cannot
+	// fail the scheduling, its failed calls are always ignored.
+	FailurePolicyFail = "Fail"
+)
+
+var (
+	_ framework.Filter             = &Filter{}
+	_ framework.Scorer             = &Scorer{}
+	_ framework.RequestAwarePicker = &Picker{}
+)
+
+type Config struct {
+	// Address is the target of the gRPC connection to the plugin server, e.g. "scheduler-plugin.default.svc:9000".
+	Address string `json:"address"`
+	// Kind is the scheduling stage the plugin is called from: filter, scorer or picker.
+	Kind string `json:"kind"`
+	// TimeoutMs is the timeout of the calls to the plugin server, in milliseconds.
+	TimeoutMs int `json:"timeoutMs,omitempty"`
+	// FailurePolicy is the behavior on failed or timed out calls: Ignore or Fail.
+	FailurePolicy string `json:"failurePolicy,omitempty"`
+	// Category is the category of a scorer: Affinity, Distribution or Balance.
+	Category framework.ScorerCategory `json:"category,omitempty"`
+}
+
+// DefaultConfig is the default configuration of the plugin, Address and Kind must be set.
+var DefaultConfig = Config{
+	TimeoutMs:     50,
+	FailurePolicy: FailurePolicyIgnore,
+	Category:      framework.Distribution,
+}
+
+func (c *Config) validate() error {
+	if c.Address == "" {
+		return errors.New("address must be set")
+	}
+	switch c.Kind {
+	case KindFilter, KindScorer, KindPicker:
+	default:
+		return fmt.Errorf("kind must be one of %s, %s or %s, got %q", KindFilter, KindScorer, KindPicker, c.Kind)
+	}
+	if c.TimeoutMs <= 0 {
+		return fmt.Errorf("timeoutMs must be positive, got %d", c.TimeoutMs)
+	}
+	if c.FailurePolicy != FailurePolicyIgnore && c.FailurePolicy != FailurePolicyFail {
+		return fmt.Errorf("failurePolicy must be %s or %s, got %q", FailurePolicyIgnore, FailurePolicyFail, c.FailurePolicy)
+	}
+	switch c.Category {
+	case framework.Affinity, framework.Distribution, framework.Balance:
+	default:
+		return fmt.Errorf("category must be one of %s, %s or %s, got %q", framework.Affinity, framework.Distribution,
+			framework.Balance, c.Category)
+	}
+	return nil
+}
+
+// Factory creates a new filter, scorer or picker calling out to a plugin server, depending on the configured kind.
+// The connection to the plugin server is closed when the context of the handle is done.
+func Factory(name string, rawParameters json.RawMessage, handle fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	config := DefaultConfig
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal config for %s: %w", PluginType, err)
+		}
+	}
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config for %s: %w", PluginType, err)
+	}
+	ctx := context.Background()
+	if handle != nil {
+		ctx = handle.Context()
+	}
+
+	switch config.Kind {
+	case KindFilter:
+		p, err := NewFilter(ctx, config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create %s: %w", PluginType, err)
+		}
+		return p.WithName(name), nil
+	case KindScorer:
+		p, err := NewScorer(ctx, config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create %s: %w", PluginType, err)
+		}
+		return p.WithName(name), nil
+	default:
+		p, err := NewPicker(ctx, config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create %s: %w", PluginType, err)
+		}
+		return p.WithName(name), nil
+	}
+}
+
+// client is the part common to the plugins: the client of the plugin server and the failure handling.
+type client struct {
+	typedName     fwkplugin.TypedName
+	stub          gen.SchedulingPluginClient
+	timeout       time.Duration
+	failurePolicy string
+}
+
+// newClient creates the client of the plugin server at the configured address. The connection is established lazily
+// and closed when ctx is done.
+func newClient(ctx context.Context, config Config, opts ...grpc.DialOption) (*client, error) {
+	opts = append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, opts...)
+	conn, err := grpc.NewClient(config.Address, opts...)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		<-ctx.Done()
+		_ = conn.Close()
+	}()
+	return &client{
+		typedName:     fwkplugin.TypedName{Type: PluginType, Name: PluginType},
+		stub:          gen.NewSchedulingPluginClient(conn),
+		timeout:       time.Duration(config.TimeoutMs) * time.Millisecond,
+		failurePolicy: config.FailurePolicy,
+	}, nil
+}
+
+func (c *client) TypedName() fwkplugin.TypedName {
+	return c.typedName
+}
+
+// failed logs the failure of a call to the plugin server and returns whether the failure must fail the scheduling.
+func (c *client) failed(ctx context.Context, method string, err error) bool {
+	log.FromContext(ctx).V(logutil.DEFAULT).Info("Call to the scheduling plugin server failed", "plugin", c.typedName,
+		"method", method, "failurePolicy", c.failurePolicy, "error", err.Error())
+	return c.failurePolicy == FailurePolicyFail
+}
+
+// Filter is a filter keeping the endpoints kept by the plugin server.
+type Filter struct {
+	*client
+}
+
+// NewFilter creates a new filter calling out to the plugin server at the configured address.
+func NewFilter(ctx context.Context, config Config, opts ...grpc.DialOption) (*Filter, error) {
+	c, err := newClient(ctx, config, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Filter{client: c}, nil
+}
+
+func (f *Filter) WithName(name string) *Filter {
+	f.typedName.Name = name
+	return f
+}
+
+// Filter keeps the endpoints kept by the plugin server. On failure, all the endpoints are kept if the failure policy
+// is Ignore, and none otherwise.
+func (f *Filter) Filter(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest, endpoints []framework.Endpoint) []framework.Endpoint {
+	callCtx, cancel := context.WithTimeout(ctx, f.timeout)
+	defer cancel()
+	response, err := f.stub.Filter(callCtx, &gen.FilterRequest{Request: toRequest(request), Endpoints: toEndpoints(endpoints)})
+	if err != nil {
+		if f.failed(ctx, "Filter", err) {
+			return []framework.Endpoint{}
+		}
+		return endpoints
+	}
+
+	byName := endpointsByName(endpoints)
+	filtered := make([]framework.Endpoint, 0, len(response.GetEndpoints()))
+	for _, name := range response.GetEndpoints() {
+		if endpoint, ok := byName[name]; ok {
+			filtered = append(filtered, endpoint)
+			delete(byName, name) // keep each endpoint once
+		}
+	}
+	return filtered
+}
+
+// Scorer is a scorer scoring the endpoints with the scores of the plugin server.
+type Scorer struct {
+	*client
+	category framework.ScorerCategory
+}
+
+// NewScorer creates a new scorer calling out to the plugin server at the configured address.
+func NewScorer(ctx context.Context, config Config, opts ...grpc.DialOption) (*Scorer, error) {
+	c, err := newClient(ctx, config, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Scorer{client: c, category: config.Category}, nil
+}
+
+func (s *Scorer) WithName(name string) *Scorer {
+	s.typedName.Name = name
+	return s
+}
+
+func (s *Scorer) Category() framework.ScorerCategory {
+	return s.category
+}
+
+// Score returns the scores of the plugin server. On failure, no endpoint is scored, whatever the failure policy.
+func (s *Scorer) Score(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest, endpoints []framework.Endpoint) map[framework.Endpoint]float64 {
+	callCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	response, err := s.stub.Score(callCtx, &gen.ScoreRequest{Request: toRequest(request), Endpoints: toEndpoints(endpoints)})
+	if err != nil {
+		s.failed(ctx, "Score", err)
+		return map[framework.Endpoint]float64{}
+	}
+
+	byName := endpointsByName(endpoints)
+	scores := make(map[framework.Endpoint]float64, len(endpoints))
+	for name, score := range response.GetScores() {
+		if endpoint, ok := byName[name]; ok {
+			scores[endpoint] = score
+		}
+	}
+	return scores
+}
+
+// Picker is a picker picking the endpoints picked by the plugin server.
+type Picker struct {
+	*client
+	fallback framework.Picker
+}
+
+// NewPicker creates a new picker calling out to the plugin server at the configured address.
+func NewPicker(ctx context.Context, config Config, opts ...grpc.DialOption) (*Picker, error) {
+	c, err := newClient(ctx, config, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Picker{client: c, fallback: maxscore.NewMaxScorePicker(picker.DefaultMaxNumOfEndpoints)}, nil
+}
+
+func (p *Picker) WithName(name string) *Picker {
+	p.typedName.Name = name
+	return p
+}
+
+// Pick picks the endpoints picked by the plugin server, without information about the request.
+func (p *Picker) Pick(ctx context.Context, cycleState *framework.CycleState, scoredEndpoints []*framework.ScoredEndpoint) *framework.ProfileRunResult {
+	return p.PickForRequest(ctx, cycleState, nil, scoredEndpoints)
+}
+
+// PickForRequest picks the endpoints picked by the plugin server. On failure, or if the plugin server picks none of
+// the candidates, the endpoint of highest score is picked if the failure policy is Ignore, and the scheduling fails
+// otherwise.
+func (p *Picker) PickForRequest(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest,
+	scoredEndpoints []*framework.ScoredEndpoint) *framework.ProfileRunResult {
+	candidates := make([]*gen.ScoredEndpoint, len(scoredEndpoints))
+	byName := make(map[string]framework.Endpoint, len(scoredEndpoints))
+	for i, scored := range scoredEndpoints {
+		candidates[i] = &gen.ScoredEndpoint{Endpoint: toEndpoint(scored.Endpoint), Score: scored.Score}
+		byName[scored.GetMetadata().NamespacedName.String()] = scored.Endpoint
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	response, err := p.stub.Pick(callCtx, &gen.PickRequest{Request: toRequest(request), Endpoints: candidates})
+	if err == nil {
+		targets := make([]framework.Endpoint, 0, len(response.GetEndpoints()))
+		for _, name := range response.GetEndpoints() {
+			if endpoint, ok := byName[name]; ok {
+				targets = append(targets, endpoint)
+				delete(byName, name)
+			}
+		}
+		if len(targets) > 0 {
+			return &framework.ProfileRunResult{TargetEndpoints: targets}
+		}
+		err = errors.New("no candidate endpoint picked")
+	}
+	if p.failed(ctx, "Pick", err) {
+		return nil
+	}
+	return p.fallback.Pick(ctx, cycleState, scoredEndpoints)
+}
+
+func toRequest(request *framework.InferenceRequest) *gen.Request {
+	if request == nil {
+		return nil
+	}
+	return &gen.Request{
+		RequestId:        request.RequestId,
+		TargetModel:      request.TargetModel,
+		Headers:          request.Headers,
+		Priority:         int32(request.Objectives.Priority),
+		RequestSizeBytes: int64(request.RequestSizeBytes),
+	}
+}
+
+func toEndpoints(endpoints []framework.Endpoint) []*gen.Endpoint {
+	converted := make([]*gen.Endpoint, len(endpoints))
+	for i, endpoint := range endpoints {
+		converted[i] = toEndpoint(endpoint)
+	}
+	return converted
+}
+
+func toEndpoint(endpoint framework.Endpoint) *gen.Endpoint {
+	converted := &gen.Endpoint{}
+	if metadata := endpoint.GetMetadata(); metadata != nil {
+		converted.Name = metadata.NamespacedName.String()
+		converted.Address = metadata.Address
+		converted.Port = metadata.Port
+		converted.Labels = metadata.Labels
+	}
+	if metrics := endpoint.GetMetrics(); metrics != nil {
+		activeModels := make([]string, 0, len(metrics.ActiveModels))
+		for model := range metrics.ActiveModels {
+			activeModels = append(activeModels, model)
+		}
+		converted.Metrics = &gen.EndpointMetrics{
+			RunningRequests: int32(metrics.RunningRequestsSize),
+			WaitingRequests: int32(metrics.WaitingQueueSize),
+			KvCacheUsage:    metrics.KVCacheUsagePercent,
+			ActiveModels:    activeModels,
+			MaxConcurrency:  int32(metrics.MaxConcurrency),
+		}
+	}
+	return converted
+}
+
+func endpointsByName(endpoints []framework.Endpoint) map[string]framework.Endpoint {
+	byName := make(map[string]framework.Endpoint, len(endpoints))
+	for _, endpoint := range endpoints {
+		byName[endpoint.GetMetadata().NamespacedName.String()] = endpoint
+	}
+	return byName
+}
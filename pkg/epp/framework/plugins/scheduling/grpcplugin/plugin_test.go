@@ -0,0 +1,245 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcplugin
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/grpcplugin/api/gen"
+)
+
+// fakeServer keeps the endpoints labeled "keep", scores the endpoints by their running requests and picks the
+// endpoint of lowest score.
+type fakeServer struct {
+	gen.UnimplementedSchedulingPluginServer
+	delay        time.Duration
+	lastRequest  *gen.Request
+	pickNotFound bool
+}
+
+func (s *fakeServer) Filter(ctx context.Context, req *gen.FilterRequest) (*gen.FilterResponse, error) {
+	if err := s.wait(ctx); err != nil {
+		return nil, err
+	}
+	s.lastRequest = req.GetRequest()
+	response := &gen.FilterResponse{}
+	for _, endpoint := range req.GetEndpoints() {
+		if endpoint.GetLabels()["keep"] == "true" {
+			response.Endpoints = append(response.Endpoints, endpoint.GetName())
+		}
+	}
+	return response, nil
+}
+
+func (s *fakeServer) Score(ctx context.Context, req *gen.ScoreRequest) (*gen.ScoreResponse, error) {
+	if err := s.wait(ctx); err != nil {
+		return nil, err
+	}
+	response := &gen.ScoreResponse{Scores: map[string]float64{"default/unknown": 1}}
+	for _, endpoint := range req.GetEndpoints() {
+		response.Scores[endpoint.GetName()] = float64(endpoint.GetMetrics().GetRunningRequests()) / 10
+	}
+	return response, nil
+}
+
+func (s *fakeServer) Pick(ctx context.Context, req *gen.PickRequest) (*gen.PickResponse, error) {
+	if err := s.wait(ctx); err != nil {
+		return nil, err
+	}
+	if s.pickNotFound {
+		return &gen.PickResponse{Endpoints: []string{"default/unknown"}}, nil
+	}
+	var lowest *gen.ScoredEndpoint
+	for _, candidate := range req.GetEndpoints() {
+		if lowest == nil || candidate.GetScore() < lowest.GetScore() {
+			lowest = candidate
+		}
+	}
+	return &gen.PickResponse{Endpoints: []string{lowest.GetEndpoint().GetName()}}, nil
+}
+
+func (s *fakeServer) wait(ctx context.Context) error {
+	select {
+	case <-time.After(s.delay):
+		return nil
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+// startServer serves the given server in-process and returns the dial options to connect to it.
+func startServer(t *testing.T, server gen.SchedulingPluginServer) []grpc.DialOption {
+	t.Helper()
+	listener := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	gen.RegisterSchedulingPluginServer(s, server)
+	go func() { _ = s.Serve(listener) }()
+	t.Cleanup(s.Stop)
+	return []grpc.DialOption{grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return listener.DialContext(ctx)
+	})}
+}
+
+func testConfig(kind, failurePolicy string) Config {
+	config := DefaultConfig
+	config.Address = "passthrough:///bufconn"
+	config.Kind = kind
+	config.FailurePolicy = failurePolicy
+	return config
+}
+
+func newTestContext(t *testing.T) context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	return ctx
+}
+
+func newEndpoint(name string, keep bool, runningRequests int) framework.Endpoint {
+	labels := map[string]string{}
+	if keep {
+		labels["keep"] = "true"
+	}
+	return framework.NewEndpoint(&fwkdl.EndpointMetadata{
+		NamespacedName: k8stypes.NamespacedName{Namespace: "default", Name: name},
+		Labels:         labels,
+	}, &fwkdl.Metrics{RunningRequestsSize: runningRequests}, nil)
+}
+
+func targetNames(result *framework.ProfileRunResult) []string {
+	names := make([]string, len(result.TargetEndpoints))
+	for i, endpoint := range result.TargetEndpoints {
+		names[i] = endpoint.GetMetadata().NamespacedName.String()
+	}
+	return names
+}
+
+func TestFactory(t *testing.T) {
+	tests := []struct {
+		name     string
+		params   string
+		wantType any
+		wantErr  bool
+	}{
+		{name: "filter", params: `{"address": "localhost:9000", "kind": "filter"}`, wantType: &Filter{}},
+		{name: "scorer", params: `{"address": "localhost:9000", "kind": "scorer", "category": "Affinity"}`, wantType: &Scorer{}},
+		{name: "picker", params: `{"address": "localhost:9000", "kind": "picker", "timeoutMs": 10, "failurePolicy": "Fail"}`,
+			wantType: &Picker{}},
+		{name: "missing address", params: `{"kind": "filter"}`, wantErr: true},
+		{name: "invalid kind", params: `{"address": "localhost:9000", "kind": "handler"}`, wantErr: true},
+		{name: "invalid timeout", params: `{"address": "localhost:9000", "kind": "filter", "timeoutMs": -1}`, wantErr: true},
+		{name: "invalid failure policy", params: `{"address": "localhost:9000", "kind": "filter", "failurePolicy": "Retry"}`,
+			wantErr: true},
+		{name: "invalid category", params: `{"address": "localhost:9000", "kind": "scorer", "category": "Other"}`,
+			wantErr: true},
+		{name: "malformed json", params: `{`, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := Factory("external", json.RawMessage(test.params), nil)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.IsType(t, test.wantType, p)
+			assert.Equal(t, "external", p.TypedName().Name)
+			assert.Equal(t, PluginType, p.TypedName().Type)
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	kept, dropped := newEndpoint("pod1", true, 0), newEndpoint("pod2", false, 0)
+	endpoints := []framework.Endpoint{kept, dropped}
+	request := &framework.InferenceRequest{RequestId: "req", TargetModel: "model", Objectives: framework.RequestObjectives{Priority: 2}}
+
+	server := &fakeServer{}
+	filter, err := NewFilter(newTestContext(t), testConfig(KindFilter, FailurePolicyIgnore), startServer(t, server)...)
+	require.NoError(t, err)
+	assert.Equal(t, []framework.Endpoint{kept}, filter.Filter(context.Background(), nil, request, endpoints))
+	assert.Equal(t, "model", server.lastRequest.GetTargetModel())
+	assert.Equal(t, int32(2), server.lastRequest.GetPriority())
+
+	// Timed out calls keep all the endpoints with the Ignore policy and none with the Fail policy.
+	server.delay = time.Second
+	assert.Equal(t, endpoints, filter.Filter(context.Background(), nil, request, endpoints))
+	failing, err := NewFilter(newTestContext(t), testConfig(KindFilter, FailurePolicyFail), startServer(t, server)...)
+	require.NoError(t, err)
+	assert.Empty(t, failing.Filter(context.Background(), nil, request, endpoints))
+}
+
+func TestScorer(t *testing.T) {
+	idle, busy := newEndpoint("pod1", true, 0), newEndpoint("pod2", true, 5)
+	endpoints := []framework.Endpoint{idle, busy}
+
+	server := &fakeServer{}
+	scorer, err := NewScorer(newTestContext(t), testConfig(KindScorer, FailurePolicyFail), startServer(t, server)...)
+	require.NoError(t, err)
+	assert.Equal(t, framework.Distribution, scorer.Category())
+	// Scores of endpoints that are not candidates are dropped.
+	assert.Equal(t, map[framework.Endpoint]float64{idle: 0, busy: 0.5}, scorer.Score(context.Background(), nil, nil, endpoints))
+
+	// Failed calls score no endpoint, whatever the failure policy.
+	server.delay = time.Second
+	assert.Empty(t, scorer.Score(context.Background(), nil, nil, endpoints))
+}
+
+func TestPicker(t *testing.T) {
+	low, high := newEndpoint("pod1", true, 0), newEndpoint("pod2", true, 0)
+	scored := func() []*framework.ScoredEndpoint {
+		return []*framework.ScoredEndpoint{{Endpoint: low, Score: 0.1}, {Endpoint: high, Score: 0.9}}
+	}
+
+	server := &fakeServer{}
+	picker, err := NewPicker(newTestContext(t), testConfig(KindPicker, FailurePolicyIgnore), startServer(t, server)...)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"default/pod1"}, targetNames(picker.PickForRequest(context.Background(), nil, nil, scored())))
+
+	// Picks of unknown endpoints and failed calls fall back to the endpoint of highest score with the Ignore policy, and
+	// fail the scheduling with the Fail policy.
+	server.pickNotFound = true
+	assert.Equal(t, []string{"default/pod2"}, targetNames(picker.Pick(context.Background(), nil, scored())))
+	server.pickNotFound = false
+	server.delay = time.Second
+	assert.Equal(t, []string{"default/pod2"}, targetNames(picker.Pick(context.Background(), nil, scored())))
+
+	failing, err := NewPicker(newTestContext(t), testConfig(KindPicker, FailurePolicyFail), startServer(t, server)...)
+	require.NoError(t, err)
+	assert.Nil(t, failing.Pick(context.Background(), nil, scored()))
+}
+
+func TestUnimplementedMethod(t *testing.T) {
+	// Plugin servers only implement the methods of the kinds they are configured as.
+	filter, err := NewFilter(newTestContext(t), testConfig(KindFilter, FailurePolicyIgnore),
+		startServer(t, gen.UnimplementedSchedulingPluginServer{})...)
+	require.NoError(t, err)
+	endpoints := []framework.Endpoint{newEndpoint("pod1", false, 0)}
+	assert.Equal(t, endpoints, filter.Filter(context.Background(), nil, nil, endpoints))
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package deterministichash implements a scheduling picker that selects the endpoint(s) with the highest score and
+// breaks ties with a stable hash of a request attribute, so that the same request is always placed on the same
+// endpoint given the same scores.
+//
+// For detailed behavioral intent and configuration, see the package README.
+package deterministichash
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cespare/xxhash/v2"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	reqcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/request"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/picker"
+)
+
+const (
+	// DeterministicHashPickerType is the registered name of the deterministic hash picker plugin.
+	DeterministicHashPickerType = "deterministic-hash-picker"
+)
+
+// compile-time type validation
+var _ framework.RequestAwarePicker = &DeterministicHashPicker{}
+
+// Parameters defines the parameters of the DeterministicHashPicker.
+type Parameters struct {
+	picker.PickerParameters
+	// HashHeader is the request header whose value is hashed to break ties, e.g. a request or session ID.
+	// Defaults to x-request-id.
+	HashHeader string `json:"hashHeader"`
+	// Seed is mixed into the hash, so that different seeds produce different (but still stable) placements.
+	Seed uint64 `json:"seed"`
+}
+
+// DeterministicHashPickerFactory defines the factory function for DeterministicHashPicker.
+func DeterministicHashPickerFactory(name string, rawParameters json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	parameters := Parameters{
+		PickerParameters: picker.PickerParameters{MaxNumOfEndpoints: picker.DefaultMaxNumOfEndpoints},
+		HashHeader:       reqcommon.RequestIdHeaderKey,
+	}
+	if rawParameters != nil {
+		if err := json.Unmarshal(rawParameters, &parameters); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' picker - %w", DeterministicHashPickerType, err)
+		}
+	}
+	return NewDeterministicHashPicker(parameters.MaxNumOfEndpoints, parameters.HashHeader, parameters.Seed).WithName(name), nil
+}
+
+// NewDeterministicHashPicker initializes a new DeterministicHashPicker and returns its pointer.
+func NewDeterministicHashPicker(maxNumOfEndpoints int, hashHeader string, seed uint64) *DeterministicHashPicker {
+	if maxNumOfEndpoints <= 0 {
+		maxNumOfEndpoints = picker.DefaultMaxNumOfEndpoints // on invalid configuration value, fallback to default value
+	}
+	if hashHeader == "" {
+		hashHeader = reqcommon.RequestIdHeaderKey
+	}
+	// Envoy delivers header keys in lowercase.
+	hashHeader = strings.ToLower(hashHeader)
+	return &DeterministicHashPicker{
+		typedName:         fwkplugin.TypedName{Type: DeterministicHashPickerType, Name: DeterministicHashPickerType},
+		maxNumOfEndpoints: maxNumOfEndpoints,
+		hashHeader:        hashHeader,
+		seed:              seed,
+	}
+}
+
+// DeterministicHashPicker picks endpoint(s) with the highest score calculated during the scoring phase. Ties are
+// broken by rendezvous hashing of the configured request header value, instead of randomly, so that replays of the
+// same request produce identical placements.
+type DeterministicHashPicker struct {
+	typedName         fwkplugin.TypedName
+	maxNumOfEndpoints int // maximum number of endpoints to pick
+	hashHeader        string
+	seed              uint64
+}
+
+// WithName sets the picker's name
+func (p *DeterministicHashPicker) WithName(name string) *DeterministicHashPicker {
+	p.typedName.Name = name
+	return p
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *DeterministicHashPicker) TypedName() fwkplugin.TypedName {
+	return p.typedName
+}
+
+// Pick selects the endpoint(s) with the highest score, breaking ties with an empty hash key. It is only used when
+// the request is not available.
+func (p *DeterministicHashPicker) Pick(ctx context.Context, cycleState *framework.CycleState, scoredEndpoints []*framework.ScoredEndpoint) *framework.ProfileRunResult {
+	return p.PickForRequest(ctx, cycleState, nil, scoredEndpoints)
+}
+
+// PickForRequest selects the endpoint(s) with the highest score, breaking ties by the hash of the request's hash
+// header value.
+func (p *DeterministicHashPicker) PickForRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest, scoredEndpoints []*framework.ScoredEndpoint) *framework.ProfileRunResult {
+	key := ""
+	if request != nil {
+		key = request.Headers[p.hashHeader]
+	}
+	log.FromContext(ctx).V(logutil.DEBUG).Info("Selecting endpoints from candidates sorted by max score and hash", "max-num-of-endpoints", p.maxNumOfEndpoints,
+		"num-of-candidates", len(scoredEndpoints), "hash-key", key, "scored-endpoints", scoredEndpoints)
+
+	weights := make(map[*framework.ScoredEndpoint]uint64, len(scoredEndpoints))
+	for _, scoredEndpoint := range scoredEndpoints {
+		weights[scoredEndpoint] = p.weight(key, scoredEndpoint)
+	}
+	slices.SortFunc(scoredEndpoints, func(i, j *framework.ScoredEndpoint) int { // highest score first, then highest weight
+		if i.Score > j.Score {
+			return -1
+		}
+		if i.Score < j.Score {
+			return 1
+		}
+		if weights[i] > weights[j] {
+			return -1
+		}
+		if weights[i] < weights[j] {
+			return 1
+		}
+		return 0
+	})
+
+	// if we have enough endpoints to return keep only the "maxNumOfEndpoints" highest scored endpoints
+	if p.maxNumOfEndpoints < len(scoredEndpoints) {
+		scoredEndpoints = scoredEndpoints[:p.maxNumOfEndpoints]
+	}
+
+	targetEndpoints := make([]framework.Endpoint, len(scoredEndpoints))
+	for i, scoredEndpoint := range scoredEndpoints {
+		targetEndpoints[i] = scoredEndpoint
+	}
+
+	return &framework.ProfileRunResult{TargetEndpoints: targetEndpoints}
+}
+
+// weight returns the rendezvous hash weight of the endpoint for the given key. It only depends on the seed, the key
+// and the endpoint's name, so it does not change with the order of the candidates or when other endpoints come and go.
+func (p *DeterministicHashPicker) weight(key string, endpoint *framework.ScoredEndpoint) uint64 {
+	digest := xxhash.NewWithSeed(p.seed)
+	_, _ = digest.WriteString(key)
+	_, _ = digest.WriteString("/")
+	if metadata := endpoint.GetMetadata(); metadata != nil {
+		_, _ = digest.WriteString(metadata.NamespacedName.String())
+	}
+	return digest.Sum64()
+}
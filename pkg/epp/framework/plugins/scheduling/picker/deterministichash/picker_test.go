@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deterministichash
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	reqcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/request"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+func makeEndpoints(n int) []fwksched.Endpoint {
+	endpoints := make([]fwksched.Endpoint, n)
+	for i := range endpoints {
+		endpoints[i] = fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: fmt.Sprintf("pod%d", i)}}, nil, nil)
+	}
+	return endpoints
+}
+
+// scored returns scored candidates with the given scores, in the given order of endpoint indexes.
+func scored(endpoints []fwksched.Endpoint, scores []float64, order []int) []*fwksched.ScoredEndpoint {
+	result := make([]*fwksched.ScoredEndpoint, 0, len(order))
+	for _, i := range order {
+		result = append(result, &fwksched.ScoredEndpoint{Endpoint: endpoints[i], Score: scores[i]})
+	}
+	return result
+}
+
+func requestWithID(id string) *fwksched.InferenceRequest {
+	return &fwksched.InferenceRequest{Headers: map[string]string{reqcommon.RequestIdHeaderKey: id}}
+}
+
+func names(result *fwksched.ProfileRunResult) []string {
+	names := make([]string, len(result.TargetEndpoints))
+	for i, endpoint := range result.TargetEndpoints {
+		names[i] = endpoint.GetMetadata().NamespacedName.Name
+	}
+	return names
+}
+
+func TestPickHighestScore(t *testing.T) {
+	endpoints := makeEndpoints(3)
+	picker := NewDeterministicHashPicker(2, "", 0)
+
+	result := picker.PickForRequest(context.Background(), fwksched.NewCycleState(), requestWithID("req"),
+		scored(endpoints, []float64{10, 30, 20}, []int{0, 1, 2}))
+	assert.Equal(t, []string{"pod1", "pod2"}, names(result))
+}
+
+func TestPickIsStable(t *testing.T) {
+	endpoints := makeEndpoints(8)
+	scores := make([]float64, len(endpoints)) // all tied
+	picker := NewDeterministicHashPicker(1, "", 0)
+
+	picked := map[string]bool{}
+	for i := range 50 {
+		id := fmt.Sprintf("req-%d", i)
+		first := picker.PickForRequest(context.Background(), fwksched.NewCycleState(), requestWithID(id),
+			scored(endpoints, scores, []int{0, 1, 2, 3, 4, 5, 6, 7}))
+		replay := picker.PickForRequest(context.Background(), fwksched.NewCycleState(), requestWithID(id),
+			scored(endpoints, scores, []int{7, 6, 5, 4, 3, 2, 1, 0}))
+		require.Equal(t, names(first), names(replay), "request %s", id)
+		picked[names(first)[0]] = true
+	}
+	assert.Greater(t, len(picked), 1, "different requests should spread across tied endpoints")
+}
+
+func TestPickSeed(t *testing.T) {
+	endpoints := makeEndpoints(8)
+	scores := make([]float64, len(endpoints))
+	order := []int{0, 1, 2, 3, 4, 5, 6, 7}
+
+	differs := false
+	for i := range 20 {
+		request := requestWithID(fmt.Sprintf("req-%d", i))
+		a := NewDeterministicHashPicker(1, "", 1).PickForRequest(context.Background(), fwksched.NewCycleState(), request, scored(endpoints, scores, order))
+		b := NewDeterministicHashPicker(1, "", 1).PickForRequest(context.Background(), fwksched.NewCycleState(), request, scored(endpoints, scores, order))
+		c := NewDeterministicHashPicker(1, "", 2).PickForRequest(context.Background(), fwksched.NewCycleState(), request, scored(endpoints, scores, order))
+		require.Equal(t, names(a), names(b))
+		differs = differs || names(a)[0] != names(c)[0]
+	}
+	assert.True(t, differs, "different seeds should produce different placements")
+}
+
+func TestPickHashHeader(t *testing.T) {
+	endpoints := makeEndpoints(8)
+	scores := make([]float64, len(endpoints))
+	order := []int{0, 1, 2, 3, 4, 5, 6, 7}
+	picker := NewDeterministicHashPicker(1, "x-session-id", 0)
+
+	for i := range 20 {
+		// Requests of the same session land on the same endpoint regardless of their request ID.
+		session := fmt.Sprintf("session-%d", i)
+		a := &fwksched.InferenceRequest{Headers: map[string]string{"x-session-id": session, reqcommon.RequestIdHeaderKey: "a"}}
+		b := &fwksched.InferenceRequest{Headers: map[string]string{"x-session-id": session, reqcommon.RequestIdHeaderKey: "b"}}
+		require.Equal(t,
+			names(picker.PickForRequest(context.Background(), fwksched.NewCycleState(), a, scored(endpoints, scores, order))),
+			names(picker.PickForRequest(context.Background(), fwksched.NewCycleState(), b, scored(endpoints, scores, order))))
+	}
+}
+
+func TestDeterministicHashPickerFactory(t *testing.T) {
+	plugin, err := DeterministicHashPickerFactory("hash", nil, nil)
+	require.NoError(t, err)
+	p := plugin.(*DeterministicHashPicker)
+	assert.Equal(t, "hash", p.TypedName().Name)
+	assert.Equal(t, 1, p.maxNumOfEndpoints)
+	assert.Equal(t, reqcommon.RequestIdHeaderKey, p.hashHeader)
+
+	plugin, err = DeterministicHashPickerFactory("hash", json.RawMessage(`{"maxNumOfEndpoints": 2, "hashHeader": "x-session-id", "seed": 42}`), nil)
+	require.NoError(t, err)
+	p = plugin.(*DeterministicHashPicker)
+	assert.Equal(t, 2, p.maxNumOfEndpoints)
+	assert.Equal(t, "x-session-id", p.hashHeader)
+	assert.Equal(t, uint64(42), p.seed)
+
+	// The header is matched against the lowercase keys delivered by Envoy.
+	plugin, err = DeterministicHashPickerFactory("hash", json.RawMessage(`{"hashHeader": "X-Run-Id"}`), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "x-run-id", plugin.(*DeterministicHashPicker).hashHeader)
+}
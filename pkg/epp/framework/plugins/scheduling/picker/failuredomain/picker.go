@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package failuredomain implements a scheduling picker that selects several endpoints in distinct failure domains,
+// e.g. nodes or zones, for the requests generated redundantly on several endpoints.
+//
+// For detailed behavioral intent and configuration, see the package README.
+package failuredomain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/picker"
+)
+
+const (
+	// FailureDomainSpreadPickerType is the registered name of the failure domain spread picker plugin.
+	FailureDomainSpreadPickerType = "failure-domain-spread-picker"
+	// DomainNode is the failure domain key spreading the picks across nodes.
+	DomainNode = "node"
+	// DefaultCount is the default number of endpoints picked.
+	DefaultCount = 2
+)
+
+// compile-time type validation
+var _ framework.Picker = &FailureDomainSpreadPicker{}
+
+// Parameters defines the parameters of the FailureDomainSpreadPicker.
+type Parameters struct {
+	// Count is the maximum number of endpoints picked, each in a distinct failure domain. Defaults to 2.
+	Count int `json:"count"`
+	// DomainKey is the failure domain of the endpoints: "node" for the node of their pod, or the key of a node label,
+	// e.g. "topology.kubernetes.io/zone", which must be selected with the --node-labels flag. Defaults to "node".
+	DomainKey string `json:"domainKey"`
+}
+
+// FailureDomainSpreadPickerFactory defines the factory function for FailureDomainSpreadPicker.
+func FailureDomainSpreadPickerFactory(name string, rawParameters json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	parameters := Parameters{Count: DefaultCount, DomainKey: DomainNode}
+	if rawParameters != nil {
+		if err := json.Unmarshal(rawParameters, &parameters); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' picker - %w", FailureDomainSpreadPickerType, err)
+		}
+	}
+	if parameters.Count < 1 {
+		return nil, fmt.Errorf("invalid parameters of the '%s' picker - count must be >= 1", FailureDomainSpreadPickerType)
+	}
+	if parameters.DomainKey == "" {
+		return nil, fmt.Errorf("invalid parameters of the '%s' picker - domainKey must not be empty", FailureDomainSpreadPickerType)
+	}
+	return NewFailureDomainSpreadPicker(parameters).WithName(name), nil
+}
+
+// NewFailureDomainSpreadPicker initializes a new FailureDomainSpreadPicker and returns its pointer.
+func NewFailureDomainSpreadPicker(parameters Parameters) *FailureDomainSpreadPicker {
+	return &FailureDomainSpreadPicker{
+		typedName:  fwkplugin.TypedName{Type: FailureDomainSpreadPickerType, Name: FailureDomainSpreadPickerType},
+		parameters: parameters,
+	}
+}
+
+// FailureDomainSpreadPicker picks the endpoint with the highest score, followed by the highest scoring endpoints of
+// other failure domains, up to Count endpoints in distinct failure domains.
+//
+// Endpoints of unknown failure domain, e.g. without the node label, are never picked alongside another endpoint, since
+// they may share its domain: fewer than Count endpoints are picked rather than two in a possibly shared domain.
+type FailureDomainSpreadPicker struct {
+	typedName  fwkplugin.TypedName
+	parameters Parameters
+}
+
+// WithName sets the picker's name
+func (p *FailureDomainSpreadPicker) WithName(name string) *FailureDomainSpreadPicker {
+	p.typedName.Name = name
+	return p
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *FailureDomainSpreadPicker) TypedName() fwkplugin.TypedName {
+	return p.typedName
+}
+
+// Pick selects the highest scoring endpoint of up to Count distinct failure domains.
+func (p *FailureDomainSpreadPicker) Pick(ctx context.Context, _ *framework.CycleState, scoredEndpoints []*framework.ScoredEndpoint) *framework.ProfileRunResult {
+	if len(scoredEndpoints) == 0 {
+		return &framework.ProfileRunResult{}
+	}
+
+	// Shuffle in-place - needed for random tie break when scores are equal
+	picker.ShuffleScoredEndpoints(scoredEndpoints)
+	slices.SortStableFunc(scoredEndpoints, func(i, j *framework.ScoredEndpoint) int { // highest score first
+		if i.Score > j.Score {
+			return -1
+		}
+		if i.Score < j.Score {
+			return 1
+		}
+		return 0
+	})
+
+	selected := scoredEndpoints[0]
+	targetEndpoints := []framework.Endpoint{selected}
+	domain, ok := p.domainOf(selected)
+	if ok {
+		domains := map[string]bool{domain: true}
+		for _, candidate := range scoredEndpoints[1:] {
+			if len(targetEndpoints) >= p.parameters.Count {
+				break
+			}
+			domain, ok := p.domainOf(candidate)
+			if !ok || domains[domain] {
+				continue
+			}
+			domains[domain] = true
+			targetEndpoints = append(targetEndpoints, candidate)
+		}
+	}
+
+	logger := log.FromContext(ctx).V(logutil.DEBUG)
+	if len(targetEndpoints) < p.parameters.Count {
+		logger.Info("Not enough failure domains to spread the endpoints", "domainKey", p.parameters.DomainKey,
+			"picked", len(targetEndpoints), "count", p.parameters.Count, "num-of-candidates", len(scoredEndpoints))
+	} else {
+		logger.Info("Selected endpoints in distinct failure domains", "selected", selected.GetMetadata().NamespacedName,
+			"domainKey", p.parameters.DomainKey, "picked", len(targetEndpoints))
+	}
+	return &framework.ProfileRunResult{TargetEndpoints: targetEndpoints}
+}
+
+// domainOf returns the failure domain of the endpoint, if known.
+func (p *FailureDomainSpreadPicker) domainOf(endpoint framework.Endpoint) (string, bool) {
+	metadata := endpoint.GetMetadata()
+	if metadata == nil {
+		return "", false
+	}
+	var domain string
+	if p.parameters.DomainKey == DomainNode {
+		domain = metadata.NodeName
+	} else {
+		domain = metadata.NodeLabels[p.parameters.DomainKey]
+	}
+	return domain, domain != ""
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package failuredomain
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+const zoneLabel = "topology.kubernetes.io/zone"
+
+func scoredEndpoint(name, node, zone string, score float64) *fwksched.ScoredEndpoint {
+	nodeLabels := map[string]string{}
+	if zone != "" {
+		nodeLabels[zoneLabel] = zone
+	}
+	return &fwksched.ScoredEndpoint{
+		Endpoint: fwksched.NewEndpoint(&fwkdl.EndpointMetadata{
+			NamespacedName: k8stypes.NamespacedName{Namespace: "default", Name: name},
+			NodeName:       node,
+			NodeLabels:     nodeLabels,
+		}, nil, nil),
+		Score: score,
+	}
+}
+
+func names(result *fwksched.ProfileRunResult) []string {
+	names := make([]string, len(result.TargetEndpoints))
+	for i, endpoint := range result.TargetEndpoints {
+		names[i] = endpoint.GetMetadata().NamespacedName.Name
+	}
+	return names
+}
+
+func TestPick(t *testing.T) {
+	tests := []struct {
+		name       string
+		parameters Parameters
+		candidates []*fwksched.ScoredEndpoint
+		want       []string
+	}{
+		{
+			name:       "spreads across nodes in descending score order",
+			parameters: Parameters{Count: 2, DomainKey: DomainNode},
+			candidates: []*fwksched.ScoredEndpoint{
+				scoredEndpoint("pod-a", "node-1", "zone-a", 0.9),
+				scoredEndpoint("pod-b", "node-1", "zone-a", 0.8),
+				scoredEndpoint("pod-c", "node-2", "zone-a", 0.5),
+				scoredEndpoint("pod-d", "node-3", "zone-b", 0.4),
+			},
+			want: []string{"pod-a", "pod-c"},
+		},
+		{
+			name:       "spreads across zones",
+			parameters: Parameters{Count: 2, DomainKey: zoneLabel},
+			candidates: []*fwksched.ScoredEndpoint{
+				scoredEndpoint("pod-a", "node-1", "zone-a", 0.9),
+				scoredEndpoint("pod-c", "node-2", "zone-a", 0.5),
+				scoredEndpoint("pod-d", "node-3", "zone-b", 0.4),
+			},
+			want: []string{"pod-a", "pod-d"},
+		},
+		{
+			name:       "more than two domains",
+			parameters: Parameters{Count: 3, DomainKey: DomainNode},
+			candidates: []*fwksched.ScoredEndpoint{
+				scoredEndpoint("pod-a", "node-1", "", 0.9),
+				scoredEndpoint("pod-b", "node-2", "", 0.8),
+				scoredEndpoint("pod-c", "node-2", "", 0.7),
+				scoredEndpoint("pod-d", "node-3", "", 0.1),
+			},
+			want: []string{"pod-a", "pod-b", "pod-d"},
+		},
+		{
+			name:       "single domain picks a single endpoint",
+			parameters: Parameters{Count: 2, DomainKey: zoneLabel},
+			candidates: []*fwksched.ScoredEndpoint{
+				scoredEndpoint("pod-a", "node-1", "zone-a", 0.9),
+				scoredEndpoint("pod-b", "node-2", "zone-a", 0.8),
+			},
+			want: []string{"pod-a"},
+		},
+		{
+			name:       "endpoints of unknown domain are not picked alongside another",
+			parameters: Parameters{Count: 2, DomainKey: zoneLabel},
+			candidates: []*fwksched.ScoredEndpoint{
+				scoredEndpoint("pod-a", "node-1", "zone-a", 0.9),
+				scoredEndpoint("pod-b", "node-2", "", 0.8),
+				scoredEndpoint("pod-c", "node-3", "zone-b", 0.1),
+			},
+			want: []string{"pod-a", "pod-c"},
+		},
+		{
+			name:       "selected endpoint of unknown domain is picked alone",
+			parameters: Parameters{Count: 2, DomainKey: zoneLabel},
+			candidates: []*fwksched.ScoredEndpoint{
+				scoredEndpoint("pod-a", "node-1", "", 0.9),
+				scoredEndpoint("pod-b", "node-2", "zone-a", 0.8),
+				scoredEndpoint("pod-c", "node-3", "zone-b", 0.1),
+			},
+			want: []string{"pod-a"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := NewFailureDomainSpreadPicker(test.parameters).Pick(context.Background(), fwksched.NewCycleState(), test.candidates)
+			assert.Equal(t, test.want, names(result))
+		})
+	}
+}
+
+func TestPickNoCandidates(t *testing.T) {
+	result := NewFailureDomainSpreadPicker(Parameters{Count: 2, DomainKey: DomainNode}).Pick(context.Background(), fwksched.NewCycleState(), nil)
+	assert.Empty(t, result.TargetEndpoints)
+}
+
+func TestFailureDomainSpreadPickerFactory(t *testing.T) {
+	plugin, err := FailureDomainSpreadPickerFactory("spread", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "spread", plugin.TypedName().Name)
+	assert.Equal(t, Parameters{Count: DefaultCount, DomainKey: DomainNode}, plugin.(*FailureDomainSpreadPicker).parameters)
+
+	plugin, err = FailureDomainSpreadPickerFactory("spread", json.RawMessage(`{"count": 3, "domainKey": "topology.kubernetes.io/zone"}`), nil)
+	require.NoError(t, err)
+	assert.Equal(t, Parameters{Count: 3, DomainKey: zoneLabel}, plugin.(*FailureDomainSpreadPicker).parameters)
+
+	_, err = FailureDomainSpreadPickerFactory("spread", json.RawMessage(`{"count": 0}`), nil)
+	assert.Error(t, err)
+	_, err = FailureDomainSpreadPickerFactory("spread", json.RawMessage(`{"domainKey": ""}`), nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package topk implements a scheduling picker that selects the endpoint with the highest score, followed by an
+// ordered list of fallback endpoints the proxy retries on connection failure.
+//
+// For detailed behavioral intent and configuration, see the package README.
+package topk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/picker"
+)
+
+const (
+	// TopKPickerType is the registered name of the top-k picker plugin.
+	TopKPickerType = "top-k-picker"
+
+	// DefaultFallbackCount is the default number of fallback endpoints.
+	DefaultFallbackCount = 2
+)
+
+// compile-time type validation
+var _ framework.Picker = &TopKPicker{}
+
+// Parameters defines the parameters of the TopKPicker.
+type Parameters struct {
+	// FallbackCount is the maximum number of fallback endpoints returned after the selected endpoint. Defaults to 2.
+	FallbackCount int `json:"fallbackCount"`
+	// MinScoreRatio is the minimum score of a fallback endpoint, relative to the score of the selected endpoint.
+	// Endpoints scoring lower are not worth a retry and are left out. Defaults to 0, i.e. no minimum.
+	MinScoreRatio float64 `json:"minScoreRatio"`
+	// AllowSamePod allows fallback endpoints on the same pod as the selected endpoint or another fallback (e.g.
+	// several ranks of a data parallel server). A connection failure usually affects the whole pod, so such endpoints
+	// are skipped by default.
+	AllowSamePod bool `json:"allowSamePod"`
+}
+
+// TopKPickerFactory defines the factory function for TopKPicker.
+func TopKPickerFactory(name string, rawParameters json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	parameters := Parameters{FallbackCount: DefaultFallbackCount}
+	if rawParameters != nil {
+		if err := json.Unmarshal(rawParameters, &parameters); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' picker - %w", TopKPickerType, err)
+		}
+	}
+	if parameters.FallbackCount < 0 {
+		return nil, fmt.Errorf("invalid parameters of the '%s' picker - fallbackCount must be >= 0", TopKPickerType)
+	}
+	if parameters.MinScoreRatio < 0 || parameters.MinScoreRatio > 1 {
+		return nil, fmt.Errorf("invalid parameters of the '%s' picker - minScoreRatio must be in [0, 1]", TopKPickerType)
+	}
+	return NewTopKPicker(parameters).WithName(name), nil
+}
+
+// NewTopKPicker initializes a new TopKPicker and returns its pointer.
+func NewTopKPicker(parameters Parameters) *TopKPicker {
+	return &TopKPicker{
+		typedName:  fwkplugin.TypedName{Type: TopKPickerType, Name: TopKPickerType},
+		parameters: parameters,
+	}
+}
+
+// TopKPicker picks the endpoint with the highest score, followed by up to FallbackCount fallback endpoints in
+// descending score order.
+//
+// All picked endpoints are sent to the proxy in the destination endpoint list, in order. When the selected endpoint
+// cannot be connected to, a proxy configured with retries moves on to the next endpoint of the list, without a new
+// scheduling cycle.
+type TopKPicker struct {
+	typedName  fwkplugin.TypedName
+	parameters Parameters
+}
+
+// WithName sets the picker's name
+func (p *TopKPicker) WithName(name string) *TopKPicker {
+	p.typedName.Name = name
+	return p
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *TopKPicker) TypedName() fwkplugin.TypedName {
+	return p.typedName
+}
+
+// Pick selects the endpoint with the highest score, and the fallback endpoints.
+func (p *TopKPicker) Pick(ctx context.Context, _ *framework.CycleState, scoredEndpoints []*framework.ScoredEndpoint) *framework.ProfileRunResult {
+	logger := log.FromContext(ctx).V(logutil.DEBUG)
+	if len(scoredEndpoints) == 0 {
+		return &framework.ProfileRunResult{}
+	}
+
+	// Shuffle in-place - needed for random tie break when scores are equal
+	picker.ShuffleScoredEndpoints(scoredEndpoints)
+	slices.SortStableFunc(scoredEndpoints, func(i, j *framework.ScoredEndpoint) int { // highest score first
+		if i.Score > j.Score {
+			return -1
+		}
+		if i.Score < j.Score {
+			return 1
+		}
+		return 0
+	})
+
+	selected := scoredEndpoints[0]
+	targetEndpoints := []framework.Endpoint{selected}
+	pods := map[string]bool{podOf(selected): true}
+	minScore := selected.Score * p.parameters.MinScoreRatio
+	for _, candidate := range scoredEndpoints[1:] {
+		if len(targetEndpoints) > p.parameters.FallbackCount {
+			break
+		}
+		if candidate.Score < minScore {
+			break // candidates are sorted, no further candidate qualifies
+		}
+		pod := podOf(candidate)
+		if pods[pod] && !p.parameters.AllowSamePod {
+			continue
+		}
+		pods[pod] = true
+		targetEndpoints = append(targetEndpoints, candidate)
+	}
+
+	logger.Info("Selected endpoint with fallbacks", "selected", selected.GetMetadata().NamespacedName,
+		"num-of-fallbacks", len(targetEndpoints)-1, "num-of-candidates", len(scoredEndpoints))
+	return &framework.ProfileRunResult{TargetEndpoints: targetEndpoints}
+}
+
+// podOf returns the pod of the endpoint, which is shared by all the ranks of a data parallel server.
+func podOf(endpoint framework.Endpoint) string {
+	metadata := endpoint.GetMetadata()
+	if metadata.PodName == "" {
+		return metadata.NamespacedName.String()
+	}
+	return metadata.NamespacedName.Namespace + "/" + metadata.PodName
+}
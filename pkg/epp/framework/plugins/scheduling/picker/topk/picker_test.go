@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topk
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+func scoredEndpoint(name, pod string, score float64) *fwksched.ScoredEndpoint {
+	return &fwksched.ScoredEndpoint{
+		Endpoint: fwksched.NewEndpoint(&fwkdl.EndpointMetadata{
+			NamespacedName: k8stypes.NamespacedName{Namespace: "default", Name: name},
+			PodName:        pod,
+		}, nil, nil),
+		Score: score,
+	}
+}
+
+func names(result *fwksched.ProfileRunResult) []string {
+	names := make([]string, len(result.TargetEndpoints))
+	for i, endpoint := range result.TargetEndpoints {
+		names[i] = endpoint.GetMetadata().NamespacedName.Name
+	}
+	return names
+}
+
+func TestPick(t *testing.T) {
+	candidates := func() []*fwksched.ScoredEndpoint {
+		return []*fwksched.ScoredEndpoint{
+			scoredEndpoint("pod-c", "", 0.2),
+			scoredEndpoint("pod-a-rank-0", "pod-a", 0.9),
+			scoredEndpoint("pod-a-rank-1", "pod-a", 0.8),
+			scoredEndpoint("pod-b", "", 0.6),
+			scoredEndpoint("pod-d", "", 0.1),
+		}
+	}
+
+	tests := []struct {
+		name       string
+		parameters Parameters
+		want       []string
+	}{
+		{
+			name:       "fallbacks on other pods in descending score order",
+			parameters: Parameters{FallbackCount: 2},
+			want:       []string{"pod-a-rank-0", "pod-b", "pod-c"},
+		},
+		{
+			name:       "fallbacks on the same pod allowed",
+			parameters: Parameters{FallbackCount: 2, AllowSamePod: true},
+			want:       []string{"pod-a-rank-0", "pod-a-rank-1", "pod-b"},
+		},
+		{
+			name:       "fallbacks below the minimum score ratio are left out",
+			parameters: Parameters{FallbackCount: 3, MinScoreRatio: 0.5},
+			want:       []string{"pod-a-rank-0", "pod-b"},
+		},
+		{
+			name:       "no fallbacks",
+			parameters: Parameters{FallbackCount: 0},
+			want:       []string{"pod-a-rank-0"},
+		},
+		{
+			name:       "fewer candidates than fallbacks",
+			parameters: Parameters{FallbackCount: 10},
+			want:       []string{"pod-a-rank-0", "pod-b", "pod-c", "pod-d"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := NewTopKPicker(test.parameters).Pick(context.Background(), fwksched.NewCycleState(), candidates())
+			assert.Equal(t, test.want, names(result))
+		})
+	}
+}
+
+func TestPickNoCandidates(t *testing.T) {
+	result := NewTopKPicker(Parameters{FallbackCount: 2}).Pick(context.Background(), fwksched.NewCycleState(), nil)
+	assert.Empty(t, result.TargetEndpoints)
+}
+
+func TestTopKPickerFactory(t *testing.T) {
+	plugin, err := TopKPickerFactory("top-k", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "top-k", plugin.TypedName().Name)
+	assert.Equal(t, Parameters{FallbackCount: DefaultFallbackCount}, plugin.(*TopKPicker).parameters)
+
+	plugin, err = TopKPickerFactory("top-k", json.RawMessage(`{"fallbackCount": 4, "minScoreRatio": 0.8}`), nil)
+	require.NoError(t, err)
+	assert.Equal(t, Parameters{FallbackCount: 4, MinScoreRatio: 0.8}, plugin.(*TopKPicker).parameters)
+
+	_, err = TopKPickerFactory("top-k", json.RawMessage(`{"fallbackCount": -1}`), nil)
+	assert.Error(t, err)
+	_, err = TopKPickerFactory("top-k", json.RawMessage(`{"minScoreRatio": 1.5}`), nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profile
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+const (
+	AppendTargetsProcessorType = "append-targets-processor"
+)
+
+// compile-time type assertion
+var _ framework.ProfileResultsProcessor = &AppendTargetsProcessor{}
+
+// AppendTargetsProcessorParameters defines the parameters of the AppendTargetsProcessor.
+type AppendTargetsProcessorParameters struct {
+	// Profiles are the profiles whose targets are appended to the targets of the primary profile, in order.
+	Profiles []string `json:"profiles"`
+	// MaxTargets caps the number of targets of the primary profile after appending. 0 means no limit.
+	MaxTargets int `json:"maxTargets,omitempty"`
+}
+
+func (p *AppendTargetsProcessorParameters) validate() error {
+	if len(p.Profiles) == 0 {
+		return errors.New("profiles must not be empty")
+	}
+	if p.MaxTargets < 0 {
+		return fmt.Errorf("maxTargets must be >= 0, got %d", p.MaxTargets)
+	}
+	return nil
+}
+
+// AppendTargetsProcessorFactory defines the factory function for AppendTargetsProcessor.
+func AppendTargetsProcessorFactory(name string, rawParameters json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	parameters := AppendTargetsProcessorParameters{}
+	if rawParameters != nil {
+		if err := json.Unmarshal(rawParameters, &parameters); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' processor - %w", AppendTargetsProcessorType, err)
+		}
+	}
+	if err := parameters.validate(); err != nil {
+		return nil, fmt.Errorf("invalid parameters of the '%s' processor - %w", AppendTargetsProcessorType, err)
+	}
+	return NewAppendTargetsProcessor(parameters).WithName(name), nil
+}
+
+// NewAppendTargetsProcessor initializes a new AppendTargetsProcessor and returns its pointer.
+func NewAppendTargetsProcessor(parameters AppendTargetsProcessorParameters) *AppendTargetsProcessor {
+	return &AppendTargetsProcessor{
+		typedName:  fwkplugin.TypedName{Type: AppendTargetsProcessorType, Name: AppendTargetsProcessorType},
+		profiles:   slices.Clone(parameters.Profiles),
+		maxTargets: parameters.MaxTargets,
+	}
+}
+
+// AppendTargetsProcessor appends the targets of secondary profiles to the targets of the primary profile, skipping
+// duplicates. The appended endpoints are used as fallback destinations by the proxy, e.g. endpoints picked by a
+// profile optimizing for a different objective, or in a different slice of the pool.
+type AppendTargetsProcessor struct {
+	typedName  fwkplugin.TypedName
+	profiles   []string
+	maxTargets int
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *AppendTargetsProcessor) TypedName() fwkplugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the processor.
+func (p *AppendTargetsProcessor) WithName(name string) *AppendTargetsProcessor {
+	p.typedName.Name = name
+	return p
+}
+
+// ReferencedProfiles returns the names of the scheduling profiles the processor reads.
+func (p *AppendTargetsProcessor) ReferencedProfiles() []string {
+	return p.profiles
+}
+
+// ProcessProfileResults appends the targets of the configured profiles to the targets of the primary profile. Profiles
+// which did not run or failed are skipped.
+func (p *AppendTargetsProcessor) ProcessProfileResults(_ context.Context, _ *framework.CycleState, _ *framework.InferenceRequest,
+	result *framework.SchedulingResult) (*framework.SchedulingResult, error) {
+	primary := result.ProfileResults[result.PrimaryProfileName]
+	if primary == nil {
+		return nil, fmt.Errorf("primary profile '%s' has no result", result.PrimaryProfileName)
+	}
+
+	seen := make(map[string]bool, len(primary.TargetEndpoints))
+	targets := make([]framework.Endpoint, 0, len(primary.TargetEndpoints))
+	add := func(endpoint framework.Endpoint) {
+		name := endpoint.GetMetadata().NamespacedName.String()
+		if seen[name] || (p.maxTargets > 0 && len(targets) >= p.maxTargets) {
+			return
+		}
+		seen[name] = true
+		targets = append(targets, endpoint)
+	}
+	for _, endpoint := range primary.TargetEndpoints {
+		add(endpoint)
+	}
+	for _, name := range p.profiles {
+		if profileResult := result.ProfileResults[name]; profileResult != nil {
+			for _, endpoint := range profileResult.TargetEndpoints {
+				add(endpoint)
+			}
+		}
+	}
+
+	// The profile results are shared with the other consumers of the scheduling result, so a new primary result is
+	// set rather than mutating the existing one.
+	profileResults := maps.Clone(result.ProfileResults)
+	profileResults[result.PrimaryProfileName] = &framework.ProfileRunResult{TargetEndpoints: targets}
+	return &framework.SchedulingResult{
+		ProfileResults:     profileResults,
+		PrimaryProfileName: result.PrimaryProfileName,
+	}, nil
+}
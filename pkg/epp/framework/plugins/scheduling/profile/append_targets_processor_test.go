@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profile
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+func TestAppendTargetsProcessorFactory(t *testing.T) {
+	if _, err := AppendTargetsProcessorFactory("processor", json.RawMessage(`{"profiles": ["mirror"], "maxTargets": 2}`), nil); err != nil {
+		t.Errorf("AppendTargetsProcessorFactory() returned unexpected error: %v", err)
+	}
+	if _, err := AppendTargetsProcessorFactory("processor", json.RawMessage(`{}`), nil); err == nil {
+		t.Errorf("Expected an error for missing profiles, got none")
+	}
+	if _, err := AppendTargetsProcessorFactory("processor", json.RawMessage(`{"profiles": ["mirror"], "maxTargets": -1}`), nil); err == nil {
+		t.Errorf("Expected an error for negative maxTargets, got none")
+	}
+}
+
+func TestAppendTargetsProcessor(t *testing.T) {
+	endpoint := func(name string) framework.Endpoint {
+		return framework.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: name}}, nil, nil)
+	}
+	names := func(endpoints []framework.Endpoint) []string {
+		res := []string{}
+		for _, e := range endpoints {
+			res = append(res, e.GetMetadata().NamespacedName.Name)
+		}
+		return res
+	}
+	a, b, c := endpoint("a"), endpoint("b"), endpoint("c")
+	input := func() *framework.SchedulingResult {
+		return &framework.SchedulingResult{
+			PrimaryProfileName: "primary",
+			ProfileResults: map[string]*framework.ProfileRunResult{
+				"primary":  {TargetEndpoints: []framework.Endpoint{a}},
+				"fallback": {TargetEndpoints: []framework.Endpoint{a, b}},
+				"mirror":   {TargetEndpoints: []framework.Endpoint{c}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		params AppendTargetsProcessorParameters
+		want   []string
+	}{
+		{
+			name:   "append in order without duplicates",
+			params: AppendTargetsProcessorParameters{Profiles: []string{"fallback", "mirror"}},
+			want:   []string{"a", "b", "c"},
+		},
+		{
+			name:   "max targets",
+			params: AppendTargetsProcessorParameters{Profiles: []string{"mirror", "fallback"}, MaxTargets: 2},
+			want:   []string{"a", "c"},
+		},
+		{
+			name:   "missing profile is skipped",
+			params: AppendTargetsProcessorParameters{Profiles: []string{"unknown", "mirror"}},
+			want:   []string{"a", "c"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			in := input()
+			result, err := NewAppendTargetsProcessor(test.params).ProcessProfileResults(context.Background(), nil, nil, in)
+			if err != nil {
+				t.Fatalf("ProcessProfileResults() returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(test.want, names(result.ProfileResults["primary"].TargetEndpoints)); diff != "" {
+				t.Errorf("Unexpected primary targets (-want +got): %s", diff)
+			}
+			if diff := cmp.Diff([]string{"a"}, names(in.ProfileResults["primary"].TargetEndpoints)); diff != "" {
+				t.Errorf("Input result must not be mutated (-want +got): %s", diff)
+			}
+		})
+	}
+}
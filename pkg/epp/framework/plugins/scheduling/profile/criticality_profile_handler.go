@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profile
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+const (
+	CriticalityProfileHandlerType = "criticality-profile-handler"
+)
+
+// compile-time type assertion
+var _ framework.ProfileHandler = &CriticalityProfileHandler{}
+
+// CriticalityRule maps the requests with a priority of at least MinPriority to a scheduling profile.
+type CriticalityRule struct {
+	// MinPriority is the minimum priority of the requests matched by the rule.
+	MinPriority int `json:"minPriority"`
+	// Profile is the name of the scheduling profile run for the matched requests.
+	Profile string `json:"profile"`
+}
+
+// CriticalityProfileHandlerParameters defines the parameters of the CriticalityProfileHandler.
+type CriticalityProfileHandlerParameters struct {
+	// Rules map priority ranges to scheduling profiles. A request is matched by the rule with the highest MinPriority
+	// not greater than its priority, regardless of the order of the rules.
+	Rules []CriticalityRule `json:"rules"`
+	// DefaultProfile is the scheduling profile run for requests not matched by any rule.
+	DefaultProfile string `json:"defaultProfile"`
+	// PriorityHeader is an optional request header carrying an integer priority overriding the priority of the
+	// InferenceObjective of the request. Invalid values are ignored.
+	PriorityHeader string `json:"priorityHeader,omitempty"`
+}
+
+func (p *CriticalityProfileHandlerParameters) validate() error {
+	if p.DefaultProfile == "" {
+		return errors.New("defaultProfile must be set")
+	}
+	seen := map[int]bool{}
+	for i, rule := range p.Rules {
+		if rule.Profile == "" {
+			return fmt.Errorf("rules[%d]: profile must be set", i)
+		}
+		if seen[rule.MinPriority] {
+			return fmt.Errorf("rules[%d]: duplicate minPriority %d", i, rule.MinPriority)
+		}
+		seen[rule.MinPriority] = true
+	}
+	return nil
+}
+
+// CriticalityProfileHandlerFactory defines the factory function for CriticalityProfileHandler.
+func CriticalityProfileHandlerFactory(name string, rawParameters json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	parameters := CriticalityProfileHandlerParameters{}
+	if rawParameters != nil {
+		if err := json.Unmarshal(rawParameters, &parameters); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' profile handler - %w", CriticalityProfileHandlerType, err)
+		}
+	}
+	if err := parameters.validate(); err != nil {
+		return nil, fmt.Errorf("invalid parameters of the '%s' profile handler - %w", CriticalityProfileHandlerType, err)
+	}
+	return NewCriticalityProfileHandler(parameters).WithName(name), nil
+}
+
+// NewCriticalityProfileHandler initializes a new CriticalityProfileHandler and returns its pointer.
+func NewCriticalityProfileHandler(parameters CriticalityProfileHandlerParameters) *CriticalityProfileHandler {
+	rules := slices.Clone(parameters.Rules)
+	slices.SortFunc(rules, func(a, b CriticalityRule) int { return b.MinPriority - a.MinPriority })
+	return &CriticalityProfileHandler{
+		typedName:      fwkplugin.TypedName{Type: CriticalityProfileHandlerType, Name: CriticalityProfileHandlerType},
+		rules:          rules,
+		defaultProfile: parameters.DefaultProfile,
+		priorityHeader: strings.ToLower(parameters.PriorityHeader),
+	}
+}
+
+// CriticalityProfileHandler runs a single profile per request, selected by the request priority. For example, sheddable
+// requests may use a cheap profile picking endpoints randomly, while critical requests use the full chain of filters
+// and scorers. The selected profile is the primary profile.
+type CriticalityProfileHandler struct {
+	typedName      fwkplugin.TypedName
+	rules          []CriticalityRule // sorted by MinPriority, in descending order
+	defaultProfile string
+	priorityHeader string
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (h *CriticalityProfileHandler) TypedName() fwkplugin.TypedName {
+	return h.typedName
+}
+
+// WithName sets the name of the profile handler.
+func (h *CriticalityProfileHandler) WithName(name string) *CriticalityProfileHandler {
+	h.typedName.Name = name
+	return h
+}
+
+// ReferencedProfiles returns the names of the scheduling profiles the handler may select.
+func (h *CriticalityProfileHandler) ReferencedProfiles() []string {
+	names := []string{h.defaultProfile}
+	for _, rule := range h.rules {
+		names = append(names, rule.Profile)
+	}
+	return names
+}
+
+// Pick selects the profile matching the request priority on the first call, and no profile afterwards.
+func (h *CriticalityProfileHandler) Pick(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest, profiles map[string]framework.SchedulerProfile,
+	profileResults map[string]*framework.ProfileRunResult) map[string]framework.SchedulerProfile {
+	if len(profileResults) > 0 { // the selected profile has been executed already in previous call
+		return map[string]framework.SchedulerProfile{}
+	}
+
+	priority := h.priorityOf(request)
+	name := h.profileFor(priority)
+	profile, ok := profiles[name]
+	if !ok {
+		log.FromContext(ctx).Error(nil, "Selected scheduling profile not found", "profile", name, "priority", priority)
+		return map[string]framework.SchedulerProfile{}
+	}
+	log.FromContext(ctx).V(logutil.DEBUG).Info("Selected scheduling profile by criticality", "profile", name, "priority", priority)
+	return map[string]framework.SchedulerProfile{name: profile}
+}
+
+// ProcessResults sets the single profile that ran as the primary profile.
+// When the profile run fails, its result in the profileResults map is nil.
+func (h *CriticalityProfileHandler) ProcessResults(_ context.Context, _ *framework.CycleState, _ *framework.InferenceRequest,
+	profileResults map[string]*framework.ProfileRunResult) (*framework.SchedulingResult, error) {
+	if len(profileResults) != 1 {
+		return nil, fmt.Errorf("criticality profile handler expects a single profile run, got %d", len(profileResults))
+	}
+
+	var profileName string
+	for name := range profileResults {
+		profileName = name
+	}
+
+	if profileResults[profileName] == nil { // there was an error while running the profile
+		return nil, fmt.Errorf("failed to run scheduler profile '%s'", profileName)
+	}
+
+	return &framework.SchedulingResult{
+		ProfileResults:     profileResults,
+		PrimaryProfileName: profileName,
+	}, nil
+}
+
+// priorityOf returns the priority of the request, from the priority header when configured and valid, or from the
+// request objectives otherwise.
+func (h *CriticalityProfileHandler) priorityOf(request *framework.InferenceRequest) int {
+	if request == nil {
+		return 0
+	}
+	if h.priorityHeader != "" {
+		if value, ok := request.Headers[h.priorityHeader]; ok {
+			if priority, err := strconv.Atoi(strings.TrimSpace(value)); err == nil {
+				return priority
+			}
+		}
+	}
+	return request.Objectives.Priority
+}
+
+// profileFor returns the profile of the rule matching the given priority, or the default profile.
+func (h *CriticalityProfileHandler) profileFor(priority int) string {
+	for _, rule := range h.rules {
+		if priority >= rule.MinPriority {
+			return rule.Profile
+		}
+	}
+	return h.defaultProfile
+}
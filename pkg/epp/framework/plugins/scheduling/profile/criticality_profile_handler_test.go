@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profile
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+func TestCriticalityProfileHandlerFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		params    string
+		expectErr bool
+	}{
+		{
+			name:   "valid parameters",
+			params: `{"rules": [{"minPriority": 0, "profile": "full"}], "defaultProfile": "cheap", "priorityHeader": "x-priority"}`,
+		},
+		{
+			name:   "default profile only",
+			params: `{"defaultProfile": "cheap"}`,
+		},
+		{
+			name:      "missing default profile",
+			params:    `{"rules": [{"minPriority": 0, "profile": "full"}]}`,
+			expectErr: true,
+		},
+		{
+			name:      "rule without profile",
+			params:    `{"rules": [{"minPriority": 0}], "defaultProfile": "cheap"}`,
+			expectErr: true,
+		},
+		{
+			name:      "duplicate min priority",
+			params:    `{"rules": [{"minPriority": 0, "profile": "a"}, {"minPriority": 0, "profile": "b"}], "defaultProfile": "cheap"}`,
+			expectErr: true,
+		},
+		{
+			name:      "invalid json",
+			params:    `{"defaultProfile": 1}`,
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			plugin, err := CriticalityProfileHandlerFactory("handler", json.RawMessage(test.params), nil)
+			if test.expectErr {
+				if err == nil {
+					t.Errorf("Expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CriticalityProfileHandlerFactory() returned unexpected error: %v", err)
+			}
+			if plugin.TypedName().Name != "handler" {
+				t.Errorf("Expected Name to be %q, got %q", "handler", plugin.TypedName().Name)
+			}
+		})
+	}
+}
+
+func TestCriticalityProfileHandlerPick(t *testing.T) {
+	profiles := map[string]framework.SchedulerProfile{
+		"critical": &fakeSchedulerProfile{},
+		"standard": &fakeSchedulerProfile{},
+		"cheap":    &fakeSchedulerProfile{},
+	}
+	handler := NewCriticalityProfileHandler(CriticalityProfileHandlerParameters{
+		Rules: []CriticalityRule{
+			{MinPriority: 0, Profile: "standard"},
+			{MinPriority: 10, Profile: "critical"},
+		},
+		DefaultProfile: "cheap",
+		PriorityHeader: "X-Priority",
+	})
+
+	tests := []struct {
+		name           string
+		request        *framework.InferenceRequest
+		profileResults map[string]*framework.ProfileRunResult
+		want           []string
+	}{
+		{
+			name:    "critical request",
+			request: &framework.InferenceRequest{Objectives: framework.RequestObjectives{Priority: 10}},
+			want:    []string{"critical"},
+		},
+		{
+			name:    "standard request",
+			request: &framework.InferenceRequest{Objectives: framework.RequestObjectives{Priority: 5}},
+			want:    []string{"standard"},
+		},
+		{
+			name:    "sheddable request",
+			request: &framework.InferenceRequest{Objectives: framework.RequestObjectives{Priority: -1}},
+			want:    []string{"cheap"},
+		},
+		{
+			name: "priority header overrides objective",
+			request: &framework.InferenceRequest{
+				Headers:    map[string]string{"x-priority": "20"},
+				Objectives: framework.RequestObjectives{Priority: -1},
+			},
+			want: []string{"critical"},
+		},
+		{
+			name: "invalid priority header is ignored",
+			request: &framework.InferenceRequest{
+				Headers:    map[string]string{"x-priority": "high"},
+				Objectives: framework.RequestObjectives{Priority: -1},
+			},
+			want: []string{"cheap"},
+		},
+		{
+			name:           "profile already ran",
+			request:        &framework.InferenceRequest{},
+			profileResults: map[string]*framework.ProfileRunResult{"standard": {}},
+			want:           []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			picked := handler.Pick(context.Background(), nil, test.request, profiles, test.profileResults)
+			got := []string{}
+			for name := range picked {
+				got = append(got, name)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Unexpected picked profiles (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCriticalityProfileHandlerProcessResults(t *testing.T) {
+	handler := NewCriticalityProfileHandler(CriticalityProfileHandlerParameters{DefaultProfile: "cheap"})
+
+	result, err := handler.ProcessResults(context.Background(), nil, nil,
+		map[string]*framework.ProfileRunResult{"cheap": {}})
+	if err != nil {
+		t.Fatalf("ProcessResults() returned unexpected error: %v", err)
+	}
+	if result.PrimaryProfileName != "cheap" {
+		t.Errorf("Expected primary profile %q, got %q", "cheap", result.PrimaryProfileName)
+	}
+
+	if _, err := handler.ProcessResults(context.Background(), nil, nil,
+		map[string]*framework.ProfileRunResult{"cheap": nil}); err == nil {
+		t.Errorf("Expected an error for a failed profile run, got none")
+	}
+}
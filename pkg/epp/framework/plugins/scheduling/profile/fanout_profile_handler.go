@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profile
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+const (
+	FanOutProfileHandlerType = "fan-out-profile-handler"
+)
+
+// compile-time type assertion
+var _ framework.ProfileHandler = &FanOutProfileHandler{}
+
+// FanOutProfileHandlerParameters defines the parameters of the FanOutProfileHandler.
+type FanOutProfileHandlerParameters struct {
+	// Profiles are the scheduling profiles run for every request. If empty, all the scheduling profiles are run.
+	Profiles []string `json:"profiles,omitempty"`
+	// PrimaryProfile is the profile whose targets are the destination of the request.
+	PrimaryProfile string `json:"primaryProfile"`
+}
+
+func (p *FanOutProfileHandlerParameters) validate() error {
+	if p.PrimaryProfile == "" {
+		return errors.New("primaryProfile must be set")
+	}
+	if len(p.Profiles) > 0 && !slices.Contains(p.Profiles, p.PrimaryProfile) {
+		return fmt.Errorf("primaryProfile '%s' must be one of the profiles", p.PrimaryProfile)
+	}
+	return nil
+}
+
+// FanOutProfileHandlerFactory defines the factory function for FanOutProfileHandler.
+func FanOutProfileHandlerFactory(name string, rawParameters json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	parameters := FanOutProfileHandlerParameters{}
+	if rawParameters != nil {
+		if err := json.Unmarshal(rawParameters, &parameters); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' profile handler - %w", FanOutProfileHandlerType, err)
+		}
+	}
+	if err := parameters.validate(); err != nil {
+		return nil, fmt.Errorf("invalid parameters of the '%s' profile handler - %w", FanOutProfileHandlerType, err)
+	}
+	return NewFanOutProfileHandler(parameters).WithName(name), nil
+}
+
+// NewFanOutProfileHandler initializes a new FanOutProfileHandler and returns its pointer.
+func NewFanOutProfileHandler(parameters FanOutProfileHandlerParameters) *FanOutProfileHandler {
+	return &FanOutProfileHandler{
+		typedName:      fwkplugin.TypedName{Type: FanOutProfileHandlerType, Name: FanOutProfileHandlerType},
+		profiles:       slices.Clone(parameters.Profiles),
+		primaryProfile: parameters.PrimaryProfile,
+	}
+}
+
+// FanOutProfileHandler runs several profiles for every request, for example a prefill and a decode profile in
+// disaggregated serving, or a primary and a mirror profile. All the results are kept in the SchedulingResult so that
+// ProfileResultsProcessors and PreRequest plugins can combine them; the configured primary profile sets the
+// destination of the request.
+type FanOutProfileHandler struct {
+	typedName      fwkplugin.TypedName
+	profiles       []string
+	primaryProfile string
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (h *FanOutProfileHandler) TypedName() fwkplugin.TypedName {
+	return h.typedName
+}
+
+// WithName sets the name of the profile handler.
+func (h *FanOutProfileHandler) WithName(name string) *FanOutProfileHandler {
+	h.typedName.Name = name
+	return h
+}
+
+// ReferencedProfiles returns the names of the scheduling profiles the handler runs.
+func (h *FanOutProfileHandler) ReferencedProfiles() []string {
+	if len(h.profiles) == 0 {
+		return []string{h.primaryProfile}
+	}
+	return h.profiles
+}
+
+// Pick selects all the configured profiles on the first call, and no profile afterwards.
+func (h *FanOutProfileHandler) Pick(ctx context.Context, _ *framework.CycleState, _ *framework.InferenceRequest, profiles map[string]framework.SchedulerProfile,
+	profileResults map[string]*framework.ProfileRunResult) map[string]framework.SchedulerProfile {
+	if len(profileResults) > 0 { // the selected profiles have been executed already in previous call
+		return map[string]framework.SchedulerProfile{}
+	}
+	if len(h.profiles) == 0 {
+		return profiles
+	}
+
+	picked := make(map[string]framework.SchedulerProfile, len(h.profiles))
+	for _, name := range h.profiles {
+		profile, ok := profiles[name]
+		if !ok {
+			log.FromContext(ctx).Error(nil, "Configured scheduling profile not found", "profile", name)
+			continue
+		}
+		picked[name] = profile
+	}
+	return picked
+}
+
+// ProcessResults sets the configured primary profile as the primary profile, and keeps the results of the other
+// profiles which ran successfully. It fails only when the primary profile failed.
+func (h *FanOutProfileHandler) ProcessResults(ctx context.Context, _ *framework.CycleState, _ *framework.InferenceRequest,
+	profileResults map[string]*framework.ProfileRunResult) (*framework.SchedulingResult, error) {
+	if profileResults[h.primaryProfile] == nil { // there was an error while running the primary profile
+		return nil, fmt.Errorf("failed to run primary scheduler profile '%s'", h.primaryProfile)
+	}
+
+	results := make(map[string]*framework.ProfileRunResult, len(profileResults))
+	for name, result := range profileResults {
+		if result == nil {
+			log.FromContext(ctx).Info("Ignoring failed scheduling profile", "profile", name)
+			continue
+		}
+		results[name] = result
+	}
+	return &framework.SchedulingResult{
+		ProfileResults:     results,
+		PrimaryProfileName: h.primaryProfile,
+	}, nil
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profile
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+func TestFanOutProfileHandlerFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		params    string
+		expectErr bool
+	}{
+		{
+			name:   "valid parameters",
+			params: `{"profiles": ["prefill", "decode"], "primaryProfile": "decode"}`,
+		},
+		{
+			name:   "all profiles",
+			params: `{"primaryProfile": "decode"}`,
+		},
+		{
+			name:      "missing primary profile",
+			params:    `{"profiles": ["prefill", "decode"]}`,
+			expectErr: true,
+		},
+		{
+			name:      "primary profile not in profiles",
+			params:    `{"profiles": ["prefill"], "primaryProfile": "decode"}`,
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			plugin, err := FanOutProfileHandlerFactory("handler", json.RawMessage(test.params), nil)
+			if test.expectErr {
+				if err == nil {
+					t.Errorf("Expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FanOutProfileHandlerFactory() returned unexpected error: %v", err)
+			}
+			if plugin.TypedName().Name != "handler" {
+				t.Errorf("Expected Name to be %q, got %q", "handler", plugin.TypedName().Name)
+			}
+		})
+	}
+}
+
+func TestFanOutProfileHandlerPick(t *testing.T) {
+	profiles := map[string]framework.SchedulerProfile{
+		"prefill": &fakeSchedulerProfile{},
+		"decode":  &fakeSchedulerProfile{},
+		"mirror":  &fakeSchedulerProfile{},
+	}
+
+	tests := []struct {
+		name           string
+		params         FanOutProfileHandlerParameters
+		profileResults map[string]*framework.ProfileRunResult
+		want           []string
+	}{
+		{
+			name:   "configured profiles",
+			params: FanOutProfileHandlerParameters{Profiles: []string{"prefill", "decode"}, PrimaryProfile: "decode"},
+			want:   []string{"decode", "prefill"},
+		},
+		{
+			name:   "all profiles",
+			params: FanOutProfileHandlerParameters{PrimaryProfile: "decode"},
+			want:   []string{"decode", "mirror", "prefill"},
+		},
+		{
+			name:           "profiles already ran",
+			params:         FanOutProfileHandlerParameters{PrimaryProfile: "decode"},
+			profileResults: map[string]*framework.ProfileRunResult{"decode": {}},
+			want:           []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			picked := NewFanOutProfileHandler(test.params).Pick(context.Background(), nil, nil, profiles, test.profileResults)
+			got := []string{}
+			for name := range picked {
+				got = append(got, name)
+			}
+			sort.Strings(got)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Unexpected picked profiles (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFanOutProfileHandlerProcessResults(t *testing.T) {
+	handler := NewFanOutProfileHandler(FanOutProfileHandlerParameters{PrimaryProfile: "decode"})
+
+	result, err := handler.ProcessResults(context.Background(), nil, nil,
+		map[string]*framework.ProfileRunResult{"decode": {}, "prefill": {}, "mirror": nil})
+	if err != nil {
+		t.Fatalf("ProcessResults() returned unexpected error: %v", err)
+	}
+	if result.PrimaryProfileName != "decode" {
+		t.Errorf("Expected primary profile %q, got %q", "decode", result.PrimaryProfileName)
+	}
+	if len(result.ProfileResults) != 2 {
+		t.Errorf("Expected the failed profile to be dropped, got results %v", result.ProfileResults)
+	}
+
+	if _, err := handler.ProcessResults(context.Background(), nil, nil,
+		map[string]*framework.ProfileRunResult{"decode": nil, "prefill": {}}); err == nil {
+		t.Errorf("Expected an error for a failed primary profile run, got none")
+	}
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profile
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+const (
+	RedundancyProfileHandlerType = "redundancy-profile-handler"
+
+	// DefaultRedundancyHeader is the default request header through which clients request redundancy.
+	DefaultRedundancyHeader = "x-gateway-inference-redundancy"
+)
+
+// compile-time type assertion
+var _ framework.ProfileHandler = &RedundancyProfileHandler{}
+
+// RedundancyProfileHandlerParameters defines the parameters of the RedundancyProfileHandler.
+type RedundancyProfileHandlerParameters struct {
+	// RedundantProfile is the scheduling profile run for the requests asking for redundancy. It typically ends with a
+	// picker returning several endpoints, e.g. the failure-domain-spread-picker.
+	RedundantProfile string `json:"redundantProfile"`
+	// DefaultProfile is the scheduling profile run for the other requests.
+	DefaultProfile string `json:"defaultProfile"`
+	// Header is the request header through which clients ask for redundancy, with a true boolean value.
+	// Defaults to DefaultRedundancyHeader.
+	Header string `json:"header,omitempty"`
+}
+
+func (p *RedundancyProfileHandlerParameters) validate() error {
+	if p.RedundantProfile == "" {
+		return errors.New("redundantProfile must be set")
+	}
+	if p.DefaultProfile == "" {
+		return errors.New("defaultProfile must be set")
+	}
+	return nil
+}
+
+// RedundancyProfileHandlerFactory defines the factory function for RedundancyProfileHandler.
+func RedundancyProfileHandlerFactory(name string, rawParameters json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	parameters := RedundancyProfileHandlerParameters{Header: DefaultRedundancyHeader}
+	if rawParameters != nil {
+		if err := json.Unmarshal(rawParameters, &parameters); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' profile handler - %w", RedundancyProfileHandlerType, err)
+		}
+	}
+	if err := parameters.validate(); err != nil {
+		return nil, fmt.Errorf("invalid parameters of the '%s' profile handler - %w", RedundancyProfileHandlerType, err)
+	}
+	return NewRedundancyProfileHandler(parameters).WithName(name), nil
+}
+
+// NewRedundancyProfileHandler initializes a new RedundancyProfileHandler and returns its pointer.
+func NewRedundancyProfileHandler(parameters RedundancyProfileHandlerParameters) *RedundancyProfileHandler {
+	header := parameters.Header
+	if header == "" {
+		header = DefaultRedundancyHeader
+	}
+	return &RedundancyProfileHandler{
+		typedName:        fwkplugin.TypedName{Type: RedundancyProfileHandlerType, Name: RedundancyProfileHandlerType},
+		redundantProfile: parameters.RedundantProfile,
+		defaultProfile:   parameters.DefaultProfile,
+		header:           strings.ToLower(header),
+	}
+}
+
+// RedundancyProfileHandler runs a single profile per request: the redundant profile for the requests asking for
+// redundancy through a header, and the default profile otherwise. The redundant profile returns several destinations,
+// on which the request is generated in duplicate. The selected profile is the primary profile.
+type RedundancyProfileHandler struct {
+	typedName        fwkplugin.TypedName
+	redundantProfile string
+	defaultProfile   string
+	header           string
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (h *RedundancyProfileHandler) TypedName() fwkplugin.TypedName {
+	return h.typedName
+}
+
+// WithName sets the name of the profile handler.
+func (h *RedundancyProfileHandler) WithName(name string) *RedundancyProfileHandler {
+	h.typedName.Name = name
+	return h
+}
+
+// ReferencedProfiles returns the names of the scheduling profiles the handler may select.
+func (h *RedundancyProfileHandler) ReferencedProfiles() []string {
+	return []string{h.redundantProfile, h.defaultProfile}
+}
+
+// Pick selects the redundant or the default profile on the first call, and no profile afterwards.
+func (h *RedundancyProfileHandler) Pick(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest, profiles map[string]framework.SchedulerProfile,
+	profileResults map[string]*framework.ProfileRunResult) map[string]framework.SchedulerProfile {
+	if len(profileResults) > 0 { // the selected profile has been executed already in previous call
+		return map[string]framework.SchedulerProfile{}
+	}
+
+	name := h.defaultProfile
+	if h.redundancyRequested(request) {
+		name = h.redundantProfile
+	}
+	profile, ok := profiles[name]
+	if !ok {
+		log.FromContext(ctx).Error(nil, "Selected scheduling profile not found", "profile", name)
+		return map[string]framework.SchedulerProfile{}
+	}
+	log.FromContext(ctx).V(logutil.DEBUG).Info("Selected scheduling profile by redundancy", "profile", name)
+	return map[string]framework.SchedulerProfile{name: profile}
+}
+
+// ProcessResults sets the single profile that ran as the primary profile.
+// When the profile run fails, its result in the profileResults map is nil.
+func (h *RedundancyProfileHandler) ProcessResults(_ context.Context, _ *framework.CycleState, _ *framework.InferenceRequest,
+	profileResults map[string]*framework.ProfileRunResult) (*framework.SchedulingResult, error) {
+	if len(profileResults) != 1 {
+		return nil, fmt.Errorf("redundancy profile handler expects a single profile run, got %d", len(profileResults))
+	}
+
+	var profileName string
+	for name := range profileResults {
+		profileName = name
+	}
+
+	if profileResults[profileName] == nil { // there was an error while running the profile
+		return nil, fmt.Errorf("failed to run scheduler profile '%s'", profileName)
+	}
+
+	return &framework.SchedulingResult{
+		ProfileResults:     profileResults,
+		PrimaryProfileName: profileName,
+	}, nil
+}
+
+// redundancyRequested returns whether the request asks for redundancy. Invalid header values are ignored.
+func (h *RedundancyProfileHandler) redundancyRequested(request *framework.InferenceRequest) bool {
+	if request == nil {
+		return false
+	}
+	requested, err := strconv.ParseBool(strings.TrimSpace(request.Headers[h.header]))
+	return err == nil && requested
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profile
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+func TestRedundancyProfileHandlerFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		params    string
+		expectErr bool
+	}{
+		{
+			name:   "valid parameters",
+			params: `{"redundantProfile": "spread", "defaultProfile": "default", "header": "x-redundant"}`,
+		},
+		{
+			name:   "default header",
+			params: `{"redundantProfile": "spread", "defaultProfile": "default"}`,
+		},
+		{
+			name:      "missing redundant profile",
+			params:    `{"defaultProfile": "default"}`,
+			expectErr: true,
+		},
+		{
+			name:      "missing default profile",
+			params:    `{"redundantProfile": "spread"}`,
+			expectErr: true,
+		},
+		{
+			name:      "invalid json",
+			params:    `{"redundantProfile": 1}`,
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			plugin, err := RedundancyProfileHandlerFactory("handler", json.RawMessage(test.params), nil)
+			if test.expectErr {
+				if err == nil {
+					t.Errorf("Expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RedundancyProfileHandlerFactory() returned unexpected error: %v", err)
+			}
+			if plugin.TypedName().Name != "handler" {
+				t.Errorf("Expected Name to be %q, got %q", "handler", plugin.TypedName().Name)
+			}
+		})
+	}
+}
+
+func TestRedundancyProfileHandlerPick(t *testing.T) {
+	profiles := map[string]framework.SchedulerProfile{
+		"spread":  &fakeSchedulerProfile{},
+		"default": &fakeSchedulerProfile{},
+	}
+	handler := NewRedundancyProfileHandler(RedundancyProfileHandlerParameters{
+		RedundantProfile: "spread",
+		DefaultProfile:   "default",
+	})
+
+	tests := []struct {
+		name           string
+		request        *framework.InferenceRequest
+		profileResults map[string]*framework.ProfileRunResult
+		want           []string
+	}{
+		{
+			name:    "redundancy requested",
+			request: &framework.InferenceRequest{Headers: map[string]string{DefaultRedundancyHeader: "true"}},
+			want:    []string{"spread"},
+		},
+		{
+			name:    "redundancy not requested",
+			request: &framework.InferenceRequest{Headers: map[string]string{DefaultRedundancyHeader: "false"}},
+			want:    []string{"default"},
+		},
+		{
+			name:    "no header",
+			request: &framework.InferenceRequest{},
+			want:    []string{"default"},
+		},
+		{
+			name:    "invalid header is ignored",
+			request: &framework.InferenceRequest{Headers: map[string]string{DefaultRedundancyHeader: "twice"}},
+			want:    []string{"default"},
+		},
+		{
+			name:           "profile already ran",
+			request:        &framework.InferenceRequest{Headers: map[string]string{DefaultRedundancyHeader: "true"}},
+			profileResults: map[string]*framework.ProfileRunResult{"spread": {}},
+			want:           []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			picked := handler.Pick(context.Background(), nil, test.request, profiles, test.profileResults)
+			got := []string{}
+			for name := range picked {
+				got = append(got, name)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Unexpected picked profiles (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRedundancyProfileHandlerProcessResults(t *testing.T) {
+	handler := NewRedundancyProfileHandler(RedundancyProfileHandlerParameters{RedundantProfile: "spread", DefaultProfile: "default"})
+
+	result, err := handler.ProcessResults(context.Background(), nil, nil,
+		map[string]*framework.ProfileRunResult{"spread": {}})
+	if err != nil {
+		t.Fatalf("ProcessResults() returned unexpected error: %v", err)
+	}
+	if result.PrimaryProfileName != "spread" {
+		t.Errorf("Expected primary profile %q, got %q", "spread", result.PrimaryProfileName)
+	}
+
+	if _, err := handler.ProcessResults(context.Background(), nil, nil,
+		map[string]*framework.ProfileRunResult{"spread": nil}); err == nil {
+		t.Errorf("Expected an error for a failed profile run, got none")
+	}
+}
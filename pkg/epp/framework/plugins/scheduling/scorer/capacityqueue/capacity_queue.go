@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacityqueue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/extractor/metrics"
+)
+
+const (
+	CapacityQueueScorerType = "capacity-queue-scorer"
+
+	// DefaultCapacityLabel is the endpoint label consulted for the capacity when the model server does not report it.
+	DefaultCapacityLabel = "inference.networking.k8s.io/max-concurrency"
+	defaultCapacity      = 1
+)
+
+// Config holds the configuration for the CapacityQueueScorer.
+type Config struct {
+	// CapacityLabel is the endpoint label holding the max concurrency, used when the model server does not report it.
+	// Defaults to "inference.networking.k8s.io/max-concurrency".
+	CapacityLabel string `json:"capacityLabel"`
+	// DefaultCapacity is the capacity assumed for endpoints whose capacity is neither reported nor labeled.
+	// Defaults to 1, i.e. the raw waiting-queue depth is used for such endpoints.
+	DefaultCapacity int `json:"defaultCapacity"`
+}
+
+// compile-time type assertion
+var _ framework.Scorer = &CapacityQueueScorer{}
+
+// CapacityQueueScorerFactory defines the factory function for CapacityQueueScorer.
+func CapacityQueueScorerFactory(name string, params json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	cfg := Config{
+		CapacityLabel:   DefaultCapacityLabel,
+		DefaultCapacity: defaultCapacity,
+	}
+	// configured records whether a capacity source is set explicitly, rather than left to the defaults.
+	var configured struct {
+		CapacityLabel   *string `json:"capacityLabel"`
+		DefaultCapacity *int    `json:"defaultCapacity"`
+	}
+	if len(params) > 0 {
+		if err := json.Unmarshal(params, &cfg); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal capacity queue scorer config: %w", err)
+		}
+		if err := json.Unmarshal(params, &configured); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal capacity queue scorer config: %w", err)
+		}
+	}
+	if cfg.DefaultCapacity <= 0 {
+		return nil, fmt.Errorf("defaultCapacity must be positive, got %d", cfg.DefaultCapacity)
+	}
+	scorer := NewCapacityQueueScorer(cfg).WithName(name)
+	scorer.capacityConfigured = configured.CapacityLabel != nil || configured.DefaultCapacity != nil
+	return scorer, nil
+}
+
+// NewCapacityQueueScorer initializes a new CapacityQueueScorer and returns its pointer.
+func NewCapacityQueueScorer(cfg Config) *CapacityQueueScorer {
+	if cfg.DefaultCapacity <= 0 {
+		cfg.DefaultCapacity = defaultCapacity
+	}
+	return &CapacityQueueScorer{
+		typedName:       fwkplugin.TypedName{Type: CapacityQueueScorerType, Name: CapacityQueueScorerType},
+		capacityLabel:   cfg.CapacityLabel,
+		defaultCapacity: cfg.DefaultCapacity,
+	}
+}
+
+// CapacityQueueScorer scores candidate endpoints based on their waiting-queue depth normalized by their advertised
+// max concurrency, so that heterogeneous replicas (different GPUs or tensor-parallel sizes) are compared fairly.
+// The lower the normalized queue depth, the higher the score.
+type CapacityQueueScorer struct {
+	typedName       fwkplugin.TypedName
+	capacityLabel   string
+	defaultCapacity int
+	// capacityConfigured is set when the capacity label or the default capacity is configured explicitly.
+	capacityConfigured bool
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (s *CapacityQueueScorer) TypedName() fwkplugin.TypedName {
+	return s.typedName
+}
+
+// CapacityConfigured returns whether the capacity label or the default capacity is configured explicitly. Otherwise,
+// the capacity of the endpoints is only known if the model servers report it, and the configuration is rejected when
+// none of the engines of the metrics extractor maps it.
+func (s *CapacityQueueScorer) CapacityConfigured() bool {
+	return s.capacityConfigured
+}
+
+// Category returns the preference the scorer applies when scoring candidate endpoints.
+func (s *CapacityQueueScorer) Category() framework.ScorerCategory {
+	return framework.Distribution
+}
+
+// Consumes returns the list of data that is consumed by the plugin.
+func (s *CapacityQueueScorer) Consumes() map[string]any {
+	return map[string]any{
+		metrics.WaitingQueueSizeKey: int(0),
+		metrics.MaxConcurrencyKey:   int(0),
+	}
+}
+
+// WithName sets the name of the scorer.
+func (s *CapacityQueueScorer) WithName(name string) *CapacityQueueScorer {
+	s.typedName.Name = name
+	return s
+}
+
+// Score returns the scoring result for the given list of endpoints based on context.
+func (s *CapacityQueueScorer) Score(_ context.Context, _ *framework.CycleState, _ *framework.InferenceRequest, endpoints []framework.Endpoint) map[framework.Endpoint]float64 {
+	minLoad := math.MaxFloat64
+	maxLoad := -math.MaxFloat64
+
+	loads := make(map[framework.Endpoint]float64, len(endpoints))
+	for _, endpoint := range endpoints {
+		load := float64(endpoint.GetMetrics().WaitingQueueSize) / float64(s.capacity(endpoint))
+		loads[endpoint] = load
+		minLoad = math.Min(minLoad, load)
+		maxLoad = math.Max(maxLoad, load)
+	}
+
+	scores := make(map[framework.Endpoint]float64, len(endpoints))
+	for endpoint, load := range loads {
+		if maxLoad == minLoad {
+			// If all endpoints have the same normalized queue depth, return a neutral score.
+			scores[endpoint] = 1.0
+			continue
+		}
+		scores[endpoint] = (maxLoad - load) / (maxLoad - minLoad)
+	}
+	return scores
+}
+
+// capacity resolves the max concurrency of the endpoint: the scraped metric takes precedence over the endpoint label,
+// which takes precedence over the configured default.
+func (s *CapacityQueueScorer) capacity(endpoint framework.Endpoint) int {
+	if capacity := endpoint.GetMetrics().MaxConcurrency; capacity > 0 {
+		return capacity
+	}
+	if metadata := endpoint.GetMetadata(); metadata != nil && s.capacityLabel != "" {
+		if value, ok := metadata.Labels[s.capacityLabel]; ok {
+			if capacity, err := strconv.Atoi(value); err == nil && capacity > 0 {
+				return capacity
+			}
+		}
+	}
+	return s.defaultCapacity
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacityqueue
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+func TestCapacityQueueScorer(t *testing.T) {
+	tests := []struct {
+		name                   string
+		config                 Config
+		endpoints              []fwksched.Endpoint
+		expectedScoresEndpoint map[int]float64 // Map of endpoint index to expected score
+	}{
+		{
+			name:   "Queue depth normalized by reported capacity",
+			config: Config{},
+			endpoints: []fwksched.Endpoint{
+				// 10 waiting on a 4x larger replica is less loaded than 5 waiting on a small one.
+				fwksched.NewEndpoint(&fwkdl.EndpointMetadata{}, &fwkdl.Metrics{WaitingQueueSize: 10, MaxConcurrency: 256}, nil),
+				fwksched.NewEndpoint(&fwkdl.EndpointMetadata{}, &fwkdl.Metrics{WaitingQueueSize: 5, MaxConcurrency: 64}, nil),
+				fwksched.NewEndpoint(&fwkdl.EndpointMetadata{}, &fwkdl.Metrics{WaitingQueueSize: 0, MaxConcurrency: 64}, nil),
+			},
+			expectedScoresEndpoint: map[int]float64{
+				0: 0.5,
+				1: 0.0,
+				2: 1.0,
+			},
+		},
+		{
+			name:   "Capacity from endpoint label when not reported",
+			config: Config{CapacityLabel: DefaultCapacityLabel},
+			endpoints: []fwksched.Endpoint{
+				fwksched.NewEndpoint(&fwkdl.EndpointMetadata{Labels: map[string]string{DefaultCapacityLabel: "100"}}, &fwkdl.Metrics{WaitingQueueSize: 10}, nil),
+				fwksched.NewEndpoint(&fwkdl.EndpointMetadata{Labels: map[string]string{DefaultCapacityLabel: "50"}}, &fwkdl.Metrics{WaitingQueueSize: 10}, nil),
+			},
+			expectedScoresEndpoint: map[int]float64{
+				0: 1.0,
+				1: 0.0,
+			},
+		},
+		{
+			name:   "Default capacity for unknown capacity and malformed label",
+			config: Config{CapacityLabel: DefaultCapacityLabel, DefaultCapacity: 10},
+			endpoints: []fwksched.Endpoint{
+				fwksched.NewEndpoint(&fwkdl.EndpointMetadata{Labels: map[string]string{DefaultCapacityLabel: "lots"}}, &fwkdl.Metrics{WaitingQueueSize: 10}, nil),
+				fwksched.NewEndpoint(&fwkdl.EndpointMetadata{}, &fwkdl.Metrics{WaitingQueueSize: 20, MaxConcurrency: 20}, nil),
+			},
+			expectedScoresEndpoint: map[int]float64{
+				0: 1.0,
+				1: 1.0,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			scorer := NewCapacityQueueScorer(test.config)
+			scores := scorer.Score(context.Background(), fwksched.NewCycleState(), &fwksched.InferenceRequest{}, test.endpoints)
+
+			for i, endpoint := range test.endpoints {
+				expectedScore := test.expectedScoresEndpoint[i]
+				assert.InDelta(t, expectedScore, scores[endpoint], 0.0001, "Endpoint %d should have score %f", i, expectedScore)
+			}
+		})
+	}
+}
+
+func TestCapacityQueueScorerFactory(t *testing.T) {
+	plugin, err := CapacityQueueScorerFactory("my-scorer", json.RawMessage(`{"defaultCapacity": 32}`), nil)
+	require.NoError(t, err)
+	scorer := plugin.(*CapacityQueueScorer)
+	assert.Equal(t, "my-scorer", scorer.TypedName().Name)
+	assert.Equal(t, 32, scorer.defaultCapacity)
+	assert.Equal(t, DefaultCapacityLabel, scorer.capacityLabel)
+	assert.True(t, scorer.CapacityConfigured())
+
+	plugin, err = CapacityQueueScorerFactory("defaults", nil, nil)
+	require.NoError(t, err)
+	assert.False(t, plugin.(*CapacityQueueScorer).CapacityConfigured(), "the defaults are not a capacity source")
+
+	_, err = CapacityQueueScorerFactory("bad", json.RawMessage(`{"defaultCapacity": -1}`), nil)
+	assert.Error(t, err)
+}
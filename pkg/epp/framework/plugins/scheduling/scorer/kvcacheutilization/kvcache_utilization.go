@@ -30,7 +30,7 @@ const (
 )
 
 // compile-time type assertion
-var _ framework.Scorer = &KVCacheUtilizationScorer{}
+var _ framework.CacheableScorer = &KVCacheUtilizationScorer{}
 
 // KvCacheUtilizationScorerFactory defines the factory function for KVCacheUtilizationScorer.
 func KvCacheUtilizationScorerFactory(name string, _ json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
@@ -66,6 +66,11 @@ func (s *KVCacheUtilizationScorer) Consumes() map[string]any {
 	}
 }
 
+// RequestClass returns the same class for all the requests, the score of an endpoint only depending on its metrics.
+func (s *KVCacheUtilizationScorer) RequestClass(_ *framework.InferenceRequest) string {
+	return ""
+}
+
 // WithName sets the name of the scorer.
 func (s *KVCacheUtilizationScorer) WithName(name string) *KVCacheUtilizationScorer {
 	s.typedName.Name = name
@@ -24,12 +24,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"time"
 
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
 	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
 	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	attrconcurrency "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/attribute/concurrency"
+	attrfingerprint "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/attribute/fingerprint"
 	attrlatency "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/attribute/latency"
 	attrprefix "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/attribute/prefix"
 )
@@ -78,15 +81,20 @@ type Config struct {
 	CompositeKVWeight     float64 `json:"compositeKVWeight,omitempty"`
 	CompositeQueueWeight  float64 `json:"compositeQueueWeight,omitempty"`
 	CompositePrefixWeight float64 `json:"compositePrefixWeight,omitempty"`
+	// CompositeFingerprintWeight weighs the measured speed of the endpoints, from the performance fingerprints
+	// produced by the performance-fingerprint-producer, in the composite fallback. Ignored when no endpoint has a
+	// fingerprint.
+	CompositeFingerprintWeight float64 `json:"compositeFingerprintWeight,omitempty"`
 }
 
 var DefaultConfig = Config{
-	TTFTWeight:                0.8,
-	TPOTWeight:                0.2,
-	HeadroomSelectionStrategy: StrategyLeast,
-	CompositeKVWeight:         1,
-	CompositeQueueWeight:      1,
-	CompositePrefixWeight:     1,
+	TTFTWeight:                 0.8,
+	TPOTWeight:                 0.2,
+	HeadroomSelectionStrategy:  StrategyLeast,
+	CompositeKVWeight:          1,
+	CompositeQueueWeight:       1,
+	CompositePrefixWeight:      1,
+	CompositeFingerprintWeight: 1,
 }
 
 // Plugin scores endpoints based on predicted latency headroom.
@@ -317,21 +325,27 @@ func (s *Plugin) scoreBucket(ctx context.Context, data []epData, scores map[fram
 	}
 }
 
-// compositeScores returns scores based on KV cache, queue, and prefix cache.
-// This is a fallback for when latency predictions are unavailable (sidecar down
-// or timed out).
+// compositeScores returns scores based on KV cache, queue, prefix cache, and
+// the performance fingerprints of the endpoints. This is a fallback for when
+// latency predictions are unavailable (sidecar down or timed out, or models
+// not trained yet after a restart).
 func (s *Plugin) compositeScores(ctx context.Context, endpoints []framework.Endpoint) map[framework.Endpoint]float64 {
 	scores := make(map[framework.Endpoint]float64, len(endpoints))
 
-	wkv, wq, wpref := s.config.CompositeKVWeight, s.config.CompositeQueueWeight, s.config.CompositePrefixWeight
-	sumw := wkv + wq + wpref
+	speeds := fingerprintSpeeds(endpoints, requestOutputTokens(endpoints))
+	wkv, wq, wpref, wfp := s.config.CompositeKVWeight, s.config.CompositeQueueWeight, s.config.CompositePrefixWeight, s.config.CompositeFingerprintWeight
+	if speeds == nil {
+		wfp = 0
+	}
+	sumw := wkv + wq + wpref + wfp
 	if sumw <= 0 {
-		wkv, wq, wpref = 1, 0, 0
+		wkv, wq, wpref, wfp = 1, 0, 0, 0
 		sumw = 1
 	}
 	wkv /= sumw
 	wq /= sumw
 	wpref /= sumw
+	wfp /= sumw
 
 	// Find max queue for relative scoring.
 	maxQ := 0
@@ -353,14 +367,19 @@ func (s *Plugin) compositeScores(ctx context.Context, endpoints []framework.Endp
 		kvFree := 1.0 - ep.GetMetrics().KVCacheUsagePercent
 		prefix := prefixCacheScore(ep)
 
-		composite := wkv*kvFree + wq*relQueue + wpref*prefix
+		speed, ok := speeds[ep]
+		if !ok {
+			speed = 0.5
+		}
+
+		composite := wkv*kvFree + wq*relQueue + wpref*prefix + wfp*speed
 		w := int(math.Round(float64(minWeight) + float64(wMax-minWeight)*composite))
 		score := float64(w) / float64(wMax)
 
 		scores[ep] = score
 		logger.V(logutil.TRACE).Info("LatencyScorer: composite",
 			"endpoint", ep.GetMetadata().NamespacedName.Name,
-			"kvFree", kvFree, "relQueue", relQueue, "prefix", prefix, "score", score)
+			"kvFree", kvFree, "relQueue", relQueue, "prefix", prefix, "speed", speed, "score", score)
 	}
 
 	return scores
@@ -368,8 +387,10 @@ func (s *Plugin) compositeScores(ctx context.Context, endpoints []framework.Endp
 
 func (s *Plugin) Consumes() map[string]any {
 	return map[string]any{
-		attrlatency.LatencyPredictionInfoKey: attrlatency.LatencyPredictionInfo{},
-		attrprefix.PrefixCacheMatchInfoKey:   attrprefix.PrefixCacheMatchInfo{},
+		attrlatency.LatencyPredictionInfoKey:      attrlatency.LatencyPredictionInfo{},
+		attrprefix.PrefixCacheMatchInfoKey:        attrprefix.PrefixCacheMatchInfo{},
+		attrfingerprint.PerformanceFingerprintKey: attrfingerprint.PerformanceFingerprint{},
+		attrconcurrency.InFlightLoadKey:           attrconcurrency.InFlightLoad{},
 	}
 }
 
@@ -393,3 +414,76 @@ func prefixCacheScore(ep framework.Endpoint) float64 {
 	}
 	return 0
 }
+
+// requestOutputTokens returns the estimated completion length of the request, as published with the in-flight load
+// of the endpoints, 0 if unknown.
+func requestOutputTokens(endpoints []framework.Endpoint) int64 {
+	for _, ep := range endpoints {
+		if raw, ok := ep.Get(attrconcurrency.InFlightLoadKey); ok {
+			if load := raw.(*attrconcurrency.InFlightLoad); load.RequestOutputTokens > 0 {
+				return load.RequestOutputTokens
+			}
+		}
+	}
+	return 0
+}
+
+// fingerprintSpeeds returns the relative speed in [0,1] of the endpoints with a
+// performance fingerprint. When the completion length of the request is known
+// and all the fingerprints have both measurements, it is the lowest expected
+// latency (TTFT plus decode time of the completion) relative to theirs, so that
+// the decode throughput dominates for the long completions of reasoning models.
+// Otherwise it is the average of their decode throughput relative to the
+// fastest endpoint and of the lowest TTFT relative to theirs. Returns nil when
+// no endpoint has a fingerprint with measurements.
+func fingerprintSpeeds(endpoints []framework.Endpoint, outputTokens int64) map[framework.Endpoint]float64 {
+	fingerprints := make(map[framework.Endpoint]*attrfingerprint.PerformanceFingerprint, len(endpoints))
+	maxTPS, minTTFT := 0.0, time.Duration(math.MaxInt64)
+	complete := true
+	for _, ep := range endpoints {
+		raw, ok := ep.Get(attrfingerprint.PerformanceFingerprintKey)
+		if !ok {
+			continue
+		}
+		fp := raw.(*attrfingerprint.PerformanceFingerprint)
+		if fp.TokensPerSecond <= 0 && fp.TTFT <= 0 {
+			continue
+		}
+		fingerprints[ep] = fp
+		maxTPS = math.Max(maxTPS, fp.TokensPerSecond)
+		if fp.TTFT > 0 {
+			minTTFT = min(minTTFT, fp.TTFT)
+		}
+		complete = complete && fp.TokensPerSecond > 0 && fp.TTFT > 0
+	}
+	if len(fingerprints) == 0 {
+		return nil
+	}
+
+	speeds := make(map[framework.Endpoint]float64, len(fingerprints))
+	if outputTokens > 0 && complete {
+		latencies := make(map[framework.Endpoint]float64, len(fingerprints))
+		minLatency := math.MaxFloat64
+		for ep, fp := range fingerprints {
+			latencies[ep] = fp.TTFT.Seconds() + float64(outputTokens)/fp.TokensPerSecond
+			minLatency = math.Min(minLatency, latencies[ep])
+		}
+		for ep, latency := range latencies {
+			speeds[ep] = minLatency / latency
+		}
+		return speeds
+	}
+	for ep, fp := range fingerprints {
+		sum, n := 0.0, 0
+		if maxTPS > 0 {
+			sum += fp.TokensPerSecond / maxTPS
+			n++
+		}
+		if fp.TTFT > 0 {
+			sum += float64(minTTFT) / float64(fp.TTFT)
+			n++
+		}
+		speeds[ep] = sum / float64(n)
+	}
+	return speeds
+}
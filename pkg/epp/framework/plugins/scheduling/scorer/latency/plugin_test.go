@@ -19,11 +19,14 @@ package latency
 import (
 	"context"
 	"testing"
+	"time"
 
 	k8stypes "k8s.io/apimachinery/pkg/types"
 
 	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
 	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	attrconcurrency "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/attribute/concurrency"
+	attrfingerprint "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/attribute/fingerprint"
 	attrlatency "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/attribute/latency"
 )
 
@@ -207,5 +210,64 @@ func TestScoreCompositeFallback(t *testing.T) {
 	t.Logf("pod1 score=%f, pod2 score=%f", s1, s2)
 }
 
+func TestScoreCompositeFallbackFingerprint(t *testing.T) {
+	scorer := NewPlugin(noExploreConfig())
+
+	// Identical load, pod1 measured faster than pod2, pod3 without fingerprint.
+	ep1 := makeLatencyScorerEndpoint("pod1", 0.5, 2, 3)
+	ep2 := makeLatencyScorerEndpoint("pod2", 0.5, 2, 3)
+	ep3 := makeLatencyScorerEndpoint("pod3", 0.5, 2, 3)
+	ep1.Put(attrfingerprint.PerformanceFingerprintKey,
+		&attrfingerprint.PerformanceFingerprint{TokensPerSecond: 100, TTFT: 100 * time.Millisecond, Samples: 10})
+	ep2.Put(attrfingerprint.PerformanceFingerprintKey,
+		&attrfingerprint.PerformanceFingerprint{TokensPerSecond: 25, TTFT: 400 * time.Millisecond, Samples: 10})
+
+	endpoints := []framework.Endpoint{ep1, ep2, ep3}
+	scores := scorer.Score(context.Background(), framework.NewCycleState(), nil, endpoints)
+
+	if !(scores[ep1] > scores[ep3] && scores[ep3] > scores[ep2]) {
+		t.Errorf("expected pod1 > pod3 (unknown) > pod2: pod1=%f, pod2=%f, pod3=%f", scores[ep1], scores[ep2], scores[ep3])
+	}
+
+	// Without fingerprints, the fingerprint weight is ignored: (kvFree 0.5 + relQueue 0 + prefix 0) / 3.
+	ep4 := makeLatencyScorerEndpoint("pod4", 0.5, 2, 3)
+	scores = scorer.Score(context.Background(), framework.NewCycleState(), nil, []framework.Endpoint{ep4})
+	if scores[ep4] != 0.17 {
+		t.Errorf("expected score 0.17 without fingerprints, got %f", scores[ep4])
+	}
+}
+
+func TestScoreCompositeFallbackCompletionLength(t *testing.T) {
+	scorer := NewPlugin(noExploreConfig())
+
+	// pod1 has the lower TTFT, pod2 the higher decode throughput.
+	newEndpoints := func(outputTokens int64) (framework.Endpoint, framework.Endpoint) {
+		ep1 := makeLatencyScorerEndpoint("pod1", 0.5, 2, 3)
+		ep2 := makeLatencyScorerEndpoint("pod2", 0.5, 2, 3)
+		ep1.Put(attrfingerprint.PerformanceFingerprintKey,
+			&attrfingerprint.PerformanceFingerprint{TokensPerSecond: 20, TTFT: 100 * time.Millisecond, Samples: 10})
+		ep2.Put(attrfingerprint.PerformanceFingerprintKey,
+			&attrfingerprint.PerformanceFingerprint{TokensPerSecond: 100, TTFT: time.Second, Samples: 10})
+		for _, ep := range []framework.Endpoint{ep1, ep2} {
+			ep.Put(attrconcurrency.InFlightLoadKey, &attrconcurrency.InFlightLoad{RequestOutputTokens: outputTokens})
+		}
+		return ep1, ep2
+	}
+
+	// Unknown completion length: TTFT and throughput weigh the same.
+	ep1, ep2 := newEndpoints(0)
+	scores := scorer.Score(context.Background(), framework.NewCycleState(), nil, []framework.Endpoint{ep1, ep2})
+	if scores[ep1] <= scores[ep2] {
+		t.Errorf("expected pod1 > pod2 without completion length: pod1=%f, pod2=%f", scores[ep1], scores[ep2])
+	}
+
+	// Long completion, e.g. of a reasoning model: the decode throughput dominates the expected latency.
+	ep1, ep2 = newEndpoints(2000)
+	scores = scorer.Score(context.Background(), framework.NewCycleState(), nil, []framework.Endpoint{ep1, ep2})
+	if scores[ep2] <= scores[ep1] {
+		t.Errorf("expected pod2 > pod1 for a long completion: pod1=%f, pod2=%f", scores[ep1], scores[ep2])
+	}
+}
+
 // Note: EpsilonExploreNeg (tier selection) is now handled by the
 // slo-headroom-tier-filter, not the scorer. See filter tests.
@@ -0,0 +1,215 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package leastloaded
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/extractor/metrics"
+)
+
+const (
+	LeastLoadedScorerType = "least-loaded-scorer"
+)
+
+// Config holds the configuration for the LeastLoadedScorer.
+type Config struct {
+	// AssignmentWeight is the load each recent assignment adds to an endpoint, in requests. Default: 1.
+	AssignmentWeight float64 `json:"assignmentWeight"`
+	// MemoryMs bounds how long an assignment is remembered, in milliseconds. Assignments are forgotten earlier, as soon
+	// as the endpoint's metrics are refreshed after the assignment. It should be a few times the metrics refresh
+	// interval, to cover endpoints whose scrapes are delayed. Default: 1000.
+	MemoryMs int `json:"memoryMs"`
+}
+
+var DefaultConfig = Config{
+	AssignmentWeight: 1,
+	MemoryMs:         1000,
+}
+
+func (c *Config) validate() error {
+	if c.AssignmentWeight < 0 {
+		return fmt.Errorf("assignmentWeight must be >= 0, got %f", c.AssignmentWeight)
+	}
+	if c.MemoryMs <= 0 {
+		return errors.New("memoryMs must be > 0")
+	}
+	return nil
+}
+
+// compile-time type assertion
+var (
+	_ framework.Scorer          = &LeastLoadedScorer{}
+	_ requestcontrol.PreRequest = &LeastLoadedScorer{}
+)
+
+// LeastLoadedScorerFactory defines the factory function for LeastLoadedScorer.
+func LeastLoadedScorerFactory(name string, params json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	cfg := DefaultConfig
+	if len(params) > 0 {
+		if err := json.Unmarshal(params, &cfg); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal least loaded scorer config: %w", err)
+		}
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("invalid least loaded scorer config: %w", err)
+	}
+	return NewLeastLoadedScorer(cfg).WithName(name), nil
+}
+
+// NewLeastLoadedScorer initializes a new LeastLoadedScorer and returns its pointer.
+func NewLeastLoadedScorer(cfg Config) *LeastLoadedScorer {
+	return &LeastLoadedScorer{
+		typedName:   fwkplugin.TypedName{Type: LeastLoadedScorerType, Name: LeastLoadedScorerType},
+		config:      cfg,
+		memory:      time.Duration(cfg.MemoryMs) * time.Millisecond,
+		assignments: map[string][]time.Time{},
+		now:         time.Now,
+	}
+}
+
+// LeastLoadedScorer scores candidate endpoints by their load, the sum of their waiting and running requests.
+//
+// Scraped metrics lag behind the assignments this EPP makes: until the next scrape, an endpoint that was just selected
+// still looks as idle as before and a burst of requests would all land on it. To compensate, the scorer remembers the
+// assignments it observed in PreRequest and adds those made after the endpoint's last metrics update to its load.
+type LeastLoadedScorer struct {
+	typedName fwkplugin.TypedName
+	config    Config
+	memory    time.Duration
+
+	mu sync.Mutex
+	// assignments holds the times of the recent assignments per endpoint, oldest first.
+	assignments map[string][]time.Time
+	now         func() time.Time
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (s *LeastLoadedScorer) TypedName() fwkplugin.TypedName {
+	return s.typedName
+}
+
+// Category returns the preference the scorer applies when scoring candidate endpoints.
+func (s *LeastLoadedScorer) Category() framework.ScorerCategory {
+	return framework.Distribution
+}
+
+// Consumes returns the list of data that is consumed by the plugin.
+func (s *LeastLoadedScorer) Consumes() map[string]any {
+	return map[string]any{
+		metrics.WaitingQueueSizeKey:    int(0),
+		metrics.RunningRequestsSizeKey: int(0),
+	}
+}
+
+// WithName sets the name of the scorer.
+func (s *LeastLoadedScorer) WithName(name string) *LeastLoadedScorer {
+	s.typedName.Name = name
+	return s
+}
+
+// Score returns the scoring result for the given list of endpoints based on context.
+func (s *LeastLoadedScorer) Score(ctx context.Context, _ *framework.CycleState, _ *framework.InferenceRequest, endpoints []framework.Endpoint) map[framework.Endpoint]float64 {
+	logger := log.FromContext(ctx).V(logutil.TRACE)
+	loads := make(map[framework.Endpoint]float64, len(endpoints))
+	minLoad := math.MaxFloat64
+	maxLoad := -math.MaxFloat64
+
+	s.mu.Lock()
+	s.prune()
+	for _, endpoint := range endpoints {
+		endpointMetrics := endpoint.GetMetrics()
+		pending := s.pendingAssignments(endpoint.GetMetadata().NamespacedName.String(), endpointMetrics.UpdateTime)
+		load := float64(endpointMetrics.WaitingQueueSize+endpointMetrics.RunningRequestsSize) +
+			float64(pending)*s.config.AssignmentWeight
+		loads[endpoint] = load
+		minLoad = min(minLoad, load)
+		maxLoad = max(maxLoad, load)
+		logger.Info("LeastLoadedScorer load", "endpoint", endpoint.GetMetadata().NamespacedName,
+			"waiting", endpointMetrics.WaitingQueueSize, "running", endpointMetrics.RunningRequestsSize,
+			"pendingAssignments", pending, "load", load)
+	}
+	s.mu.Unlock()
+
+	scores := make(map[framework.Endpoint]float64, len(endpoints))
+	for endpoint, load := range loads {
+		if maxLoad == minLoad {
+			// If all endpoints have the same load, return a neutral score
+			scores[endpoint] = 1.0
+			continue
+		}
+		scores[endpoint] = (maxLoad - load) / (maxLoad - minLoad)
+	}
+	return scores
+}
+
+// PreRequest records the assignment of the request to the endpoints selected by the scheduler.
+func (s *LeastLoadedScorer) PreRequest(_ context.Context, _ *framework.InferenceRequest, schedulingResult *framework.SchedulingResult) {
+	if schedulingResult == nil {
+		return
+	}
+	now := s.now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, result := range schedulingResult.ProfileResults {
+		if result == nil || len(result.TargetEndpoints) == 0 {
+			continue
+		}
+		key := result.TargetEndpoints[0].GetMetadata().NamespacedName.String()
+		s.assignments[key] = append(s.assignments[key], now)
+	}
+}
+
+// pendingAssignments returns the number of recent assignments to the endpoint that are not reflected in its metrics
+// yet, i.e. made after the given metrics update time. Must be called with the lock held.
+func (s *LeastLoadedScorer) pendingAssignments(key string, updateTime time.Time) int {
+	times := s.assignments[key]
+	pending := 0
+	for i := len(times) - 1; i >= 0 && times[i].After(updateTime); i-- {
+		pending++
+	}
+	return pending
+}
+
+// prune forgets assignments older than the configured memory. Must be called with the lock held.
+func (s *LeastLoadedScorer) prune() {
+	cutoff := s.now().Add(-s.memory)
+	for key, times := range s.assignments {
+		i := 0
+		for i < len(times) && !times[i].After(cutoff) {
+			i++
+		}
+		if i == len(times) {
+			delete(s.assignments, key)
+		} else if i > 0 {
+			s.assignments[key] = times[i:]
+		}
+	}
+}
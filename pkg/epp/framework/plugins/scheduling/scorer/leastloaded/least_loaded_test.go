@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package leastloaded
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+func newEndpoint(name string, waiting, running int, updateTime time.Time) fwksched.Endpoint {
+	return fwksched.NewEndpoint(
+		&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Namespace: "default", Name: name}},
+		&fwkdl.Metrics{WaitingQueueSize: waiting, RunningRequestsSize: running, UpdateTime: updateTime},
+		nil)
+}
+
+func assign(scorer *LeastLoadedScorer, endpoint fwksched.Endpoint) {
+	scorer.PreRequest(context.Background(), &fwksched.InferenceRequest{}, &fwksched.SchedulingResult{
+		PrimaryProfileName: "default",
+		ProfileResults: map[string]*fwksched.ProfileRunResult{
+			"default": {TargetEndpoints: []fwksched.Endpoint{endpoint}},
+		},
+	})
+}
+
+func TestLeastLoadedScorer(t *testing.T) {
+	now := time.Now()
+	scrape := now.Add(-10 * time.Millisecond)
+
+	tests := []struct {
+		name                   string
+		endpoints              []fwksched.Endpoint
+		assignments            []int           // endpoint indexes assigned before scoring
+		expectedScoresEndpoint map[int]float64 // Map of endpoint index to expected score
+	}{
+		{
+			name: "Load is waiting plus running requests",
+			endpoints: []fwksched.Endpoint{
+				newEndpoint("pod-a", 4, 4, scrape),
+				newEndpoint("pod-b", 0, 4, scrape),
+				newEndpoint("pod-c", 0, 0, scrape),
+			},
+			expectedScoresEndpoint: map[int]float64{0: 0.0, 1: 0.5, 2: 1.0},
+		},
+		{
+			name: "Same load",
+			endpoints: []fwksched.Endpoint{
+				newEndpoint("pod-a", 2, 1, scrape),
+				newEndpoint("pod-b", 1, 2, scrape),
+			},
+			expectedScoresEndpoint: map[int]float64{0: 1.0, 1: 1.0},
+		},
+		{
+			name: "Assignments since the last scrape are discounted",
+			endpoints: []fwksched.Endpoint{
+				newEndpoint("pod-a", 0, 0, scrape),
+				newEndpoint("pod-b", 1, 0, scrape),
+			},
+			assignments:            []int{0, 0},
+			expectedScoresEndpoint: map[int]float64{0: 0.0, 1: 1.0},
+		},
+		{
+			name: "Assignments before the last scrape are already reflected",
+			endpoints: []fwksched.Endpoint{
+				newEndpoint("pod-a", 0, 0, now.Add(time.Millisecond)),
+				newEndpoint("pod-b", 1, 0, now.Add(time.Millisecond)),
+			},
+			assignments:            []int{0, 0},
+			expectedScoresEndpoint: map[int]float64{0: 1.0, 1: 0.0},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			scorer := NewLeastLoadedScorer(DefaultConfig)
+			scorer.now = func() time.Time { return now }
+			for _, i := range test.assignments {
+				assign(scorer, test.endpoints[i])
+			}
+
+			scores := scorer.Score(context.Background(), fwksched.NewCycleState(), &fwksched.InferenceRequest{}, test.endpoints)
+			for i, endpoint := range test.endpoints {
+				assert.InDelta(t, test.expectedScoresEndpoint[i], scores[endpoint], 0.0001, "endpoint %d", i)
+			}
+		})
+	}
+}
+
+func TestLeastLoadedScorerForgetsAssignments(t *testing.T) {
+	now := time.Now()
+	scorer := NewLeastLoadedScorer(DefaultConfig)
+	scorer.now = func() time.Time { return now }
+	endpoints := []fwksched.Endpoint{
+		newEndpoint("pod-a", 0, 0, now.Add(-time.Hour)),
+		newEndpoint("pod-b", 0, 0, now.Add(-time.Hour)),
+	}
+
+	assign(scorer, endpoints[0])
+	scores := scorer.Score(context.Background(), fwksched.NewCycleState(), &fwksched.InferenceRequest{}, endpoints)
+	assert.InDelta(t, 0.0, scores[endpoints[0]], 0.0001)
+
+	now = now.Add(2 * time.Second)
+	scores = scorer.Score(context.Background(), fwksched.NewCycleState(), &fwksched.InferenceRequest{}, endpoints)
+	assert.InDelta(t, 1.0, scores[endpoints[0]], 0.0001)
+	assert.Empty(t, scorer.assignments)
+}
+
+func TestLeastLoadedScorerFactory(t *testing.T) {
+	plugin, err := LeastLoadedScorerFactory("least-loaded", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "least-loaded", plugin.TypedName().Name)
+	assert.Equal(t, DefaultConfig, plugin.(*LeastLoadedScorer).config)
+
+	plugin, err = LeastLoadedScorerFactory("least-loaded", json.RawMessage(`{"memoryMs": 200}`), nil)
+	require.NoError(t, err)
+	assert.Equal(t, 200*time.Millisecond, plugin.(*LeastLoadedScorer).memory)
+
+	_, err = LeastLoadedScorerFactory("least-loaded", json.RawMessage(`{"memoryMs": 0}`), nil)
+	assert.Error(t, err)
+	_, err = LeastLoadedScorerFactory("least-loaded", json.RawMessage(`{"assignmentWeight": -1}`), nil)
+	assert.Error(t, err)
+}
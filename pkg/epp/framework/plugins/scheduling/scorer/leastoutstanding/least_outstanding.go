@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package leastoutstanding
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	attrconcurrency "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/attribute/concurrency"
+)
+
+const (
+	LeastOutstandingScorerType = "least-outstanding-requests-scorer"
+)
+
+// compile-time type assertion
+var _ framework.Scorer = &LeastOutstandingScorer{}
+
+// LeastOutstandingScorerFactory defines the factory function for LeastOutstandingScorer.
+func LeastOutstandingScorerFactory(name string, _ json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	return NewLeastOutstandingScorer().WithName(name), nil
+}
+
+// NewLeastOutstandingScorer initializes a new LeastOutstandingScorer and returns its pointer.
+func NewLeastOutstandingScorer() *LeastOutstandingScorer {
+	return &LeastOutstandingScorer{
+		typedName: fwkplugin.TypedName{Type: LeastOutstandingScorerType, Name: LeastOutstandingScorerType},
+	}
+}
+
+// LeastOutstandingScorer scores candidate endpoints by their outstanding requests, the requests this EPP routed to them
+// whose responses have not completed yet, as tracked by the in-flight load producer.
+//
+// Unlike the scraped running and waiting requests, the outstanding requests are updated as soon as a request is
+// dispatched or completes, so that the requests are balanced accurately between two scrapes of the metrics.
+type LeastOutstandingScorer struct {
+	typedName fwkplugin.TypedName
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (s *LeastOutstandingScorer) TypedName() fwkplugin.TypedName {
+	return s.typedName
+}
+
+// Category returns the preference the scorer applies when scoring candidate endpoints.
+func (s *LeastOutstandingScorer) Category() framework.ScorerCategory {
+	return framework.Distribution
+}
+
+// Consumes returns the list of data that is consumed by the plugin.
+func (s *LeastOutstandingScorer) Consumes() map[string]any {
+	return map[string]any{
+		attrconcurrency.InFlightLoadKey: attrconcurrency.InFlightLoad{},
+	}
+}
+
+// WithName sets the name of the scorer.
+func (s *LeastOutstandingScorer) WithName(name string) *LeastOutstandingScorer {
+	s.typedName.Name = name
+	return s
+}
+
+// Score returns the scoring result for the given list of endpoints based on context. The endpoints with the fewest
+// outstanding requests score 1 and those with the most score 0. Endpoints without in-flight load have no outstanding
+// requests.
+func (s *LeastOutstandingScorer) Score(ctx context.Context, _ *framework.CycleState, _ *framework.InferenceRequest, endpoints []framework.Endpoint) map[framework.Endpoint]float64 {
+	logger := log.FromContext(ctx).V(logutil.TRACE)
+	outstanding := make(map[framework.Endpoint]float64, len(endpoints))
+	minOutstanding := math.MaxFloat64
+	maxOutstanding := -math.MaxFloat64
+
+	for _, endpoint := range endpoints {
+		requests := 0.0
+		if val, ok := endpoint.Get(attrconcurrency.InFlightLoadKey); ok {
+			if load, ok := val.(*attrconcurrency.InFlightLoad); ok {
+				requests = float64(max(load.Requests, 0))
+			}
+		}
+		outstanding[endpoint] = requests
+		minOutstanding = min(minOutstanding, requests)
+		maxOutstanding = max(maxOutstanding, requests)
+		logger.Info("LeastOutstandingScorer outstanding requests", "endpoint", endpoint.GetMetadata().NamespacedName,
+			"requests", requests)
+	}
+
+	scores := make(map[framework.Endpoint]float64, len(endpoints))
+	for endpoint, requests := range outstanding {
+		if maxOutstanding == minOutstanding {
+			// If all endpoints have the same outstanding requests, return a neutral score
+			scores[endpoint] = 1.0
+			continue
+		}
+		scores[endpoint] = (maxOutstanding - requests) / (maxOutstanding - minOutstanding)
+	}
+	return scores
+}
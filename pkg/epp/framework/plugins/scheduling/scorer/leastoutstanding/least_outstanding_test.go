@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package leastoutstanding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	attrconcurrency "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/attribute/concurrency"
+)
+
+func newEndpoint(name string, load *attrconcurrency.InFlightLoad) fwksched.Endpoint {
+	endpoint := fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: types.NamespacedName{Namespace: "default", Name: name}},
+		&fwkdl.Metrics{}, nil)
+	if load != nil {
+		endpoint.Put(attrconcurrency.InFlightLoadKey, load)
+	}
+	return endpoint
+}
+
+func TestLeastOutstandingScorer(t *testing.T) {
+	tests := []struct {
+		name       string
+		loads      []*attrconcurrency.InFlightLoad
+		wantScores []float64
+	}{
+		{
+			name:       "fewest outstanding requests score highest",
+			loads:      []*attrconcurrency.InFlightLoad{{Requests: 0}, {Requests: 2}, {Requests: 4}},
+			wantScores: []float64{1.0, 0.5, 0.0},
+		},
+		{
+			name:       "endpoints without in-flight load have no outstanding requests",
+			loads:      []*attrconcurrency.InFlightLoad{nil, {Requests: 3}},
+			wantScores: []float64{1.0, 0.0},
+		},
+		{
+			name:       "equal outstanding requests are neutral",
+			loads:      []*attrconcurrency.InFlightLoad{{Requests: 5}, {Requests: 5}},
+			wantScores: []float64{1.0, 1.0},
+		},
+		{
+			name:       "tokens are ignored",
+			loads:      []*attrconcurrency.InFlightLoad{{Requests: 1, Tokens: 100000}, {Requests: 2, Tokens: 10}},
+			wantScores: []float64{1.0, 0.0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoints := make([]fwksched.Endpoint, 0, len(tt.loads))
+			for i, load := range tt.loads {
+				endpoints = append(endpoints, newEndpoint(string(rune('a'+i)), load))
+			}
+
+			scores := NewLeastOutstandingScorer().Score(context.Background(), fwksched.NewCycleState(), &fwksched.InferenceRequest{}, endpoints)
+
+			for i, endpoint := range endpoints {
+				assert.InDelta(t, tt.wantScores[i], scores[endpoint], 0.0001, "endpoint %d", i)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
 	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
 	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/extractor/metrics"
@@ -27,10 +28,14 @@ import (
 
 const (
 	LoraAffinityScorerType = "lora-affinity-scorer"
+
+	// maxContentionPenalty is the score penalty of an active adapter all of whose requests are waiting. It keeps the
+	// endpoints with the adapter active ahead of those that would have to load it.
+	maxContentionPenalty = 0.1
 )
 
 // compile-time type assertion
-var _ framework.Scorer = &LoraAffinityScorer{}
+var _ framework.CacheableScorer = &LoraAffinityScorer{}
 
 // LoraAffinityScorerFactory defines the factory function for LoraAffinityScorer.
 func LoraAffinityScorerFactory(name string, _ json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
@@ -64,9 +69,18 @@ func (s *LoraAffinityScorer) Consumes() map[string]any {
 	return map[string]any{
 		metrics.ActiveModelsKey:  map[string]int{},
 		metrics.WaitingModelsKey: map[string]int{},
+		// Optional, for the model servers exposing per-adapter request metrics.
+		metrics.AdapterRunningRequestsKey: map[string]int{},
+		metrics.AdapterWaitingRequestsKey: map[string]int{},
 	}
 }
 
+// RequestClass returns the target model of the request, the score of an endpoint only depending on its metrics and on
+// the adapter requested.
+func (s *LoraAffinityScorer) RequestClass(request *framework.InferenceRequest) string {
+	return request.TargetModel
+}
+
 // WithName sets the name of the scorer.
 func (s *LoraAffinityScorer) WithName(name string) *LoraAffinityScorer {
 	s.typedName.Name = name
@@ -83,9 +97,9 @@ func (s *LoraAffinityScorer) Score(_ context.Context, _ *framework.CycleState, r
 
 		// Determine the model server's suitability score based on adapter load status and capacity.
 		switch {
-		// Ideal: The adapter is already active on this model server.
+		// Ideal: The adapter is already active on this model server, the less contended the better.
 		case active:
-			scores[endpoint] = 1.0
+			scores[endpoint] = 1.0 - maxContentionPenalty*adapterContention(endpoint.GetMetrics(), request.TargetModel)
 		// Good: The model server has capacity to load at least one more adapter.
 		case len(endpoint.GetMetrics().ActiveModels)+len(endpoint.GetMetrics().WaitingModels) < endpoint.GetMetrics().MaxActiveModels:
 			scores[endpoint] = 0.8
@@ -100,3 +114,13 @@ func (s *LoraAffinityScorer) Score(_ context.Context, _ *framework.CycleState, r
 
 	return scores
 }
+
+// adapterContention returns the fraction of the requests of the adapter that are waiting on the model server, 0 if the
+// model server does not expose per-adapter request metrics.
+func adapterContention(metrics *fwkdl.Metrics, adapter string) float64 {
+	running, waiting := metrics.AdapterRunningRequests[adapter], metrics.AdapterWaitingRequests[adapter]
+	if running+waiting == 0 {
+		return 0
+	}
+	return float64(waiting) / float64(running+waiting)
+}
@@ -50,6 +50,34 @@ func TestLoraAffinityScorer(t *testing.T) {
 				"pod1": 1.0,
 			},
 		},
+		{
+			name:    "Target model is active and contended",
+			request: &fwksched.InferenceRequest{TargetModel: "active-model-1"},
+			endpoints: []fwksched.Endpoint{
+				fwksched.NewEndpoint(
+					&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "pod1"}},
+					&fwkdl.Metrics{
+						ActiveModels:           map[string]int{"active-model-1": 1},
+						WaitingModels:          map[string]int{},
+						AdapterRunningRequests: map[string]int{"active-model-1": 3},
+						AdapterWaitingRequests: map[string]int{"active-model-1": 1},
+						MaxActiveModels:        5,
+					}, nil),
+				fwksched.NewEndpoint(
+					&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "pod2"}},
+					&fwkdl.Metrics{
+						ActiveModels:           map[string]int{"active-model-1": 1},
+						WaitingModels:          map[string]int{},
+						AdapterRunningRequests: map[string]int{"active-model-1": 0},
+						AdapterWaitingRequests: map[string]int{"active-model-1": 4},
+						MaxActiveModels:        5,
+					}, nil),
+			},
+			expectedScoresEndpoint: map[string]float64{
+				"pod1": 0.975,
+				"pod2": 0.9,
+			},
+		},
 		{
 			name:    "Target model is waiting",
 			request: &fwksched.InferenceRequest{TargetModel: "active-model-1"},
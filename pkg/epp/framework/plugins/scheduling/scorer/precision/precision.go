@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package precision
+
+import (
+	"context"
+	"encoding/json"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+const (
+	PrecisionScorerType = "precision-scorer"
+)
+
+// compile-time type assertion
+var _ framework.Scorer = &PrecisionScorer{}
+
+// PrecisionScorerFactory defines the factory function for PrecisionScorer.
+func PrecisionScorerFactory(name string, _ json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	return NewPrecisionScorer().WithName(name), nil
+}
+
+// NewPrecisionScorer initializes a new PrecisionScorer and returns its pointer.
+func NewPrecisionScorer() *PrecisionScorer {
+	return &PrecisionScorer{
+		typedName: fwkplugin.TypedName{Type: PrecisionScorerType, Name: PrecisionScorerType},
+	}
+}
+
+// PrecisionScorer scores candidate endpoints based on the precision requirement of the request, for pools serving the
+// same model at several precisions. Requests preferring quantized endpoints score the quantized endpoints 1, and
+// requests requiring full precision score the full-precision endpoints 1. All endpoints score 0 for requests without a
+// requirement. The precision of an endpoint is read from the "inference.networking.k8s.io/precision" pod label, or as
+// reported by the model server. Endpoints of unknown precision are considered full precision.
+type PrecisionScorer struct {
+	typedName fwkplugin.TypedName
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (s *PrecisionScorer) TypedName() fwkplugin.TypedName {
+	return s.typedName
+}
+
+// Category returns the preference the scorer applies when scoring candidate endpoints.
+func (s *PrecisionScorer) Category() framework.ScorerCategory {
+	return framework.Affinity
+}
+
+// WithName sets the name of the scorer.
+func (s *PrecisionScorer) WithName(name string) *PrecisionScorer {
+	s.typedName.Name = name
+	return s
+}
+
+// Score returns the scoring result for the given list of endpoints based on context.
+func (s *PrecisionScorer) Score(_ context.Context, _ *framework.CycleState, request *framework.InferenceRequest, endpoints []framework.Endpoint) map[framework.Endpoint]float64 {
+	var requirement framework.PrecisionRequirement
+	if request != nil {
+		requirement = request.Objectives.Precision
+	}
+	scores := make(map[framework.Endpoint]float64, len(endpoints))
+	for _, endpoint := range endpoints {
+		quantized := fwkdl.IsQuantized(fwkdl.Precision(endpoint))
+		switch {
+		case requirement == framework.PrecisionPreferQuantized && quantized,
+			requirement == framework.PrecisionFull && !quantized:
+			scores[endpoint] = 1
+		default:
+			scores[endpoint] = 0
+		}
+	}
+	return scores
+}
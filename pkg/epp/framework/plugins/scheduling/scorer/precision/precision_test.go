@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package precision
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+func TestPrecisionScorer(t *testing.T) {
+	full := framework.NewEndpoint(&fwkdl.EndpointMetadata{
+		NamespacedName: types.NamespacedName{Name: "full"},
+		Labels:         map[string]string{fwkdl.PrecisionLabel: "BF16"},
+	}, &fwkdl.Metrics{}, fwkdl.NewAttributes())
+	quantized := framework.NewEndpoint(&fwkdl.EndpointMetadata{
+		NamespacedName: types.NamespacedName{Name: "quantized"},
+	}, &fwkdl.Metrics{Precision: "fp8"}, fwkdl.NewAttributes())
+	endpoints := []framework.Endpoint{full, quantized}
+
+	tests := []struct {
+		name      string
+		precision framework.PrecisionRequirement
+		want      map[framework.Endpoint]float64
+	}{
+		{name: "no requirement", want: map[framework.Endpoint]float64{full: 0, quantized: 0}},
+		{name: "full precision", precision: framework.PrecisionFull, want: map[framework.Endpoint]float64{full: 1, quantized: 0}},
+		{name: "prefer quantized", precision: framework.PrecisionPreferQuantized, want: map[framework.Endpoint]float64{full: 0, quantized: 1}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := &framework.InferenceRequest{Objectives: framework.RequestObjectives{Precision: test.precision}}
+			assert.Equal(t, test.want, NewPrecisionScorer().Score(context.Background(), nil, request, endpoints))
+		})
+	}
+}
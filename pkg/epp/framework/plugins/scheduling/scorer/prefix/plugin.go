@@ -91,9 +91,21 @@ func (p *Plugin) Consumes() map[string]any {
 	return map[string]any{attrprefix.PrefixCacheMatchInfoKey: attrprefix.PrefixCacheMatchInfo{}}
 }
 
+// Requires returns the data the plugin cannot score without.
+func (p *Plugin) Requires() []string {
+	return []string{attrprefix.PrefixCacheMatchInfoKey}
+}
+
 // Score returns the scoring result for the given list of pods based on prefix cache match info.
-func (p *Plugin) Score(ctx context.Context, _ *framework.CycleState, _ *framework.InferenceRequest, endpoints []framework.Endpoint) map[framework.Endpoint]float64 {
+// The requests disabling the cache affinity through their scheduling hints score 0 on all the endpoints.
+func (p *Plugin) Score(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest, endpoints []framework.Endpoint) map[framework.Endpoint]float64 {
 	scores := make(map[framework.Endpoint]float64, len(endpoints))
+	if request != nil && request.Hints.DisableCacheAffinity {
+		for _, endpoint := range endpoints {
+			scores[endpoint] = 0.0
+		}
+		return scores
+	}
 	logger := log.FromContext(ctx)
 
 	for _, endpoint := range endpoints {
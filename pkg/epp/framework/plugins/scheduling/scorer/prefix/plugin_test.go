@@ -41,4 +41,10 @@ func TestPrefixPluginScore(t *testing.T) {
 
 	assert.Equal(t, 0.5, scores[endpoint1])
 	assert.Equal(t, 0.2, scores[endpoint2])
+
+	// The requests disabling the cache affinity score 0 everywhere.
+	request := &fwksched.InferenceRequest{Hints: fwksched.SchedulingHints{DisableCacheAffinity: true}}
+	scores = p.Score(context.Background(), fwksched.NewCycleState(), request, endpoints)
+	assert.Equal(t, 0.0, scores[endpoint1])
+	assert.Equal(t, 0.0, scores[endpoint2])
 }
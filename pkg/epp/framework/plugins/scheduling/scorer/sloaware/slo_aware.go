@@ -0,0 +1,195 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sloaware
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/extractor/metrics"
+)
+
+const (
+	SLOAwareScorerType = "slo-aware-scorer"
+
+	// feasibleMinScore is the lowest score of an endpoint expected to meet the request's objectives.
+	feasibleMinScore = 0.5
+	// infeasibleMaxScore is the highest score of an endpoint expected to miss one of the request's objectives.
+	infeasibleMaxScore = 0.05
+	// bytesPerToken is the approximate number of request bytes per prompt token.
+	bytesPerToken = 4
+)
+
+// Config holds the coefficients of the latency model used by the SLOAwareScorer. The defaults are rough figures for a
+// mid-sized model and should be calibrated for the served model and accelerator.
+type Config struct {
+	// BaseTTFTMs is the time to first token of an idle endpoint for an empty prompt, in milliseconds.
+	BaseTTFTMs float64 `json:"baseTTFTMs"`
+	// PrefillMsPerToken is the prefill time per prompt token, in milliseconds.
+	PrefillMsPerToken float64 `json:"prefillMsPerToken"`
+	// QueueWaitMsPerRequest is the time to first token added by each request waiting in the endpoint's queue, in
+	// milliseconds.
+	QueueWaitMsPerRequest float64 `json:"queueWaitMsPerRequest"`
+	// BaseTPOTMs is the time per output token of an endpoint serving a single request, in milliseconds.
+	BaseTPOTMs float64 `json:"baseTPOTMs"`
+	// TPOTMsPerRunningRequest is the time per output token added by each request running on the endpoint, in
+	// milliseconds.
+	TPOTMsPerRunningRequest float64 `json:"tpotMsPerRunningRequest"`
+}
+
+var DefaultConfig = Config{
+	BaseTTFTMs:              20,
+	PrefillMsPerToken:       0.1,
+	QueueWaitMsPerRequest:   200,
+	BaseTPOTMs:              10,
+	TPOTMsPerRunningRequest: 0.5,
+}
+
+func (c *Config) validate() error {
+	for name, value := range map[string]float64{
+		"baseTTFTMs":              c.BaseTTFTMs,
+		"prefillMsPerToken":       c.PrefillMsPerToken,
+		"queueWaitMsPerRequest":   c.QueueWaitMsPerRequest,
+		"baseTPOTMs":              c.BaseTPOTMs,
+		"tpotMsPerRunningRequest": c.TPOTMsPerRunningRequest,
+	} {
+		if value < 0 {
+			return fmt.Errorf("%s must be >= 0, got %f", name, value)
+		}
+	}
+	return nil
+}
+
+// compile-time type assertion
+var _ framework.Scorer = &SLOAwareScorer{}
+
+// SLOAwareScorerFactory defines the factory function for SLOAwareScorer.
+func SLOAwareScorerFactory(name string, params json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	cfg := DefaultConfig
+	if len(params) > 0 {
+		if err := json.Unmarshal(params, &cfg); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal slo aware scorer config: %w", err)
+		}
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("invalid slo aware scorer config: %w", err)
+	}
+	return NewSLOAwareScorer(cfg).WithName(name), nil
+}
+
+// NewSLOAwareScorer initializes a new SLOAwareScorer and returns its pointer.
+func NewSLOAwareScorer(cfg Config) *SLOAwareScorer {
+	return &SLOAwareScorer{
+		typedName: fwkplugin.TypedName{Type: SLOAwareScorerType, Name: SLOAwareScorerType},
+		config:    cfg,
+	}
+}
+
+// SLOAwareScorer scores endpoints by whether they are expected to meet the request's declared time to first token
+// and time per output token objectives. Latencies are estimated with a linear model of the endpoint's waiting queue,
+// running requests and the prompt length.
+//
+// Endpoints expected to meet all declared objectives score in [0.5, 1], more headroom scoring higher. Endpoints
+// expected to miss an objective score in [0, 0.05], closer misses scoring higher. Requests without objectives get
+// the same score on all endpoints.
+type SLOAwareScorer struct {
+	typedName fwkplugin.TypedName
+	config    Config
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (s *SLOAwareScorer) TypedName() fwkplugin.TypedName {
+	return s.typedName
+}
+
+// Consumes returns the list of data that is consumed by the plugin.
+func (s *SLOAwareScorer) Consumes() map[string]any {
+	return map[string]any{
+		metrics.WaitingQueueSizeKey:    int(0),
+		metrics.RunningRequestsSizeKey: int(0),
+	}
+}
+
+// WithName sets the name of the scorer.
+func (s *SLOAwareScorer) WithName(name string) *SLOAwareScorer {
+	s.typedName.Name = name
+	return s
+}
+
+// Category returns the preference the scorer applies when scoring candidate endpoints.
+func (s *SLOAwareScorer) Category() framework.ScorerCategory {
+	return framework.Distribution
+}
+
+// Score returns the scoring result for the given list of endpoints based on context.
+func (s *SLOAwareScorer) Score(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest, endpoints []framework.Endpoint) map[framework.Endpoint]float64 {
+	scores := make(map[framework.Endpoint]float64, len(endpoints))
+	var objectives framework.RequestObjectives
+	if request != nil {
+		objectives = request.Objectives
+	}
+	if objectives.TTFT <= 0 && objectives.TPOT <= 0 {
+		for _, endpoint := range endpoints {
+			scores[endpoint] = 1.0
+		}
+		return scores
+	}
+
+	promptTokens := 0.0
+	if request != nil {
+		promptTokens = float64(request.RequestSizeBytes / bytesPerToken)
+	}
+
+	logger := log.FromContext(ctx).V(logutil.TRACE)
+	for _, endpoint := range endpoints {
+		endpointMetrics := endpoint.GetMetrics()
+		ttft, tpot := s.estimate(endpointMetrics.WaitingQueueSize, endpointMetrics.RunningRequestsSize, promptTokens)
+		score := min(objectiveScore(ttft, objectives.TTFT), objectiveScore(tpot, objectives.TPOT))
+		scores[endpoint] = score
+		logger.Info("SLOAwareScorer scoring", "endpoint", endpoint.GetMetadata().NamespacedName,
+			"estimatedTTFT", ttft, "ttftObjective", objectives.TTFT,
+			"estimatedTPOT", tpot, "tpotObjective", objectives.TPOT, "score", score)
+	}
+	return scores
+}
+
+// estimate returns the estimated time to first token and time per output token of a request with the given prompt
+// length on an endpoint with the given load.
+func (s *SLOAwareScorer) estimate(waiting, running int, promptTokens float64) (time.Duration, time.Duration) {
+	ttftMs := s.config.BaseTTFTMs + float64(waiting)*s.config.QueueWaitMsPerRequest + promptTokens*s.config.PrefillMsPerToken
+	tpotMs := s.config.BaseTPOTMs + float64(running)*s.config.TPOTMsPerRunningRequest
+	return time.Duration(ttftMs * float64(time.Millisecond)), time.Duration(tpotMs * float64(time.Millisecond))
+}
+
+// objectiveScore scores an estimated latency against an objective. A non-positive objective is always met.
+func objectiveScore(estimated, objective time.Duration) float64 {
+	if objective <= 0 {
+		return 1.0
+	}
+	ratio := float64(estimated) / float64(objective)
+	if ratio <= 1 {
+		return 1.0 - ratio*(1.0-feasibleMinScore)
+	}
+	return infeasibleMaxScore / ratio
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sloaware
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+func TestSLOAwareScorer(t *testing.T) {
+	// TTFT = waiting * 100ms, TPOT = 10ms + running * 1ms; prompt length is irrelevant unless stated.
+	config := Config{QueueWaitMsPerRequest: 100, BaseTPOTMs: 10, TPOTMsPerRunningRequest: 1}
+
+	tests := []struct {
+		name                   string
+		request                *fwksched.InferenceRequest
+		endpoints              []fwksched.Endpoint
+		expectedScoresEndpoint map[int]float64 // Map of endpoint index to expected score
+	}{
+		{
+			name:    "No objectives declared",
+			request: &fwksched.InferenceRequest{},
+			endpoints: []fwksched.Endpoint{
+				fwksched.NewEndpoint(&fwkdl.EndpointMetadata{}, &fwkdl.Metrics{WaitingQueueSize: 100}, nil),
+				fwksched.NewEndpoint(&fwkdl.EndpointMetadata{}, &fwkdl.Metrics{}, nil),
+			},
+			expectedScoresEndpoint: map[int]float64{0: 1.0, 1: 1.0},
+		},
+		{
+			name:    "TTFT objective",
+			request: &fwksched.InferenceRequest{Objectives: fwksched.RequestObjectives{TTFT: time.Second}},
+			endpoints: []fwksched.Endpoint{
+				fwksched.NewEndpoint(&fwkdl.EndpointMetadata{}, &fwkdl.Metrics{}, nil),
+				fwksched.NewEndpoint(&fwkdl.EndpointMetadata{}, &fwkdl.Metrics{WaitingQueueSize: 5}, nil),
+				fwksched.NewEndpoint(&fwkdl.EndpointMetadata{}, &fwkdl.Metrics{WaitingQueueSize: 10}, nil),
+				fwksched.NewEndpoint(&fwkdl.EndpointMetadata{}, &fwkdl.Metrics{WaitingQueueSize: 20}, nil),
+			},
+			expectedScoresEndpoint: map[int]float64{0: 1.0, 1: 0.75, 2: 0.5, 3: 0.025},
+		},
+		{
+			name:    "Infeasible on either objective",
+			request: &fwksched.InferenceRequest{Objectives: fwksched.RequestObjectives{TTFT: time.Second, TPOT: 20 * time.Millisecond}},
+			endpoints: []fwksched.Endpoint{
+				fwksched.NewEndpoint(&fwkdl.EndpointMetadata{}, &fwkdl.Metrics{WaitingQueueSize: 5, RunningRequestsSize: 30}, nil),
+				fwksched.NewEndpoint(&fwkdl.EndpointMetadata{}, &fwkdl.Metrics{WaitingQueueSize: 5, RunningRequestsSize: 0}, nil),
+			},
+			expectedScoresEndpoint: map[int]float64{0: 0.025, 1: 0.75},
+		},
+		{
+			name: "Prompt length adds to TTFT",
+			request: &fwksched.InferenceRequest{
+				Objectives:       fwksched.RequestObjectives{TTFT: time.Second},
+				RequestSizeBytes: 40000, // 10000 tokens
+			},
+			endpoints: []fwksched.Endpoint{
+				fwksched.NewEndpoint(&fwkdl.EndpointMetadata{}, &fwkdl.Metrics{WaitingQueueSize: 5}, nil),
+			},
+			expectedScoresEndpoint: map[int]float64{0: 0.5},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := config
+			if test.request.RequestSizeBytes > 0 {
+				cfg.PrefillMsPerToken = 0.05
+			}
+			scorer := NewSLOAwareScorer(cfg)
+			scores := scorer.Score(context.Background(), fwksched.NewCycleState(), test.request, test.endpoints)
+
+			for i, endpoint := range test.endpoints {
+				assert.InDelta(t, test.expectedScoresEndpoint[i], scores[endpoint], 0.0001, "endpoint %d", i)
+			}
+		})
+	}
+}
+
+func TestSLOAwareScorerFactory(t *testing.T) {
+	plugin, err := SLOAwareScorerFactory("slo", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "slo", plugin.TypedName().Name)
+	assert.Equal(t, DefaultConfig, plugin.(*SLOAwareScorer).config)
+
+	plugin, err = SLOAwareScorerFactory("slo", json.RawMessage(`{"queueWaitMsPerRequest": 50}`), nil)
+	require.NoError(t, err)
+	assert.InDelta(t, 50.0, plugin.(*SLOAwareScorer).config.QueueWaitMsPerRequest, 0)
+	assert.InDelta(t, DefaultConfig.BaseTTFTMs, plugin.(*SLOAwareScorer).config.BaseTTFTMs, 0)
+
+	_, err = SLOAwareScorerFactory("slo", json.RawMessage(`{"prefillMsPerToken": -1}`), nil)
+	assert.Error(t, err)
+}
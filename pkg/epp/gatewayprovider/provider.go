@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package gatewayprovider captures the quirks of the gateway implementations in their integration with the ext-proc
+// server of the endpoint picker, so that the server does not scatter per-implementation conditionals and new gateways
+// can be supported by adding a provider.
+package gatewayprovider
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	extprocv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metadata"
+)
+
+const (
+	// ProviderEnvoy is a plain Envoy proxy configured with the ext_proc filter, the default.
+	ProviderEnvoy        = "envoy"
+	ProviderEnvoyGateway = "envoy-gateway"
+	ProviderIstio        = "istio"
+	ProviderGKE          = "gke"
+	ProviderKgateway     = "kgateway"
+)
+
+// Provider captures how a gateway implementation exchanges with the ext-proc server.
+type Provider interface {
+	// Name returns the name of the provider.
+	Name() string
+	// SetHeader returns the header mutation option setting the given header to the given value.
+	SetHeader(key, value string) *corev3.HeaderValueOption
+	// MetadataNamespace returns the namespace of the dynamic metadata in which the destination endpoint is published.
+	MetadataNamespace() string
+	// BodyMode returns the mode in which the gateway sends the request and response bodies to the ext-proc server.
+	BodyMode() extprocv3.ProcessingMode_BodySendMode
+}
+
+// provider is a Provider whose quirks are configuration values.
+type provider struct {
+	name string
+	// rawHeaderValues is whether the header values are set in the raw_value field of the mutations, rather than in the
+	// value field, which older proxies require.
+	rawHeaderValues   bool
+	metadataNamespace string
+	bodyMode          extprocv3.ProcessingMode_BodySendMode
+}
+
+func (p *provider) Name() string {
+	return p.name
+}
+
+func (p *provider) SetHeader(key, value string) *corev3.HeaderValueOption {
+	header := &corev3.HeaderValue{Key: key}
+	if p.rawHeaderValues {
+		header.RawValue = []byte(value)
+	} else {
+		header.Value = value
+	}
+	return &corev3.HeaderValueOption{Header: header}
+}
+
+func (p *provider) MetadataNamespace() string {
+	return p.metadataNamespace
+}
+
+func (p *provider) BodyMode() extprocv3.ProcessingMode_BodySendMode {
+	return p.bodyMode
+}
+
+// envoyDefaults returns a provider with the defaults of Envoy, which all the supported gateways are built on.
+func envoyDefaults(name string) *provider {
+	return &provider{
+		name:              name,
+		rawHeaderValues:   true,
+		metadataNamespace: metadata.DestinationEndpointNamespace,
+		bodyMode:          extprocv3.ProcessingMode_FULL_DUPLEX_STREAMED,
+	}
+}
+
+// providers are the supported gateway implementations. They all use the Envoy defaults today; a gateway departing from
+// them overrides the corresponding fields here.
+var providers = map[string]Provider{
+	ProviderEnvoy:        envoyDefaults(ProviderEnvoy),
+	ProviderEnvoyGateway: envoyDefaults(ProviderEnvoyGateway),
+	ProviderIstio:        envoyDefaults(ProviderIstio),
+	ProviderGKE:          envoyDefaults(ProviderGKE),
+	ProviderKgateway:     envoyDefaults(ProviderKgateway),
+}
+
+// Names returns the names of the supported providers, sorted.
+func Names() []string {
+	return slices.Sorted(maps.Keys(providers))
+}
+
+// Get returns the provider of the given name.
+func Get(name string) (Provider, error) {
+	p, ok := providers[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown gateway provider %q, must be one of %v", name, Names())
+	}
+	return p, nil
+}
+
+// Default returns the provider used when none is configured.
+func Default() Provider {
+	return providers[ProviderEnvoy]
+}
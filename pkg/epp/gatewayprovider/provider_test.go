@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gatewayprovider
+
+import (
+	"testing"
+
+	extprocv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metadata"
+)
+
+func TestGet(t *testing.T) {
+	assert.Equal(t, []string{ProviderEnvoy, ProviderEnvoyGateway, ProviderGKE, ProviderIstio, ProviderKgateway}, Names())
+	for _, name := range Names() {
+		p, err := Get(name)
+		require.NoError(t, err)
+		assert.Equal(t, name, p.Name())
+		assert.Equal(t, metadata.DestinationEndpointNamespace, p.MetadataNamespace())
+		assert.Equal(t, extprocv3.ProcessingMode_FULL_DUPLEX_STREAMED, p.BodyMode())
+	}
+	assert.Equal(t, ProviderEnvoy, Default().Name())
+
+	_, err := Get("unknown")
+	assert.Error(t, err)
+}
+
+func TestSetHeader(t *testing.T) {
+	raw := envoyDefaults("raw").SetHeader("x-key", "value")
+	assert.Equal(t, "x-key", raw.GetHeader().GetKey())
+	assert.Equal(t, []byte("value"), raw.GetHeader().GetRawValue())
+	assert.Empty(t, raw.GetHeader().GetValue())
+
+	legacy := envoyDefaults("legacy")
+	legacy.rawHeaderValues = false
+	header := legacy.SetHeader("x-key", "value")
+	assert.Equal(t, "value", header.GetHeader().GetValue())
+	assert.Empty(t, header.GetHeader().GetRawValue())
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metadata"
+)
+
+const (
+	// FairnessIDSourceHeader reads the fairness ID of the requests from the x-gateway-inference-fairness-id header.
+	FairnessIDSourceHeader = "header"
+	// fairnessIDSourceHeaderPrefix prefixes a source reading the fairness ID from a custom header.
+	fairnessIDSourceHeaderPrefix = "header:"
+	// fairnessIDSourceJWTClaimPrefix prefixes a source reading the fairness ID from a claim of the bearer token.
+	fairnessIDSourceJWTClaimPrefix = "jwt-claim:"
+)
+
+// FairnessIDSource is where the fairness ID of the requests, keying their flow control queues, is read from. The
+// x-gateway-inference-fairness-id header is the fallback of a custom header. It is not the fallback of a JWT claim, as
+// the clients could then pick their fairness ID by omitting the claim: such requests get the default fairness ID.
+type FairnessIDSource struct {
+	// Header is the header holding the fairness ID, if not the x-gateway-inference-fairness-id one.
+	Header string
+	// JWTClaim is the claim of the bearer token of the authorization header holding the fairness ID.
+	JWTClaim string
+}
+
+// ParseFairnessIDSource parses a fairness ID source: header, header:<name> or jwt-claim:<claim>.
+func ParseFairnessIDSource(value string) (FairnessIDSource, error) {
+	switch {
+	case value == FairnessIDSourceHeader:
+		return FairnessIDSource{}, nil
+	case strings.HasPrefix(value, fairnessIDSourceHeaderPrefix) && len(value) > len(fairnessIDSourceHeaderPrefix):
+		return FairnessIDSource{Header: strings.ToLower(strings.TrimPrefix(value, fairnessIDSourceHeaderPrefix))}, nil
+	case strings.HasPrefix(value, fairnessIDSourceJWTClaimPrefix) && len(value) > len(fairnessIDSourceJWTClaimPrefix):
+		return FairnessIDSource{JWTClaim: strings.TrimPrefix(value, fairnessIDSourceJWTClaimPrefix)}, nil
+	}
+	return FairnessIDSource{}, fmt.Errorf("unknown fairness ID source %q, expected %q, %q<name> or %q<claim>", value,
+		FairnessIDSourceHeader, fairnessIDSourceHeaderPrefix, fairnessIDSourceJWTClaimPrefix)
+}
+
+// fairnessID returns the fairness ID of a request from its headers, empty if the source holds none.
+func (s FairnessIDSource) fairnessID(headers map[string]string) string {
+	switch {
+	case s.Header != "":
+		if id := headers[s.Header]; id != "" {
+			return id
+		}
+	case s.JWTClaim != "":
+		return jwtClaim(headers["authorization"], s.JWTClaim)
+	}
+	return headers[metadata.FlowFairnessIDKey]
+}
+
+// jwtClaim returns the value of a string or numeric claim of a bearer token, empty if there is none. The token is not
+// verified: it is expected to be authenticated by the gateway before the request reaches the EPP.
+func jwtClaim(authorization, claim string) string {
+	scheme, token, ok := strings.Cut(strings.TrimSpace(authorization), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	token = strings.TrimSpace(token)
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ""
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return ""
+	}
+	var claims map[string]any
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return ""
+	}
+	switch value := claims[claim].(type) {
+	case string:
+		return value
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	}
+	return ""
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metadata"
+)
+
+// bearer returns an authorization header value holding an unsigned token with the given payload.
+func bearer(payload string) string {
+	encode := base64.RawURLEncoding.EncodeToString
+	return "Bearer " + encode([]byte(`{"alg":"none"}`)) + "." + encode([]byte(payload)) + ".sig"
+}
+
+func TestParseFairnessIDSource(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value   string
+		want    FairnessIDSource
+		wantErr bool
+	}{
+		{value: "header", want: FairnessIDSource{}},
+		{value: "header:X-Tenant", want: FairnessIDSource{Header: "x-tenant"}},
+		{value: "jwt-claim:tenant", want: FairnessIDSource{JWTClaim: "tenant"}},
+		{value: "header:", wantErr: true},
+		{value: "jwt-claim:", wantErr: true},
+		{value: "namespace", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParseFairnessIDSource(test.value)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestFairnessIDSource(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		source  FairnessIDSource
+		headers map[string]string
+		want    string
+	}{
+		{
+			name:    "fairness ID header",
+			headers: map[string]string{metadata.FlowFairnessIDKey: "tenant-a"},
+			want:    "tenant-a",
+		},
+		{
+			name:    "custom header",
+			source:  FairnessIDSource{Header: "x-tenant"},
+			headers: map[string]string{"x-tenant": "tenant-b", metadata.FlowFairnessIDKey: "tenant-a"},
+			want:    "tenant-b",
+		},
+		{
+			name:    "custom header falls back to the fairness ID header",
+			source:  FairnessIDSource{Header: "x-tenant"},
+			headers: map[string]string{metadata.FlowFairnessIDKey: "tenant-a"},
+			want:    "tenant-a",
+		},
+		{
+			name:    "string JWT claim",
+			source:  FairnessIDSource{JWTClaim: "org"},
+			headers: map[string]string{"authorization": bearer(`{"sub":"user","org":"tenant-c"}`)},
+			want:    "tenant-c",
+		},
+		{
+			name:    "numeric JWT claim",
+			source:  FairnessIDSource{JWTClaim: "org"},
+			headers: map[string]string{"authorization": bearer(`{"org":42}`)},
+			want:    "42",
+		},
+		{
+			name:    "large numeric JWT claim",
+			source:  FairnessIDSource{JWTClaim: "org"},
+			headers: map[string]string{"authorization": bearer(`{"org":1000000}`)},
+			want:    "1000000",
+		},
+		{
+			name:   "missing JWT claim does not fall back to the fairness ID header",
+			source: FairnessIDSource{JWTClaim: "org"},
+			headers: map[string]string{"authorization": bearer(`{"sub":"user"}`),
+				metadata.FlowFairnessIDKey: "tenant-a"},
+			want: "",
+		},
+		{
+			name:    "missing authorization does not fall back to the fairness ID header",
+			source:  FairnessIDSource{JWTClaim: "org"},
+			headers: map[string]string{metadata.FlowFairnessIDKey: "tenant-a"},
+			want:    "",
+		},
+		{
+			name:    "lowercase bearer scheme",
+			source:  FairnessIDSource{JWTClaim: "org"},
+			headers: map[string]string{"authorization": "bearer " + strings.TrimPrefix(bearer(`{"org":"tenant-c"}`), "Bearer ")},
+			want:    "tenant-c",
+		},
+		{
+			name:    "malformed token",
+			source:  FairnessIDSource{JWTClaim: "org"},
+			headers: map[string]string{"authorization": "Bearer not-a-jwt"},
+			want:    "",
+		},
+		{
+			name:    "basic authorization",
+			source:  FairnessIDSource{JWTClaim: "org"},
+			headers: map[string]string{"authorization": "Basic dXNlcjpwYXNz"},
+			want:    "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.want, test.source.fairnessID(test.headers))
+		})
+	}
+}
@@ -30,6 +30,7 @@ import (
 
 	envoy "sigs.k8s.io/gateway-api-inference-extension/pkg/common/envoy"
 	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/decisioncompare"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metadata"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/request"
 )
@@ -50,14 +51,17 @@ func (s *StreamingServer) HandleRequestHeaders(ctx context.Context, reqCtx *Requ
 		reqCtx.TargetEndpoint = endpoint.GetIPAddress() + ":" + endpoint.GetPort()
 		reqCtx.RequestSize = 0
 		reqCtx.reqHeaderResp = s.generateRequestHeaderResponse(ctx, reqCtx)
+		if s.decisionComparer != nil {
+			s.decisionComparer.Compare(ctx, decisioncompare.Decision{Skipped: true})
+		}
 		return nil
 	}
 
 	for _, header := range req.RequestHeaders.Headers.Headers {
 		reqCtx.Request.Headers[header.Key] = envoy.GetHeaderValue(header)
 		switch header.Key {
-		case metadata.FlowFairnessIDKey:
-			reqCtx.FairnessID = reqCtx.Request.Headers[header.Key]
+		case metadata.RequestFamilyIDKey:
+			reqCtx.RequestFamilyID = reqCtx.Request.Headers[header.Key]
 		case metadata.ObjectiveKey:
 			reqCtx.ObjectiveKey = reqCtx.Request.Headers[header.Key]
 		case metadata.ModelNameRewriteKey:
@@ -65,6 +69,7 @@ func (s *StreamingServer) HandleRequestHeaders(ctx context.Context, reqCtx *Requ
 		}
 	}
 
+	reqCtx.FairnessID = s.fairnessIDSource.fairnessID(reqCtx.Request.Headers)
 	if reqCtx.FairnessID == "" {
 		reqCtx.FairnessID = metadata.DefaultFairnessID
 	}
@@ -77,6 +82,7 @@ func (s *StreamingServer) generateRequestHeaderResponse(ctx context.Context, req
 	// and as an unstructure ext-proc response metadata key/value pair. This enables different integration
 	// options for gateway providers.
 	dynamicMetadata := s.generateMetadata(reqCtx.TargetEndpoint)
+	dynamicMetadata.Fields[metadata.SchedulingMetadataNamespace] = structpb.NewStructValue(generateSchedulingMetadata(reqCtx))
 	if reqCtx.Response.DynamicMetadata != nil {
 		if dynamicMetadata.Fields == nil {
 			dynamicMetadata.Fields = make(map[string]*structpb.Value)
@@ -90,7 +96,8 @@ func (s *StreamingServer) generateRequestHeaderResponse(ctx context.Context, req
 				Response: &extProcPb.CommonResponse{
 					ClearRouteCache: true,
 					HeaderMutation: &extProcPb.HeaderMutation{
-						SetHeaders: s.generateHeaders(ctx, reqCtx),
+						SetHeaders:    s.generateHeaders(ctx, reqCtx),
+						RemoveHeaders: reqCtx.Request.RemovedHeaders,
 					},
 				},
 			},
@@ -102,22 +109,12 @@ func (s *StreamingServer) generateRequestHeaderResponse(ctx context.Context, req
 func (s *StreamingServer) generateHeaders(ctx context.Context, reqCtx *RequestContext) []*configPb.HeaderValueOption {
 	// can likely refactor these two bespoke headers to be updated in PostDispatch, to centralize logic.
 	headers := []*configPb.HeaderValueOption{
-		{
-			Header: &configPb.HeaderValue{
-				Key:      metadata.DestinationEndpointKey,
-				RawValue: []byte(reqCtx.TargetEndpoint),
-			},
-		},
+		s.gatewayProvider().SetHeader(metadata.DestinationEndpointKey, reqCtx.TargetEndpoint),
 	}
 	if reqCtx.RequestSize > 0 {
 		// We need to update the content length header if the body is mutated, see Envoy doc:
 		// https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/http/ext_proc/v3/processing_mode.proto
-		headers = append(headers, &configPb.HeaderValueOption{
-			Header: &configPb.HeaderValue{
-				Key:      "Content-Length",
-				RawValue: []byte(strconv.Itoa(reqCtx.RequestSize)),
-			},
-		})
+		headers = append(headers, s.gatewayProvider().SetHeader("Content-Length", strconv.Itoa(reqCtx.RequestSize)))
 	}
 
 	// Inject trace context headers for propagation to downstream services
@@ -125,12 +122,7 @@ func (s *StreamingServer) generateHeaders(ctx context.Context, reqCtx *RequestCo
 	propagator := otel.GetTextMapPropagator()
 	propagator.Inject(ctx, propagation.MapCarrier(traceHeaders))
 	for key, value := range traceHeaders {
-		headers = append(headers, &configPb.HeaderValueOption{
-			Header: &configPb.HeaderValue{
-				Key:      key,
-				RawValue: []byte(value),
-			},
-		})
+		headers = append(headers, s.gatewayProvider().SetHeader(key, value))
 	}
 
 	// Include any non-system-owned headers.
@@ -138,12 +130,7 @@ func (s *StreamingServer) generateHeaders(ctx context.Context, reqCtx *RequestCo
 		if request.IsSystemOwnedHeader(key) {
 			continue
 		}
-		headers = append(headers, &configPb.HeaderValueOption{
-			Header: &configPb.HeaderValue{
-				Key:      key,
-				RawValue: []byte(value),
-			},
-		})
+		headers = append(headers, s.gatewayProvider().SetHeader(key, value))
 	}
 	return headers
 }
@@ -151,7 +138,7 @@ func (s *StreamingServer) generateHeaders(ctx context.Context, reqCtx *RequestCo
 func (s *StreamingServer) generateMetadata(endpoint string) *structpb.Struct {
 	return &structpb.Struct{
 		Fields: map[string]*structpb.Value{
-			metadata.DestinationEndpointNamespace: {
+			s.gatewayProvider().MetadataNamespace(): {
 				Kind: &structpb.Value_StructValue{
 					StructValue: &structpb.Struct{
 						Fields: map[string]*structpb.Value{
@@ -167,3 +154,37 @@ func (s *StreamingServer) generateMetadata(endpoint string) *structpb.Struct {
 		},
 	}
 }
+
+// generateSchedulingMetadata returns the scheduling decision of the request, for Envoy access logs and downstream
+// filters. The profile, priority and queue wait are only set for the requests that were scheduled.
+func generateSchedulingMetadata(reqCtx *RequestContext) *structpb.Struct {
+	fields := map[string]*structpb.Value{
+		metadata.SchedulingEndpointKey: structpb.NewStringValue(reqCtx.TargetEndpoint),
+	}
+	if reqCtx.SchedulingRequest != nil {
+		fields[metadata.SchedulingPriorityKey] = structpb.NewNumberValue(float64(reqCtx.Priority))
+		fields[metadata.SchedulingQueueWaitKey] = structpb.NewNumberValue(float64(reqCtx.QueueWait.Milliseconds()))
+		if result := reqCtx.SchedulingRequest.SchedulingResult; result != nil {
+			fields[metadata.SchedulingProfileKey] = structpb.NewStringValue(result.PrimaryProfileName)
+		}
+	}
+	return &structpb.Struct{Fields: fields}
+}
+
+// compareDecision compares the scheduling decision for the request against the decision of the active EPP, when running
+// in decision compare mode.
+func (s *StreamingServer) compareDecision(ctx context.Context, reqCtx *RequestContext, err error) {
+	if s.decisionComparer == nil {
+		return
+	}
+	decision := decisioncompare.Decision{
+		Model:  reqCtx.IncomingModelName,
+		Active: reqCtx.Request.Headers[metadata.DestinationEndpointKey],
+		Canary: reqCtx.TargetEndpoint,
+		Err:    err,
+	}
+	if reqCtx.SchedulingRequest != nil {
+		decision.RequestID = reqCtx.SchedulingRequest.RequestId
+	}
+	s.decisionComparer.Compare(ctx, decision)
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+// RequestBodyMode is how the chunks of a request body are accumulated before the body is parsed.
+type RequestBodyMode string
+
+const (
+	// RequestBodyModeBuffered appends the chunks to a single buffer as they are received, sized up front from the
+	// content-length header when there is one.
+	RequestBodyModeBuffered RequestBodyMode = "buffered"
+	// RequestBodyModeStreamed keeps the chunks as received and joins them once, at the end of the stream, into a
+	// buffer of the exact size of the body, avoiding the reallocations of a growing buffer for large payloads of an
+	// unknown size.
+	RequestBodyModeStreamed RequestBodyMode = "streamed"
+)
+
+// RequestBodyConfig is the configuration of the handling of the request bodies.
+type RequestBodyConfig struct {
+	// Mode is how the chunks of the bodies are accumulated.
+	Mode RequestBodyMode
+	// MaxBytes is the size from which the bodies are rejected with a 413, 0 for no limit.
+	MaxBytes int
+}
+
+// Validate checks the configuration.
+func (c RequestBodyConfig) Validate() error {
+	if c.Mode != RequestBodyModeBuffered && c.Mode != RequestBodyModeStreamed {
+		return fmt.Errorf("unknown request body mode %q, expected %q or %q", c.Mode, RequestBodyModeBuffered,
+			RequestBodyModeStreamed)
+	}
+	if c.MaxBytes < 0 {
+		return fmt.Errorf("the maximum request body size must not be negative, got %d", c.MaxBytes)
+	}
+	return nil
+}
+
+// tooLarge returns the error rejecting a body of the given size when it exceeds the limit, nil otherwise.
+func (c RequestBodyConfig) tooLarge(size int) error {
+	if c.MaxBytes == 0 || size <= c.MaxBytes {
+		return nil
+	}
+	return errcommon.Error{Code: errcommon.PayloadTooLarge,
+		Msg: fmt.Sprintf("request body of %d bytes exceeds the limit of %d bytes", size, c.MaxBytes)}
+}
+
+// requestBody accumulates the chunks of a request body according to the configuration of the server.
+type requestBody struct {
+	config RequestBodyConfig
+	size   int
+	buf    []byte   // buffered mode
+	chunks [][]byte // streamed mode
+}
+
+// checkContentLength rejects a body announced larger than the limit before any of it is received, and sizes the
+// buffer of the buffered mode. A missing or malformed content-length is left to the per-chunk checks.
+func (b *requestBody) checkContentLength(value string) error {
+	length, err := strconv.Atoi(value)
+	if err != nil || length < 0 {
+		return nil
+	}
+	if err := b.config.tooLarge(length); err != nil {
+		return err
+	}
+	if b.config.Mode == RequestBodyModeBuffered && b.buf == nil {
+		b.buf = make([]byte, 0, length)
+	}
+	return nil
+}
+
+// add accumulates a chunk, rejecting it and dropping what was accumulated once the body exceeds the limit.
+func (b *requestBody) add(chunk []byte) error {
+	b.size += len(chunk)
+	if err := b.config.tooLarge(b.size); err != nil {
+		b.reset()
+		return err
+	}
+	if b.config.Mode == RequestBodyModeStreamed {
+		if len(chunk) > 0 {
+			b.chunks = append(b.chunks, chunk)
+		}
+		return nil
+	}
+	b.buf = append(b.buf, chunk...)
+	return nil
+}
+
+// take returns the accumulated body and resets the accumulation.
+func (b *requestBody) take() []byte {
+	body := b.buf
+	if b.config.Mode == RequestBodyModeStreamed {
+		body = make([]byte, 0, b.size)
+		for _, chunk := range b.chunks {
+			body = append(body, chunk...)
+		}
+	}
+	if body == nil {
+		body = []byte{}
+	}
+	b.reset()
+	return body
+}
+
+func (b *requestBody) reset() {
+	b.size = 0
+	b.buf = nil
+	b.chunks = nil
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestRequestBody(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		config        RequestBodyConfig
+		contentLength string
+		chunks        []string
+		wantErrCode   string
+		wantBody      string
+	}{
+		{
+			name:     "buffered without limit",
+			config:   RequestBodyConfig{Mode: RequestBodyModeBuffered},
+			chunks:   []string{`{"model":`, `"m"}`},
+			wantBody: `{"model":"m"}`,
+		},
+		{
+			name:     "streamed without limit",
+			config:   RequestBodyConfig{Mode: RequestBodyModeStreamed},
+			chunks:   []string{`{"model":`, ``, `"m"}`},
+			wantBody: `{"model":"m"}`,
+		},
+		{
+			name:          "buffered within limit sized from the content-length",
+			config:        RequestBodyConfig{Mode: RequestBodyModeBuffered, MaxBytes: 13},
+			contentLength: "13",
+			chunks:        []string{`{"model":`, `"m"}`},
+			wantBody:      `{"model":"m"}`,
+		},
+		{
+			name:          "content-length over the limit",
+			config:        RequestBodyConfig{Mode: RequestBodyModeBuffered, MaxBytes: 12},
+			contentLength: "13",
+			wantErrCode:   errcommon.PayloadTooLarge,
+		},
+		{
+			name:          "malformed content-length left to the chunks",
+			config:        RequestBodyConfig{Mode: RequestBodyModeStreamed, MaxBytes: 12},
+			contentLength: "many",
+			chunks:        []string{`{"model":`, `"m"}`},
+			wantErrCode:   errcommon.PayloadTooLarge,
+		},
+		{
+			name:        "chunks over the limit",
+			config:      RequestBodyConfig{Mode: RequestBodyModeBuffered, MaxBytes: 12},
+			chunks:      []string{`{"model":`, `"m"}`},
+			wantErrCode: errcommon.PayloadTooLarge,
+		},
+		{
+			name:     "zero configuration buffers without limit",
+			chunks:   []string{`{}`},
+			wantBody: `{}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			b := requestBody{config: test.config}
+			err := b.checkContentLength(test.contentLength)
+			for _, chunk := range test.chunks {
+				if err != nil {
+					break
+				}
+				err = b.add([]byte(chunk))
+			}
+			if test.wantErrCode != "" {
+				assert.Equal(t, test.wantErrCode, errcommon.CanonicalCode(err))
+				assert.Empty(t, b.take(), "accumulated body should be dropped once rejected")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.wantBody, string(b.take()))
+			assert.Empty(t, b.take(), "body should be reset once taken")
+		})
+	}
+}
+
+func TestRequestBodyConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, RequestBodyConfig{Mode: RequestBodyModeBuffered}.Validate())
+	assert.NoError(t, RequestBodyConfig{Mode: RequestBodyModeStreamed, MaxBytes: 1 << 20}.Validate())
+	assert.Error(t, RequestBodyConfig{Mode: "chunked"}.Validate())
+	assert.Error(t, RequestBodyConfig{Mode: RequestBodyModeBuffered, MaxBytes: -1}.Validate())
+}
@@ -19,11 +19,15 @@ package handlers
 import (
 	"context"
 	"testing"
+	"time"
 
 	configPb "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	extprocfilterPb "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
 	extProcPb "github.com/envoyproxy/go-control-plane/envoy/service/ext_proc/v3"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/types/known/structpb"
+
+	schedulingtypes "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metadata"
 )
 
@@ -35,6 +39,7 @@ func TestHandleRequestHeaders(t *testing.T) {
 		headers        []*configPb.HeaderValue
 		wantHeaders    map[string]string
 		wantFairnessID string
+		wantFamilyID   string
 	}{
 		{
 			name: "Extracts Fairness ID and Removes Header",
@@ -52,6 +57,15 @@ func TestHandleRequestHeaders(t *testing.T) {
 			},
 			wantFairnessID: "binary-id",
 		},
+		{
+			name: "Extracts Request Family ID",
+			headers: []*configPb.HeaderValue{
+				{Key: metadata.FlowFairnessIDKey, Value: "user-123"},
+				{Key: metadata.RequestFamilyIDKey, Value: "task-42"},
+			},
+			wantFairnessID: "user-123",
+			wantFamilyID:   "task-42",
+		},
 	}
 
 	for _, tc := range tests {
@@ -70,6 +84,7 @@ func TestHandleRequestHeaders(t *testing.T) {
 			assert.NoError(t, err, "HandleRequestHeaders should not return an error")
 
 			assert.Equal(t, tc.wantFairnessID, reqCtx.FairnessID, "FairnessID should match expected value")
+			assert.Equal(t, tc.wantFamilyID, reqCtx.RequestFamilyID, "RequestFamilyID should match expected value")
 
 			if tc.wantHeaders != nil {
 				for k, v := range tc.wantHeaders {
@@ -108,6 +123,61 @@ func TestGenerateHeaders_Sanitization(t *testing.T) {
 	assert.Equal(t, "123", gotHeaders["Content-Length"])
 }
 
+// valueHeadersProvider is a gateway provider setting the value field of the headers, under a custom metadata namespace.
+type valueHeadersProvider struct{}
+
+func (valueHeadersProvider) Name() string { return "value-headers" }
+
+func (valueHeadersProvider) SetHeader(key, value string) *configPb.HeaderValueOption {
+	return &configPb.HeaderValueOption{Header: &configPb.HeaderValue{Key: key, Value: value}}
+}
+
+func (valueHeadersProvider) MetadataNamespace() string { return "custom.lb" }
+
+func (valueHeadersProvider) BodyMode() extprocfilterPb.ProcessingMode_BodySendMode {
+	return extprocfilterPb.ProcessingMode_BUFFERED
+}
+
+func TestGenerateRequestHeaderResponse_GatewayProvider(t *testing.T) {
+	server := &StreamingServer{}
+	server.SetGatewayProvider(valueHeadersProvider{})
+	reqCtx := &RequestContext{
+		TargetEndpoint: "1.2.3.4:8080",
+		Request:        &Request{Headers: map[string]string{"x-user-data": "important"}},
+		Response:       &Response{},
+	}
+
+	resp := server.generateRequestHeaderResponse(context.Background(), reqCtx)
+
+	gotHeaders := make(map[string]string)
+	for _, h := range resp.GetRequestHeaders().GetResponse().GetHeaderMutation().GetSetHeaders() {
+		assert.Empty(t, h.Header.RawValue)
+		gotHeaders[h.Header.Key] = h.Header.Value
+	}
+	assert.Equal(t, "1.2.3.4:8080", gotHeaders[metadata.DestinationEndpointKey])
+	assert.Equal(t, "important", gotHeaders["x-user-data"])
+	endpoint := resp.GetDynamicMetadata().GetFields()["custom.lb"].GetStructValue().GetFields()[metadata.DestinationEndpointKey]
+	assert.Equal(t, "1.2.3.4:8080", endpoint.GetStringValue())
+	assert.NotContains(t, resp.GetDynamicMetadata().GetFields(), metadata.DestinationEndpointNamespace)
+}
+
+func TestGenerateRequestHeaderResponse_RemovedHeaders(t *testing.T) {
+	server := &StreamingServer{}
+	reqCtx := &RequestContext{
+		TargetEndpoint: "1.2.3.4:8080",
+		Request: &Request{
+			Headers:        map[string]string{"x-user-data": "important"},
+			RemovedHeaders: []string{"x-internal"},
+		},
+		Response: &Response{},
+	}
+
+	resp := server.generateRequestHeaderResponse(context.Background(), reqCtx)
+
+	mutation := resp.GetRequestHeaders().GetResponse().GetHeaderMutation()
+	assert.Equal(t, []string{"x-internal"}, mutation.GetRemoveHeaders())
+}
+
 func TestGenerateRequestHeaderResponse_MergeMetadata(t *testing.T) {
 	t.Parallel()
 
@@ -150,3 +220,52 @@ func TestGenerateRequestHeaderResponse_MergeMetadata(t *testing.T) {
 	assert.True(t, ok, "Expected DestinationEndpointKey to be in DestinationEndpointNamespace")
 	assert.Equal(t, "1.2.3.4:8080", endpointKey.GetStringValue(), "Unexpected value for DestinationEndpointKey")
 }
+
+func TestGenerateRequestHeaderResponse_SchedulingMetadata(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		reqCtx    *RequestContext
+		wantField map[string]any
+	}{
+		{
+			name: "scheduled request",
+			reqCtx: &RequestContext{
+				TargetEndpoint: "1.2.3.4:8080",
+				Priority:       -1,
+				QueueWait:      1500 * time.Millisecond,
+				SchedulingRequest: &schedulingtypes.InferenceRequest{
+					SchedulingResult: &schedulingtypes.SchedulingResult{PrimaryProfileName: "decode"},
+				},
+			},
+			wantField: map[string]any{
+				metadata.SchedulingEndpointKey:  "1.2.3.4:8080",
+				metadata.SchedulingProfileKey:   "decode",
+				metadata.SchedulingPriorityKey:  float64(-1),
+				metadata.SchedulingQueueWaitKey: float64(1500),
+			},
+		},
+		{
+			name:   "request without a body routed to a random endpoint",
+			reqCtx: &RequestContext{TargetEndpoint: "1.2.3.4:8080"},
+			wantField: map[string]any{
+				metadata.SchedulingEndpointKey: "1.2.3.4:8080",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			server := &StreamingServer{}
+			tc.reqCtx.Request = &Request{Headers: make(map[string]string)}
+			tc.reqCtx.Response = &Response{}
+
+			resp := server.generateRequestHeaderResponse(context.Background(), tc.reqCtx)
+
+			scheduling := resp.GetDynamicMetadata().GetFields()[metadata.SchedulingMetadataNamespace].GetStructValue()
+			assert.Equal(t, tc.wantField, scheduling.AsMap())
+		})
+	}
+}
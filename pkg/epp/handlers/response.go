@@ -17,10 +17,13 @@ limitations under the License.
 package handlers
 
 import (
+	"bytes"
 	"context"
+	"time"
 
 	configPb "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	extProcPb "github.com/envoyproxy/go-control-plane/envoy/service/ext_proc/v3"
+	"github.com/go-logr/logr"
 	"google.golang.org/protobuf/types/known/structpb"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
@@ -47,6 +50,9 @@ func (s *StreamingServer) HandleResponseBody(ctx context.Context, reqCtx *Reques
 	logger.V(logutil.DEBUG).Info("HandleResponseBody is triggered", "len(responseBytes)", len(responseBytes), "endOfStream", endOfStream)
 
 	reqCtx.ResponseSize += len(responseBytes)
+	if len(responseBytes) > 0 && reqCtx.FirstResponseChunkTimestamp.IsZero() {
+		reqCtx.FirstResponseChunkTimestamp = time.Now()
+	}
 
 	parsedResp, err := s.parser.ParseResponse(ctx, responseBytes, reqCtx.Response.Headers, endOfStream)
 	if err != nil {
@@ -63,10 +69,96 @@ func (s *StreamingServer) HandleResponseBody(ctx context.Context, reqCtx *Reques
 		metrics.RecordNormalizedTimePerOutputToken(ctx, reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.RequestReceivedTimestamp, reqCtx.ResponseCompleteTimestamp, reqCtx.Usage.CompletionTokens)
 		metrics.RecordRequestLatencies(ctx, reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.RequestReceivedTimestamp, reqCtx.ResponseCompleteTimestamp)
 		metrics.RecordResponseSizes(reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.ResponseSize)
+		recordSLOAttainment(reqCtx)
 	}
 	return s.director.HandleResponseBody(ctx, reqCtx, endOfStream)
 }
 
+// recordAbandonedRequest records the work wasted on a request whose client disconnected before the response completed.
+// The output tokens are taken from the usage reported by the model server when available. Otherwise, they are estimated
+// from the number of streamed events, as model servers stream about one token per event. Tokens generated by the model
+// server after the disconnect, until it notices it, are not observed.
+func recordAbandonedRequest(logger logr.Logger, reqCtx *RequestContext) {
+	wastedOutputTokens := reqCtx.Usage.CompletionTokens
+	if wastedOutputTokens == 0 {
+		wastedOutputTokens = reqCtx.streamedEvents
+	}
+	metrics.RecordAbandonedRequest(reqCtx.IncomingModelName, reqCtx.TargetModelName, wastedOutputTokens)
+	logger.V(logutil.DEFAULT).Info("Client disconnected before the response completed", "endpoint", reqCtx.TargetEndpoint,
+		"wastedOutputTokens", wastedOutputTokens)
+}
+
+// recordStreamedEvents records the server-sent events of a streamed response chunk received at the given time. Model
+// servers stream about one token per event: the first event measures the time to first token, and the events of the
+// next chunks the cadence of the tokens, spread evenly since the previous event.
+func (s *StreamingServer) recordStreamedEvents(reqCtx *RequestContext, events int, now time.Time) {
+	if events == 0 {
+		return
+	}
+	reqCtx.streamedEvents += events
+	if reqCtx.FirstTokenTimestamp.IsZero() {
+		reqCtx.FirstTokenTimestamp = now
+		metrics.RecordRequestTimeToFirstToken(s.poolName(), reqCtx.IncomingModelName, reqCtx.TargetModelName,
+			now.Sub(reqCtx.RequestReceivedTimestamp))
+	} else {
+		metrics.RecordRequestInterTokenLatency(s.poolName(), reqCtx.IncomingModelName, reqCtx.TargetModelName,
+			now.Sub(reqCtx.LastTokenTimestamp)/time.Duration(events), events)
+	}
+	reqCtx.LastTokenTimestamp = now
+}
+
+// recordSLOAttainment records the latencies of a completed request against its objectives. The time to first token and
+// the time per output token are only measured for streamed responses.
+func recordSLOAttainment(reqCtx *RequestContext) {
+	latencies := metrics.RequestLatencies{
+		TPOT: reqCtx.InterTokenLatency(),
+		E2E:  reqCtx.ResponseCompleteTimestamp.Sub(reqCtx.RequestReceivedTimestamp),
+	}
+	if !reqCtx.FirstTokenTimestamp.IsZero() {
+		latencies.TTFT = reqCtx.FirstTokenTimestamp.Sub(reqCtx.RequestReceivedTimestamp)
+	}
+	objectives := metrics.RequestLatencyObjectives{}
+	if reqCtx.SchedulingRequest != nil {
+		objectives.TTFT = reqCtx.SchedulingRequest.Objectives.TTFT
+		objectives.TPOT = reqCtx.SchedulingRequest.Objectives.TPOT
+	}
+	metrics.RecordRequestSLOAttainment(reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.Priority, latencies,
+		objectives)
+}
+
+// InterTokenLatency returns the mean time between the token events of a streamed response, or zero if fewer than two
+// token events were received.
+func (r *RequestContext) InterTokenLatency() time.Duration {
+	if r.streamedEvents < 2 {
+		return 0
+	}
+	return r.LastTokenTimestamp.Sub(r.FirstTokenTimestamp) / time.Duration(r.streamedEvents-1)
+}
+
+// poolName returns the name of the inference pool served, empty if not known yet.
+func (s *StreamingServer) poolName() string {
+	if s.datastore == nil {
+		return ""
+	}
+	pool, err := s.datastore.PoolGet()
+	if err != nil {
+		return ""
+	}
+	return pool.Name
+}
+
+// countStreamedEvents returns the number of server-sent data events in the chunk, excluding the terminal [DONE] event.
+func countStreamedEvents(chunk []byte) int {
+	events := 0
+	for _, line := range bytes.Split(chunk, []byte("\n")) {
+		data, found := bytes.CutPrefix(bytes.TrimSpace(line), []byte("data:"))
+		if found && !bytes.Equal(bytes.TrimSpace(data), []byte("[DONE]")) {
+			events++
+		}
+	}
+	return events
+}
+
 func (s *StreamingServer) HandleResponseHeaders(ctx context.Context, reqCtx *RequestContext, resp *extProcPb.ProcessingRequest_ResponseHeaders) *RequestContext {
 	for _, header := range resp.ResponseHeaders.Headers.Headers {
 		reqCtx.Response.Headers[header.Key] = envoy.GetHeaderValue(header)
@@ -74,6 +166,20 @@ func (s *StreamingServer) HandleResponseHeaders(ctx context.Context, reqCtx *Req
 	return s.director.HandleResponseHeader(ctx, reqCtx)
 }
 
+// HandleResponseTrailers records the response trailers, e.g. the load reports of the model servers, for the
+// PostResponse plugins.
+func (s *StreamingServer) HandleResponseTrailers(reqCtx *RequestContext, resp *extProcPb.ProcessingRequest_ResponseTrailers) {
+	if resp.ResponseTrailers == nil || resp.ResponseTrailers.Trailers == nil {
+		return
+	}
+	if reqCtx.Response.Trailers == nil {
+		reqCtx.Response.Trailers = make(map[string]string, len(resp.ResponseTrailers.Trailers.Headers))
+	}
+	for _, header := range resp.ResponseTrailers.Trailers.Headers {
+		reqCtx.Response.Trailers[header.Key] = envoy.GetHeaderValue(header)
+	}
+}
+
 func (s *StreamingServer) generateResponseHeaderResponse(reqCtx *RequestContext) *extProcPb.ProcessingResponse {
 	return &extProcPb.ProcessingResponse{
 		Response: &extProcPb.ProcessingResponse_ResponseHeaders{
@@ -112,13 +218,8 @@ func generateResponseBodyResponses(responseBodyBytes []byte, setEoS bool, dynami
 func (s *StreamingServer) generateResponseHeaders(reqCtx *RequestContext) []*configPb.HeaderValueOption {
 	// can likely refactor these two bespoke headers to be updated in PostDispatch, to centralize logic.
 	headers := []*configPb.HeaderValueOption{
-		{
-			Header: &configPb.HeaderValue{
-				// This is for debugging purpose only.
-				Key:      "x-went-into-resp-headers",
-				RawValue: []byte("true"),
-			},
-		},
+		// This is for debugging purpose only.
+		s.gatewayProvider().SetHeader("x-went-into-resp-headers", "true"),
 	}
 
 	// Include any non-system-owned headers.
@@ -126,12 +227,7 @@ func (s *StreamingServer) generateResponseHeaders(reqCtx *RequestContext) []*con
 		if request.IsSystemOwnedHeader(key) {
 			continue
 		}
-		headers = append(headers, &configPb.HeaderValueOption{
-			Header: &configPb.HeaderValue{
-				Key:      key,
-				RawValue: []byte(value),
-			},
-		})
+		headers = append(headers, s.gatewayProvider().SetHeader(key, value))
 	}
 	return headers
 }
@@ -19,7 +19,10 @@ package handlers
 import (
 	"context"
 	"testing"
+	"time"
 
+	configPb "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	extProcPb "github.com/envoyproxy/go-control-plane/envoy/service/ext_proc/v3"
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
 
@@ -363,6 +366,21 @@ func TestGenerateResponseHeaders_Sanitization(t *testing.T) {
 	assert.NotContains(t, gotHeaders, "content-length")
 }
 
+func TestHandleResponseTrailers(t *testing.T) {
+	server := &StreamingServer{}
+	reqCtx := &RequestContext{Response: &Response{Headers: map[string]string{"content-type": "application/grpc"}}}
+
+	server.HandleResponseTrailers(reqCtx, &extProcPb.ProcessingRequest_ResponseTrailers{
+		ResponseTrailers: &extProcPb.HttpTrailers{Trailers: &configPb.HeaderMap{Headers: []*configPb.HeaderValue{
+			{Key: "grpc-status", RawValue: []byte("0")},
+			{Key: "endpoint-load-metrics", RawValue: []byte("TEXT cpu_utilization=0.3")},
+		}}},
+	})
+
+	assert.Equal(t, map[string]string{"grpc-status": "0", "endpoint-load-metrics": "TEXT cpu_utilization=0.3"}, reqCtx.Response.Trailers)
+	assert.Equal(t, map[string]string{"content-type": "application/grpc"}, reqCtx.Response.Headers)
+}
+
 func TestRewriteModelName(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -486,3 +504,41 @@ func TestResponseSizeAccumulation(t *testing.T) {
 		})
 	}
 }
+
+func TestCountStreamedEvents(t *testing.T) {
+	tests := []struct {
+		name  string
+		chunk string
+		want  int
+	}{
+		{name: "empty chunk", chunk: "", want: 0},
+		{name: "single event", chunk: "data: {\"choices\":[{\"text\":\"a\"}]}\n\n", want: 1},
+		{name: "multiple events", chunk: "data: {\"choices\":[{\"text\":\"a\"}]}\n\ndata: {\"choices\":[{\"text\":\"b\"}]}\n\n", want: 2},
+		{name: "done event is not counted", chunk: "data: {\"choices\":[{\"text\":\"a\"}]}\n\ndata: [DONE]\n\n", want: 1},
+		{name: "non data lines are not counted", chunk: ": keep-alive\nevent: message\n", want: 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, countStreamedEvents([]byte(test.chunk)))
+		})
+	}
+}
+
+func TestRecordStreamedEvents(t *testing.T) {
+	server := &StreamingServer{}
+	received := time.Now()
+	reqCtx := &RequestContext{RequestReceivedTimestamp: received}
+
+	server.recordStreamedEvents(reqCtx, 0, received.Add(50*time.Millisecond))
+	assert.True(t, reqCtx.FirstTokenTimestamp.IsZero(), "chunks without events should not carry the first token")
+
+	server.recordStreamedEvents(reqCtx, 1, received.Add(200*time.Millisecond))
+	server.recordStreamedEvents(reqCtx, 2, received.Add(240*time.Millisecond))
+	server.recordStreamedEvents(reqCtx, 1, received.Add(260*time.Millisecond))
+
+	assert.Equal(t, received.Add(200*time.Millisecond), reqCtx.FirstTokenTimestamp)
+	assert.Equal(t, received.Add(260*time.Millisecond), reqCtx.LastTokenTimestamp)
+	assert.Equal(t, 4, reqCtx.streamedEvents)
+	assert.Equal(t, 20*time.Millisecond, reqCtx.InterTokenLatency())
+	assert.Zero(t, (&RequestContext{streamedEvents: 1}).InterTokenLatency(), "a single token has no cadence")
+}
@@ -21,10 +21,10 @@ import (
 	"context"
 	"io"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	extProcPb "github.com/envoyproxy/go-control-plane/envoy/service/ext_proc/v3"
-	envoyTypePb "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/go-logr/logr"
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
@@ -40,10 +40,13 @@ import (
 	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
 	reqcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/request"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/decisioncompare"
 	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
 	fwkrh "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
 	schedulingtypes "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/gatewayprovider"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/wirecapture"
 	"sigs.k8s.io/gateway-api-inference-extension/version"
 )
 
@@ -63,11 +66,44 @@ func NewStreamingServer(datastore Datastore, director Director, parser fwkrh.Par
 	}
 }
 
+// SetGatewayProvider sets the gateway implementation the server exchanges with.
+func (s *StreamingServer) SetGatewayProvider(provider gatewayprovider.Provider) {
+	s.provider = provider
+}
+
+// gatewayProvider returns the gateway provider of the server, the default one if none was set.
+func (s *StreamingServer) gatewayProvider() gatewayprovider.Provider {
+	if s.provider == nil {
+		return gatewayprovider.Default()
+	}
+	return s.provider
+}
+
 // SetEvictChannelLookup sets the eviction channel lookup for eviction support.
 func (s *StreamingServer) SetEvictChannelLookup(lookup EvictChannelLookup) {
 	s.evictionLookup = lookup
 }
 
+// SetDecisionComparer sets the comparer of scheduling decisions, for EPPs running as a canary in decision compare mode.
+func (s *StreamingServer) SetDecisionComparer(comparer *decisioncompare.Comparer) {
+	s.decisionComparer = comparer
+}
+
+// SetRequestBodyConfig sets how the request bodies are accumulated and the size from which they are rejected.
+func (s *StreamingServer) SetRequestBodyConfig(config RequestBodyConfig) {
+	s.requestBodyConfig = config
+}
+
+// SetFairnessIDSource sets where the fairness ID of the requests is read from.
+func (s *StreamingServer) SetFairnessIDSource(source FairnessIDSource) {
+	s.fairnessIDSource = source
+}
+
+// SetWireCapturer sets the capturer recording the ext-proc messages of a sampled fraction of the streams.
+func (s *StreamingServer) SetWireCapturer(capturer *wirecapture.Capturer) {
+	s.wireCapturer = capturer
+}
+
 type Director interface {
 	HandleRequest(ctx context.Context, reqCtx *RequestContext, inferenceRequestBody *fwkrh.InferenceRequestBody) (*RequestContext, error)
 	HandleResponseHeader(ctx context.Context, reqCtx *RequestContext) *RequestContext
@@ -86,6 +122,18 @@ type StreamingServer struct {
 	director       Director
 	parser         fwkrh.Parser
 	evictionLookup EvictChannelLookup // optional, set for eviction support
+	// decisionComparer is optional, set when running as a canary comparing its decisions against the active EPP.
+	decisionComparer *decisioncompare.Comparer
+	// wireCapturer is optional, set when the ext-proc messages of sampled streams are captured for debugging.
+	wireCapturer *wirecapture.Capturer
+	// provider captures the quirks of the gateway implementation, the default one if nil.
+	provider gatewayprovider.Provider
+	// requestBodyConfig is how the request bodies are accumulated, buffered without a size limit if zero.
+	requestBodyConfig RequestBodyConfig
+	// fairnessIDSource is where the fairness ID of the requests is read from, the fairness ID header if zero.
+	fairnessIDSource FairnessIDSource
+	// bodyModesWarned is set once the body modes reported by the gateway were found to differ from the provider's.
+	bodyModesWarned atomic.Bool
 }
 
 // RequestContext stores context information during the life time of an HTTP request.
@@ -96,26 +144,41 @@ type StreamingServer struct {
 type RequestContext struct {
 	TargetPod                 *fwkdl.EndpointMetadata
 	TargetEndpoint            string
+	TargetPods                []*fwkdl.EndpointMetadata
+	DowngradedTo              string
 	IncomingModelName         string
 	TargetModelName           string
 	FairnessID                string
+	RequestFamilyID           string
 	ObjectiveKey              string
 	Priority                  int
+	MaxQueueWait              time.Duration
+	QueueWait                 time.Duration
 	RequestReceivedTimestamp  time.Time
 	ResponseCompleteTimestamp time.Time
-	RequestSize               int
-	Usage                     fwkrh.Usage
-	ResponseSize              int
-	ResponseBodyStarted       bool
-	ResponseComplete          bool
-	ResponseStatusCode        string
-	RequestRunning            bool
-	Request                   *Request
+	// FirstResponseChunkTimestamp is the time at which the first chunk of the response body was received.
+	FirstResponseChunkTimestamp time.Time
+	RequestSize                 int
+	Usage                       fwkrh.Usage
+	ResponseSize                int
+	ResponseBodyStarted         bool
+	ResponseComplete            bool
+	Abandoned                   bool // the client disconnected before the response completed
+	Spilled                     bool // the request was forwarded to a peer or secondary pool instead of being scheduled
+	ResponseStatusCode          string
+	RequestRunning              bool
+	Request                     *Request
 
 	SchedulingRequest *schedulingtypes.InferenceRequest
 
 	RequestState         StreamRequestState
 	modelServerStreaming bool
+	// streamedEvents is the number of server-sent events received in a streamed response.
+	streamedEvents int
+	// FirstTokenTimestamp and LastTokenTimestamp are the times at which the first and last server-sent events of a
+	// streamed response were received.
+	FirstTokenTimestamp time.Time
+	LastTokenTimestamp  time.Time
 
 	Response *Response
 
@@ -132,9 +195,12 @@ type Request struct {
 	Headers  map[string]string
 	RawBody  []byte // This field will be updated when request body is modified (e.g. model mutation in requestBody)
 	Metadata map[string]any
+	// RemovedHeaders are the headers removed from the request forwarded to the model server.
+	RemovedHeaders []string
 }
 type Response struct {
 	Headers         map[string]string
+	Trailers        map[string]string
 	DynamicMetadata *structpb.Struct
 }
 type StreamRequestState int
@@ -160,9 +226,14 @@ type recvResult struct {
 }
 
 func (s *StreamingServer) Process(srv extProcPb.ExternalProcessor_ProcessServer) error {
+	if s.wireCapturer != nil {
+		srv = s.wireCapturer.Wrap(srv)
+		defer s.wireCapturer.Finish(srv)
+	}
 	ctx := srv.Context()
 
-	// Start tracing span for the request
+	// The tracing span of the request is started once its headers are received, so that it continues the trace
+	// propagated by the gateway, if any.
 	tracer := otel.Tracer(
 		"gateway-api-inference-extension/epp/extproc",
 		trace.WithInstrumentationVersion(version.BuildRef),
@@ -170,8 +241,12 @@ func (s *StreamingServer) Process(srv extProcPb.ExternalProcessor_ProcessServer)
 			attribute.String("commit-sha", version.CommitSHA),
 		),
 	)
-	ctx, span := tracer.Start(ctx, "gateway.request", trace.WithSpanKind(trace.SpanKindServer))
-	defer span.End()
+	var span trace.Span
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
 
 	logger := log.FromContext(ctx)
 	loggerTrace := logger.V(logutil.TRACE)
@@ -191,7 +266,10 @@ func (s *StreamingServer) Process(srv extProcPb.ExternalProcessor_ProcessServer)
 	}
 
 	var body []byte
+	reqBody := requestBody{config: s.requestBodyConfig}
 	var evictionRequestID string
+	// clientDisconnected is set when the stream is closed by Envoy, which happens when the client goes away.
+	var clientDisconnected bool
 
 	// Start a single reader goroutine for the lifetime of the stream.
 	// This avoids spawning a new goroutine per message and allows the main loop to
@@ -240,6 +318,10 @@ func (s *StreamingServer) Process(srv extProcPb.ExternalProcessor_ProcessServer)
 		// If we scheduled a pod (TargetPod != nil) but never marked the response  as complete (e.g. error, disconnect,
 		// panic), force the completion hooks to run.
 		if reqCtx.TargetPod != nil && !reqCtx.ResponseComplete {
+			if clientDisconnected {
+				reqCtx.Abandoned = true
+				recordAbandonedRequest(logger, reqCtx)
+			}
 			// Use a fresh context as the request context might be canceled (Client Disconnect).
 			// We only need logging from the original context.
 			cleanupCtx := log.IntoContext(context.Background(), logger)
@@ -274,10 +356,12 @@ func (s *StreamingServer) Process(srv extProcPb.ExternalProcessor_ProcessServer)
 			}
 			return nil
 		case <-ctx.Done():
+			clientDisconnected = true
 			return ctx.Err()
 		}
 
 		if recvErr == io.EOF || status.Code(recvErr) == codes.Canceled {
+			clientDisconnected = true
 			return nil
 		}
 		if recvErr != nil {
@@ -285,6 +369,9 @@ func (s *StreamingServer) Process(srv extProcPb.ExternalProcessor_ProcessServer)
 		}
 
 		reqCtx.Request.Metadata = envoy.ExtractMetadataValues(req)
+		if protocolConfig := req.GetProtocolConfig(); protocolConfig != nil {
+			s.checkBodyModes(logger, protocolConfig)
+		}
 
 		switch v := req.Request.(type) {
 		case *extProcPb.ProcessingRequest_RequestHeaders:
@@ -296,25 +383,34 @@ func (s *StreamingServer) Process(srv extProcPb.ExternalProcessor_ProcessServer)
 				loggerTrace.Info("RequestID header is not found in the request, generated a request id")
 				reqCtx.Request.Headers[reqcommon.RequestIdHeaderKey] = requestID // update in headers so director can consume it
 			}
+			ctx = otel.GetTextMapPropagator().Extract(ctx, envoy.RequestHeadersCarrier{Req: v})
+			ctx, span = tracer.Start(ctx, "gateway.request", trace.WithSpanKind(trace.SpanKindServer))
+			span.SetAttributes(attribute.String("request_id", requestID))
 			logger = logger.WithValues(reqcommon.RequestIdHeaderKey, requestID)
 			logger.V(logutil.DEFAULT).Info("EPP received request") // Request ID will be logged too as part of logger context values.
 			loggerTrace = logger.V(logutil.TRACE)
 			ctx = log.IntoContext(ctx, logger)
 
 			err = s.HandleRequestHeaders(ctx, reqCtx, v)
+			if err == nil {
+				// Reject a body announced too large before receiving any of it.
+				err = reqBody.checkContentLength(reqCtx.Request.Headers["content-length"])
+			}
 		case *extProcPb.ProcessingRequest_RequestBody:
 			loggerTrace.Info("Incoming body chunk", "EoS", v.RequestBody.EndOfStream)
 			// In the stream case, we can receive multiple request bodies.
-			body = append(body, v.RequestBody.Body...)
+			if err = reqBody.add(v.RequestBody.Body); err != nil {
+				logger.Error(err, "Request body too large")
+				break
+			}
 
 			// Message is buffered, we can read and decode.
 			if v.RequestBody.EndOfStream {
 				loggerTrace.Info("decoding")
-				reqCtx.Request.RawBody = body
+				reqCtx.Request.RawBody = reqBody.take()
 
 				// Body stream complete. Capture raw size for flow control.
-				reqCtx.RequestSize = len(body)
-				body = []byte{}
+				reqCtx.RequestSize = len(reqCtx.Request.RawBody)
 
 				inferenceRequestBody, parseErr := s.parser.ParseRequest(ctx, reqCtx.Request.RawBody, reqCtx.Request.Headers)
 				if parseErr != nil {
@@ -324,6 +420,7 @@ func (s *StreamingServer) Process(srv extProcPb.ExternalProcessor_ProcessServer)
 				}
 
 				reqCtx, err = s.director.HandleRequest(ctx, reqCtx, inferenceRequestBody)
+				s.compareDecision(ctx, reqCtx, err)
 				if err != nil {
 					logger.Error(err, "Error handling request")
 					break
@@ -350,7 +447,7 @@ func (s *StreamingServer) Process(srv extProcPb.ExternalProcessor_ProcessServer)
 			// This is currently unused.
 		case *extProcPb.ProcessingRequest_ResponseHeaders:
 			for _, header := range v.ResponseHeaders.Headers.GetHeaders() {
-				value := string(header.RawValue)
+				value := envoy.GetHeaderValue(header)
 				loggerTrace.Info("header", "key", header.Key, "value", value)
 				if header.Key == "status" && value != "200" {
 					reqCtx.ResponseStatusCode = errcommon.ModelServerError
@@ -368,6 +465,7 @@ func (s *StreamingServer) Process(srv extProcPb.ExternalProcessor_ProcessServer)
 			chunk := v.ResponseBody.Body
 
 			if reqCtx.modelServerStreaming {
+				s.recordStreamedEvents(reqCtx, countStreamedEvents(chunk), time.Now())
 				if endOfStream {
 					reqCtx.ResponseComplete = true
 					reqCtx.ResponseCompleteTimestamp = time.Now()
@@ -387,6 +485,7 @@ func (s *StreamingServer) Process(srv extProcPb.ExternalProcessor_ProcessServer)
 			// For HTTP, the response trailer is not sent. Thus, this case will not be triggered.
 			// For gRPC(over HTTP2), the protocol relies on responseTrialers to determine whether a response is complete.
 			// More info: https://chromium.googlesource.com/external/github.com/grpc/grpc/+/HEAD/doc/PROTOCOL-HTTP2.md#responses
+			s.HandleResponseTrailers(reqCtx, v)
 			s.finishResponse(ctx, reqCtx, body, reqCtx.modelServerStreaming, false)
 			reqCtx.respTrailerResp = &extProcPb.ProcessingResponse{
 				Response: &extProcPb.ProcessingResponse_ResponseTrailers{
@@ -419,6 +518,20 @@ func (s *StreamingServer) Process(srv extProcPb.ExternalProcessor_ProcessServer)
 	}
 }
 
+// checkBodyModes warns, once, when the body modes the gateway reports in the first message of a stream are not the
+// body mode of its provider, which the framing of the body responses follows.
+func (s *StreamingServer) checkBodyModes(logger logr.Logger, config *extProcPb.ProtocolConfiguration) {
+	provider := s.gatewayProvider()
+	expected := provider.BodyMode()
+	if config.GetRequestBodyMode() == expected && config.GetResponseBodyMode() == expected ||
+		!s.bodyModesWarned.CompareAndSwap(false, true) {
+		return
+	}
+	logger.V(logutil.DEFAULT).Info("Gateway body modes differ from those of the gateway provider, bodies may be mishandled",
+		"provider", provider.Name(), "expected", expected, "requestBodyMode", config.GetRequestBodyMode(),
+		"responseBodyMode", config.GetResponseBodyMode())
+}
+
 // finishResponse ensures all post-response logic, such as metric recording
 // and state updates, is executed exactly once for the request lifecycle.
 func (s *StreamingServer) finishResponse(ctx context.Context, reqCtx *RequestContext, body []byte, modelStreaming bool, setEos bool) {
@@ -467,16 +580,12 @@ func (r *RequestContext) updateStateAndSendIfNeeded(srv extProcPb.ExternalProces
 	// Handle eviction — send ImmediateResponse(429) to Envoy to reset the upstream connection.
 	if r.RequestState == RequestEvicted {
 		loggerTrace.Info("Sending ImmediateResponse for evicted request")
-		return srv.Send(&extProcPb.ProcessingResponse{
-			Response: &extProcPb.ProcessingResponse_ImmediateResponse{
-				ImmediateResponse: &extProcPb.ImmediateResponse{
-					Status: &envoyTypePb.HttpStatus{
-						Code: envoyTypePb.StatusCode_TooManyRequests,
-					},
-					Body: []byte("request evicted by flow control"),
-				},
-			},
-		})
+		resp, err := errcommon.BuildErrResponse(errcommon.Error{Code: errcommon.ResourceExhausted,
+			Msg: "request evicted by flow control"})
+		if err != nil {
+			return err
+		}
+		return srv.Send(resp)
 	}
 
 	// No switch statement as we could send multiple responses in one pass.
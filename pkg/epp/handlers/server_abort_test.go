@@ -63,7 +63,8 @@ func TestUpdateStateAndSendIfNeeded_Evicted(t *testing.T) {
 	ir := srv.sentResponses[0].GetImmediateResponse()
 	require.NotNil(t, ir, "Response should be an ImmediateResponse")
 	assert.Equal(t, envoyTypePb.StatusCode_TooManyRequests, ir.Status.Code)
-	assert.Equal(t, []byte("request evicted by flow control"), ir.Body)
+	assert.JSONEq(t, `{"error":{"message":"request evicted by flow control","type":"rate_limit_error","param":null,`+
+		`"code":"rate_limit_exceeded"}}`, string(ir.Body))
 }
 
 func TestUpdateStateAndSendIfNeeded_NotEvicted(t *testing.T) {
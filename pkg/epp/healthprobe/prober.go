@@ -0,0 +1,233 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package healthprobe implements active health probing of the endpoints.
+//
+// Kubernetes readiness takes several periods of the kubelet probe to take a crashed or hung model server out of the
+// pool, while the EPP keeps routing to it. The Prober probes the health endpoint of each model server itself, at a
+// shorter interval, and excludes the endpoints failing consecutive probes from scheduling until they recover.
+package healthprobe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+const (
+	// DefaultPath is the default path of the health endpoint of the model servers.
+	DefaultPath = "/health"
+	// DefaultInterval is the default interval between two probes of an endpoint.
+	DefaultInterval = 2 * time.Second
+	// DefaultTimeout is the default timeout of a probe.
+	DefaultTimeout = time.Second
+	// DefaultFailureThreshold is the default number of consecutive failed probes after which an endpoint is unhealthy.
+	DefaultFailureThreshold = 2
+	// DefaultSuccessThreshold is the default number of consecutive successful probes after which an unhealthy endpoint
+	// is healthy again.
+	DefaultSuccessThreshold = 1
+)
+
+// Config configures the Prober.
+type Config struct {
+	// Path is the path of the health endpoint, e.g. /health or /v1/models, probed on the port of each endpoint.
+	Path string
+	// Interval is the interval between two probes of an endpoint.
+	Interval time.Duration
+	// Timeout is the timeout of a probe.
+	Timeout time.Duration
+	// FailureThreshold is the number of consecutive failed probes after which an endpoint is unhealthy.
+	FailureThreshold int
+	// SuccessThreshold is the number of consecutive successful probes after which an unhealthy endpoint is healthy
+	// again.
+	SuccessThreshold int
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c Config) Validate() error {
+	if c.Path == "" || c.Path[0] != '/' {
+		return fmt.Errorf("path must start with '/', got %q", c.Path)
+	}
+	if c.Interval <= 0 {
+		return errors.New("interval must be positive")
+	}
+	if c.Timeout <= 0 || c.Timeout > c.Interval {
+		return fmt.Errorf("timeout must be positive and at most the interval %s, got %s", c.Interval, c.Timeout)
+	}
+	if c.FailureThreshold < 1 {
+		return fmt.Errorf("failure threshold must be at least 1, got %d", c.FailureThreshold)
+	}
+	if c.SuccessThreshold < 1 {
+		return fmt.Errorf("success threshold must be at least 1, got %d", c.SuccessThreshold)
+	}
+	return nil
+}
+
+// EndpointLister lists the endpoints to probe.
+type EndpointLister interface {
+	PodList(predicate func(fwkdl.Endpoint) bool) []fwkdl.Endpoint
+}
+
+// probeState is the probing state of an endpoint.
+type probeState struct {
+	// failures and successes are the numbers of consecutive failed and successful probes.
+	failures  int
+	successes int
+	unhealthy bool
+}
+
+// Prober periodically probes the health endpoint of every endpoint of the pool, and reports the endpoints failing
+// FailureThreshold consecutive probes as excluded from scheduling until they pass SuccessThreshold consecutive probes.
+// It is safe for concurrent use.
+type Prober struct {
+	config    Config
+	endpoints EndpointLister
+	client    *http.Client
+
+	mu     sync.RWMutex
+	states map[types.NamespacedName]*probeState
+}
+
+// NewProber creates a new Prober of the endpoints listed by the given lister.
+func NewProber(endpoints EndpointLister, config Config) (*Prober, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return &Prober{
+		config:    config,
+		endpoints: endpoints,
+		client:    &http.Client{Timeout: config.Timeout},
+		states:    map[types.NamespacedName]*probeState{},
+	}, nil
+}
+
+// IsExcluded returns true if the endpoint is unhealthy.
+func (p *Prober) IsExcluded(endpoint *fwkdl.EndpointMetadata) bool {
+	if endpoint == nil {
+		return false
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	state, ok := p.states[endpoint.NamespacedName]
+	return ok && state.unhealthy
+}
+
+// Run periodically probes the endpoints until the context is cancelled.
+func (p *Prober) Run(ctx context.Context) {
+	logger := log.FromContext(ctx).WithName("health-probe")
+	ticker := time.NewTicker(p.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.V(logutil.DEFAULT).Info("Shutting down endpoint health probing")
+			return
+		case <-ticker.C:
+			p.probeAll(log.IntoContext(ctx, logger))
+		}
+	}
+}
+
+// probeAll probes all the endpoints concurrently, updates their state and forgets the endpoints that are gone.
+func (p *Prober) probeAll(ctx context.Context) {
+	endpoints := p.endpoints.PodList(func(fwkdl.Endpoint) bool { return true })
+	results := make([]error, len(endpoints))
+	var wg sync.WaitGroup
+	for i, endpoint := range endpoints {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = p.probe(ctx, endpoint.GetMetadata())
+		}()
+	}
+	wg.Wait()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	seen := make(map[types.NamespacedName]bool, len(endpoints))
+	for i, endpoint := range endpoints {
+		name := endpoint.GetMetadata().NamespacedName
+		seen[name] = true
+		p.update(ctx, name, results[i])
+	}
+	for name, state := range p.states {
+		if !seen[name] {
+			delete(p.states, name)
+			if state.unhealthy {
+				metrics.RecordEndpointProbeHealthy(name.Namespace, name.Name)
+			}
+		}
+	}
+}
+
+// update records the result of a probe of the given endpoint. It must be called with the lock held.
+func (p *Prober) update(ctx context.Context, name types.NamespacedName, err error) {
+	logger := log.FromContext(ctx)
+	state, ok := p.states[name]
+	if !ok {
+		state = &probeState{}
+		p.states[name] = state
+	}
+	if err != nil {
+		state.failures++
+		state.successes = 0
+		if !state.unhealthy && state.failures >= p.config.FailureThreshold {
+			state.unhealthy = true
+			metrics.RecordEndpointProbeUnhealthy(name.Namespace, name.Name)
+			logger.V(logutil.DEFAULT).Info("Endpoint failed health probes, excluding it from scheduling", "endpoint", name,
+				"failures", state.failures, "error", err.Error())
+		}
+		return
+	}
+	state.successes++
+	state.failures = 0
+	if state.unhealthy && state.successes >= p.config.SuccessThreshold {
+		state.unhealthy = false
+		metrics.RecordEndpointProbeHealthy(name.Namespace, name.Name)
+		logger.V(logutil.DEFAULT).Info("Endpoint passed health probes again, including it in scheduling", "endpoint", name)
+	}
+}
+
+// probe returns an error if the health endpoint of the given endpoint cannot be reached or does not answer with a 2xx
+// status.
+func (p *Prober) probe(ctx context.Context, endpoint *fwkdl.EndpointMetadata) error {
+	url := "http://" + net.JoinHostPort(endpoint.Address, endpoint.Port) + p.config.Path
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("health endpoint %s returned status %d", url, resp.StatusCode)
+	}
+	return nil
+}
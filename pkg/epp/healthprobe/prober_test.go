@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthprobe
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+type staticLister []fwkdl.Endpoint
+
+func (l staticLister) PodList(predicate func(fwkdl.Endpoint) bool) []fwkdl.Endpoint {
+	var res []fwkdl.Endpoint
+	for _, endpoint := range l {
+		if predicate(endpoint) {
+			res = append(res, endpoint)
+		}
+	}
+	return res
+}
+
+// newEndpoint returns an endpoint served by the given server.
+func newEndpoint(t *testing.T, name string, server *httptest.Server) fwkdl.Endpoint {
+	host, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+	return fwkdl.NewEndpoint(&fwkdl.EndpointMetadata{
+		NamespacedName: types.NamespacedName{Namespace: "default", Name: name},
+		PodName:        name,
+		Address:        host,
+		Port:           port,
+	}, nil)
+}
+
+func testConfig() Config {
+	return Config{
+		Path:             DefaultPath,
+		Interval:         DefaultInterval,
+		Timeout:          DefaultTimeout,
+		FailureThreshold: 2,
+		SuccessThreshold: 2,
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*Config)
+		wantErr bool
+	}{
+		{name: "valid", modify: func(*Config) {}},
+		{name: "path without leading slash", modify: func(c *Config) { c.Path = "health" }, wantErr: true},
+		{name: "zero interval", modify: func(c *Config) { c.Interval = 0 }, wantErr: true},
+		{name: "timeout above interval", modify: func(c *Config) { c.Timeout = 3 * c.Interval }, wantErr: true},
+		{name: "zero failure threshold", modify: func(c *Config) { c.FailureThreshold = 0 }, wantErr: true},
+		{name: "zero success threshold", modify: func(c *Config) { c.SuccessThreshold = 0 }, wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			config := testConfig()
+			tc.modify(&config)
+			err := config.Validate()
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestProber(t *testing.T) {
+	var healthy atomic.Bool
+	healthy.Store(true)
+	flaky := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, DefaultPath, r.URL.Path)
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer flaky.Close()
+	stable := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer stable.Close()
+
+	flakyEndpoint := newEndpoint(t, "flaky", flaky)
+	stableEndpoint := newEndpoint(t, "stable", stable)
+	lister := staticLister{flakyEndpoint, stableEndpoint}
+	prober, err := NewProber(lister, testConfig())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	prober.probeAll(ctx)
+	assert.False(t, prober.IsExcluded(flakyEndpoint.GetMetadata()), "healthy endpoint")
+
+	healthy.Store(false)
+	prober.probeAll(ctx)
+	assert.False(t, prober.IsExcluded(flakyEndpoint.GetMetadata()), "below the failure threshold")
+	prober.probeAll(ctx)
+	assert.True(t, prober.IsExcluded(flakyEndpoint.GetMetadata()), "at the failure threshold")
+	assert.False(t, prober.IsExcluded(stableEndpoint.GetMetadata()), "other endpoints are not affected")
+
+	healthy.Store(true)
+	prober.probeAll(ctx)
+	assert.True(t, prober.IsExcluded(flakyEndpoint.GetMetadata()), "below the success threshold")
+	prober.probeAll(ctx)
+	assert.False(t, prober.IsExcluded(flakyEndpoint.GetMetadata()), "at the success threshold")
+}
+
+func TestProberUnreachableEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	endpoint := newEndpoint(t, "gone", server)
+	server.Close()
+
+	config := testConfig()
+	config.FailureThreshold = 1
+	config.Timeout = 100 * time.Millisecond
+	prober, err := NewProber(staticLister{endpoint}, config)
+	require.NoError(t, err)
+
+	prober.probeAll(context.Background())
+	assert.True(t, prober.IsExcluded(endpoint.GetMetadata()))
+
+	// Endpoints removed from the pool are forgotten.
+	prober.endpoints = staticLister{}
+	prober.probeAll(context.Background())
+	assert.Empty(t, prober.states)
+	assert.False(t, prober.IsExcluded(endpoint.GetMetadata()))
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package loadhints receives the load hints that model servers push ahead of imminent changes of their load, e.g. a
+// planned restart, and exposes them to the scheduling plugins as an endpoint attribute, reducing the reaction lag of
+// the metrics scraped periodically.
+package loadhints
+
+import (
+	"slices"
+	"time"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// HintsAttributeKey is the endpoint attribute holding the load hints of the endpoint.
+const HintsAttributeKey = "load-hints"
+
+// Kind is the kind of a load hint.
+type Kind string
+
+const (
+	// KindCompactionImminent hints that the model server is about to compact or defragment its KV cache.
+	KindCompactionImminent Kind = "compaction-imminent"
+	// KindAdapterLoading hints that the model server started loading a LoRA adapter.
+	KindAdapterLoading Kind = "adapter-loading"
+	// KindRestartPlanned hints that the model server is about to restart.
+	KindRestartPlanned Kind = "restart-planned"
+)
+
+// Kinds are the kinds of load hints.
+var Kinds = []Kind{KindCompactionImminent, KindAdapterLoading, KindRestartPlanned}
+
+// Hint is a load hint of an endpoint.
+type Hint struct {
+	Kind Kind
+	// Adapter is the adapter being loaded, for KindAdapterLoading hints.
+	Adapter string
+	// Expiry is the time until which the hint holds.
+	Expiry time.Time
+}
+
+// Hints are the load hints of an endpoint.
+type Hints struct {
+	hints []Hint
+}
+
+var _ fwkdl.Cloneable = &Hints{}
+
+// Clone returns a copy of the hints.
+func (h *Hints) Clone() fwkdl.Cloneable {
+	return &Hints{hints: slices.Clone(h.hints)}
+}
+
+// Active returns the hints holding at the given time.
+func (h *Hints) Active(now time.Time) []Hint {
+	active := []Hint{}
+	for _, hint := range h.hints {
+		if now.Before(hint.Expiry) {
+			active = append(active, hint)
+		}
+	}
+	return active
+}
+
+// Has returns whether a hint of the given kind holds at the given time. For KindAdapterLoading, a non-empty adapter
+// restricts the match to the hints of that adapter.
+func (h *Hints) Has(kind Kind, adapter string, now time.Time) bool {
+	for _, hint := range h.Active(now) {
+		if hint.Kind == kind && (adapter == "" || hint.Adapter == adapter) {
+			return true
+		}
+	}
+	return false
+}
+
+// update returns the hints with the given hint, replacing the hint of the same kind and adapter, and without the
+// expired hints. A hint expiring before the given time clears the hint it replaces.
+func (h *Hints) update(hint Hint, now time.Time) *Hints {
+	updated := &Hints{hints: slices.DeleteFunc(h.Active(now), func(existing Hint) bool {
+		return existing.Kind == hint.Kind && existing.Adapter == hint.Adapter
+	})}
+	if now.Before(hint.Expiry) {
+		updated.hints = append(updated.hints, hint)
+	}
+	return updated
+}
+
+// FromEndpoint returns the load hints of the given endpoint attributes, empty if none were pushed.
+func FromEndpoint(attributes interface {
+	Get(string) (fwkdl.Cloneable, bool)
+}) *Hints {
+	if value, ok := attributes.Get(HintsAttributeKey); ok {
+		if hints, ok := value.(*Hints); ok {
+			return hints
+		}
+	}
+	return &Hints{}
+}
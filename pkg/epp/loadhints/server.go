@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadhints
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/loadhints/api/gen"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+// DefaultMaxDuration is the default maximum duration of a load hint.
+const DefaultMaxDuration = 5 * time.Minute
+
+var kinds = map[gen.HintKind]Kind{
+	gen.HintKind_HINT_KIND_COMPACTION_IMMINENT: KindCompactionImminent,
+	gen.HintKind_HINT_KIND_ADAPTER_LOADING:     KindAdapterLoading,
+	gen.HintKind_HINT_KIND_RESTART_PLANNED:     KindRestartPlanned,
+}
+
+// Datastore lists the endpoints of the pool.
+type Datastore interface {
+	PodList(predicate func(fwkdl.Endpoint) bool) []fwkdl.Endpoint
+}
+
+// Server implements the LoadHints gRPC service, recording the pushed hints on the endpoints of the pool.
+type Server struct {
+	gen.UnimplementedLoadHintsServer
+
+	datastore   Datastore
+	maxDuration time.Duration
+	now         func() time.Time
+	// mu serializes the updates of the hints of the endpoints.
+	mu sync.Mutex
+}
+
+// NewServer returns a new load hints server. The duration of the hints is capped to the given maximum duration, so
+// that a faulty model server cannot hold a hint forever.
+func NewServer(datastore Datastore, maxDuration time.Duration) *Server {
+	if maxDuration <= 0 {
+		maxDuration = DefaultMaxDuration
+	}
+	return &Server{datastore: datastore, maxDuration: maxDuration, now: time.Now}
+}
+
+// Push records the given hints on the endpoints served on the given address and port. The service is not
+// authenticated, so the hints are only accepted from the given address, i.e. from the pod of the endpoints, so that a
+// client cannot push hints for other pods.
+func (s *Server) Push(ctx context.Context, req *gen.PushRequest) (*gen.PushResponse, error) {
+	if req.GetAddress() == "" || req.GetPort() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "address and port are required")
+	}
+	if peerIP := peerIP(ctx); peerIP == nil || !peerIP.Equal(net.ParseIP(req.GetAddress())) {
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("Rejected load hints not pushed from the address of the endpoint",
+			"address", req.GetAddress(), "peer", peerIP)
+		return nil, status.Error(codes.PermissionDenied, "load hints must be pushed from the address of the endpoint")
+	}
+	now := s.now()
+	hints := make([]Hint, 0, len(req.GetHints()))
+	for _, pushed := range req.GetHints() {
+		kind, ok := kinds[pushed.GetKind()]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown hint kind %v", pushed.GetKind())
+		}
+		if kind == KindAdapterLoading && pushed.GetAdapter() == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "adapter is required for %v hints", pushed.GetKind())
+		}
+		if pushed.GetDurationMs() < 0 {
+			return nil, status.Error(codes.InvalidArgument, "duration must not be negative")
+		}
+		duration := min(time.Duration(pushed.GetDurationMs())*time.Millisecond, s.maxDuration)
+		hints = append(hints, Hint{Kind: kind, Adapter: pushed.GetAdapter(), Expiry: now.Add(duration)})
+	}
+
+	port := strconv.Itoa(int(req.GetPort()))
+	endpoints := s.datastore.PodList(func(ep fwkdl.Endpoint) bool {
+		metadata := ep.GetMetadata()
+		return metadata != nil && metadata.Address == req.GetAddress() && metadata.Port == port
+	})
+	accepted := len(endpoints) > 0
+	for _, hint := range hints {
+		metrics.RecordLoadHint(string(hint.Kind), accepted)
+	}
+	if !accepted {
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("Dropped load hints of an unknown endpoint",
+			"address", req.GetAddress(), "port", port)
+		return &gen.PushResponse{Accepted: false}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, endpoint := range endpoints {
+		current := FromEndpoint(endpoint.GetAttributes())
+		for _, hint := range hints {
+			current = current.update(hint, now)
+		}
+		endpoint.GetAttributes().Put(HintsAttributeKey, current)
+		log.FromContext(ctx).V(logutil.DEBUG).Info("Recorded load hints", "endpoint", endpoint.GetMetadata().NamespacedName,
+			"hints", current.Active(now))
+	}
+	return &gen.PushResponse{Accepted: true}, nil
+}
+
+// peerIP returns the IP of the client of the given call, or nil if it is unknown.
+func peerIP(ctx context.Context) net.IP {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	return net.ParseIP(host)
+}
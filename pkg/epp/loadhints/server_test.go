@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadhints
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/loadhints/api/gen"
+)
+
+type fakeDatastore struct {
+	endpoints []fwkdl.Endpoint
+}
+
+func (ds *fakeDatastore) PodList(predicate func(fwkdl.Endpoint) bool) []fwkdl.Endpoint {
+	res := []fwkdl.Endpoint{}
+	for _, ep := range ds.endpoints {
+		if predicate(ep) {
+			res = append(res, ep)
+		}
+	}
+	return res
+}
+
+func TestPush(t *testing.T) {
+	endpoint := fwkdl.NewEndpoint(&fwkdl.EndpointMetadata{
+		NamespacedName: types.NamespacedName{Name: "pod1-rank-0", Namespace: "default"},
+		Address:        "10.0.0.1",
+		Port:           "8000",
+	}, nil)
+	now := time.Now()
+	server := NewServer(&fakeDatastore{endpoints: []fwkdl.Endpoint{endpoint}}, time.Minute)
+	server.now = func() time.Time { return now }
+
+	// The hints are pushed from the address they are about, as model servers do.
+	push := func(address string, port int32, hints ...*gen.LoadHint) (*gen.PushResponse, error) {
+		ctx := peer.NewContext(t.Context(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(address), Port: 43210}})
+		return server.Push(ctx, &gen.PushRequest{Address: address, Port: port, Hints: hints})
+	}
+	hints := func() []Hint { return FromEndpoint(endpoint.GetAttributes()).Active(now) }
+
+	resp, err := push("10.0.0.1", 8000,
+		&gen.LoadHint{Kind: gen.HintKind_HINT_KIND_RESTART_PLANNED, DurationMs: 10_000},
+		&gen.LoadHint{Kind: gen.HintKind_HINT_KIND_ADAPTER_LOADING, Adapter: "sql-lora", DurationMs: 3_600_000})
+	require.NoError(t, err)
+	assert.True(t, resp.GetAccepted())
+	assert.ElementsMatch(t, []Hint{
+		{Kind: KindRestartPlanned, Expiry: now.Add(10 * time.Second)},
+		{Kind: KindAdapterLoading, Adapter: "sql-lora", Expiry: now.Add(time.Minute)},
+	}, hints(), "the duration of the hints is capped")
+
+	// A hint replaces the hint of the same kind and adapter, and a zero duration clears it.
+	_, err = push("10.0.0.1", 8000,
+		&gen.LoadHint{Kind: gen.HintKind_HINT_KIND_RESTART_PLANNED},
+		&gen.LoadHint{Kind: gen.HintKind_HINT_KIND_ADAPTER_LOADING, Adapter: "chat-lora", DurationMs: 1_000})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []Hint{
+		{Kind: KindAdapterLoading, Adapter: "sql-lora", Expiry: now.Add(time.Minute)},
+		{Kind: KindAdapterLoading, Adapter: "chat-lora", Expiry: now.Add(time.Second)},
+	}, hints())
+	assert.False(t, FromEndpoint(endpoint.GetAttributes()).Has(KindAdapterLoading, "sql-lora", now.Add(2*time.Minute)),
+		"hints expire")
+
+	resp, err = push("10.0.0.2", 8000, &gen.LoadHint{Kind: gen.HintKind_HINT_KIND_COMPACTION_IMMINENT, DurationMs: 1_000})
+	require.NoError(t, err)
+	assert.False(t, resp.GetAccepted(), "hints of unknown endpoints are dropped")
+
+	for name, hint := range map[string]*gen.LoadHint{
+		"unspecified kind":         {DurationMs: 1_000},
+		"adapter loading, unnamed": {Kind: gen.HintKind_HINT_KIND_ADAPTER_LOADING, DurationMs: 1_000},
+		"negative duration":        {Kind: gen.HintKind_HINT_KIND_RESTART_PLANNED, DurationMs: -1},
+	} {
+		_, err = push("10.0.0.1", 8000, hint)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), name)
+	}
+	_, err = push("", 8000)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "address is required")
+}
+
+func TestPushFromAnotherPeer(t *testing.T) {
+	endpoint := fwkdl.NewEndpoint(&fwkdl.EndpointMetadata{
+		NamespacedName: types.NamespacedName{Name: "pod1-rank-0", Namespace: "default"},
+		Address:        "10.0.0.1",
+		Port:           "8000",
+	}, nil)
+	server := NewServer(&fakeDatastore{endpoints: []fwkdl.Endpoint{endpoint}}, time.Minute)
+	req := &gen.PushRequest{Address: "10.0.0.1", Port: 8000, Hints: []*gen.LoadHint{
+		{Kind: gen.HintKind_HINT_KIND_RESTART_PLANNED, DurationMs: 10_000},
+	}}
+
+	ctx := peer.NewContext(t.Context(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.2"), Port: 43210}})
+	_, err := server.Push(ctx, req)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "hints pushed from another address are rejected")
+	_, err = server.Push(t.Context(), req)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "hints pushed from an unknown peer are rejected")
+	assert.Empty(t, FromEndpoint(endpoint.GetAttributes()).Active(time.Now()))
+}
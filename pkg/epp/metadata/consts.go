@@ -28,12 +28,39 @@ const (
 	DestinationEndpointKey = "x-gateway-destination-endpoint"
 	// DestinationEndpointServedKey is the metadata key used by Envoy to specify the endpoint that served the request.
 	DestinationEndpointServedKey = "x-gateway-destination-endpoint-served"
+	// SchedulingMetadataNamespace is the key for the outer namespace struct in the metadata field of the extproc response
+	// that is used to wrap the scheduling decision of the request, e.g. for Envoy access logs and downstream filters.
+	SchedulingMetadataNamespace = "inference.epp"
+	// SchedulingEndpointKey is the scheduling metadata key of the endpoint selected for the request.
+	SchedulingEndpointKey = "endpoint"
+	// SchedulingProfileKey is the scheduling metadata key of the primary scheduling profile of the request.
+	SchedulingProfileKey = "profile"
+	// SchedulingPriorityKey is the scheduling metadata key of the priority of the request.
+	SchedulingPriorityKey = "priority"
+	// SchedulingQueueWaitKey is the scheduling metadata key of the time, in milliseconds, the request waited for its
+	// admission, e.g. queued in Flow Control.
+	SchedulingQueueWaitKey = "queue_wait_ms"
 	// FlowFairnessIDKey is the header key used to pass the fairness ID to be used in Flow Control.
 	FlowFairnessIDKey = "x-gateway-inference-fairness-id"
+	// RequestFamilyIDKey is the header key used to pass the ID of the family of an incoming request, e.g. the agent task
+	// it was fanned out from. The requests of a family share the family budget of Flow Control.
+	RequestFamilyIDKey = "x-gateway-inference-request-family-id"
 	// ObjectiveKey is the header key used to specify the objective of an incoming request.
 	ObjectiveKey = "x-gateway-inference-objective"
 	// ModelNameRewriteKey is the header key used to specify the model name to be used when the request is forwarded to the model server.
 	ModelNameRewriteKey = "x-gateway-model-name-rewrite"
+	// PinEndpointKey is the header key used to pin an incoming request to an endpoint, by name, namespace/name or
+	// address:port. It is a scheduling hint, only honored when allowed by the configuration.
+	PinEndpointKey = "x-gateway-inference-pin-endpoint"
+	// ExcludeEndpointsKey is the header key used to exclude a comma-separated list of endpoints from the candidates of
+	// an incoming request. It is a scheduling hint, only honored when allowed by the configuration.
+	ExcludeEndpointsKey = "x-gateway-inference-exclude-endpoints"
+	// ForceProfileKey is the header key used to schedule an incoming request with a single scheduling profile. It is a
+	// scheduling hint, only honored when allowed by the configuration.
+	ForceProfileKey = "x-gateway-inference-force-profile"
+	// DisableCacheAffinityKey is the header key used to disable the cache-affinity filters and scorers for an incoming
+	// request, when "true". It is a scheduling hint, only honored when allowed by the configuration.
+	DisableCacheAffinityKey = "x-gateway-inference-disable-cache-affinity"
 
 	// DefaultFairnessID is the default fairness ID used when no ID is provided in the request.
 	// This ensures that requests without explicit fairness identifiers are still grouped and managed by the Flow Control
@@ -29,6 +29,7 @@ import (
 
 	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
 	metricsutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/metrics"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
 	schedulingframework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
 )
 
@@ -57,6 +58,8 @@ var (
 	modelTypeLabels         = []string{"model_name", "target_model_name", "type"}
 	poolLabels              = []string{"name"}
 	endpointLabels          = []string{"pod_name", "namespace", "port"}
+	poolSliceLabels         = []string{"name", "slice"}
+	pluginLabels            = []string{"extension_point", "plugin_type", "plugin_name", "profile"}
 
 	// --- Common Buckets ---
 
@@ -338,129 +341,815 @@ var (
 		prometheus.HistogramOpts{
 			Subsystem: inferenceExtension,
 			Name:      "plugin_duration_seconds",
-			Help:      metricsutil.HelpMsgWithStability("Plugin processing latency distribution in seconds for each extension point, plugin type and plugin name.", compbasemetrics.ALPHA),
+			Help:      metricsutil.HelpMsgWithStability("Plugin processing latency distribution in seconds for each extension point, plugin type, plugin name and scheduling profile.", compbasemetrics.ALPHA),
 			Buckets: []float64{
 				0.0001, 0.0002, 0.0005, 0.001, 0.002, 0.005, 0.01, 0.02, 0.05, 0.1,
 			},
 		},
+		pluginLabels,
+	)
+
+	pluginErrorsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceExtension,
+			Name:      "plugin_errors_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of plugin runs that failed for each extension point, plugin type, plugin name and scheduling profile.", compbasemetrics.ALPHA),
+		},
+		pluginLabels,
+	)
+
+	pluginFilterEliminatedEndpoints = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: inferenceExtension,
+			Name:      "plugin_filter_eliminated_endpoints",
+			Help:      metricsutil.HelpMsgWithStability("Distribution of the number of endpoints eliminated by a filter plugin run for each plugin type, plugin name and scheduling profile.", compbasemetrics.ALPHA),
+			Buckets:   []float64{0, 1, 2, 4, 8, 16, 32, 64, 128, 256},
+		},
+		[]string{"plugin_type", "plugin_name", "profile"},
+	)
+
+	prefixCacheSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: inferenceExtension,
+			Name:      "prefix_indexer_size",
+			Help:      metricsutil.HelpMsgWithStability("Size of the prefix indexer.", compbasemetrics.ALPHA),
+		},
+		[]string{},
+	)
+
+	prefixCacheHitRatio = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: inferenceExtension,
+			Name:      "prefix_indexer_hit_ratio",
+			Help:      metricsutil.HelpMsgWithStability("Ratio of prefix length matched to total prefix length in the cache lookup.", compbasemetrics.ALPHA),
+			// Buckets from 0.0 to 1.0 in increments
+			Buckets: []float64{0.0, 0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9, 1.0},
+		},
+		[]string{},
+	)
+
+	prefixCacheHitLength = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: inferenceExtension,
+			Name:      "prefix_indexer_hit_bytes",
+			Help:      metricsutil.HelpMsgWithStability("Length of the prefix match in number of bytes in the cache lookup.", compbasemetrics.ALPHA),
+			Buckets:   []float64{0, 16, 32, 64, 128, 256, 512, 1024, 2048, 4096, 8192, 16384, 32768, 65536},
+		},
+		[]string{},
+	)
+
+	prefixCacheLookupBlocksTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceExtension,
+			Name:      "prefix_indexer_lookup_blocks_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of prefix blocks of the scheduled requests looked up in the prefix indexer.", compbasemetrics.ALPHA),
+		},
+		[]string{"target_model_name"},
+	)
+
+	prefixCacheMatchedBlocksTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceExtension,
+			Name:      "prefix_indexer_matched_blocks_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of prefix blocks of the scheduled requests estimated to be cached on the endpoint they were routed to.", compbasemetrics.ALPHA),
+		},
+		[]string{"target_model_name", "namespace", "name"},
+	)
+
+	prefixCacheRequestMatchedBlocks = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: inferenceExtension,
+			Name:      "prefix_indexer_request_matched_blocks",
+			Help:      metricsutil.HelpMsgWithStability("Distribution of the number of prefix blocks of a request estimated to be cached on the endpoint it was routed to.", compbasemetrics.ALPHA),
+			Buckets:   []float64{0, 1, 2, 4, 8, 16, 32, 64, 128, 256, 512, 1024},
+		},
+		[]string{"target_model_name"},
+	)
+
+	prefixCacheEvictionsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceExtension,
+			Name:      "prefix_indexer_evictions_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of prefix blocks evicted from the prefix indexer of an endpoint because its estimated cache capacity was exceeded.", compbasemetrics.ALPHA),
+		},
+		[]string{"namespace", "name"},
+	)
+
+	prefixCacheEndpointEntries = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: inferenceExtension,
+			Name:      "prefix_indexer_endpoint_entries",
+			Help:      metricsutil.HelpMsgWithStability("Number of prefix blocks the prefix indexer estimates to be cached on an endpoint.", compbasemetrics.ALPHA),
+		},
+		[]string{"namespace", "name"},
+	)
+)
+
+// --- Info Metrics ---
+var inferenceExtensionInfo = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: inferenceExtension,
+		Name:      "info",
+		Help:      metricsutil.HelpMsgWithStability("General information of the current build of Inference Extension.", compbasemetrics.ALPHA),
+	},
+	[]string{"commit", "build_ref"},
+)
+
+// --- Flow Control Metrics ---
+var (
+	flowControlRequestQueueDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: inferenceExtension,
+			Name:      "flow_control_request_queue_duration_seconds",
+			Help:      metricsutil.HelpMsgWithStability("Distribution of total time requests spend in the Flow Control layer (from enqueue to final outcome).", compbasemetrics.ALPHA),
+			Buckets: []float64{
+				0.0001, 0.0005, 0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1.0, 2.5, 5.0, 10.0, 30.0, 60.0,
+			},
+		},
+		append([]string{"fairness_id", "priority", "outcome", "inference_pool"}, modelLabels...),
+	)
+
+	flowControlDispatchCycleDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: inferenceExtension,
+			Name:      "flow_control_dispatch_cycle_duration_seconds",
+			Help:      metricsutil.HelpMsgWithStability("Distribution of time taken for each internal dispatch cycle in the Flow Control layer.", compbasemetrics.ALPHA),
+			Buckets: []float64{
+				0.0001, 0.0002, 0.0005, 0.001, 0.002, 0.005, 0.01, 0.02, 0.05, 0.1,
+			},
+		},
+		[]string{},
+	)
+
+	flowControlRequestEnqueueDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: inferenceExtension,
+			Name:      "flow_control_request_enqueue_duration_seconds",
+			Help:      metricsutil.HelpMsgWithStability("Distribution of time taken to enqueue requests into the Flow Control layer.", compbasemetrics.ALPHA),
+			Buckets: []float64{
+				0.0001, 0.0002, 0.0005, 0.001, 0.002, 0.005, 0.01, 0.02, 0.05, 0.1,
+			},
+		},
+		[]string{"fairness_id", "priority", "outcome"},
+	)
+
+	flowControlQueueSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: inferenceExtension,
+			Name:      "flow_control_queue_size",
+			Help:      metricsutil.HelpMsgWithStability("Current number of requests actively held in the Flow Control queue.", compbasemetrics.ALPHA),
+		},
+		append([]string{"fairness_id", "priority", "inference_pool"}, modelLabels...),
+	)
+
+	flowControlQueueBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: inferenceExtension,
+			Name:      "flow_control_queue_bytes",
+			Help:      metricsutil.HelpMsgWithStability("Current total size in bytes of requests actively held in the Flow Control queue.", compbasemetrics.ALPHA),
+		},
+		append([]string{"fairness_id", "priority", "inference_pool"}, modelLabels...),
+	)
+
+	flowControlPoolSaturation = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: inferenceExtension,
+			Name:      "flow_control_pool_saturation",
+			Help:      metricsutil.HelpMsgWithStability("Current saturation level of the inference pool (0.0 = empty, 1.0 = fully saturated).", compbasemetrics.ALPHA),
+		},
+		[]string{"inference_pool"},
+	)
+)
+
+// --- Inference Model Rewrite Metrics ---
+var inferenceModelRewriteDecisionsTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: inferenceExtension,
+		Name:      "model_rewrite_decisions_total",
+		Help:      metricsutil.HelpMsgWithStability("Total number of inference model rewrite decisions.", compbasemetrics.ALPHA),
+	},
+	[]string{"model_rewrite_name", "model_name", "target_model"},
+)
+
+// --- Endpoint Exclusion Metrics ---
+var (
+	endpointExcluded = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: inferenceExtension,
+			Name:      "endpoint_excluded",
+			Help:      metricsutil.HelpMsgWithStability("Set to 1 while an endpoint or pod is excluded from scheduling through the exclusion API.", compbasemetrics.ALPHA),
+		},
+		[]string{"namespace", "name"},
+	)
+
+	endpointExclusionsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceExtension,
+			Name:      "endpoint_exclusions_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of endpoint exclusions requested through the exclusion API, by requesting source.", compbasemetrics.ALPHA),
+		},
+		[]string{"source"},
+	)
+
+	endpointExclusionsLiftedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceExtension,
+			Name:      "endpoint_exclusions_lifted_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of endpoint exclusions lifted, by cause (expired or removed).", compbasemetrics.ALPHA),
+		},
+		[]string{"cause"},
+	)
+)
+
+// --- Evaluation Run Metrics ---
+var (
+	evalRunRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceExtension,
+			Name:      "eval_run_requests_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of requests of evaluation runs, by serving endpoint.", compbasemetrics.ALPHA),
+		},
+		[]string{"target_model_name", "namespace", "name"},
+	)
+)
+
+// --- EPP Self Pressure Metrics ---
+var (
+	eppCPUUtilization = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Subsystem: inferenceExtension,
+			Name:      "self_cpu_utilization",
+			Help:      metricsutil.HelpMsgWithStability("Fraction of the CPU available to the EPP (GOMAXPROCS) used by the EPP process.", compbasemetrics.ALPHA),
+		},
+	)
+
+	eppMemoryUtilization = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Subsystem: inferenceExtension,
+			Name:      "self_memory_utilization",
+			Help:      metricsutil.HelpMsgWithStability("Fraction of the Go memory limit (GOMEMLIMIT) used by the EPP process, 0 if no limit is set.", compbasemetrics.ALPHA),
+		},
+	)
+
+	eppSelfPressure = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Subsystem: inferenceExtension,
+			Name:      "self_pressure",
+			Help:      metricsutil.HelpMsgWithStability("Set to 1 while the EPP is under resource pressure and runs with degraded scheduling.", compbasemetrics.ALPHA),
+		},
+	)
+
+	eppSelfPressureTransitionsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceExtension,
+			Name:      "self_pressure_transitions_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of transitions into and out of the EPP resource pressure state.", compbasemetrics.ALPHA),
+		},
+		[]string{"state"},
+	)
+
+	metricsRefreshInterval = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Subsystem: inferenceExtension,
+			Name:      "metrics_refresh_interval_seconds",
+			Help:      metricsutil.HelpMsgWithStability("Interval at which the metrics of the model servers are currently refreshed, adapted to the activity of the pool.", compbasemetrics.ALPHA),
+		},
+	)
+)
+
+// --- Context Window Metrics ---
+var (
+	contextWindowEnforcementsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceObjectiveComponent,
+			Name:      "context_window_enforcements_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of requests exceeding the model context window, by action taken (clamped or rejected).", compbasemetrics.ALPHA),
+		},
+		[]string{"target_model_name", "action"},
+	)
+)
+
+// --- Wasted Work Metrics ---
+var (
+	abandonedRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceObjectiveComponent,
+			Name:      "abandoned_requests_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of requests whose client disconnected after the request was dispatched to a model server and before the response completed.", compbasemetrics.ALPHA),
+		},
+		[]string{"model_name", "target_model_name"},
+	)
+
+	wastedOutputTokensTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceObjectiveComponent,
+			Name:      "wasted_output_tokens_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of output tokens generated for abandoned requests, as observed by the EPP.", compbasemetrics.ALPHA),
+		},
+		[]string{"model_name", "target_model_name"},
+	)
+)
+
+// --- Flow Control Saturation Reason Metrics ---
+var flowControlPoolSaturated = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: inferenceExtension,
+		Name:      "flow_control_pool_saturated",
+		Help:      metricsutil.HelpMsgWithStability("Whether the inference pool is saturated (1) or not (0), by the constraint that saturates it.", compbasemetrics.ALPHA),
+	},
+	[]string{"inference_pool", "reason"},
+)
+
+// --- Decision Compare Metrics ---
+var decisionCompareTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: inferenceExtension,
+		Name:      "decision_compare_total",
+		Help:      metricsutil.HelpMsgWithStability("Total number of scheduling decisions compared against the decisions of the active EPP, by result.", compbasemetrics.ALPHA),
+	},
+	[]string{"model_name", "result"},
+)
+
+// --- Shadow Scheduling Profile Metrics ---
+var shadowProfileDecisionsTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: inferenceExtension,
+		Name:      "shadow_profile_decisions_total",
+		Help:      metricsutil.HelpMsgWithStability("Total number of shadow scheduling profile runs, by comparison of their decision with the decision of the primary profile.", compbasemetrics.ALPHA),
+	},
+	[]string{"profile", "result"},
+)
+
+// --- Inference Pool Slice Metrics ---
+var (
+	inferencePoolSliceReadyPods = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: inferencePoolComponent,
+			Name:      "slice_ready_pods",
+			Help:      metricsutil.HelpMsgWithStability("The number of ready pods in a slice of the inference server pool.", compbasemetrics.ALPHA),
+		},
+		poolSliceLabels,
+	)
+
+	inferencePoolSliceAvgKVCache = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: inferencePoolComponent,
+			Name:      "slice_average_kv_cache_utilization",
+			Help:      metricsutil.HelpMsgWithStability("The average kv cache utilization for a slice of the inference server pool.", compbasemetrics.ALPHA),
+		},
+		poolSliceLabels,
+	)
+
+	inferencePoolSliceAvgQueueSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: inferencePoolComponent,
+			Name:      "slice_average_queue_size",
+			Help:      metricsutil.HelpMsgWithStability("The average number of requests pending in the model server queue for a slice of the inference server pool.", compbasemetrics.ALPHA),
+		},
+		poolSliceLabels,
+	)
+
+	flowControlSliceSaturation = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: inferenceExtension,
+			Name:      "flow_control_slice_saturation",
+			Help:      metricsutil.HelpMsgWithStability("Current saturation level of a slice of the inference pool (0.0 = empty, 1.0 = fully saturated).", compbasemetrics.ALPHA),
+		},
+		[]string{"inference_pool", "slice"},
+	)
+
+	// recordedPoolSlices tracks the slices with recorded series, so that the series of removed slices are deleted.
+	recordedPoolSlices   = map[string]map[string]bool{}
+	recordedPoolSlicesMu sync.Mutex
+)
+
+// --- Scheduling Budget Metrics ---
+var (
+	schedulerBudgetExceededTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceExtension,
+			Name:      "scheduler_budget_exceeded_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of scheduling profile runs which exceeded their time budget and fell back to the fast-path picker.", compbasemetrics.ALPHA),
+		},
+		[]string{"budget", "plugin_type", "plugin_name"},
+	)
+)
+
+// --- Prompt Quarantine Metrics ---
+var (
+	promptQuarantineTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceExtension,
+			Name:      "prompt_quarantine_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of prompt patterns quarantined for repeatedly failing backends, and of requests matching a quarantined pattern that were isolated or rejected.", compbasemetrics.ALPHA),
+		},
+		[]string{"target_model_name", "action"},
+	)
+)
+
+var (
+	predictedSLOViolationCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceObjectiveComponent,
+			Name:      "request_predicted_slo_violation_total",
+			Help:      metricsutil.HelpMsgWithStability("Counter of requests scheduled on an endpoint predicted to violate their latency objectives, by whether no candidate endpoint could meet them (capacity) or another one could (scheduling).", compbasemetrics.ALPHA),
+		},
+		[]string{"model_name", "target_model_name", "reason"},
+	)
+)
+
+var (
+	timeAnomaliesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceExtension,
+			Name:      "time_anomalies_total",
+			Help:      metricsutil.HelpMsgWithStability("Counter of the timestamps, durations and rates found anomalous, e.g. because of a skewed model server clock or a jump of the EPP clock, and clamped or discarded, by source and anomaly.", compbasemetrics.ALPHA),
+		},
+		[]string{"source", "anomaly"},
+	)
+)
+
+var (
+	pluginPanicsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceExtension,
+			Name:      "plugin_panics_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of plugin runs that panicked and were recovered for each extension point, plugin type and plugin name.", compbasemetrics.ALPHA),
+		},
 		[]string{"extension_point", "plugin_type", "plugin_name"},
 	)
 
-	prefixCacheSize = prometheus.NewGaugeVec(
-		prometheus.GaugeOpts{
+	pluginQuarantined = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: inferenceExtension,
+			Name:      "plugin_quarantined",
+			Help:      metricsutil.HelpMsgWithStability("Whether a plugin is quarantined (1) or not (0) after failing repeatedly, for each plugin type and plugin name.", compbasemetrics.ALPHA),
+		},
+		[]string{"plugin_type", "plugin_name"},
+	)
+)
+
+// --- Request Shaping Metrics ---
+var (
+	maxTokensShapedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceObjectiveComponent,
+			Name:      "max_tokens_shaped_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of requests without a requested completion length that were given one based on the pool headroom.", compbasemetrics.ALPHA),
+		},
+		[]string{"target_model_name"},
+	)
+)
+
+// --- Pool Pause Metrics ---
+var (
+	poolPaused = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: inferenceExtension,
+			Name:      "pool_paused",
+			Help:      metricsutil.HelpMsgWithStability("Set to 1 while the dispatch of requests to the inference pool is paused through the pool pause API, with the policy of the pause.", compbasemetrics.ALPHA),
+		},
+		[]string{"inference_pool", "policy"},
+	)
+
+	poolPausesLiftedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceExtension,
+			Name:      "pool_pauses_lifted_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of inference pool pauses lifted, by cause (expired or resumed).", compbasemetrics.ALPHA),
+		},
+		[]string{"inference_pool", "cause"},
+	)
+
+	poolPausedRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceExtension,
+			Name:      "pool_paused_requests_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of requests received while the inference pool was paused, by outcome (held, rejected, or abandoned while held).", compbasemetrics.ALPHA),
+		},
+		[]string{"inference_pool", "outcome"},
+	)
+)
+
+// --- Metrics Backfill Metrics ---
+var (
+	syntheticMetricsDecisionsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceExtension,
+			Name:      "synthetic_metrics_decisions_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of requests scheduled on an endpoint whose stale metrics were backfilled from the dispatch and completion accounting of the EPP.", compbasemetrics.ALPHA),
+		},
+		[]string{"target_model_name"},
+	)
+)
+
+// --- Backend Abort Metrics ---
+var (
+	backendAbortsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceExtension,
+			Name:      "backend_aborts_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of abort calls made to model servers for dispatched requests that will not be delivered, by cause and outcome.", compbasemetrics.ALPHA),
+		},
+		[]string{"cause", "outcome"},
+	)
+)
+
+// --- Load Hint Metrics ---
+var (
+	loadHintsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceExtension,
+			Name:      "load_hints_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of load hints pushed by model servers, by kind and whether they were accepted.", compbasemetrics.ALPHA),
+		},
+		[]string{"kind", "outcome"},
+	)
+)
+
+// --- Score Cache Metrics ---
+var (
+	scorerCacheLookupsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceExtension,
+			Name:      "scorer_cache_lookups_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of endpoint score lookups in the score cache of the cacheable scorers, by plugin type, plugin name and result.", compbasemetrics.ALPHA),
+		},
+		[]string{"plugin_type", "plugin_name", "result"},
+	)
+)
+
+// --- Request Family Metrics ---
+var (
+	flowControlFamilyBudgetRejections = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceExtension,
+			Name:      "flow_control_family_budget_rejections_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of requests rejected by the Flow Control layer because their family exhausted its budget.", compbasemetrics.ALPHA),
+		},
+		[]string{"inference_pool", "reason"},
+	)
+)
+
+// --- Endpoint Health Probe Metrics ---
+var (
+	endpointProbeUnhealthy = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: inferenceExtension,
+			Name:      "endpoint_probe_unhealthy",
+			Help:      metricsutil.HelpMsgWithStability("Set to 1 while an endpoint is excluded from scheduling after failing active health probes.", compbasemetrics.ALPHA),
+		},
+		[]string{"namespace", "name"},
+	)
+
+	endpointProbeUnhealthyTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Subsystem: inferenceExtension,
+			Name:      "endpoint_probe_unhealthy_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of times an endpoint was marked unhealthy by active health probing.", compbasemetrics.ALPHA),
+		},
+	)
+)
+
+// --- Endpoint Bias Metrics ---
+var (
+	endpointBiasRuleMultiplier = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: inferenceExtension,
+			Name:      "endpoint_bias_rule_multiplier",
+			Help:      metricsutil.HelpMsgWithStability("Score multiplier of each active endpoint bias rule.", compbasemetrics.ALPHA),
+		},
+		[]string{"rule"},
+	)
+
+	endpointBiasRulesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceExtension,
+			Name:      "endpoint_bias_rules_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of endpoint bias rules set through the bias API, by requesting source.", compbasemetrics.ALPHA),
+		},
+		[]string{"source"},
+	)
+
+	endpointBiasRulesLiftedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceExtension,
+			Name:      "endpoint_bias_rules_lifted_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of endpoint bias rules lifted, by cause (expired or removed).", compbasemetrics.ALPHA),
+		},
+		[]string{"cause"},
+	)
+)
+
+// --- Idempotency Metrics ---
+var (
+	nonIdempotentDestinationsDroppedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceObjectiveComponent,
+			Name:      "non_idempotent_destinations_dropped_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of fallback or redundant destinations dropped from non-idempotent requests.", compbasemetrics.ALPHA),
+		},
+		[]string{"target_model_name"},
+	)
+)
+
+// --- Metrics Staleness Metrics ---
+var (
+	staleMetricsEndpointsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceExtension,
+			Name:      "stale_metrics_endpoints_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of candidate endpoints whose metrics were stale when a request was scheduled, by the action applied to them.", compbasemetrics.ALPHA),
+		},
+		[]string{"action"},
+	)
+)
+
+// --- Scheduling Confidence Metrics ---
+var (
+	schedulerDecisionScoreGap = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: inferenceExtension,
+			Name:      "scheduler_decision_score_gap",
+			Help:      metricsutil.HelpMsgWithStability("Distribution of the gap between the two best weighted scores of a scheduling decision, relative to the best one, for each scheduling profile.", compbasemetrics.ALPHA),
+			Buckets:   []float64{0.0, 0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9, 1.0},
+		},
+		[]string{"profile"},
+	)
+
+	schedulerDecisionScoreEntropy = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: inferenceExtension,
+			Name:      "scheduler_decision_score_entropy",
+			Help:      metricsutil.HelpMsgWithStability("Distribution of the normalized entropy of the weighted scores of a scheduling decision, for each scheduling profile.", compbasemetrics.ALPHA),
+			Buckets:   []float64{0.0, 0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9, 1.0},
+		},
+		[]string{"profile"},
+	)
+)
+
+// --- Spillover Metrics ---
+var (
+	spilloverRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceExtension,
+			Name:      "spillover_requests_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of requests received while the flow control queues were over the spillover threshold, by outcome (spilled over to the peer, or kept to prevent a spillover loop).", compbasemetrics.ALPHA),
+		},
+		[]string{"inference_pool", "outcome"},
+	)
+)
+
+// --- OTLP Receiver Metrics ---
+var (
+	otlpMetricsExportsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceExtension,
+			Name:      "otlp_metrics_exports_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of resource metrics pushed by model servers on the OTLP receiver, by whether they were accepted.", compbasemetrics.ALPHA),
+		},
+		[]string{"outcome"},
+	)
+)
+
+// --- Flow Control Token Usage Metrics ---
+var (
+	flowControlTokensTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
 			Subsystem: inferenceExtension,
-			Name:      "prefix_indexer_size",
-			Help:      metricsutil.HelpMsgWithStability("Size of the prefix indexer.", compbasemetrics.ALPHA),
+			Name:      "flow_control_tokens_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of tokens consumed by the requests dispatched by the Flow Control layer, as reported in the responses, by flow and token type.", compbasemetrics.ALPHA),
 		},
-		[]string{},
+		append(append([]string{"fairness_id", "priority", "inference_pool"}, modelLabels...), "type"),
 	)
+)
 
-	prefixCacheHitRatio = prometheus.NewHistogramVec(
+// --- Streamed Response Latency Metrics ---
+var (
+	requestTimeToFirstToken = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
-			Subsystem: inferenceExtension,
-			Name:      "prefix_indexer_hit_ratio",
-			Help:      metricsutil.HelpMsgWithStability("Ratio of prefix length matched to total prefix length in the cache lookup.", compbasemetrics.ALPHA),
-			// Buckets from 0.0 to 1.0 in increments
-			Buckets: []float64{0.0, 0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9, 1.0},
+			Subsystem: inferenceObjectiveComponent,
+			Name:      "request_time_to_first_token_seconds",
+			Help:      metricsutil.HelpMsgWithStability("Distribution of the time from the reception of a streamed request to the first token event of its response, in seconds.", compbasemetrics.ALPHA),
+			Buckets:   generalLatencyBuckets,
 		},
-		[]string{},
+		append([]string{"inference_pool"}, modelLabels...),
 	)
 
-	prefixCacheHitLength = prometheus.NewHistogramVec(
+	requestInterTokenLatency = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
-			Subsystem: inferenceExtension,
-			Name:      "prefix_indexer_hit_bytes",
-			Help:      metricsutil.HelpMsgWithStability("Length of the prefix match in number of bytes in the cache lookup.", compbasemetrics.ALPHA),
-			Buckets:   []float64{0, 16, 32, 64, 128, 256, 512, 1024, 2048, 4096, 8192, 16384, 32768, 65536},
+			Subsystem: inferenceObjectiveComponent,
+			Name:      "request_inter_token_latency_seconds",
+			Help:      metricsutil.HelpMsgWithStability("Distribution of the time between the token events of streamed responses, in seconds.", compbasemetrics.ALPHA),
+			Buckets:   tpotBuckets,
 		},
-		[]string{},
+		append([]string{"inference_pool"}, modelLabels...),
 	)
 )
 
-// --- Info Metrics ---
-var inferenceExtensionInfo = prometheus.NewGaugeVec(
-	prometheus.GaugeOpts{
-		Subsystem: inferenceExtension,
-		Name:      "info",
-		Help:      metricsutil.HelpMsgWithStability("General information of the current build of Inference Extension.", compbasemetrics.ALPHA),
-	},
-	[]string{"commit", "build_ref"},
+// --- Fallback Metrics ---
+var (
+	requestFallbackTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceObjectiveComponent,
+			Name:      "request_fallback_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of requests served by a fallback destination instead of the picked one.", compbasemetrics.ALPHA),
+		},
+		[]string{"model_name", "target_model_name"},
+	)
 )
 
-// --- Flow Control Metrics ---
+// --- Pool Fallback Metrics ---
 var (
-	flowControlRequestQueueDuration = prometheus.NewHistogramVec(
-		prometheus.HistogramOpts{
+	poolFallbackRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
 			Subsystem: inferenceExtension,
-			Name:      "flow_control_request_queue_duration_seconds",
-			Help:      metricsutil.HelpMsgWithStability("Distribution of total time requests spend in the Flow Control layer (from enqueue to final outcome).", compbasemetrics.ALPHA),
-			Buckets: []float64{
-				0.0001, 0.0005, 0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1.0, 2.5, 5.0, 10.0, 30.0, 60.0,
-			},
+			Name:      "pool_fallback_requests_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of requests opting in to the pool fallback received while the pool was saturated, by outcome (downgraded to the secondary pool, or kept to prevent a fallback loop).", compbasemetrics.ALPHA),
 		},
-		append([]string{"fairness_id", "priority", "outcome", "inference_pool"}, modelLabels...),
+		[]string{"inference_pool", "secondary_pool", "outcome"},
 	)
+)
 
-	flowControlDispatchCycleDuration = prometheus.NewHistogramVec(
-		prometheus.HistogramOpts{
+// --- Flow Control Starvation Metrics ---
+var (
+	flowControlStarvationDispatches = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
 			Subsystem: inferenceExtension,
-			Name:      "flow_control_dispatch_cycle_duration_seconds",
-			Help:      metricsutil.HelpMsgWithStability("Distribution of time taken for each internal dispatch cycle in the Flow Control layer.", compbasemetrics.ALPHA),
-			Buckets: []float64{
-				0.0001, 0.0002, 0.0005, 0.001, 0.002, 0.005, 0.01, 0.02, 0.05, 0.1,
-			},
+			Name:      "flow_control_starvation_dispatches_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of requests dispatched by the Flow Control layer ahead of higher priority bands because they were queued longer than the starvation threshold.", compbasemetrics.ALPHA),
 		},
-		[]string{},
+		[]string{"inference_pool", "priority"},
 	)
+)
 
-	flowControlRequestEnqueueDuration = prometheus.NewHistogramVec(
-		prometheus.HistogramOpts{
-			Subsystem: inferenceExtension,
-			Name:      "flow_control_request_enqueue_duration_seconds",
-			Help:      metricsutil.HelpMsgWithStability("Distribution of time taken to enqueue requests into the Flow Control layer.", compbasemetrics.ALPHA),
-			Buckets: []float64{
-				0.0001, 0.0002, 0.0005, 0.001, 0.002, 0.005, 0.01, 0.02, 0.05, 0.1,
-			},
+// --- Rate Limiting Metrics ---
+var (
+	rateLimitedRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceObjectiveComponent,
+			Name:      "rate_limited_requests_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of requests rejected for exceeding a request or token rate limit.", compbasemetrics.ALPHA),
 		},
-		[]string{"fairness_id", "priority", "outcome"},
+		[]string{"target_model_name", "resource"},
 	)
+)
 
-	flowControlQueueSize = prometheus.NewGaugeVec(
+// --- Adaptive Concurrency Metrics ---
+var (
+	endpointConcurrencyLimit = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Subsystem: inferenceExtension,
-			Name:      "flow_control_queue_size",
-			Help:      metricsutil.HelpMsgWithStability("Current number of requests actively held in the Flow Control queue.", compbasemetrics.ALPHA),
+			Name:      "endpoint_concurrency_limit",
+			Help:      metricsutil.HelpMsgWithStability("Concurrency limit of an endpoint learned from the latency and errors of its responses.", compbasemetrics.ALPHA),
 		},
-		append([]string{"fairness_id", "priority", "inference_pool"}, modelLabels...),
+		[]string{"namespace", "name"},
 	)
+)
 
-	flowControlQueueBytes = prometheus.NewGaugeVec(
-		prometheus.GaugeOpts{
+// --- Flow Control Preemption Metrics ---
+var (
+	flowControlPreemptions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
 			Subsystem: inferenceExtension,
-			Name:      "flow_control_queue_bytes",
-			Help:      metricsutil.HelpMsgWithStability("Current total size in bytes of requests actively held in the Flow Control queue.", compbasemetrics.ALPHA),
+			Name:      "flow_control_preemptions_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of queued requests evicted by the Flow Control layer to make room for a request of higher priority while the queues were at capacity.", compbasemetrics.ALPHA),
 		},
-		append([]string{"fairness_id", "priority", "inference_pool"}, modelLabels...),
+		[]string{"inference_pool", "fairness_id", "priority"},
 	)
 
-	flowControlPoolSaturation = prometheus.NewGaugeVec(
-		prometheus.GaugeOpts{
+	flowControlRejections = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
 			Subsystem: inferenceExtension,
-			Name:      "flow_control_pool_saturation",
-			Help:      metricsutil.HelpMsgWithStability("Current saturation level of the inference pool (0.0 = empty, 1.0 = fully saturated).", compbasemetrics.ALPHA),
+			Name:      "flow_control_rejections_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of requests rejected by the Flow Control layer, before or after being queued, by outcome.", compbasemetrics.ALPHA),
 		},
-		[]string{"inference_pool"},
+		[]string{"inference_pool", "fairness_id", "priority", "outcome"},
 	)
 )
 
-// --- Inference Model Rewrite Metrics ---
-var inferenceModelRewriteDecisionsTotal = prometheus.NewCounterVec(
-	prometheus.CounterOpts{
-		Subsystem: inferenceExtension,
-		Name:      "model_rewrite_decisions_total",
-		Help:      metricsutil.HelpMsgWithStability("Total number of inference model rewrite decisions.", compbasemetrics.ALPHA),
-	},
-	[]string{"model_rewrite_name", "model_name", "target_model"},
+// --- SLO Attainment Metrics ---
+var (
+	requestSLOLatency = prometheus.NewSummaryVec(
+		prometheus.SummaryOpts{
+			Subsystem:  inferenceObjectiveComponent,
+			Name:       "request_slo_latency_seconds",
+			Help:       metricsutil.HelpMsgWithStability("Rolling percentiles over the last 5 minutes of the time to first token, time per output token and end-to-end latency of the requests, in seconds.", compbasemetrics.ALPHA),
+			Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.95: 0.005, 0.99: 0.001},
+			MaxAge:     5 * time.Minute,
+			AgeBuckets: 5,
+		},
+		append(append([]string{}, modelLabels...), "priority", "latency"),
+	)
+
+	requestSLOAttainment = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceObjectiveComponent,
+			Name:      "request_slo_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of requests evaluated against their time to first token or time per output token objective, by whether the objective was met.", compbasemetrics.ALPHA),
+		},
+		append(append([]string{}, modelLabels...), "priority", "objective", "met"),
+	)
 )
 
 var registerMetrics sync.Once
@@ -500,10 +1189,17 @@ func Register(customCollectors ...prometheus.Collector) {
 		metrics.Registry.MustRegister(schedulerE2ELatency)
 		metrics.Registry.MustRegister(schedulerAttemptsTotal)
 		metrics.Registry.MustRegister(pluginProcessingLatencies)
+		metrics.Registry.MustRegister(pluginErrorsTotal)
+		metrics.Registry.MustRegister(pluginFilterEliminatedEndpoints)
 		metrics.Registry.MustRegister(inferenceExtensionInfo)
 		metrics.Registry.MustRegister(prefixCacheSize)
 		metrics.Registry.MustRegister(prefixCacheHitRatio)
 		metrics.Registry.MustRegister(prefixCacheHitLength)
+		metrics.Registry.MustRegister(prefixCacheLookupBlocksTotal)
+		metrics.Registry.MustRegister(prefixCacheMatchedBlocksTotal)
+		metrics.Registry.MustRegister(prefixCacheRequestMatchedBlocks)
+		metrics.Registry.MustRegister(prefixCacheEvictionsTotal)
+		metrics.Registry.MustRegister(prefixCacheEndpointEntries)
 		metrics.Registry.MustRegister(flowControlRequestQueueDuration)
 		metrics.Registry.MustRegister(flowControlDispatchCycleDuration)
 		metrics.Registry.MustRegister(flowControlQueueSize)
@@ -511,6 +1207,63 @@ func Register(customCollectors ...prometheus.Collector) {
 		metrics.Registry.MustRegister(flowControlPoolSaturation)
 		metrics.Registry.MustRegister(flowControlRequestEnqueueDuration)
 		metrics.Registry.MustRegister(inferenceModelRewriteDecisionsTotal)
+		metrics.Registry.MustRegister(endpointExcluded)
+		metrics.Registry.MustRegister(endpointExclusionsTotal)
+		metrics.Registry.MustRegister(endpointExclusionsLiftedTotal)
+		metrics.Registry.MustRegister(evalRunRequestsTotal)
+		metrics.Registry.MustRegister(eppCPUUtilization)
+		metrics.Registry.MustRegister(eppMemoryUtilization)
+		metrics.Registry.MustRegister(eppSelfPressure)
+		metrics.Registry.MustRegister(metricsRefreshInterval)
+		metrics.Registry.MustRegister(eppSelfPressureTransitionsTotal)
+		metrics.Registry.MustRegister(contextWindowEnforcementsTotal)
+		metrics.Registry.MustRegister(abandonedRequestsTotal)
+		metrics.Registry.MustRegister(wastedOutputTokensTotal)
+		metrics.Registry.MustRegister(flowControlPoolSaturated)
+		metrics.Registry.MustRegister(decisionCompareTotal)
+		metrics.Registry.MustRegister(shadowProfileDecisionsTotal)
+		metrics.Registry.MustRegister(inferencePoolSliceReadyPods)
+		metrics.Registry.MustRegister(inferencePoolSliceAvgKVCache)
+		metrics.Registry.MustRegister(inferencePoolSliceAvgQueueSize)
+		metrics.Registry.MustRegister(flowControlSliceSaturation)
+		metrics.Registry.MustRegister(schedulerBudgetExceededTotal)
+		metrics.Registry.MustRegister(promptQuarantineTotal)
+		metrics.Registry.MustRegister(predictedSLOViolationCounter)
+		metrics.Registry.MustRegister(timeAnomaliesTotal)
+		metrics.Registry.MustRegister(pluginPanicsTotal)
+		metrics.Registry.MustRegister(pluginQuarantined)
+		metrics.Registry.MustRegister(maxTokensShapedTotal)
+		metrics.Registry.MustRegister(poolPaused)
+		metrics.Registry.MustRegister(poolPausesLiftedTotal)
+		metrics.Registry.MustRegister(poolPausedRequestsTotal)
+		metrics.Registry.MustRegister(syntheticMetricsDecisionsTotal)
+		metrics.Registry.MustRegister(backendAbortsTotal)
+		metrics.Registry.MustRegister(loadHintsTotal)
+		metrics.Registry.MustRegister(scorerCacheLookupsTotal)
+		metrics.Registry.MustRegister(flowControlFamilyBudgetRejections)
+		metrics.Registry.MustRegister(endpointProbeUnhealthy)
+		metrics.Registry.MustRegister(endpointProbeUnhealthyTotal)
+		metrics.Registry.MustRegister(endpointBiasRuleMultiplier)
+		metrics.Registry.MustRegister(endpointBiasRulesTotal)
+		metrics.Registry.MustRegister(endpointBiasRulesLiftedTotal)
+		metrics.Registry.MustRegister(nonIdempotentDestinationsDroppedTotal)
+		metrics.Registry.MustRegister(staleMetricsEndpointsTotal)
+		metrics.Registry.MustRegister(schedulerDecisionScoreGap)
+		metrics.Registry.MustRegister(schedulerDecisionScoreEntropy)
+		metrics.Registry.MustRegister(spilloverRequestsTotal)
+		metrics.Registry.MustRegister(otlpMetricsExportsTotal)
+		metrics.Registry.MustRegister(flowControlTokensTotal)
+		metrics.Registry.MustRegister(requestTimeToFirstToken)
+		metrics.Registry.MustRegister(requestInterTokenLatency)
+		metrics.Registry.MustRegister(requestFallbackTotal)
+		metrics.Registry.MustRegister(poolFallbackRequestsTotal)
+		metrics.Registry.MustRegister(flowControlStarvationDispatches)
+		metrics.Registry.MustRegister(rateLimitedRequestsTotal)
+		metrics.Registry.MustRegister(endpointConcurrencyLimit)
+		metrics.Registry.MustRegister(flowControlPreemptions)
+		metrics.Registry.MustRegister(flowControlRejections)
+		metrics.Registry.MustRegister(requestSLOLatency)
+		metrics.Registry.MustRegister(requestSLOAttainment)
 		for _, collector := range customCollectors {
 			metrics.Registry.MustRegister(collector)
 		}
@@ -551,16 +1304,79 @@ func Reset() {
 	schedulerE2ELatency.Reset()
 	schedulerAttemptsTotal.Reset()
 	pluginProcessingLatencies.Reset()
+	pluginErrorsTotal.Reset()
+	pluginFilterEliminatedEndpoints.Reset()
 	inferenceExtensionInfo.Reset()
 	prefixCacheSize.Reset()
 	prefixCacheHitRatio.Reset()
 	prefixCacheHitLength.Reset()
+	prefixCacheLookupBlocksTotal.Reset()
+	prefixCacheMatchedBlocksTotal.Reset()
+	prefixCacheRequestMatchedBlocks.Reset()
+	prefixCacheEvictionsTotal.Reset()
+	prefixCacheEndpointEntries.Reset()
 	flowControlRequestQueueDuration.Reset()
 	flowControlQueueSize.Reset()
 	flowControlQueueBytes.Reset()
 	flowControlPoolSaturation.Reset()
 	flowControlRequestEnqueueDuration.Reset()
 	inferenceModelRewriteDecisionsTotal.Reset()
+	endpointExcluded.Reset()
+	endpointExclusionsTotal.Reset()
+	endpointExclusionsLiftedTotal.Reset()
+	evalRunRequestsTotal.Reset()
+	eppCPUUtilization.Set(0)
+	eppMemoryUtilization.Set(0)
+	eppSelfPressure.Set(0)
+	metricsRefreshInterval.Set(0)
+	eppSelfPressureTransitionsTotal.Reset()
+	contextWindowEnforcementsTotal.Reset()
+	abandonedRequestsTotal.Reset()
+	wastedOutputTokensTotal.Reset()
+	flowControlPoolSaturated.Reset()
+	decisionCompareTotal.Reset()
+	shadowProfileDecisionsTotal.Reset()
+	inferencePoolSliceReadyPods.Reset()
+	inferencePoolSliceAvgKVCache.Reset()
+	inferencePoolSliceAvgQueueSize.Reset()
+	flowControlSliceSaturation.Reset()
+	schedulerBudgetExceededTotal.Reset()
+	promptQuarantineTotal.Reset()
+	predictedSLOViolationCounter.Reset()
+	timeAnomaliesTotal.Reset()
+	pluginPanicsTotal.Reset()
+	pluginQuarantined.Reset()
+	maxTokensShapedTotal.Reset()
+	poolPaused.Reset()
+	poolPausesLiftedTotal.Reset()
+	poolPausedRequestsTotal.Reset()
+	syntheticMetricsDecisionsTotal.Reset()
+	backendAbortsTotal.Reset()
+	loadHintsTotal.Reset()
+	scorerCacheLookupsTotal.Reset()
+	flowControlFamilyBudgetRejections.Reset()
+	endpointProbeUnhealthy.Reset()
+	endpointBiasRuleMultiplier.Reset()
+	endpointBiasRulesTotal.Reset()
+	endpointBiasRulesLiftedTotal.Reset()
+	nonIdempotentDestinationsDroppedTotal.Reset()
+	staleMetricsEndpointsTotal.Reset()
+	schedulerDecisionScoreGap.Reset()
+	schedulerDecisionScoreEntropy.Reset()
+	spilloverRequestsTotal.Reset()
+	otlpMetricsExportsTotal.Reset()
+	flowControlTokensTotal.Reset()
+	requestTimeToFirstToken.Reset()
+	requestInterTokenLatency.Reset()
+	requestFallbackTotal.Reset()
+	poolFallbackRequestsTotal.Reset()
+	flowControlStarvationDispatches.Reset()
+	rateLimitedRequestsTotal.Reset()
+	endpointConcurrencyLimit.Reset()
+	flowControlPreemptions.Reset()
+	flowControlRejections.Reset()
+	requestSLOLatency.Reset()
+	requestSLOAttainment.Reset()
 }
 
 // RecordRequestCounter records the number of requests.
@@ -817,9 +1633,27 @@ const (
 	SchedulerStatusFailure = "failure"
 )
 
-// RecordPluginProcessingLatency records the processing latency for a plugin.
+// RecordPluginProcessingLatency records the processing latency for a plugin run outside of a scheduling profile.
 func RecordPluginProcessingLatency(extensionPoint, pluginType, pluginName string, duration time.Duration) {
-	pluginProcessingLatencies.WithLabelValues(extensionPoint, pluginType, pluginName).Observe(duration.Seconds())
+	RecordProfilePluginProcessingLatency("", extensionPoint, pluginType, pluginName, duration)
+}
+
+// RecordProfilePluginProcessingLatency records the processing latency for a plugin run in the given scheduling
+// profile.
+func RecordProfilePluginProcessingLatency(profile, extensionPoint, pluginType, pluginName string, duration time.Duration) {
+	pluginProcessingLatencies.WithLabelValues(extensionPoint, pluginType, pluginName, profile).Observe(duration.Seconds())
+}
+
+// RecordPluginError records a failed plugin run. The profile is empty for plugins run outside of a scheduling
+// profile.
+func RecordPluginError(profile, extensionPoint, pluginType, pluginName string) {
+	pluginErrorsTotal.WithLabelValues(extensionPoint, pluginType, pluginName, profile).Inc()
+}
+
+// RecordPluginFilterEliminatedEndpoints records the number of endpoints eliminated by a filter plugin run in the
+// given scheduling profile.
+func RecordPluginFilterEliminatedEndpoints(profile, pluginType, pluginName string, eliminated int) {
+	pluginFilterEliminatedEndpoints.WithLabelValues(pluginType, pluginName, profile).Observe(float64(eliminated))
 }
 
 // RecordPrefixCacheSize records the size of the prefix indexer in megabytes.
@@ -840,6 +1674,32 @@ func RecordPrefixCacheMatch(matchedLength, totalLength int) {
 	}
 }
 
+// RecordPrefixCacheBlocksMatch records the prefix blocks of a request of the given target model looked up in the prefix
+// indexer, and those estimated to be cached on the endpoint it was routed to, attributed to that endpoint.
+func RecordPrefixCacheBlocksMatch(targetModelName, namespace, name string, matchedBlocks, totalBlocks int) {
+	prefixCacheLookupBlocksTotal.WithLabelValues(targetModelName).Add(float64(totalBlocks))
+	prefixCacheMatchedBlocksTotal.WithLabelValues(targetModelName, namespace, name).Add(float64(matchedBlocks))
+	prefixCacheRequestMatchedBlocks.WithLabelValues(targetModelName).Observe(float64(matchedBlocks))
+}
+
+// RecordPrefixCacheEvictions records prefix blocks evicted from the prefix indexer of the given endpoint.
+func RecordPrefixCacheEvictions(namespace, name string, evicted int) {
+	prefixCacheEvictionsTotal.WithLabelValues(namespace, name).Add(float64(evicted))
+}
+
+// RecordPrefixCacheEndpointEntries records the number of prefix blocks the prefix indexer tracks for the given endpoint.
+func RecordPrefixCacheEndpointEntries(namespace, name string, entries int) {
+	prefixCacheEndpointEntries.WithLabelValues(namespace, name).Set(float64(entries))
+}
+
+// DeletePrefixCacheEndpoint deletes the prefix indexer series of the given endpoint, once it left the indexer.
+func DeletePrefixCacheEndpoint(namespace, name string) {
+	endpoint := prometheus.Labels{"namespace": namespace, "name": name}
+	prefixCacheMatchedBlocksTotal.DeletePartialMatch(endpoint)
+	prefixCacheEvictionsTotal.DeletePartialMatch(endpoint)
+	prefixCacheEndpointEntries.DeletePartialMatch(endpoint)
+}
+
 func RecordInferenceExtensionInfo(commitSha, buildRef string) {
 	inferenceExtensionInfo.WithLabelValues(commitSha, buildRef).Set(1)
 }
@@ -902,3 +1762,404 @@ func RecordFlowControlPoolSaturation(inferencePool string, saturation float64) {
 func RecordInferenceModelRewriteDecision(modelRewriteName, modelName, targetModel string) {
 	inferenceModelRewriteDecisionsTotal.WithLabelValues(modelRewriteName, modelName, targetModel).Inc()
 }
+
+const (
+	// EndpointExclusionExpired is the cause recorded when an endpoint exclusion reaches its expiry time.
+	EndpointExclusionExpired = "expired"
+	// EndpointExclusionRemoved is the cause recorded when an endpoint exclusion is explicitly removed.
+	EndpointExclusionRemoved = "removed"
+)
+
+// RecordEndpointExclusion records that the given endpoint or pod was excluded from scheduling.
+func RecordEndpointExclusion(namespace, name, source string) {
+	endpointExcluded.WithLabelValues(namespace, name).Set(1)
+	endpointExclusionsTotal.WithLabelValues(source).Inc()
+}
+
+// RecordEndpointExclusionLifted records that the exclusion of the given endpoint or pod was lifted.
+func RecordEndpointExclusionLifted(namespace, name, cause string) {
+	endpointExcluded.DeleteLabelValues(namespace, name)
+	endpointExclusionsLiftedTotal.WithLabelValues(cause).Inc()
+}
+
+// RecordEvalRunRequest records a request of an evaluation run served by the given endpoint. The run ID is not a label:
+// it is chosen by the clients, which would control the cardinality of the metric.
+func RecordEvalRunRequest(targetModelName, namespace, name string) {
+	evalRunRequestsTotal.WithLabelValues(targetModelName, namespace, name).Inc()
+}
+
+// RecordEPPResourceUtilization records the EPP's own CPU and memory utilization.
+func RecordEPPResourceUtilization(cpu, memory float64) {
+	eppCPUUtilization.Set(cpu)
+	eppMemoryUtilization.Set(memory)
+}
+
+// RecordEPPSelfPressure records a transition into or out of the EPP resource pressure state.
+func RecordEPPSelfPressure(underPressure bool) {
+	if underPressure {
+		eppSelfPressure.Set(1)
+		eppSelfPressureTransitionsTotal.WithLabelValues("entered").Inc()
+		return
+	}
+	eppSelfPressure.Set(0)
+	eppSelfPressureTransitionsTotal.WithLabelValues("exited").Inc()
+}
+
+// RecordMetricsRefreshInterval records the interval at which the metrics of the model servers are currently refreshed.
+func RecordMetricsRefreshInterval(interval time.Duration) {
+	metricsRefreshInterval.Set(interval.Seconds())
+}
+
+// RecordContextWindowEnforcement records a request exceeding the context window of its target model and the action
+// taken on it.
+func RecordContextWindowEnforcement(targetModelName, action string) {
+	contextWindowEnforcementsTotal.WithLabelValues(targetModelName, action).Inc()
+}
+
+// RecordAbandonedRequest records a request abandoned by its client, and the output tokens generated for it.
+func RecordAbandonedRequest(modelName, targetModelName string, wastedOutputTokens int) {
+	abandonedRequestsTotal.WithLabelValues(modelName, targetModelName).Inc()
+	if wastedOutputTokens > 0 {
+		wastedOutputTokensTotal.WithLabelValues(modelName, targetModelName).Add(float64(wastedOutputTokens))
+	}
+}
+
+// RecordFlowControlPoolSaturated records whether an inference pool is saturated, and the constraint that saturates it.
+// The gauge of every other known reason is reset to 0.
+func RecordFlowControlPoolSaturated(inferencePool string, saturated bool, reason string, knownReasons []string) {
+	for _, r := range knownReasons {
+		value := 0.0
+		if saturated && r == reason {
+			value = 1
+		}
+		flowControlPoolSaturated.WithLabelValues(inferencePool, r).Set(value)
+	}
+}
+
+// RecordDecisionCompare records the result of comparing a scheduling decision against the decision of the active EPP.
+func RecordDecisionCompare(modelName, result string) {
+	decisionCompareTotal.WithLabelValues(modelName, result).Inc()
+}
+
+// RecordShadowProfileDecision records the run of a shadow scheduling profile and how its decision compares with the
+// decision of the primary profile.
+func RecordShadowProfileDecision(profile, result string) {
+	shadowProfileDecisionsTotal.WithLabelValues(profile, result).Inc()
+}
+
+// RecordInferencePoolSlices records the metrics of the slices of the inference pool, aggregated over the given
+// endpoints, and deletes the series of the slices which are no longer part of the pool.
+func RecordInferencePoolSlices(name string, endpoints []fwkdl.Endpoint) {
+	slices := fwkdl.GroupBySlice(endpoints)
+
+	recordedPoolSlicesMu.Lock()
+	defer recordedPoolSlicesMu.Unlock()
+	for slice := range recordedPoolSlices[name] {
+		if _, ok := slices[slice]; !ok {
+			inferencePoolSliceReadyPods.DeleteLabelValues(name, slice)
+			inferencePoolSliceAvgKVCache.DeleteLabelValues(name, slice)
+			inferencePoolSliceAvgQueueSize.DeleteLabelValues(name, slice)
+		}
+	}
+	recorded := make(map[string]bool, len(slices))
+	for slice, sliceEndpoints := range slices {
+		var kvCacheTotal float64
+		var queueTotal int
+		for _, endpoint := range sliceEndpoints {
+			kvCacheTotal += endpoint.GetMetrics().KVCacheUsagePercent
+			queueTotal += endpoint.GetMetrics().WaitingQueueSize
+		}
+		count := float64(len(sliceEndpoints))
+		inferencePoolSliceReadyPods.WithLabelValues(name, slice).Set(count)
+		inferencePoolSliceAvgKVCache.WithLabelValues(name, slice).Set(kvCacheTotal / count)
+		inferencePoolSliceAvgQueueSize.WithLabelValues(name, slice).Set(float64(queueTotal) / count)
+		recorded[slice] = true
+	}
+	recordedPoolSlices[name] = recorded
+}
+
+// RecordFlowControlSliceSaturation records the current saturation level of a slice of the inference pool.
+func RecordFlowControlSliceSaturation(inferencePool, slice string, saturation float64) {
+	flowControlSliceSaturation.WithLabelValues(inferencePool, slice).Set(saturation)
+}
+
+// RecordSchedulerBudgetExceeded records a scheduling profile run exceeding the given budget ("profile" or "plugin")
+// while running, or before starting, the given scorer.
+func RecordSchedulerBudgetExceeded(budget, pluginType, pluginName string) {
+	schedulerBudgetExceededTotal.WithLabelValues(budget, pluginType, pluginName).Inc()
+}
+
+// RecordPromptQuarantine records an action of the prompt quarantine: a prompt pattern being quarantined, or a request
+// matching a quarantined pattern being isolated or rejected.
+func RecordPromptQuarantine(targetModelName, action string) {
+	promptQuarantineTotal.WithLabelValues(targetModelName, action).Inc()
+}
+
+// RecordRequestPredictedSLOViolation counts a request scheduled on an endpoint predicted to violate its latency
+// objectives, with the reason of the predicted violation: capacity or scheduling.
+func RecordRequestPredictedSLOViolation(modelName, targetModelName, reason string) {
+	predictedSLOViolationCounter.WithLabelValues(modelName, targetModelName, reason).Inc()
+}
+
+// RecordTimeAnomaly records a timestamp, duration or rate found anomalous by the given source.
+func RecordTimeAnomaly(source, anomaly string) {
+	timeAnomaliesTotal.WithLabelValues(source, anomaly).Inc()
+}
+
+// RecordPluginPanic records a plugin run that panicked and was recovered.
+func RecordPluginPanic(extensionPoint, pluginType, pluginName string) {
+	pluginPanicsTotal.WithLabelValues(extensionPoint, pluginType, pluginName).Inc()
+}
+
+// RecordPluginQuarantined records whether a plugin is quarantined.
+func RecordPluginQuarantined(pluginType, pluginName string, quarantined bool) {
+	value := 0.0
+	if quarantined {
+		value = 1
+	}
+	pluginQuarantined.WithLabelValues(pluginType, pluginName).Set(value)
+}
+
+// RecordMaxTokensShaped records a request given a completion length based on the pool headroom.
+func RecordMaxTokensShaped(targetModelName string) {
+	maxTokensShapedTotal.WithLabelValues(targetModelName).Inc()
+}
+
+const (
+	// PoolPauseExpired is the cause recorded when a pool pause reaches its expiry time.
+	PoolPauseExpired = "expired"
+	// PoolPauseResumed is the cause recorded when a pool is explicitly resumed.
+	PoolPauseResumed = "resumed"
+
+	// PoolPausedRequestHeld is the outcome recorded when a request is held while the pool is paused.
+	PoolPausedRequestHeld = "held"
+	// PoolPausedRequestRejected is the outcome recorded when a request is rejected while the pool is paused.
+	PoolPausedRequestRejected = "rejected"
+	// PoolPausedRequestAbandoned is the outcome recorded when the client of a held request gives up.
+	PoolPausedRequestAbandoned = "abandoned"
+)
+
+// RecordPoolPause records that the dispatch of requests to the given pool is paused with the given policy.
+func RecordPoolPause(poolName, policy string) {
+	poolPaused.DeletePartialMatch(prometheus.Labels{"inference_pool": poolName})
+	poolPaused.WithLabelValues(poolName, policy).Set(1)
+}
+
+// RecordPoolPauseLifted records that the pause of the given pool was lifted.
+func RecordPoolPauseLifted(poolName, cause string) {
+	poolPaused.DeletePartialMatch(prometheus.Labels{"inference_pool": poolName})
+	poolPausesLiftedTotal.WithLabelValues(poolName, cause).Inc()
+}
+
+// RecordPoolPausedRequest records the outcome of a request received while the given pool was paused.
+func RecordPoolPausedRequest(poolName, outcome string) {
+	poolPausedRequestsTotal.WithLabelValues(poolName, outcome).Inc()
+}
+
+// RecordSyntheticMetricsDecision records a request scheduled on an endpoint whose metrics were backfilled.
+func RecordSyntheticMetricsDecision(targetModelName string) {
+	syntheticMetricsDecisionsTotal.WithLabelValues(targetModelName).Inc()
+}
+
+const (
+	// BackendAbortAbandoned is the cause recorded when the client of the request disconnected.
+	BackendAbortAbandoned = "abandoned"
+	// BackendAbortEvicted is the cause recorded when the request was evicted by flow control.
+	BackendAbortEvicted = "evicted"
+)
+
+// RecordBackendAbort records an abort call made to a model server, and whether it succeeded.
+func RecordBackendAbort(cause string, success bool) {
+	outcome := "success"
+	if !success {
+		outcome = "failure"
+	}
+	backendAbortsTotal.WithLabelValues(cause, outcome).Inc()
+}
+
+// RecordLoadHint records a load hint pushed by a model server, and whether it was accepted.
+func RecordLoadHint(kind string, accepted bool) {
+	outcome := "accepted"
+	if !accepted {
+		outcome = "dropped"
+	}
+	loadHintsTotal.WithLabelValues(kind, outcome).Inc()
+}
+
+// RecordScorerCacheLookups records the endpoint score lookups of a scoring run of a cacheable scorer, hits being the
+// endpoints whose score was cached and misses the endpoints that were scored.
+func RecordScorerCacheLookups(pluginType, pluginName string, hits, misses int) {
+	scorerCacheLookupsTotal.WithLabelValues(pluginType, pluginName, "hit").Add(float64(hits))
+	scorerCacheLookupsTotal.WithLabelValues(pluginType, pluginName, "miss").Add(float64(misses))
+}
+
+// RecordFlowControlFamilyBudgetRejection records a request rejected because its family exhausted its budget, for the
+// given reason: tokens or duration.
+func RecordFlowControlFamilyBudgetRejection(inferencePool, reason string) {
+	flowControlFamilyBudgetRejections.WithLabelValues(inferencePool, reason).Inc()
+}
+
+// RecordEndpointProbeUnhealthy records that the given endpoint was marked unhealthy by active health probing.
+func RecordEndpointProbeUnhealthy(namespace, name string) {
+	endpointProbeUnhealthy.WithLabelValues(namespace, name).Set(1)
+	endpointProbeUnhealthyTotal.Inc()
+}
+
+// RecordEndpointProbeHealthy records that the given endpoint is not unhealthy anymore, or is gone.
+func RecordEndpointProbeHealthy(namespace, name string) {
+	endpointProbeUnhealthy.DeleteLabelValues(namespace, name)
+}
+
+const (
+	// EndpointBiasRuleExpired is the cause recorded when an endpoint bias rule reaches its expiry time.
+	EndpointBiasRuleExpired = "expired"
+	// EndpointBiasRuleRemoved is the cause recorded when an endpoint bias rule is explicitly removed.
+	EndpointBiasRuleRemoved = "removed"
+)
+
+// RecordEndpointBiasRule records that the given bias rule was set.
+func RecordEndpointBiasRule(rule, source string, multiplier float64) {
+	endpointBiasRuleMultiplier.WithLabelValues(rule).Set(multiplier)
+	endpointBiasRulesTotal.WithLabelValues(source).Inc()
+}
+
+// RecordEndpointBiasRuleLifted records that the given bias rule was lifted.
+func RecordEndpointBiasRuleLifted(rule, cause string) {
+	endpointBiasRuleMultiplier.DeleteLabelValues(rule)
+	endpointBiasRulesLiftedTotal.WithLabelValues(cause).Inc()
+}
+
+// RecordNonIdempotentDestinationsDropped records the fallback or redundant destinations dropped from a non-idempotent
+// request.
+func RecordNonIdempotentDestinationsDropped(targetModelName string, dropped int) {
+	nonIdempotentDestinationsDroppedTotal.WithLabelValues(targetModelName).Add(float64(dropped))
+}
+
+// RecordStaleMetricsEndpoints records the candidate endpoints of a request whose metrics were stale, and the action
+// applied to them.
+func RecordStaleMetricsEndpoints(action string, count int) {
+	staleMetricsEndpointsTotal.WithLabelValues(action).Add(float64(count))
+}
+
+// RecordSchedulingConfidence records the confidence of a scheduling decision of the given profile: the relative gap
+// between its two best scores, and the normalized entropy of its scores.
+func RecordSchedulingConfidence(profile string, gap, entropy float64) {
+	schedulerDecisionScoreGap.WithLabelValues(profile).Observe(gap)
+	schedulerDecisionScoreEntropy.WithLabelValues(profile).Observe(entropy)
+}
+
+// RecordSpillover records the outcome of a request received while the flow control queues of the given pool were over
+// the spillover threshold.
+func RecordSpillover(poolName, outcome string) {
+	spilloverRequestsTotal.WithLabelValues(poolName, outcome).Inc()
+}
+
+// RecordOTLPMetricsExport records the resource metrics of an endpoint pushed on the OTLP receiver, and whether they
+// were accepted.
+func RecordOTLPMetricsExport(accepted bool) {
+	outcome := "accepted"
+	if !accepted {
+		outcome = "dropped"
+	}
+	otlpMetricsExportsTotal.WithLabelValues(outcome).Inc()
+}
+
+// RecordFlowControlTokens records the prompt and completion tokens consumed by a request dispatched by the Flow Control
+// layer, as reported in its response.
+func RecordFlowControlTokens(fairnessID, priority, inferencePool, modelName, targetModelName string, promptTokens, completionTokens int) {
+	flowControlTokensTotal.WithLabelValues(fairnessID, priority, inferencePool, modelName, targetModelName, "prompt").Add(float64(promptTokens))
+	flowControlTokensTotal.WithLabelValues(fairnessID, priority, inferencePool, modelName, targetModelName, "completion").Add(float64(completionTokens))
+}
+
+// RecordRequestTimeToFirstToken records the time to the first token event of a streamed response.
+func RecordRequestTimeToFirstToken(inferencePool, modelName, targetModelName string, ttft time.Duration) {
+	requestTimeToFirstToken.WithLabelValues(inferencePool, modelName, targetModelName).Observe(ttft.Seconds())
+}
+
+// RecordRequestInterTokenLatency records the latency of the given number of token events of a streamed response,
+// received together after the given latency each.
+func RecordRequestInterTokenLatency(inferencePool, modelName, targetModelName string, latency time.Duration, tokens int) {
+	observer := requestInterTokenLatency.WithLabelValues(inferencePool, modelName, targetModelName)
+	for range tokens {
+		observer.Observe(latency.Seconds())
+	}
+}
+
+// RecordRequestFallback records a request served by a fallback destination because the proxy failed to reach the
+// picked one.
+func RecordRequestFallback(modelName, targetModelName string) {
+	requestFallbackTotal.WithLabelValues(modelName, targetModelName).Inc()
+}
+
+// RecordPoolFallback records the outcome of a request opting in to the pool fallback received while the given pool
+// was saturated.
+func RecordPoolFallback(poolName, secondaryPoolName, outcome string) {
+	poolFallbackRequestsTotal.WithLabelValues(poolName, secondaryPoolName, outcome).Inc()
+}
+
+// RecordFlowControlStarvationDispatch records a request of the given priority dispatched ahead of higher priority bands
+// because it was queued longer than the starvation threshold.
+func RecordFlowControlStarvationDispatch(inferencePool string, priority int) {
+	flowControlStarvationDispatches.WithLabelValues(inferencePool, strconv.Itoa(priority)).Inc()
+}
+
+// RecordRateLimitedRequest records a request rejected for exceeding the rate limit of the given resource.
+func RecordRateLimitedRequest(targetModelName, resource string) {
+	rateLimitedRequestsTotal.WithLabelValues(targetModelName, resource).Inc()
+}
+
+// RecordEndpointConcurrencyLimit records the concurrency limit learned for the given endpoint.
+func RecordEndpointConcurrencyLimit(namespace, name string, limit float64) {
+	endpointConcurrencyLimit.WithLabelValues(namespace, name).Set(limit)
+}
+
+// DeleteEndpointConcurrencyLimit removes the concurrency limit series of an endpoint which left the pool.
+func DeleteEndpointConcurrencyLimit(namespace, name string) {
+	endpointConcurrencyLimit.DeleteLabelValues(namespace, name)
+}
+
+// RecordFlowControlPreemption records a queued request of the given flow evicted to make room for a request of higher
+// priority.
+func RecordFlowControlPreemption(inferencePool, fairnessID string, priority int) {
+	flowControlPreemptions.WithLabelValues(inferencePool, fairnessID, strconv.Itoa(priority)).Inc()
+}
+
+// RecordFlowControlRejection records a request of the given flow rejected by the Flow Control layer with the given
+// outcome.
+func RecordFlowControlRejection(inferencePool, fairnessID, priority, outcome string) {
+	flowControlRejections.WithLabelValues(inferencePool, fairnessID, priority, outcome).Inc()
+}
+
+// RequestLatencies are the latencies of a completed request. Zero means the latency was not measured, e.g. the time
+// to first token of a response which was not streamed.
+type RequestLatencies struct {
+	TTFT time.Duration
+	TPOT time.Duration
+	E2E  time.Duration
+}
+
+// RequestLatencyObjectives are the latency objectives of a request. Zero means no objective was declared.
+type RequestLatencyObjectives struct {
+	TTFT time.Duration
+	TPOT time.Duration
+}
+
+// RecordRequestSLOAttainment records the latencies of a completed request of the given priority, and whether they met
+// the objectives of the request.
+func RecordRequestSLOAttainment(modelName, targetModelName string, priority int, latencies RequestLatencies,
+	objectives RequestLatencyObjectives) {
+	prio := strconv.Itoa(priority)
+	record := func(latencyType string, latency, objective time.Duration) {
+		if latency <= 0 {
+			return
+		}
+		requestSLOLatency.WithLabelValues(modelName, targetModelName, prio, latencyType).Observe(latency.Seconds())
+		if objective > 0 {
+			met := strconv.FormatBool(latency <= objective)
+			requestSLOAttainment.WithLabelValues(modelName, targetModelName, prio, latencyType, met).Inc()
+		}
+	}
+	record(typeTTFT, latencies.TTFT, objectives.TTFT)
+	record(typeTPOT, latencies.TPOT, objectives.TPOT)
+	record("e2e", latencies.E2E, 0)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
 	k8stypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/component-base/metrics/testutil"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
@@ -597,6 +598,7 @@ func TestInferencePoolMetrics(t *testing.T) {
 func TestPluginProcessingLatencies(t *testing.T) {
 	Reset()
 	type pluginLatency struct {
+		profile        string
 		extensionPoint string
 		pluginType     string
 		pluginName     string
@@ -616,18 +618,21 @@ func TestPluginProcessingLatencies(t *testing.T) {
 					duration:       200 * time.Millisecond,
 				},
 				{
+					profile:        "default",
 					extensionPoint: "Filter",
 					pluginType:     "TestFilter",
 					pluginName:     "PluginC",
 					duration:       50 * time.Millisecond,
 				},
 				{
+					profile:        "default",
 					extensionPoint: "Scorer",
 					pluginType:     "TestScorer",
 					pluginName:     "PluginD",
 					duration:       10 * time.Millisecond,
 				},
 				{
+					profile:        "default",
 					extensionPoint: "Picker",
 					pluginType:     "TestPicker",
 					pluginName:     "PluginE",
@@ -639,7 +644,7 @@ func TestPluginProcessingLatencies(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name, func(t *testing.T) {
 			for _, latency := range scenario.latencies {
-				RecordPluginProcessingLatency(latency.extensionPoint, latency.pluginType, latency.pluginName, latency.duration)
+				RecordProfilePluginProcessingLatency(latency.profile, latency.extensionPoint, latency.pluginType, latency.pluginName, latency.duration)
 			}
 
 			wantPluginLatencies, err := os.Open("testdata/plugin_processing_latencies_metric")
@@ -1063,6 +1068,83 @@ func getHistogramVecLabelValues(t *testing.T, h *prometheus.HistogramVec, labelV
 	return metricDto.GetHistogram(), nil
 }
 
+func TestPrefixCacheEffectivenessMetrics(t *testing.T) {
+	Reset()
+	countSeries := func(collector prometheus.Collector) int {
+		ch := make(chan prometheus.Metric, 10)
+		collector.Collect(ch)
+		close(ch)
+		return len(ch)
+	}
+	requireCounter := func(want float64, counter prometheus.Counter) {
+		t.Helper()
+		val, err := testutil.GetCounterMetricValue(counter)
+		require.NoError(t, err)
+		require.Equal(t, want, val)
+	}
+
+	RecordPrefixCacheBlocksMatch("m1", "ns", "pod1", 6, 8)
+	RecordPrefixCacheBlocksMatch("m1", "ns", "pod2", 0, 4)
+	RecordPrefixCacheBlocksMatch("m2", "ns", "pod1", 2, 2)
+	RecordPrefixCacheEvictions("ns", "pod1", 3)
+	RecordPrefixCacheEndpointEntries("ns", "pod1", 100)
+	RecordPrefixCacheEndpointEntries("ns", "pod2", 50)
+
+	requireCounter(12, prefixCacheLookupBlocksTotal.WithLabelValues("m1"))
+	requireCounter(6, prefixCacheMatchedBlocksTotal.WithLabelValues("m1", "ns", "pod1"))
+	requireCounter(0, prefixCacheMatchedBlocksTotal.WithLabelValues("m1", "ns", "pod2"))
+	requireCounter(2, prefixCacheMatchedBlocksTotal.WithLabelValues("m2", "ns", "pod1"))
+	require.Equal(t, 2, countSeries(prefixCacheRequestMatchedBlocks))
+	requireCounter(3, prefixCacheEvictionsTotal.WithLabelValues("ns", "pod1"))
+	val, err := testutil.GetGaugeMetricValue(prefixCacheEndpointEntries.WithLabelValues("ns", "pod1"))
+	require.NoError(t, err)
+	require.Equal(t, 100.0, val)
+
+	// The series of an endpoint which left the indexer are deleted.
+	DeletePrefixCacheEndpoint("ns", "pod1")
+	require.Equal(t, 1, countSeries(prefixCacheMatchedBlocksTotal))
+	require.Equal(t, 0, countSeries(prefixCacheEvictionsTotal))
+	require.Equal(t, 1, countSeries(prefixCacheEndpointEntries))
+	require.Equal(t, 2, countSeries(prefixCacheLookupBlocksTotal), "the model series must be kept")
+}
+
+func TestRequestSLOAttainmentMetrics(t *testing.T) {
+	Reset()
+	countSeries := func(collector prometheus.Collector) int {
+		ch := make(chan prometheus.Metric, 10)
+		collector.Collect(ch)
+		close(ch)
+		return len(ch)
+	}
+	requireCounter := func(want float64, labels ...string) {
+		t.Helper()
+		val, err := testutil.GetCounterMetricValue(requestSLOAttainment.WithLabelValues(labels...))
+		require.NoError(t, err)
+		require.Equal(t, want, val)
+	}
+	objectives := RequestLatencyObjectives{TTFT: 200 * time.Millisecond, TPOT: 50 * time.Millisecond}
+
+	RecordRequestSLOAttainment("m1", "t1", 0, RequestLatencies{
+		TTFT: 100 * time.Millisecond, TPOT: 40 * time.Millisecond, E2E: time.Second,
+	}, objectives)
+	RecordRequestSLOAttainment("m1", "t1", 0, RequestLatencies{
+		TTFT: 300 * time.Millisecond, TPOT: 50 * time.Millisecond, E2E: 2 * time.Second,
+	}, objectives)
+	// Without objectives, the latencies are recorded but not evaluated.
+	RecordRequestSLOAttainment("m1", "t1", 10, RequestLatencies{
+		TTFT: 100 * time.Millisecond, TPOT: 40 * time.Millisecond, E2E: time.Second,
+	}, RequestLatencyObjectives{})
+	// The latencies of a response which was not streamed are not measured.
+	RecordRequestSLOAttainment("m2", "t2", 0, RequestLatencies{E2E: time.Second}, objectives)
+
+	requireCounter(1, "m1", "t1", "0", "ttft", "true")
+	requireCounter(1, "m1", "t1", "0", "ttft", "false")
+	requireCounter(2, "m1", "t1", "0", "tpot", "true")
+	require.Equal(t, 3, countSeries(requestSLOAttainment))
+	// ttft, tpot and e2e for each priority of m1, e2e only for m2.
+	require.Equal(t, 7, countSeries(requestSLOLatency))
+}
+
 func TestFlowControlQueueDurationMetric(t *testing.T) {
 	Reset()
 
@@ -1270,6 +1352,67 @@ func TestFlowControlPoolSaturationMetric(t *testing.T) {
 	require.Equal(t, 0.0, val, "Gauge value for non-existent pool should be 0")
 }
 
+func TestFlowControlPoolSaturatedMetric(t *testing.T) {
+	Reset()
+
+	const pool = "test-pool"
+	reasons := []string{"endpoint_capacity", "pool_concurrency_ceiling"}
+
+	RecordFlowControlPoolSaturated(pool, true, "pool_concurrency_ceiling", reasons)
+	val, err := testutil.GetGaugeMetricValue(flowControlPoolSaturated.WithLabelValues(pool, "pool_concurrency_ceiling"))
+	require.NoError(t, err)
+	require.Equal(t, 1.0, val, "Gauge value should be 1 for the saturating reason")
+	val, err = testutil.GetGaugeMetricValue(flowControlPoolSaturated.WithLabelValues(pool, "endpoint_capacity"))
+	require.NoError(t, err)
+	require.Equal(t, 0.0, val, "Gauge value should be 0 for other reasons")
+
+	RecordFlowControlPoolSaturated(pool, false, "pool_concurrency_ceiling", reasons)
+	val, err = testutil.GetGaugeMetricValue(flowControlPoolSaturated.WithLabelValues(pool, "pool_concurrency_ceiling"))
+	require.NoError(t, err)
+	require.Equal(t, 0.0, val, "Gauge value should be 0 once the pool is no longer saturated")
+}
+
+func TestInferencePoolSliceMetrics(t *testing.T) {
+	Reset()
+
+	const pool = "test-pool"
+	newEndpoint := func(name, slice string, kvCache float64, queueSize int) fwkdl.Endpoint {
+		return fwkdl.NewEndpoint(
+			&fwkdl.EndpointMetadata{
+				NamespacedName: types.NamespacedName{Name: name},
+				Labels:         map[string]string{fwkdl.SliceLabel: slice},
+			},
+			&fwkdl.Metrics{KVCacheUsagePercent: kvCache, WaitingQueueSize: queueSize})
+	}
+	countSeries := func(collector prometheus.Collector) int {
+		ch := make(chan prometheus.Metric, 10)
+		collector.Collect(ch)
+		close(ch)
+		return len(ch)
+	}
+
+	RecordInferencePoolSlices(pool, []fwkdl.Endpoint{
+		newEndpoint("a", "tp8", 0.2, 2),
+		newEndpoint("b", "tp8", 0.4, 4),
+		newEndpoint("c", "tp2", 0.9, 1),
+	})
+	val, err := testutil.GetGaugeMetricValue(inferencePoolSliceReadyPods.WithLabelValues(pool, "tp8"))
+	require.NoError(t, err)
+	require.Equal(t, 2.0, val)
+	val, err = testutil.GetGaugeMetricValue(inferencePoolSliceAvgKVCache.WithLabelValues(pool, "tp8"))
+	require.NoError(t, err)
+	require.InDelta(t, 0.3, val, 1e-9)
+	val, err = testutil.GetGaugeMetricValue(inferencePoolSliceAvgQueueSize.WithLabelValues(pool, "tp8"))
+	require.NoError(t, err)
+	require.Equal(t, 3.0, val)
+	require.Equal(t, 2, countSeries(inferencePoolSliceReadyPods))
+
+	// The series of a slice which is no longer part of the pool are deleted.
+	RecordInferencePoolSlices(pool, []fwkdl.Endpoint{newEndpoint("c", "tp2", 0.9, 1)})
+	require.Equal(t, 1, countSeries(inferencePoolSliceReadyPods))
+	require.Equal(t, 1, countSeries(inferencePoolSliceAvgKVCache))
+}
+
 func TestInferenceModelRewriteDecisionsTotalMetric(t *testing.T) {
 	Reset()
 
@@ -1302,3 +1445,24 @@ func TestInferenceModelRewriteDecisionsTotalMetric(t *testing.T) {
 		})
 	}
 }
+
+func TestPluginErrorsAndEliminatedEndpoints(t *testing.T) {
+	Reset()
+
+	RecordPluginError("default", "Scorer", "test-scorer", "scorer")
+	RecordPluginError("default", "Scorer", "test-scorer", "scorer")
+	RecordPluginError("", "RequestMutation", "test-mutator", "mutator")
+	val, err := testutil.GetCounterMetricValue(pluginErrorsTotal.WithLabelValues("Scorer", "test-scorer", "scorer", "default"))
+	require.NoError(t, err)
+	require.Equal(t, 2.0, val)
+	val, err = testutil.GetCounterMetricValue(pluginErrorsTotal.WithLabelValues("RequestMutation", "test-mutator", "mutator", ""))
+	require.NoError(t, err)
+	require.Equal(t, 1.0, val)
+
+	RecordPluginFilterEliminatedEndpoints("default", "test-filter", "filter", 3)
+	RecordPluginFilterEliminatedEndpoints("default", "test-filter", "filter", 0)
+	hist, err := getHistogramVecLabelValues(t, pluginFilterEliminatedEndpoints, "test-filter", "filter", "default")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), hist.GetSampleCount())
+	require.Equal(t, 3.0, hist.GetSampleSum())
+}
@@ -0,0 +1,297 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package otlpreceiver receives the load metrics that model servers, or their sidecars, push with the OTLP/HTTP
+// protocol, for deployments in which the EPP cannot scrape the model servers, e.g. because the pod networking does not
+// allow connections initiated by the EPP. The pushed datapoints update the same metrics as the scrapes.
+//
+// The receiver does not authenticate the pushes: it only accepts the metrics of an endpoint pushed from the IP of the
+// endpoint's own pod, so that a client cannot spoof the load of other pods. Its port must still not be exposed outside
+// the cluster.
+package otlpreceiver
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	collectorpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+const (
+	// HandlerPath is the path on which the OTLP/HTTP metrics are received.
+	HandlerPath = "/v1/metrics"
+
+	// maxBodyBytes bounds the size of an export request.
+	maxBodyBytes = 4 << 20
+
+	// The resource attributes identifying the endpoint that pushed the metrics, from the OpenTelemetry semantic
+	// conventions.
+	podNameAttribute = "k8s.pod.name"
+	podIPAttribute   = "k8s.pod.ip"
+	portAttribute    = "server.port"
+
+	contentTypeProtobuf = "application/x-protobuf"
+	contentTypeJSON     = "application/json"
+)
+
+// Datastore lists the endpoints of the pool.
+type Datastore interface {
+	PodList(predicate func(fwkdl.Endpoint) bool) []fwkdl.Endpoint
+}
+
+// Receiver implements the OTLP/HTTP metrics receiver, updating the metrics of the endpoints of the pool with the
+// pushed datapoints.
+type Receiver struct {
+	datastore Datastore
+	mapping   *backendmetrics.MetricMapping
+	now       func() time.Time
+}
+
+// NewReceiver returns a new OTLP/HTTP metrics receiver, which maps the pushed datapoints to the endpoint metrics with
+// the given mapping, as the scrapes do.
+func NewReceiver(datastore Datastore, mapping *backendmetrics.MetricMapping) *Receiver {
+	return &Receiver{datastore: datastore, mapping: mapping, now: time.Now}
+}
+
+// ServeHTTP handles an OTLP/HTTP export request, encoded in protobuf or in JSON.
+func (r *Receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	contentType, _, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if err != nil || (contentType != contentTypeProtobuf && contentType != contentTypeJSON) {
+		http.Error(w, fmt.Sprintf("unsupported content type, expected %s or %s", contentTypeProtobuf, contentTypeJSON),
+			http.StatusUnsupportedMediaType)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, req.Body, maxBodyBytes))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to read request - %v", err), http.StatusBadRequest)
+		return
+	}
+	export := &collectorpb.ExportMetricsServiceRequest{}
+	if contentType == contentTypeJSON {
+		err = protojson.Unmarshal(body, export)
+	} else {
+		err = proto.Unmarshal(body, export)
+	}
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to decode request - %v", err), http.StatusBadRequest)
+		return
+	}
+
+	sourceIP, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		sourceIP = req.RemoteAddr
+	}
+
+	response := &collectorpb.ExportMetricsServiceResponse{}
+	if rejected := r.Export(req.Context(), sourceIP, export); rejected > 0 {
+		response.PartialSuccess = &collectorpb.ExportMetricsPartialSuccess{
+			RejectedDataPoints: rejected,
+			ErrorMessage:       "datapoints of unknown endpoints, or not pushed from their pod, were rejected",
+		}
+	}
+	var encoded []byte
+	if contentType == contentTypeJSON {
+		encoded, err = protojson.Marshal(response)
+	} else {
+		encoded, err = proto.Marshal(response)
+	}
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response - %v", err), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(encoded)
+}
+
+// Export updates the metrics of the endpoints that pushed the given resource metrics from the given source IP, and
+// returns the number of datapoints rejected because their endpoint is not in the pool or its pod does not have the
+// source IP.
+func (r *Receiver) Export(ctx context.Context, sourceIP string, export *collectorpb.ExportMetricsServiceRequest) int64 {
+	logger := log.FromContext(ctx)
+	rejected := int64(0)
+	for _, resourceMetrics := range export.GetResourceMetrics() {
+		attributes := stringAttributes(resourceMetrics.GetResource().GetAttributes())
+		endpoints := r.datastore.PodList(func(ep fwkdl.Endpoint) bool {
+			return matches(ep.GetMetadata(), attributes) && ep.GetMetadata().Address == sourceIP
+		})
+		metrics.RecordOTLPMetricsExport(len(endpoints) > 0)
+		if len(endpoints) == 0 {
+			rejected += countDatapoints(resourceMetrics)
+			logger.V(logutil.VERBOSE).Info("Dropped pushed metrics of an unknown endpoint, or not pushed from its pod",
+				"attributes", attributes, "sourceIP", sourceIP)
+			continue
+		}
+		for _, endpoint := range endpoints {
+			if r.update(endpoint, resourceMetrics) {
+				logger.V(logutil.TRACE).Info("Updated endpoint metrics from pushed datapoints",
+					"endpoint", endpoint.GetMetadata().NamespacedName, "metrics", endpoint.GetMetrics())
+			}
+		}
+	}
+	return rejected
+}
+
+// update applies the mapped datapoints of the given resource metrics to the metrics of the endpoint, and returns
+// whether any was found.
+func (r *Receiver) update(endpoint fwkdl.Endpoint, resourceMetrics *metricspb.ResourceMetrics) bool {
+	queued, queuedFound := latestValue(resourceMetrics, r.mapping.TotalQueuedRequests)
+	running, runningFound := latestValue(resourceMetrics, r.mapping.TotalRunningRequests)
+	kvCache, kvCacheFound := latestValue(resourceMetrics, r.mapping.KVCacheUtilization)
+	if !queuedFound && !runningFound && !kvCacheFound {
+		return false
+	}
+	updated := endpoint.GetMetrics().Clone()
+	if updated == nil {
+		updated = fwkdl.NewMetrics()
+	}
+	if queuedFound {
+		updated.WaitingQueueSize = int(queued)
+	}
+	if runningFound {
+		updated.RunningRequestsSize = int(running)
+	}
+	if kvCacheFound {
+		updated.KVCacheUsagePercent = kvCache
+	}
+	updated.UpdateTime = r.now()
+	endpoint.UpdateMetrics(updated)
+	return true
+}
+
+// matches returns whether the endpoint is the one described by the resource attributes: the pod name, or else the pod
+// IP, identifies the pod, and the port, if any, the endpoint within the pod.
+func matches(metadata *fwkdl.EndpointMetadata, attributes map[string]string) bool {
+	if metadata == nil {
+		return false
+	}
+	if podName, ok := attributes[podNameAttribute]; ok {
+		if metadata.PodName != podName {
+			return false
+		}
+	} else if podIP, ok := attributes[podIPAttribute]; !ok || metadata.Address != podIP {
+		return false
+	}
+	port, ok := attributes[portAttribute]
+	return !ok || metadata.Port == port
+}
+
+// latestValue returns the most recent value of the gauge or sum datapoints matching the given spec.
+func latestValue(resourceMetrics *metricspb.ResourceMetrics, spec *backendmetrics.MetricSpec) (float64, bool) {
+	if spec == nil {
+		return 0, false
+	}
+	value, latest, found := 0.0, uint64(0), false
+	for _, scopeMetrics := range resourceMetrics.GetScopeMetrics() {
+		for _, metric := range scopeMetrics.GetMetrics() {
+			if metric.GetName() != spec.MetricName {
+				continue
+			}
+			for _, datapoint := range numberDatapoints(metric) {
+				if !labelsMatch(stringAttributes(datapoint.GetAttributes()), spec.Labels) {
+					continue
+				}
+				if found && datapoint.GetTimeUnixNano() < latest {
+					continue
+				}
+				switch datapointValue := datapoint.GetValue().(type) {
+				case *metricspb.NumberDataPoint_AsDouble:
+					value = datapointValue.AsDouble
+				case *metricspb.NumberDataPoint_AsInt:
+					value = float64(datapointValue.AsInt)
+				default:
+					continue
+				}
+				latest, found = datapoint.GetTimeUnixNano(), true
+			}
+		}
+	}
+	return value, found
+}
+
+func numberDatapoints(metric *metricspb.Metric) []*metricspb.NumberDataPoint {
+	if gauge := metric.GetGauge(); gauge != nil {
+		return gauge.GetDataPoints()
+	}
+	return metric.GetSum().GetDataPoints()
+}
+
+func labelsMatch(attributes, labels map[string]string) bool {
+	for name, value := range labels {
+		if attributes[name] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func countDatapoints(resourceMetrics *metricspb.ResourceMetrics) int64 {
+	count := int64(0)
+	for _, scopeMetrics := range resourceMetrics.GetScopeMetrics() {
+		for _, metric := range scopeMetrics.GetMetrics() {
+			switch {
+			case metric.GetGauge() != nil:
+				count += int64(len(metric.GetGauge().GetDataPoints()))
+			case metric.GetSum() != nil:
+				count += int64(len(metric.GetSum().GetDataPoints()))
+			case metric.GetHistogram() != nil:
+				count += int64(len(metric.GetHistogram().GetDataPoints()))
+			case metric.GetExponentialHistogram() != nil:
+				count += int64(len(metric.GetExponentialHistogram().GetDataPoints()))
+			case metric.GetSummary() != nil:
+				count += int64(len(metric.GetSummary().GetDataPoints()))
+			}
+		}
+	}
+	return count
+}
+
+// stringAttributes returns the string, int and bool attributes as strings.
+func stringAttributes(keyValues []*commonpb.KeyValue) map[string]string {
+	attributes := make(map[string]string, len(keyValues))
+	for _, keyValue := range keyValues {
+		switch value := keyValue.GetValue().GetValue().(type) {
+		case *commonpb.AnyValue_StringValue:
+			attributes[keyValue.GetKey()] = value.StringValue
+		case *commonpb.AnyValue_IntValue:
+			attributes[keyValue.GetKey()] = strconv.FormatInt(value.IntValue, 10)
+		case *commonpb.AnyValue_BoolValue:
+			attributes[keyValue.GetKey()] = strconv.FormatBool(value.BoolValue)
+		}
+	}
+	return attributes
+}
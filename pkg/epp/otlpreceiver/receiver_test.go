@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package otlpreceiver
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collectorpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/types"
+
+	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+type fakeDatastore struct {
+	endpoints []fwkdl.Endpoint
+}
+
+func (ds *fakeDatastore) PodList(predicate func(fwkdl.Endpoint) bool) []fwkdl.Endpoint {
+	res := []fwkdl.Endpoint{}
+	for _, ep := range ds.endpoints {
+		if predicate(ep) {
+			res = append(res, ep)
+		}
+	}
+	return res
+}
+
+func stringAttribute(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{Key: key, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}}}
+}
+
+func gauge(name string, timeUnixNano uint64, value float64, attributes ...*commonpb.KeyValue) *metricspb.Metric {
+	return &metricspb.Metric{Name: name, Data: &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{
+		DataPoints: []*metricspb.NumberDataPoint{{
+			Attributes:   attributes,
+			TimeUnixNano: timeUnixNano,
+			Value:        &metricspb.NumberDataPoint_AsDouble{AsDouble: value},
+		}},
+	}}}
+}
+
+func exportRequest(resourceAttributes []*commonpb.KeyValue, metrics ...*metricspb.Metric) *collectorpb.ExportMetricsServiceRequest {
+	return &collectorpb.ExportMetricsServiceRequest{ResourceMetrics: []*metricspb.ResourceMetrics{{
+		Resource:     &resourcepb.Resource{Attributes: resourceAttributes},
+		ScopeMetrics: []*metricspb.ScopeMetrics{{Metrics: metrics}},
+	}}}
+}
+
+func newTestReceiver(t *testing.T, endpoints ...fwkdl.Endpoint) *Receiver {
+	mapping, err := backendmetrics.NewMetricMapping("vllm:num_requests_waiting", "vllm:num_requests_running",
+		"vllm:kv_cache_usage_perc{engine=0}", "", "")
+	require.NoError(t, err)
+	return NewReceiver(&fakeDatastore{endpoints: endpoints}, mapping)
+}
+
+func TestExport(t *testing.T) {
+	rank0 := fwkdl.NewEndpoint(&fwkdl.EndpointMetadata{
+		NamespacedName: types.NamespacedName{Name: "pod1-rank-0", Namespace: "default"},
+		PodName:        "pod1",
+		Address:        "10.0.0.1",
+		Port:           "8000",
+	}, nil)
+	rank1 := fwkdl.NewEndpoint(&fwkdl.EndpointMetadata{
+		NamespacedName: types.NamespacedName{Name: "pod1-rank-1", Namespace: "default"},
+		PodName:        "pod1",
+		Address:        "10.0.0.1",
+		Port:           "8001",
+	}, nil)
+	now := time.Now()
+	receiver := newTestReceiver(t, rank0, rank1)
+	receiver.now = func() time.Time { return now }
+
+	// The port narrows the update to an endpoint of the pod, and the latest datapoint with the mapped labels wins.
+	rejected := receiver.Export(t.Context(), "10.0.0.1", exportRequest(
+		[]*commonpb.KeyValue{stringAttribute(podNameAttribute, "pod1"), stringAttribute(portAttribute, "8001")},
+		gauge("vllm:num_requests_waiting", 2, 7),
+		gauge("vllm:num_requests_waiting", 1, 3),
+		gauge("vllm:kv_cache_usage_perc", 1, 0.5, stringAttribute("engine", "0")),
+		gauge("vllm:kv_cache_usage_perc", 2, 0.9, stringAttribute("engine", "1")),
+	))
+	assert.Zero(t, rejected)
+	assert.Equal(t, 7, rank1.GetMetrics().WaitingQueueSize)
+	assert.Equal(t, 0.5, rank1.GetMetrics().KVCacheUsagePercent)
+	assert.Equal(t, now, rank1.GetMetrics().UpdateTime)
+	assert.Zero(t, rank0.GetMetrics().WaitingQueueSize, "the other endpoint of the pod is not updated")
+
+	// Without the pod name, the pod IP identifies the pod, and without a port, all its endpoints are updated.
+	rejected = receiver.Export(t.Context(), "10.0.0.1", exportRequest(
+		[]*commonpb.KeyValue{stringAttribute(podIPAttribute, "10.0.0.1")},
+		gauge("vllm:num_requests_running", 1, 4),
+	))
+	assert.Zero(t, rejected)
+	assert.Equal(t, 4, rank0.GetMetrics().RunningRequestsSize)
+	assert.Equal(t, 4, rank1.GetMetrics().RunningRequestsSize)
+	assert.Equal(t, 7, rank1.GetMetrics().WaitingQueueSize, "the metrics not pushed are kept")
+
+	// The datapoints not pushed from the pod of the endpoint are rejected.
+	rejected = receiver.Export(t.Context(), "10.0.0.2", exportRequest(
+		[]*commonpb.KeyValue{stringAttribute(podNameAttribute, "pod1")},
+		gauge("vllm:num_requests_running", 2, 9),
+	))
+	assert.Equal(t, int64(1), rejected)
+	assert.Equal(t, 4, rank0.GetMetrics().RunningRequestsSize, "spoofed datapoints are not applied")
+
+	// The datapoints of unknown endpoints are rejected.
+	rejected = receiver.Export(t.Context(), "10.0.0.1", exportRequest(
+		[]*commonpb.KeyValue{stringAttribute(podNameAttribute, "pod2")},
+		gauge("vllm:num_requests_waiting", 1, 1),
+		gauge("vllm:num_requests_running", 1, 1),
+	))
+	assert.Equal(t, int64(2), rejected)
+}
+
+func TestServeHTTP(t *testing.T) {
+	endpoint := fwkdl.NewEndpoint(&fwkdl.EndpointMetadata{
+		NamespacedName: types.NamespacedName{Name: "pod1", Namespace: "default"},
+		PodName:        "pod1",
+		Address:        "10.0.0.1",
+		Port:           "8000",
+	}, nil)
+	receiver := newTestReceiver(t, endpoint)
+	export := exportRequest([]*commonpb.KeyValue{stringAttribute(podNameAttribute, "pod1")},
+		gauge("vllm:num_requests_waiting", 1, 5))
+	protobufBody, err := proto.Marshal(export)
+	require.NoError(t, err)
+	jsonBody, err := protojson.Marshal(export)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		method      string
+		contentType string
+		body        []byte
+		remoteAddr  string
+		wantStatus  int
+		wantWaiting int
+	}{
+		{name: "protobuf", method: http.MethodPost, contentType: contentTypeProtobuf, body: protobufBody, wantStatus: http.StatusOK, wantWaiting: 5},
+		{name: "spoofed source", method: http.MethodPost, contentType: contentTypeProtobuf, body: protobufBody, remoteAddr: "10.0.0.2:43210", wantStatus: http.StatusOK},
+		{name: "json", method: http.MethodPost, contentType: contentTypeJSON, body: jsonBody, wantStatus: http.StatusOK, wantWaiting: 5},
+		{name: "method not allowed", method: http.MethodGet, contentType: contentTypeProtobuf, wantStatus: http.StatusMethodNotAllowed},
+		{name: "unsupported content type", method: http.MethodPost, contentType: "text/plain", body: protobufBody, wantStatus: http.StatusUnsupportedMediaType},
+		{name: "malformed body", method: http.MethodPost, contentType: contentTypeJSON, body: []byte("{"), wantStatus: http.StatusBadRequest},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			endpoint.UpdateMetrics(fwkdl.NewMetrics())
+			req := httptest.NewRequest(test.method, HandlerPath, bytes.NewReader(test.body))
+			req.Header.Set("Content-Type", test.contentType)
+			req.RemoteAddr = "10.0.0.1:43210"
+			if test.remoteAddr != "" {
+				req.RemoteAddr = test.remoteAddr
+			}
+			rec := httptest.NewRecorder()
+			receiver.ServeHTTP(rec, req)
+			assert.Equal(t, test.wantStatus, rec.Code)
+			if test.wantStatus == http.StatusOK {
+				assert.Equal(t, test.contentType, rec.Header().Get("Content-Type"))
+				assert.Equal(t, test.wantWaiting, endpoint.GetMetrics().WaitingQueueSize)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package peerstate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/adminauth"
+)
+
+// maxSnapshotBytes bounds the size of a snapshot fetched from a peer.
+const maxSnapshotBytes = 256 << 20
+
+// Bootstrapper fetches a Snapshot from a peer replica and restores it into the local registry.
+//
+// The bootstrap is best effort: when no peer answers (e.g. for the first replica of the deployment) or the snapshot
+// cannot be restored, the replica starts with empty state, as it would without bootstrapping.
+type Bootstrapper struct {
+	registry *Registry
+	url      string
+	client   *http.Client
+	// bearerToken returns the token presented to the peer, or an empty string.
+	bearerToken func() (string, error)
+	// adminToken returns the admin API token presented to the peer, or an empty string.
+	adminToken func() (string, error)
+	done       atomic.Bool
+}
+
+// NewBootstrapper returns a Bootstrapper fetching the snapshot from the given URL, giving up after the timeout.
+//
+// When bearerToken or bearerTokenFile is set, the token is presented to the peer, whose metrics server authenticates
+// and authorizes requests by default. When adminTokenFile is set, its token is presented in the admin API token header,
+// for the peers scoping their admin APIs with tokens. The token files are read on each attempt so that rotated tokens
+// are picked up.
+func NewBootstrapper(registry *Registry, url string, timeout time.Duration, bearerToken, bearerTokenFile,
+	adminTokenFile string) *Bootstrapper {
+	return &Bootstrapper{
+		registry:    registry,
+		url:         url,
+		client:      &http.Client{Timeout: timeout},
+		bearerToken: tokenSource(bearerToken, bearerTokenFile),
+		adminToken:  tokenSource("", adminTokenFile),
+	}
+}
+
+// tokenSource returns a function reading the token from the given file, or returning the given token if there is none.
+func tokenSource(token, file string) func() (string, error) {
+	return func() (string, error) {
+		if file == "" {
+			return token, nil
+		}
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(content)), nil
+	}
+}
+
+// Done returns true once the bootstrap completed, whether or not it succeeded.
+func (b *Bootstrapper) Done() bool {
+	return b.done.Load()
+}
+
+// Run fetches the snapshot from the peer and restores it. It marks the bootstrap done when it returns.
+func (b *Bootstrapper) Run(ctx context.Context) {
+	defer b.done.Store(true)
+	logger := log.FromContext(ctx).WithValues("peer", b.url)
+
+	start := time.Now()
+	snapshot, err := b.fetch(ctx)
+	if err != nil {
+		logger.Error(err, "Failed to fetch plugin state from peer, starting with empty state")
+		return
+	}
+	if err := b.registry.Restore(ctx, snapshot); err != nil {
+		logger.Error(err, "Failed to restore plugin state from peer")
+		return
+	}
+	logger.V(logutil.DEFAULT).Info("Bootstrapped plugin state from peer", "plugins", len(snapshot.Plugins),
+		"duration", time.Since(start))
+}
+
+func (b *Bootstrapper) fetch(ctx context.Context) (*Snapshot, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, b.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	token, err := b.bearerToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bearer token - %w", err)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	adminToken, err := b.adminToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read admin API token - %w", err)
+	}
+	if adminToken != "" {
+		req.Header.Set(adminauth.TokenHeader, adminToken)
+	}
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	snapshot := &Snapshot{}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxSnapshotBytes)).Decode(snapshot); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot - %w", err)
+	}
+	return snapshot, nil
+}
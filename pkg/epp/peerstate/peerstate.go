@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package peerstate implements the hand-off of plugin state between EPP replicas.
+//
+// A new EPP replica starts with empty in-memory state: no in-flight counters, no prefix cache affinity. Until that
+// state is rebuilt from live traffic, its scheduling decisions are worse than those of the existing replicas, which
+// shows up as a scheduling-quality dip every time the EPP deployment scales out. With the peer state API enabled,
+// existing replicas serve a snapshot of the state of their plugins, and a starting replica imports it before it
+// reports ready.
+package peerstate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+// Snapshot is the state of the plugins of an EPP replica, as served by the peer state API.
+type Snapshot struct {
+	// Plugins maps plugin names to their state.
+	Plugins map[string]PluginState `json:"plugins"`
+}
+
+// PluginState is the exported state of a single plugin.
+type PluginState struct {
+	// Type is the plugin type. State is only imported into a plugin of the same name and type.
+	Type string `json:"type"`
+	// State is the plugin specific state.
+	State json.RawMessage `json:"state"`
+}
+
+// Registry holds the plugins whose state is handed off between replicas.
+type Registry struct {
+	plugins []fwkplugin.PeerStatePlugin
+}
+
+// NewRegistry returns a Registry holding the given plugins that implement fwkplugin.PeerStatePlugin. Other plugins
+// are ignored.
+func NewRegistry(plugins ...fwkplugin.Plugin) *Registry {
+	r := &Registry{}
+	for _, plugin := range plugins {
+		if stateful, ok := plugin.(fwkplugin.PeerStatePlugin); ok {
+			r.plugins = append(r.plugins, stateful)
+		}
+	}
+	return r
+}
+
+// Len returns the number of plugins in the registry.
+func (r *Registry) Len() int {
+	return len(r.plugins)
+}
+
+// Snapshot exports the state of all plugins in the registry.
+func (r *Registry) Snapshot() (*Snapshot, error) {
+	snapshot := &Snapshot{Plugins: make(map[string]PluginState, len(r.plugins))}
+	for _, plugin := range r.plugins {
+		state, err := plugin.ExportState()
+		if err != nil {
+			return nil, fmt.Errorf("failed to export state of plugin %s - %w", plugin.TypedName(), err)
+		}
+		snapshot.Plugins[plugin.TypedName().Name] = PluginState{Type: plugin.TypedName().Type, State: state}
+	}
+	return snapshot, nil
+}
+
+// Restore imports the given snapshot into the plugins of the registry. The state of a plugin is imported only if the
+// snapshot holds state for a plugin of the same name and type, so that replicas running different configurations
+// (e.g. during a rollout) do not exchange incompatible state. Import errors of individual plugins do not prevent the
+// others from being restored.
+func (r *Registry) Restore(ctx context.Context, snapshot *Snapshot) error {
+	logger := log.FromContext(ctx)
+	var errs []error
+	for _, plugin := range r.plugins {
+		name := plugin.TypedName()
+		state, ok := snapshot.Plugins[name.Name]
+		if !ok || state.Type != name.Type {
+			logger.V(logutil.DEFAULT).Info("No peer state found for plugin", "plugin", name)
+			continue
+		}
+		if err := plugin.ImportState(ctx, state.State); err != nil {
+			errs = append(errs, fmt.Errorf("failed to import state of plugin %s - %w", name, err))
+			continue
+		}
+		logger.V(logutil.DEFAULT).Info("Imported peer state", "plugin", name)
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package peerstate
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/adminauth"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+// counterPlugin is a PeerStatePlugin holding a single counter.
+type counterPlugin struct {
+	typedName fwkplugin.TypedName
+	count     int
+	importErr error
+}
+
+func newCounterPlugin(pluginType, name string, count int) *counterPlugin {
+	return &counterPlugin{typedName: fwkplugin.TypedName{Type: pluginType, Name: name}, count: count}
+}
+
+func (p *counterPlugin) TypedName() fwkplugin.TypedName {
+	return p.typedName
+}
+
+func (p *counterPlugin) ExportState() (json.RawMessage, error) {
+	return json.Marshal(p.count)
+}
+
+func (p *counterPlugin) ImportState(_ context.Context, state json.RawMessage) error {
+	if p.importErr != nil {
+		return p.importErr
+	}
+	count := 0
+	if err := json.Unmarshal(state, &count); err != nil {
+		return err
+	}
+	p.count += count
+	return nil
+}
+
+// statelessPlugin does not implement PeerStatePlugin.
+type statelessPlugin struct{}
+
+func (statelessPlugin) TypedName() fwkplugin.TypedName {
+	return fwkplugin.TypedName{Type: "stateless", Name: "stateless"}
+}
+
+func TestRegistrySnapshotAndRestore(t *testing.T) {
+	source := NewRegistry(newCounterPlugin("counter", "a", 3), newCounterPlugin("counter", "b", 5), statelessPlugin{})
+	require.Equal(t, 2, source.Len())
+
+	snapshot, err := source.Snapshot()
+	require.NoError(t, err)
+	assert.Equal(t, PluginState{Type: "counter", State: json.RawMessage("3")}, snapshot.Plugins["a"])
+
+	sameName := newCounterPlugin("counter", "a", 1)
+	otherType := newCounterPlugin("other", "b", 1)
+	missing := newCounterPlugin("counter", "c", 1)
+	failing := newCounterPlugin("counter", "b", 1)
+	failing.importErr = errors.New("boom")
+
+	err = NewRegistry(sameName, otherType, missing, failing).Restore(context.Background(), snapshot)
+	assert.ErrorContains(t, err, "boom")
+	assert.Equal(t, 4, sameName.count, "state should be imported into the plugin of the same name and type")
+	assert.Equal(t, 1, otherType.count, "state should not be imported into a plugin of another type")
+	assert.Equal(t, 1, missing.count)
+}
+
+func TestHandler(t *testing.T) {
+	handler := NewHandler(NewRegistry(newCounterPlugin("counter", "a", 3)))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, HandlerPath, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"plugins": {"a": {"type": "counter", "state": 3}}}`, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, HandlerPath, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestBootstrapper(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("secret\n"), 0o600))
+
+	peer := NewHandler(NewRegistry(newCounterPlugin("counter", "a", 3)))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		peer.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	local := newCounterPlugin("counter", "a", 0)
+	bootstrapper := NewBootstrapper(NewRegistry(local), server.URL+HandlerPath, time.Second, "", tokenFile, "")
+	assert.False(t, bootstrapper.Done())
+	bootstrapper.Run(context.Background())
+	assert.True(t, bootstrapper.Done())
+	assert.Equal(t, 3, local.count)
+
+	local = newCounterPlugin("counter", "a", 0)
+	bootstrapper = NewBootstrapper(NewRegistry(local), server.URL+HandlerPath, time.Second, "wrong", "", "")
+	bootstrapper.Run(context.Background())
+	assert.True(t, bootstrapper.Done(), "a failed bootstrap should not prevent the replica from becoming ready")
+	assert.Equal(t, 0, local.count)
+}
+
+func TestBootstrapperAdminToken(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "admin-token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("admin-secret\n"), 0o600))
+
+	// The peer scopes its admin APIs with tokens, as with --admin-api-tokens-file.
+	authorizer := adminauth.NewAuthorizer(adminauth.Tokens{
+		sha256.Sum256([]byte("admin-secret")): {User: "epp", Role: adminauth.RoleViewer},
+	})
+	server := httptest.NewServer(authorizer.Wrap(NewHandler(NewRegistry(newCounterPlugin("counter", "a", 3)))))
+	defer server.Close()
+
+	local := newCounterPlugin("counter", "a", 0)
+	NewBootstrapper(NewRegistry(local), server.URL+HandlerPath, time.Second, "", "", "").Run(context.Background())
+	assert.Equal(t, 0, local.count, "the peer rejects a bootstrap without admin API token")
+
+	NewBootstrapper(NewRegistry(local), server.URL+HandlerPath, time.Second, "", "", tokenFile).Run(context.Background())
+	assert.Equal(t, 3, local.count)
+}
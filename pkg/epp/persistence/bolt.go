@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// boltBucket is the bucket of the BoltDB file the records are stored in.
+var boltBucket = []byte("state")
+
+// boltOpenTimeout bounds the wait for the lock of the BoltDB file, held by another process.
+const boltOpenTimeout = 5 * time.Second
+
+// BoltDriver persists the records to a BoltDB file. The file must be on a volume that survives the restarts of the
+// EPP container, e.g. an emptyDir volume, and cannot be shared by several EPP replicas.
+type BoltDriver struct {
+	db *bolt.DB
+}
+
+// NewBoltDriver opens, or creates, the BoltDB file at the given path.
+func NewBoltDriver(path string) (*BoltDriver, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: boltOpenTimeout})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	if err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(boltBucket)
+		return err
+	}); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize %s: %w", path, err)
+	}
+	return &BoltDriver{db: db}, nil
+}
+
+func (d *BoltDriver) Load(context.Context) (map[string][]byte, error) {
+	records := map[string][]byte{}
+	err := d.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(boltBucket).ForEach(func(key, value []byte) error {
+			// Values are only valid during the transaction.
+			records[string(key)] = append([]byte(nil), value...)
+			return nil
+		})
+	})
+	return records, err
+}
+
+func (d *BoltDriver) Store(_ context.Context, records map[string][]byte) error {
+	return d.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(boltBucket)
+		for key, value := range records {
+			if err := bucket.Put([]byte(key), value); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (d *BoltDriver) Close() error {
+	return d.db.Close()
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"context"
+	"maps"
+	"sync"
+)
+
+// MemoryDriver keeps the records in memory. The records do not survive restarts of the EPP.
+type MemoryDriver struct {
+	mu      sync.Mutex
+	records map[string][]byte
+}
+
+// NewMemoryDriver returns an empty MemoryDriver.
+func NewMemoryDriver() *MemoryDriver {
+	return &MemoryDriver{records: map[string][]byte{}}
+}
+
+func (d *MemoryDriver) Load(context.Context) (map[string][]byte, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return maps.Clone(d.records), nil
+}
+
+func (d *MemoryDriver) Store(_ context.Context, records map[string][]byte) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	maps.Copy(d.records, records)
+	return nil
+}
+
+func (d *MemoryDriver) Close() error {
+	return nil
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package persistence implements the persistence of the soft state of the plugins, e.g. the prefix cache index, the
+// in-flight load counters and the performance fingerprints, behind a single driver selected by configuration.
+//
+// The state persisted is the state the plugins hand off to other EPP replicas (see package peerstate): it is written
+// to the driver periodically and on shutdown, and imported from it at startup, so that operators choose the
+// durability of all the soft state at once, from none (memory), to surviving restarts of the EPP container (bolt),
+// to surviving the rescheduling of the EPP pods (redis).
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	configapi "sigs.k8s.io/gateway-api-inference-extension/apix/config/v1alpha1"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/peerstate"
+)
+
+const (
+	// DriverMemory keeps the state in memory only.
+	DriverMemory = "memory"
+	// DriverBolt persists the state to a BoltDB file.
+	DriverBolt = "bolt"
+	// DriverRedis persists the state to a Redis server.
+	DriverRedis = "redis"
+
+	// DefaultFlushInterval is the default interval at which the state is persisted.
+	DefaultFlushInterval = 30 * time.Second
+	// DefaultRedisKeyPrefix is the default prefix of the keys the state is persisted under in Redis.
+	DefaultRedisKeyPrefix = "epp:"
+)
+
+// Driver persists records of opaque values, keyed by name.
+type Driver interface {
+	// Load returns all the persisted records.
+	Load(ctx context.Context) (map[string][]byte, error)
+	// Store persists the given records, replacing the persisted records of the same keys.
+	Store(ctx context.Context, records map[string][]byte) error
+	// Close releases the resources of the driver.
+	Close() error
+}
+
+// NewDriver returns the driver selected by the given configuration. A nil configuration selects the memory driver.
+func NewDriver(cfg *configapi.PersistenceConfig) (Driver, error) {
+	if cfg == nil {
+		return NewMemoryDriver(), nil
+	}
+	switch cfg.Driver {
+	case "", DriverMemory:
+		return NewMemoryDriver(), nil
+	case DriverBolt:
+		if cfg.Bolt == nil || cfg.Bolt.Path == "" {
+			return nil, errors.New("the bolt driver requires a path")
+		}
+		return NewBoltDriver(cfg.Bolt.Path)
+	case DriverRedis:
+		if cfg.Redis == nil || cfg.Redis.Address == "" {
+			return nil, errors.New("the redis driver requires an address")
+		}
+		password := ""
+		if cfg.Redis.PasswordEnv != "" {
+			password = os.Getenv(cfg.Redis.PasswordEnv)
+		}
+		keyPrefix := cfg.Redis.KeyPrefix
+		if keyPrefix == "" {
+			keyPrefix = DefaultRedisKeyPrefix
+		}
+		return NewRedisDriver(cfg.Redis.Address, password, cfg.Redis.DB, keyPrefix), nil
+	default:
+		return nil, fmt.Errorf("unknown persistence driver %q, expected one of %q, %q or %q", cfg.Driver,
+			DriverMemory, DriverBolt, DriverRedis)
+	}
+}
+
+// FlushInterval returns the flush interval of the given configuration, or the default one.
+func FlushInterval(cfg *configapi.PersistenceConfig) time.Duration {
+	if cfg == nil || cfg.FlushInterval == nil || cfg.FlushInterval.Duration <= 0 {
+		return DefaultFlushInterval
+	}
+	return cfg.FlushInterval.Duration
+}
+
+// Persister persists the state of the plugins of a registry with a driver. Each plugin is persisted as a record keyed
+// by the plugin name.
+type Persister struct {
+	driver        Driver
+	registry      *peerstate.Registry
+	flushInterval time.Duration
+}
+
+// NewPersister returns a Persister persisting the state of the plugins of the registry with the driver, at the given
+// interval.
+func NewPersister(driver Driver, registry *peerstate.Registry, flushInterval time.Duration) *Persister {
+	return &Persister{driver: driver, registry: registry, flushInterval: flushInterval}
+}
+
+// Restore imports the persisted state into the plugins of the registry. As for the hand-off between replicas, the
+// state of a plugin is only imported into a plugin of the same name and type.
+func (p *Persister) Restore(ctx context.Context) error {
+	records, err := p.driver.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load the persisted state - %w", err)
+	}
+	snapshot := &peerstate.Snapshot{Plugins: make(map[string]peerstate.PluginState, len(records))}
+	for name, record := range records {
+		var state peerstate.PluginState
+		if err := json.Unmarshal(record, &state); err != nil {
+			return fmt.Errorf("failed to parse the persisted state of plugin %s - %w", name, err)
+		}
+		snapshot.Plugins[name] = state
+	}
+	return p.registry.Restore(ctx, snapshot)
+}
+
+// Save persists the state of the plugins of the registry.
+func (p *Persister) Save(ctx context.Context) error {
+	snapshot, err := p.registry.Snapshot()
+	if err != nil {
+		return err
+	}
+	records := make(map[string][]byte, len(snapshot.Plugins))
+	for name, state := range snapshot.Plugins {
+		record, err := json.Marshal(state)
+		if err != nil {
+			return fmt.Errorf("failed to encode the state of plugin %s - %w", name, err)
+		}
+		records[name] = record
+	}
+	return p.driver.Store(ctx, records)
+}
+
+// Run persists the state periodically, and a last time when ctx is cancelled, after which it closes the driver.
+func (p *Persister) Run(ctx context.Context) {
+	logger := log.FromContext(ctx)
+	ticker := time.NewTicker(p.flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			// ctx is cancelled: the last save gets a context of its own.
+			saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), p.flushInterval)
+			if err := p.Save(saveCtx); err != nil {
+				logger.Error(err, "Failed to persist the plugin state")
+			} else {
+				logger.V(logutil.DEFAULT).Info("Persisted the plugin state")
+			}
+			cancel()
+			if err := p.driver.Close(); err != nil {
+				logger.Error(err, "Failed to close the persistence driver")
+			}
+			return
+		case <-ticker.C:
+			if err := p.Save(ctx); err != nil {
+				logger.Error(err, "Failed to persist the plugin state")
+			}
+		}
+	}
+}
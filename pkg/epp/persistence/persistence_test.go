@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	configapi "sigs.k8s.io/gateway-api-inference-extension/apix/config/v1alpha1"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/peerstate"
+)
+
+// counterPlugin is a PeerStatePlugin holding a single counter.
+type counterPlugin struct {
+	typedName fwkplugin.TypedName
+	count     int
+}
+
+func newCounterPlugin(name string, count int) *counterPlugin {
+	return &counterPlugin{typedName: fwkplugin.TypedName{Type: "counter", Name: name}, count: count}
+}
+
+func (p *counterPlugin) TypedName() fwkplugin.TypedName {
+	return p.typedName
+}
+
+func (p *counterPlugin) ExportState() (json.RawMessage, error) {
+	return json.Marshal(p.count)
+}
+
+func (p *counterPlugin) ImportState(_ context.Context, state json.RawMessage) error {
+	return json.Unmarshal(state, &p.count)
+}
+
+func TestDrivers(t *testing.T) {
+	drivers := map[string]func(t *testing.T) Driver{
+		DriverMemory: func(*testing.T) Driver {
+			return NewMemoryDriver()
+		},
+		DriverBolt: func(t *testing.T) Driver {
+			driver, err := NewBoltDriver(filepath.Join(t.TempDir(), "state.db"))
+			require.NoError(t, err)
+			return driver
+		},
+		DriverRedis: func(t *testing.T) Driver {
+			return NewRedisDriver(miniredis.RunT(t).Addr(), "", 0, DefaultRedisKeyPrefix)
+		},
+	}
+	for name, newDriver := range drivers {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			driver := newDriver(t)
+			defer driver.Close()
+
+			records, err := driver.Load(ctx)
+			require.NoError(t, err)
+			assert.Empty(t, records)
+
+			require.NoError(t, driver.Store(ctx, map[string][]byte{"a": []byte("1"), "b": []byte("2")}))
+			require.NoError(t, driver.Store(ctx, map[string][]byte{"b": []byte("3")}))
+			records, err = driver.Load(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, map[string][]byte{"a": []byte("1"), "b": []byte("3")}, records)
+		})
+	}
+}
+
+func TestBoltDriverSurvivesReopen(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "state.db")
+	driver, err := NewBoltDriver(path)
+	require.NoError(t, err)
+	require.NoError(t, driver.Store(ctx, map[string][]byte{"a": []byte("1")}))
+	require.NoError(t, driver.Close())
+
+	driver, err = NewBoltDriver(path)
+	require.NoError(t, err)
+	defer driver.Close()
+	records, err := driver.Load(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"a": []byte("1")}, records)
+}
+
+func TestRedisDriverKeyPrefix(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	pool1 := NewRedisDriver(server.Addr(), "", 0, "pool1:")
+	pool2 := NewRedisDriver(server.Addr(), "", 0, "pool2:")
+	defer pool1.Close()
+	defer pool2.Close()
+
+	require.NoError(t, pool1.Store(ctx, map[string][]byte{"a": []byte("1")}))
+	require.NoError(t, pool2.Store(ctx, map[string][]byte{"a": []byte("2")}))
+	value, err := server.Get("pool1:a")
+	require.NoError(t, err)
+	assert.Equal(t, "1", value)
+	records, err := pool2.Load(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"a": []byte("2")}, records)
+}
+
+func TestNewDriver(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      *configapi.PersistenceConfig
+		wantType Driver
+		wantErr  bool
+	}{
+		{name: "default", cfg: nil, wantType: &MemoryDriver{}},
+		{name: "memory", cfg: &configapi.PersistenceConfig{Driver: DriverMemory}, wantType: &MemoryDriver{}},
+		{name: "bolt", cfg: &configapi.PersistenceConfig{Driver: DriverBolt,
+			Bolt: &configapi.BoltPersistenceConfig{Path: filepath.Join(t.TempDir(), "state.db")}}, wantType: &BoltDriver{}},
+		{name: "redis", cfg: &configapi.PersistenceConfig{Driver: DriverRedis,
+			Redis: &configapi.RedisPersistenceConfig{Address: "localhost:6379"}}, wantType: &RedisDriver{}},
+		{name: "bolt without path", cfg: &configapi.PersistenceConfig{Driver: DriverBolt}, wantErr: true},
+		{name: "redis without address", cfg: &configapi.PersistenceConfig{Driver: DriverRedis}, wantErr: true},
+		{name: "unknown driver", cfg: &configapi.PersistenceConfig{Driver: "etcd"}, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			driver, err := NewDriver(test.cfg)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			defer driver.Close()
+			assert.IsType(t, test.wantType, driver)
+		})
+	}
+
+	assert.Equal(t, DefaultFlushInterval, FlushInterval(nil))
+	assert.Equal(t, time.Minute, FlushInterval(&configapi.PersistenceConfig{FlushInterval: &metav1.Duration{Duration: time.Minute}}))
+}
+
+func TestPersister(t *testing.T) {
+	ctx := context.Background()
+	driver := NewMemoryDriver()
+	a, b := newCounterPlugin("a", 3), newCounterPlugin("b", 5)
+	require.NoError(t, NewPersister(driver, peerstate.NewRegistry(a, b), time.Minute).Save(ctx))
+
+	// A restarted EPP restores the persisted state of its plugins.
+	restoredA, other := newCounterPlugin("a", 0), &counterPlugin{typedName: fwkplugin.TypedName{Type: "other", Name: "b"}}
+	require.NoError(t, NewPersister(driver, peerstate.NewRegistry(restoredA, other), time.Minute).Restore(ctx))
+	assert.Equal(t, 3, restoredA.count)
+	assert.Zero(t, other.count, "state is only restored into plugins of the same type")
+
+	// The state is persisted a last time when the context is cancelled.
+	runCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		NewPersister(driver, peerstate.NewRegistry(newCounterPlugin("a", 7)), time.Hour).Run(runCtx)
+		close(done)
+	}()
+	cancel()
+	<-done
+	records, err := driver.Load(ctx)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type": "counter", "state": 7}`, string(records["a"]))
+}
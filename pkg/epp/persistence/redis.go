@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisScanCount is the number of keys requested per SCAN iteration.
+const redisScanCount = 100
+
+// RedisDriver persists the records to a Redis server, as keys of a common prefix. The records survive the
+// rescheduling of the EPP pods. Replicas sharing the prefix share the records: each replica imports the state last
+// persisted by any of them.
+type RedisDriver struct {
+	client    *redis.Client
+	keyPrefix string
+}
+
+// NewRedisDriver returns a RedisDriver persisting the records to the given database of the Redis server at the given
+// address, under the given key prefix. The connection is established lazily.
+func NewRedisDriver(address, password string, db int, keyPrefix string) *RedisDriver {
+	return &RedisDriver{
+		client:    redis.NewClient(&redis.Options{Addr: address, Password: password, DB: db}),
+		keyPrefix: keyPrefix,
+	}
+}
+
+func (d *RedisDriver) Load(ctx context.Context) (map[string][]byte, error) {
+	var keys []string
+	iter := d.client.Scan(ctx, 0, d.keyPrefix+"*", redisScanCount).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+
+	records := make(map[string][]byte, len(keys))
+	for _, key := range keys {
+		value, err := d.client.Get(ctx, key).Bytes()
+		if errors.Is(err, redis.Nil) {
+			continue // deleted since the scan
+		}
+		if err != nil {
+			return nil, err
+		}
+		records[strings.TrimPrefix(key, d.keyPrefix)] = value
+	}
+	return records, nil
+}
+
+func (d *RedisDriver) Store(ctx context.Context, records map[string][]byte) error {
+	if len(records) == 0 {
+		return nil
+	}
+	_, err := d.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for key, value := range records {
+			pipe.Set(ctx, d.keyPrefix+key, value, 0)
+		}
+		return nil
+	})
+	return err
+}
+
+func (d *RedisDriver) Close() error {
+	return d.client.Close()
+}
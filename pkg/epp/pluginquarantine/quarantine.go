@@ -0,0 +1,220 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package pluginquarantine isolates the request handling from faulty plugins.
+//
+// The panics of the plugin runs are recovered as errors, so that a buggy plugin fails its own run instead of the
+// request, and a plugin whose runs fail repeatedly is quarantined for a cooldown period, during which it is skipped
+// while the rest of the plugin chain runs. After the cooldown the plugin is run again; a single failure quarantines it
+// again, a success lifts the quarantine.
+package pluginquarantine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+const (
+	// DefaultThreshold is the default number of consecutive failed runs after which a plugin is quarantined.
+	DefaultThreshold = 5
+	// DefaultCooldown is the default duration of a quarantine.
+	DefaultCooldown = time.Minute
+)
+
+// ErrQuarantined is returned for the runs of a quarantined plugin, which are skipped.
+var ErrQuarantined = errors.New("plugin is quarantined")
+
+// PanicError is the error a panicking plugin run is recovered as.
+type PanicError struct {
+	// Value is the value the plugin panicked with.
+	Value any
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("plugin panicked: %v", e.Value)
+}
+
+// Quarantine describes the failures of a plugin, and its quarantine if any.
+type Quarantine struct {
+	// PluginType and PluginName identify the plugin.
+	PluginType string `json:"pluginType"`
+	PluginName string `json:"pluginName"`
+	// ConsecutiveFailures is the number of consecutive failed runs of the plugin.
+	ConsecutiveFailures int `json:"consecutiveFailures"`
+	// LastError is the error of the last failed run of the plugin.
+	LastError string `json:"lastError"`
+	// Quarantines is the number of times the plugin was quarantined.
+	Quarantines int `json:"quarantines"`
+	// QuarantinedUntil is the end of the quarantine of the plugin, zero if it is not quarantined.
+	QuarantinedUntil time.Time `json:"quarantinedUntil,omitempty"`
+}
+
+// Breaker recovers the panics of the plugin runs and quarantines the plugins failing repeatedly. It is safe for
+// concurrent use. A nil Breaker only recovers the panics.
+type Breaker struct {
+	clock     clock.PassiveClock
+	threshold int
+	cooldown  time.Duration
+
+	mu      sync.Mutex
+	plugins map[fwkplugin.TypedName]*Quarantine
+}
+
+// NewBreaker returns a Breaker quarantining the plugins for the given cooldown after the given number of consecutive
+// failed runs. A non-positive threshold disables the quarantine; a non-positive cooldown selects DefaultCooldown.
+func NewBreaker(threshold int, cooldown time.Duration) *Breaker {
+	return newBreakerWithClock(threshold, cooldown, clock.RealClock{})
+}
+
+func newBreakerWithClock(threshold int, cooldown time.Duration, clk clock.PassiveClock) *Breaker {
+	if cooldown <= 0 {
+		cooldown = DefaultCooldown
+	}
+	return &Breaker{
+		clock:     clk,
+		threshold: threshold,
+		cooldown:  cooldown,
+		plugins:   map[fwkplugin.TypedName]*Quarantine{},
+	}
+}
+
+// Run runs the given plugin function for the given extension point. It returns ErrQuarantined without running the
+// function if the plugin is quarantined, and a *PanicError if the function panicked. The function returns the errors
+// that count as failures of the plugin; callers handle the other outcomes of the run, e.g. the denial of a request by
+// an admission plugin, themselves.
+func (b *Breaker) Run(ctx context.Context, extensionPoint string, plugin fwkplugin.TypedName, run func() error) error {
+	if b.quarantined(plugin) {
+		return ErrQuarantined
+	}
+	err := Recover(ctx, extensionPoint, plugin, run)
+	if err != nil {
+		b.recordFailure(ctx, plugin, err)
+	} else {
+		b.recordSuccess(plugin)
+	}
+	return err
+}
+
+// Recover runs the given plugin function for the given extension point, returning a *PanicError if it panicked. It
+// is meant for the plugins that cannot be skipped, e.g. the profile handler, which are never quarantined.
+func Recover(ctx context.Context, extensionPoint string, plugin fwkplugin.TypedName, run func() error) (err error) {
+	defer func() {
+		if value := recover(); value != nil {
+			log.FromContext(ctx).Error(nil, "Recovered from a plugin panic", "plugin", plugin, "extensionPoint", extensionPoint,
+				"panic", value, "stack", string(debug.Stack()))
+			metrics.RecordPluginPanic(extensionPoint, plugin.Type, plugin.Name)
+			err = &PanicError{Value: value}
+		}
+	}()
+	return run()
+}
+
+func (b *Breaker) quarantined(plugin fwkplugin.TypedName) bool {
+	if b == nil || b.threshold <= 0 {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	state, ok := b.plugins[plugin]
+	if !ok || state.QuarantinedUntil.IsZero() {
+		return false
+	}
+	if b.clock.Now().Before(state.QuarantinedUntil) {
+		return true
+	}
+	// The cooldown is over: the plugin is run again, and quarantined again by its next failure.
+	state.QuarantinedUntil = time.Time{}
+	state.ConsecutiveFailures = b.threshold - 1
+	metrics.RecordPluginQuarantined(plugin.Type, plugin.Name, false)
+	return false
+}
+
+func (b *Breaker) recordFailure(ctx context.Context, plugin fwkplugin.TypedName, err error) {
+	if b == nil || b.threshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	state, ok := b.plugins[plugin]
+	if !ok {
+		state = &Quarantine{PluginType: plugin.Type, PluginName: plugin.Name}
+		b.plugins[plugin] = state
+	}
+	state.ConsecutiveFailures++
+	state.LastError = err.Error()
+	if state.ConsecutiveFailures < b.threshold || !state.QuarantinedUntil.IsZero() {
+		return
+	}
+	state.Quarantines++
+	state.QuarantinedUntil = b.clock.Now().Add(b.cooldown)
+	log.FromContext(ctx).V(logutil.DEFAULT).Info("Quarantining a repeatedly failing plugin", "plugin", plugin,
+		"consecutiveFailures", state.ConsecutiveFailures, "lastError", state.LastError, "until", state.QuarantinedUntil)
+	metrics.RecordPluginQuarantined(plugin.Type, plugin.Name, true)
+}
+
+func (b *Breaker) recordSuccess(plugin fwkplugin.TypedName) {
+	if b == nil || b.threshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	// Plugins that never failed are not tracked, so that the common path does not allocate.
+	if state, ok := b.plugins[plugin]; ok {
+		state.ConsecutiveFailures = 0
+	}
+}
+
+// List returns the plugins that failed since they last succeeded or that were ever quarantined, ordered by plugin type
+// and name.
+func (b *Breaker) List() []Quarantine {
+	if b == nil {
+		return []Quarantine{}
+	}
+	now := b.clock.Now()
+	b.mu.Lock()
+	res := make([]Quarantine, 0, len(b.plugins))
+	for _, state := range b.plugins {
+		if state.ConsecutiveFailures == 0 && state.Quarantines == 0 {
+			continue
+		}
+		quarantine := *state
+		if !now.Before(quarantine.QuarantinedUntil) {
+			quarantine.QuarantinedUntil = time.Time{}
+		}
+		res = append(res, quarantine)
+	}
+	b.mu.Unlock()
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].PluginType != res[j].PluginType {
+			return res[i].PluginType < res[j].PluginType
+		}
+		return res[i].PluginName < res[j].PluginName
+	})
+	return res
+}
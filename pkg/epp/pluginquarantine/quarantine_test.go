@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pluginquarantine
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+var (
+	buggy   = fwkplugin.TypedName{Type: "buggy-scorer", Name: "buggy"}
+	healthy = fwkplugin.TypedName{Type: "healthy-scorer", Name: "healthy"}
+)
+
+func panicking() error {
+	panic("bug")
+}
+
+func failing() error {
+	return errors.New("failure")
+}
+
+func succeeding() error {
+	return nil
+}
+
+func TestRunRecoversPanics(t *testing.T) {
+	for name, breaker := range map[string]*Breaker{
+		"breaker":          NewBreaker(DefaultThreshold, DefaultCooldown),
+		"nil breaker":      nil,
+		"quarantine off":   NewBreaker(0, DefaultCooldown),
+		"without breaking": NewBreaker(1000, DefaultCooldown),
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := breaker.Run(context.Background(), "Scorer", buggy, panicking)
+			var panicErr *PanicError
+			require.ErrorAs(t, err, &panicErr)
+			assert.Equal(t, "bug", panicErr.Value)
+		})
+	}
+}
+
+func TestBreaker(t *testing.T) {
+	ctx := context.Background()
+	clock := clocktesting.NewFakePassiveClock(time.Now())
+	breaker := newBreakerWithClock(3, time.Minute, clock)
+
+	// Successes reset the consecutive failures.
+	require.Error(t, breaker.Run(ctx, "Scorer", buggy, failing))
+	require.Error(t, breaker.Run(ctx, "Scorer", buggy, panicking))
+	require.NoError(t, breaker.Run(ctx, "Scorer", buggy, succeeding))
+	require.Error(t, breaker.Run(ctx, "Scorer", buggy, failing))
+	require.Error(t, breaker.Run(ctx, "Scorer", buggy, failing))
+	assert.Equal(t, []Quarantine{{PluginType: buggy.Type, PluginName: buggy.Name, ConsecutiveFailures: 2, LastError: "failure"}},
+		breaker.List())
+
+	// The third consecutive failure quarantines the plugin, which is then skipped until the end of the cooldown.
+	require.ErrorAs(t, breaker.Run(ctx, "Scorer", buggy, panicking), new(*PanicError))
+	ran := false
+	err := breaker.Run(ctx, "Scorer", buggy, func() error {
+		ran = true
+		return nil
+	})
+	assert.ErrorIs(t, err, ErrQuarantined)
+	assert.False(t, ran)
+	require.NoError(t, breaker.Run(ctx, "Scorer", healthy, succeeding), "other plugins are not quarantined")
+	quarantines := breaker.List()
+	require.Len(t, quarantines, 1)
+	assert.Equal(t, 1, quarantines[0].Quarantines)
+	assert.Equal(t, clock.Now().Add(time.Minute), quarantines[0].QuarantinedUntil)
+
+	// After the cooldown the plugin runs again; a single failure quarantines it again.
+	clock.SetTime(clock.Now().Add(time.Minute))
+	require.Error(t, breaker.Run(ctx, "Scorer", buggy, failing))
+	assert.ErrorIs(t, breaker.Run(ctx, "Scorer", buggy, succeeding), ErrQuarantined)
+	assert.Equal(t, 2, breaker.List()[0].Quarantines)
+
+	// A success after the cooldown lifts the quarantine, so that a single failure no longer quarantines the plugin.
+	clock.SetTime(clock.Now().Add(time.Minute))
+	require.NoError(t, breaker.Run(ctx, "Scorer", buggy, succeeding))
+	require.Error(t, breaker.Run(ctx, "Scorer", buggy, failing))
+	require.NoError(t, breaker.Run(ctx, "Scorer", buggy, succeeding))
+	quarantines = breaker.List()
+	require.Len(t, quarantines, 1)
+	assert.Zero(t, quarantines[0].ConsecutiveFailures)
+	assert.True(t, quarantines[0].QuarantinedUntil.IsZero())
+}
+
+func TestBreakerDisabled(t *testing.T) {
+	breaker := NewBreaker(0, DefaultCooldown)
+	for range 10 {
+		require.Error(t, breaker.Run(context.Background(), "Scorer", buggy, failing))
+	}
+	require.NoError(t, breaker.Run(context.Background(), "Scorer", buggy, succeeding))
+	assert.Empty(t, breaker.List())
+}
+
+func TestHandler(t *testing.T) {
+	breaker := NewBreaker(1, DefaultCooldown)
+	require.Error(t, breaker.Run(context.Background(), "Filter", buggy, panicking))
+	handler := NewHandler(breaker)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, HandlerPath, nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var quarantines []Quarantine
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &quarantines))
+	require.Len(t, quarantines, 1)
+	assert.Equal(t, buggy.Name, quarantines[0].PluginName)
+	assert.Equal(t, "plugin panicked: bug", quarantines[0].LastError)
+	assert.False(t, quarantines[0].QuarantinedUntil.IsZero())
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, HandlerPath, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package poolfallback forwards the requests of the objectives opting in to a secondary pool, e.g. a pool serving a
+// smaller model or the gateway of a pool in a remote cluster, while the pool of the EPP is saturated, instead of
+// queuing or shedding them.
+//
+// The secondary pool is reached through its gateway, whose own EPP picks the endpoint and applies its own model
+// rewrites, so that the EPP needs no knowledge of the endpoints of the secondary pool.
+package poolfallback
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	reqcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/request"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/flowcontrol"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+const (
+	// DefaultSaturationThreshold is the default saturation of the pool from which the requests fall back.
+	DefaultSaturationThreshold = 1.0
+
+	// OutcomeDowngraded is the outcome recorded for a request forwarded to the secondary pool.
+	OutcomeDowngraded = "downgraded"
+	// OutcomeLoopPrevented is the outcome recorded for a request received while the pool was saturated that was kept,
+	// because it already fell back from another pool.
+	OutcomeLoopPrevented = "loop_prevented"
+)
+
+// Config is the configuration of the pool fallback.
+type Config struct {
+	// Address is the host:port the requests fall back to, e.g. the gateway of the secondary pool.
+	Address string
+	// SecondaryPoolName is the name of the secondary pool, reported to the clients in the response headers.
+	SecondaryPoolName string
+	// SaturationThreshold is the saturation of the pool from which the requests fall back.
+	SaturationThreshold float64
+	// PoolName is the name of the pool of the EPP, sent to the secondary pool with the requests falling back.
+	PoolName string
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c Config) Validate() error {
+	if c.Address == "" {
+		return errors.New("address must not be empty")
+	}
+	if _, _, err := net.SplitHostPort(c.Address); err != nil {
+		return fmt.Errorf("invalid address %q: %w", c.Address, err)
+	}
+	if c.SecondaryPoolName == "" {
+		return errors.New("secondary pool name must not be empty")
+	}
+	if c.SaturationThreshold <= 0 {
+		return fmt.Errorf("saturation threshold must be positive, got %v", c.SaturationThreshold)
+	}
+	return nil
+}
+
+// Router decides which requests fall back to the secondary pool.
+type Router struct {
+	config   Config
+	detector flowcontrol.SaturationDetector
+}
+
+// NewRouter creates a new router forwarding the requests to the secondary pool of the given configuration while the
+// given detector reports the pool at or over its threshold.
+func NewRouter(config Config, detector flowcontrol.SaturationDetector) (*Router, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	if detector == nil {
+		return nil, errors.New("saturation detector must not be nil")
+	}
+	return &Router{config: config, detector: detector}, nil
+}
+
+// Route returns the address and the name of the secondary pool to forward the request with the given headers to, and
+// true, if the given endpoints of the pool are saturated. The request is marked as downgraded in its headers, so that
+// the secondary pool does not forward it again: the requests that already fell back are never forwarded, which
+// prevents fallback loops between pools.
+func (r *Router) Route(ctx context.Context, headers map[string]string, endpoints []fwkdl.Endpoint) (string, string, bool) {
+	saturation, reason := flowcontrol.SaturationWithReason(ctx, r.detector, endpoints)
+	if saturation < r.config.SaturationThreshold {
+		return "", "", false
+	}
+	logger := log.FromContext(ctx)
+	if from, ok := headers[reqcommon.DowngradedFromHeaderKey]; ok {
+		logger.V(logutil.DEBUG).Info("Pool saturated, keeping request which already fell back",
+			"downgradedFrom", from, "saturation", saturation, "reason", reason)
+		metrics.RecordPoolFallback(r.config.PoolName, r.config.SecondaryPoolName, OutcomeLoopPrevented)
+		return "", "", false
+	}
+	headers[reqcommon.DowngradedFromHeaderKey] = r.config.PoolName
+	logger.V(logutil.DEBUG).Info("Pool saturated, forwarding request to the secondary pool",
+		"secondaryPool", r.config.SecondaryPoolName, "address", r.config.Address, "saturation", saturation, "reason", reason)
+	metrics.RecordPoolFallback(r.config.PoolName, r.config.SecondaryPoolName, OutcomeDowngraded)
+	return r.config.Address, r.config.SecondaryPoolName, true
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package poolfallback
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	reqcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/request"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+type fakeDetector struct {
+	saturation float64
+}
+
+func (d *fakeDetector) TypedName() fwkplugin.TypedName {
+	return fwkplugin.TypedName{Type: "fake-detector", Name: "fake-detector"}
+}
+
+func (d *fakeDetector) Saturation(context.Context, []fwkdl.Endpoint) float64 {
+	return d.saturation
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		wantErr bool
+	}{
+		{name: "valid", config: Config{Address: "small-pool:80", SecondaryPoolName: "small-pool", SaturationThreshold: 1}},
+		{name: "empty address", config: Config{SecondaryPoolName: "small-pool", SaturationThreshold: 1}, wantErr: true},
+		{name: "address without port", config: Config{Address: "small-pool", SecondaryPoolName: "small-pool", SaturationThreshold: 1}, wantErr: true},
+		{name: "empty secondary pool name", config: Config{Address: "small-pool:80", SaturationThreshold: 1}, wantErr: true},
+		{name: "non-positive threshold", config: Config{Address: "small-pool:80", SecondaryPoolName: "small-pool"}, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.config.Validate()
+			if test.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRoute(t *testing.T) {
+	detector := &fakeDetector{}
+	router, err := NewRouter(Config{Address: "small-pool:80", SecondaryPoolName: "small-pool", SaturationThreshold: 0.9, PoolName: "pool-a"}, detector)
+	require.NoError(t, err)
+
+	// Below the threshold, the requests are kept.
+	detector.saturation = 0.8
+	headers := map[string]string{}
+	_, _, ok := router.Route(context.Background(), headers, nil)
+	assert.False(t, ok)
+	assert.NotContains(t, headers, reqcommon.DowngradedFromHeaderKey)
+
+	// From the threshold, the requests fall back and are marked.
+	detector.saturation = 0.9
+	address, pool, ok := router.Route(context.Background(), headers, nil)
+	assert.True(t, ok)
+	assert.Equal(t, "small-pool:80", address)
+	assert.Equal(t, "small-pool", pool)
+	assert.Equal(t, "pool-a", headers[reqcommon.DowngradedFromHeaderKey])
+
+	// The requests which already fell back are kept.
+	headers = map[string]string{reqcommon.DowngradedFromHeaderKey: "pool-b"}
+	_, _, ok = router.Route(context.Background(), headers, nil)
+	assert.False(t, ok)
+	assert.Equal(t, "pool-b", headers[reqcommon.DowngradedFromHeaderKey])
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package poolpause
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// HandlerPath is the path on which the pool pause admin API is served.
+	HandlerPath = "/admin/v1/pool-pause"
+
+	// maxRequestBytes bounds the size of a pause request body.
+	maxRequestBytes = 64 * 1024
+)
+
+// PauseRequest is the body of a POST request to the pool pause admin API.
+type PauseRequest struct {
+	// Duration is the pause duration, in Go duration format (e.g. "90s", "10m").
+	Duration string `json:"duration"`
+	// Reason is a free-form, human readable explanation of the pause.
+	Reason string `json:"reason"`
+	// Source optionally identifies the system or operator pausing the pool.
+	Source string `json:"source,omitempty"`
+	// Policy is "queue" (default) to hold the requests until the pool is resumed, or "reject" to reject them.
+	Policy Policy `json:"policy,omitempty"`
+}
+
+// Status is the body of the responses to the GET requests to the pool pause admin API.
+type Status struct {
+	// Paused is true while the pool is paused.
+	Paused bool `json:"paused"`
+	// Pause is the active pause, if any.
+	Pause *Pause `json:"pause,omitempty"`
+}
+
+// NewHandler returns an http.Handler serving the pool pause admin API:
+//
+//	GET    - returns the pause status of the pool, see Status.
+//	POST   - pauses the pool or replaces the active pause, see PauseRequest.
+//	DELETE - resumes the pool.
+func NewHandler(controller *Controller) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			status := Status{}
+			if pause, ok := controller.Status(); ok {
+				status = Status{Paused: true, Pause: &pause}
+			}
+			writeJSON(w, http.StatusOK, status)
+		case http.MethodPost:
+			handlePause(controller, w, r)
+		case http.MethodDelete:
+			if !controller.Resume() {
+				http.Error(w, "inference pool is not paused", http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.Header().Set("Allow", strings.Join([]string{http.MethodGet, http.MethodPost, http.MethodDelete}, ", "))
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+func handlePause(controller *Controller, w http.ResponseWriter, r *http.Request) {
+	req := PauseRequest{Policy: PolicyQueue}
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBytes))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("failed to decode request body - %v", err), http.StatusBadRequest)
+		return
+	}
+	duration, err := time.ParseDuration(req.Duration)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid duration %q - %v", req.Duration, err), http.StatusBadRequest)
+		return
+	}
+	pause, err := controller.Pause(duration, req.Reason, req.Source, req.Policy)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	writeJSON(w, http.StatusOK, pause)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package poolpause implements the time-bounded pause of the dispatch of requests to an inference pool.
+//
+// Operators pause a pool for coordinated maintenance, for example the failover of the storage shared by its model
+// servers, during which the requests are held until the pool is resumed or rejected, depending on the policy of the
+// pause. Pauses are bounded in duration and lifted automatically when they expire, so that a forgotten pause cannot
+// take the pool out of service indefinitely.
+package poolpause
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+const (
+	// DefaultMaxDuration is the default upper bound on the duration of a pause.
+	DefaultMaxDuration = time.Hour
+
+	// sweepInterval dictates how often an expired pause is lifted and its metrics cleared.
+	sweepInterval = time.Second
+)
+
+// Policy defines what happens to the requests received while the pool is paused.
+type Policy string
+
+const (
+	// PolicyQueue holds the requests until the pool is resumed, the pause expires or the client gives up.
+	PolicyQueue Policy = "queue"
+	// PolicyReject rejects the requests with a 503 status code.
+	PolicyReject Policy = "reject"
+)
+
+// Pause describes a pause of the pool.
+type Pause struct {
+	// Policy defines what happens to the requests received while the pool is paused.
+	Policy Policy `json:"policy"`
+	// Reason is a free-form, human readable explanation of the pause.
+	Reason string `json:"reason"`
+	// Source optionally identifies the system or operator that paused the pool.
+	Source string `json:"source,omitempty"`
+	// CreatedAt is the time the pause was (last) set.
+	CreatedAt time.Time `json:"createdAt"`
+	// ExpiresAt is the time after which the pause is no longer honored.
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// Controller holds the pause of the pool, if any, and gates the dispatch of the requests accordingly. It is safe for
+// concurrent use.
+type Controller struct {
+	clock       clock.WithTicker
+	poolName    string
+	maxDuration time.Duration
+
+	mu    sync.Mutex
+	pause *Pause
+	// resumed is closed when the current pause is lifted.
+	resumed chan struct{}
+}
+
+// NewController creates a new pause controller for the given pool. Durations requested through Pause are capped by
+// maxDuration; a non-positive maxDuration selects DefaultMaxDuration.
+func NewController(poolName string, maxDuration time.Duration) *Controller {
+	return newControllerWithClock(poolName, maxDuration, &clock.RealClock{})
+}
+
+func newControllerWithClock(poolName string, maxDuration time.Duration, clk clock.WithTicker) *Controller {
+	if maxDuration <= 0 {
+		maxDuration = DefaultMaxDuration
+	}
+	return &Controller{
+		clock:       clk,
+		poolName:    poolName,
+		maxDuration: maxDuration,
+	}
+}
+
+// Pause pauses the dispatch of the requests to the pool for the given duration. Pausing an already paused pool
+// replaces the previous pause; the requests held by it stay held if the new pause also queues them.
+func (c *Controller) Pause(duration time.Duration, reason, source string, policy Policy) (Pause, error) {
+	if duration <= 0 {
+		return Pause{}, errors.New("duration must be positive")
+	}
+	if duration > c.maxDuration {
+		return Pause{}, fmt.Errorf("duration %s exceeds the maximum allowed duration %s", duration, c.maxDuration)
+	}
+	if reason == "" {
+		return Pause{}, errors.New("reason must not be empty")
+	}
+	if policy != PolicyQueue && policy != PolicyReject {
+		return Pause{}, fmt.Errorf("policy must be one of %s or %s, got %q", PolicyQueue, PolicyReject, policy)
+	}
+
+	now := c.clock.Now()
+	pause := &Pause{
+		Policy:    policy,
+		Reason:    reason,
+		Source:    source,
+		CreatedAt: now,
+		ExpiresAt: now.Add(duration),
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.pause == nil {
+		c.resumed = make(chan struct{})
+	}
+	c.pause = pause
+	metrics.RecordPoolPause(c.poolName, string(policy))
+	return *pause, nil
+}
+
+// Resume lifts the pause of the pool, releasing the held requests. It returns false if the pool was not paused.
+func (c *Controller) Resume() bool {
+	return c.lift(func(*Pause) bool { return true }, metrics.PoolPauseResumed) != nil
+}
+
+// Status returns the active, non-expired pause of the pool, if any.
+func (c *Controller) Status() (Pause, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if pause := c.activePause(); pause != nil {
+		return *pause, true
+	}
+	return Pause{}, false
+}
+
+// AwaitDispatch returns once the requests may be dispatched to the pool. While the pool is paused, it either holds the
+// request until the pause is lifted, or rejects it with a ServiceUnavailable errcommon.Error, depending on the policy
+// of the pause. Held requests whose context is done are rejected too.
+func (c *Controller) AwaitDispatch(ctx context.Context) error {
+	held := false
+	for {
+		c.mu.Lock()
+		pause, resumed := c.activePause(), c.resumed
+		c.mu.Unlock()
+		if pause == nil {
+			return nil
+		}
+		if pause.Policy == PolicyReject {
+			metrics.RecordPoolPausedRequest(c.poolName, metrics.PoolPausedRequestRejected)
+			return errcommon.Error{Code: errcommon.ServiceUnavailable, Msg: "inference pool paused: " + pause.Reason,
+				RetryAfter: pause.ExpiresAt.Sub(c.clock.Now())}
+		}
+		if !held {
+			held = true
+			metrics.RecordPoolPausedRequest(c.poolName, metrics.PoolPausedRequestHeld)
+			log.FromContext(ctx).V(logutil.DEBUG).Info("Holding request while the inference pool is paused",
+				"reason", pause.Reason, "expiresAt", pause.ExpiresAt)
+		}
+
+		// The pause may be replaced while the request is held, so it is checked again once the current one expires.
+		timer := c.clock.NewTimer(pause.ExpiresAt.Sub(c.clock.Now()))
+		select {
+		case <-resumed:
+		case <-timer.C():
+		case <-ctx.Done():
+			timer.Stop()
+			metrics.RecordPoolPausedRequest(c.poolName, metrics.PoolPausedRequestAbandoned)
+			return errcommon.Error{Code: errcommon.ServiceUnavailable,
+				Msg: "client disconnected while the inference pool was paused: " + pause.Reason}
+		}
+		timer.Stop()
+	}
+}
+
+// Run periodically lifts the expired pause until the context is cancelled.
+func (c *Controller) Run(ctx context.Context) {
+	logger := log.FromContext(ctx).WithName("pool-pause")
+	ticker := c.clock.NewTicker(sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.V(logutil.DEFAULT).Info("Shutting down pool pause sweep")
+			return
+		case <-ticker.C():
+			if pause := c.sweep(); pause != nil {
+				logger.V(logutil.DEFAULT).Info("Inference pool pause expired", "reason", pause.Reason,
+					"source", pause.Source, "policy", pause.Policy)
+			}
+		}
+	}
+}
+
+// sweep lifts the pause if it expired and returns it.
+func (c *Controller) sweep() *Pause {
+	now := c.clock.Now()
+	return c.lift(func(pause *Pause) bool { return !now.Before(pause.ExpiresAt) }, metrics.PoolPauseExpired)
+}
+
+// lift lifts the pause if the given condition holds for it, and returns it.
+func (c *Controller) lift(condition func(*Pause) bool, cause string) *Pause {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	pause := c.pause
+	if pause == nil || !condition(pause) {
+		return nil
+	}
+	c.pause = nil
+	close(c.resumed)
+	metrics.RecordPoolPauseLifted(c.poolName, cause)
+	return pause
+}
+
+// activePause returns the pause if it did not expire. The caller must hold the lock.
+func (c *Controller) activePause() *Pause {
+	if c.pause == nil || !c.clock.Now().Before(c.pause.ExpiresAt) {
+		return nil
+	}
+	return c.pause
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package poolpause
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	testclock "k8s.io/utils/clock/testing"
+
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+// awaitDispatch runs AwaitDispatch in the background and returns the channel of its result.
+func awaitDispatch(ctx context.Context, controller *Controller) <-chan error {
+	res := make(chan error, 1)
+	go func() { res <- controller.AwaitDispatch(ctx) }()
+	return res
+}
+
+func TestController_Queue(t *testing.T) {
+	clk := testclock.NewFakeClock(time.Now())
+	controller := newControllerWithClock("pool", time.Hour, clk)
+	require.NoError(t, controller.AwaitDispatch(t.Context()), "requests are dispatched while the pool is not paused")
+
+	_, err := controller.Pause(10*time.Minute, "storage failover", "oncall", PolicyQueue)
+	require.NoError(t, err)
+	pause, paused := controller.Status()
+	require.True(t, paused)
+	assert.Equal(t, "storage failover", pause.Reason)
+
+	// Held requests are released when the pool is resumed.
+	held := awaitDispatch(t.Context(), controller)
+	assert.Never(t, func() bool { return len(held) > 0 }, 50*time.Millisecond, 10*time.Millisecond)
+	assert.True(t, controller.Resume())
+	assert.NoError(t, <-held)
+	assert.False(t, controller.Resume(), "the pool is not paused anymore")
+	_, paused = controller.Status()
+	assert.False(t, paused)
+
+	// Held requests are released when the pause expires.
+	_, err = controller.Pause(time.Minute, "storage failover", "", PolicyQueue)
+	require.NoError(t, err)
+	held = awaitDispatch(t.Context(), controller)
+	require.Eventually(t, clk.HasWaiters, time.Second, 10*time.Millisecond)
+	clk.Step(time.Minute)
+	assert.NoError(t, <-held)
+	require.NotNil(t, controller.sweep())
+	assert.Nil(t, controller.sweep())
+
+	// Held requests whose client gives up are rejected.
+	_, err = controller.Pause(time.Minute, "storage failover", "", PolicyQueue)
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(t.Context())
+	held = awaitDispatch(ctx, controller)
+	cancel()
+	err = <-held
+	var e errcommon.Error
+	require.ErrorAs(t, err, &e)
+	assert.Equal(t, errcommon.ServiceUnavailable, e.Code)
+}
+
+func TestController_Reject(t *testing.T) {
+	controller := NewController("pool", time.Hour)
+	_, err := controller.Pause(time.Minute, "storage failover", "", PolicyReject)
+	require.NoError(t, err)
+
+	err = controller.AwaitDispatch(t.Context())
+	var e errcommon.Error
+	require.ErrorAs(t, err, &e)
+	assert.Equal(t, errcommon.ServiceUnavailable, e.Code)
+	assert.Contains(t, e.Msg, "storage failover")
+	assert.InDelta(t, time.Minute, e.RetryAfter, float64(time.Second), "clients should retry once the pause expires")
+
+	// Replacing the pause with a queuing one holds the subsequent requests.
+	_, err = controller.Pause(time.Minute, "storage failover", "", PolicyQueue)
+	require.NoError(t, err)
+	held := awaitDispatch(t.Context(), controller)
+	assert.True(t, controller.Resume())
+	assert.NoError(t, <-held)
+}
+
+func TestController_PauseValidation(t *testing.T) {
+	controller := NewController("pool", 10*time.Minute)
+
+	tests := []struct {
+		name     string
+		duration time.Duration
+		reason   string
+		policy   Policy
+	}{
+		{name: "non-positive duration", duration: 0, reason: "r", policy: PolicyQueue},
+		{name: "duration above maximum", duration: time.Hour, reason: "r", policy: PolicyQueue},
+		{name: "missing reason", duration: time.Minute, policy: PolicyQueue},
+		{name: "unknown policy", duration: time.Minute, reason: "r", policy: "drop"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := controller.Pause(test.duration, test.reason, "", test.policy)
+			assert.Error(t, err)
+		})
+	}
+	_, paused := controller.Status()
+	assert.False(t, paused)
+}
+
+func TestHandler(t *testing.T) {
+	controller := NewController("pool", time.Hour)
+	handler := NewHandler(controller)
+
+	serve := func(method, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(method, HandlerPath, strings.NewReader(body)))
+		return rec
+	}
+
+	rec := serve(http.MethodGet, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"paused":false}`, rec.Body.String())
+
+	rec = serve(http.MethodPost, `{"duration":"5m","reason":"storage failover","source":"oncall"}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	pause, paused := controller.Status()
+	require.True(t, paused)
+	assert.Equal(t, PolicyQueue, pause.Policy, "requests are queued by default")
+
+	rec = serve(http.MethodPost, `{"duration":"2h","reason":"r"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = serve(http.MethodPost, `{"duration":"forever","reason":"r"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = serve(http.MethodPost, `{"duration":"5m","reason":"r","unknown":1}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = serve(http.MethodGet, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "storage failover")
+
+	rec = serve(http.MethodDelete, "")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	rec = serve(http.MethodDelete, "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = serve(http.MethodPut, "")
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
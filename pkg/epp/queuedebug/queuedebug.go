@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package queuedebug implements the flow control queue debug API of the EPP, which lists the requests currently queued
+// by the Flow Control layer with their flow and age. It helps telling which flows hold the queues when they back up.
+package queuedebug
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/contracts"
+)
+
+// Queues are the requests queued by the Flow Control layer, as served by the queue debug API.
+type Queues struct {
+	// Occupants are the queued requests, sorted by descending priority then from the oldest.
+	Occupants []Occupant `json:"occupants"`
+}
+
+// Occupant is a single queued request.
+type Occupant struct {
+	RequestID   string    `json:"requestId"`
+	FairnessID  string    `json:"fairnessId"`
+	Priority    int       `json:"priority"`
+	EnqueueTime time.Time `json:"enqueueTime"`
+	// AgeSeconds is the time the request has been in the Flow Control layer, in seconds.
+	AgeSeconds float64 `json:"ageSeconds"`
+	ByteSize   uint64  `json:"byteSize"`
+}
+
+// Registry lists the queued items, as the flow registry does.
+type Registry interface {
+	QueuedItems() []contracts.QueuedItem
+}
+
+// Inspector assembles the Queues of the Flow Control layer.
+type Inspector struct {
+	registry Registry
+	clock    clock.PassiveClock
+}
+
+// NewInspector returns an Inspector of the items queued in the given registry.
+func NewInspector(registry Registry, clock clock.PassiveClock) *Inspector {
+	return &Inspector{registry: registry, clock: clock}
+}
+
+// Queues returns the requests currently queued.
+func (i *Inspector) Queues() Queues {
+	now := i.clock.Now()
+	items := i.registry.QueuedItems()
+	occupants := make([]Occupant, 0, len(items))
+	for _, item := range items {
+		occupants = append(occupants, Occupant{
+			RequestID:   item.RequestID,
+			FairnessID:  item.FlowKey.ID,
+			Priority:    item.FlowKey.Priority,
+			EnqueueTime: item.EnqueueTime,
+			AgeSeconds:  now.Sub(item.EnqueueTime).Seconds(),
+			ByteSize:    item.ByteSize,
+		})
+	}
+	slices.SortFunc(occupants, func(a, b Occupant) int {
+		if a.Priority != b.Priority {
+			return cmp.Compare(b.Priority, a.Priority)
+		}
+		return a.EnqueueTime.Compare(b.EnqueueTime)
+	})
+	return Queues{Occupants: occupants}
+}
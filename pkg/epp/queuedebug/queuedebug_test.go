@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queuedebug
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	testclock "k8s.io/utils/clock/testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/contracts"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/flowcontrol"
+)
+
+type fakeRegistry []contracts.QueuedItem
+
+func (r fakeRegistry) QueuedItems() []contracts.QueuedItem { return r }
+
+func TestQueues(t *testing.T) {
+	now := time.Now()
+	registry := fakeRegistry{
+		{RequestID: "low", FlowKey: flowcontrol.FlowKey{ID: "a", Priority: -1}, EnqueueTime: now.Add(-5 * time.Second)},
+		{RequestID: "high-new", FlowKey: flowcontrol.FlowKey{ID: "b", Priority: 10}, EnqueueTime: now.Add(-time.Second)},
+		{RequestID: "high-old", FlowKey: flowcontrol.FlowKey{ID: "a", Priority: 10}, EnqueueTime: now.Add(-2 * time.Second),
+			ByteSize: 128},
+	}
+	inspector := NewInspector(registry, testclock.NewFakeClock(now))
+
+	queues := inspector.Queues()
+	require.Len(t, queues.Occupants, 3)
+	assert.Equal(t, "high-old", queues.Occupants[0].RequestID)
+	assert.Equal(t, "high-new", queues.Occupants[1].RequestID)
+	assert.Equal(t, "low", queues.Occupants[2].RequestID)
+	assert.Equal(t, Occupant{
+		RequestID:   "high-old",
+		FairnessID:  "a",
+		Priority:    10,
+		EnqueueTime: now.Add(-2 * time.Second),
+		AgeSeconds:  2,
+		ByteSize:    128,
+	}, queues.Occupants[0])
+}
+
+func TestHandler(t *testing.T) {
+	now := time.Now()
+	registry := fakeRegistry{{RequestID: "1", FlowKey: flowcontrol.FlowKey{ID: "a"}, EnqueueTime: now.Add(-time.Second)}}
+	handler := NewHandler(NewInspector(registry, testclock.NewFakeClock(now)))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, HandlerPath, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	queues := Queues{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &queues))
+	require.Len(t, queues.Occupants, 1)
+	assert.Equal(t, "1", queues.Occupants[0].RequestID)
+	assert.InDelta(t, 1, queues.Occupants[0].AgeSeconds, 1e-9)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, HandlerPath, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
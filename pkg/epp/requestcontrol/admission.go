@@ -18,9 +18,15 @@ package requestcontrol
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
@@ -55,12 +61,25 @@ type AdmissionController interface {
 	) error
 }
 
+// UsageRecorder is implemented by the AdmissionControllers accounting the tokens actually consumed by the admitted
+// requests, rather than their estimate from the request size.
+type UsageRecorder interface {
+	// RecordUsage records the token usage reported in the response of the admitted request.
+	RecordUsage(ctx context.Context, reqCtx *handlers.RequestContext)
+}
+
 // flowController defines the minimal interface required by FlowControlAdmissionController for enqueuing requests and
 // waiting for an admission outcome.
 type flowController interface {
 	EnqueueAndWait(ctx context.Context, req flowcontrol.FlowControlRequest) (types.QueueOutcome, error)
 }
 
+// usageAccountingFlowController is implemented by the flow controllers accounting the token usage of the dispatched
+// requests.
+type usageAccountingFlowController interface {
+	RecordUsage(req flowcontrol.FlowControlRequest, promptTokens, completionTokens int)
+}
+
 // rejectIfSheddableAndSaturated checks if a request should be immediately rejected.
 func rejectIfSheddableAndSaturated(
 	ctx context.Context,
@@ -71,12 +90,13 @@ func rejectIfSheddableAndSaturated(
 	logger logr.Logger,
 ) error {
 	if requtil.IsSheddable(priority) {
-		if sd.Saturation(ctx, endpointCandidates.Locate(ctx, reqCtx.Request.Metadata)) >= 1.0 {
+		saturation, reason := flowcontrol.SaturationWithReason(ctx, sd, endpointCandidates.Locate(ctx, reqCtx.Request.Metadata))
+		if saturation >= 1.0 {
 			logger.V(logutil.TRACE).Info("Request rejected: system saturated and request is sheddable",
-				"requestID", reqCtx.SchedulingRequest.RequestId)
+				"requestID", reqCtx.SchedulingRequest.RequestId, "reason", reason)
 			return errcommon.Error{
 				Code: errcommon.ResourceExhausted,
-				Msg:  "system saturated, sheddable request dropped",
+				Msg:  fmt.Sprintf("system saturated, sheddable request dropped (reason: %s)", reason),
 			}
 		}
 	}
@@ -155,8 +175,44 @@ func (fcac *FlowControlAdmissionController) Admit(
 	logger.V(logutil.TRACE).Info("Executing FlowControlAdmissionController",
 		"requestID", reqCtx.SchedulingRequest.RequestId, "priority", priority, "fairnessID", reqCtx.FairnessID)
 
-	fcReq := &flowControlRequest{
+	// The span covers the time spent queued in the Flow Control layer until the request is dispatched or rejected.
+	ctx, span := otel.Tracer("gateway-api-inference-extension").Start(ctx, "gateway.flow_control",
+		trace.WithAttributes(
+			attribute.Int("request_prio", priority),
+			attribute.String("fairness_id", reqCtx.FairnessID),
+		))
+	defer span.End()
+
+	outcome, err := fcac.flowController.EnqueueAndWait(ctx, fcac.newFlowControlRequest(reqCtx, priority))
+	logger.V(logutil.DEBUG).Info("Flow control outcome",
+		"requestID", reqCtx.SchedulingRequest.RequestId, "outcome", outcome, "error", err)
+	span.SetAttributes(attribute.String("outcome", outcome.String()))
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return translateFlowControlOutcome(outcome, err)
+}
+
+// RecordUsage implements the UsageRecorder interface by accounting the token usage of the request in the Flow Control
+// layer, if the flow controller supports it.
+func (fcac *FlowControlAdmissionController) RecordUsage(ctx context.Context, reqCtx *handlers.RequestContext) {
+	recorder, ok := fcac.flowController.(usageAccountingFlowController)
+	if !ok {
+		return
+	}
+	recorder.RecordUsage(fcac.newFlowControlRequest(reqCtx, reqCtx.Priority), reqCtx.Usage.PromptTokens,
+		reqCtx.Usage.CompletionTokens)
+	log.FromContext(ctx).V(logutil.TRACE).Info("Recorded flow control token usage",
+		"requestID", reqCtx.SchedulingRequest.RequestId, "usage", reqCtx.Usage)
+}
+
+// newFlowControlRequest returns the flow control request of the request. It is rebuilt identically when the usage of
+// the request is recorded, so that the estimate charged at admission can be settled.
+func (fcac *FlowControlAdmissionController) newFlowControlRequest(reqCtx *handlers.RequestContext,
+	priority int) *flowControlRequest {
+	return &flowControlRequest{
 		fairnessID:        reqCtx.FairnessID,
+		familyID:          reqCtx.RequestFamilyID,
 		priority:          priority,
 		requestByteSize:   uint64(reqCtx.RequestSize),
 		inferenceRequest:  reqCtx.SchedulingRequest,
@@ -164,17 +220,56 @@ func (fcac *FlowControlAdmissionController) Admit(
 		reqMetadata:       reqCtx.Request.Metadata,
 		inferencePoolName: fcac.poolName,
 		modelName:         reqCtx.IncomingModelName,
+		maxQueueWait:      reqCtx.MaxQueueWait,
 	}
+}
 
-	outcome, err := fcac.flowController.EnqueueAndWait(ctx, fcReq)
-	logger.V(logutil.DEBUG).Info("Flow control outcome",
-		"requestID", reqCtx.SchedulingRequest.RequestId, "outcome", outcome, "error", err)
-	return translateFlowControlOutcome(outcome, err)
+// --- GatedAdmissionController ---
+
+// DispatchGate holds or rejects the requests before their admission, e.g. while the dispatch to the pool is paused.
+type DispatchGate interface {
+	// AwaitDispatch returns once the request may proceed to admission, or the errcommon.Error to reject it with.
+	AwaitDispatch(ctx context.Context) error
+}
+
+// GatedAdmissionController passes the requests through a DispatchGate before delegating their admission decision to
+// another AdmissionController.
+type GatedAdmissionController struct {
+	gate DispatchGate
+	next AdmissionController
+}
+
+// NewGatedAdmissionController creates a new GatedAdmissionController.
+func NewGatedAdmissionController(gate DispatchGate, next AdmissionController) *GatedAdmissionController {
+	return &GatedAdmissionController{
+		gate: gate,
+		next: next,
+	}
+}
+
+// Admit implements the AdmissionController interface by waiting for the gate, then deferring to the next controller.
+func (gac *GatedAdmissionController) Admit(
+	ctx context.Context,
+	reqCtx *handlers.RequestContext,
+	priority int,
+) error {
+	if err := gac.gate.AwaitDispatch(ctx); err != nil {
+		return err
+	}
+	return gac.next.Admit(ctx, reqCtx, priority)
+}
+
+// RecordUsage implements the UsageRecorder interface by deferring to the next controller, if it records usage.
+func (gac *GatedAdmissionController) RecordUsage(ctx context.Context, reqCtx *handlers.RequestContext) {
+	if recorder, ok := gac.next.(UsageRecorder); ok {
+		recorder.RecordUsage(ctx, reqCtx)
+	}
 }
 
 // flowControlRequest is an adapter that implements the FlowControlRequest interface.
 type flowControlRequest struct {
 	fairnessID        string
+	familyID          string
 	priority          int
 	requestByteSize   uint64
 	inferenceRequest  *scheduling.InferenceRequest
@@ -182,6 +277,7 @@ type flowControlRequest struct {
 	reqMetadata       map[string]any
 	inferencePoolName string
 	modelName         string
+	maxQueueWait      time.Duration
 }
 
 var _ flowcontrol.FlowControlRequest = &flowControlRequest{}
@@ -192,7 +288,8 @@ func (r *flowControlRequest) ID() string {
 	}
 	return r.inferenceRequest.RequestId
 }
-func (r *flowControlRequest) InitialEffectiveTTL() time.Duration { return 0 } // Use controller default.
+func (r *flowControlRequest) FamilyID() string                   { return r.familyID }
+func (r *flowControlRequest) InitialEffectiveTTL() time.Duration { return r.maxQueueWait } // Zero uses the default.
 func (r *flowControlRequest) ByteSize() uint64                   { return r.requestByteSize }
 func (r *flowControlRequest) InferenceRequest() *scheduling.InferenceRequest {
 	return r.inferenceRequest
@@ -228,7 +325,13 @@ func translateFlowControlOutcome(outcome types.QueueOutcome, err error) error {
 		return errcommon.Error{Code: errcommon.ServiceUnavailable, Msg: "request timed out in queue: " + msg}
 	case types.QueueOutcomeEvictedContextCancelled:
 		return errcommon.Error{Code: errcommon.ServiceUnavailable, Msg: "client disconnected: " + msg}
+	case types.QueueOutcomeEvictedPreempted:
+		return errcommon.Error{Code: errcommon.ResourceExhausted, Msg: msg}
 	case types.QueueOutcomeRejectedOther, types.QueueOutcomeEvictedOther:
+		if errors.Is(err, types.ErrFlowControllerNotRunning) {
+			// The EPP is shutting down, the request can be retried on another replica.
+			return errcommon.Error{Code: errcommon.ServiceUnavailable, Msg: "flow control is shutting down: " + msg}
+		}
 		return errcommon.Error{Code: errcommon.Internal, Msg: "internal flow control error: " + msg}
 	default:
 		return errcommon.Error{Code: errcommon.Internal, Msg: "unhandled flow control outcome: " + msg}
@@ -19,7 +19,9 @@ package requestcontrol
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -31,6 +33,7 @@ import (
 	fctypes "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/types"
 	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/flowcontrol"
+	fwkrh "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
 	schedulingtypes "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/handlers"
 )
@@ -63,6 +66,17 @@ func (m *mockFlowController) EnqueueAndWait(
 	return m.outcome, m.err
 }
 
+type mockUsageAccountingFlowController struct {
+	mockFlowController
+	req              flowcontrol.FlowControlRequest
+	promptTokens     int
+	completionTokens int
+}
+
+func (m *mockUsageAccountingFlowController) RecordUsage(req flowcontrol.FlowControlRequest, promptTokens, completionTokens int) {
+	m.req, m.promptTokens, m.completionTokens = req, promptTokens, completionTokens
+}
+
 // --- Legacy Controller Tests ---
 
 func TestLegacyAdmissionController_Admit(t *testing.T) {
@@ -161,6 +175,7 @@ func TestFlowControlRequestAdapter(t *testing.T) {
 		fairnessID      string
 		priority        int
 		requestByteSize uint64
+		maxQueueWait    time.Duration
 		expectFlowKey   flowcontrol.FlowKey
 	}{
 		{
@@ -171,6 +186,14 @@ func TestFlowControlRequestAdapter(t *testing.T) {
 			requestByteSize: 1024,
 			expectFlowKey:   flowcontrol.FlowKey{ID: "flow-1", Priority: 10},
 		},
+		{
+			name:            "max queue wait",
+			requestID:       "req-2",
+			fairnessID:      "flow-2",
+			requestByteSize: 512,
+			maxQueueWait:    500 * time.Millisecond,
+			expectFlowKey:   flowcontrol.FlowKey{ID: "flow-2"},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -181,12 +204,13 @@ func TestFlowControlRequestAdapter(t *testing.T) {
 				priority:         tc.priority,
 				requestByteSize:  tc.requestByteSize,
 				inferenceRequest: &schedulingtypes.InferenceRequest{RequestId: tc.requestID},
+				maxQueueWait:     tc.maxQueueWait,
 			}
 
 			assert.Equal(t, tc.requestID, fcReq.ID(), "ID() mismatch")
 			assert.Equal(t, tc.requestByteSize, fcReq.ByteSize(), "ByteSize() mismatch")
 			assert.Equal(t, tc.expectFlowKey, fcReq.FlowKey(), "FlowKey() mismatch")
-			assert.Zero(t, fcReq.InitialEffectiveTTL(), "InitialEffectiveTTL() should be zero")
+			assert.Equal(t, tc.maxQueueWait, fcReq.InitialEffectiveTTL(), "InitialEffectiveTTL() mismatch")
 		})
 	}
 }
@@ -247,6 +271,15 @@ func TestFlowControlAdmissionController_Admit(t *testing.T) {
 			expectErrCode:   errcommon.ServiceUnavailable,
 			expectErrSubstr: "client disconnected",
 		},
+		{
+			name:            "fc_evict_preempted",
+			priority:        -1,
+			fcOutcome:       fctypes.QueueOutcomeEvictedPreempted,
+			fcErr:           fmt.Errorf("%w: %w", fctypes.ErrEvicted, fctypes.ErrPreempted),
+			expectErr:       true,
+			expectErrCode:   errcommon.ResourceExhausted,
+			expectErrSubstr: "preempted by a higher priority request",
+		},
 		{
 			name:            "fc_reject_other",
 			priority:        0,
@@ -264,6 +297,15 @@ func TestFlowControlAdmissionController_Admit(t *testing.T) {
 			expectErrCode:   errcommon.Internal,
 			expectErrSubstr: "internal flow control error: internal error",
 		},
+		{
+			name:            "fc_evict_shutdown",
+			priority:        0,
+			fcOutcome:       fctypes.QueueOutcomeEvictedOther,
+			fcErr:           fmt.Errorf("%w: %w", fctypes.ErrEvicted, fctypes.ErrFlowControllerNotRunning),
+			expectErr:       true,
+			expectErrCode:   errcommon.ServiceUnavailable,
+			expectErrSubstr: "flow control is shutting down",
+		},
 		{
 			name:            "fc_unhandled_outcome",
 			priority:        0,
@@ -297,3 +339,78 @@ func TestFlowControlAdmissionController_Admit(t *testing.T) {
 		})
 	}
 }
+
+// --- Gated Controller Tests ---
+
+type mockDispatchGate struct {
+	err error
+}
+
+func (m *mockDispatchGate) AwaitDispatch(_ context.Context) error {
+	return m.err
+}
+
+func TestFlowControlAdmissionController_RecordUsage(t *testing.T) {
+	t.Parallel()
+	ctx := logutil.NewTestLoggerIntoContext(context.Background())
+	reqCtx := &handlers.RequestContext{
+		SchedulingRequest: &schedulingtypes.InferenceRequest{RequestId: "test-req"},
+		Request:           &handlers.Request{Metadata: map[string]any{}},
+		FairnessID:        "tenant-a",
+		RequestFamilyID:   "task-1",
+		Priority:          2,
+		RequestSize:       400,
+		Usage:             fwkrh.Usage{PromptTokens: 90, CompletionTokens: 30},
+	}
+
+	fc := &mockUsageAccountingFlowController{}
+	// The usage is recorded through the gate as well.
+	var controller AdmissionController = NewGatedAdmissionController(&mockDispatchGate{},
+		NewFlowControlAdmissionController(fc, "pool"))
+	recorder, ok := controller.(UsageRecorder)
+	require.True(t, ok)
+	recorder.RecordUsage(ctx, reqCtx)
+
+	require.NotNil(t, fc.req)
+	assert.Equal(t, flowcontrol.FlowKey{ID: "tenant-a", Priority: 2}, fc.req.FlowKey())
+	assert.Equal(t, "task-1", fc.req.FamilyID())
+	assert.Equal(t, uint64(400), fc.req.ByteSize(), "the request should be rebuilt as at admission")
+	assert.Equal(t, 90, fc.promptTokens)
+	assert.Equal(t, 30, fc.completionTokens)
+
+	// Flow controllers not accounting usage are skipped.
+	NewFlowControlAdmissionController(&mockFlowController{}, "pool").RecordUsage(ctx, reqCtx)
+}
+
+func TestGatedAdmissionController_Admit(t *testing.T) {
+	t.Parallel()
+	ctx := logutil.NewTestLoggerIntoContext(context.Background())
+	reqCtx := &handlers.RequestContext{
+		SchedulingRequest: &schedulingtypes.InferenceRequest{RequestId: "test-req"},
+		Request:           &handlers.Request{Metadata: map[string]any{}},
+	}
+	gateErr := errcommon.Error{Code: errcommon.ServiceUnavailable, Msg: "inference pool paused: maintenance"}
+
+	testCases := []struct {
+		name       string
+		gateErr    error
+		expectErr  error
+		expectNext bool
+	}{
+		{name: "gate_open", expectNext: true},
+		{name: "gate_rejects", gateErr: gateErr, expectErr: gateErr},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			fc := &mockFlowController{outcome: fctypes.QueueOutcomeDispatched}
+			ac := NewGatedAdmissionController(&mockDispatchGate{err: tc.gateErr}, NewFlowControlAdmissionController(fc, "pool"))
+
+			err := ac.Admit(ctx, reqCtx, 0)
+
+			assert.Equal(t, tc.expectErr, err)
+			assert.Equal(t, tc.expectNext, fc.called, "the next controller should only be called when the gate is open")
+		})
+	}
+}
@@ -54,6 +54,13 @@ const (
 // EndpointCandidatesConfig holds configuration for the DatastoreEndpointCandidates.
 type EndpointCandidatesConfig struct {
 	DisableEndpointSubsetFilter bool
+	// Excluders remove currently excluded endpoints from every candidate list.
+	Excluders []EndpointExcluder
+}
+
+// EndpointExcluder reports whether an endpoint is currently excluded from scheduling.
+type EndpointExcluder interface {
+	IsExcluded(endpoint *fwkdl.EndpointMetadata) bool
 }
 
 // EndpointCandidatesOption is a function that configures the EndpointCandidatesConfig.
@@ -66,6 +73,14 @@ func WithDisableEndpointSubsetFilter(disable bool) EndpointCandidatesOption {
 	}
 }
 
+// WithEndpointExcluder adds an EndpointExcluder used to drop excluded endpoints from the candidate list. An endpoint
+// excluded by any of the excluders is dropped.
+func WithEndpointExcluder(excluder EndpointExcluder) EndpointCandidatesOption {
+	return func(c *EndpointCandidatesConfig) {
+		c.Excluders = append(c.Excluders, excluder)
+	}
+}
+
 // DatastoreEndpointCandidates implements contracts.EndpointCandidates by querying the EPP Datastore.
 // It centralizes the logic for resolving endpoint candidates based on request metadata (specifically Envoy subset filters).
 type DatastoreEndpointCandidates struct {
@@ -101,24 +116,24 @@ func (d *DatastoreEndpointCandidates) Locate(ctx context.Context, requestMetadat
 	// If the user explicitly disabled subset filtering, return the default pool (all endpoint candidates).
 	if d.config.DisableEndpointSubsetFilter {
 		loggerTrace.Info("endpoint subset filtering is explicitly disabled, returning all endpoint candidates")
-		return d.datastore.PodList(datastore.AllPodsPredicate)
+		return d.podList(datastore.AllPodsPredicate)
 	}
 
 	// Check if the subset filter namespace exists in metadata.
 	// If not, we assume the request targets the default pool (all endpoint candidates).
 	if requestMetadata == nil {
-		return d.datastore.PodList(datastore.AllPodsPredicate)
+		return d.podList(datastore.AllPodsPredicate)
 	}
 
 	subsetMap, found := requestMetadata[metadata.SubsetFilterNamespace].(map[string]any)
 	if !found {
-		return d.datastore.PodList(datastore.AllPodsPredicate)
+		return d.podList(datastore.AllPodsPredicate)
 	}
 
 	// Check if the specific endpoint key exists within the subset map.
 	endpointSubsetList, found := subsetMap[metadata.SubsetFilterKey].([]any)
 	if !found {
-		return d.datastore.PodList(datastore.AllPodsPredicate)
+		return d.podList(datastore.AllPodsPredicate)
 	}
 
 	// If the filter key exists but the list is empty, it implies a filter that matched nothing upstream (or malformed
@@ -148,7 +163,7 @@ func (d *DatastoreEndpointCandidates) Locate(ctx context.Context, requestMetadat
 
 	// Query the Datastore with a predicate.
 	podTotalCount := 0
-	podFilteredList := d.datastore.PodList(func(pm fwkdl.Endpoint) bool {
+	podFilteredList := d.podList(func(pm fwkdl.Endpoint) bool {
 		podTotalCount++
 		// If the pod's IP is in our allowed map, include it.
 		// Note: We use GetIPAddress() which should align with the subset address.
@@ -167,6 +182,24 @@ func (d *DatastoreEndpointCandidates) Locate(ctx context.Context, requestMetadat
 	return podFilteredList
 }
 
+// podList lists the datastore endpoints matching the predicate that are not currently excluded.
+func (d *DatastoreEndpointCandidates) podList(predicate func(fwkdl.Endpoint) bool) []fwkdl.Endpoint {
+	if len(d.config.Excluders) == 0 {
+		return d.datastore.PodList(predicate)
+	}
+	return d.datastore.PodList(func(ep fwkdl.Endpoint) bool {
+		if !predicate(ep) {
+			return false
+		}
+		for _, excluder := range d.config.Excluders {
+			if excluder.IsExcluded(ep.GetMetadata()) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
 // --- CachedEndpointCandidates (The Decorator) ---
 
 // cacheEntry represents a snapshot of endpoint candidate metrics at a specific point in time.
@@ -133,6 +133,34 @@ func TestDatastoreEndpointCandidates_Locate(t *testing.T) {
 			}),
 			expectedEndpointIPs: []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"},
 		},
+		{
+			name: "Excluded endpoint is dropped",
+			opts: []EndpointCandidatesOption{
+				WithEndpointExcluder(staticExcluder{"pod-b": true}),
+			},
+			metadata:            nil,
+			expectedEndpointIPs: []string{"10.0.0.1", "10.0.0.3"},
+		},
+		{
+			name: "Excluded endpoint is dropped from subset filter matches",
+			opts: []EndpointCandidatesOption{
+				WithEndpointExcluder(staticExcluder{"pod-a": true}),
+			},
+			metadata: makeMetadataWithSubset([]any{
+				"10.0.0.1:8080",
+				"10.0.0.3:9090",
+			}),
+			expectedEndpointIPs: []string{"10.0.0.3"},
+		},
+		{
+			name: "Endpoints excluded by any excluder are dropped",
+			opts: []EndpointCandidatesOption{
+				WithEndpointExcluder(staticExcluder{"pod-a": true}),
+				WithEndpointExcluder(staticExcluder{"pod-c": true}),
+			},
+			metadata:            nil,
+			expectedEndpointIPs: []string{"10.0.0.2"},
+		},
 	}
 
 	for _, tc := range tests {
@@ -303,6 +331,13 @@ func (m *mockEndpointCandidates) callCount() int {
 	return m.calls
 }
 
+// staticExcluder excludes endpoints by name.
+type staticExcluder map[string]bool
+
+func (e staticExcluder) IsExcluded(endpoint *fwkdl.EndpointMetadata) bool {
+	return e[endpoint.NamespacedName.Name]
+}
+
 func makeMockEndpoint(name, ip string) fwkdl.Endpoint {
 	return &backendmetrics.FakePodMetrics{
 		Metadata: &fwkdl.EndpointMetadata{
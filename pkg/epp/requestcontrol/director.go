@@ -21,9 +21,11 @@ package requestcontrol
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/rand"
 	"net"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -31,6 +33,7 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	"sigs.k8s.io/gateway-api-inference-extension/apix/v1alpha2"
@@ -41,11 +44,15 @@ import (
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datastore"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/contracts"
 	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
 	fwk "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
 	fwkrh "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
 	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/handlers"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metadata"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/pluginquarantine"
+	requtil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/request"
 )
 
 const (
@@ -69,6 +76,31 @@ type Scheduler interface {
 	Schedule(ctx context.Context, request *fwksched.InferenceRequest, candidateEndpoints []fwksched.Endpoint) (result *fwksched.SchedulingResult, err error)
 }
 
+// DecisionRecorder records the scheduling decisions and the outcome of their requests, e.g. to replay them against a
+// hypothetical topology of the pool.
+type DecisionRecorder interface {
+	RecordDecision(request *fwksched.InferenceRequest, endpoints []fwksched.Endpoint, result *fwksched.SchedulingResult)
+	RecordOutcome(requestID string, latency time.Duration, failed bool)
+}
+
+// ArrivalRecorder records the arrival of the requests, e.g. to adapt the metrics scrape interval to the request rate.
+type ArrivalRecorder interface {
+	RecordArrival()
+}
+
+// Spiller forwards the requests overflowing the flow control queues to a peer pool.
+type Spiller interface {
+	// Spill returns the address to forward the request with the given headers to, and true, if it is spilled over.
+	Spill(ctx context.Context, headers map[string]string) (string, bool)
+}
+
+// PoolFallback forwards the requests of the objectives opting in to a secondary pool while the pool is saturated.
+type PoolFallback interface {
+	// Route returns the address and the name of the secondary pool to forward the request with the given headers to,
+	// and true, if the given endpoints are saturated.
+	Route(ctx context.Context, headers map[string]string, endpoints []fwkdl.Endpoint) (string, string, bool)
+}
+
 // NewDirectorWithConfig creates a new Director instance with all dependencies.
 func NewDirectorWithConfig(
 	datastore Datastore,
@@ -87,6 +119,72 @@ func NewDirectorWithConfig(
 	}
 }
 
+// WithPluginBreaker sets the breaker the request control plugins are run through.
+func (d *Director) WithPluginBreaker(breaker *pluginquarantine.Breaker) *Director {
+	d.pluginBreaker = breaker
+	return d
+}
+
+// WithDecisionRecorder sets the recorder of the scheduling decisions.
+func (d *Director) WithDecisionRecorder(recorder DecisionRecorder) *Director {
+	d.decisionRecorder = recorder
+	return d
+}
+
+// WithIdempotencyPolicy sets the policy deciding which requests are idempotent. Without one, the requests declaring
+// nothing are idempotent.
+func (d *Director) WithIdempotencyPolicy(policy *IdempotencyPolicy) *Director {
+	d.idempotency = policy
+	return d
+}
+
+// WithSchedulingHintsPolicy sets the policy parsing the scheduling hints of the requests. Without one, the scheduling
+// hints are ignored.
+func (d *Director) WithSchedulingHintsPolicy(policy *SchedulingHintsPolicy) *Director {
+	d.schedulingHints = policy
+	return d
+}
+
+// WithArrivalRecorder sets the recorder of the arrival of the requests.
+func (d *Director) WithArrivalRecorder(recorder ArrivalRecorder) *Director {
+	d.arrivalRecorder = recorder
+	return d
+}
+
+// WithSpiller sets the spiller forwarding the requests overflowing the flow control queues to a peer pool.
+func (d *Director) WithSpiller(spiller Spiller) *Director {
+	d.spiller = spiller
+	return d
+}
+
+// WithPoolFallback sets the pool fallback forwarding the requests opting in to a secondary pool while the pool is
+// saturated.
+func (d *Director) WithPoolFallback(poolFallback PoolFallback) *Director {
+	d.poolFallback = poolFallback
+	return d
+}
+
+// WithDefaultMaxQueueWait sets the maximum queue wait of the requests whose objective declares none, i.e. the flow
+// control default request TTL, capping the one declared by their header.
+func (d *Director) WithDefaultMaxQueueWait(wait time.Duration) *Director {
+	d.defaultMaxQueueWait = wait
+	return d
+}
+
+// runPlugin runs the given plugin function through the plugin breaker, so that its panics are recovered and the plugin
+// is skipped while quarantined. Failed runs are recorded in the plugin error metrics and logged.
+func (d *Director) runPlugin(ctx context.Context, extensionPoint string, plugin fwkplugin.TypedName, run func() error) error {
+	err := d.pluginBreaker.Run(ctx, extensionPoint, plugin, run)
+	if err != nil && !errors.Is(err, pluginquarantine.ErrQuarantined) {
+		metrics.RecordPluginError("", extensionPoint, plugin.Type, plugin.Name)
+	}
+	if err != nil {
+		log.FromContext(ctx).V(logutil.DEBUG).Info("Plugin run failed or skipped", "plugin", plugin, "extensionPoint", extensionPoint,
+			"reason", err.Error())
+	}
+	return err
+}
+
 // responseBodyWork represents a unit of work to be processed by the async response body queue.
 type responseBodyWork struct {
 	ctx            context.Context
@@ -118,6 +216,24 @@ type Director struct {
 	admissionController   AdmissionController
 	endpointCandidates    contracts.EndpointCandidates
 	requestControlPlugins Config
+	// pluginBreaker quarantines the request control plugins failing repeatedly. The panics of the plugins are recovered
+	// whether it is set or not.
+	pluginBreaker *pluginquarantine.Breaker
+	// decisionRecorder is optional, set when the scheduling decisions are recorded.
+	decisionRecorder DecisionRecorder
+	// arrivalRecorder is optional, set when the arrival of the requests is recorded.
+	arrivalRecorder ArrivalRecorder
+	// idempotency decides which requests are idempotent. It may be nil.
+	idempotency *IdempotencyPolicy
+	// schedulingHints parses the scheduling hints allowed by the configuration. It may be nil, allowing no hint.
+	schedulingHints *SchedulingHintsPolicy
+	// spiller is optional, set when the requests overflowing the flow control queues are spilled over to a peer pool.
+	spiller Spiller
+	// poolFallback is optional, set when the requests opting in fall back to a secondary pool while the pool is saturated.
+	poolFallback PoolFallback
+	// defaultMaxQueueWait caps the maximum queue wait declared by the requests whose objective declares none. Zero does
+	// not cap it.
+	defaultMaxQueueWait time.Duration
 	// we just need a pointer to an int variable since priority is a pointer in InferenceObjective
 	// no need to set this in the constructor, since the value we want is the default int val
 	// and value types cannot be nil
@@ -146,6 +262,49 @@ func (d *Director) getInferenceObjective(ctx context.Context, reqCtx *handlers.R
 	return infObjective
 }
 
+// latencyObjective returns the latency objective declared by the given request header value, in milliseconds, falling
+// back to the given InferenceObjective's objective. Zero means that no objective was declared.
+func latencyObjective(objective *metav1.Duration, headerValue string) time.Duration {
+	if headerValue != "" {
+		if ms, err := strconv.ParseFloat(headerValue, 64); err == nil && ms > 0 {
+			return time.Duration(ms * float64(time.Millisecond))
+		}
+	}
+	if objective != nil && objective.Duration > 0 {
+		return objective.Duration
+	}
+	return 0
+}
+
+// maxQueueWait returns the maximum queue wait declared by the given request header value, in milliseconds, falling back
+// to the given InferenceObjective's. The header may only shorten the wait: it is capped at the objective's, or at the
+// given default when the objective declares none. Zero means that no maximum queue wait was declared.
+func maxQueueWait(objective *metav1.Duration, defaultWait time.Duration, headerValue string) time.Duration {
+	wait := latencyObjective(objective, headerValue)
+	limit := defaultWait
+	if objective != nil && objective.Duration > 0 {
+		limit = objective.Duration
+	}
+	if limit > 0 && wait > limit {
+		return limit
+	}
+	return wait
+}
+
+// precisionObjective returns the precision requirement declared by the given request header value, falling back to the
+// given InferenceObjective's requirement. Header values are case-insensitive.
+func precisionObjective(objective *v1alpha2.PrecisionRequirement, headerValue string) fwksched.PrecisionRequirement {
+	for _, precision := range []fwksched.PrecisionRequirement{fwksched.PrecisionFull, fwksched.PrecisionPreferQuantized} {
+		if strings.EqualFold(headerValue, string(precision)) {
+			return precision
+		}
+	}
+	if objective != nil {
+		return fwksched.PrecisionRequirement(*objective)
+	}
+	return ""
+}
+
 // HandleRequest orchestrates the request lifecycle.
 // It always returns the requestContext even in the error case, as the request context is used in error handling.
 func (d *Director) HandleRequest(ctx context.Context, reqCtx *handlers.RequestContext, inferenceRequestBody *fwkrh.InferenceRequestBody) (*handlers.RequestContext, error) {
@@ -154,6 +313,9 @@ func (d *Director) HandleRequest(ctx context.Context, reqCtx *handlers.RequestCo
 	defer span.End()
 
 	logger := log.FromContext(ctx)
+	if d.arrivalRecorder != nil {
+		d.arrivalRecorder.RecordArrival()
+	}
 
 	err := d.modelRewriteIfNeeded(reqCtx, inferenceRequestBody)
 	if err != nil {
@@ -162,7 +324,16 @@ func (d *Director) HandleRequest(ctx context.Context, reqCtx *handlers.RequestCo
 
 	infObjective := d.getInferenceObjective(ctx, reqCtx)
 	reqCtx.Priority = *infObjective.Spec.Priority
-	requestObjectives := fwksched.RequestObjectives{Priority: *infObjective.Spec.Priority}
+	reqCtx.MaxQueueWait = maxQueueWait(infObjective.Spec.MaxQueueWait, d.defaultMaxQueueWait,
+		reqCtx.Request.Headers[reqcommon.MaxQueueWaitHeaderKey])
+	requestObjectives := fwksched.RequestObjectives{
+		Priority:  *infObjective.Spec.Priority,
+		TTFT:      latencyObjective(infObjective.Spec.TTFTObjective, reqCtx.Request.Headers[reqcommon.TTFTObjectiveHeaderKey]),
+		TPOT:      latencyObjective(infObjective.Spec.TPOTObjective, reqCtx.Request.Headers[reqcommon.TPOTObjectiveHeaderKey]),
+		Precision: precisionObjective(infObjective.Spec.Precision, reqCtx.Request.Headers[reqcommon.PrecisionHeaderKey]),
+		Idempotent: d.idempotency.Idempotent(infObjective.Spec.Idempotent,
+			reqCtx.Request.Headers[reqcommon.IdempotentHeaderKey]),
+	}
 
 	span.SetAttributes(
 		attribute.String("target_model", reqCtx.TargetModelName),
@@ -177,13 +348,43 @@ func (d *Director) HandleRequest(ctx context.Context, reqCtx *handlers.RequestCo
 		Headers:          reqCtx.Request.Headers,
 		Objectives:       requestObjectives,
 		RequestSizeBytes: reqCtx.RequestSize,
+		Hints:            d.schedulingHints.Parse(reqCtx.Request.Headers),
+		FairnessID:       reqCtx.FairnessID,
 	}
 
 	logger = logger.WithValues("objectiveKey", reqCtx.ObjectiveKey, "incomingModelName", reqCtx.IncomingModelName, "targetModelName", reqCtx.TargetModelName, "priority", infObjective.Spec.Priority)
 	ctx = log.IntoContext(ctx, logger)
 	logger.V(logutil.DEBUG).Info("LLM request assembled")
 
-	if err := d.admissionController.Admit(ctx, reqCtx, *infObjective.Spec.Priority); err != nil {
+	if d.spiller != nil {
+		if peer, ok := d.spiller.Spill(ctx, reqCtx.Request.Headers); ok {
+			// The original request is forwarded, so that the peer applies its own model rewrites.
+			reqCtx.Spilled = true
+			reqCtx.TargetEndpoint = peer
+			reqCtx.TargetModelName = reqCtx.IncomingModelName
+			logger.V(logutil.VERBOSE).Info("Request spilled over", "peer", peer)
+			return reqCtx, nil
+		}
+	}
+
+	if d.poolFallback != nil && infObjective.Spec.SaturationFallback != nil && *infObjective.Spec.SaturationFallback {
+		address, pool, ok := d.poolFallback.Route(ctx, reqCtx.Request.Headers, d.endpointCandidates.Locate(ctx, reqCtx.Request.Metadata))
+		if ok {
+			// As for the spillover, the original request is forwarded, so that the secondary pool applies its own model
+			// rewrites, e.g. to a smaller model.
+			reqCtx.Spilled = true
+			reqCtx.DowngradedTo = pool
+			reqCtx.TargetEndpoint = address
+			reqCtx.TargetModelName = reqCtx.IncomingModelName
+			logger.V(logutil.VERBOSE).Info("Request downgraded to the secondary pool", "secondaryPool", pool, "address", address)
+			return reqCtx, nil
+		}
+	}
+
+	admissionStart := time.Now()
+	err = d.admissionController.Admit(ctx, reqCtx, *infObjective.Spec.Priority)
+	reqCtx.QueueWait = time.Since(admissionStart)
+	if err != nil {
 		return reqCtx, err
 	}
 
@@ -194,6 +395,13 @@ func (d *Director) HandleRequest(ctx context.Context, reqCtx *handlers.RequestCo
 			Msg:  "failed to find endpoint candidates for serving the request",
 		}
 	}
+	endpointCandidates = applyEndpointHints(reqCtx.SchedulingRequest.Hints, endpointCandidates)
+	if len(endpointCandidates) == 0 {
+		return reqCtx, errcommon.Error{
+			Code: errcommon.ServiceUnavailable,
+			Msg:  "no endpoint candidate left by the pin-endpoint and exclude-endpoints hints of the request",
+		}
+	}
 
 	snapshotOfCandidatePods := d.toSchedulerEndpoints(endpointCandidates)
 	// Prepare per request data by running PrepareData plugins.
@@ -204,8 +412,8 @@ func (d *Director) HandleRequest(ctx context.Context, reqCtx *handlers.RequestCo
 	}
 
 	// Run admit request plugins
-	if !d.runAdmissionPlugins(ctx, reqCtx.SchedulingRequest, snapshotOfCandidatePods) {
-		return reqCtx, errcommon.Error{Code: errcommon.Internal, Msg: "request cannot be admitted"}
+	if err := d.runAdmissionPlugins(ctx, reqCtx.SchedulingRequest, snapshotOfCandidatePods); err != nil {
+		return reqCtx, err
 	}
 
 	result, err := d.scheduler.Schedule(ctx, reqCtx.SchedulingRequest, snapshotOfCandidatePods)
@@ -214,6 +422,9 @@ func (d *Director) HandleRequest(ctx context.Context, reqCtx *handlers.RequestCo
 	}
 
 	reqCtx.SchedulingRequest.SchedulingResult = result
+	if d.decisionRecorder != nil {
+		d.decisionRecorder.RecordDecision(reqCtx.SchedulingRequest, snapshotOfCandidatePods, result)
+	}
 
 	// Prepare Request (Populates RequestContext and call PreRequest plugins)
 	// Insert target endpoint to instruct Envoy to route requests to the specified target pod and attach the port number.
@@ -235,6 +446,12 @@ func (d *Director) modelRewriteIfNeeded(reqCtx *handlers.RequestContext, inferen
 		if err != nil {
 			return err
 		}
+	} else if inferenceRequestBody.Model != "" {
+		// The model name extracted from other payloads routes the request, but cannot be rewritten.
+		reqCtx.IncomingModelName = inferenceRequestBody.Model
+		if reqCtx.TargetModelName == "" {
+			reqCtx.TargetModelName = reqCtx.IncomingModelName
+		}
 	}
 	return nil
 }
@@ -311,12 +528,15 @@ func (d *Director) selectWeightedModel(models []v1alpha2.TargetModel) string {
 }
 
 // prepareRequest populates the RequestContext and calls the registered PreRequest plugins
-// for allowing plugging customized logic based on the scheduling result.
+// for allowing plugging customized logic based on the scheduling result, then the RequestMutator
+// plugins.
 func (d *Director) prepareRequest(ctx context.Context, reqCtx *handlers.RequestContext, result *fwksched.SchedulingResult) (*handlers.RequestContext, error) {
 	logger := log.FromContext(ctx)
 	if result == nil || len(result.ProfileResults) == 0 {
 		return reqCtx, errcommon.Error{Code: errcommon.Internal, Msg: "results must be greater than zero"}
 	}
+	d.idempotency.Restrict(ctx, reqCtx.SchedulingRequest, result)
+
 	// primary profile is used to set destination
 	targetMetadatas := []*fwkdl.EndpointMetadata{}
 	targetEndpoints := []string{}
@@ -332,9 +552,13 @@ func (d *Director) prepareRequest(ctx context.Context, reqCtx *handlers.RequestC
 	logger.V(logutil.VERBOSE).Info("Request handled", "objectiveKey", reqCtx.ObjectiveKey, "incomingModelName", reqCtx.IncomingModelName, "targetModel", reqCtx.TargetModelName, "endpoint", multiEndpointString)
 
 	reqCtx.TargetPod = targetMetadatas[0]
+	reqCtx.TargetPods = targetMetadatas
 	reqCtx.TargetEndpoint = multiEndpointString
 
 	d.runPreRequestPlugins(ctx, reqCtx.SchedulingRequest, result)
+	if err := d.runRequestMutators(ctx, reqCtx); err != nil {
+		return reqCtx, err
+	}
 
 	return reqCtx, nil
 }
@@ -350,6 +574,13 @@ func (d *Director) toSchedulerEndpoints(endpoints []fwkdl.Endpoint) []fwksched.E
 
 // HandleResponseHeader is called when the response headers are received.
 func (d *Director) HandleResponseHeader(ctx context.Context, reqCtx *handlers.RequestContext) *handlers.RequestContext {
+	if reqCtx.DowngradedTo != "" {
+		reqCtx.Response.Headers[reqcommon.DowngradedToHeaderKey] = reqCtx.DowngradedTo
+	}
+	if reqCtx.Spilled {
+		return reqCtx
+	}
+	d.attributeServedEndpoint(ctx, reqCtx)
 	if len(d.requestControlPlugins.responseReceivedPlugins) == 0 {
 		return reqCtx
 	}
@@ -358,12 +589,40 @@ func (d *Director) HandleResponseHeader(ctx context.Context, reqCtx *handlers.Re
 		Headers:     reqCtx.Response.Headers,
 		ReqMetadata: reqCtx.Request.Metadata,
 	}
-	// TODO: to extend fallback functionality, handle cases where target pod is unavailable
-	// https://github.com/kubernetes-sigs/gateway-api-inference-extension/issues/1224
 	d.runResponseHeaderPlugins(ctx, reqCtx.SchedulingRequest, response, reqCtx.TargetPod)
 	return reqCtx
 }
 
+// attributeServedEndpoint makes the endpoint which served the request the target pod of the request. When the proxy
+// fails to connect to the picked endpoint, or the connection is reset before the response, it retries the request on
+// the fallback destinations without the client re-sending it, and reports the endpoint that served it in the response
+// metadata. The response plugins then observe the endpoint that served the response.
+func (d *Director) attributeServedEndpoint(ctx context.Context, reqCtx *handlers.RequestContext) {
+	if len(reqCtx.TargetPods) <= 1 {
+		return
+	}
+	lbMetadata, ok := reqCtx.Request.Metadata[metadata.DestinationEndpointNamespace].(map[string]any)
+	if !ok {
+		return
+	}
+	served, ok := lbMetadata[metadata.DestinationEndpointServedKey].(string)
+	if !ok || served == "" {
+		return
+	}
+	for i, pod := range reqCtx.TargetPods {
+		if net.JoinHostPort(pod.GetIPAddress(), pod.GetPort()) != served {
+			continue
+		}
+		if i > 0 {
+			log.FromContext(ctx).V(logutil.DEBUG).Info("Request served by a fallback destination",
+				"picked", reqCtx.TargetPod.NamespacedName, "served", pod.NamespacedName)
+			metrics.RecordRequestFallback(reqCtx.IncomingModelName, reqCtx.TargetModelName)
+		}
+		reqCtx.TargetPod = pod
+		return
+	}
+}
+
 // HandleResponseBody is invoked by the director for every chunk received in a streaming
 // response, or exactly once for a non-streaming response.
 //
@@ -375,6 +634,21 @@ func (d *Director) HandleResponseHeader(ctx context.Context, reqCtx *handlers.Re
 func (d *Director) HandleResponseBody(ctx context.Context, reqCtx *handlers.RequestContext, endOfStream bool) *handlers.RequestContext {
 	logger := log.FromContext(ctx).WithValues("stage", "bodyChunk")
 	logger.V(logutil.TRACE).Info("Entering HandleResponseBodyChunk")
+	if reqCtx.Spilled {
+		// The response comes from the peer pool, whose endpoints are unknown to the plugins.
+		return reqCtx
+	}
+	if endOfStream && d.decisionRecorder != nil {
+		d.recordOutcome(reqCtx)
+	}
+	if endOfStream && (reqCtx.Usage.PromptTokens > 0 || reqCtx.Usage.CompletionTokens > 0) {
+		if recorder, ok := d.admissionController.(UsageRecorder); ok {
+			recorder.RecordUsage(ctx, reqCtx)
+		}
+	}
+	if endOfStream && len(d.requestControlPlugins.postResponsePlugins) > 0 {
+		defer d.runPostResponsePlugins(ctx, reqCtx)
+	}
 	if len(d.requestControlPlugins.responseStreamingPlugins) == 0 {
 		logger.V(logutil.TRACE).Info("Exiting HandleResponseBodyChunk")
 		return reqCtx
@@ -387,6 +661,8 @@ func (d *Director) HandleResponseBody(ctx context.Context, reqCtx *handlers.Requ
 		Headers:       reqCtx.Response.Headers,
 		StartOfStream: startOfStream,
 		EndOfStream:   endOfStream,
+		Abandoned:     reqCtx.Abandoned,
+		Evicted:       reqCtx.RequestState == handlers.RequestEvicted,
 		Usage:         reqCtx.Usage,
 	}
 	requestId := reqCtx.Request.Headers[reqcommon.RequestIdHeaderKey]
@@ -442,32 +718,122 @@ func (d *Director) runPreRequestPlugins(ctx context.Context, request *fwksched.I
 	for _, plugin := range d.requestControlPlugins.preRequestPlugins {
 		loggerDebug.Info("Running PreRequest plugin", "plugin", plugin.TypedName())
 		before := time.Now()
-		plugin.PreRequest(ctx, request, schedulingResult)
+		if err := d.runPlugin(ctx, fwk.PreRequestExtensionPoint, plugin.TypedName(), func() error {
+			plugin.PreRequest(ctx, request, schedulingResult)
+			return nil
+		}); err != nil {
+			continue
+		}
 		metrics.RecordPluginProcessingLatency(fwk.PreRequestExtensionPoint, plugin.TypedName().Type, plugin.TypedName().Name, time.Since(before))
 		loggerDebug.Info("Completed running PreRequest plugin successfully", "plugin", plugin.TypedName())
 	}
 }
 
+// runRequestMutators runs the RequestMutator plugins in order and applies their header mutations to the request.
+// It returns the error to reply with if any of them failed. Errors that are inference errors are returned as is, so
+// plugins can choose the error code; other errors are not exposed to the client.
+func (d *Director) runRequestMutators(ctx context.Context, reqCtx *handlers.RequestContext) error {
+	if len(d.requestControlPlugins.requestMutators) == 0 {
+		return nil
+	}
+	loggerDebug := log.FromContext(ctx).V(logutil.DEBUG)
+	mutation := &fwk.RequestMutation{TargetEndpoint: reqCtx.TargetPod, SetHeaders: map[string]string{}}
+	for _, plugin := range d.requestControlPlugins.requestMutators {
+		loggerDebug.Info("Running RequestMutator plugin", "plugin", plugin.TypedName())
+		before := time.Now()
+		// Inference errors are the replies the plugins chose for the request, and do not count as failures of the
+		// plugin; a mutator that panicked or is quarantined is skipped.
+		var err error
+		if runErr := d.runPlugin(ctx, fwk.RequestMutationExtensionPoint, plugin.TypedName(), func() error {
+			err = plugin.MutateRequest(ctx, reqCtx.SchedulingRequest, mutation)
+			var inferenceErr errcommon.Error
+			if errors.As(err, &inferenceErr) {
+				return nil
+			}
+			return err
+		}); runErr != nil && err == nil {
+			continue
+		}
+		metrics.RecordPluginProcessingLatency(fwk.RequestMutationExtensionPoint, plugin.TypedName().Type, plugin.TypedName().Name, time.Since(before))
+		if err != nil {
+			loggerDebug.Info("RequestMutator plugin failed", "plugin", plugin.TypedName(), "error", err.Error())
+			var inferenceErr errcommon.Error
+			if errors.As(err, &inferenceErr) {
+				// Other errors are recorded by runPlugin.
+				metrics.RecordPluginError("", fwk.RequestMutationExtensionPoint, plugin.TypedName().Type, plugin.TypedName().Name)
+				return inferenceErr
+			}
+			return errcommon.Error{Code: errcommon.Internal, Msg: "failed to mutate the request"}
+		}
+		loggerDebug.Info("Completed running RequestMutator plugin successfully", "plugin", plugin.TypedName())
+	}
+
+	// A header removed, then set again by a later plugin, is set.
+	for _, key := range mutation.RemoveHeaders {
+		for existing := range reqCtx.Request.Headers {
+			if strings.EqualFold(existing, key) {
+				delete(reqCtx.Request.Headers, existing)
+			}
+		}
+		if !containsHeader(mutation.SetHeaders, key) {
+			reqCtx.Request.RemovedHeaders = append(reqCtx.Request.RemovedHeaders, key)
+		}
+	}
+	for key, value := range mutation.SetHeaders {
+		if requtil.IsSystemOwnedHeader(key) {
+			loggerDebug.Info("Ignoring the mutation of a system-owned header", "header", key)
+			continue
+		}
+		reqCtx.Request.Headers[key] = value
+	}
+	return nil
+}
+
+// containsHeader returns whether the given headers contain the given key, case-insensitively.
+func containsHeader(headers map[string]string, key string) bool {
+	for existing := range headers {
+		if strings.EqualFold(existing, key) {
+			return true
+		}
+	}
+	return false
+}
+
 func (d *Director) runPrepareDataPlugins(ctx context.Context,
 	request *fwksched.InferenceRequest, endpoints []fwksched.Endpoint) error {
 	if len(d.requestControlPlugins.prepareDataPlugins) == 0 {
 		return nil
 	}
-	return prepareDataPluginsWithTimeout(prepareDataTimeout, d.requestControlPlugins.prepareDataPlugins, ctx, request, endpoints)
+	return prepareDataPluginsWithTimeout(prepareDataTimeout, d.requestControlPlugins.prepareDataPlugins, d.pluginBreaker, ctx, request, endpoints)
 }
 
+// runAdmissionPlugins returns the error to reply with if any of the AdmitRequest plugins denied the request.
+// Denial reasons that are inference errors are returned as is, so plugins can choose the error code; other denial
+// reasons are not exposed to the client.
 func (d *Director) runAdmissionPlugins(ctx context.Context,
-	request *fwksched.InferenceRequest, endpoints []fwksched.Endpoint) bool {
+	request *fwksched.InferenceRequest, endpoints []fwksched.Endpoint) error {
 	loggerDebug := log.FromContext(ctx).V(logutil.DEBUG)
 	for _, plugin := range d.requestControlPlugins.admissionPlugins {
 		loggerDebug.Info("Running AdmitRequest plugin", "plugin", plugin.TypedName())
-		if denyReason := plugin.AdmitRequest(ctx, request, endpoints); denyReason != nil {
+		// An admission plugin that panicked or is quarantined admits the request.
+		var denyReason error
+		if err := d.runPlugin(ctx, fwk.AdmitRequestExtensionPoint, plugin.TypedName(), func() error {
+			denyReason = plugin.AdmitRequest(ctx, request, endpoints)
+			return nil
+		}); err != nil {
+			continue
+		}
+		if denyReason != nil {
 			loggerDebug.Info("AdmitRequest plugin denied the request", "plugin", plugin.TypedName(), "reason", denyReason.Error())
-			return false
+			var inferenceErr errcommon.Error
+			if errors.As(denyReason, &inferenceErr) {
+				return inferenceErr
+			}
+			return errcommon.Error{Code: errcommon.Internal, Msg: "request cannot be admitted"}
 		}
 		loggerDebug.Info("Completed running AdmitRequest plugin successfully", "plugin", plugin.TypedName())
 	}
-	return true
+	return nil
 }
 
 func (d *Director) runResponseHeaderPlugins(ctx context.Context, request *fwksched.InferenceRequest, response *fwk.Response, targetEndpoint *fwkdl.EndpointMetadata) {
@@ -475,7 +841,12 @@ func (d *Director) runResponseHeaderPlugins(ctx context.Context, request *fwksch
 	for _, plugin := range d.requestControlPlugins.responseReceivedPlugins {
 		loggerDebug.Info("Running ResponseReceived plugin", "plugin", plugin.TypedName())
 		before := time.Now()
-		plugin.ResponseHeader(ctx, request, response, targetEndpoint)
+		if err := d.runPlugin(ctx, fwk.ResponseReceivedExtensionPoint, plugin.TypedName(), func() error {
+			plugin.ResponseHeader(ctx, request, response, targetEndpoint)
+			return nil
+		}); err != nil {
+			continue
+		}
 		metrics.RecordPluginProcessingLatency(fwk.ResponseReceivedExtensionPoint, plugin.TypedName().Type, plugin.TypedName().Name, time.Since(before))
 		loggerDebug.Info("Completed running ResponseReceived plugin successfully", "plugin", plugin.TypedName())
 	}
@@ -486,12 +857,60 @@ func (d *Director) runResponseBodyPlugins(ctx context.Context, request *fwksched
 	for _, plugin := range d.requestControlPlugins.responseStreamingPlugins {
 		loggerTrace.Info("Running ResponseStreaming plugin", "plugin", plugin.TypedName())
 		before := time.Now()
-		plugin.ResponseBody(ctx, request, response, targetEndpoint)
+		if err := d.runPlugin(ctx, fwk.ResponseStreamingExtensionPoint, plugin.TypedName(), func() error {
+			plugin.ResponseBody(ctx, request, response, targetEndpoint)
+			return nil
+		}); err != nil {
+			continue
+		}
 		metrics.RecordPluginProcessingLatency(fwk.ResponseStreamingExtensionPoint, plugin.TypedName().Type, plugin.TypedName().Name, time.Since(before))
 		loggerTrace.Info("Completed running ResponseStreaming plugin successfully", "plugin", plugin.TypedName())
 	}
 }
 
+// recordOutcome records the outcome of the request in the decision recorder.
+func (d *Director) recordOutcome(reqCtx *handlers.RequestContext) {
+	// Responses that did not complete, e.g. abandoned or evicted, are failed.
+	failed := !reqCtx.ResponseComplete || reqCtx.ResponseStatusCode == errcommon.ModelServerError
+	d.decisionRecorder.RecordOutcome(reqCtx.Request.Headers[reqcommon.RequestIdHeaderKey],
+		reqCtx.ResponseCompleteTimestamp.Sub(reqCtx.RequestReceivedTimestamp), failed)
+}
+
+// runPostResponsePlugins runs the PostResponse plugins with the outcome of the completed response.
+func (d *Director) runPostResponsePlugins(ctx context.Context, reqCtx *handlers.RequestContext) {
+	completedAt := reqCtx.ResponseCompleteTimestamp
+	if completedAt.IsZero() {
+		completedAt = time.Now()
+	}
+	response := &fwk.CompletedResponse{
+		RequestId: reqCtx.Request.Headers[reqcommon.RequestIdHeaderKey],
+		Headers:   reqCtx.Response.Headers,
+		Trailers:  reqCtx.Response.Trailers,
+		Usage:     reqCtx.Usage,
+		Failed:    reqCtx.ResponseStatusCode == errcommon.ModelServerError,
+		Abandoned: reqCtx.Abandoned,
+		Latency:   completedAt.Sub(reqCtx.RequestReceivedTimestamp),
+	}
+	response.InterTokenLatency = reqCtx.InterTokenLatency()
+	if !reqCtx.FirstResponseChunkTimestamp.IsZero() {
+		response.TTFT = reqCtx.FirstResponseChunkTimestamp.Sub(reqCtx.RequestReceivedTimestamp)
+	}
+
+	loggerDebug := log.FromContext(ctx).V(logutil.DEBUG)
+	for _, plugin := range d.requestControlPlugins.postResponsePlugins {
+		loggerDebug.Info("Running PostResponse plugin", "plugin", plugin.TypedName())
+		before := time.Now()
+		if err := d.runPlugin(ctx, fwk.PostResponseExtensionPoint, plugin.TypedName(), func() error {
+			plugin.PostResponse(ctx, reqCtx.SchedulingRequest, response, reqCtx.TargetPod)
+			return nil
+		}); err != nil {
+			continue
+		}
+		metrics.RecordPluginProcessingLatency(fwk.PostResponseExtensionPoint, plugin.TypedName().Type, plugin.TypedName().Name, time.Since(before))
+		loggerDebug.Info("Completed running PostResponse plugin successfully", "plugin", plugin.TypedName())
+	}
+}
+
 // processResponseBodyQueue reads work items from the queue channel and runs response body
 // plugins for each one sequentially. It exits when the channel is closed and signals
 // completion by closing q.done.
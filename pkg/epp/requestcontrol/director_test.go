@@ -53,6 +53,8 @@ import (
 	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requesthandling/parsers/openai"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/handlers"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metadata"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/pluginquarantine"
 	poolutil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/pool"
 	testutil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/testing"
 )
@@ -558,6 +560,24 @@ func TestDirector_HandleRequest(t *testing.T) {
 			admitRequestDenialError: errors.New("denied by admit plugin"),
 			wantErrCode:             errcommon.Internal,
 		},
+		{
+			name: "denied request by admit request plugin with inference error",
+			reqBodyMap: map[string]any{
+				"model":  model,
+				"prompt": "critical prompt",
+			},
+			mockAdmissionController: &mockAdmissionController{admitErr: nil},
+			schedulerMockSetup: func(m *mockScheduler) {
+				m.scheduleResults = defaultSuccessfulScheduleResults
+			},
+			wantMutatedBody: map[string]any{
+				"model":  model,
+				"prompt": "critical prompt",
+			},
+			targetModelName:         model,
+			admitRequestDenialError: fmt.Errorf("denied: %w", errcommon.Error{Code: errcommon.BadRequest, Msg: "prompt too long"}),
+			wantErrCode:             errcommon.BadRequest,
+		},
 		{
 			name: "successful chat completions request with multiple messages",
 			reqBodyMap: map[string]any{
@@ -835,6 +855,69 @@ func TestDirector_HandleRequest(t *testing.T) {
 	}
 }
 
+func TestLatencyObjective(t *testing.T) {
+	tests := []struct {
+		name        string
+		objective   *metav1.Duration
+		headerValue string
+		want        time.Duration
+	}{
+		{name: "none declared", want: 0},
+		{name: "from objective", objective: &metav1.Duration{Duration: time.Second}, want: time.Second},
+		{name: "header overrides objective", objective: &metav1.Duration{Duration: time.Second}, headerValue: "250.5", want: 250500 * time.Microsecond},
+		{name: "invalid header falls back to objective", objective: &metav1.Duration{Duration: time.Second}, headerValue: "fast", want: time.Second},
+		{name: "non-positive header ignored", headerValue: "-1", want: 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, latencyObjective(test.objective, test.headerValue))
+		})
+	}
+}
+
+func TestMaxQueueWait(t *testing.T) {
+	tests := []struct {
+		name        string
+		objective   *metav1.Duration
+		defaultWait time.Duration
+		headerValue string
+		want        time.Duration
+	}{
+		{name: "none declared", want: 0},
+		{name: "from objective", objective: &metav1.Duration{Duration: time.Second}, want: time.Second},
+		{name: "header shortens objective", objective: &metav1.Duration{Duration: time.Second}, headerValue: "500", want: 500 * time.Millisecond},
+		{name: "header capped at objective", objective: &metav1.Duration{Duration: time.Second}, defaultWait: time.Minute, headerValue: "5000", want: time.Second},
+		{name: "header capped at default", defaultWait: time.Minute, headerValue: "3600000", want: time.Minute},
+		{name: "header within default", defaultWait: time.Minute, headerValue: "500", want: 500 * time.Millisecond},
+		{name: "header unbounded without default", headerValue: "3600000", want: time.Hour},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, maxQueueWait(test.objective, test.defaultWait, test.headerValue))
+		})
+	}
+}
+
+func TestPrecisionObjective(t *testing.T) {
+	full := v1alpha2.PrecisionFull
+	tests := []struct {
+		name        string
+		objective   *v1alpha2.PrecisionRequirement
+		headerValue string
+		want        fwksched.PrecisionRequirement
+	}{
+		{name: "none declared", want: ""},
+		{name: "from objective", objective: &full, want: fwksched.PrecisionFull},
+		{name: "header overrides objective", objective: &full, headerValue: "preferquantized", want: fwksched.PrecisionPreferQuantized},
+		{name: "invalid header falls back to objective", objective: &full, headerValue: "int4", want: fwksched.PrecisionFull},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, precisionObjective(test.objective, test.headerValue))
+		})
+	}
+}
+
 func TestGetRandomEndpoint(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -1090,6 +1173,16 @@ func TestDirector_ApplyWeightedModelRewrite(t *testing.T) {
 	}
 }
 
+func TestDirector_ExtractedModelName(t *testing.T) {
+	d := &Director{}
+	reqCtx := &handlers.RequestContext{}
+	body := &fwkrh.InferenceRequestBody{Model: "densenet", Payload: fwkrh.RawPayload("frame")}
+
+	require.NoError(t, d.modelRewriteIfNeeded(reqCtx, body))
+	assert.Equal(t, "densenet", reqCtx.IncomingModelName)
+	assert.Equal(t, "densenet", reqCtx.TargetModelName, "the model of payloads that are not maps is not rewritten")
+}
+
 func TestDirector_SelectWeightedModel(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -1212,6 +1305,47 @@ func TestDirector_HandleResponseReceived(t *testing.T) {
 	}
 }
 
+func TestDirector_HandleResponseHeaderServedByFallback(t *testing.T) {
+	picked := &fwkdl.EndpointMetadata{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "picked"}, Address: "10.0.0.1", Port: "8000"}
+	fallback := &fwkdl.EndpointMetadata{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "fallback"}, Address: "10.0.0.2", Port: "8000"}
+	servedMetadata := func(served string) map[string]any {
+		return map[string]any{metadata.DestinationEndpointNamespace: map[string]any{metadata.DestinationEndpointServedKey: served}}
+	}
+
+	tests := []struct {
+		name     string
+		metadata map[string]any
+		wantPod  string
+	}{
+		{name: "served by the picked endpoint", metadata: servedMetadata("10.0.0.1:8000"), wantPod: "ns/picked"},
+		{name: "served by a fallback endpoint", metadata: servedMetadata("10.0.0.2:8000"), wantPod: "ns/fallback"},
+		{name: "served by an unknown endpoint", metadata: servedMetadata("10.0.0.3:8000"), wantPod: "ns/picked"},
+		{name: "no served endpoint reported", wantPod: "ns/picked"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pr1 := newTestResponseReceived("pr1")
+			ctx := logutil.NewTestLoggerIntoContext(context.Background())
+			ds := datastore.NewDatastore(t.Context(), nil, 0)
+			endpointCandidates := NewCachedEndpointCandidates(context.Background(), NewDatastoreEndpointCandidates(ds), time.Minute)
+			director := NewDirectorWithConfig(ds, &mockScheduler{}, &mockAdmissionController{}, endpointCandidates,
+				NewConfig().WithResponseReceivedPlugins(pr1))
+
+			reqCtx := &handlers.RequestContext{
+				Request:        &handlers.Request{Headers: map[string]string{}, Metadata: test.metadata},
+				Response:       &handlers.Response{Headers: map[string]string{}},
+				TargetPod:      picked,
+				TargetPods:     []*fwkdl.EndpointMetadata{picked, fallback},
+				TargetEndpoint: "10.0.0.1:8000,10.0.0.2:8000",
+			}
+			director.HandleResponseHeader(ctx, reqCtx)
+
+			assert.Equal(t, test.wantPod, reqCtx.TargetPod.NamespacedName.String())
+			assert.Equal(t, test.wantPod, pr1.lastTargetPodOnResponse)
+		})
+	}
+}
+
 func TestDirector_HandleResponseBody(t *testing.T) {
 	ps1 := newTestResponseStreaming("ps1")
 
@@ -1267,6 +1401,246 @@ func TestDirector_HandleResponseBody(t *testing.T) {
 	}
 }
 
+func TestDirector_HandlePostResponse(t *testing.T) {
+	plugin := &testPostResponse{typedName: fwkplugin.TypedName{Type: "test-post-response", Name: "pr"}}
+
+	ctx := logutil.NewTestLoggerIntoContext(context.Background())
+	ds := datastore.NewDatastore(t.Context(), nil, 0)
+	director := NewDirectorWithConfig(ds, &mockScheduler{}, nil, nil, NewConfig().WithPostResponsePlugins(plugin))
+
+	received := time.Now()
+	reqCtx := &handlers.RequestContext{
+		Request: &handlers.Request{
+			Headers: map[string]string{reqcommon.RequestIdHeaderKey: "test-req-id"},
+		},
+		Response:                    &handlers.Response{Headers: map[string]string{"status": "500"}},
+		TargetPod:                   &fwkdl.EndpointMetadata{NamespacedName: types.NamespacedName{Namespace: "namespace1", Name: "test-pod-name"}},
+		RequestReceivedTimestamp:    received,
+		FirstResponseChunkTimestamp: received.Add(100 * time.Millisecond),
+		ResponseCompleteTimestamp:   received.Add(time.Second),
+		ResponseStatusCode:          errcommon.ModelServerError,
+		Usage:                       fwkrh.Usage{PromptTokens: 10, CompletionTokens: 20},
+	}
+
+	director.HandleResponseBody(ctx, reqCtx, false)
+	assert.Empty(t, plugin.responses, "PostResponse plugins should not run before the end of the stream")
+
+	director.HandleResponseBody(ctx, reqCtx, true)
+	require.Len(t, plugin.responses, 1)
+	response := plugin.responses[0]
+	assert.Equal(t, "test-req-id", response.RequestId)
+	assert.True(t, response.Failed)
+	assert.False(t, response.Abandoned)
+	assert.Equal(t, 100*time.Millisecond, response.TTFT)
+	assert.Equal(t, time.Second, response.Latency)
+	assert.Equal(t, 20, response.Usage.CompletionTokens)
+	assert.Equal(t, "namespace1/test-pod-name", plugin.targetPod)
+}
+
+type fakeSpiller struct {
+	peer string
+}
+
+func (s *fakeSpiller) Spill(context.Context, map[string]string) (string, bool) {
+	return s.peer, s.peer != ""
+}
+
+func TestDirector_SpilledRequest(t *testing.T) {
+	ps1 := newTestResponseStreaming("ps1")
+	ctx := logutil.NewTestLoggerIntoContext(context.Background())
+	ds := datastore.NewDatastore(t.Context(), nil, 0)
+	// Neither admission nor scheduling are run for the spilled requests.
+	admission := &mockAdmissionController{admitErr: errcommon.Error{Code: errcommon.ResourceExhausted, Msg: "queues full"}}
+	mockSched := &mockScheduler{scheduleErr: errors.New("unexpected scheduling")}
+	director := NewDirectorWithConfig(ds, mockSched, admission, nil, NewConfig().WithResponseStreamingPlugins(ps1)).
+		WithSpiller(&fakeSpiller{peer: "peer-gateway:80"})
+
+	reqCtx := &handlers.RequestContext{
+		Request: &handlers.Request{
+			Headers: map[string]string{reqcommon.RequestIdHeaderKey: "test-req-id", ":path": "/v1/completions"},
+		},
+		Response: &handlers.Response{Headers: map[string]string{}},
+	}
+	var err error
+	reqCtx.Request.RawBody, err = json.Marshal(map[string]any{"model": "food-review", "prompt": "critic"})
+	require.NoError(t, err)
+	inferenceRequestBody, err := openai.NewOpenAIParser().ParseRequest(ctx, reqCtx.Request.RawBody, reqCtx.Request.Headers)
+	require.NoError(t, err)
+
+	reqCtx, err = director.HandleRequest(ctx, reqCtx, inferenceRequestBody)
+	require.NoError(t, err)
+	assert.True(t, reqCtx.Spilled)
+	assert.Equal(t, "peer-gateway:80", reqCtx.TargetEndpoint)
+	assert.Nil(t, reqCtx.TargetPod)
+	assert.Equal(t, "food-review", reqCtx.TargetModelName)
+
+	// The response plugins are not run for the responses of the peer.
+	director.HandleResponseHeader(ctx, reqCtx)
+	director.HandleResponseBody(ctx, reqCtx, true)
+	assert.Empty(t, ps1.respsOnStreaming)
+}
+
+type fakePoolFallback struct {
+	saturated bool
+}
+
+func (f *fakePoolFallback) Route(context.Context, map[string]string, []fwkdl.Endpoint) (string, string, bool) {
+	if !f.saturated {
+		return "", "", false
+	}
+	return "small-pool-gateway:80", "small-pool", true
+}
+
+func TestDirector_PoolFallback(t *testing.T) {
+	optIn := true
+	tests := []struct {
+		name           string
+		objective      string
+		saturated      bool
+		wantDowngraded bool
+	}{
+		{name: "opted in and saturated", objective: "batch", saturated: true, wantDowngraded: true},
+		{name: "opted in and not saturated", objective: "batch"},
+		{name: "not opted in and saturated", objective: "interactive", saturated: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := logutil.NewTestLoggerIntoContext(context.Background())
+			ds := datastore.NewDatastore(t.Context(), nil, 0)
+			ds.ObjectiveSet(&v1alpha2.InferenceObjective{
+				ObjectMeta: metav1.ObjectMeta{Name: "batch"},
+				Spec:       v1alpha2.InferenceObjectiveSpec{SaturationFallback: &optIn},
+			})
+			// The downgraded requests are neither admitted nor scheduled.
+			admission := &mockAdmissionController{admitErr: errcommon.Error{Code: errcommon.ResourceExhausted, Msg: "saturated"}}
+			endpointCandidates := NewCachedEndpointCandidates(context.Background(), NewDatastoreEndpointCandidates(ds), time.Minute)
+			director := NewDirectorWithConfig(ds, &mockScheduler{scheduleErr: errors.New("unexpected scheduling")}, admission,
+				endpointCandidates, NewConfig()).WithPoolFallback(&fakePoolFallback{saturated: test.saturated})
+
+			reqCtx := &handlers.RequestContext{
+				Request: &handlers.Request{
+					Headers: map[string]string{reqcommon.RequestIdHeaderKey: "test-req-id", ":path": "/v1/completions"},
+				},
+				Response:     &handlers.Response{Headers: map[string]string{}},
+				ObjectiveKey: test.objective,
+			}
+			var err error
+			reqCtx.Request.RawBody, err = json.Marshal(map[string]any{"model": "food-review", "prompt": "critic"})
+			require.NoError(t, err)
+			inferenceRequestBody, err := openai.NewOpenAIParser().ParseRequest(ctx, reqCtx.Request.RawBody, reqCtx.Request.Headers)
+			require.NoError(t, err)
+
+			reqCtx, err = director.HandleRequest(ctx, reqCtx, inferenceRequestBody)
+			if !test.wantDowngraded {
+				assert.Error(t, err, "the request not downgraded should reach the admission")
+				assert.False(t, reqCtx.Spilled)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, reqCtx.Spilled)
+			assert.Equal(t, "small-pool-gateway:80", reqCtx.TargetEndpoint)
+			assert.Equal(t, "food-review", reqCtx.TargetModelName)
+
+			director.HandleResponseHeader(ctx, reqCtx)
+			assert.Equal(t, "small-pool", reqCtx.Response.Headers[reqcommon.DowngradedToHeaderKey])
+		})
+	}
+}
+
+func TestDirector_RunRequestMutators(t *testing.T) {
+	var order []string
+	first := &testRequestMutator{typedName: fwkplugin.TypedName{Type: "test-mutator", Name: "b-first"}, order: &order,
+		mutate: func(mutation *fwk.RequestMutation) error {
+			mutation.SetHeaders["x-target-pod"] = mutation.TargetEndpoint.PodName
+			mutation.SetHeaders["x-gateway-destination-endpoint"] = "10.0.0.2:8000"
+			mutation.RemoveHeaders = append(mutation.RemoveHeaders, "x-internal", "x-hint")
+			return nil
+		}}
+	second := &testRequestMutator{typedName: fwkplugin.TypedName{Type: "test-mutator", Name: "a-second"}, order: &order,
+		mutate: func(mutation *fwk.RequestMutation) error {
+			mutation.SetHeaders["x-hint"] = "replaced"
+			return nil
+		}}
+	unordered := &testRequestMutator{typedName: fwkplugin.TypedName{Type: "test-mutator", Name: "c-unordered"}, order: &order,
+		mutate: func(*fwk.RequestMutation) error { return nil }}
+
+	config := NewConfig()
+	config.AddPlugins(unordered, second, first)
+	config.OrderRequestMutators([]string{"b-first", "a-second"})
+
+	ctx := logutil.NewTestLoggerIntoContext(context.Background())
+	ds := datastore.NewDatastore(t.Context(), nil, 0)
+	director := NewDirectorWithConfig(ds, &mockScheduler{}, nil, nil, config)
+
+	reqCtx := &handlers.RequestContext{
+		Request: &handlers.Request{
+			Headers: map[string]string{"X-Internal": "secret", "x-hint": "original", "x-tenant": "team-a"},
+		},
+		TargetPod: &fwkdl.EndpointMetadata{PodName: "pod1"},
+	}
+	reqCtx.SchedulingRequest = &fwksched.InferenceRequest{Headers: reqCtx.Request.Headers}
+
+	require.NoError(t, director.runRequestMutators(ctx, reqCtx))
+	assert.Equal(t, []string{"b-first", "a-second", "c-unordered"}, order)
+	assert.Equal(t, map[string]string{"x-target-pod": "pod1", "x-hint": "replaced", "x-tenant": "team-a"}, reqCtx.Request.Headers,
+		"system-owned headers must not be set")
+	assert.Equal(t, []string{"x-internal"}, reqCtx.Request.RemovedHeaders, "headers set again must not be removed")
+
+	// A failing mutator fails the request, with its error if it is an inference error.
+	failing := &testRequestMutator{typedName: fwkplugin.TypedName{Type: "test-mutator", Name: "failing"}, order: &order,
+		mutate: func(*fwk.RequestMutation) error {
+			return errcommon.Error{Code: errcommon.BadRequest, Msg: "unsupported request"}
+		}}
+	director = NewDirectorWithConfig(ds, &mockScheduler{}, nil, nil, NewConfig().WithRequestMutators(failing))
+	err := director.runRequestMutators(ctx, reqCtx)
+	var inferenceErr errcommon.Error
+	require.ErrorAs(t, err, &inferenceErr)
+	assert.Equal(t, errcommon.BadRequest, inferenceErr.Code)
+}
+
+func TestDirector_IsolatesPanickingPlugins(t *testing.T) {
+	var order []string
+	buggy := &testRequestMutator{typedName: fwkplugin.TypedName{Type: "test-mutator", Name: "buggy"}, order: &order,
+		mutate: func(*fwk.RequestMutation) error { panic("bug") }}
+	rejecting := &testRequestMutator{typedName: fwkplugin.TypedName{Type: "test-mutator", Name: "rejecting"}, order: &order,
+		mutate: func(*fwk.RequestMutation) error { return errcommon.Error{Code: errcommon.BadRequest, Msg: "rejected"} }}
+	healthy := &testRequestMutator{typedName: fwkplugin.TypedName{Type: "test-mutator", Name: "healthy"}, order: &order,
+		mutate: func(mutation *fwk.RequestMutation) error {
+			mutation.SetHeaders["x-healthy"] = "true"
+			return nil
+		}}
+	preRequest := &mockPreRequestPlugin{name: "buggy", modifyFn: func(*fwksched.InferenceRequest) { panic("bug") }}
+
+	ctx := logutil.NewTestLoggerIntoContext(context.Background())
+	ds := datastore.NewDatastore(t.Context(), nil, 0)
+	breaker := pluginquarantine.NewBreaker(1, time.Minute)
+	newReqCtx := func() *handlers.RequestContext {
+		reqCtx := &handlers.RequestContext{Request: &handlers.Request{Headers: map[string]string{}}, TargetPod: &fwkdl.EndpointMetadata{}}
+		reqCtx.SchedulingRequest = &fwksched.InferenceRequest{Headers: reqCtx.Request.Headers}
+		return reqCtx
+	}
+
+	// A panicking plugin is skipped while the rest of the chain runs, and quarantined.
+	config := NewConfig().WithRequestMutators(buggy, healthy).WithPreRequestPlugins(preRequest)
+	director := NewDirectorWithConfig(ds, &mockScheduler{}, nil, nil, config).WithPluginBreaker(breaker)
+	for range 2 {
+		reqCtx := newReqCtx()
+		director.runPreRequestPlugins(ctx, reqCtx.SchedulingRequest, &fwksched.SchedulingResult{})
+		require.NoError(t, director.runRequestMutators(ctx, reqCtx))
+		assert.Equal(t, "true", reqCtx.Request.Headers["x-healthy"])
+	}
+	assert.Equal(t, []string{"buggy", "healthy", "healthy"}, order)
+	assert.Len(t, breaker.List(), 2)
+
+	// Inference errors are replies chosen by the plugins, not failures: the plugin is not quarantined.
+	director = NewDirectorWithConfig(ds, &mockScheduler{}, nil, nil, NewConfig().WithRequestMutators(rejecting)).
+		WithPluginBreaker(breaker)
+	for range 2 {
+		require.Error(t, director.runRequestMutators(ctx, newReqCtx()))
+	}
+	assert.Len(t, breaker.List(), 2)
+}
+
 func TestDirector_HandleResponseBody_ChunkOrdering(t *testing.T) {
 	// orderTrackingPlugin records the RequestId of each chunk it processes.
 	// Since we set a unique RequestId per chunk, the recorded order lets us
@@ -1351,6 +1725,36 @@ const (
 	testPostCompleteType     = "test-response-complete"
 )
 
+type testRequestMutator struct {
+	typedName fwkplugin.TypedName
+	order     *[]string
+	mutate    func(mutation *fwk.RequestMutation) error
+}
+
+func (p *testRequestMutator) TypedName() fwkplugin.TypedName {
+	return p.typedName
+}
+
+func (p *testRequestMutator) MutateRequest(_ context.Context, _ *fwksched.InferenceRequest, mutation *fwk.RequestMutation) error {
+	*p.order = append(*p.order, p.typedName.Name)
+	return p.mutate(mutation)
+}
+
+type testPostResponse struct {
+	typedName fwkplugin.TypedName
+	responses []*fwk.CompletedResponse
+	targetPod string
+}
+
+func (p *testPostResponse) TypedName() fwkplugin.TypedName {
+	return p.typedName
+}
+
+func (p *testPostResponse) PostResponse(_ context.Context, _ *fwksched.InferenceRequest, response *fwk.CompletedResponse, targetPod *fwkdl.EndpointMetadata) {
+	p.responses = append(p.responses, response)
+	p.targetPod = targetPod.NamespacedName.String()
+}
+
 type testResponseReceived struct {
 	mu                      sync.Mutex
 	typedName               fwkplugin.TypedName
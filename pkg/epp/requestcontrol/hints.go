@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestcontrol
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metadata"
+)
+
+// SchedulingHintsPolicy parses the scheduling hints of the requests from their headers, keeping only the hints allowed
+// by the configuration, so that the placement of the requests cannot be influenced by default.
+type SchedulingHintsPolicy struct {
+	allowed sets.Set[string]
+}
+
+// NewSchedulingHintsPolicy returns a policy allowing the scheduling hints of the given names.
+func NewSchedulingHintsPolicy(allowed []string) *SchedulingHintsPolicy {
+	return &SchedulingHintsPolicy{allowed: sets.New(allowed...)}
+}
+
+// Parse returns the allowed scheduling hints declared by the given request headers. A nil policy allows no hint.
+func (p *SchedulingHintsPolicy) Parse(headers map[string]string) fwksched.SchedulingHints {
+	hints := fwksched.SchedulingHints{}
+	if p == nil || p.allowed.Len() == 0 {
+		return hints
+	}
+	if p.allowed.Has(fwksched.HintPinEndpoint) {
+		hints.PinEndpoint = strings.TrimSpace(headers[metadata.PinEndpointKey])
+	}
+	if p.allowed.Has(fwksched.HintExcludeEndpoints) {
+		for ref := range strings.SplitSeq(headers[metadata.ExcludeEndpointsKey], ",") {
+			if ref = strings.TrimSpace(ref); ref != "" {
+				hints.ExcludeEndpoints = append(hints.ExcludeEndpoints, ref)
+			}
+		}
+	}
+	if p.allowed.Has(fwksched.HintForceProfile) {
+		hints.Profile = strings.TrimSpace(headers[metadata.ForceProfileKey])
+	}
+	if p.allowed.Has(fwksched.HintDisableCacheAffinity) {
+		hints.DisableCacheAffinity, _ = strconv.ParseBool(strings.TrimSpace(headers[metadata.DisableCacheAffinityKey]))
+	}
+	return hints
+}
+
+// applyEndpointHints returns the given candidate endpoints the request is pinned to and not excluded from.
+func applyEndpointHints(hints fwksched.SchedulingHints, endpoints []fwkdl.Endpoint) []fwkdl.Endpoint {
+	if hints.PinEndpoint == "" && len(hints.ExcludeEndpoints) == 0 {
+		return endpoints
+	}
+	kept := make([]fwkdl.Endpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		if hints.PinEndpoint != "" && !fwksched.EndpointMatches(hints.PinEndpoint, endpoint.GetMetadata()) {
+			continue
+		}
+		if slices.ContainsFunc(hints.ExcludeEndpoints, func(ref string) bool {
+			return fwksched.EndpointMatches(ref, endpoint.GetMetadata())
+		}) {
+			continue
+		}
+		kept = append(kept, endpoint)
+	}
+	return kept
+}
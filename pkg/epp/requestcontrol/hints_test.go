@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestcontrol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metadata"
+)
+
+func TestSchedulingHintsPolicy_Parse(t *testing.T) {
+	headers := map[string]string{
+		metadata.PinEndpointKey:          "pod1",
+		metadata.ExcludeEndpointsKey:     "pod2, ns/pod3,,10.0.0.4:8000",
+		metadata.ForceProfileKey:         "decode",
+		metadata.DisableCacheAffinityKey: "true",
+	}
+	tests := []struct {
+		name   string
+		policy *SchedulingHintsPolicy
+		want   fwksched.SchedulingHints
+	}{
+		{name: "nil policy", policy: nil},
+		{name: "no hint allowed", policy: NewSchedulingHintsPolicy(nil)},
+		{
+			name:   "some hints allowed",
+			policy: NewSchedulingHintsPolicy([]string{fwksched.HintPinEndpoint, fwksched.HintDisableCacheAffinity}),
+			want:   fwksched.SchedulingHints{PinEndpoint: "pod1", DisableCacheAffinity: true},
+		},
+		{
+			name:   "all hints allowed",
+			policy: NewSchedulingHintsPolicy(fwksched.SchedulingHintNames),
+			want: fwksched.SchedulingHints{
+				PinEndpoint:          "pod1",
+				ExcludeEndpoints:     []string{"pod2", "ns/pod3", "10.0.0.4:8000"},
+				Profile:              "decode",
+				DisableCacheAffinity: true,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, test.policy.Parse(headers))
+		})
+	}
+}
+
+func TestApplyEndpointHints(t *testing.T) {
+	endpoint := func(name, address string) fwkdl.Endpoint {
+		return fwkdl.NewEndpoint(&fwkdl.EndpointMetadata{
+			NamespacedName: k8stypes.NamespacedName{Namespace: "ns", Name: name},
+			Address:        address,
+			Port:           "8000",
+		}, nil)
+	}
+	endpoints := []fwkdl.Endpoint{endpoint("pod1", "10.0.0.1"), endpoint("pod2", "10.0.0.2"), endpoint("pod3", "10.0.0.3")}
+	names := func(endpoints []fwkdl.Endpoint) []string {
+		result := []string{}
+		for _, endpoint := range endpoints {
+			result = append(result, endpoint.GetMetadata().NamespacedName.Name)
+		}
+		return result
+	}
+
+	tests := []struct {
+		name  string
+		hints fwksched.SchedulingHints
+		want  []string
+	}{
+		{name: "no hints", want: []string{"pod1", "pod2", "pod3"}},
+		{name: "pinned by name", hints: fwksched.SchedulingHints{PinEndpoint: "pod2"}, want: []string{"pod2"}},
+		{name: "pinned by address", hints: fwksched.SchedulingHints{PinEndpoint: "10.0.0.3:8000"}, want: []string{"pod3"}},
+		{name: "pinned to an unknown endpoint", hints: fwksched.SchedulingHints{PinEndpoint: "pod4"}, want: []string{}},
+		{
+			name:  "excluded",
+			hints: fwksched.SchedulingHints{ExcludeEndpoints: []string{"ns/pod1", "10.0.0.3:8000"}},
+			want:  []string{"pod2"},
+		},
+		{
+			name:  "pinned and excluded",
+			hints: fwksched.SchedulingHints{PinEndpoint: "pod1", ExcludeEndpoints: []string{"pod1"}},
+			want:  []string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, names(applyEndpointHints(test.hints, endpoints)))
+		})
+	}
+}
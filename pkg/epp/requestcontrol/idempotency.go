@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestcontrol
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+// IdempotencyPolicy decides which requests can be sent to the model servers more than once, and restricts the features
+// duplicating requests to them. It is the single place this is enforced: the scheduling plugins producing several
+// destinations, e.g. fallback destinations retried by the proxy or redundant generations, need not check it.
+type IdempotencyPolicy struct {
+	// defaultIdempotent applies to the requests declaring nothing, through their objective or a header.
+	defaultIdempotent bool
+}
+
+// NewIdempotencyPolicy returns a policy treating the requests declaring nothing as idempotent or not.
+func NewIdempotencyPolicy(defaultIdempotent bool) *IdempotencyPolicy {
+	return &IdempotencyPolicy{defaultIdempotent: defaultIdempotent}
+}
+
+// Idempotent returns whether a request is idempotent, as declared by the given request header value, falling back to
+// the given InferenceObjective's declaration and then to the default of the policy. A nil policy treats the requests
+// declaring nothing as idempotent.
+func (p *IdempotencyPolicy) Idempotent(objective *bool, headerValue string) bool {
+	if idempotent, err := strconv.ParseBool(strings.TrimSpace(headerValue)); err == nil {
+		return idempotent
+	}
+	if objective != nil {
+		return *objective
+	}
+	return p == nil || p.defaultIdempotent
+}
+
+// Restrict drops all the destinations of the primary profile but the first from the result of a non-idempotent
+// request, so that the request is neither retried on fallback destinations nor generated redundantly.
+func (p *IdempotencyPolicy) Restrict(ctx context.Context, request *fwksched.InferenceRequest, result *fwksched.SchedulingResult) {
+	if request == nil || request.Objectives.Idempotent {
+		return
+	}
+	primary := result.ProfileResults[result.PrimaryProfileName]
+	if primary == nil || len(primary.TargetEndpoints) <= 1 {
+		return
+	}
+	dropped := len(primary.TargetEndpoints) - 1
+	primary.TargetEndpoints = primary.TargetEndpoints[:1]
+	metrics.RecordNonIdempotentDestinationsDropped(request.TargetModel, dropped)
+	log.FromContext(ctx).V(logutil.DEBUG).Info("Dropped the additional destinations of a non-idempotent request",
+		"dropped", dropped)
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestcontrol
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+func TestIdempotencyPolicy_Idempotent(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      *IdempotencyPolicy
+		objective   *bool
+		headerValue string
+		want        bool
+	}{
+		{name: "none declared, no policy", want: true},
+		{name: "none declared, idempotent by default", policy: NewIdempotencyPolicy(true), want: true},
+		{name: "none declared, non-idempotent by default", policy: NewIdempotencyPolicy(false), want: false},
+		{name: "from objective", policy: NewIdempotencyPolicy(true), objective: ptr.To(false), want: false},
+		{name: "header overrides objective", policy: NewIdempotencyPolicy(false), objective: ptr.To(false), headerValue: "true", want: true},
+		{name: "invalid header falls back to objective", policy: NewIdempotencyPolicy(false), objective: ptr.To(true), headerValue: "maybe", want: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, test.policy.Idempotent(test.objective, test.headerValue))
+		})
+	}
+}
+
+func TestIdempotencyPolicy_Restrict(t *testing.T) {
+	endpoint := func(name string) fwksched.Endpoint {
+		return fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: name}}, nil, nil)
+	}
+	result := func() *fwksched.SchedulingResult {
+		return &fwksched.SchedulingResult{
+			PrimaryProfileName: "default",
+			ProfileResults: map[string]*fwksched.ProfileRunResult{
+				"default": {TargetEndpoints: []fwksched.Endpoint{endpoint("pod1"), endpoint("pod2"), endpoint("pod3")}},
+				"prefill": {TargetEndpoints: []fwksched.Endpoint{endpoint("pod4")}},
+			},
+		}
+	}
+	policy := NewIdempotencyPolicy(true)
+
+	idempotent := result()
+	policy.Restrict(context.Background(), &fwksched.InferenceRequest{Objectives: fwksched.RequestObjectives{Idempotent: true}}, idempotent)
+	assert.Len(t, idempotent.ProfileResults["default"].TargetEndpoints, 3)
+
+	nonIdempotent := result()
+	policy.Restrict(context.Background(), &fwksched.InferenceRequest{}, nonIdempotent)
+	assert.Len(t, nonIdempotent.ProfileResults["default"].TargetEndpoints, 1)
+	assert.Equal(t, "pod1", nonIdempotent.ProfileResults["default"].TargetEndpoints[0].GetMetadata().NamespacedName.Name)
+	assert.Len(t, nonIdempotent.ProfileResults["prefill"].TargetEndpoints, 1)
+}
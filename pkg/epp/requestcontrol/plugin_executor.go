@@ -23,14 +23,26 @@ import (
 
 	fwk "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
 	schedulingtypes "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/pluginquarantine"
 )
 
 // executePluginsAsDAG executes PrepareData plugins as a DAG based on their dependencies asynchronously.
 // So, a plugin is executed only after all its dependencies have been executed.
-// If there is a cycle or any plugin fails with error, it returns an error.
-func executePluginsAsDAG(plugins []fwk.DataProducer, ctx context.Context, request *schedulingtypes.InferenceRequest, endpoints []schedulingtypes.Endpoint) error {
+// If there is a cycle or any plugin fails with error, it returns an error. The plugins are run through the given
+// breaker, so that their panics are recovered as errors and the quarantined plugins are skipped.
+func executePluginsAsDAG(plugins []fwk.DataProducer, breaker *pluginquarantine.Breaker, ctx context.Context, request *schedulingtypes.InferenceRequest, endpoints []schedulingtypes.Endpoint) error {
 	for _, plugin := range plugins {
-		if err := plugin.PrepareRequestData(ctx, request, endpoints); err != nil {
+		before := time.Now()
+		err := breaker.Run(ctx, fwk.PrepareDataExtensionPoint, plugin.TypedName(), func() error {
+			return plugin.PrepareRequestData(ctx, request, endpoints)
+		})
+		if errors.Is(err, pluginquarantine.ErrQuarantined) {
+			continue
+		}
+		metrics.RecordPluginProcessingLatency(fwk.PrepareDataExtensionPoint, plugin.TypedName().Type, plugin.TypedName().Name, time.Since(before))
+		if err != nil {
+			metrics.RecordPluginError("", fwk.PrepareDataExtensionPoint, plugin.TypedName().Type, plugin.TypedName().Name)
 			return errors.New("prepare data plugin " + plugin.TypedName().String() + " failed: " + err.Error())
 		}
 	}
@@ -40,14 +52,14 @@ func executePluginsAsDAG(plugins []fwk.DataProducer, ctx context.Context, reques
 // prepareDataPluginsWithTimeout executes the PrepareRequestData plugins with retries and timeout.
 // The child context is cancelled when the timeout fires so plugins can observe cancellation
 // (e.g. abort outbound HTTP calls) and avoid committing state after the director has moved on.
-func prepareDataPluginsWithTimeout(timeout time.Duration, plugins []fwk.DataProducer,
+func prepareDataPluginsWithTimeout(timeout time.Duration, plugins []fwk.DataProducer, breaker *pluginquarantine.Breaker,
 	ctx context.Context, request *schedulingtypes.InferenceRequest, endpoints []schedulingtypes.Endpoint) error {
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	errCh := make(chan error, 1)
 	go func() {
-		errCh <- executePluginsAsDAG(plugins, ctx, request, endpoints)
+		errCh <- executePluginsAsDAG(plugins, breaker, ctx, request, endpoints)
 	}()
 
 	select {
@@ -101,6 +101,7 @@ func TestPrepareDataPluginsWithTimeout_CancelsPluginContext(t *testing.T) {
 	err := prepareDataPluginsWithTimeout(
 		20*time.Millisecond,
 		[]fwk.DataProducer{plugin},
+		nil,
 		context.Background(),
 		&schedulingtypes.InferenceRequest{},
 		nil,
@@ -197,7 +198,7 @@ func TestPrepareDataPluginsWithTimeout(t *testing.T) {
 			ctx, cancel := tc.ctxFn()
 			defer cancel()
 
-			err := prepareDataPluginsWithTimeout(tc.timeout, tc.plugins, ctx, &schedulingtypes.InferenceRequest{}, nil)
+			err := prepareDataPluginsWithTimeout(tc.timeout, tc.plugins, nil, ctx, &schedulingtypes.InferenceRequest{}, nil)
 
 			if tc.expectSuccess {
 				assert.NoError(t, err)
@@ -333,7 +334,7 @@ func TestExecutePluginsAsDAG(t *testing.T) {
 				plugin.execTime = time.Time{}
 			}
 
-			err := executePluginsAsDAG(tc.plugins, context.Background(), &schedulingtypes.InferenceRequest{}, nil)
+			err := executePluginsAsDAG(tc.plugins, nil, context.Background(), &schedulingtypes.InferenceRequest{}, nil)
 
 			if tc.expectErr {
 				assert.Error(t, err)
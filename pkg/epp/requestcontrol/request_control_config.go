@@ -17,6 +17,9 @@ limitations under the License.
 package requestcontrol
 
 import (
+	"cmp"
+	"slices"
+
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
 	fwk "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
 )
@@ -27,8 +30,10 @@ func NewConfig() *Config {
 		admissionPlugins:         []fwk.Admitter{},
 		prepareDataPlugins:       []fwk.DataProducer{},
 		preRequestPlugins:        []fwk.PreRequest{},
+		requestMutators:          []fwk.RequestMutator{},
 		responseReceivedPlugins:  []fwk.ResponseHeaderProcessor{},
 		responseStreamingPlugins: []fwk.ResponseBodyProcessor{},
+		postResponsePlugins:      []fwk.PostResponse{},
 	}
 }
 
@@ -37,8 +42,10 @@ type Config struct {
 	admissionPlugins         []fwk.Admitter
 	prepareDataPlugins       []fwk.DataProducer
 	preRequestPlugins        []fwk.PreRequest
+	requestMutators          []fwk.RequestMutator
 	responseReceivedPlugins  []fwk.ResponseHeaderProcessor
 	responseStreamingPlugins []fwk.ResponseBodyProcessor
+	postResponsePlugins      []fwk.PostResponse
 }
 
 // WithPreRequestPlugins sets the given plugins as the PreRequest plugins.
@@ -48,6 +55,13 @@ func (c *Config) WithPreRequestPlugins(plugins ...fwk.PreRequest) *Config {
 	return c
 }
 
+// WithRequestMutators sets the given plugins as the RequestMutator plugins, in the given order.
+// If the Config has RequestMutator plugins already, this call replaces the existing plugins with the given ones.
+func (c *Config) WithRequestMutators(plugins ...fwk.RequestMutator) *Config {
+	c.requestMutators = plugins
+	return c
+}
+
 // WithResponseReceivedPlugins sets the given plugins as the ResponseReceived plugins.
 // If the Config has ResponseReceived plugins already, this call replaces the existing plugins with the given ones.
 func (c *Config) WithResponseReceivedPlugins(plugins ...fwk.ResponseHeaderProcessor) *Config {
@@ -62,6 +76,13 @@ func (c *Config) WithResponseStreamingPlugins(plugins ...fwk.ResponseBodyProcess
 	return c
 }
 
+// WithPostResponsePlugins sets the given plugins as the PostResponse plugins.
+// If the Config has PostResponse plugins already, this call replaces the existing plugins with the given ones.
+func (c *Config) WithPostResponsePlugins(plugins ...fwk.PostResponse) *Config {
+	c.postResponsePlugins = plugins
+	return c
+}
+
 // WithPrepareDataPlugins sets the given plugins as the PrepareData plugins.
 func (c *Config) WithPrepareDataPlugins(plugins ...fwk.DataProducer) *Config {
 	c.prepareDataPlugins = plugins
@@ -82,12 +103,18 @@ func (c *Config) AddPlugins(pluginObjects ...plugin.Plugin) {
 		if preRequestPlugin, ok := plugin.(fwk.PreRequest); ok {
 			c.preRequestPlugins = append(c.preRequestPlugins, preRequestPlugin)
 		}
+		if requestMutator, ok := plugin.(fwk.RequestMutator); ok {
+			c.requestMutators = append(c.requestMutators, requestMutator)
+		}
 		if responseReceivedPlugin, ok := plugin.(fwk.ResponseHeaderProcessor); ok {
 			c.responseReceivedPlugins = append(c.responseReceivedPlugins, responseReceivedPlugin)
 		}
 		if responseStreamingPlugin, ok := plugin.(fwk.ResponseBodyProcessor); ok {
 			c.responseStreamingPlugins = append(c.responseStreamingPlugins, responseStreamingPlugin)
 		}
+		if postResponsePlugin, ok := plugin.(fwk.PostResponse); ok {
+			c.postResponsePlugins = append(c.postResponsePlugins, postResponsePlugin)
+		}
 		if prepareDataPlugin, ok := plugin.(fwk.DataProducer); ok {
 			c.prepareDataPlugins = append(c.prepareDataPlugins, prepareDataPlugin)
 		}
@@ -111,3 +138,26 @@ func (c *Config) OrderPrepareDataPlugins(sortedPluginNames []string) {
 	}
 	c.prepareDataPlugins = sortedPlugins
 }
+
+// OrderRequestMutators reorders the requestMutators in the Config: the plugins with the given names first, in the
+// given order, then the other ones ordered by name.
+func (c *Config) OrderRequestMutators(orderedPluginNames []string) {
+	rank := make(map[string]int, len(orderedPluginNames))
+	for i, name := range orderedPluginNames {
+		rank[name] = i
+	}
+	slices.SortStableFunc(c.requestMutators, func(a, b fwk.RequestMutator) int {
+		rankA, rankedA := rank[a.TypedName().Name]
+		rankB, rankedB := rank[b.TypedName().Name]
+		switch {
+		case rankedA && rankedB:
+			return cmp.Compare(rankA, rankB)
+		case rankedA:
+			return -1
+		case rankedB:
+			return 1
+		default:
+			return cmp.Compare(a.TypedName().Name, b.TypedName().Name)
+		}
+	})
+}
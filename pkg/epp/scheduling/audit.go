@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"time"
+
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+// DecisionAudit is the audit record of a scheduling decision: the candidate endpoints, how each profile filtered and
+// scored them, and the endpoint chosen for the request.
+type DecisionAudit struct {
+	Timestamp time.Time `json:"timestamp"`
+	RequestID string    `json:"requestId"`
+	Model     string    `json:"model"`
+	// Candidates are the "<namespace>/<name>" of the candidate endpoints of the request.
+	Candidates []string `json:"candidates"`
+	// Profiles are the audits of the profiles run, by profile name.
+	Profiles map[string]*ProfileAudit `json:"profiles"`
+	// Chosen is the "<namespace>/<name>" of the endpoint picked by the primary profile, empty if none was.
+	Chosen string `json:"chosen,omitempty"`
+	// Error is the error of the scheduling cycle, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// ProfileAudit is the audit of a profile run.
+type ProfileAudit struct {
+	// Filters are the filters run, in order, with the endpoints each of them eliminated.
+	Filters []FilterAudit `json:"filters,omitempty"`
+	// Scorers are the scorers run, in order, with the score each of them gave to the endpoints.
+	Scorers []ScorerAudit `json:"scorers,omitempty"`
+	// Picked are the "<namespace>/<name>" of the target endpoints picked by the profile.
+	Picked []string `json:"picked,omitempty"`
+}
+
+// FilterAudit is the audit of a filter run.
+type FilterAudit struct {
+	Plugin     string   `json:"plugin"`
+	Eliminated []string `json:"eliminated"`
+}
+
+// ScorerAudit is the audit of a scorer run. The scores are the scores of the scorer, in the [0, 1] range, before they
+// are weighted.
+type ScorerAudit struct {
+	Plugin string             `json:"plugin"`
+	Weight float64            `json:"weight"`
+	Scores map[string]float64 `json:"scores"`
+}
+
+// DecisionAuditSink receives the audit records of the scheduling decisions.
+type DecisionAuditSink interface {
+	AuditDecision(audit *DecisionAudit)
+}
+
+// WithDecisionAuditSink sets the sink the audit record of every scheduling decision is sent to. Simulated decisions,
+// e.g. of the what-if API, and the runs of the shadow profiles are not audited.
+func (s *Scheduler) WithDecisionAuditSink(sink DecisionAuditSink) *Scheduler {
+	s.auditSink = sink
+	return s
+}
+
+// Explain runs a dry-run scheduling cycle like Simulate, and returns the audit record of the decision, which explains
+// which endpoints each filter eliminated and how each scorer scored the rest.
+func (s *Scheduler) Explain(ctx context.Context, request *framework.InferenceRequest, candidateEndpoints []framework.Endpoint) *DecisionAudit {
+	audit := newDecisionAudit(request, candidateEndpoints)
+	result, err := s.Simulate(withDecisionAudit(ctx, audit), request, candidateEndpoints)
+	audit.complete(result, err)
+	return audit
+}
+
+type decisionAuditKey struct{}
+
+// withDecisionAudit returns a context carrying the audit record the profiles run with it record their run into.
+func withDecisionAudit(ctx context.Context, audit *DecisionAudit) context.Context {
+	return context.WithValue(ctx, decisionAuditKey{}, audit)
+}
+
+// profileAudit returns the audit of the profile run with the given context, or nil if the decision is not audited.
+func profileAudit(ctx context.Context) *ProfileAudit {
+	audit, _ := ctx.Value(decisionAuditKey{}).(*DecisionAudit)
+	if audit == nil {
+		return nil
+	}
+	name := profileName(ctx)
+	if audit.Profiles[name] == nil {
+		audit.Profiles[name] = &ProfileAudit{}
+	}
+	return audit.Profiles[name]
+}
+
+// newDecisionAudit returns the audit record of the scheduling decision of the given request.
+func newDecisionAudit(request *framework.InferenceRequest, candidateEndpoints []framework.Endpoint) *DecisionAudit {
+	return &DecisionAudit{
+		Timestamp:  time.Now(),
+		RequestID:  request.RequestId,
+		Model:      request.TargetModel,
+		Candidates: endpointNames(candidateEndpoints),
+		Profiles:   map[string]*ProfileAudit{},
+	}
+}
+
+// complete records the outcome of the scheduling cycle in the audit record.
+func (a *DecisionAudit) complete(result *framework.SchedulingResult, err error) {
+	if err != nil {
+		a.Error = err.Error()
+	}
+	if result == nil {
+		return
+	}
+	if primary := result.ProfileResults[result.PrimaryProfileName]; primary != nil && len(primary.TargetEndpoints) > 0 {
+		a.Chosen = endpointName(primary.TargetEndpoints[0])
+	}
+}
+
+// eliminatedEndpoints returns the names of the endpoints of before that are not in after.
+func eliminatedEndpoints(before, after []framework.Endpoint) []string {
+	kept := make(map[string]bool, len(after))
+	for _, endpoint := range after {
+		kept[endpointName(endpoint)] = true
+	}
+	eliminated := []string{}
+	for _, endpoint := range before {
+		if name := endpointName(endpoint); !kept[name] {
+			eliminated = append(eliminated, name)
+		}
+	}
+	return eliminated
+}
+
+func endpointNames(endpoints []framework.Endpoint) []string {
+	names := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		names = append(names, endpointName(endpoint))
+	}
+	return names
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/profile"
+)
+
+type auditRecorder struct {
+	audits []*DecisionAudit
+}
+
+func (r *auditRecorder) AuditDecision(audit *DecisionAudit) {
+	r.audits = append(r.audits, audit)
+}
+
+func TestScheduleAuditsDecisions(t *testing.T) {
+	filter := &testPlugin{TypeRes: "filter", FilterRes: []k8stypes.NamespacedName{{Name: "pod1"}, {Name: "pod2"}}}
+	scorer := &testPlugin{TypeRes: "scorer", ScoreRes: 0.4}
+	picker := &testPlugin{TypeRes: "picker", PickRes: k8stypes.NamespacedName{Name: "pod2"}}
+	defaultProfile := NewSchedulerProfile().
+		WithFilters(filter).
+		WithScorers(NewWeightedScorer(scorer, 2)).
+		WithPicker(picker)
+	shadow := NewSchedulerProfile().WithFilters(filter).WithPicker(picker)
+	sink := &auditRecorder{}
+	scheduler := NewSchedulerWithConfig(NewSchedulerConfig(profile.NewSingleProfileHandler(),
+		map[string]fwksched.SchedulerProfile{"default": defaultProfile}).
+		WithShadowProfiles(map[string]ShadowProfile{"shadow": {Profile: shadow, SampleRate: 1}})).
+		WithDecisionAuditSink(sink)
+	endpoints := []fwksched.Endpoint{
+		fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "pod1"}}, nil, nil),
+		fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "pod2"}}, nil, nil),
+		fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "pod3"}}, nil, nil),
+	}
+	request := &fwksched.InferenceRequest{TargetModel: "test-model", RequestId: uuid.NewString()}
+
+	_, err := scheduler.Schedule(context.Background(), request, endpoints)
+	require.NoError(t, err)
+	_, err = scheduler.Simulate(context.Background(), request, endpoints)
+	require.NoError(t, err)
+
+	require.Len(t, sink.audits, 1, "simulated decisions must not be audited")
+	audit := sink.audits[0]
+	assert.Equal(t, request.RequestId, audit.RequestID)
+	assert.Equal(t, "test-model", audit.Model)
+	assert.Equal(t, []string{"/pod1", "/pod2", "/pod3"}, audit.Candidates)
+	assert.Equal(t, "/pod2", audit.Chosen)
+	assert.Empty(t, audit.Error)
+	require.Len(t, audit.Profiles, 1, "shadow profiles must not be audited")
+	defaultAudit := audit.Profiles["default"]
+	require.NotNil(t, defaultAudit)
+	assert.Equal(t, []FilterAudit{{Plugin: filter.TypedName().String(), Eliminated: []string{"/pod3"}}}, defaultAudit.Filters)
+	assert.Equal(t, []ScorerAudit{{
+		Plugin: scorer.TypedName().String(),
+		Weight: 2,
+		Scores: map[string]float64{"/pod1": 0.4, "/pod2": 0.4},
+	}}, defaultAudit.Scorers)
+	assert.Equal(t, []string{"/pod2"}, defaultAudit.Picked)
+}
+
+func TestScheduleAuditsFailedDecisions(t *testing.T) {
+	filterAll := &testPlugin{TypeRes: "filter all", FilterRes: []k8stypes.NamespacedName{}}
+	defaultProfile := NewSchedulerProfile().
+		WithFilters(filterAll).
+		WithPicker(&testPlugin{TypeRes: "picker"})
+	sink := &auditRecorder{}
+	scheduler := NewSchedulerWithConfig(NewSchedulerConfig(profile.NewSingleProfileHandler(),
+		map[string]fwksched.SchedulerProfile{"default": defaultProfile})).
+		WithDecisionAuditSink(sink)
+	endpoints := []fwksched.Endpoint{
+		fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "pod1"}}, nil, nil),
+	}
+
+	_, err := scheduler.Schedule(context.Background(), &fwksched.InferenceRequest{RequestId: uuid.NewString()}, endpoints)
+	require.Error(t, err)
+
+	require.Len(t, sink.audits, 1)
+	audit := sink.audits[0]
+	assert.Empty(t, audit.Chosen)
+	assert.NotEmpty(t, audit.Error)
+	assert.Equal(t, []FilterAudit{{Plugin: filterAll.TypedName().String(), Eliminated: []string{"/pod1"}}},
+		audit.Profiles["default"].Filters)
+}
+
+func TestExplain(t *testing.T) {
+	filter := &testPlugin{TypeRes: "filter", FilterRes: []k8stypes.NamespacedName{{Name: "pod1"}}}
+	picker := &testPlugin{TypeRes: "picker", PickRes: k8stypes.NamespacedName{Name: "pod1"}}
+	sink := &auditRecorder{}
+	scheduler := NewSchedulerWithConfig(NewSchedulerConfig(profile.NewSingleProfileHandler(),
+		map[string]fwksched.SchedulerProfile{"default": NewSchedulerProfile().WithFilters(filter).WithPicker(picker)})).
+		WithDecisionAuditSink(sink)
+	endpoints := []fwksched.Endpoint{
+		fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "pod1"}}, nil, nil),
+		fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "pod2"}}, nil, nil),
+	}
+
+	audit := scheduler.Explain(context.Background(), &fwksched.InferenceRequest{RequestId: "req-1"}, endpoints)
+	assert.Equal(t, "/pod1", audit.Chosen)
+	assert.Equal(t, []string{"/pod2"}, audit.Profiles["default"].Filters[0].Eliminated)
+	assert.Empty(t, sink.audits, "explained decisions must not be sent to the audit sink")
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+const (
+	budgetExceededProfile = "profile"
+	budgetExceededPlugin  = "plugin"
+)
+
+// ProfileBudget bounds the execution time of a SchedulerProfile run.
+type ProfileBudget struct {
+	// Timeout is the time budget of a run, including the filters and scorers. 0 means no limit.
+	Timeout time.Duration
+	// PluginTimeout is the time budget of each scorer, unless overridden by the scorer. 0 means no limit.
+	PluginTimeout time.Duration
+	// FallbackPicker picks the endpoint once the budget is exceeded. If nil, a random endpoint is picked.
+	FallbackPicker fwksched.Picker
+}
+
+// WithBudget sets the time budget of the SchedulerProfile.
+func (p *SchedulerProfile) WithBudget(budget ProfileBudget) *SchedulerProfile {
+	p.budget = budget
+	return p
+}
+
+// scorerTimeout returns the time budget of the given scorer, bounded by the remaining budget of the run, and the
+// budget which bounds it. The timeout is 0 if the scorer is not bounded, and negative if the budget of the run is
+// already exhausted.
+func (p *SchedulerProfile) scorerTimeout(scorer *WeightedScorer, runStart time.Time) (time.Duration, string) {
+	timeout := scorer.Timeout()
+	if timeout == 0 {
+		timeout = p.budget.PluginTimeout
+	}
+	if p.budget.Timeout > 0 {
+		remaining := p.budget.Timeout - time.Since(runStart)
+		if remaining <= 0 {
+			return -1, budgetExceededProfile
+		}
+		if timeout == 0 || remaining < timeout {
+			return remaining, budgetExceededProfile
+		}
+	}
+	return timeout, budgetExceededPlugin
+}
+
+// scoreWithTimeout runs the scorer, abandoning it if it does not complete within the given timeout. The scorer keeps
+// running in the background until it returns, its context being cancelled, but its scores are discarded. It returns
+// the error of the run, e.g. a recovered panic, or ErrQuarantined if the scorer is quarantined and was not run.
+func scoreWithTimeout(ctx context.Context, scorer *WeightedScorer, timeout time.Duration, cycleState *fwksched.CycleState,
+	request *fwksched.InferenceRequest, endpoints []fwksched.Endpoint) (map[fwksched.Endpoint]float64, bool, error) {
+	score := func(ctx context.Context) (map[fwksched.Endpoint]float64, error) {
+		var scores map[fwksched.Endpoint]float64
+		err := runPlugin(ctx, scorerExtensionPoint, scorer.TypedName(), func() error {
+			scores = scorer.Score(ctx, cycleState, request, endpoints)
+			return nil
+		})
+		return scores, err
+	}
+	if timeout == 0 {
+		scores, err := score(ctx)
+		return scores, true, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	type result struct {
+		scores map[fwksched.Endpoint]float64
+		err    error
+	}
+	done := make(chan result, 1)
+	go func() {
+		scores, err := score(ctx)
+		done <- result{scores: scores, err: err}
+	}()
+	select {
+	case res := <-done:
+		return res.scores, true, res.err
+	case <-ctx.Done():
+		return nil, false, nil
+	}
+}
+
+// runFallbackPicker picks the endpoint once the budget of the run is exceeded, with the fallback picker if configured,
+// or randomly otherwise.
+func (p *SchedulerProfile) runFallbackPicker(ctx context.Context, cycleState *fwksched.CycleState, endpoints []fwksched.Endpoint) *fwksched.ProfileRunResult {
+	if p.budget.FallbackPicker == nil {
+		return &fwksched.ProfileRunResult{TargetEndpoints: []fwksched.Endpoint{endpoints[rand.IntN(len(endpoints))]}}
+	}
+	scoredEndpoints := make([]*fwksched.ScoredEndpoint, len(endpoints))
+	for i, endpoint := range endpoints {
+		scoredEndpoints[i] = &fwksched.ScoredEndpoint{Endpoint: endpoint}
+	}
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("Running fallback picker plugin", "plugin", p.budget.FallbackPicker.TypedName())
+	var result *fwksched.ProfileRunResult
+	if err := runPlugin(ctx, pickerExtensionPoint, p.budget.FallbackPicker.TypedName(), func() error {
+		result = p.budget.FallbackPicker.Pick(ctx, cycleState, scoredEndpoints)
+		return nil
+	}); err != nil {
+		return &fwksched.ProfileRunResult{TargetEndpoints: []fwksched.Endpoint{endpoints[rand.IntN(len(endpoints))]}}
+	}
+	return result
+}
+
+func recordBudgetExceeded(ctx context.Context, scorer *WeightedScorer, reason string) {
+	log.FromContext(ctx).V(logutil.DEFAULT).Info("Scheduling budget exceeded, skipping the remaining scorers",
+		"plugin", scorer.TypedName(), "reason", reason)
+	metrics.RecordSchedulerBudgetExceeded(reason, scorer.TypedName().Type, scorer.TypedName().Name)
+}
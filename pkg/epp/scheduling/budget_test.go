@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+// slowScorer is a scorer blocking until its context is cancelled or its delay elapses.
+type slowScorer struct {
+	delay time.Duration
+}
+
+func (s *slowScorer) TypedName() fwkplugin.TypedName {
+	return fwkplugin.TypedName{Type: "slow", Name: "slow"}
+}
+
+func (s *slowScorer) Category() fwksched.ScorerCategory {
+	return fwksched.Distribution
+}
+
+func (s *slowScorer) Score(ctx context.Context, _ *fwksched.CycleState, _ *fwksched.InferenceRequest, endpoints []fwksched.Endpoint) map[fwksched.Endpoint]float64 {
+	select {
+	case <-ctx.Done():
+	case <-time.After(s.delay):
+	}
+	scores := make(map[fwksched.Endpoint]float64, len(endpoints))
+	for _, endpoint := range endpoints {
+		scores[endpoint] = 1
+	}
+	return scores
+}
+
+func TestSchedulerProfileBudget(t *testing.T) {
+	endpoints := []fwksched.Endpoint{
+		fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "pod1"}}, nil, nil),
+		fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "pod2"}}, nil, nil),
+	}
+	allEndpoints := []k8stypes.NamespacedName{{Name: "pod1"}, {Name: "pod2"}}
+
+	tests := []struct {
+		name             string
+		budget           ProfileBudget
+		scorerTimeout    time.Duration
+		wantPicker       bool
+		wantFallback     bool
+		wantSecondScorer bool
+	}{
+		{
+			name:             "no budget",
+			wantPicker:       true,
+			wantSecondScorer: true,
+		},
+		{
+			name:         "plugin timeout exceeded",
+			budget:       ProfileBudget{PluginTimeout: 10 * time.Millisecond},
+			wantFallback: true,
+		},
+		{
+			name:          "scorer timeout overrides plugin timeout",
+			budget:        ProfileBudget{PluginTimeout: 10 * time.Millisecond},
+			scorerTimeout: time.Second,
+			wantPicker:    true,
+			// the second scorer is bounded by the plugin timeout, but is fast
+			wantSecondScorer: true,
+		},
+		{
+			name:         "profile timeout exceeded",
+			budget:       ProfileBudget{Timeout: 10 * time.Millisecond},
+			wantFallback: true,
+		},
+		{
+			name:   "random fallback",
+			budget: ProfileBudget{PluginTimeout: 10 * time.Millisecond},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			slow := &slowScorer{delay: 100 * time.Millisecond}
+			second := &testPlugin{TypeRes: "second", ScoreRes: 0.5}
+			picker := &testPlugin{TypeRes: "picker", PickRes: k8stypes.NamespacedName{Name: "pod1"}}
+			fallback := &testPlugin{TypeRes: "fallback", PickRes: k8stypes.NamespacedName{Name: "pod2"}}
+
+			budget := test.budget
+			if test.wantFallback {
+				budget.FallbackPicker = fallback
+			}
+			profile := NewSchedulerProfile().
+				WithFilters(&testPlugin{TypeRes: "filter", FilterRes: allEndpoints}).
+				WithScorers(NewWeightedScorer(slow, 1).WithTimeout(test.scorerTimeout), NewWeightedScorer(second, 1)).
+				WithPicker(picker).
+				WithBudget(budget)
+
+			result, err := profile.Run(context.Background(), &fwksched.InferenceRequest{}, fwksched.NewCycleState(), endpoints)
+			require.NoError(t, err)
+			require.Len(t, result.TargetEndpoints, 1)
+			assert.Equal(t, test.wantPicker, picker.PickCallCount == 1, "profile picker call")
+			assert.Equal(t, test.wantFallback, fallback.PickCallCount == 1, "fallback picker call")
+			assert.Equal(t, test.wantSecondScorer, second.ScoreCallCount == 1, "second scorer call")
+		})
+	}
+}
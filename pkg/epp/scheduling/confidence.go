@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"math"
+
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+// decisionConfidence measures how clearly the given weighted scores discriminate the candidate endpoints. It returns
+// the gap between the two best scores relative to the best one, and the entropy of the scores normalized to [0, 1]:
+// a small gap and an entropy close to 1 mean that the scorers barely told the candidates apart. It returns false when
+// there are fewer than two candidates, or when all the scores are zero.
+func decisionConfidence(weightedScorePerEndpoint map[fwksched.Endpoint]float64) (gap, entropy float64, ok bool) {
+	if len(weightedScorePerEndpoint) < 2 {
+		return 0, 0, false
+	}
+	best, second, total := 0.0, 0.0, 0.0
+	for _, score := range weightedScorePerEndpoint {
+		score = max(score, 0)
+		total += score
+		if score > best {
+			best, second = score, best
+		} else if score > second {
+			second = score
+		}
+	}
+	if total == 0 {
+		return 0, 0, false
+	}
+	for _, score := range weightedScorePerEndpoint {
+		if p := max(score, 0) / total; p > 0 {
+			entropy -= p * math.Log(p)
+		}
+	}
+	return (best - second) / best, entropy / math.Log(float64(len(weightedScorePerEndpoint))), true
+}
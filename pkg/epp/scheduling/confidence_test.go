@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+func TestDecisionConfidence(t *testing.T) {
+	endpoints := make([]fwksched.Endpoint, 4)
+	for i := range endpoints {
+		endpoints[i] = fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: string(rune('a' + i))}},
+			&fwkdl.Metrics{}, nil)
+	}
+	scores := func(values ...float64) map[fwksched.Endpoint]float64 {
+		res := map[fwksched.Endpoint]float64{}
+		for i, value := range values {
+			res[endpoints[i]] = value
+		}
+		return res
+	}
+
+	tests := []struct {
+		name        string
+		scores      map[fwksched.Endpoint]float64
+		wantGap     float64
+		wantEntropy float64
+		wantOK      bool
+	}{
+		{
+			name:   "single candidate",
+			scores: scores(0.8),
+		},
+		{
+			name:   "all zero",
+			scores: scores(0, 0, 0),
+		},
+		{
+			name:        "tie",
+			scores:      scores(0.5, 0.5, 0.5, 0.5),
+			wantGap:     0,
+			wantEntropy: 1,
+			wantOK:      true,
+		},
+		{
+			name:        "single scored candidate",
+			scores:      scores(1, 0, 0, 0),
+			wantGap:     1,
+			wantEntropy: 0,
+			wantOK:      true,
+		},
+		{
+			name:        "two candidates",
+			scores:      scores(0.75, 0.25),
+			wantGap:     2.0 / 3,
+			wantEntropy: 0.8112781244591328,
+			wantOK:      true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gap, entropy, ok := decisionConfidence(test.scores)
+			assert.Equal(t, test.wantOK, ok)
+			assert.InDelta(t, test.wantGap, gap, 1e-9)
+			assert.InDelta(t, test.wantEntropy, entropy, 1e-9)
+		})
+	}
+}
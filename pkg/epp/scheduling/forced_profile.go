@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"fmt"
+
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+const forcedProfileHandlerType = "forced-profile-handler"
+
+// forcedProfileHandler replaces the configured profile handler for the requests forcing a profile through their
+// force-profile scheduling hint: it runs that profile only, and makes it the primary profile.
+type forcedProfileHandler struct {
+	profile string
+}
+
+func (h *forcedProfileHandler) TypedName() fwkplugin.TypedName {
+	return fwkplugin.TypedName{Type: forcedProfileHandlerType, Name: forcedProfileHandlerType}
+}
+
+func (h *forcedProfileHandler) Pick(_ context.Context, _ *framework.CycleState, _ *framework.InferenceRequest,
+	profiles map[string]framework.SchedulerProfile, profileResults map[string]*framework.ProfileRunResult) map[string]framework.SchedulerProfile {
+	if _, ran := profileResults[h.profile]; ran {
+		return map[string]framework.SchedulerProfile{}
+	}
+	return map[string]framework.SchedulerProfile{h.profile: profiles[h.profile]}
+}
+
+func (h *forcedProfileHandler) ProcessResults(_ context.Context, _ *framework.CycleState, _ *framework.InferenceRequest,
+	profileResults map[string]*framework.ProfileRunResult) (*framework.SchedulingResult, error) {
+	if profileResults[h.profile] == nil {
+		return nil, fmt.Errorf("failed to run forced scheduler profile '%s'", h.profile)
+	}
+	return &framework.SchedulingResult{ProfileResults: profileResults, PrimaryProfileName: h.profile}, nil
+}
+
+// profileHandlerFor returns the profile handler of the given request: the configured one, unless the request forces
+// a profile.
+func (s *Scheduler) profileHandlerFor(request *framework.InferenceRequest) (framework.ProfileHandler, error) {
+	if request == nil || request.Hints.Profile == "" {
+		return s.profileHandler, nil
+	}
+	if _, ok := s.profiles[request.Hints.Profile]; !ok {
+		return nil, fmt.Errorf("unknown scheduler profile '%s' forced by request %s", request.Hints.Profile, request.RequestId)
+	}
+	return &forcedProfileHandler{profile: request.Hints.Profile}, nil
+}
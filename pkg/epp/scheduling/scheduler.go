@@ -22,11 +22,15 @@ import (
 	"fmt"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
 	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/pluginquarantine"
 )
 
 const (
@@ -35,40 +39,170 @@ const (
 	scorerExtensionPoint                 = "Scorer"
 	pickerExtensionPoint                 = "Picker"
 	processProfilesResultsExtensionPoint = "ProcessProfilesResults"
+	resultsProcessorExtensionPoint       = "ProfileResultsProcessor"
 )
 
+// SchedulerType is the type of the scheduler, as an endpoint subscriber.
+const SchedulerType = "scheduler"
+
+var _ fwkdl.EndpointSubscriber = &Scheduler{}
+
+// endpointChangeListener is implemented by the profiles and scorers caching state about the endpoints.
+type endpointChangeListener interface {
+	OnEndpointChange(ctx context.Context, change fwkdl.EndpointChange)
+}
+
 // NewSchedulerWithConfig returns a new scheduler with the given scheduler plugins configuration.
 func NewSchedulerWithConfig(config *SchedulerConfig) *Scheduler {
 	return &Scheduler{
 		profileHandler: config.profileHandler,
 		profiles:       config.profiles,
+		shadowProfiles: config.shadowProfiles,
+		processors:     config.processors,
 	}
 }
 
 type Scheduler struct {
 	profileHandler framework.ProfileHandler
 	profiles       map[string]framework.SchedulerProfile
+	shadowProfiles map[string]ShadowProfile
+	processors     []framework.ProfileResultsProcessor
+	pressure       PressureSignal
+	breaker        *pluginquarantine.Breaker
+	bias           ScoreBias
+	staleness      *StalenessPolicy
+	auditSink      DecisionAuditSink
+}
+
+// PressureSignal reports whether the EPP itself is under resource pressure.
+type PressureSignal interface {
+	UnderPressure() bool
+}
+
+// WithPressureSignal sets the signal consulted on every scheduling cycle. While the signal reports pressure,
+// scorers marked as optional are skipped by all profiles.
+func (s *Scheduler) WithPressureSignal(pressure PressureSignal) *Scheduler {
+	s.pressure = pressure
+	return s
+}
+
+// ScoreBias biases the scheduling toward, or away from, some endpoints.
+type ScoreBias interface {
+	// Multiplier returns the factor applied to the weighted score of the given endpoint.
+	Multiplier(endpoint *fwkdl.EndpointMetadata) float64
+}
+
+// WithScoreBias sets the bias applied to the weighted scores of the endpoints by all profiles, before their pickers
+// are run.
+func (s *Scheduler) WithScoreBias(bias ScoreBias) *Scheduler {
+	s.bias = bias
+	return s
+}
+
+// WithStalenessPolicy sets the policy tracking the age of the metrics of the candidate endpoints, and applied to the
+// endpoints whose metrics are stale.
+func (s *Scheduler) WithStalenessPolicy(policy *StalenessPolicy) *Scheduler {
+	s.staleness = policy
+	return s
+}
+
+// WithPluginBreaker sets the breaker the scheduling plugins are run through, which quarantines the filters, scorers,
+// pickers and results processors failing repeatedly. The panics of the plugins are recovered whether a breaker is set
+// or not.
+func (s *Scheduler) WithPluginBreaker(breaker *pluginquarantine.Breaker) *Scheduler {
+	s.breaker = breaker
+	return s
+}
+
+// TypedName returns the type and name of the scheduler.
+func (s *Scheduler) TypedName() fwkplugin.TypedName {
+	return fwkplugin.TypedName{Type: SchedulerType, Name: SchedulerType}
+}
+
+// OnEndpointChange evicts the cached scores of the endpoints added to or deleted from the datastore from the profiles,
+// shadow profiles included.
+func (s *Scheduler) OnEndpointChange(ctx context.Context, change fwkdl.EndpointChange) {
+	for _, profile := range s.profiles {
+		if listener, ok := profile.(endpointChangeListener); ok {
+			listener.OnEndpointChange(ctx, change)
+		}
+	}
+	for _, shadow := range s.shadowProfiles {
+		if listener, ok := shadow.Profile.(endpointChangeListener); ok {
+			listener.OnEndpointChange(ctx, change)
+		}
+	}
 }
 
 // Schedule finds the target pod based on metrics and the requested lora adapter.
 func (s *Scheduler) Schedule(ctx context.Context, request *framework.InferenceRequest, candidateEndpoints []framework.Endpoint) (result *framework.SchedulingResult, err error) {
 	loggerVerbose := log.FromContext(ctx).V(logutil.VERBOSE)
 
+	ctx, span := startSpan(ctx, "gateway.scheduling",
+		attribute.String("target_model", request.TargetModel),
+		attribute.Int("candidate_endpoints", len(candidateEndpoints)),
+	)
+	defer func() {
+		if result != nil {
+			if attr, ok := targetEndpointAttribute("target_endpoint", result.ProfileResults[result.PrimaryProfileName]); ok {
+				span.SetAttributes(attr)
+			}
+		}
+		endSpan(span, err)
+	}()
+
+	simulation := isSimulation(ctx)
 	scheduleStart := time.Now()
 	defer func() {
+		if simulation {
+			return
+		}
 		metrics.RecordSchedulerE2ELatency(time.Since(scheduleStart))
 		metrics.RecordSchedulerAttempt(err, request.TargetModel, result)
 	}()
 
+	profileHandler, err := s.profileHandlerFor(request)
+	if err != nil {
+		return nil, err
+	}
 	profileRunResults := map[string]*framework.ProfileRunResult{}
 	cycleState := framework.NewCycleState()
+	if s.pressure != nil && s.pressure.UnderPressure() {
+		ctx = withSkipOptionalScorers(ctx)
+	}
+	ctx = withPluginBreaker(ctx, s.breaker)
+	if s.bias != nil {
+		ctx = withScoreBias(ctx, s.bias)
+	}
+	if s.auditSink != nil && !simulation {
+		audit := newDecisionAudit(request, candidateEndpoints)
+		ctx = withDecisionAudit(ctx, audit)
+		defer func() {
+			audit.complete(result, err)
+			s.auditSink.AuditDecision(audit)
+		}()
+	}
+	if s.staleness != nil {
+		var stale int
+		candidateEndpoints, stale = s.staleness.apply(ctx, candidateEndpoints)
+		if stale > 0 && !simulation {
+			metrics.RecordStaleMetricsEndpoints(string(s.staleness.action), stale)
+		}
+		ctx = withStalenessPolicy(ctx, s.staleness)
+	}
 
 	for { // get the next set of profiles to run iteratively based on the request and the previous execution results
-		loggerVerbose.Info("Running profile handler, Pick profiles", "plugin", s.profileHandler.TypedName())
+		loggerVerbose.Info("Running profile handler, Pick profiles", "plugin", profileHandler.TypedName())
 		before := time.Now()
-		profiles := s.profileHandler.Pick(ctx, cycleState, request, s.profiles, profileRunResults)
-		metrics.RecordPluginProcessingLatency(profilePickerExtensionPoint, s.profileHandler.TypedName().Type, s.profileHandler.TypedName().Name, time.Since(before))
-		loggerVerbose.Info("Completed running profile handler Pick profiles successfully", "plugin", s.profileHandler.TypedName(), "result", profiles)
+		var profiles map[string]framework.SchedulerProfile
+		if err := pluginquarantine.Recover(ctx, profilePickerExtensionPoint, profileHandler.TypedName(), func() error {
+			profiles = profileHandler.Pick(ctx, cycleState, request, s.profiles, profileRunResults)
+			return nil
+		}); err != nil {
+			metrics.RecordPluginError("", profilePickerExtensionPoint, profileHandler.TypedName().Type, profileHandler.TypedName().Name)
+		}
+		metrics.RecordPluginProcessingLatency(profilePickerExtensionPoint, profileHandler.TypedName().Type, profileHandler.TypedName().Name, time.Since(before))
+		loggerVerbose.Info("Completed running profile handler Pick profiles successfully", "plugin", profileHandler.TypedName(), "result", profiles)
 		if len(profiles) == 0 { // profile picker didn't pick any profile to run
 			break
 		}
@@ -76,7 +210,7 @@ func (s *Scheduler) Schedule(ctx context.Context, request *framework.InferenceRe
 		for name, profile := range profiles {
 			loggerVerbose.Info("Running scheduler profile", "profile", name)
 			// run the selected profiles and collect results (current code runs all profiles)
-			profileRunResult, err := profile.Run(ctx, request, cycleState, candidateEndpoints)
+			profileRunResult, err := profile.Run(withProfileName(ctx, name), request, cycleState, candidateEndpoints)
 			if err != nil {
 				loggerVerbose.Info("failed to run scheduler profile", "profile", name, "error", err.Error())
 			} else {
@@ -92,11 +226,59 @@ func (s *Scheduler) Schedule(ctx context.Context, request *framework.InferenceRe
 		return nil, err
 	}
 
-	loggerVerbose.Info("Running profile handler, ProcessResults", "plugin", s.profileHandler.TypedName())
+	loggerVerbose.Info("Running profile handler, ProcessResults", "plugin", profileHandler.TypedName())
 	before := time.Now()
-	result, err = s.profileHandler.ProcessResults(ctx, cycleState, request, profileRunResults)
-	metrics.RecordPluginProcessingLatency(processProfilesResultsExtensionPoint, s.profileHandler.TypedName().Type, s.profileHandler.TypedName().Name, time.Since(before))
-	loggerVerbose.Info("Completed running profile handler ProcessResults successfully", "plugin", s.profileHandler.TypedName())
+	if panicErr := pluginquarantine.Recover(ctx, processProfilesResultsExtensionPoint, profileHandler.TypedName(), func() error {
+		result, err = profileHandler.ProcessResults(ctx, cycleState, request, profileRunResults)
+		return nil
+	}); panicErr != nil {
+		result, err = nil, panicErr
+	}
+	metrics.RecordPluginProcessingLatency(processProfilesResultsExtensionPoint, profileHandler.TypedName().Type, profileHandler.TypedName().Name, time.Since(before))
+	if err != nil {
+		metrics.RecordPluginError("", processProfilesResultsExtensionPoint, profileHandler.TypedName().Type, profileHandler.TypedName().Name)
+	}
+	loggerVerbose.Info("Completed running profile handler ProcessResults successfully", "plugin", profileHandler.TypedName())
 
+	for _, processor := range s.processors {
+		if err != nil || result == nil {
+			break
+		}
+		loggerVerbose.Info("Running profile results processor", "plugin", processor.TypedName())
+		before := time.Now()
+		var processed *framework.SchedulingResult
+		var processErr error
+		// A processor that panicked or is quarantined is skipped, leaving the result as is.
+		if runErr := runPlugin(ctx, resultsProcessorExtensionPoint, processor.TypedName(), func() error {
+			processed, processErr = processor.ProcessProfileResults(ctx, cycleState, request, result)
+			return nil
+		}); runErr != nil {
+			loggerVerbose.Info("Skipping profile results processor", "plugin", processor.TypedName(), "reason", runErr.Error())
+			continue
+		}
+		result, err = processed, processErr
+		metrics.RecordPluginProcessingLatency(resultsProcessorExtensionPoint, processor.TypedName().Type, processor.TypedName().Name, time.Since(before))
+		if err != nil {
+			metrics.RecordPluginError("", resultsProcessorExtensionPoint, processor.TypedName().Type, processor.TypedName().Name)
+		}
+		loggerVerbose.Info("Completed running profile results processor", "plugin", processor.TypedName(), "error", err)
+	}
+
+	if err == nil && result != nil && !simulation {
+		s.runShadowProfiles(withDecisionAudit(ctx, nil), request, candidateEndpoints, result)
+	}
 	return result, err
 }
+
+// Simulate runs a scheduling cycle like Schedule, for what-if analyses of hypothetical candidate endpoints: the
+// scheduling attempt is not recorded in the scheduler metrics and the shadow profiles are not run.
+func (s *Scheduler) Simulate(ctx context.Context, request *framework.InferenceRequest, candidateEndpoints []framework.Endpoint) (*framework.SchedulingResult, error) {
+	return s.Schedule(context.WithValue(ctx, simulationKey{}, true), request, candidateEndpoints)
+}
+
+type simulationKey struct{}
+
+func isSimulation(ctx context.Context) bool {
+	simulation, _ := ctx.Value(simulationKey{}).(bool)
+	return simulation
+}
@@ -34,6 +34,27 @@ func NewSchedulerConfig(profileHandler framework.ProfileHandler, profiles map[st
 type SchedulerConfig struct {
 	profileHandler framework.ProfileHandler
 	profiles       map[string]framework.SchedulerProfile
+	shadowProfiles map[string]ShadowProfile
+	processors     []framework.ProfileResultsProcessor
+}
+
+// ShadowProfile is a scheduler profile evaluated on a sample of the requests without affecting routing.
+type ShadowProfile struct {
+	Profile framework.SchedulerProfile
+	// SampleRate is the fraction of the requests, in [0, 1], on which the profile runs.
+	SampleRate float64
+}
+
+// WithShadowProfiles sets the shadow profiles of the scheduler.
+func (c *SchedulerConfig) WithShadowProfiles(shadowProfiles map[string]ShadowProfile) *SchedulerConfig {
+	c.shadowProfiles = shadowProfiles
+	return c
+}
+
+// WithProfileResultsProcessors sets the processors combining the profile results, in execution order.
+func (c *SchedulerConfig) WithProfileResultsProcessors(processors ...framework.ProfileResultsProcessor) *SchedulerConfig {
+	c.processors = processors
+	return c
 }
 
 func (c *SchedulerConfig) String() string {
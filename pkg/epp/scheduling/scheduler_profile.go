@@ -18,17 +18,22 @@ package scheduling
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	errcommmon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
 	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
 	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/pluginquarantine"
 )
 
 // NewSchedulerProfile creates a new SchedulerProfile object and returns its pointer.
@@ -45,6 +50,7 @@ type SchedulerProfile struct {
 	filters []fwksched.Filter
 	scorers []*WeightedScorer
 	picker  fwksched.Picker
+	budget  ProfileBudget
 }
 
 // WithFilters sets the given filter plugins as the Filter plugins.
@@ -94,6 +100,13 @@ func (p *SchedulerProfile) AddPlugins(pluginObjects ...plugin.Plugin) error {
 	return nil
 }
 
+// OnEndpointChange evicts the cached scores of the endpoints added to or deleted from the datastore.
+func (p *SchedulerProfile) OnEndpointChange(ctx context.Context, change fwkdl.EndpointChange) {
+	for _, scorer := range p.scorers {
+		scorer.OnEndpointChange(ctx, change)
+	}
+}
+
 func (p *SchedulerProfile) String() string {
 	filterNames := make([]string, len(p.filters))
 	for i, filter := range p.filters {
@@ -114,29 +127,76 @@ func (p *SchedulerProfile) String() string {
 
 // Run runs a SchedulerProfile. It invokes all the SchedulerProfile plugins for the given request in this
 // order - Filters, Scorers, Picker. After completing all, it returns the result.
-func (p *SchedulerProfile) Run(ctx context.Context, request *fwksched.InferenceRequest, cycleState *fwksched.CycleState, candidateEndpoints []fwksched.Endpoint) (*fwksched.ProfileRunResult, error) {
+func (p *SchedulerProfile) Run(ctx context.Context, request *fwksched.InferenceRequest, cycleState *fwksched.CycleState, candidateEndpoints []fwksched.Endpoint) (result *fwksched.ProfileRunResult, err error) {
+	ctx, span := startSpan(ctx, "gateway.scheduling.profile",
+		attribute.String("profile", profileName(ctx)),
+		attribute.Int("candidate_endpoints", len(candidateEndpoints)),
+	)
+	defer func() {
+		if attr, ok := targetEndpointAttribute("target_endpoint", result); ok {
+			span.SetAttributes(attr)
+		}
+		endSpan(span, err)
+	}()
+
+	runStart := time.Now()
 	endpoints := p.runFilterPlugins(ctx, request, cycleState, candidateEndpoints)
 	if len(endpoints) == 0 {
 		return nil, errcommmon.Error{Code: errcommmon.Internal, Msg: "no endpoints available for the given request"}
 	}
 	// if we got here, there is at least one endpoint to score
-	weightedScorePerEndpoint := p.runScorerPlugins(ctx, request, cycleState, endpoints)
+	weightedScorePerEndpoint, withinBudget := p.runScorerPlugins(ctx, request, cycleState, endpoints, runStart)
+	if !withinBudget {
+		span.SetAttributes(attribute.Bool("budget_exceeded", true))
+		result = p.runFallbackPicker(ctx, cycleState, endpoints)
+		if audit := profileAudit(ctx); audit != nil && result != nil {
+			audit.Picked = endpointNames(result.TargetEndpoints)
+		}
+		return result, nil
+	}
 
-	result := p.runPickerPlugin(ctx, cycleState, weightedScorePerEndpoint)
+	result = p.runPickerPlugin(ctx, request, cycleState, weightedScorePerEndpoint)
+	if audit := profileAudit(ctx); audit != nil && result != nil {
+		audit.Picked = endpointNames(result.TargetEndpoints)
+	}
 
 	return result, nil
 }
 
 func (p *SchedulerProfile) runFilterPlugins(ctx context.Context, request *fwksched.InferenceRequest, cycleState *fwksched.CycleState, endpoints []fwksched.Endpoint) []fwksched.Endpoint {
 	logger := log.FromContext(ctx)
+	profile := profileName(ctx)
 	filteredEndpoints := endpoints
 	logger.V(logutil.DEBUG).Info("Before running filter plugins", "endpoints", filteredEndpoints)
+	audit := profileAudit(ctx)
 
 	for _, filter := range p.filters {
 		logger.V(logutil.VERBOSE).Info("Running filter plugin", "plugin", filter.TypedName())
 		before := time.Now()
-		filteredEndpoints = filter.Filter(ctx, cycleState, request, filteredEndpoints)
-		metrics.RecordPluginProcessingLatency(filterExtensionPoint, filter.TypedName().Type, filter.TypedName().Name, time.Since(before))
+		remaining := len(filteredEndpoints)
+		var filtered []fwksched.Endpoint
+		if err := runPlugin(ctx, filterExtensionPoint, filter.TypedName(), func() error {
+			filtered = filter.Filter(ctx, cycleState, request, filteredEndpoints)
+			return nil
+		}); err != nil {
+			logger.V(logutil.VERBOSE).Info("Skipping filter plugin", "plugin", filter.TypedName(), "reason", err.Error())
+			continue
+		}
+		if audit != nil {
+			audit.Filters = append(audit.Filters, FilterAudit{
+				Plugin:     filter.TypedName().String(),
+				Eliminated: eliminatedEndpoints(filteredEndpoints, filtered),
+			})
+		}
+		filteredEndpoints = filtered
+		trace.SpanFromContext(ctx).AddEvent("filter", trace.WithAttributes(
+			attribute.String("plugin_type", filter.TypedName().Type),
+			attribute.String("plugin_name", filter.TypedName().Name),
+			attribute.Int("endpoints_before", remaining),
+			attribute.Int("endpoints_after", len(filteredEndpoints)),
+		))
+		metrics.RecordProfilePluginProcessingLatency(profile, filterExtensionPoint, filter.TypedName().Type, filter.TypedName().Name, time.Since(before))
+		metrics.RecordPluginFilterEliminatedEndpoints(profile, filter.TypedName().Type, filter.TypedName().Name, remaining-len(filteredEndpoints))
 		logger.V(logutil.DEBUG).Info("Completed running filter plugin successfully", "plugin", filter.TypedName(), "endpoints", filteredEndpoints)
 		if len(filteredEndpoints) == 0 {
 			logger.V(logutil.VERBOSE).Info("Filter eliminated all endpoints", "plugin", filter.TypedName(), "endpointsBefore", len(endpoints))
@@ -148,32 +208,147 @@ func (p *SchedulerProfile) runFilterPlugins(ctx context.Context, request *fwksch
 	return filteredEndpoints
 }
 
-func (p *SchedulerProfile) runScorerPlugins(ctx context.Context, request *fwksched.InferenceRequest, cycleState *fwksched.CycleState, endpoints []fwksched.Endpoint) map[fwksched.Endpoint]float64 {
+// runScorerPlugins runs the scorers and returns the weighted score of each endpoint. It returns false if the budget of
+// the run was exceeded, in which case the scores are incomplete.
+func (p *SchedulerProfile) runScorerPlugins(ctx context.Context, request *fwksched.InferenceRequest, cycleState *fwksched.CycleState, endpoints []fwksched.Endpoint,
+	runStart time.Time) (map[fwksched.Endpoint]float64, bool) {
 	logger := log.FromContext(ctx)
+	profile := profileName(ctx)
 	logger.V(logutil.DEBUG).Info("Before running scorer plugins", "endpoints", endpoints)
 
 	weightedScorePerEndpoint := make(map[fwksched.Endpoint]float64, len(endpoints))
 	for _, endpoint := range endpoints {
 		weightedScorePerEndpoint[endpoint] = float64(0) // initialize weighted score per endpoint with 0 value
 	}
+	skipOptional := skipOptionalScorers(ctx)
+	audit := profileAudit(ctx)
 	// Iterate through each scorer in the chain and accumulate the weighted scores.
 	for _, scorer := range p.scorers {
+		if skipOptional && scorer.Optional() {
+			logger.V(logutil.VERBOSE).Info("Skipping optional scorer plugin under resource pressure", "plugin", scorer.TypedName())
+			continue
+		}
+		timeout, budget := p.scorerTimeout(scorer, runStart)
+		if timeout < 0 {
+			recordBudgetExceeded(ctx, scorer, budget)
+			return weightedScorePerEndpoint, false
+		}
 		logger.V(logutil.VERBOSE).Info("Running scorer plugin", "plugin", scorer.TypedName())
 		before := time.Now()
-		scores := scorer.Score(ctx, cycleState, request, endpoints)
-		metrics.RecordPluginProcessingLatency(scorerExtensionPoint, scorer.TypedName().Type, scorer.TypedName().Name, time.Since(before))
+		scores, completed, err := scoreWithTimeout(ctx, scorer, timeout, cycleState, request, endpoints)
+		if errors.Is(err, pluginquarantine.ErrQuarantined) {
+			logger.V(logutil.VERBOSE).Info("Skipping quarantined scorer plugin", "plugin", scorer.TypedName())
+			continue
+		}
+		metrics.RecordProfilePluginProcessingLatency(profile, scorerExtensionPoint, scorer.TypedName().Type, scorer.TypedName().Name, time.Since(before))
+		if err != nil {
+			logger.V(logutil.VERBOSE).Info("Scorer plugin failed, skipping its scores", "plugin", scorer.TypedName(), "error", err.Error())
+			continue
+		}
+		if !completed {
+			metrics.RecordPluginError(profile, scorerExtensionPoint, scorer.TypedName().Type, scorer.TypedName().Name)
+			recordBudgetExceeded(ctx, scorer, budget)
+			return weightedScorePerEndpoint, false
+		}
+		var scorerAudit *ScorerAudit
+		if audit != nil {
+			audit.Scorers = append(audit.Scorers, ScorerAudit{
+				Plugin: scorer.TypedName().String(),
+				Weight: scorer.Weight(),
+				Scores: make(map[string]float64, len(scores)),
+			})
+			scorerAudit = &audit.Scorers[len(audit.Scorers)-1]
+		}
 		for endpoint, score := range scores { // weight is relative to the sum of weights
 			logger.V(logutil.DEBUG).Info("Calculated score", "plugin", scorer.TypedName(), "endpoint", endpoint.GetMetadata().NamespacedName, "score", score)
 			weightedScorePerEndpoint[endpoint] += enforceScoreRange(score) * scorer.Weight()
+			if scorerAudit != nil {
+				scorerAudit.Scores[endpointName(endpoint)] = enforceScoreRange(score)
+			}
 		}
 		logger.V(logutil.DEBUG).Info("Completed running scorer plugin successfully", "plugin", scorer.TypedName())
 	}
 	logger.V(logutil.VERBOSE).Info("Completed running scorer plugins successfully")
+	applyScoreBias(ctx, weightedScorePerEndpoint)
+	applyStalenessDecay(ctx, weightedScorePerEndpoint)
 
-	return weightedScorePerEndpoint
+	return weightedScorePerEndpoint, true
 }
 
-func (p *SchedulerProfile) runPickerPlugin(ctx context.Context, cycleState *fwksched.CycleState, weightedScorePerEndpoint map[fwksched.Endpoint]float64) *fwksched.ProfileRunResult {
+type scoreBiasKey struct{}
+
+// withScoreBias returns a context carrying the bias applied to the weighted scores of the profiles run with it.
+func withScoreBias(ctx context.Context, bias ScoreBias) context.Context {
+	return context.WithValue(ctx, scoreBiasKey{}, bias)
+}
+
+// applyScoreBias multiplies the weighted scores by the bias of the context, if any.
+func applyScoreBias(ctx context.Context, weightedScorePerEndpoint map[fwksched.Endpoint]float64) {
+	bias, ok := ctx.Value(scoreBiasKey{}).(ScoreBias)
+	if !ok {
+		return
+	}
+	for endpoint, score := range weightedScorePerEndpoint {
+		if multiplier := bias.Multiplier(endpoint.GetMetadata()); multiplier != 1 {
+			log.FromContext(ctx).V(logutil.DEBUG).Info("Applied score bias", "endpoint", endpoint.GetMetadata().NamespacedName,
+				"score", score, "multiplier", multiplier)
+			weightedScorePerEndpoint[endpoint] = score * multiplier
+		}
+	}
+}
+
+type skipOptionalScorersKey struct{}
+
+// withSkipOptionalScorers returns a context instructing profiles to skip optional scorers.
+func withSkipOptionalScorers(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipOptionalScorersKey{}, true)
+}
+
+func skipOptionalScorers(ctx context.Context) bool {
+	skip, _ := ctx.Value(skipOptionalScorersKey{}).(bool)
+	return skip
+}
+
+type profileNameKey struct{}
+
+// withProfileName returns a context carrying the name of the profile run with it, to label the plugin metrics of the
+// run.
+func withProfileName(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, profileNameKey{}, name)
+}
+
+func profileName(ctx context.Context) string {
+	name, _ := ctx.Value(profileNameKey{}).(string)
+	return name
+}
+
+type pluginBreakerKey struct{}
+
+// withPluginBreaker returns a context carrying the breaker the plugins of the profiles run with it are run through.
+func withPluginBreaker(ctx context.Context, breaker *pluginquarantine.Breaker) context.Context {
+	return context.WithValue(ctx, pluginBreakerKey{}, breaker)
+}
+
+// runPlugin runs the given plugin function through the breaker of the context, if any, so that its panics are
+// recovered and the plugin is skipped while quarantined. Failed runs are recorded in the plugin error metrics, and each
+// run is traced in its own span.
+func runPlugin(ctx context.Context, extensionPoint string, typedName plugin.TypedName, run func() error) (err error) {
+	ctx, span := startSpan(ctx, "gateway.scheduling.plugin",
+		attribute.String("extension_point", extensionPoint),
+		attribute.String("plugin_type", typedName.Type),
+		attribute.String("plugin_name", typedName.Name),
+	)
+	defer func() { endSpan(span, err) }()
+
+	breaker, _ := ctx.Value(pluginBreakerKey{}).(*pluginquarantine.Breaker)
+	err = breaker.Run(ctx, extensionPoint, typedName, run)
+	if err != nil && !errors.Is(err, pluginquarantine.ErrQuarantined) {
+		metrics.RecordPluginError(profileName(ctx), extensionPoint, typedName.Type, typedName.Name)
+	}
+	return err
+}
+
+func (p *SchedulerProfile) runPickerPlugin(ctx context.Context, request *fwksched.InferenceRequest, cycleState *fwksched.CycleState, weightedScorePerEndpoint map[fwksched.Endpoint]float64) *fwksched.ProfileRunResult {
 	logger := log.FromContext(ctx)
 	scoredEndpoints := make([]*fwksched.ScoredEndpoint, len(weightedScorePerEndpoint))
 	i := 0
@@ -184,9 +359,32 @@ func (p *SchedulerProfile) runPickerPlugin(ctx context.Context, cycleState *fwks
 	logger.V(logutil.VERBOSE).Info("Running picker plugin", "plugin", p.picker.TypedName())
 	logger.V(logutil.DEBUG).Info("Candidate pods for picking", "endpoints-weighted-score", scoredEndpoints)
 	before := time.Now()
-	result := p.picker.Pick(ctx, cycleState, scoredEndpoints)
-	metrics.RecordPluginProcessingLatency(pickerExtensionPoint, p.picker.TypedName().Type, p.picker.TypedName().Name, time.Since(before))
+	var result *fwksched.ProfileRunResult
+	if err := runPlugin(ctx, pickerExtensionPoint, p.picker.TypedName(), func() error {
+		if requestAwarePicker, ok := p.picker.(fwksched.RequestAwarePicker); ok {
+			result = requestAwarePicker.PickForRequest(ctx, cycleState, request, scoredEndpoints)
+		} else {
+			result = p.picker.Pick(ctx, cycleState, scoredEndpoints)
+		}
+		return nil
+	}); err != nil {
+		logger.V(logutil.VERBOSE).Info("Picker plugin failed, running the fallback picker", "plugin", p.picker.TypedName(), "reason", err.Error())
+		endpoints := make([]fwksched.Endpoint, len(scoredEndpoints))
+		for i, scored := range scoredEndpoints {
+			endpoints[i] = scored.Endpoint
+		}
+		return p.runFallbackPicker(ctx, cycleState, endpoints)
+	}
+	profile := profileName(ctx)
+	metrics.RecordProfilePluginProcessingLatency(profile, pickerExtensionPoint, p.picker.TypedName().Type, p.picker.TypedName().Name, time.Since(before))
+	if result == nil || len(result.TargetEndpoints) == 0 {
+		metrics.RecordPluginError(profile, pickerExtensionPoint, p.picker.TypedName().Type, p.picker.TypedName().Name)
+	}
 	logger.V(logutil.DEBUG).Info("Completed running picker plugin successfully", "plugin", p.picker.TypedName(), "result", result)
+	if gap, entropy, ok := decisionConfidence(weightedScorePerEndpoint); ok && !isSimulation(ctx) {
+		logger.V(logutil.DEBUG).Info("Scheduling decision confidence", "scoreGap", gap, "scoreEntropy", entropy)
+		metrics.RecordSchedulingConfidence(profile, gap, entropy)
+	}
 
 	return result
 }
@@ -20,6 +20,7 @@ import (
 	"context"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
@@ -28,6 +29,7 @@ import (
 	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
 	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
 	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/pluginquarantine"
 )
 
 func TestSchedulePlugins(t *testing.T) {
@@ -412,6 +414,195 @@ func TestRunWithOutOfRangeScores(t *testing.T) {
 	}
 }
 
+func TestRunSkipsOptionalScorersUnderPressure(t *testing.T) {
+	requiredScorer := &testPlugin{TypeRes: "required", ScoreRes: 0.5}
+	optionalScorer := &testPlugin{TypeRes: "optional", ScoreRes: 0.25}
+	pickerPlugin := &testPlugin{
+		TypeRes: "picker",
+		PickRes: k8stypes.NamespacedName{Name: "pod1"},
+	}
+
+	profile := NewSchedulerProfile().
+		WithScorers(NewWeightedScorer(requiredScorer, 1), NewWeightedScorer(optionalScorer, 1).WithOptional(true)).
+		WithPicker(pickerPlugin)
+
+	input := []fwksched.Endpoint{
+		fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "pod1"}}, nil, nil),
+	}
+	request := &fwksched.InferenceRequest{
+		TargetModel: "test-model",
+		RequestId:   uuid.NewString(),
+	}
+
+	_, err := profile.Run(context.Background(), request, fwksched.NewCycleState(), input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pickerPlugin.WinnerEndpointScore != 0.75 {
+		t.Errorf("expected winner score 0.75 without pressure, got %v", pickerPlugin.WinnerEndpointScore)
+	}
+
+	_, err = profile.Run(withSkipOptionalScorers(context.Background()), request, fwksched.NewCycleState(), input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pickerPlugin.WinnerEndpointScore != 0.5 {
+		t.Errorf("expected winner score 0.5 under pressure, got %v", pickerPlugin.WinnerEndpointScore)
+	}
+	if optionalScorer.ScoreCallCount != 1 {
+		t.Errorf("expected optional scorer to be called once, got %d", optionalScorer.ScoreCallCount)
+	}
+}
+
+// biasFunc adapts a function to the ScoreBias interface.
+type biasFunc func(endpoint *fwkdl.EndpointMetadata) float64
+
+func (f biasFunc) Multiplier(endpoint *fwkdl.EndpointMetadata) float64 {
+	return f(endpoint)
+}
+
+func TestRunAppliesScoreBias(t *testing.T) {
+	scorer := &testPlugin{TypeRes: "scorer", ScoreRes: 0.5}
+	pickerPlugin := &testPlugin{
+		TypeRes: "picker",
+		PickRes: k8stypes.NamespacedName{Name: "pod1"},
+	}
+
+	profile := NewSchedulerProfile().
+		WithScorers(NewWeightedScorer(scorer, 2)).
+		WithPicker(pickerPlugin)
+
+	input := []fwksched.Endpoint{
+		fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "pod1"}}, nil, nil),
+		fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "pod2"}}, nil, nil),
+	}
+	request := &fwksched.InferenceRequest{
+		TargetModel: "test-model",
+		RequestId:   uuid.NewString(),
+	}
+	bias := biasFunc(func(endpoint *fwkdl.EndpointMetadata) float64 {
+		if endpoint.NamespacedName.Name == "pod1" {
+			return 3
+		}
+		return 1
+	})
+
+	_, err := profile.Run(withScoreBias(context.Background(), bias), request, fwksched.NewCycleState(), input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// weighted score 0.5*2 = 1, biased by 3
+	if pickerPlugin.WinnerEndpointScore != 3.0 {
+		t.Errorf("expected biased winner score 3.0, got %v", pickerPlugin.WinnerEndpointScore)
+	}
+	if pickerPlugin.NumOfPickerCandidates != 2 {
+		t.Errorf("expected the bias not to filter endpoints, got %d picker candidates", pickerPlugin.NumOfPickerCandidates)
+	}
+}
+
+// panickingPlugin is a filter, scorer and picker panicking on every run.
+type panickingPlugin struct {
+	testPlugin
+	calls int
+}
+
+func (p *panickingPlugin) Filter(_ context.Context, _ *fwksched.CycleState, _ *fwksched.InferenceRequest, _ []fwksched.Endpoint) []fwksched.Endpoint {
+	p.calls++
+	panic("filter bug")
+}
+
+func (p *panickingPlugin) Score(_ context.Context, _ *fwksched.CycleState, _ *fwksched.InferenceRequest, _ []fwksched.Endpoint) map[fwksched.Endpoint]float64 {
+	p.calls++
+	panic("scorer bug")
+}
+
+func (p *panickingPlugin) Pick(_ context.Context, _ *fwksched.CycleState, _ []*fwksched.ScoredEndpoint) *fwksched.ProfileRunResult {
+	p.calls++
+	panic("picker bug")
+}
+
+func TestRunIsolatesPanickingPlugins(t *testing.T) {
+	pod1 := k8stypes.NamespacedName{Name: "pod1"}
+	input := []fwksched.Endpoint{fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: pod1}, nil, nil)}
+	request := &fwksched.InferenceRequest{TargetModel: "test-model", RequestId: uuid.NewString()}
+
+	filter := &panickingPlugin{testPlugin: testPlugin{typedName: fwkplugin.TypedName{Type: "buggy", Name: "filter"}}}
+	scorer := &panickingPlugin{testPlugin: testPlugin{typedName: fwkplugin.TypedName{Type: "buggy", Name: "scorer"}}}
+	healthyScorer := &testPlugin{TypeRes: "healthy", ScoreRes: 0.5}
+	picker := &panickingPlugin{testPlugin: testPlugin{typedName: fwkplugin.TypedName{Type: "buggy", Name: "picker"}}}
+	profile := NewSchedulerProfile().
+		WithFilters(filter).
+		WithScorers(NewWeightedScorer(scorer, 1), NewWeightedScorer(healthyScorer, 1)).
+		WithPicker(picker)
+
+	// The panicking plugins are skipped, the picker falling back to a random pick, while the rest of the chain runs.
+	breaker := pluginquarantine.NewBreaker(2, time.Minute)
+	ctx := withPluginBreaker(context.Background(), breaker)
+	for range 3 {
+		result, err := profile.Run(ctx, request, fwksched.NewCycleState(), input)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(result.TargetEndpoints) != 1 || result.TargetEndpoints[0].GetMetadata().NamespacedName != pod1 {
+			t.Fatalf("expected pod1 to be picked, got %v", result.TargetEndpoints)
+		}
+	}
+	if healthyScorer.ScoreCallCount != 3 {
+		t.Errorf("expected the healthy scorer to run 3 times, got %d", healthyScorer.ScoreCallCount)
+	}
+
+	// After 2 failed runs the panicking plugins are quarantined, and no longer run.
+	for _, plugin := range []*panickingPlugin{filter, scorer, picker} {
+		if plugin.calls != 2 {
+			t.Errorf("expected plugin %s to run 2 times, got %d", plugin.TypedName(), plugin.calls)
+		}
+	}
+	if quarantines := breaker.List(); len(quarantines) != 3 || quarantines[0].QuarantinedUntil.IsZero() {
+		t.Errorf("expected 3 quarantined plugins, got %+v", quarantines)
+	}
+
+	// Without a breaker the panics are still recovered.
+	if _, err := profile.Run(context.Background(), request, fwksched.NewCycleState(), input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+type requestAwarePicker struct {
+	testPlugin
+	pickedFor *fwksched.InferenceRequest
+}
+
+func (p *requestAwarePicker) PickForRequest(ctx context.Context, cycleState *fwksched.CycleState, request *fwksched.InferenceRequest, scoredEndpoints []*fwksched.ScoredEndpoint) *fwksched.ProfileRunResult {
+	p.pickedFor = request
+	return p.Pick(ctx, cycleState, scoredEndpoints)
+}
+
+func TestRunPassesRequestToRequestAwarePicker(t *testing.T) {
+	pickerPlugin := &requestAwarePicker{testPlugin: testPlugin{
+		TypeRes: "picker",
+		PickRes: k8stypes.NamespacedName{Name: "pod1"},
+	}}
+	profile := NewSchedulerProfile().WithPicker(pickerPlugin)
+
+	input := []fwksched.Endpoint{
+		fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "pod1"}}, nil, nil),
+	}
+	request := &fwksched.InferenceRequest{
+		TargetModel: "test-model",
+		RequestId:   uuid.NewString(),
+	}
+
+	if _, err := profile.Run(context.Background(), request, fwksched.NewCycleState(), input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pickerPlugin.pickedFor != request {
+		t.Errorf("expected PickForRequest to be called with the request, got %v", pickerPlugin.pickedFor)
+	}
+	if pickerPlugin.PickCallCount != 1 {
+		t.Errorf("expected picker to be called once, got %d", pickerPlugin.PickCallCount)
+	}
+}
+
 // TestFilterExecutionOrder verifies that filters execute in the order they are
 // registered in the scheduling profile. See also TestFilterExecutionOrderFromYAML
 // in pkg/epp/config/loader which verifies that YAML declaration order is preserved
@@ -157,3 +157,85 @@ func TestSchedule(t *testing.T) {
 		})
 	}
 }
+
+// fixedProfile is a SchedulerProfile always picking the same endpoint.
+type fixedProfile struct {
+	target fwksched.Endpoint
+	runs   int
+}
+
+func (p *fixedProfile) Run(_ context.Context, _ *fwksched.InferenceRequest, _ *fwksched.CycleState, _ []fwksched.Endpoint) (*fwksched.ProfileRunResult, error) {
+	p.runs++
+	return &fwksched.ProfileRunResult{TargetEndpoints: []fwksched.Endpoint{p.target}}, nil
+}
+
+func TestScheduleShadowProfiles(t *testing.T) {
+	pod1 := fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "pod1"}}, nil, nil)
+	pod2 := fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "pod2"}}, nil, nil)
+	primary := &fixedProfile{target: pod1}
+	shadow := &fixedProfile{target: pod2}
+	unsampled := &fixedProfile{target: pod2}
+
+	scheduler := NewSchedulerWithConfig(NewSchedulerConfig(profile.NewSingleProfileHandler(),
+		map[string]fwksched.SchedulerProfile{"default": primary}).
+		WithShadowProfiles(map[string]ShadowProfile{
+			"shadow":    {Profile: shadow, SampleRate: 1},
+			"unsampled": {Profile: unsampled, SampleRate: 0},
+		}))
+
+	result, err := scheduler.Schedule(context.Background(), &fwksched.InferenceRequest{RequestId: uuid.NewString()},
+		[]fwksched.Endpoint{pod1, pod2})
+	assert.NoError(t, err)
+	assert.Equal(t, "default", result.PrimaryProfileName)
+	assert.Len(t, result.ProfileResults, 1, "shadow profiles must not be part of the scheduling result")
+	assert.Equal(t, pod1, result.ProfileResults["default"].TargetEndpoints[0])
+	assert.Equal(t, 1, shadow.runs)
+	assert.Equal(t, 0, unsampled.runs)
+}
+
+func TestScheduleProfileResultsProcessors(t *testing.T) {
+	pod1 := fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "pod1"}}, nil, nil)
+	pod2 := fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "pod2"}}, nil, nil)
+	primary := &fixedProfile{target: pod1}
+	fallback := &fixedProfile{target: pod2}
+
+	scheduler := NewSchedulerWithConfig(NewSchedulerConfig(
+		profile.NewFanOutProfileHandler(profile.FanOutProfileHandlerParameters{PrimaryProfile: "primary"}),
+		map[string]fwksched.SchedulerProfile{"primary": primary, "fallback": fallback}).
+		WithProfileResultsProcessors(profile.NewAppendTargetsProcessor(profile.AppendTargetsProcessorParameters{
+			Profiles: []string{"fallback"},
+		})))
+
+	result, err := scheduler.Schedule(context.Background(), &fwksched.InferenceRequest{RequestId: uuid.NewString()},
+		[]fwksched.Endpoint{pod1, pod2})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, primary.runs)
+	assert.Equal(t, 1, fallback.runs)
+	assert.Equal(t, "primary", result.PrimaryProfileName)
+	assert.Equal(t, []fwksched.Endpoint{pod1, pod2}, result.ProfileResults["primary"].TargetEndpoints)
+	assert.Equal(t, []fwksched.Endpoint{pod2}, result.ProfileResults["fallback"].TargetEndpoints)
+}
+
+func TestScheduleForcedProfile(t *testing.T) {
+	pod1 := fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "pod1"}}, nil, nil)
+	pod2 := fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "pod2"}}, nil, nil)
+	primary := &fixedProfile{target: pod1}
+	secondary := &fixedProfile{target: pod2}
+	scheduler := NewSchedulerWithConfig(NewSchedulerConfig(
+		profile.NewFanOutProfileHandler(profile.FanOutProfileHandlerParameters{PrimaryProfile: "primary"}),
+		map[string]fwksched.SchedulerProfile{"primary": primary, "secondary": secondary}))
+
+	// The forced profile is the only one run, and becomes the primary profile.
+	request := &fwksched.InferenceRequest{RequestId: uuid.NewString(), Hints: fwksched.SchedulingHints{Profile: "secondary"}}
+	result, err := scheduler.Schedule(context.Background(), request, []fwksched.Endpoint{pod1, pod2})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, primary.runs)
+	assert.Equal(t, 1, secondary.runs)
+	assert.Equal(t, "secondary", result.PrimaryProfileName)
+	assert.Equal(t, []fwksched.Endpoint{pod2}, result.ProfileResults["secondary"].TargetEndpoints)
+
+	// An unknown forced profile fails the scheduling.
+	request = &fwksched.InferenceRequest{RequestId: uuid.NewString(), Hints: fwksched.SchedulingHints{Profile: "unknown"}}
+	_, err = scheduler.Schedule(context.Background(), request, []fwksched.Endpoint{pod1, pod2})
+	assert.Error(t, err)
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+// maxScoreCacheEntries bounds the number of entries of a score cache. The cache is emptied once reached, which only
+// happens when request classes churn, since there is one entry per endpoint and request class and the entries of the
+// endpoints leaving the pool are evicted.
+const maxScoreCacheEntries = 100_000
+
+type scoreCacheEntry struct {
+	// metricsUpdateTime is the update time of the endpoint metrics the score was computed from.
+	metricsUpdateTime time.Time
+	score             float64
+}
+
+// scoreCache caches the scores of a cacheable scorer by endpoint and request class. A score is valid until the metrics
+// of its endpoint are updated, i.e. until the next scrape of the endpoint, so that the scorer only scores each
+// endpoint once per scrape interval and request class rather than at each cycle. The scores of an endpoint are evicted
+// when it is added to or deleted from the datastore, so that an endpoint recreated under the same name is rescored.
+type scoreCache struct {
+	scorer fwksched.CacheableScorer
+	mu     sync.Mutex
+	// entries holds the entries by endpoint and request class.
+	entries map[types.NamespacedName]map[string]scoreCacheEntry
+	size    int
+}
+
+var _ endpointChangeListener = &scoreCache{}
+
+func newScoreCache(scorer fwksched.CacheableScorer) *scoreCache {
+	return &scoreCache{scorer: scorer, entries: map[types.NamespacedName]map[string]scoreCacheEntry{}}
+}
+
+// OnEndpointChange evicts the scores of the endpoints added to or deleted from the datastore.
+func (c *scoreCache) OnEndpointChange(_ context.Context, change fwkdl.EndpointChange) {
+	if change.Type == fwkdl.EndpointUpdated || change.Endpoint == nil || change.Endpoint.GetMetadata() == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	name := change.Endpoint.GetMetadata().NamespacedName
+	c.size -= len(c.entries[name])
+	delete(c.entries, name)
+}
+
+// score returns the cached scores of the given endpoints, scoring the endpoints whose score is missing or stale.
+// Endpoints whose metrics were never updated are always scored.
+func (c *scoreCache) score(ctx context.Context, cycleState *fwksched.CycleState, request *fwksched.InferenceRequest,
+	endpoints []fwksched.Endpoint) map[fwksched.Endpoint]float64 {
+	class := c.scorer.RequestClass(request)
+	scores := make(map[fwksched.Endpoint]float64, len(endpoints))
+	misses := make([]fwksched.Endpoint, 0, len(endpoints))
+
+	c.mu.Lock()
+	for _, endpoint := range endpoints {
+		updateTime, ok := metricsUpdateTime(endpoint)
+		if !ok {
+			misses = append(misses, endpoint)
+			continue
+		}
+		entry, ok := c.entries[endpoint.GetMetadata().NamespacedName][class]
+		if !ok || !entry.metricsUpdateTime.Equal(updateTime) {
+			misses = append(misses, endpoint)
+			continue
+		}
+		scores[endpoint] = entry.score
+	}
+	c.mu.Unlock()
+
+	typedName := c.scorer.TypedName()
+	metrics.RecordScorerCacheLookups(typedName.Type, typedName.Name, len(endpoints)-len(misses), len(misses))
+	if len(misses) == 0 {
+		return scores
+	}
+	missScores := c.scorer.Score(ctx, cycleState, request, misses)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, endpoint := range misses {
+		score, ok := missScores[endpoint]
+		if !ok {
+			continue
+		}
+		scores[endpoint] = score
+		updateTime, ok := metricsUpdateTime(endpoint)
+		if !ok {
+			continue
+		}
+		if c.size >= maxScoreCacheEntries {
+			c.entries = map[types.NamespacedName]map[string]scoreCacheEntry{}
+			c.size = 0
+		}
+		name := endpoint.GetMetadata().NamespacedName
+		classes, ok := c.entries[name]
+		if !ok {
+			classes = map[string]scoreCacheEntry{}
+			c.entries[name] = classes
+		}
+		if _, ok := classes[class]; !ok {
+			c.size++
+		}
+		classes[class] = scoreCacheEntry{metricsUpdateTime: updateTime, score: score}
+	}
+	return scores
+}
+
+// metricsUpdateTime returns the update time of the metrics of the given endpoint, false if they were never updated.
+func metricsUpdateTime(endpoint fwksched.Endpoint) (time.Time, bool) {
+	if endpoint.GetMetadata() == nil || endpoint.GetMetrics() == nil || endpoint.GetMetrics().UpdateTime.IsZero() {
+		return time.Time{}, false
+	}
+	return endpoint.GetMetrics().UpdateTime, true
+}
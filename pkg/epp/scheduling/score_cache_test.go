@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+// countingScorer is a cacheable scorer recording the endpoints it scores, whose score is the KV cache usage of the
+// endpoints and whose request class is the target model.
+type countingScorer struct {
+	scored []string
+}
+
+func (s *countingScorer) TypedName() fwkplugin.TypedName {
+	return fwkplugin.TypedName{Type: "counting", Name: "counting"}
+}
+
+func (s *countingScorer) Category() fwksched.ScorerCategory {
+	return fwksched.Distribution
+}
+
+func (s *countingScorer) RequestClass(request *fwksched.InferenceRequest) string {
+	return request.TargetModel
+}
+
+func (s *countingScorer) Score(_ context.Context, _ *fwksched.CycleState, _ *fwksched.InferenceRequest, endpoints []fwksched.Endpoint) map[fwksched.Endpoint]float64 {
+	scores := make(map[fwksched.Endpoint]float64, len(endpoints))
+	for _, endpoint := range endpoints {
+		s.scored = append(s.scored, endpoint.GetMetadata().NamespacedName.Name)
+		scores[endpoint] = endpoint.GetMetrics().KVCacheUsagePercent
+	}
+	return scores
+}
+
+func TestScoreCache(t *testing.T) {
+	scrape := time.Now()
+	endpoint := func(name string, usage float64, updateTime time.Time) fwksched.Endpoint {
+		return fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: name}},
+			&fwkdl.Metrics{KVCacheUsagePercent: usage, UpdateTime: updateTime}, nil)
+	}
+	scoresByName := func(scores map[fwksched.Endpoint]float64) map[string]float64 {
+		res := map[string]float64{}
+		for endpoint, score := range scores {
+			res[endpoint.GetMetadata().NamespacedName.Name] = score
+		}
+		return res
+	}
+	scorer := &countingScorer{}
+	weighted := NewWeightedScorer(scorer, 1)
+	request := &fwksched.InferenceRequest{TargetModel: "model-a"}
+	score := func(endpoints ...fwksched.Endpoint) map[string]float64 {
+		scorer.scored = nil
+		return scoresByName(weighted.Score(context.Background(), nil, request, endpoints))
+	}
+
+	got := score(endpoint("pod1", 0.1, scrape), endpoint("pod2", 0.2, scrape))
+	assert.Equal(t, map[string]float64{"pod1": 0.1, "pod2": 0.2}, got)
+	assert.ElementsMatch(t, []string{"pod1", "pod2"}, scorer.scored)
+
+	// The endpoints are snapshots of the datastore endpoints at each cycle; the scores are cached while their
+	// metrics are not updated.
+	got = score(endpoint("pod1", 0.1, scrape), endpoint("pod2", 0.2, scrape))
+	assert.Equal(t, map[string]float64{"pod1": 0.1, "pod2": 0.2}, got)
+	assert.Empty(t, scorer.scored)
+
+	got = score(endpoint("pod1", 0.1, scrape), endpoint("pod2", 0.5, scrape.Add(time.Second)))
+	assert.Equal(t, map[string]float64{"pod1": 0.1, "pod2": 0.5}, got, "updated metrics invalidate the score")
+	assert.Equal(t, []string{"pod2"}, scorer.scored)
+
+	request = &fwksched.InferenceRequest{TargetModel: "model-b"}
+	score(endpoint("pod1", 0.1, scrape))
+	assert.Equal(t, []string{"pod1"}, scorer.scored, "scores are cached by request class")
+
+	score(endpoint("pod3", 0.3, time.Time{}))
+	score(endpoint("pod3", 0.3, time.Time{}))
+	assert.Equal(t, []string{"pod3"}, scorer.scored, "endpoints never scraped are not cached")
+
+	// An endpoint deleted and re-added under the same name is rescored, even with the same metrics update time.
+	deleted := endpoint("pod1", 0.1, scrape)
+	weighted.OnEndpointChange(context.Background(), fwkdl.EndpointChange{Type: fwkdl.EndpointDeleted,
+		Endpoint: fwkdl.NewEndpoint(deleted.GetMetadata(), nil)})
+	score(endpoint("pod1", 0.1, scrape))
+	assert.Equal(t, []string{"pod1"}, scorer.scored, "deleted endpoints are evicted")
+	weighted.OnEndpointChange(context.Background(), fwkdl.EndpointChange{Type: fwkdl.EndpointAdded,
+		Endpoint: fwkdl.NewEndpoint(deleted.GetMetadata(), nil)})
+	got = score(endpoint("pod1", 0.7, scrape))
+	assert.Equal(t, map[string]float64{"pod1": 0.7}, got, "added endpoints are evicted")
+	assert.Equal(t, []string{"pod1"}, scorer.scored)
+	weighted.OnEndpointChange(context.Background(), fwkdl.EndpointChange{Type: fwkdl.EndpointUpdated,
+		Endpoint: fwkdl.NewEndpoint(deleted.GetMetadata(), nil)})
+	score(endpoint("pod1", 0.7, scrape))
+	assert.Empty(t, scorer.scored, "updated endpoints are kept, their scores are invalidated by their metrics")
+
+	// Scorers that are not cacheable are always run.
+	uncached := NewWeightedScorer(&slowScorer{}, 1)
+	assert.Nil(t, uncached.cache)
+}
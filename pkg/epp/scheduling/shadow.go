@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"math/rand/v2"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+const (
+	shadowResultAgree    = "agree"
+	shadowResultDisagree = "disagree"
+	shadowResultError    = "error"
+)
+
+// runShadowProfiles runs the shadow profiles sampled for the request, and compares their decisions with the decision
+// of the primary profile. The profiles run with their own cycle state and their results are discarded, so that they
+// never affect the routing of the request. They run before the request is dispatched, as the request may be mutated
+// afterwards, so their cost adds to the scheduling latency of the sampled requests.
+func (s *Scheduler) runShadowProfiles(ctx context.Context, request *framework.InferenceRequest,
+	candidateEndpoints []framework.Endpoint, result *framework.SchedulingResult) {
+	if len(s.shadowProfiles) == 0 {
+		return
+	}
+	primary := primaryTarget(result)
+	for name, shadow := range s.shadowProfiles {
+		if shadow.SampleRate < 1 && rand.Float64() >= shadow.SampleRate {
+			continue
+		}
+		runShadowProfile(ctx, name, shadow.Profile, request, candidateEndpoints, primary)
+	}
+}
+
+func runShadowProfile(ctx context.Context, name string, profile framework.SchedulerProfile,
+	request *framework.InferenceRequest, candidateEndpoints []framework.Endpoint, primary framework.Endpoint) {
+	logger := log.FromContext(ctx).V(logutil.DEBUG).WithValues("shadowProfile", name)
+	runResult, err := profile.Run(withProfileName(ctx, name), request, framework.NewCycleState(), candidateEndpoints)
+	if err != nil || runResult == nil || len(runResult.TargetEndpoints) == 0 {
+		metrics.RecordShadowProfileDecision(name, shadowResultError)
+		logger.Info("Shadow profile failed to pick an endpoint", "error", err)
+		return
+	}
+
+	target := runResult.TargetEndpoints[0]
+	result := shadowResultDisagree
+	if primary != nil && endpointName(target) == endpointName(primary) {
+		result = shadowResultAgree
+	}
+	metrics.RecordShadowProfileDecision(name, result)
+
+	keysAndValues := []any{"result", result, "endpoint", endpointName(target), "primaryEndpoint", endpointName(primary)}
+	if scored, ok := target.(*framework.ScoredEndpoint); ok {
+		keysAndValues = append(keysAndValues, "score", scored.Score)
+	}
+	logger.Info("Shadow profile decision", keysAndValues...)
+}
+
+// primaryTarget returns the first target endpoint of the primary profile, or nil.
+func primaryTarget(result *framework.SchedulingResult) framework.Endpoint {
+	primary := result.ProfileResults[result.PrimaryProfileName]
+	if primary == nil || len(primary.TargetEndpoints) == 0 {
+		return nil
+	}
+	return primary.TargetEndpoints[0]
+}
+
+func endpointName(endpoint framework.Endpoint) string {
+	if endpoint == nil || endpoint.GetMetadata() == nil {
+		return ""
+	}
+	return endpoint.GetMetadata().NamespacedName.String()
+}
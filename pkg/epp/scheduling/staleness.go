@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	attrstaleness "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/attribute/staleness"
+)
+
+// StaleMetricsAction is what the scheduler does with the candidate endpoints whose metrics are stale.
+type StaleMetricsAction string
+
+const (
+	// StaleMetricsKeep schedules on stale metrics as on fresh ones. Their age is still exposed to the plugins.
+	StaleMetricsKeep StaleMetricsAction = "keep"
+	// StaleMetricsExclude removes the endpoints whose metrics are stale from the candidates, unless all of them are.
+	StaleMetricsExclude StaleMetricsAction = "exclude"
+	// StaleMetricsDecay halves the weighted score of the endpoints whose metrics are stale for every staleness
+	// threshold elapsed past the threshold.
+	StaleMetricsDecay StaleMetricsAction = "decay"
+	// StaleMetricsOptimistic replaces the queue and KV cache metrics of the endpoints whose metrics are stale with
+	// those of an idle endpoint.
+	StaleMetricsOptimistic StaleMetricsAction = "optimistic"
+)
+
+// minStaleMetricsDecay is the lowest multiplier of the weighted score of the endpoints whose metrics are stale, applied
+// to the endpoints never scraped.
+const minStaleMetricsDecay = 1.0 / 64
+
+// ParseStaleMetricsAction returns the stale metrics action of the given name.
+func ParseStaleMetricsAction(name string) (StaleMetricsAction, error) {
+	switch action := StaleMetricsAction(name); action {
+	case StaleMetricsKeep, StaleMetricsExclude, StaleMetricsDecay, StaleMetricsOptimistic:
+		return action, nil
+	default:
+		return "", fmt.Errorf("unknown stale metrics action %q, must be one of %q, %q, %q or %q", name,
+			StaleMetricsKeep, StaleMetricsExclude, StaleMetricsDecay, StaleMetricsOptimistic)
+	}
+}
+
+// StalenessPolicy tracks the age of the metrics of the candidate endpoints of each request, and applies its action to
+// the endpoints whose last successful scrape is older than its threshold.
+type StalenessPolicy struct {
+	threshold time.Duration
+	action    StaleMetricsAction
+	now       func() time.Time
+}
+
+// NewStalenessPolicy creates a new staleness policy applying the given action to the endpoints whose metrics are older
+// than the given threshold.
+func NewStalenessPolicy(threshold time.Duration, action StaleMetricsAction) *StalenessPolicy {
+	return &StalenessPolicy{
+		threshold: threshold,
+		action:    action,
+		now:       time.Now,
+	}
+}
+
+// apply puts the age of their metrics on the given endpoints, and applies the action of the policy to the stale ones.
+// It returns the candidate endpoints to schedule on, and the number of stale endpoints.
+func (p *StalenessPolicy) apply(ctx context.Context, endpoints []framework.Endpoint) ([]framework.Endpoint, int) {
+	now := p.now()
+	fresh := make([]framework.Endpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		age := p.age(endpoint.GetMetrics(), now)
+		endpoint.Put(attrstaleness.MetricsAgeKey, &age)
+		if !age.Stale {
+			fresh = append(fresh, endpoint)
+			continue
+		}
+		if p.action == StaleMetricsOptimistic && endpoint.GetMetrics() != nil {
+			// The metrics of the endpoints are copies owned by the request, so the other requests still see the last
+			// scrape.
+			m := endpoint.GetMetrics()
+			m.WaitingQueueSize = 0
+			m.RunningRequestsSize = 0
+			m.KVCacheUsagePercent = 0
+		}
+	}
+
+	stale := len(endpoints) - len(fresh)
+	if stale == 0 {
+		return endpoints, 0
+	}
+	log.FromContext(ctx).V(logutil.DEBUG).Info("Scheduling on stale endpoint metrics", "action", p.action,
+		"stale", stale, "candidates", len(endpoints))
+	if p.action == StaleMetricsExclude && len(fresh) > 0 {
+		return fresh, stale
+	}
+	return endpoints, stale
+}
+
+// age returns the age of the given metrics at the given time.
+func (p *StalenessPolicy) age(m *fwkdl.Metrics, now time.Time) attrstaleness.MetricsAge {
+	if m == nil || m.UpdateTime.IsZero() {
+		return attrstaleness.MetricsAge{Stale: true}
+	}
+	age := max(now.Sub(m.UpdateTime), 0)
+	return attrstaleness.MetricsAge{LastScrape: m.UpdateTime, Age: age, Stale: age > p.threshold}
+}
+
+// multiplier returns the factor applied to the weighted score of the given endpoint.
+func (p *StalenessPolicy) multiplier(endpoint framework.Endpoint) float64 {
+	if p.action != StaleMetricsDecay {
+		return 1
+	}
+	val, ok := endpoint.Get(attrstaleness.MetricsAgeKey)
+	if !ok {
+		return 1
+	}
+	age, ok := val.(*attrstaleness.MetricsAge)
+	if !ok || !age.Stale {
+		return 1
+	}
+	if age.LastScrape.IsZero() || p.threshold <= 0 {
+		return minStaleMetricsDecay
+	}
+	return max(math.Pow(0.5, age.Age.Seconds()/p.threshold.Seconds()-1), minStaleMetricsDecay)
+}
+
+type stalenessPolicyKey struct{}
+
+// withStalenessPolicy returns a context carrying the staleness policy decaying the weighted scores of the profiles run
+// with it.
+func withStalenessPolicy(ctx context.Context, policy *StalenessPolicy) context.Context {
+	return context.WithValue(ctx, stalenessPolicyKey{}, policy)
+}
+
+// applyStalenessDecay multiplies the weighted scores of the endpoints whose metrics are stale by their decay, if the
+// staleness policy of the context decays them.
+func applyStalenessDecay(ctx context.Context, weightedScorePerEndpoint map[framework.Endpoint]float64) {
+	policy, ok := ctx.Value(stalenessPolicyKey{}).(*StalenessPolicy)
+	if !ok || policy.action != StaleMetricsDecay {
+		return
+	}
+	for endpoint, score := range weightedScorePerEndpoint {
+		if multiplier := policy.multiplier(endpoint); multiplier != 1 {
+			log.FromContext(ctx).V(logutil.DEBUG).Info("Decayed score of stale endpoint metrics",
+				"endpoint", endpoint.GetMetadata().NamespacedName, "score", score, "multiplier", multiplier)
+			weightedScorePerEndpoint[endpoint] = score * multiplier
+		}
+	}
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	attrstaleness "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/attribute/staleness"
+)
+
+func TestParseStaleMetricsAction(t *testing.T) {
+	for _, name := range []string{"keep", "exclude", "decay", "optimistic"} {
+		action, err := ParseStaleMetricsAction(name)
+		require.NoError(t, err)
+		assert.Equal(t, StaleMetricsAction(name), action)
+	}
+	_, err := ParseStaleMetricsAction("drop")
+	assert.Error(t, err)
+}
+
+func TestStalenessPolicy(t *testing.T) {
+	now := time.Now()
+	endpoint := func(name string, updateTime time.Time) fwksched.Endpoint {
+		return fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: name}},
+			&fwkdl.Metrics{WaitingQueueSize: 5, RunningRequestsSize: 3, KVCacheUsagePercent: 0.9, UpdateTime: updateTime}, nil)
+	}
+	names := func(endpoints []fwksched.Endpoint) []string {
+		res := []string{}
+		for _, endpoint := range endpoints {
+			res = append(res, endpoint.GetMetadata().NamespacedName.Name)
+		}
+		return res
+	}
+	metricsAge := func(endpoint fwksched.Endpoint) *attrstaleness.MetricsAge {
+		val, ok := endpoint.Get(attrstaleness.MetricsAgeKey)
+		require.True(t, ok)
+		return val.(*attrstaleness.MetricsAge)
+	}
+
+	tests := []struct {
+		name           string
+		action         StaleMetricsAction
+		endpoints      []fwksched.Endpoint
+		wantCandidates []string
+		wantStale      int
+	}{
+		{
+			name:           "keep",
+			action:         StaleMetricsKeep,
+			endpoints:      []fwksched.Endpoint{endpoint("fresh", now.Add(-time.Second)), endpoint("stale", now.Add(-5*time.Second))},
+			wantCandidates: []string{"fresh", "stale"},
+			wantStale:      1,
+		},
+		{
+			name:   "exclude",
+			action: StaleMetricsExclude,
+			endpoints: []fwksched.Endpoint{endpoint("fresh", now.Add(-time.Second)), endpoint("stale", now.Add(-5*time.Second)),
+				endpoint("never-scraped", time.Time{})},
+			wantCandidates: []string{"fresh"},
+			wantStale:      2,
+		},
+		{
+			name:           "exclude keeps all candidates when all are stale",
+			action:         StaleMetricsExclude,
+			endpoints:      []fwksched.Endpoint{endpoint("stale1", now.Add(-5*time.Second)), endpoint("stale2", now.Add(-3*time.Second))},
+			wantCandidates: []string{"stale1", "stale2"},
+			wantStale:      2,
+		},
+		{
+			name:           "no stale endpoints",
+			action:         StaleMetricsExclude,
+			endpoints:      []fwksched.Endpoint{endpoint("fresh1", now), endpoint("fresh2", now.Add(-2*time.Second))},
+			wantCandidates: []string{"fresh1", "fresh2"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			policy := NewStalenessPolicy(2*time.Second, test.action)
+			policy.now = func() time.Time { return now }
+
+			candidates, stale := policy.apply(context.Background(), test.endpoints)
+			assert.Equal(t, test.wantCandidates, names(candidates))
+			assert.Equal(t, test.wantStale, stale)
+			for _, endpoint := range test.endpoints {
+				age := metricsAge(endpoint)
+				assert.Equal(t, endpoint.GetMetrics().UpdateTime, age.LastScrape)
+				assert.Equal(t, endpoint.GetMetrics().UpdateTime.IsZero() || now.Sub(age.LastScrape) > 2*time.Second, age.Stale)
+			}
+		})
+	}
+}
+
+func TestStalenessPolicy_Optimistic(t *testing.T) {
+	now := time.Now()
+	policy := NewStalenessPolicy(2*time.Second, StaleMetricsOptimistic)
+	policy.now = func() time.Time { return now }
+	fresh := fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "fresh"}},
+		&fwkdl.Metrics{WaitingQueueSize: 5, RunningRequestsSize: 3, KVCacheUsagePercent: 0.9, UpdateTime: now}, nil)
+	stale := fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "stale"}},
+		&fwkdl.Metrics{WaitingQueueSize: 5, RunningRequestsSize: 3, KVCacheUsagePercent: 0.9, UpdateTime: now.Add(-3 * time.Second)}, nil)
+
+	candidates, count := policy.apply(context.Background(), []fwksched.Endpoint{fresh, stale})
+	assert.Len(t, candidates, 2)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, 5, fresh.GetMetrics().WaitingQueueSize)
+	assert.Equal(t, 0.9, fresh.GetMetrics().KVCacheUsagePercent)
+	assert.Equal(t, 0, stale.GetMetrics().WaitingQueueSize)
+	assert.Equal(t, 0, stale.GetMetrics().RunningRequestsSize)
+	assert.Equal(t, 0.0, stale.GetMetrics().KVCacheUsagePercent)
+}
+
+func TestStalenessPolicy_Decay(t *testing.T) {
+	now := time.Now()
+	policy := NewStalenessPolicy(2*time.Second, StaleMetricsDecay)
+	policy.now = func() time.Time { return now }
+	endpoint := func(name string, updateTime time.Time) fwksched.Endpoint {
+		return fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: name}},
+			&fwkdl.Metrics{UpdateTime: updateTime}, nil)
+	}
+	fresh := endpoint("fresh", now.Add(-time.Second))
+	stale := endpoint("stale", now.Add(-4*time.Second))
+	veryStale := endpoint("very-stale", now.Add(-time.Minute))
+	neverScraped := endpoint("never-scraped", time.Time{})
+	_, _ = policy.apply(context.Background(), []fwksched.Endpoint{fresh, stale, veryStale, neverScraped})
+
+	scores := map[fwksched.Endpoint]float64{fresh: 1, stale: 1, veryStale: 1, neverScraped: 1}
+	applyStalenessDecay(withStalenessPolicy(context.Background(), policy), scores)
+	assert.Equal(t, 1.0, scores[fresh])
+	assert.InDelta(t, 0.5, scores[stale], 1e-9)
+	assert.Equal(t, minStaleMetricsDecay, scores[veryStale])
+	assert.Equal(t, minStaleMetricsDecay, scores[neverScraped])
+
+	// Without a decaying policy, the scores are left untouched.
+	scores = map[fwksched.Endpoint]float64{stale: 1}
+	applyStalenessDecay(withStalenessPolicy(context.Background(), NewStalenessPolicy(2*time.Second, StaleMetricsKeep)), scores)
+	assert.Equal(t, 1.0, scores[stale])
+}
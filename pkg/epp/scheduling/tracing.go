@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+const tracerName = "gateway-api-inference-extension/epp/scheduling"
+
+// startSpan starts a span of the scheduling cycle as a child of the span of the context.
+func startSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// endSpan records the error of the traced operation, if any, and ends its span.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// targetEndpointAttribute returns the attribute holding the first target endpoint of the profile run result, if any.
+func targetEndpointAttribute(key string, result *fwksched.ProfileRunResult) (attribute.KeyValue, bool) {
+	if result == nil || len(result.TargetEndpoints) == 0 || result.TargetEndpoints[0].GetMetadata() == nil {
+		return attribute.KeyValue{}, false
+	}
+	return attribute.String(key, result.TargetEndpoints[0].GetMetadata().NamespacedName.String()), true
+}
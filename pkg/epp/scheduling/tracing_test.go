@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+func TestRunTracesPlugins(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	filter := &testPlugin{TypeRes: "filter", FilterRes: []k8stypes.NamespacedName{{Name: "pod1"}}}
+	scorer := &testPlugin{TypeRes: "scorer", ScoreRes: 0.5}
+	picker := &testPlugin{TypeRes: "picker", PickRes: k8stypes.NamespacedName{Name: "pod1"}}
+	profile := NewSchedulerProfile().
+		WithFilters(filter).
+		WithScorers(NewWeightedScorer(scorer, 1)).
+		WithPicker(picker)
+	input := []fwksched.Endpoint{
+		fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "pod1"}}, nil, nil),
+		fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "pod2"}}, nil, nil),
+	}
+	request := &fwksched.InferenceRequest{TargetModel: "test-model", RequestId: uuid.NewString()}
+
+	if _, err := profile.Run(withProfileName(context.Background(), "default"), request, fwksched.NewCycleState(), input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	spans := exporter.GetSpans()
+	var profileSpan tracetest.SpanStub
+	pluginSpans := map[string]tracetest.SpanStub{}
+	for _, span := range spans {
+		switch span.Name {
+		case "gateway.scheduling.profile":
+			profileSpan = span
+		case "gateway.scheduling.plugin":
+			pluginSpans[attributeValue(span.Attributes, "extension_point")] = span
+		}
+	}
+	if profileSpan.Name == "" {
+		t.Fatalf("expected a profile span, got %v", spans)
+	}
+	if got := attributeValue(profileSpan.Attributes, "target_endpoint"); got != "/pod1" {
+		t.Errorf("expected the profile span to record the target endpoint /pod1, got %q", got)
+	}
+	if len(profileSpan.Events) != 1 || profileSpan.Events[0].Name != "filter" {
+		t.Fatalf("expected a filter event on the profile span, got %v", profileSpan.Events)
+	}
+	if got := attributeValue(profileSpan.Events[0].Attributes, "endpoints_after"); got != "1" {
+		t.Errorf("expected the filter to leave 1 endpoint, got %q", got)
+	}
+	for _, extensionPoint := range []string{filterExtensionPoint, scorerExtensionPoint, pickerExtensionPoint} {
+		span, ok := pluginSpans[extensionPoint]
+		if !ok {
+			t.Errorf("expected a span for the %s plugin", extensionPoint)
+			continue
+		}
+		if span.Parent.SpanID() != profileSpan.SpanContext.SpanID() {
+			t.Errorf("expected the %s plugin span to be a child of the profile span", extensionPoint)
+		}
+	}
+}
+
+func attributeValue(attributes []attribute.KeyValue, key string) string {
+	for _, attr := range attributes {
+		if string(attr.Key) == key {
+			return attr.Value.Emit()
+		}
+	}
+	return ""
+}
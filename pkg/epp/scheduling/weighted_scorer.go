@@ -17,24 +17,75 @@ limitations under the License.
 package scheduling
 
 import (
+	"context"
+	"time"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
 	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
 )
 
 // NewWeightedScorer initializes a new WeightedScorer and returns its pointer.
 func NewWeightedScorer(scorer fwksched.Scorer, weight float64) *WeightedScorer {
-	return &WeightedScorer{
+	weighted := &WeightedScorer{
 		Scorer: scorer,
 		weight: weight,
 	}
+	if cacheable, ok := scorer.(fwksched.CacheableScorer); ok {
+		weighted.cache = newScoreCache(cacheable)
+	}
+	return weighted
 }
 
 // WeightedScorer is a struct that encapsulates a scorer with its weight.
 type WeightedScorer struct {
 	fwksched.Scorer
-	weight float64
+	weight   float64
+	optional bool
+	timeout  time.Duration
+	// cache caches the scores of cacheable scorers, nil for the other scorers.
+	cache *scoreCache
+}
+
+// WithOptional marks the scorer as optional. Optional scorers are skipped while the EPP is under resource pressure.
+func (s *WeightedScorer) WithOptional(optional bool) *WeightedScorer {
+	s.optional = optional
+	return s
+}
+
+// Optional returns true if the scorer may be skipped while the EPP is under resource pressure.
+func (s *WeightedScorer) Optional() bool {
+	return s.optional
+}
+
+// WithTimeout sets the time budget of the scorer, overriding the plugin timeout of the profile budget.
+func (s *WeightedScorer) WithTimeout(timeout time.Duration) *WeightedScorer {
+	s.timeout = timeout
+	return s
+}
+
+// Timeout returns the time budget of the scorer, or 0 if the scorer uses the plugin timeout of the profile budget.
+func (s *WeightedScorer) Timeout() time.Duration {
+	return s.timeout
 }
 
 // Weight returns the weight of the scorer.
 func (s *WeightedScorer) Weight() float64 {
 	return s.weight
 }
+
+// Score returns the scores of the given endpoints. The scores of cacheable scorers are served from the score cache
+// while the metrics of the endpoints are not updated.
+func (s *WeightedScorer) Score(ctx context.Context, cycleState *fwksched.CycleState, request *fwksched.InferenceRequest,
+	endpoints []fwksched.Endpoint) map[fwksched.Endpoint]float64 {
+	if s.cache == nil {
+		return s.Scorer.Score(ctx, cycleState, request, endpoints)
+	}
+	return s.cache.score(ctx, cycleState, request, endpoints)
+}
+
+// OnEndpointChange evicts the cached scores of the endpoints added to or deleted from the datastore.
+func (s *WeightedScorer) OnEndpointChange(ctx context.Context, change fwkdl.EndpointChange) {
+	if s.cache != nil {
+		s.cache.OnEndpointChange(ctx, change)
+	}
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package selfpressure monitors the EPP's own CPU and memory usage.
+//
+// When the EPP is resource-starved, every piece of work it does per request adds latency. The Monitor detects this
+// situation and notifies registered listeners, which degrade the EPP in a predictable way (e.g. stretch the metrics
+// scrape interval and skip optional scorers) until the pressure is relieved.
+package selfpressure
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+const (
+	// DefaultCPUThreshold is the default fraction of the available CPU (GOMAXPROCS) above which the EPP is
+	// considered under pressure.
+	DefaultCPUThreshold = 0.85
+	// DefaultMemoryThreshold is the default fraction of the Go memory limit (GOMEMLIMIT) above which the EPP is
+	// considered under pressure.
+	DefaultMemoryThreshold = 0.9
+	// DefaultScrapeStretchFactor is the default factor by which the scrape interval is stretched under pressure.
+	DefaultScrapeStretchFactor = 4
+
+	// checkInterval dictates how often resource usage is sampled.
+	checkInterval = time.Second
+	// recoveryRatio is the fraction of a threshold usage has to fall below before the pressure is considered
+	// relieved. It prevents flapping around the threshold.
+	recoveryRatio = 0.8
+)
+
+// Config configures the Monitor.
+type Config struct {
+	// CPUThreshold is the fraction of the available CPU above which the EPP is considered under pressure.
+	CPUThreshold float64
+	// MemoryThreshold is the fraction of the Go memory limit above which the EPP is considered under pressure.
+	// It has no effect when no memory limit is set.
+	MemoryThreshold float64
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c Config) Validate() error {
+	if c.CPUThreshold <= 0 || c.CPUThreshold > 1 {
+		return fmt.Errorf("CPU threshold must be in (0, 1], got %f", c.CPUThreshold)
+	}
+	if c.MemoryThreshold <= 0 || c.MemoryThreshold > 1 {
+		return fmt.Errorf("memory threshold must be in (0, 1], got %f", c.MemoryThreshold)
+	}
+	return nil
+}
+
+// Usage is a sample of the EPP's resource usage, as fractions of the available resources.
+type Usage struct {
+	CPU    float64
+	Memory float64
+}
+
+// Sampler samples the EPP's resource usage.
+type Sampler interface {
+	Sample() (Usage, error)
+}
+
+// Monitor periodically samples the EPP's resource usage and tracks whether it is under pressure.
+// It is safe for concurrent use.
+type Monitor struct {
+	config  Config
+	sampler Sampler
+
+	underPressure atomic.Bool
+
+	mu        sync.Mutex
+	listeners []func(underPressure bool)
+}
+
+// NewMonitor creates a new Monitor sampling the resource usage of the current process.
+func NewMonitor(config Config) (*Monitor, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return newMonitorWithSampler(config, newProcessSampler()), nil
+}
+
+func newMonitorWithSampler(config Config, sampler Sampler) *Monitor {
+	return &Monitor{
+		config:  config,
+		sampler: sampler,
+	}
+}
+
+// UnderPressure returns true if the EPP is currently under resource pressure.
+func (m *Monitor) UnderPressure() bool {
+	return m.underPressure.Load()
+}
+
+// OnPressureChange registers a listener that is called whenever the EPP enters or leaves the pressure state.
+func (m *Monitor) OnPressureChange(listener func(underPressure bool)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listeners = append(m.listeners, listener)
+}
+
+// Run periodically samples the resource usage until the context is cancelled.
+func (m *Monitor) Run(ctx context.Context) {
+	logger := log.FromContext(ctx).WithName("self-pressure")
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.V(logutil.DEFAULT).Info("Shutting down self-pressure monitor")
+			return
+		case <-ticker.C:
+			if err := m.check(ctx); err != nil {
+				logger.V(logutil.DEBUG).Error(err, "Failed to sample resource usage")
+			}
+		}
+	}
+}
+
+// check samples the resource usage once and updates the pressure state.
+func (m *Monitor) check(ctx context.Context) error {
+	usage, err := m.sampler.Sample()
+	if err != nil {
+		return err
+	}
+	metrics.RecordEPPResourceUtilization(usage.CPU, usage.Memory)
+
+	wasUnderPressure := m.underPressure.Load()
+	var underPressure bool
+	if wasUnderPressure {
+		underPressure = usage.CPU >= m.config.CPUThreshold*recoveryRatio ||
+			usage.Memory >= m.config.MemoryThreshold*recoveryRatio
+	} else {
+		underPressure = usage.CPU >= m.config.CPUThreshold || usage.Memory >= m.config.MemoryThreshold
+	}
+	if underPressure == wasUnderPressure {
+		return nil
+	}
+
+	m.underPressure.Store(underPressure)
+	metrics.RecordEPPSelfPressure(underPressure)
+	logger := log.FromContext(ctx)
+	if underPressure {
+		logger.Info("EPP is under resource pressure, degrading scheduling",
+			"cpuUtilization", usage.CPU, "cpuThreshold", m.config.CPUThreshold,
+			"memoryUtilization", usage.Memory, "memoryThreshold", m.config.MemoryThreshold)
+	} else {
+		logger.Info("EPP resource pressure relieved, restoring scheduling",
+			"cpuUtilization", usage.CPU, "memoryUtilization", usage.Memory)
+	}
+
+	m.mu.Lock()
+	listeners := m.listeners
+	m.mu.Unlock()
+	for _, listener := range listeners {
+		listener(underPressure)
+	}
+	return nil
+}
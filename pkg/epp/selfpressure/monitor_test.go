@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package selfpressure
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSampler struct {
+	usage Usage
+	err   error
+}
+
+func (s *fakeSampler) Sample() (Usage, error) {
+	return s.usage, s.err
+}
+
+func TestMonitor_PressureTransitions(t *testing.T) {
+	sampler := &fakeSampler{}
+	monitor := newMonitorWithSampler(Config{CPUThreshold: 0.8, MemoryThreshold: 0.9}, sampler)
+	transitions := []bool{}
+	monitor.OnPressureChange(func(underPressure bool) {
+		transitions = append(transitions, underPressure)
+	})
+	ctx := context.Background()
+
+	steps := []struct {
+		name  string
+		usage Usage
+		want  bool
+	}{
+		{name: "idle", usage: Usage{CPU: 0.1, Memory: 0.1}, want: false},
+		{name: "cpu above threshold", usage: Usage{CPU: 0.85}, want: true},
+		{name: "cpu below threshold but above recovery", usage: Usage{CPU: 0.7}, want: true},
+		{name: "cpu below recovery", usage: Usage{CPU: 0.5}, want: false},
+		{name: "memory above threshold", usage: Usage{CPU: 0.1, Memory: 0.95}, want: true},
+		{name: "memory recovered", usage: Usage{CPU: 0.1, Memory: 0.5}, want: false},
+	}
+	for _, step := range steps {
+		sampler.usage = step.usage
+		require.NoError(t, monitor.check(ctx), step.name)
+		assert.Equal(t, step.want, monitor.UnderPressure(), step.name)
+	}
+	assert.Equal(t, []bool{true, false, true, false}, transitions)
+}
+
+func TestMonitor_SampleError(t *testing.T) {
+	sampler := &fakeSampler{usage: Usage{CPU: 1}, err: errors.New("boom")}
+	monitor := newMonitorWithSampler(Config{CPUThreshold: 0.8, MemoryThreshold: 0.9}, sampler)
+
+	assert.Error(t, monitor.check(context.Background()))
+	assert.False(t, monitor.UnderPressure(), "a failed sample should not change the pressure state")
+}
+
+func TestConfigValidate(t *testing.T) {
+	assert.NoError(t, Config{CPUThreshold: DefaultCPUThreshold, MemoryThreshold: DefaultMemoryThreshold}.Validate())
+	assert.Error(t, Config{CPUThreshold: 0, MemoryThreshold: DefaultMemoryThreshold}.Validate())
+	assert.Error(t, Config{CPUThreshold: DefaultCPUThreshold, MemoryThreshold: 1.5}.Validate())
+}
+
+func TestProcessSampler(t *testing.T) {
+	sampler := newProcessSampler()
+	_, err := sampler.Sample()
+	require.NoError(t, err)
+	usage, err := sampler.Sample()
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, usage.CPU, 0.0)
+	assert.GreaterOrEqual(t, usage.Memory, 0.0)
+}
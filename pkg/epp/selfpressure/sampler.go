@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package selfpressure
+
+import (
+	"fmt"
+	"math"
+	"runtime"
+	"runtime/debug"
+	runtimemetrics "runtime/metrics"
+	"syscall"
+	"time"
+)
+
+const (
+	memoryTotalMetric    = "/memory/classes/total:bytes"
+	memoryReleasedMetric = "/memory/classes/heap/released:bytes"
+)
+
+// processSampler samples the resource usage of the current process.
+//
+// CPU usage is the process CPU time consumed since the previous sample relative to the CPU time available to the Go
+// scheduler (GOMAXPROCS, which honors container CPU limits). Memory usage is the memory mapped by the Go runtime,
+// excluding memory released to the OS, relative to the Go memory limit (GOMEMLIMIT); it is reported as 0 when no
+// memory limit is set.
+type processSampler struct {
+	lastWall time.Time
+	lastCPU  time.Duration
+	samples  []runtimemetrics.Sample
+}
+
+func newProcessSampler() *processSampler {
+	return &processSampler{
+		samples: []runtimemetrics.Sample{{Name: memoryTotalMetric}, {Name: memoryReleasedMetric}},
+	}
+}
+
+func (s *processSampler) Sample() (Usage, error) {
+	var rusage syscall.Rusage
+	if err := syscall.Getrusage(syscall.RUSAGE_SELF, &rusage); err != nil {
+		return Usage{}, fmt.Errorf("failed to get process resource usage - %w", err)
+	}
+	now := time.Now()
+	cpu := time.Duration(rusage.Utime.Nano() + rusage.Stime.Nano())
+
+	usage := Usage{}
+	if !s.lastWall.IsZero() {
+		if wall := now.Sub(s.lastWall); wall > 0 {
+			usage.CPU = float64(cpu-s.lastCPU) / float64(wall) / float64(runtime.GOMAXPROCS(0))
+		}
+	}
+	s.lastWall, s.lastCPU = now, cpu
+
+	if limit := debug.SetMemoryLimit(-1); limit > 0 && limit != math.MaxInt64 {
+		runtimemetrics.Read(s.samples)
+		used := s.samples[0].Value.Uint64() - s.samples[1].Value.Uint64()
+		usage.Memory = float64(used) / float64(limit)
+	}
+	return usage, nil
+}
@@ -28,6 +28,11 @@ type ControllerConfig struct {
 	startCrdReconcilers       bool
 	hasInferenceObjective     bool
 	hasInferenceModelRewrites bool
+	// endpointSliceService is the Service whose EndpointSlices the endpoints are discovered from, empty to discover
+	// them from the pods.
+	endpointSliceService string
+	// watchNodes watches the nodes, to set their labels on the endpoints of their pods.
+	watchNodes bool
 }
 
 func NewControllerConfig(startCrdReconcilers bool) ControllerConfig {
@@ -36,6 +41,18 @@ func NewControllerConfig(startCrdReconcilers bool) ControllerConfig {
 	}
 }
 
+// WithEndpointSlices discovers the endpoints from the EndpointSlices of the given Service instead of from the pods.
+func (cc ControllerConfig) WithEndpointSlices(serviceName string) ControllerConfig {
+	cc.endpointSliceService = serviceName
+	return cc
+}
+
+// WithNodes watches the nodes, to set their labels on the endpoints of the pods running on them.
+func (cc ControllerConfig) WithNodes() ControllerConfig {
+	cc.watchNodes = true
+	return cc
+}
+
 func (cc *ControllerConfig) PopulateControllerConfig(cfg *rest.Config) error {
 	if !cc.startCrdReconcilers {
 		return nil
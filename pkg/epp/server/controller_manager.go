@@ -20,7 +20,9 @@ import (
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -48,16 +50,27 @@ func defaultManagerOptions(cfg ControllerConfig, gknn common.GKNN, metricsServer
 	opt := ctrl.Options{
 		Scheme: scheme,
 		Cache: cache.Options{
-			ByObject: map[client.Object]cache.ByObject{
-				&corev1.Pod{}: {
-					Namespaces: map[string]cache.Config{
-						gknn.Namespace: {},
-					},
-				},
-			},
+			ByObject: map[client.Object]cache.ByObject{},
 		},
 		Metrics: metricsServerOptions,
 	}
+	if cfg.endpointSliceService != "" {
+		// The pods are not watched, only the EndpointSlices of the Service.
+		opt.Cache.ByObject[&discoveryv1.EndpointSlice{}] = cache.ByObject{
+			Namespaces: map[string]cache.Config{gknn.Namespace: {LabelSelector: labels.SelectorFromSet(labels.Set{
+				discoveryv1.LabelServiceName: cfg.endpointSliceService,
+			})}},
+		}
+	} else {
+		opt.Cache.ByObject[&corev1.Pod{}] = cache.ByObject{
+			Namespaces: map[string]cache.Config{
+				gknn.Namespace: {},
+			},
+		}
+	}
+	if cfg.watchNodes {
+		opt.Cache.ByObject[&corev1.Node{}] = cache.ByObject{}
+	}
 	if cfg.startCrdReconcilers {
 		if cfg.hasInferenceObjective {
 			opt.Cache.ByObject[&v1alpha2.InferenceObjective{}] = cache.ByObject{Namespaces: map[string]cache.Config{
@@ -19,17 +19,38 @@ package server
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/spf13/pflag"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/adaptivescrape"
+	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/featuregate"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/gatewayprovider"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/handlers"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/healthprobe"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/loadhints"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/pluginquarantine"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/poolfallback"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/selfpressure"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/spillover"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/wirecapture"
 )
 
 const (
 	DefaultGrpcPort      = 9002
 	DefaultPoolNamespace = "default" // default when pool namespace is empty (CLI flag default is empty)
+
+	EndpointDiscoveryPods           = "pods"           // endpoints discovered from the pods selected by the pool
+	EndpointDiscoveryEndpointSlices = "endpointslices" // endpoints discovered from the EndpointSlices of a Service
+
+	DrainQueuedRequestsDispatch = "dispatch" // requests queued by flow control keep being dispatched while draining
+	DrainQueuedRequestsReject   = "reject"   // requests queued by flow control are rejected when draining starts
 )
 
 // Options contains configuration values necessary to create and run the EPP.
@@ -37,8 +58,17 @@ type Options struct {
 	//
 	// ext_proc configuration.
 	//
-	GRPCPort             int  // gRPC port used for communicating with Envoy proxy. (TODO: uint16?)
-	EnableLeaderElection bool // Enables leader election for high availability
+	GRPCPort             int    // gRPC port used for communicating with Envoy proxy. (TODO: uint16?)
+	EnableLeaderElection bool   // Enables leader election for high availability
+	GatewayProvider      string // Gateway implementation the ext_proc server exchanges with, selecting its quirks.
+	RequestBodyMode      string // How the chunks of the request bodies are accumulated, buffered or streamed.
+	RequestBodyMaxBytes  int    // Size from which the request bodies are rejected with a 413; 0 for no limit.
+	FairnessIDSource     string // Where the fairness ID keying the flow control queues is read from.
+	//
+	// Shutdown.
+	//
+	DrainTimeout        time.Duration // Maximum duration of the drain of the in-flight requests on shutdown.
+	DrainQueuedRequests string        // What happens to the requests queued by flow control on shutdown.
 	//
 	// InferencePool.
 	//
@@ -51,6 +81,12 @@ type Options struct {
 	EndpointSelector            string // Selector to filter model server pods on, only 'key=value' pairs are supported. (TODO: k8s.Selector, pflag.StringSlice?)
 	EndpointTargetPorts         []int  // Target ports of model server pods.
 	DisableEndpointSubsetFilter bool   // Disables respecting x-gateway-destination-endpoint-subset in EPP.
+	StaticEndpointsFile         string // File of the static endpoints served alongside the discovered pods.
+	EndpointDiscoveryMode       string // Source the endpoints are discovered from: pods or endpointslices.
+	EndpointSliceService        string // Service whose EndpointSlices the endpoints are discovered from.
+
+	// NodeLabels are the keys of the node labels set on the endpoints of the pods running on the nodes.
+	NodeLabels []string
 	//
 	// MSP metrics scraping.
 	//
@@ -58,9 +94,11 @@ type Options struct {
 	ModelServerMetricsPath           string        // URL path used in scraping metrics from endpoints.
 	ModelServerMetricsPort           int           // Port to scrape metrics from endpoints. (TODO: Deprecated, uint16)
 	ModelServerMetricsHTTPSInsecure  bool          // Disable certificate verification when using 'https' scheme for 'model-server-metrics-scheme'.
+	ModelServerType                  string        // Type of the model servers of the pool, selecting the metrics scraped by the legacy metrics scraper.
 	RefreshMetricsInterval           time.Duration // Interval to refresh metrics.
 	RefreshPrometheusMetricsInterval time.Duration // Interval to flush Prometheus metrics.
 	MetricsStalenessThreshold        time.Duration // Duration after which metrics are considered stale.
+	StaleMetricsPolicy               string        // Action applied to the candidate endpoints whose metrics are stale.
 	TotalQueuedRequestsMetric        string        // Prometheus metric specification for the number of queued requests.
 	TotalRunningRequestsMetric       string        // Prometheus metric specification for the number of running requests.
 	KVCacheUsagePercentageMetric     string        // Prometheus metric specification for the fraction of KV-cache blocks currently in use.
@@ -80,10 +118,101 @@ type Options struct {
 	SecureServing          bool   // Enables secure serving.
 	MetricsEndpointAuth    bool   // Enables authentication and authorization of the metrics endpoint.
 	//
+	// Admin API (served on the metrics port).
+	//
+	EnableEndpointExclusionAPI   bool          // Enables the admin API for time-bounded endpoint exclusion.
+	EndpointExclusionMaxDuration time.Duration // Maximum duration of a single endpoint exclusion.
+	EnableEndpointBiasAPI        bool          // Enables the admin API for time-bounded endpoint score bias rules.
+	EndpointBiasMaxDuration      time.Duration // Maximum duration of a single endpoint bias rule.
+	EnablePoolPauseAPI           bool          // Enables the admin API pausing the dispatch of requests to the pool.
+	PoolPauseMaxDuration         time.Duration // Maximum duration of a pool pause.
+	EnableWhatIfAPI              bool          // Enables the admin API projecting the impact of pool topology changes.
+	WhatIfRecords                int           // Number of scheduling decisions recorded for the what-if API.
+	EnablePeerStateAPI           bool          // Enables the API serving the plugin state to starting EPP replicas.
+	EnableStateDebugAPI          bool          // Enables the debug API dumping the endpoints, plugin state and configuration.
+	EnableExplainDebugAPI        bool          // Enables the debug API explaining the scheduling decision of a captured request.
+	EnableQueueDebugAPI          bool          // Enables the debug API listing the requests queued by flow control.
+	AdminAPITokensFile           string        // CSV file of the role-scoped tokens of the admin and debug APIs.
+	//
+	// Plugin quarantine.
+	//
+	PluginQuarantineThreshold int           // Consecutive failed runs after which a plugin is quarantined, 0 disables.
+	PluginQuarantineCooldown  time.Duration // Duration of a plugin quarantine.
+	//
+	// Peer state bootstrap.
+	//
+	PeerStateBootstrapURL            string        // URL of the peer state API from which plugin state is bootstrapped.
+	PeerStateBootstrapTimeout        time.Duration // Timeout of the peer state bootstrap.
+	PeerStateBootstrapAdminTokenFile string        // File of the admin API token presented to the peer.
+	//
+	// Decision compare mode.
+	//
+	DecisionCompareMode    bool // Compares the scheduling decisions against the decisions of the active EPP.
+	DecisionCompareSamples int  // Number of divergence samples kept in decision compare mode.
+	//
+	// Decision audit.
+	//
+	DecisionAuditLog string // Destination of the scheduling decision audit log, "stdout" or a file path; empty disables it.
+	//
+	// Wire capture.
+	//
+	ExtProcCaptureSampleFraction  float64  // Fraction of the ext-proc streams whose messages are captured.
+	ExtProcCaptureStreams         int      // Number of the latest captured ext-proc streams kept.
+	ExtProcCaptureMaxMessages     int      // Maximum number of messages captured per ext-proc stream.
+	ExtProcCaptureMaxBodyBytes    int      // Number of bytes of each body kept in the captured messages.
+	ExtProcCaptureRedactedHeaders []string // Headers redacted from the captured messages, in addition to the defaults.
+	//
+	// Self-pressure degradation.
+	//
+	EnableSelfPressureDegradation   bool    // Enables degrading scheduling while the EPP itself is resource-starved.
+	SelfPressureCPUThreshold        float64 // Fraction of the available CPU above which the EPP is under pressure.
+	SelfPressureMemoryThreshold     float64 // Fraction of GOMEMLIMIT above which the EPP is under pressure.
+	SelfPressureScrapeStretchFactor int     // Factor by which the metrics refresh interval is stretched under pressure.
+	//
+	// Adaptive metrics refresh.
+	//
+	EnableAdaptiveMetricsRefresh        bool          // Enables adapting the metrics refresh interval to the activity of the pool.
+	AdaptiveMetricsRefreshMaxInterval   time.Duration // Longest metrics refresh interval, used while the pool is steady.
+	AdaptiveMetricsRefreshBurstRequests float64       // Request rate, per second, from which metrics are refreshed fastest.
+	//
+	// Active health probing.
+	//
+	EnableHealthProbing         bool          // Enables active health probing of the endpoints.
+	HealthProbePath             string        // Path of the health endpoint of the model servers.
+	HealthProbeInterval         time.Duration // Interval between two probes of an endpoint.
+	HealthProbeTimeout          time.Duration // Timeout of a probe.
+	HealthProbeFailureThreshold int           // Consecutive failed probes after which an endpoint is unhealthy.
+	HealthProbeSuccessThreshold int           // Consecutive successful probes after which an endpoint is healthy again.
+	//
+	// Load hints.
+	//
+	LoadHintsPort        int           // The port of the gRPC service on which model servers push load hints, 0 disables it.
+	LoadHintsMaxDuration time.Duration // Maximum duration of a load hint.
+	//
+	// Pushed metrics.
+	//
+	OTLPMetricsPort int // The port of the OTLP/HTTP receiver on which model servers push load metrics, 0 disables it.
+	//
+	// Idempotency.
+	//
+	RequestsIdempotentByDefault bool // Whether the requests declaring nothing are idempotent.
+	//
+	// Spillover.
+	//
+	SpilloverPeerAddress    string // Address the requests overflowing the flow control queues are forwarded to; empty disables spillover.
+	SpilloverQueueThreshold int    // Number of queued requests from which the requests are spilled over.
+	//
+	// Pool fallback.
+	//
+	PoolFallbackAddress             string  // Address the requests opting in are forwarded to while the pool is saturated; empty disables the fallback.
+	PoolFallbackSecondaryPool       string  // Name of the secondary pool, reported in the response headers.
+	PoolFallbackSaturationThreshold float64 // Saturation of the pool from which the requests fall back.
+	//
 	// Configuration.
 	//
-	ConfigFile string // The path to the configuration file.
-	ConfigText string // The configuration specified as text, in lieu of a file.
+	ConfigFile   string // The path to the configuration file.
+	ConfigText   string // The configuration specified as text, in lieu of a file.
+	FeatureGates string // Feature gates to enable or disable, overriding the featureGates section of the configuration.
 
 	// internal
 	fs *pflag.FlagSet // FlagSet used in AddFlags() and consulted in Validate()
@@ -92,28 +221,60 @@ type Options struct {
 // NewOptions returns a new Options struct initialized with the default values.
 func NewOptions() *Options {
 	return &Options{ // "zero" values are no explicitly set
-		GRPCPort:                         DefaultGrpcPort,
-		PoolGroup:                        "inference.networking.k8s.io",
-		EndpointTargetPorts:              []int{},
-		DisableEndpointSubsetFilter:      false,
-		ModelServerMetricsScheme:         "http",
-		ModelServerMetricsPath:           "/metrics",
-		ModelServerMetricsHTTPSInsecure:  true,
-		RefreshMetricsInterval:           50 * time.Millisecond,
-		RefreshPrometheusMetricsInterval: 5 * time.Second,
-		MetricsStalenessThreshold:        2 * time.Second,
-		TotalQueuedRequestsMetric:        "vllm:num_requests_waiting",
-		TotalRunningRequestsMetric:       "vllm:num_requests_running",
-		KVCacheUsagePercentageMetric:     "vllm:kv_cache_usage_perc",
-		LoRAInfoMetric:                   "vllm:lora_requests_info",
-		CacheInfoMetric:                  "vllm:cache_config_info",
-		LoggingOptions:                   *logging.NewOptions(),
-		Tracing:                          true,
-		MetricsPort:                      9090,
-		GRPCHealthPort:                   9003,
-		EnablePprof:                      true,
-		SecureServing:                    true,
-		MetricsEndpointAuth:              true,
+		GRPCPort:                            DefaultGrpcPort,
+		GatewayProvider:                     gatewayprovider.ProviderEnvoy,
+		RequestBodyMode:                     string(handlers.RequestBodyModeBuffered),
+		FairnessIDSource:                    handlers.FairnessIDSourceHeader,
+		DrainTimeout:                        30 * time.Second,
+		DrainQueuedRequests:                 DrainQueuedRequestsDispatch,
+		PoolGroup:                           "inference.networking.k8s.io",
+		EndpointTargetPorts:                 []int{},
+		DisableEndpointSubsetFilter:         false,
+		ModelServerMetricsScheme:            "http",
+		ModelServerMetricsPath:              "/metrics",
+		ModelServerMetricsHTTPSInsecure:     true,
+		ModelServerType:                     backendmetrics.ModelServerTypeVLLM,
+		RefreshMetricsInterval:              50 * time.Millisecond,
+		RefreshPrometheusMetricsInterval:    5 * time.Second,
+		MetricsStalenessThreshold:           2 * time.Second,
+		StaleMetricsPolicy:                  string(scheduling.StaleMetricsKeep),
+		TotalQueuedRequestsMetric:           "vllm:num_requests_waiting",
+		TotalRunningRequestsMetric:          "vllm:num_requests_running",
+		KVCacheUsagePercentageMetric:        "vllm:kv_cache_usage_perc",
+		LoRAInfoMetric:                      "vllm:lora_requests_info",
+		CacheInfoMetric:                     "vllm:cache_config_info",
+		LoggingOptions:                      *logging.NewOptions(),
+		Tracing:                             true,
+		MetricsPort:                         9090,
+		GRPCHealthPort:                      9003,
+		EndpointDiscoveryMode:               EndpointDiscoveryPods,
+		EnablePprof:                         true,
+		SecureServing:                       true,
+		MetricsEndpointAuth:                 true,
+		EndpointExclusionMaxDuration:        time.Hour,
+		EndpointBiasMaxDuration:             24 * time.Hour,
+		PoolPauseMaxDuration:                time.Hour,
+		WhatIfRecords:                       1000,
+		PeerStateBootstrapTimeout:           10 * time.Second,
+		PluginQuarantineThreshold:           pluginquarantine.DefaultThreshold,
+		PluginQuarantineCooldown:            pluginquarantine.DefaultCooldown,
+		DecisionCompareSamples:              100,
+		ExtProcCaptureStreams:               100,
+		ExtProcCaptureMaxMessages:           1000,
+		SelfPressureCPUThreshold:            selfpressure.DefaultCPUThreshold,
+		SelfPressureMemoryThreshold:         selfpressure.DefaultMemoryThreshold,
+		SelfPressureScrapeStretchFactor:     selfpressure.DefaultScrapeStretchFactor,
+		AdaptiveMetricsRefreshMaxInterval:   adaptivescrape.DefaultMaxInterval,
+		AdaptiveMetricsRefreshBurstRequests: adaptivescrape.DefaultBurstRequestRate,
+		HealthProbePath:                     healthprobe.DefaultPath,
+		HealthProbeInterval:                 healthprobe.DefaultInterval,
+		HealthProbeTimeout:                  healthprobe.DefaultTimeout,
+		HealthProbeFailureThreshold:         healthprobe.DefaultFailureThreshold,
+		HealthProbeSuccessThreshold:         healthprobe.DefaultSuccessThreshold,
+		LoadHintsMaxDuration:                loadhints.DefaultMaxDuration,
+		RequestsIdempotentByDefault:         true,
+		SpilloverQueueThreshold:             spillover.DefaultQueueThreshold,
+		PoolFallbackSaturationThreshold:     poolfallback.DefaultSaturationThreshold,
 	}
 }
 
@@ -126,6 +287,30 @@ func (opts *Options) AddFlags(fs *pflag.FlagSet) {
 	fs.IntVar(&opts.GRPCPort, "grpc-port", opts.GRPCPort, "gRPC port used for communicating with Envoy proxy.")
 	fs.BoolVar(&opts.EnableLeaderElection, "ha-enable-leader-election", opts.EnableLeaderElection,
 		"Enables leader election for high availability. When enabled, readiness probes will only pass on the leader.")
+	fs.StringVar(&opts.GatewayProvider, "gateway-provider", opts.GatewayProvider,
+		"Gateway implementation the ext_proc server exchanges with, selecting its header mutation semantics, dynamic "+
+			"metadata namespace and body mode, one of "+strings.Join(gatewayprovider.Names(), ", ")+".")
+	fs.StringVar(&opts.RequestBodyMode, "request-body-mode", opts.RequestBodyMode,
+		"How the chunks of the request bodies are accumulated before being parsed: buffered appends them to a single "+
+			"buffer sized from the content-length header, streamed keeps them as received and joins them once at the "+
+			"end of the body, which suits large payloads sent without a content-length.")
+	fs.IntVar(&opts.RequestBodyMaxBytes, "request-body-max-bytes", opts.RequestBodyMaxBytes,
+		"The size in bytes from which the request bodies are rejected with a 413, as soon as the content-length header "+
+			"or the chunks received exceed it. 0 for no limit.")
+	fs.StringVar(&opts.FairnessIDSource, "fairness-id-source", opts.FairnessIDSource,
+		"Where the fairness ID of the requests, keying their flow control queues, e.g. per tenant, is read from: header "+
+			"for the x-gateway-inference-fairness-id header, header:<name> for another header, or jwt-claim:<claim> for "+
+			"a claim of the bearer token of the authorization header, which the gateway must have authenticated. "+
+			"The x-gateway-inference-fairness-id header is the fallback of another header. Requests without the claim get "+
+			"the default fairness ID, so that the clients cannot pick theirs.")
+	fs.DurationVar(&opts.DrainTimeout, "drain-timeout", opts.DrainTimeout,
+		"Maximum duration of the drain on shutdown, e.g. during a rolling update: the ext_proc server stops accepting "+
+			"new streams and waits for the in-flight requests to complete before the EPP exits, closing the remaining "+
+			"ones when it expires. 0 closes them right away. Must be lower than the termination grace period of the pod.")
+	fs.StringVar(&opts.DrainQueuedRequests, "drain-queued-requests", opts.DrainQueuedRequests,
+		"What happens to the requests queued by flow control on shutdown: dispatch keeps dispatching them while "+
+			"draining, reject rejects them with a 503 as soon as the drain starts so that the gateway can retry them "+
+			"on another EPP replica.")
 	fs.StringVar(&opts.PoolGroup, "pool-group", opts.PoolGroup,
 		"Kubernetes resource group of the InferencePool this Endpoint Picker is associated with. Only `inference.networking.k8s.io/v1` is currently supported.")
 	fs.StringVar(&opts.PoolNamespace, "pool-namespace", opts.PoolNamespace,
@@ -138,6 +323,21 @@ func (opts *Options) AddFlags(fs *pflag.FlagSet) {
 		"Format: a comma-separated list of numbers without whitespace (e.g., '3000,3001,3002').")
 	fs.BoolVar(&opts.DisableEndpointSubsetFilter, "disable-endpoint-subset-filter", opts.DisableEndpointSubsetFilter,
 		"Disables respecting the x-gateway-destination-endpoint-subset metadata for dispatching requests in EPP.")
+	fs.StringVar(&opts.EndpointDiscoveryMode, "endpoint-discovery-mode", opts.EndpointDiscoveryMode,
+		"Source the endpoints of the pool are discovered from: 'pods' watches the pods selected by the pool, "+
+			"'endpointslices' watches the EndpointSlices of the Service set by endpoint-slice-service, which reduces the load "+
+			"on the API server in large clusters.")
+	fs.StringVar(&opts.EndpointSliceService, "endpoint-slice-service", opts.EndpointSliceService,
+		"Name of the Service, in the namespace of the pool, whose EndpointSlices the endpoints are discovered from in the "+
+			"'endpointslices' endpoint discovery mode.")
+	fs.StringSliceVar(&opts.NodeLabels, "node-labels", opts.NodeLabels,
+		"Keys of the labels of the nodes to set on the endpoints of the pods running on them, e.g. their zone, GPU model "+
+			"or instance type, for the filters and scorers to use. Requires read access to the nodes. "+
+			"Format: a comma-separated list of label keys without whitespace "+
+			"(e.g., 'topology.kubernetes.io/zone,node.kubernetes.io/instance-type').")
+	fs.StringVar(&opts.StaticEndpointsFile, "static-endpoints-file", opts.StaticEndpointsFile,
+		"Path of a YAML file listing model server endpoints that are not discovered from pods, e.g. running outside "+
+			"of the cluster, served alongside the pods of the pool.")
 	fs.StringVar(&opts.ModelServerMetricsScheme, "model-server-metrics-scheme", opts.ModelServerMetricsScheme,
 		"Protocol scheme used in scraping metrics from endpoints.")
 	_ = fs.MarkDeprecated("model-server-metrics-scheme", "This flag is deprecated. Configure via EndpointPickerConfig data layer plugin parameters instead.")
@@ -150,11 +350,18 @@ func (opts *Options) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&opts.ModelServerMetricsHTTPSInsecure, "model-server-metrics-https-insecure-skip-verify", opts.ModelServerMetricsHTTPSInsecure,
 		"Disable certificate verification when using 'https' scheme for 'model-server-metrics-scheme'.")
 	_ = fs.MarkDeprecated("model-server-metrics-https-insecure-skip-verify", "This flag is deprecated. Configure via EndpointPickerConfig data layer plugin parameters instead.")
+	fs.StringVar(&opts.ModelServerType, "model-server-type", opts.ModelServerType,
+		"Type of the model servers of the pool, selecting the metrics scraped from them when legacy metrics polling is "+
+			"enabled, one of "+strings.Join(backendmetrics.ModelServerTypes(), ", ")+".")
 	fs.DurationVar(&opts.RefreshMetricsInterval, "refresh-metrics-interval", opts.RefreshMetricsInterval, "Interval to refresh metrics.")
 	fs.DurationVar(&opts.RefreshPrometheusMetricsInterval, "refresh-prometheus-metrics-interval", opts.RefreshPrometheusMetricsInterval,
 		"Interval to flush Prometheus metrics.")
 	fs.DurationVar(&opts.MetricsStalenessThreshold, "metrics-staleness-threshold", opts.MetricsStalenessThreshold,
 		"Duration after which metrics are considered stale. This is used to determine if an endpoint's metrics are fresh enough.")
+	fs.StringVar(&opts.StaleMetricsPolicy, "stale-metrics-policy", opts.StaleMetricsPolicy,
+		"Action applied to the candidate endpoints whose metrics are older than the metrics staleness threshold: "+
+			"'keep' schedules on them as on fresh metrics, 'exclude' removes them from the candidates unless all are stale, "+
+			"'decay' decays their scores with the age of their metrics, and 'optimistic' schedules on them as on idle endpoints.")
 	fs.StringVar(&opts.TotalQueuedRequestsMetric, "total-queued-requests-metric", opts.TotalQueuedRequestsMetric,
 		"Prometheus metric for the number of queued requests.")
 	_ = fs.MarkDeprecated("total-queued-requests-metric", "use engineConfigs in EndpointPickerConfig instead")
@@ -188,8 +395,143 @@ func (opts *Options) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&opts.SecureServing, "secure-serving", opts.SecureServing, "Enables secure serving.")
 	fs.BoolVar(&opts.MetricsEndpointAuth, "metrics-endpoint-auth", opts.MetricsEndpointAuth,
 		"Enables authentication and authorization of the metrics endpoint.")
+	fs.BoolVar(&opts.EnableEndpointExclusionAPI, "enable-endpoint-exclusion-api", opts.EnableEndpointExclusionAPI,
+		"Enables the admin API, served on the metrics port, that lets external systems exclude endpoints from scheduling for a bounded duration.")
+	fs.DurationVar(&opts.EndpointExclusionMaxDuration, "endpoint-exclusion-max-duration", opts.EndpointExclusionMaxDuration,
+		"Maximum duration of a single endpoint exclusion requested through the endpoint exclusion API.")
+	fs.BoolVar(&opts.EnableEndpointBiasAPI, "enable-endpoint-bias-api", opts.EnableEndpointBiasAPI,
+		"Enables the admin API, served on the metrics port, that lets operators bias the scheduling toward or away from "+
+			"endpoints by multiplying their scores for a bounded duration, without filtering any endpoint out.")
+	fs.DurationVar(&opts.EndpointBiasMaxDuration, "endpoint-bias-max-duration", opts.EndpointBiasMaxDuration,
+		"Maximum duration of a single endpoint bias rule set through the endpoint bias API.")
+	fs.BoolVar(&opts.EnablePoolPauseAPI, "enable-pool-pause-api", opts.EnablePoolPauseAPI,
+		"Enables the admin API, served on the metrics port, that lets operators pause the dispatch of requests to the pool "+
+			"for a bounded duration, e.g. during a coordinated maintenance, holding or rejecting the requests meanwhile.")
+	fs.DurationVar(&opts.PoolPauseMaxDuration, "pool-pause-max-duration", opts.PoolPauseMaxDuration,
+		"Maximum duration of a pool pause requested through the pool pause API. Pauses are lifted automatically when they expire.")
+	fs.BoolVar(&opts.EnableWhatIfAPI, "enable-what-if-api", opts.EnableWhatIfAPI,
+		"Enables the admin API, served on the metrics port, that replays the recent scheduling decisions against a "+
+			"hypothetical change of the pool topology (e.g. removing pods or adding pods of a slower class) and reports the "+
+			"projected latency and saturation.")
+	fs.IntVar(&opts.WhatIfRecords, "what-if-records", opts.WhatIfRecords,
+		"Number of recent scheduling decisions recorded for the what-if API.")
+	fs.BoolVar(&opts.EnablePeerStateAPI, "enable-peer-state-api", opts.EnablePeerStateAPI,
+		"Enables the API, served on the metrics port, that serves the state of the plugins (e.g. in-flight load, prefix "+
+			"cache affinity) to EPP replicas bootstrapping from this one.")
+	fs.BoolVar(&opts.EnableStateDebugAPI, "enable-state-debug-api", opts.EnableStateDebugAPI,
+		"Enables the debug API, served on the metrics port, that dumps the endpoints known to the EPP with their latest "+
+			"metrics, a summary of the plugin state (e.g. the prefix cache index) and the loaded configuration.")
+	fs.BoolVar(&opts.EnableExplainDebugAPI, "enable-explain-debug-api", opts.EnableExplainDebugAPI,
+		"Enables the debug API, served on the metrics port, that re-runs the scheduling cycle of a captured request "+
+			"against the current endpoints in dry-run mode, and explains which endpoints each filter eliminated and how each "+
+			"scorer scored the rest.")
+	fs.BoolVar(&opts.EnableQueueDebugAPI, "enable-queue-debug-api", opts.EnableQueueDebugAPI,
+		"Enables the debug API, served on the metrics port, that lists the requests currently queued by the Flow Control "+
+			"layer with their fairness ID, priority and age. Requires the Flow Control layer to be enabled.")
+	fs.StringVar(&opts.AdminAPITokensFile, "admin-api-tokens-file", opts.AdminAPITokensFile,
+		"Path to a CSV file of \"token,user,role\" lines scoping the access to the admin and debug APIs served on the "+
+			"metrics port, passed in the X-EPP-Admin-Token header. The viewer role is limited to the read-only operations, "+
+			"the operator role may also call the mutating ones. Mutating calls are audit logged whether or not tokens are set.")
+	fs.IntVar(&opts.PluginQuarantineThreshold, "plugin-quarantine-threshold", opts.PluginQuarantineThreshold,
+		"Number of consecutive failed or panicking runs after which a plugin is quarantined, i.e. skipped while the rest "+
+			"of the plugin chain runs. The quarantines are served on the metrics port. Set to 0 to disable the quarantine; "+
+			"the panics of the plugins are recovered regardless.")
+	fs.DurationVar(&opts.PluginQuarantineCooldown, "plugin-quarantine-cooldown", opts.PluginQuarantineCooldown,
+		"Duration of a plugin quarantine, after which the plugin is run again.")
+	fs.StringVar(&opts.PeerStateBootstrapURL, "peer-state-bootstrap-url", opts.PeerStateBootstrapURL,
+		"URL of the peer state API of an existing EPP replica, typically through a Service selecting the EPP pods. "+
+			"When set, the EPP imports the state of its plugins from the peer before reporting ready.")
+	fs.DurationVar(&opts.PeerStateBootstrapTimeout, "peer-state-bootstrap-timeout", opts.PeerStateBootstrapTimeout,
+		"Timeout of the peer state bootstrap. The EPP starts with empty plugin state if the bootstrap does not complete in time.")
+	fs.StringVar(&opts.PeerStateBootstrapAdminTokenFile, "peer-state-bootstrap-admin-token-file", opts.PeerStateBootstrapAdminTokenFile,
+		"Path to a file holding the admin API token presented to the peer in the X-EPP-Admin-Token header, required when "+
+			"the peers scope their admin APIs with --admin-api-tokens-file. The file is read on each bootstrap attempt.")
+	fs.BoolVar(&opts.DecisionCompareMode, "decision-compare-mode", opts.DecisionCompareMode,
+		"Runs the EPP as a canary comparing its scheduling decisions against those of the active EPP, read from the "+
+			"destination endpoint header of the mirrored requests. The results are exposed as metrics and on the metrics port.")
+	fs.IntVar(&opts.DecisionCompareSamples, "decision-compare-samples", opts.DecisionCompareSamples,
+		"Number of the latest divergent decisions kept in decision compare mode.")
+	fs.StringVar(&opts.DecisionAuditLog, "decision-audit-log", opts.DecisionAuditLog,
+		"Destination of the audit log of the scheduling decisions: \"stdout\", or the path of a file the records are "+
+			"appended to. Each record is a line of JSON holding the request ID, the model, the candidate endpoints, the "+
+			"endpoints eliminated by each filter, the scores of each scorer and the chosen endpoint. Empty disables it.")
+	fs.Float64Var(&opts.ExtProcCaptureSampleFraction, "extproc-capture-sample-fraction", opts.ExtProcCaptureSampleFraction,
+		"Fraction of the ext-proc streams whose sanitized messages are captured for debugging and served on the metrics "+
+			"port. The capture is disabled when 0.")
+	fs.IntVar(&opts.ExtProcCaptureStreams, "extproc-capture-streams", opts.ExtProcCaptureStreams,
+		"Number of the latest captured ext-proc streams kept.")
+	fs.IntVar(&opts.ExtProcCaptureMaxMessages, "extproc-capture-max-messages", opts.ExtProcCaptureMaxMessages,
+		"Maximum number of messages captured per ext-proc stream.")
+	fs.IntVar(&opts.ExtProcCaptureMaxBodyBytes, "extproc-capture-max-body-bytes", opts.ExtProcCaptureMaxBodyBytes,
+		"Number of bytes of each request and response body kept in the captured messages. Bodies are dropped when 0.")
+	fs.StringSliceVar(&opts.ExtProcCaptureRedactedHeaders, "extproc-capture-redacted-headers", opts.ExtProcCaptureRedactedHeaders,
+		"Headers whose values are redacted from the captured messages, in addition to "+
+			strings.Join(wirecapture.DefaultRedactedHeaders, ", ")+".")
+	fs.BoolVar(&opts.EnableSelfPressureDegradation, "enable-self-pressure-degradation", opts.EnableSelfPressureDegradation,
+		"Enables monitoring of the EPP's own CPU and memory usage. While the EPP is under resource pressure, the metrics "+
+			"refresh interval is stretched and scorers marked as optional in the scheduling profiles are skipped.")
+	fs.Float64Var(&opts.SelfPressureCPUThreshold, "self-pressure-cpu-threshold", opts.SelfPressureCPUThreshold,
+		"Fraction of the CPU available to the EPP (GOMAXPROCS) above which the EPP is considered under resource pressure.")
+	fs.Float64Var(&opts.SelfPressureMemoryThreshold, "self-pressure-memory-threshold", opts.SelfPressureMemoryThreshold,
+		"Fraction of the Go memory limit (GOMEMLIMIT) above which the EPP is considered under resource pressure.")
+	fs.IntVar(&opts.SelfPressureScrapeStretchFactor, "self-pressure-scrape-stretch-factor", opts.SelfPressureScrapeStretchFactor,
+		"Factor by which the metrics refresh interval is stretched while the EPP is under resource pressure.")
+	fs.BoolVar(&opts.EnableAdaptiveMetricsRefresh, "enable-adaptive-metrics-refresh", opts.EnableAdaptiveMetricsRefresh,
+		"Enables adapting the metrics refresh interval to the activity of the pool: the metrics are refreshed every "+
+			"'refresh-metrics-interval' during request bursts and while the load of the pool changes quickly, and up to "+
+			"every 'adaptive-metrics-refresh-max-interval' while the pool is idle or steady. Requires the data layer.")
+	fs.DurationVar(&opts.AdaptiveMetricsRefreshMaxInterval, "adaptive-metrics-refresh-max-interval", opts.AdaptiveMetricsRefreshMaxInterval,
+		"Longest metrics refresh interval with adaptive metrics refresh, used while the pool is idle or steady.")
+	fs.Float64Var(&opts.AdaptiveMetricsRefreshBurstRequests, "adaptive-metrics-refresh-burst-request-rate", opts.AdaptiveMetricsRefreshBurstRequests,
+		"Request rate, in requests per second, from which the metrics are refreshed every 'refresh-metrics-interval' "+
+			"with adaptive metrics refresh.")
+	fs.BoolVar(&opts.EnableHealthProbing, "enable-health-probing", opts.EnableHealthProbing,
+		"Enables active health probing of the endpoints. The EPP periodically probes the health endpoint of each model "+
+			"server and excludes the endpoints failing consecutive probes from scheduling, faster than the Kubernetes "+
+			"readiness, until they pass consecutive probes again.")
+	fs.StringVar(&opts.HealthProbePath, "health-probe-path", opts.HealthProbePath,
+		"Path of the health endpoint of the model servers probed on the endpoint port, e.g. /health or /v1/models.")
+	fs.DurationVar(&opts.HealthProbeInterval, "health-probe-interval", opts.HealthProbeInterval,
+		"Interval between two health probes of an endpoint.")
+	fs.DurationVar(&opts.HealthProbeTimeout, "health-probe-timeout", opts.HealthProbeTimeout,
+		"Timeout of a health probe, at most the probe interval.")
+	fs.IntVar(&opts.HealthProbeFailureThreshold, "health-probe-failure-threshold", opts.HealthProbeFailureThreshold,
+		"Number of consecutive failed health probes after which an endpoint is excluded from scheduling.")
+	fs.IntVar(&opts.HealthProbeSuccessThreshold, "health-probe-success-threshold", opts.HealthProbeSuccessThreshold,
+		"Number of consecutive successful health probes after which an excluded endpoint is included in scheduling again.")
+	fs.IntVar(&opts.LoadHintsPort, "load-hints-port", opts.LoadHintsPort,
+		"The port of the gRPC service on which model servers push hints about imminent changes of their load, e.g. a "+
+			"planned restart, consumed by the load-hint-filter ahead of the next metrics scrape. Set to 0 to disable the service.")
+	fs.DurationVar(&opts.LoadHintsMaxDuration, "load-hints-max-duration", opts.LoadHintsMaxDuration,
+		"Maximum duration of a load hint. Longer hints are capped, so that a faulty model server cannot hold a hint forever.")
+	fs.IntVar(&opts.OTLPMetricsPort, "otlp-metrics-port", opts.OTLPMetricsPort,
+		"The port of the OTLP/HTTP receiver on which model servers, or their sidecars, push their load metrics, for "+
+			"deployments in which the EPP cannot scrape them. The pushes are not authenticated, only accepted from the pod of the "+
+			"endpoint: the port must not be exposed outside the cluster. Set to 0 to disable the receiver.")
+	fs.BoolVar(&opts.RequestsIdempotentByDefault, "requests-idempotent-by-default", opts.RequestsIdempotentByDefault,
+		"Whether the requests declaring nothing through their InferenceObjective or the x-idempotent header are "+
+			"idempotent. Only idempotent requests are sent to fallback destinations or generated redundantly.")
+	fs.StringVar(&opts.SpilloverPeerAddress, "spillover-peer-address", opts.SpilloverPeerAddress,
+		"The host:port, e.g. the gateway of a peer pool, to which the requests are forwarded instead of being queued while "+
+			"the flow control queues hold at least --spillover-queue-threshold requests. Requests already spilled over "+
+			"by another EPP are never forwarded. Empty disables spillover. Requires the flow control feature gate.")
+	fs.IntVar(&opts.SpilloverQueueThreshold, "spillover-queue-threshold", opts.SpilloverQueueThreshold,
+		"The number of requests queued by flow control from which the requests are spilled over to --spillover-peer-address.")
+	fs.StringVar(&opts.PoolFallbackAddress, "pool-fallback-address", opts.PoolFallbackAddress,
+		"The host:port, e.g. the gateway of a secondary pool serving a smaller model or of a pool in a remote cluster, to "+
+			"which the requests of the InferenceObjectives with saturationFallback are forwarded while the pool is "+
+			"saturated. Requests which already fell back from another pool are never forwarded. Empty disables the fallback.")
+	fs.StringVar(&opts.PoolFallbackSecondaryPool, "pool-fallback-secondary-pool", opts.PoolFallbackSecondaryPool,
+		"The name of the secondary pool reached through --pool-fallback-address, reported to the clients in the "+
+			"x-gateway-inference-downgraded-to response header.")
+	fs.Float64Var(&opts.PoolFallbackSaturationThreshold, "pool-fallback-saturation-threshold", opts.PoolFallbackSaturationThreshold,
+		"The saturation of the pool, as reported by the saturation detector, from which the requests fall back to "+
+			"--pool-fallback-address.")
 	fs.StringVar(&opts.ConfigFile, "config-file", opts.ConfigFile, "The path to the configuration file.")
 	fs.StringVar(&opts.ConfigText, "config-text", opts.ConfigText, "The configuration specified as text, in lieu of a file.")
+	fs.StringVar(&opts.FeatureGates, "feature-gates", opts.FeatureGates,
+		"Feature gates to enable or disable, overriding the featureGates section of the configuration. "+
+			"Format: a comma-separated list of name=true|false pairs (e.g., 'flowControl=true,enableLegacyMetrics=false').")
 }
 
 func (opts *Options) Complete() error {
@@ -217,9 +559,119 @@ func (opts *Options) Validate() error {
 		}
 	}
 
+	if _, err := scheduling.ParseStaleMetricsAction(opts.StaleMetricsPolicy); err != nil {
+		return fmt.Errorf("invalid %q flag: %w", "stale-metrics-policy", err)
+	}
+
+	switch opts.EndpointDiscoveryMode {
+	case EndpointDiscoveryPods:
+		if opts.EndpointSliceService != "" {
+			return fmt.Errorf("flag %q requires the %q endpoint discovery mode", "endpoint-slice-service", EndpointDiscoveryEndpointSlices)
+		}
+	case EndpointDiscoveryEndpointSlices:
+		if opts.EndpointSliceService == "" {
+			return fmt.Errorf("flag %q is required in the %q endpoint discovery mode", "endpoint-slice-service", EndpointDiscoveryEndpointSlices)
+		}
+	default:
+		return fmt.Errorf("invalid %q flag %q, must be %q or %q", "endpoint-discovery-mode", opts.EndpointDiscoveryMode,
+			EndpointDiscoveryPods, EndpointDiscoveryEndpointSlices)
+	}
+
+	for _, key := range opts.NodeLabels {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("invalid label key %q in %q: %s", key, "node-labels", strings.Join(errs, "; "))
+		}
+	}
+
 	if opts.ConfigText != "" && opts.ConfigFile != "" {
 		return fmt.Errorf("both the %q and %q flags can not be set at the same time", "configText", "configFile")
 	}
+	if _, err := featuregate.ParseOverrides(opts.FeatureGates); err != nil {
+		return fmt.Errorf("invalid %q flag - %w", "feature-gates", err)
+	}
+	if opts.EndpointExclusionMaxDuration <= 0 {
+		return fmt.Errorf("flag %q must be positive", "endpoint-exclusion-max-duration")
+	}
+	if opts.EndpointBiasMaxDuration <= 0 {
+		return fmt.Errorf("flag %q must be positive", "endpoint-bias-max-duration")
+	}
+	if opts.DrainTimeout < 0 {
+		return fmt.Errorf("flag %q must be non-negative", "drain-timeout")
+	}
+	if opts.DrainQueuedRequests != DrainQueuedRequestsDispatch && opts.DrainQueuedRequests != DrainQueuedRequestsReject {
+		return fmt.Errorf("invalid %q flag %q, must be one of %q or %q", "drain-queued-requests", opts.DrainQueuedRequests,
+			DrainQueuedRequestsDispatch, DrainQueuedRequestsReject)
+	}
+	if opts.PoolPauseMaxDuration <= 0 {
+		return fmt.Errorf("flag %q must be positive", "pool-pause-max-duration")
+	}
+	if opts.LoadHintsPort < 0 || opts.LoadHintsPort > 65535 {
+		return fmt.Errorf("invalid port number %d in %q", opts.LoadHintsPort, "load-hints-port")
+	}
+	if opts.LoadHintsMaxDuration <= 0 {
+		return fmt.Errorf("flag %q must be positive", "load-hints-max-duration")
+	}
+	if opts.OTLPMetricsPort < 0 || opts.OTLPMetricsPort > 65535 {
+		return fmt.Errorf("invalid port number %d in %q", opts.OTLPMetricsPort, "otlp-metrics-port")
+	}
+	if opts.WhatIfRecords <= 0 {
+		return fmt.Errorf("flag %q must be positive", "what-if-records")
+	}
+	if opts.PluginQuarantineThreshold < 0 {
+		return fmt.Errorf("flag %q must be non-negative", "plugin-quarantine-threshold")
+	}
+	if opts.PluginQuarantineCooldown <= 0 {
+		return fmt.Errorf("flag %q must be positive", "plugin-quarantine-cooldown")
+	}
+	if opts.PeerStateBootstrapTimeout <= 0 {
+		return fmt.Errorf("flag %q must be positive", "peer-state-bootstrap-timeout")
+	}
+	if opts.DecisionCompareSamples < 0 {
+		return fmt.Errorf("flag %q must be non-negative", "decision-compare-samples")
+	}
+	if err := opts.WireCaptureConfig().Validate(); err != nil {
+		return fmt.Errorf("invalid ext-proc capture configuration - %w", err)
+	}
+	if opts.EnableSelfPressureDegradation {
+		if err := (selfpressure.Config{CPUThreshold: opts.SelfPressureCPUThreshold, MemoryThreshold: opts.SelfPressureMemoryThreshold}).Validate(); err != nil {
+			return fmt.Errorf("invalid self-pressure configuration - %w", err)
+		}
+		if opts.SelfPressureScrapeStretchFactor < 1 {
+			return fmt.Errorf("flag %q must be at least 1", "self-pressure-scrape-stretch-factor")
+		}
+	}
+	if opts.EnableAdaptiveMetricsRefresh {
+		if err := opts.AdaptiveScrapeConfig().Validate(); err != nil {
+			return fmt.Errorf("invalid adaptive metrics refresh configuration - %w", err)
+		}
+	}
+	if opts.SpilloverPeerAddress != "" {
+		if err := opts.SpilloverConfig().Validate(); err != nil {
+			return fmt.Errorf("invalid spillover configuration - %w", err)
+		}
+	}
+	if opts.PoolFallbackAddress != "" {
+		if err := opts.PoolFallbackConfig().Validate(); err != nil {
+			return fmt.Errorf("invalid pool fallback configuration - %w", err)
+		}
+	}
+	if opts.EnableHealthProbing {
+		if err := opts.HealthProbeConfig().Validate(); err != nil {
+			return fmt.Errorf("invalid health probe configuration - %w", err)
+		}
+	}
+	if _, err := handlers.ParseFairnessIDSource(opts.FairnessIDSource); err != nil {
+		return fmt.Errorf("invalid %q flag - %w", "fairness-id-source", err)
+	}
+	if err := opts.RequestBodyConfig().Validate(); err != nil {
+		return fmt.Errorf("invalid request body configuration - %w", err)
+	}
+	if _, err := gatewayprovider.Get(opts.GatewayProvider); err != nil {
+		return fmt.Errorf("invalid %q flag - %w", "gateway-provider", err)
+	}
+	if _, err := backendmetrics.ModelServerConfig(opts.ModelServerType); err != nil {
+		return fmt.Errorf("invalid %q flag - %w", "model-server-type", err)
+	}
 	if opts.ModelServerMetricsScheme != "http" && opts.ModelServerMetricsScheme != "https" {
 		return fmt.Errorf("unexpected %q value for %q flag, it can only be set to 'http' or 'https'",
 			opts.ModelServerMetricsScheme, "model-server-metrics-scheme")
@@ -247,6 +699,74 @@ func (opts *Options) Validate() error {
 	return nil
 }
 
+// HealthProbeConfig returns the configuration of the active health probing.
+func (opts *Options) HealthProbeConfig() healthprobe.Config {
+	return healthprobe.Config{
+		Path:             opts.HealthProbePath,
+		Interval:         opts.HealthProbeInterval,
+		Timeout:          opts.HealthProbeTimeout,
+		FailureThreshold: opts.HealthProbeFailureThreshold,
+		SuccessThreshold: opts.HealthProbeSuccessThreshold,
+	}
+}
+
+// AdaptiveScrapeConfig returns the configuration of the adaptive metrics refresh.
+func (opts *Options) AdaptiveScrapeConfig() adaptivescrape.Config {
+	return adaptivescrape.Config{
+		MinInterval:      opts.RefreshMetricsInterval,
+		MaxInterval:      opts.AdaptiveMetricsRefreshMaxInterval,
+		BurstRequestRate: opts.AdaptiveMetricsRefreshBurstRequests,
+	}
+}
+
+// RequestBodyConfig returns the configuration of the handling of the request bodies.
+func (opts *Options) RequestBodyConfig() handlers.RequestBodyConfig {
+	return handlers.RequestBodyConfig{
+		Mode:     handlers.RequestBodyMode(opts.RequestBodyMode),
+		MaxBytes: opts.RequestBodyMaxBytes,
+	}
+}
+
+// SpilloverConfig returns the configuration of the spillover to a peer pool.
+func (opts *Options) SpilloverConfig() spillover.Config {
+	return spillover.Config{
+		PeerAddress:    opts.SpilloverPeerAddress,
+		QueueThreshold: opts.SpilloverQueueThreshold,
+		PoolName:       opts.PoolName,
+	}
+}
+
+// PoolFallbackConfig returns the configuration of the fallback to a secondary pool.
+func (opts *Options) PoolFallbackConfig() poolfallback.Config {
+	return poolfallback.Config{
+		Address:             opts.PoolFallbackAddress,
+		SecondaryPoolName:   opts.PoolFallbackSecondaryPool,
+		SaturationThreshold: opts.PoolFallbackSaturationThreshold,
+		PoolName:            opts.PoolName,
+	}
+}
+
+// ModelServerMetricsConfig returns the configuration of the legacy metrics scraper, scraping the metrics of the type
+// of the model servers of the pool.
+func (opts *Options) ModelServerMetricsConfig() backendmetrics.Config {
+	config, _ := backendmetrics.ModelServerConfig(opts.ModelServerType) // validated by Validate
+	config.ModelServerMetricsScheme = opts.ModelServerMetricsScheme
+	config.ModelServerMetricsHTTPSInsecure = opts.ModelServerMetricsHTTPSInsecure
+	config.ModelServerMetricsPath = opts.ModelServerMetricsPath
+	return config
+}
+
+// WireCaptureConfig returns the configuration of the capture of the ext-proc messages.
+func (opts *Options) WireCaptureConfig() wirecapture.Config {
+	return wirecapture.Config{
+		SampleFraction:       opts.ExtProcCaptureSampleFraction,
+		Streams:              opts.ExtProcCaptureStreams,
+		MaxMessagesPerStream: opts.ExtProcCaptureMaxMessages,
+		MaxBodyBytes:         opts.ExtProcCaptureMaxBodyBytes,
+		RedactedHeaders:      opts.ExtProcCaptureRedactedHeaders,
+	}
+}
+
 func removeDuplicatePorts(ports []int) []int {
 	seen := sets.NewInt()
 	unique := make([]int, 0, len(ports))
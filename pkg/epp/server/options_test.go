@@ -120,3 +120,119 @@ func TestEndpointTargetPorts(t *testing.T) {
 		})
 	}
 }
+
+func TestEndpointDiscoveryFlags(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		expectError bool
+	}{
+		{name: "Default pods mode", args: []string{}},
+		{name: "EndpointSlices mode", args: []string{"--endpoint-discovery-mode", "endpointslices", "--endpoint-slice-service", "vllm"}},
+		{name: "EndpointSlices mode without service", args: []string{"--endpoint-discovery-mode", "endpointslices"}, expectError: true},
+		{name: "Service in pods mode", args: []string{"--endpoint-slice-service", "vllm"}, expectError: true},
+		{name: "Unknown mode", args: []string{"--endpoint-discovery-mode", "dns"}, expectError: true},
+		{name: "Node labels", args: []string{"--node-labels", "topology.kubernetes.io/zone,node.kubernetes.io/instance-type"}},
+		{name: "Invalid node label", args: []string{"--node-labels", "zone/with/slashes"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := pflag.NewFlagSet(tt.name, pflag.ContinueOnError)
+			opts := NewOptions()
+			opts.AddFlags(fs)
+			argv := append([]string{"--pool-name", "pool", "--config-file", "fake-config.yaml"}, tt.args...)
+			if err := fs.Parse(argv); err != nil {
+				t.Fatalf("Failed to parse flags: %v", err)
+			}
+			if err := opts.Complete(); err != nil {
+				t.Fatalf("Complete failed unexpectedly with error: %v", err)
+			}
+			err := opts.Validate()
+			if tt.expectError && err == nil {
+				t.Fatalf("Expected a validation error but got none.")
+			}
+			if !tt.expectError && err != nil {
+				t.Fatalf("Validate failed unexpectedly with error: %v", err)
+			}
+		})
+	}
+}
+
+func TestDrainFlags(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		expectError bool
+	}{
+		{name: "Defaults", args: []string{}},
+		{name: "Drain disabled", args: []string{"--drain-timeout", "0s"}},
+		{name: "Reject queued requests", args: []string{"--drain-timeout", "1m", "--drain-queued-requests", "reject"}},
+		{name: "Negative timeout", args: []string{"--drain-timeout", "-1s"}, expectError: true},
+		{name: "Unknown policy", args: []string{"--drain-queued-requests", "requeue"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := pflag.NewFlagSet(tt.name, pflag.ContinueOnError)
+			opts := NewOptions()
+			opts.AddFlags(fs)
+			argv := append([]string{"--pool-name", "pool", "--config-file", "fake-config.yaml"}, tt.args...)
+			if err := fs.Parse(argv); err != nil {
+				t.Fatalf("Failed to parse flags: %v", err)
+			}
+			if err := opts.Complete(); err != nil {
+				t.Fatalf("Complete failed unexpectedly with error: %v", err)
+			}
+			err := opts.Validate()
+			if tt.expectError && err == nil {
+				t.Fatalf("Expected a validation error but got none.")
+			}
+			if !tt.expectError && err != nil {
+				t.Fatalf("Validate failed unexpectedly with error: %v", err)
+			}
+		})
+	}
+}
+
+func TestModelServerTypeFlag(t *testing.T) {
+	tests := []struct {
+		name              string
+		args              []string
+		expectError       bool
+		expectQueueMetric string
+	}{
+		{name: "Default vLLM", args: []string{}, expectQueueMetric: "vllm:num_requests_waiting"},
+		{name: "SGLang", args: []string{"--model-server-type", "sglang"}, expectQueueMetric: "sglang:num_queue_reqs"},
+		{name: "Unknown type", args: []string{"--model-server-type", "unknown"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := pflag.NewFlagSet(tt.name, pflag.ContinueOnError)
+			opts := NewOptions()
+			opts.AddFlags(fs)
+			argv := append([]string{"--pool-name", "pool", "--config-file", "fake-config.yaml"}, tt.args...)
+			if err := fs.Parse(argv); err != nil {
+				t.Fatalf("Failed to parse flags: %v", err)
+			}
+			err := opts.Validate()
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("Expected a validation error but got none.")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Validate failed unexpectedly with error: %v", err)
+			}
+			config := opts.ModelServerMetricsConfig()
+			if config.TotalQueuedRequestsMetric != tt.expectQueueMetric {
+				t.Errorf("Expected queued requests metric %q, got %q", tt.expectQueueMetric, config.TotalQueuedRequestsMetric)
+			}
+			if config.ModelServerMetricsPath != opts.ModelServerMetricsPath {
+				t.Errorf("Expected metrics path %q, got %q", opts.ModelServerMetricsPath, config.ModelServerMetricsPath)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"sync"
 	"time"
 
 	extProcPb "github.com/envoyproxy/go-control-plane/envoy/service/ext_proc/v3"
@@ -40,10 +41,13 @@ import (
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/controller"
 	datalayerlogger "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer/logger"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datastore"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/decisioncompare"
 	fwkflowcontrol "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/flowcontrol"
 	fwkrh "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/gatewayprovider"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/handlers"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/requestcontrol"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/wirecapture"
 )
 
 // ExtProcServerRunner provides methods to manage an external process server.
@@ -62,6 +66,29 @@ type ExtProcServerRunner struct {
 	Parser                           fwkrh.Parser
 	SaturationDetector               fwkflowcontrol.SaturationDetector
 	UseExperimentalDatalayerV2       bool // Pluggable data layer feature flag
+
+	// DecisionComparer is set when running as a canary in decision compare mode.
+	DecisionComparer *decisioncompare.Comparer
+	// WireCapturer is set when the ext-proc messages of a sampled fraction of the streams are captured.
+	WireCapturer *wirecapture.Capturer
+	// GatewayProvider is the gateway implementation the ext-proc server exchanges with, the default one if nil.
+	GatewayProvider gatewayprovider.Provider
+	// RequestBodyConfig is how the request bodies are accumulated and the size from which they are rejected.
+	RequestBodyConfig handlers.RequestBodyConfig
+	// FairnessIDSource is where the fairness ID of the requests is read from, the fairness ID header if zero.
+	FairnessIDSource handlers.FairnessIDSource
+	// DrainTimeout bounds the drain of the server on shutdown, see Drain. Zero disables the drain.
+	DrainTimeout time.Duration
+	// RejectQueuedOnDrain rejects the requests queued by flow control when the drain starts, rather than dispatching
+	// them while draining.
+	RejectQueuedOnDrain bool
+	// StopFlowControl stops the flow controller, evicting the requests it queues. Nil when flow control is disabled.
+	StopFlowControl func()
+
+	// mu guards the gRPC and health servers, set once the server is started.
+	mu          sync.Mutex
+	srv         *grpc.Server
+	healthcheck *health.Server
 }
 
 // NewDefaultExtProcServerRunner creates a runner with default values.
@@ -82,7 +109,7 @@ func NewDefaultExtProcServerRunner() *ExtProcServerRunner {
 	return &ExtProcServerRunner{
 		GrpcPort:                         opts.GRPCPort,
 		GKNN:                             gknn,
-		ControllerCfg:                    ControllerConfig{true, true, true},
+		ControllerCfg:                    ControllerConfig{startCrdReconcilers: true, hasInferenceObjective: true, hasInferenceModelRewrites: true},
 		SecureServing:                    opts.SecureServing,
 		HealthChecking:                   opts.HealthChecking,
 		RefreshPrometheusMetricsInterval: opts.RefreshPrometheusMetricsInterval,
@@ -122,6 +149,26 @@ func (r *ExtProcServerRunner) SetupWithManager(mgr ctrl.Manager) error {
 		}
 	}
 
+	if r.ControllerCfg.watchNodes {
+		if err := (&controller.NodeReconciler{
+			Datastore: r.Datastore,
+			Reader:    mgr.GetClient(),
+		}).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("failed setting up NodeReconciler - %w", err)
+		}
+	}
+
+	if r.ControllerCfg.endpointSliceService != "" {
+		if err := (&controller.EndpointSliceReconciler{
+			Datastore:   r.Datastore,
+			Reader:      mgr.GetClient(),
+			ServiceName: r.ControllerCfg.endpointSliceService,
+		}).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("failed setting up EndpointSliceReconciler - %w", err)
+		}
+		return nil
+	}
+
 	if err := (&controller.PodReconciler{
 		Datastore: r.Datastore,
 		Reader:    mgr.GetClient(),
@@ -179,7 +226,22 @@ func (r *ExtProcServerRunner) AsRunnable(logger logr.Logger) manager.Runnable {
 			srv = grpc.NewServer()
 		}
 
+		r.mu.Lock()
+		r.srv = srv
+		r.mu.Unlock()
+
 		extProcServer := handlers.NewStreamingServer(r.Datastore, r.Director, r.Parser)
+		if r.DecisionComparer != nil {
+			extProcServer.SetDecisionComparer(r.DecisionComparer)
+		}
+		if r.WireCapturer != nil {
+			extProcServer.SetWireCapturer(r.WireCapturer)
+		}
+		if r.GatewayProvider != nil {
+			extProcServer.SetGatewayProvider(r.GatewayProvider)
+		}
+		extProcServer.SetRequestBodyConfig(r.RequestBodyConfig)
+		extProcServer.SetFairnessIDSource(r.FairnessIDSource)
 		extProcPb.RegisterExternalProcessorServer(srv, extProcServer)
 
 		if r.HealthChecking {
@@ -190,9 +252,57 @@ func (r *ExtProcServerRunner) AsRunnable(logger logr.Logger) manager.Runnable {
 			svcName := extProcPb.ExternalProcessor_ServiceDesc.ServiceName
 			logger.Info("Setting ExternalProcessor service status to SERVING", "serviceName", svcName)
 			healthcheck.SetServingStatus(svcName, healthgrpc.HealthCheckResponse_SERVING)
+			r.mu.Lock()
+			r.healthcheck = healthcheck
+			r.mu.Unlock()
 		}
 
 		// Forward to the gRPC runnable.
 		return runnable.GRPCServer("ext-proc", srv, r.GrpcPort).Start(ctx)
 	}))
 }
+
+// Drain gracefully stops the ext-proc server on shutdown, before the manager is stopped. It reports the ext-proc service
+// as not serving, stops accepting new streams and waits up to the drain timeout for the in-flight ones to complete,
+// i.e. for their queued requests to be dispatched and their scheduled requests to be served, then closes the remaining
+// streams. The flow controller is stopped once the server has drained, or when the drain starts if the queued requests
+// are rejected on drain.
+func (r *ExtProcServerRunner) Drain(logger logr.Logger) {
+	if r.DrainTimeout <= 0 {
+		return
+	}
+	if r.StopFlowControl != nil {
+		if r.RejectQueuedOnDrain {
+			r.StopFlowControl()
+		} else {
+			defer r.StopFlowControl()
+		}
+	}
+
+	r.mu.Lock()
+	srv, healthcheck := r.srv, r.healthcheck
+	r.mu.Unlock()
+	if srv == nil {
+		return
+	}
+	if healthcheck != nil {
+		healthcheck.Shutdown()
+	}
+
+	logger.Info("Draining the ext-proc server", "timeout", r.DrainTimeout, "rejectQueued", r.RejectQueuedOnDrain)
+	stopped := make(chan struct{})
+	go func() {
+		srv.GracefulStop()
+		close(stopped)
+	}()
+	timer := time.NewTimer(r.DrainTimeout)
+	defer timer.Stop()
+	select {
+	case <-stopped:
+		logger.Info("Drained the ext-proc server")
+	case <-timer.C:
+		logger.Info("Timed out draining the ext-proc server, closing the remaining streams")
+		srv.Stop()
+		<-stopped
+	}
+}
@@ -18,6 +18,7 @@ package server_test
 
 import (
 	"testing"
+	"time"
 
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
@@ -35,3 +36,32 @@ func TestRunnable(t *testing.T) {
 		t.Error("runner returned NeedLeaderElection = true, expected false")
 	}
 }
+
+func TestDrainStopsFlowControl(t *testing.T) {
+	tests := []struct {
+		name         string
+		drainTimeout time.Duration
+		rejectQueued bool
+		wantStopped  bool
+	}{
+		{name: "drain disabled", drainTimeout: 0, wantStopped: false},
+		{name: "dispatch queued requests", drainTimeout: time.Second, wantStopped: true},
+		{name: "reject queued requests", drainTimeout: time.Second, rejectQueued: true, wantStopped: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stopped := false
+			runner := server.NewDefaultExtProcServerRunner()
+			runner.DrainTimeout = tt.drainTimeout
+			runner.RejectQueuedOnDrain = tt.rejectQueued
+			runner.StopFlowControl = func() { stopped = true }
+
+			// The server is not started, so the drain only stops flow control.
+			runner.Drain(logutil.NewTestLogger())
+			if stopped != tt.wantStopped {
+				t.Errorf("flow control stopped = %t, expected %t", stopped, tt.wantStopped)
+			}
+		})
+	}
+}
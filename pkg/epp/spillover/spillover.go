@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package spillover forwards the requests overflowing the flow control queues of the EPP to a peer pool, e.g. the
+// gateway of another team's pool, instead of queuing or rejecting them, for a simple hierarchical sharing of capacity.
+package spillover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	reqcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/request"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/contracts"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+const (
+	// DefaultQueueThreshold is the default number of queued requests from which the requests are spilled over.
+	DefaultQueueThreshold = 100
+
+	// OutcomeSpilled is the outcome recorded for a request forwarded to the peer.
+	OutcomeSpilled = "spilled"
+	// OutcomeLoopPrevented is the outcome recorded for a request overflowing the queues that was kept, because it was
+	// already spilled over by another EPP.
+	OutcomeLoopPrevented = "loop_prevented"
+)
+
+// Config is the configuration of the spillover.
+type Config struct {
+	// PeerAddress is the host:port the overflowing requests are forwarded to, e.g. the gateway of the peer pool.
+	PeerAddress string
+	// QueueThreshold is the number of requests queued by flow control from which the requests are spilled over.
+	QueueThreshold int
+	// PoolName is the name of the pool of the EPP, sent to the peer with the spilled requests.
+	PoolName string
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c Config) Validate() error {
+	if c.PeerAddress == "" {
+		return errors.New("peer address must not be empty")
+	}
+	if _, _, err := net.SplitHostPort(c.PeerAddress); err != nil {
+		return fmt.Errorf("invalid peer address %q: %w", c.PeerAddress, err)
+	}
+	if c.QueueThreshold <= 0 {
+		return fmt.Errorf("queue threshold must be positive, got %d", c.QueueThreshold)
+	}
+	return nil
+}
+
+// QueueStats reports the statistics of the flow control queues.
+type QueueStats interface {
+	Stats() contracts.AggregateStats
+}
+
+// Spiller decides which requests are spilled over to the peer.
+type Spiller struct {
+	config Config
+	queues QueueStats
+}
+
+// NewSpiller creates a new spiller forwarding the requests to the peer of the given configuration while the given
+// queues hold at least its threshold of requests.
+func NewSpiller(config Config, queues QueueStats) (*Spiller, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return &Spiller{config: config, queues: queues}, nil
+}
+
+// Spill returns the address of the peer to forward the request with the given headers to, and true, if the flow
+// control queues are over the threshold. The request is marked as spilled over in its headers, so that it is not
+// spilled over again by the peer: the requests that were already spilled over are never forwarded, which prevents
+// spillover loops between pools.
+func (s *Spiller) Spill(ctx context.Context, headers map[string]string) (string, bool) {
+	queued := s.queues.Stats().TotalLen
+	if queued < uint64(s.config.QueueThreshold) {
+		return "", false
+	}
+	logger := log.FromContext(ctx)
+	if from, ok := headers[reqcommon.SpilledFromHeaderKey]; ok {
+		logger.V(logutil.DEBUG).Info("Queues over the spillover threshold, keeping request already spilled over",
+			"spilledFrom", from, "queued", queued)
+		metrics.RecordSpillover(s.config.PoolName, OutcomeLoopPrevented)
+		return "", false
+	}
+	headers[reqcommon.SpilledFromHeaderKey] = s.config.PoolName
+	logger.V(logutil.DEBUG).Info("Spilling request over to peer", "peer", s.config.PeerAddress, "queued", queued)
+	metrics.RecordSpillover(s.config.PoolName, OutcomeSpilled)
+	return s.config.PeerAddress, true
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package spillover
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	reqcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/request"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/contracts"
+)
+
+type fakeQueues struct {
+	queued uint64
+}
+
+func (q *fakeQueues) Stats() contracts.AggregateStats {
+	return contracts.AggregateStats{TotalLen: q.queued}
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		wantErr bool
+	}{
+		{name: "valid", config: Config{PeerAddress: "peer-gateway.team-b:80", QueueThreshold: 10}},
+		{name: "empty address", config: Config{QueueThreshold: 10}, wantErr: true},
+		{name: "address without port", config: Config{PeerAddress: "peer-gateway", QueueThreshold: 10}, wantErr: true},
+		{name: "non-positive threshold", config: Config{PeerAddress: "peer-gateway:80"}, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.config.Validate()
+			if test.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSpill(t *testing.T) {
+	queues := &fakeQueues{}
+	spiller, err := NewSpiller(Config{PeerAddress: "peer-gateway:80", QueueThreshold: 10, PoolName: "pool-a"}, queues)
+	require.NoError(t, err)
+
+	// Below the threshold, the requests are kept.
+	queues.queued = 9
+	headers := map[string]string{}
+	_, ok := spiller.Spill(context.Background(), headers)
+	assert.False(t, ok)
+	assert.NotContains(t, headers, reqcommon.SpilledFromHeaderKey)
+
+	// From the threshold, the requests are spilled over and marked.
+	queues.queued = 10
+	peer, ok := spiller.Spill(context.Background(), headers)
+	assert.True(t, ok)
+	assert.Equal(t, "peer-gateway:80", peer)
+	assert.Equal(t, "pool-a", headers[reqcommon.SpilledFromHeaderKey])
+
+	// The requests already spilled over are kept.
+	headers = map[string]string{reqcommon.SpilledFromHeaderKey: "pool-b"}
+	_, ok = spiller.Spill(context.Background(), headers)
+	assert.False(t, ok)
+	assert.Equal(t, "pool-b", headers[reqcommon.SpilledFromHeaderKey])
+}
@@ -32,6 +32,10 @@ var (
 		strings.ToLower(metadata.ObjectiveKey),
 		strings.ToLower(metadata.ModelNameRewriteKey),
 		strings.ToLower(metadata.SubsetFilterKey),
+		strings.ToLower(metadata.PinEndpointKey),
+		strings.ToLower(metadata.ExcludeEndpointsKey),
+		strings.ToLower(metadata.ForceProfileKey),
+		strings.ToLower(metadata.DisableCacheAffinityKey),
 	)
 
 	// OutputInjectionHeaders are headers EPP injects for the backend.
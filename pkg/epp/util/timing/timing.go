@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package timing provides checks of the timestamps, durations and rates derived from clocks that cannot be fully
+// trusted: the clocks of the model servers, which may be skewed from the one of the EPP, and the clock of the EPP
+// itself, which may jump on NTP steps or VM pauses.
+package timing
+
+import (
+	"math"
+	"time"
+)
+
+// Anomaly is the kind of anomaly of a timestamp, duration or rate. The empty Anomaly is none.
+type Anomaly string
+
+const (
+	// Negative is a negative duration or rate, e.g. measured across a backward step of the clock.
+	Negative Anomaly = "negative"
+	// Absurd is a duration or rate beyond any plausible value, e.g. measured across a VM pause or a forward step of
+	// the clock.
+	Absurd Anomaly = "absurd"
+	// Future is a timestamp ahead of the clock of the EPP by more than MaxClockSkew.
+	Future Anomaly = "future"
+)
+
+// MaxClockSkew is the skew tolerated between the clocks of the model servers and the clock of the EPP.
+const MaxClockSkew = time.Minute
+
+// ClampDuration clamps a duration to [0, maxDuration], and returns the anomaly of the duration, if any.
+func ClampDuration(d, maxDuration time.Duration) (time.Duration, Anomaly) {
+	switch {
+	case d < 0:
+		return 0, Negative
+	case d > maxDuration:
+		return maxDuration, Absurd
+	default:
+		return d, ""
+	}
+}
+
+// ClampRate clamps a rate to [0, maxRate], and returns the anomaly of the rate, if any. A NaN rate is clamped to 0.
+func ClampRate(rate, maxRate float64) (float64, Anomaly) {
+	switch {
+	case math.IsNaN(rate), rate < 0:
+		return 0, Negative
+	case rate > maxRate:
+		return maxRate, Absurd
+	default:
+		return rate, ""
+	}
+}
+
+// CheckTimestamp returns Future if a timestamp is ahead of now by more than MaxClockSkew, and no anomaly otherwise.
+// Timestamps in the past are not anomalies, as they may legitimately be old.
+func CheckTimestamp(ts, now time.Time) Anomaly {
+	if ts.Sub(now) > MaxClockSkew {
+		return Future
+	}
+	return ""
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package timing
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClampDuration(t *testing.T) {
+	tests := []struct {
+		name        string
+		d           time.Duration
+		want        time.Duration
+		wantAnomaly Anomaly
+	}{
+		{name: "plausible", d: time.Second, want: time.Second},
+		{name: "zero", d: 0, want: 0},
+		{name: "negative", d: -time.Second, want: 0, wantAnomaly: Negative},
+		{name: "absurd", d: 2 * time.Hour, want: time.Hour, wantAnomaly: Absurd},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, anomaly := ClampDuration(test.d, time.Hour)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantAnomaly, anomaly)
+		})
+	}
+}
+
+func TestClampRate(t *testing.T) {
+	tests := []struct {
+		name        string
+		rate        float64
+		want        float64
+		wantAnomaly Anomaly
+	}{
+		{name: "plausible", rate: 50, want: 50},
+		{name: "negative", rate: -1, want: 0, wantAnomaly: Negative},
+		{name: "nan", rate: math.NaN(), want: 0, wantAnomaly: Negative},
+		{name: "absurd", rate: 1e9, want: 100, wantAnomaly: Absurd},
+		{name: "infinite", rate: math.Inf(1), want: 100, wantAnomaly: Absurd},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, anomaly := ClampRate(test.rate, 100)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.wantAnomaly, anomaly)
+		})
+	}
+}
+
+func TestCheckTimestamp(t *testing.T) {
+	now := time.Now()
+	assert.Empty(t, CheckTimestamp(now.Add(-time.Hour), now), "past timestamps are not anomalies")
+	assert.Empty(t, CheckTimestamp(now.Add(MaxClockSkew/2), now), "skews within the tolerance are not anomalies")
+	assert.Equal(t, Future, CheckTimestamp(now.Add(2*MaxClockSkew), now))
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package whatif
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	// HandlerPath is the path on which the what-if admin API is served.
+	HandlerPath = "/admin/v1/what-if"
+
+	// maxRequestBytes bounds the size of a what-if request body.
+	maxRequestBytes = 64 * 1024
+)
+
+// NewHandler returns an http.Handler serving the what-if admin API:
+//
+//	POST - replays the recorded decisions against the topology changed by the Change of the request body, and returns
+//	       the Report of the projection.
+func NewHandler(recorder *Recorder, scheduler Scheduler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		change := Change{}
+		decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBytes))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&change); err != nil {
+			http.Error(w, fmt.Sprintf("failed to decode request body - %v", err), http.StatusBadRequest)
+			return
+		}
+		if err := change.validate(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		report, err := Simulate(r.Context(), scheduler, recorder.Completed(change.MaxRecords), change)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(report)
+	})
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package whatif projects the impact of hypothetical changes of the pool topology, e.g. removing pods or adding pods
+// of a slower class, by replaying the recent scheduling decisions of the EPP against the hypothetical topology with the
+// scheduler's own logic.
+package whatif
+
+import (
+	"sync"
+	"time"
+
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+// Record is a scheduling decision and its outcome.
+type Record struct {
+	Timestamp time.Time
+	// Request is the scheduled request.
+	Request *fwksched.InferenceRequest
+	// Endpoints are the snapshots of the candidate endpoints the request was scheduled on.
+	Endpoints []fwksched.Endpoint
+	// Picked is the "<namespace>/<name>" of the primary endpoint picked for the request.
+	Picked string
+	// Latency is the end-to-end latency of the request, set once it completed.
+	Latency time.Duration
+
+	completed bool
+}
+
+// Recorder keeps the latest scheduling decisions whose request completed successfully.
+type Recorder struct {
+	mu      sync.Mutex
+	records []*Record // ring buffer of the latest decisions
+	next    int
+	full    bool
+	// pending are the recorded decisions whose request did not complete yet, by request ID.
+	pending map[string]*Record
+}
+
+// NewRecorder returns a Recorder keeping the given number of decisions.
+func NewRecorder(capacity int) *Recorder {
+	return &Recorder{
+		records: make([]*Record, max(capacity, 1)),
+		pending: map[string]*Record{},
+	}
+}
+
+// RecordDecision records the scheduling decision of the given request. The candidate endpoints are cloned, since they
+// are owned by the scheduling cycle. Requests without an ID are not recorded, as their outcome cannot be matched.
+func (r *Recorder) RecordDecision(request *fwksched.InferenceRequest, endpoints []fwksched.Endpoint,
+	result *fwksched.SchedulingResult) {
+	picked := primaryEndpoint(result)
+	if request == nil || request.RequestId == "" || picked == "" {
+		return
+	}
+	snapshot := *request
+	snapshot.SchedulingResult = nil
+	record := &Record{
+		Timestamp: time.Now(),
+		Request:   &snapshot,
+		Endpoints: make([]fwksched.Endpoint, 0, len(endpoints)),
+		Picked:    picked,
+	}
+	for _, endpoint := range endpoints {
+		record.Endpoints = append(record.Endpoints, cloneEndpoint(endpoint))
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if evicted := r.records[r.next]; evicted != nil && !evicted.completed {
+		delete(r.pending, evicted.Request.RequestId)
+	}
+	r.records[r.next] = record
+	r.pending[request.RequestId] = record
+	r.next = (r.next + 1) % len(r.records)
+	r.full = r.full || r.next == 0
+}
+
+// RecordOutcome records the outcome of the request of the given ID. Failed requests are dropped, since their latency
+// does not reflect the load of their endpoint.
+func (r *Recorder) RecordOutcome(requestID string, latency time.Duration, failed bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	record, ok := r.pending[requestID]
+	if !ok {
+		return
+	}
+	delete(r.pending, requestID)
+	if failed {
+		return
+	}
+	record.Latency = latency
+	record.completed = true
+}
+
+// Completed returns up to the given number of the latest completed records, oldest first. A non-positive limit returns
+// all of them.
+func (r *Recorder) Completed(limit int) []*Record {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ordered := []*Record{}
+	if r.full {
+		ordered = append(ordered, r.records[r.next:]...)
+	}
+	ordered = append(ordered, r.records[:r.next]...)
+	completed := make([]*Record, 0, len(ordered))
+	for _, record := range ordered {
+		if record != nil && record.completed {
+			completed = append(completed, record)
+		}
+	}
+	if limit > 0 && len(completed) > limit {
+		completed = completed[len(completed)-limit:]
+	}
+	return completed
+}
+
+// primaryEndpoint returns the "<namespace>/<name>" of the primary endpoint of the given result, if any.
+func primaryEndpoint(result *fwksched.SchedulingResult) string {
+	if result == nil {
+		return ""
+	}
+	profileResult := result.ProfileResults[result.PrimaryProfileName]
+	if profileResult == nil || len(profileResult.TargetEndpoints) == 0 {
+		return ""
+	}
+	return endpointName(profileResult.TargetEndpoints[0])
+}
+
+func endpointName(endpoint fwksched.Endpoint) string {
+	if metadata := endpoint.GetMetadata(); metadata != nil {
+		return metadata.NamespacedName.String()
+	}
+	return ""
+}
+
+func cloneEndpoint(endpoint fwksched.Endpoint) fwksched.Endpoint {
+	return fwksched.NewEndpoint(endpoint.GetMetadata(), endpoint.GetMetrics(), endpoint)
+}
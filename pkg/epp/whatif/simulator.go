@@ -0,0 +1,301 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package whatif
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+// addedPodPrefix is the name prefix of the hypothetical pods added by a change.
+const addedPodPrefix = "what-if-added-"
+
+// Scheduler runs scheduling cycles without side effects on the scheduler metrics.
+type Scheduler interface {
+	Simulate(ctx context.Context, request *fwksched.InferenceRequest, candidateEndpoints []fwksched.Endpoint) (*fwksched.SchedulingResult, error)
+}
+
+// Change is a hypothetical change of the pool topology.
+type Change struct {
+	// RemoveEndpoints are the "<namespace>/<name>" of the endpoints to remove.
+	RemoveEndpoints []string `json:"removeEndpoints,omitempty"`
+	// RemovePods is the number of endpoints to remove in addition to RemoveEndpoints, the last ones by name.
+	RemovePods int `json:"removePods,omitempty"`
+	// AddPods is the number of endpoints to add.
+	AddPods int `json:"addPods,omitempty"`
+	// AddedPodSpeed is the throughput of the added endpoints relative to the current ones, e.g. 0.5 for pods of a class
+	// twice as slow. Default: 1.
+	AddedPodSpeed float64 `json:"addedPodSpeed,omitempty"`
+	// MaxRecords bounds the number of recorded decisions replayed, the latest ones. Default: all.
+	MaxRecords int `json:"maxRecords,omitempty"`
+}
+
+func (c *Change) validate() error {
+	if c.RemovePods < 0 || c.AddPods < 0 || c.MaxRecords < 0 {
+		return errors.New("removePods, addPods and maxRecords must be >= 0")
+	}
+	if c.AddedPodSpeed < 0 || math.IsNaN(c.AddedPodSpeed) || math.IsInf(c.AddedPodSpeed, 0) {
+		return fmt.Errorf("addedPodSpeed must be > 0, got %v", c.AddedPodSpeed)
+	}
+	if c.AddedPodSpeed == 0 {
+		c.AddedPodSpeed = 1
+	}
+	return nil
+}
+
+// Summary summarizes the latency and saturation of the replayed requests.
+type Summary struct {
+	// Requests is the number of replayed requests.
+	Requests int `json:"requests"`
+	// SchedulingFailures is the number of requests the scheduler failed to schedule.
+	SchedulingFailures int `json:"schedulingFailures"`
+	// Latencies of the scheduled requests, in milliseconds.
+	MeanLatencyMs float64 `json:"meanLatencyMs"`
+	P50LatencyMs  float64 `json:"p50LatencyMs"`
+	P90LatencyMs  float64 `json:"p90LatencyMs"`
+	// MeanKVCacheUsage is the mean KV cache usage of the endpoints of the pool, between 0 and 1.
+	MeanKVCacheUsage float64 `json:"meanKvCacheUsage"`
+	// MeanWaitingQueueSize is the mean number of queued requests of the endpoints of the pool.
+	MeanWaitingQueueSize float64 `json:"meanWaitingQueueSize"`
+	// QueuedPickRatio is the ratio of the scheduled requests picked on an endpoint with queued requests.
+	QueuedPickRatio float64 `json:"queuedPickRatio"`
+}
+
+// Report compares the recorded requests with their projection on the changed topology.
+type Report struct {
+	Change    Change  `json:"change"`
+	Baseline  Summary `json:"baseline"`
+	Projected Summary `json:"projected"`
+}
+
+// Simulate replays the given records against the topology of their candidate endpoints changed by the given change.
+//
+// The projection is a first-order model: the load of the pool, i.e. its running and queued requests and its KV cache
+// usage, is spread over the changed pool in proportion to the throughput of its endpoints, and the latency of a request
+// scales with the load of the endpoint it is picked on and inversely with the endpoint throughput. Stateful plugins,
+// e.g. prefix cache scorers, score the replayed requests with their current state.
+func Simulate(ctx context.Context, scheduler Scheduler, records []*Record, change Change) (Report, error) {
+	if err := change.validate(); err != nil {
+		return Report{}, err
+	}
+	report := Report{Change: change}
+	baseline, projected := newAccumulator(), newAccumulator()
+	for _, record := range records {
+		if ctx.Err() != nil {
+			return Report{}, ctx.Err()
+		}
+		original := endpointsByName(record.Endpoints)
+		picked, ok := original[record.Picked]
+		if !ok {
+			continue
+		}
+		baseline.addPool(record.Endpoints)
+		baseline.addPick(record.Latency, picked)
+
+		endpoints, speeds := changeTopology(record.Endpoints, change)
+		projected.addPool(endpoints)
+		result, err := scheduler.Simulate(ctx, record.Request, endpoints)
+		target := ""
+		if err == nil {
+			target = primaryEndpoint(result)
+		}
+		hypothetical, ok := endpointsByName(endpoints)[target]
+		if !ok {
+			projected.addFailure()
+			continue
+		}
+		latency := float64(record.Latency) * float64(load(hypothetical)+1) / float64(load(picked)+1) / speeds[target]
+		projected.addPick(time.Duration(latency), hypothetical)
+	}
+	report.Baseline = baseline.summary()
+	report.Projected = projected.summary()
+	return report, nil
+}
+
+// changeTopology returns the candidate endpoints changed by the given change, and the relative throughput of each
+// endpoint by name. The endpoints are clones, which the scheduler may annotate.
+func changeTopology(endpoints []fwksched.Endpoint, change Change) ([]fwksched.Endpoint, map[string]float64) {
+	names := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		names = append(names, endpointName(endpoint))
+	}
+	slices.Sort(names)
+	removed := map[string]bool{}
+	for _, name := range change.RemoveEndpoints {
+		removed[name] = true
+	}
+	for i := len(names) - 1; i >= 0 && len(removed) < len(change.RemoveEndpoints)+change.RemovePods; i-- {
+		removed[names[i]] = true
+	}
+
+	remaining := len(endpoints)
+	for _, name := range names {
+		if removed[name] {
+			remaining--
+		}
+	}
+	capacity := float64(remaining) + float64(change.AddPods)*change.AddedPodSpeed
+	changed := make([]fwksched.Endpoint, 0, remaining+change.AddPods)
+	speeds := make(map[string]float64, remaining+change.AddPods)
+	if capacity <= 0 || len(endpoints) == 0 {
+		return changed, speeds
+	}
+	factor := float64(len(endpoints)) / capacity
+
+	var running, waiting, kvCache float64
+	for _, endpoint := range endpoints {
+		if metrics := endpoint.GetMetrics(); metrics != nil {
+			running += float64(metrics.RunningRequestsSize)
+			waiting += float64(metrics.WaitingQueueSize)
+			kvCache += metrics.KVCacheUsagePercent
+		}
+		name := endpointName(endpoint)
+		if removed[name] {
+			continue
+		}
+		clone := cloneEndpoint(endpoint)
+		scaleLoad(clone, factor)
+		changed = append(changed, clone)
+		speeds[name] = 1
+	}
+
+	// The added endpoints are modeled after the first endpoint, with the mean load of the pool.
+	count := float64(len(endpoints))
+	template := endpoints[0]
+	for i := range change.AddPods {
+		metadata := template.GetMetadata().Clone()
+		if metadata == nil {
+			metadata = &fwkdl.EndpointMetadata{}
+		}
+		metadata.NamespacedName = types.NamespacedName{Namespace: metadata.NamespacedName.Namespace,
+			Name: fmt.Sprintf("%s%d", addedPodPrefix, i)}
+		metadata.PodName = metadata.NamespacedName.Name
+		metadata.Address = metadata.NamespacedName.Name
+		metrics := template.GetMetrics().Clone()
+		if metrics != nil {
+			metrics.RunningRequestsSize = int(math.Round(running / count * change.AddedPodSpeed * factor))
+			metrics.WaitingQueueSize = int(math.Round(waiting / count * change.AddedPodSpeed * factor))
+			metrics.KVCacheUsagePercent = min(kvCache/count*factor, 1)
+			metrics.ActiveModels = map[string]int{}
+			metrics.WaitingModels = map[string]int{}
+		}
+		added := fwksched.NewEndpoint(metadata, metrics, nil)
+		changed = append(changed, added)
+		speeds[metadata.NamespacedName.String()] = change.AddedPodSpeed
+	}
+	return changed, speeds
+}
+
+// scaleLoad scales the load of the given endpoint by the given factor.
+func scaleLoad(endpoint fwksched.Endpoint, factor float64) {
+	metrics := endpoint.GetMetrics()
+	if metrics == nil {
+		return
+	}
+	metrics.RunningRequestsSize = int(math.Round(float64(metrics.RunningRequestsSize) * factor))
+	metrics.WaitingQueueSize = int(math.Round(float64(metrics.WaitingQueueSize) * factor))
+	metrics.KVCacheUsagePercent = min(metrics.KVCacheUsagePercent*factor, 1)
+}
+
+// load returns the number of requests running or queued on the given endpoint.
+func load(endpoint fwksched.Endpoint) int {
+	metrics := endpoint.GetMetrics()
+	if metrics == nil {
+		return 0
+	}
+	return metrics.RunningRequestsSize + metrics.WaitingQueueSize
+}
+
+func endpointsByName(endpoints []fwksched.Endpoint) map[string]fwksched.Endpoint {
+	byName := make(map[string]fwksched.Endpoint, len(endpoints))
+	for _, endpoint := range endpoints {
+		byName[endpointName(endpoint)] = endpoint
+	}
+	return byName
+}
+
+// accumulator accumulates the statistics of a Summary.
+type accumulator struct {
+	requests, failures, queuedPicks int
+	latencies                       []float64
+	endpoints                       int
+	kvCache, waiting                float64
+}
+
+func newAccumulator() *accumulator {
+	return &accumulator{}
+}
+
+// addPool accounts for the endpoints of the pool a request was scheduled on.
+func (a *accumulator) addPool(endpoints []fwksched.Endpoint) {
+	for _, endpoint := range endpoints {
+		if metrics := endpoint.GetMetrics(); metrics != nil {
+			a.endpoints++
+			a.kvCache += metrics.KVCacheUsagePercent
+			a.waiting += float64(metrics.WaitingQueueSize)
+		}
+	}
+}
+
+func (a *accumulator) addPick(latency time.Duration, picked fwksched.Endpoint) {
+	a.requests++
+	a.latencies = append(a.latencies, float64(latency)/float64(time.Millisecond))
+	if metrics := picked.GetMetrics(); metrics != nil && metrics.WaitingQueueSize > 0 {
+		a.queuedPicks++
+	}
+}
+
+func (a *accumulator) addFailure() {
+	a.requests++
+	a.failures++
+}
+
+func (a *accumulator) summary() Summary {
+	summary := Summary{Requests: a.requests, SchedulingFailures: a.failures}
+	if a.endpoints > 0 {
+		summary.MeanKVCacheUsage = a.kvCache / float64(a.endpoints)
+		summary.MeanWaitingQueueSize = a.waiting / float64(a.endpoints)
+	}
+	if len(a.latencies) == 0 {
+		return summary
+	}
+	slices.Sort(a.latencies)
+	total := 0.0
+	for _, latency := range a.latencies {
+		total += latency
+	}
+	summary.MeanLatencyMs = total / float64(len(a.latencies))
+	summary.P50LatencyMs = percentile(a.latencies, 0.5)
+	summary.P90LatencyMs = percentile(a.latencies, 0.9)
+	summary.QueuedPickRatio = float64(a.queuedPicks) / float64(len(a.latencies))
+	return summary
+}
+
+// percentile returns the given percentile of the given sorted values.
+func percentile(sorted []float64, p float64) float64 {
+	index := int(math.Ceil(p*float64(len(sorted)))) - 1
+	return sorted[min(max(index, 0), len(sorted)-1)]
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package whatif
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+// leastLoadedScheduler picks the endpoint with the fewest running and queued requests, the first one by order on ties.
+type leastLoadedScheduler struct{}
+
+func (leastLoadedScheduler) Simulate(_ context.Context, _ *fwksched.InferenceRequest,
+	endpoints []fwksched.Endpoint) (*fwksched.SchedulingResult, error) {
+	if len(endpoints) == 0 {
+		return nil, errors.New("no candidate endpoints")
+	}
+	best := endpoints[0]
+	for _, endpoint := range endpoints[1:] {
+		if load(endpoint) < load(best) {
+			best = endpoint
+		}
+	}
+	return result(best), nil
+}
+
+func result(endpoint fwksched.Endpoint) *fwksched.SchedulingResult {
+	return &fwksched.SchedulingResult{
+		PrimaryProfileName: "default",
+		ProfileResults:     map[string]*fwksched.ProfileRunResult{"default": {TargetEndpoints: []fwksched.Endpoint{endpoint}}},
+	}
+}
+
+func newEndpoint(name string, running, waiting int, kvCache float64) fwksched.Endpoint {
+	return fwksched.NewEndpoint(
+		&fwkdl.EndpointMetadata{NamespacedName: types.NamespacedName{Namespace: "default", Name: name}, PodName: name},
+		&fwkdl.Metrics{RunningRequestsSize: running, WaitingQueueSize: waiting, KVCacheUsagePercent: kvCache},
+		nil)
+}
+
+func TestRecorder(t *testing.T) {
+	recorder := NewRecorder(2)
+	endpoints := []fwksched.Endpoint{newEndpoint("pod1", 1, 0, 0.1)}
+	for _, id := range []string{"req-1", "req-2", "req-3"} {
+		recorder.RecordDecision(&fwksched.InferenceRequest{RequestId: id}, endpoints, result(endpoints[0]))
+	}
+	recorder.RecordDecision(&fwksched.InferenceRequest{}, endpoints, result(endpoints[0]))
+	recorder.RecordDecision(&fwksched.InferenceRequest{RequestId: "req-4"}, endpoints, nil)
+
+	recorder.RecordOutcome("req-1", time.Second, false) // overwritten by req-3
+	recorder.RecordOutcome("req-2", time.Second, true)
+	recorder.RecordOutcome("req-3", 2*time.Second, false)
+	assert.Empty(t, recorder.pending)
+
+	completed := recorder.Completed(0)
+	require.Len(t, completed, 1)
+	assert.Equal(t, "req-3", completed[0].Request.RequestId)
+	assert.Equal(t, "default/pod1", completed[0].Picked)
+	assert.Equal(t, 2*time.Second, completed[0].Latency)
+
+	// The snapshots are not affected by later changes of the endpoints.
+	endpoints[0].GetMetrics().RunningRequestsSize = 10
+	assert.Equal(t, 1, completed[0].Endpoints[0].GetMetrics().RunningRequestsSize)
+}
+
+func TestSimulate(t *testing.T) {
+	endpoints := []fwksched.Endpoint{
+		newEndpoint("pod1", 4, 0, 0.4),
+		newEndpoint("pod2", 2, 0, 0.2),
+		newEndpoint("pod3", 6, 2, 0.6),
+		newEndpoint("pod4", 4, 0, 0.4),
+	}
+	records := []*Record{{
+		Request:   &fwksched.InferenceRequest{RequestId: "req-1"},
+		Endpoints: endpoints,
+		Picked:    "default/pod2",
+		Latency:   300 * time.Millisecond,
+		completed: true,
+	}}
+
+	tests := []struct {
+		name          string
+		change        Change
+		wantLatencyMs float64
+		wantFailures  int
+	}{
+		{
+			name:          "no change",
+			change:        Change{},
+			wantLatencyMs: 300,
+		},
+		{
+			// The load of the pool doubles on the two remaining pods: pod2 runs 4 requests instead of 2.
+			name:          "remove half of the pods",
+			change:        Change{RemovePods: 2},
+			wantLatencyMs: 500,
+		},
+		{
+			// The load of the pool spreads over the capacity of 6 pods: pod2 runs 1 request instead of 2. The added pods, twice
+			// as slow, run 1 request each, which is not less than pod2.
+			name:          "add slower pods",
+			change:        Change{AddPods: 4, AddedPodSpeed: 0.5},
+			wantLatencyMs: 200,
+		},
+		{
+			name:         "remove all the pods",
+			change:       Change{RemoveEndpoints: []string{"default/pod1"}, RemovePods: 3},
+			wantFailures: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			report, err := Simulate(context.Background(), leastLoadedScheduler{}, records, test.change)
+			require.NoError(t, err)
+			assert.Equal(t, 1, report.Baseline.Requests)
+			assert.InDelta(t, 300, report.Baseline.MeanLatencyMs, 1e-9)
+			assert.InDelta(t, 0.4, report.Baseline.MeanKVCacheUsage, 1e-9)
+			assert.InDelta(t, 0.5, report.Baseline.MeanWaitingQueueSize, 1e-9)
+			assert.Equal(t, test.wantFailures, report.Projected.SchedulingFailures)
+			assert.InDelta(t, test.wantLatencyMs, report.Projected.MeanLatencyMs, 1e-9)
+		})
+	}
+
+	// The recorded endpoints are not modified by the simulations.
+	assert.Equal(t, 2, endpoints[1].GetMetrics().RunningRequestsSize)
+
+	_, err := Simulate(context.Background(), leastLoadedScheduler{}, records, Change{AddedPodSpeed: -1})
+	assert.Error(t, err)
+}
+
+func TestHandler(t *testing.T) {
+	recorder := NewRecorder(10)
+	endpoints := []fwksched.Endpoint{newEndpoint("pod1", 1, 0, 0.1), newEndpoint("pod2", 1, 0, 0.1)}
+	recorder.RecordDecision(&fwksched.InferenceRequest{RequestId: "req-1"}, endpoints, result(endpoints[0]))
+	recorder.RecordOutcome("req-1", 100*time.Millisecond, false)
+	handler := NewHandler(recorder, leastLoadedScheduler{})
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, HandlerPath, strings.NewReader(`{"removePods": 1}`)))
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"projected":{"requests":1`)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, HandlerPath, strings.NewReader(`{"removePods": -1}`)))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, HandlerPath, strings.NewReader(`{"unknown": 1}`)))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, HandlerPath, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wirecapture
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// HandlerPath is the path on which the wire capture API is served.
+const HandlerPath = "/debug/v1/extproc-captures"
+
+// NewHandler returns an http.Handler serving the wire capture API:
+//
+//	GET    - returns the captured streams, oldest first, as a JSON array of Stream.
+//	DELETE - drops the captured streams.
+func NewHandler(capturer *Capturer) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("Content-Disposition", `attachment; filename="extproc-captures.json"`)
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(capturer.Streams())
+		case http.MethodDelete:
+			capturer.Clear()
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.Header().Set("Allow", strings.Join([]string{http.MethodGet, http.MethodDelete}, ", "))
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+}
@@ -0,0 +1,288 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package wirecapture records the ext-proc messages exchanged with the proxy on a sampled fraction of the streams, so
+// that protocol bugs specific to a Gateway implementation can be reproduced offline.
+//
+// The messages are sanitized before they are recorded: the values of sensitive headers are redacted and the bodies are
+// dropped, or truncated when body capture is enabled. The captured streams are kept in a ring buffer and are encoded
+// with protojson, so that each message can be decoded back into its protobuf type.
+package wirecapture
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"math/rand/v2"
+	"strings"
+	"sync"
+	"time"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	extProcPb "github.com/envoyproxy/go-control-plane/envoy/service/ext_proc/v3"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	reqcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/request"
+)
+
+const (
+	// DirectionRequest is the direction of the messages received from the proxy.
+	DirectionRequest = "request"
+	// DirectionResponse is the direction of the messages sent to the proxy.
+	DirectionResponse = "response"
+
+	// redactedValue replaces the values of the redacted headers.
+	redactedValue = "REDACTED"
+)
+
+// DefaultRedactedHeaders are the headers whose values are always redacted.
+var DefaultRedactedHeaders = []string{"authorization", "proxy-authorization", "cookie", "set-cookie", "x-api-key", "api-key"}
+
+// Config configures the capture.
+type Config struct {
+	// SampleFraction is the fraction of the streams captured, between 0 and 1.
+	SampleFraction float64
+	// Streams is the number of the latest captured streams kept.
+	Streams int
+	// MaxMessagesPerStream bounds the number of messages captured per stream. The messages beyond it are counted but
+	// not recorded.
+	MaxMessagesPerStream int
+	// MaxBodyBytes is the number of bytes of each body kept. Bodies are dropped when it is 0.
+	MaxBodyBytes int
+	// RedactedHeaders are the headers whose values are redacted, in addition to DefaultRedactedHeaders.
+	RedactedHeaders []string
+}
+
+// Validate checks the configuration.
+func (c Config) Validate() error {
+	if c.SampleFraction < 0 || c.SampleFraction > 1 {
+		return errors.New("sample fraction must be between 0 and 1")
+	}
+	if c.Streams <= 0 {
+		return errors.New("number of streams must be positive")
+	}
+	if c.MaxMessagesPerStream <= 0 {
+		return errors.New("maximum number of messages per stream must be positive")
+	}
+	if c.MaxBodyBytes < 0 {
+		return errors.New("maximum body bytes must not be negative")
+	}
+	return nil
+}
+
+// Message is a captured ext-proc message.
+type Message struct {
+	Timestamp time.Time `json:"timestamp"`
+	// Direction is DirectionRequest for a ProcessingRequest and DirectionResponse for a ProcessingResponse.
+	Direction string `json:"direction"`
+	// Message is the protojson encoding of the sanitized message.
+	Message json.RawMessage `json:"message"`
+}
+
+// Stream is a captured ext-proc stream.
+type Stream struct {
+	// RequestID is the ID of the request of the stream, if known.
+	RequestID string    `json:"requestId,omitempty"`
+	StartedAt time.Time `json:"startedAt"`
+	EndedAt   time.Time `json:"endedAt"`
+	Messages  []Message `json:"messages"`
+	// DroppedMessages is the number of messages beyond the maximum number of messages per stream.
+	DroppedMessages int `json:"droppedMessages,omitempty"`
+}
+
+// Capturer samples the ext-proc streams and keeps the latest captured ones. It is safe for concurrent use.
+type Capturer struct {
+	config   Config
+	redacted map[string]bool
+
+	mu      sync.Mutex
+	streams []*Stream // ring buffer of the latest captured streams
+	next    int
+	full    bool
+}
+
+// NewCapturer returns a Capturer with the given configuration, which must be valid.
+func NewCapturer(config Config) *Capturer {
+	redacted := map[string]bool{}
+	for _, header := range append(DefaultRedactedHeaders, config.RedactedHeaders...) {
+		redacted[strings.ToLower(header)] = true
+	}
+	return &Capturer{
+		config:   config,
+		redacted: redacted,
+		streams:  make([]*Stream, max(config.Streams, 1)),
+	}
+}
+
+// Wrap returns the given stream server, wrapped so that its messages are captured if the stream is sampled.
+func (c *Capturer) Wrap(srv extProcPb.ExternalProcessor_ProcessServer) extProcPb.ExternalProcessor_ProcessServer {
+	if c.config.SampleFraction <= 0 || rand.Float64() >= c.config.SampleFraction {
+		return srv
+	}
+	return &capturingServer{
+		ExternalProcessor_ProcessServer: srv,
+		capturer:                        c,
+		stream:                          &Stream{StartedAt: time.Now()},
+	}
+}
+
+// Finish stores the stream of the given server, if it was captured. It must be called once the stream is complete.
+func (c *Capturer) Finish(srv extProcPb.ExternalProcessor_ProcessServer) {
+	capturing, ok := srv.(*capturingServer)
+	if !ok {
+		return
+	}
+	capturing.mu.Lock()
+	stream := capturing.stream
+	stream.EndedAt = time.Now()
+	capturing.finished = true
+	capturing.mu.Unlock()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.streams[c.next] = stream
+	c.next = (c.next + 1) % len(c.streams)
+	c.full = c.full || c.next == 0
+}
+
+// Streams returns the captured streams, oldest first.
+func (c *Capturer) Streams() []*Stream {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ordered := []*Stream{}
+	if c.full {
+		ordered = append(ordered, c.streams[c.next:]...)
+	}
+	return append(ordered, c.streams[:c.next]...)
+}
+
+// Clear drops the captured streams.
+func (c *Capturer) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.streams = make([]*Stream, len(c.streams))
+	c.next = 0
+	c.full = false
+}
+
+// capturingServer records the messages received and sent on an ext-proc stream. Recv and Send are called from
+// different goroutines.
+type capturingServer struct {
+	extProcPb.ExternalProcessor_ProcessServer
+	capturer *Capturer
+
+	mu     sync.Mutex
+	stream *Stream
+	// finished is set once the stream is stored, after which the messages are no longer recorded.
+	finished bool
+}
+
+func (s *capturingServer) Recv() (*extProcPb.ProcessingRequest, error) {
+	req, err := s.ExternalProcessor_ProcessServer.Recv()
+	if err == nil {
+		s.record(DirectionRequest, req)
+	}
+	return req, err
+}
+
+func (s *capturingServer) Send(resp *extProcPb.ProcessingResponse) error {
+	s.record(DirectionResponse, resp)
+	return s.ExternalProcessor_ProcessServer.Send(resp)
+}
+
+func (s *capturingServer) record(direction string, msg proto.Message) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.finished {
+		return
+	}
+	if len(s.stream.Messages) >= s.capturer.config.MaxMessagesPerStream {
+		s.stream.DroppedMessages++
+		return
+	}
+	if req, ok := msg.(*extProcPb.ProcessingRequest); ok && s.stream.RequestID == "" {
+		s.stream.RequestID = requestID(req)
+	}
+	encoded, err := protojson.Marshal(s.capturer.sanitize(msg))
+	if err != nil {
+		encoded, _ = json.Marshal(map[string]string{"error": err.Error()})
+	}
+	s.stream.Messages = append(s.stream.Messages, Message{Timestamp: time.Now(), Direction: direction, Message: encoded})
+}
+
+// requestID returns the request ID header of the given request headers message, if any.
+func requestID(req *extProcPb.ProcessingRequest) string {
+	for _, header := range req.GetRequestHeaders().GetHeaders().GetHeaders() {
+		if strings.EqualFold(header.GetKey(), reqcommon.RequestIdHeaderKey) {
+			return cmp.Or(header.GetValue(), string(header.GetRawValue()))
+		}
+	}
+	return ""
+}
+
+// sanitize returns a copy of the given message with the values of the redacted headers replaced and the bodies
+// truncated to the maximum body size.
+func (c *Capturer) sanitize(msg proto.Message) proto.Message {
+	clone := proto.Clone(msg)
+	c.sanitizeMessage(clone.ProtoReflect())
+	return clone
+}
+
+func (c *Capturer) sanitizeMessage(m protoreflect.Message) {
+	if header, ok := m.Interface().(*corev3.HeaderValue); ok {
+		if c.redacted[strings.ToLower(header.GetKey())] {
+			if header.GetValue() != "" {
+				header.Value = redactedValue
+			}
+			if len(header.GetRawValue()) > 0 {
+				header.RawValue = []byte(redactedValue)
+			}
+		}
+		return
+	}
+	// The fields are collected first, since a message must not be mutated while its fields are ranged over.
+	type field struct {
+		descriptor protoreflect.FieldDescriptor
+		value      protoreflect.Value
+	}
+	fields := []field{}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		fields = append(fields, field{descriptor: fd, value: v})
+		return true
+	})
+	for _, f := range fields {
+		switch {
+		case f.descriptor.IsList() && f.descriptor.Message() != nil:
+			list := f.value.List()
+			for i := range list.Len() {
+				c.sanitizeMessage(list.Get(i).Message())
+			}
+		case f.descriptor.IsMap() && f.descriptor.MapValue().Message() != nil:
+			f.value.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				c.sanitizeMessage(v.Message())
+				return true
+			})
+		case f.descriptor.Message() != nil && !f.descriptor.IsList() && !f.descriptor.IsMap():
+			c.sanitizeMessage(f.value.Message())
+		case f.descriptor.Kind() == protoreflect.BytesKind && f.descriptor.Name() == "body":
+			if body := f.value.Bytes(); len(body) > c.config.MaxBodyBytes {
+				m.Set(f.descriptor, protoreflect.ValueOfBytes(body[:c.config.MaxBodyBytes]))
+			}
+		}
+	}
+}
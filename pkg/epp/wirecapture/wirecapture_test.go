@@ -0,0 +1,210 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wirecapture
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	extProcPb "github.com/envoyproxy/go-control-plane/envoy/service/ext_proc/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// mockProcessServer implements ExternalProcessor_ProcessServer, returning the given requests from Recv.
+type mockProcessServer struct {
+	requests      []*extProcPb.ProcessingRequest
+	sentResponses []*extProcPb.ProcessingResponse
+}
+
+func (m *mockProcessServer) Recv() (*extProcPb.ProcessingRequest, error) {
+	req := m.requests[0]
+	m.requests = m.requests[1:]
+	return req, nil
+}
+
+func (m *mockProcessServer) Send(resp *extProcPb.ProcessingResponse) error {
+	m.sentResponses = append(m.sentResponses, resp)
+	return nil
+}
+
+// Unused methods to satisfy the interface.
+func (m *mockProcessServer) SetHeader(metadata.MD) error  { return nil }
+func (m *mockProcessServer) SendHeader(metadata.MD) error { return nil }
+func (m *mockProcessServer) SetTrailer(metadata.MD)       {}
+func (m *mockProcessServer) Context() context.Context     { return context.Background() }
+func (m *mockProcessServer) SendMsg(any) error            { return nil }
+func (m *mockProcessServer) RecvMsg(any) error            { return nil }
+
+func headersRequest() *extProcPb.ProcessingRequest {
+	return &extProcPb.ProcessingRequest{
+		Request: &extProcPb.ProcessingRequest_RequestHeaders{
+			RequestHeaders: &extProcPb.HttpHeaders{
+				Headers: &corev3.HeaderMap{Headers: []*corev3.HeaderValue{
+					{Key: "x-request-id", RawValue: []byte("req-1")},
+					{Key: "Authorization", RawValue: []byte("Bearer secret")},
+					{Key: "x-tenant-token", Value: "tenant-secret"},
+					{Key: "content-type", RawValue: []byte("application/json")},
+				}},
+			},
+		},
+	}
+}
+
+func bodyRequest() *extProcPb.ProcessingRequest {
+	return &extProcPb.ProcessingRequest{
+		Request: &extProcPb.ProcessingRequest_RequestBody{
+			RequestBody: &extProcPb.HttpBody{Body: []byte(`{"prompt":"private"}`), EndOfStream: true},
+		},
+	}
+}
+
+func config() Config {
+	return Config{SampleFraction: 1, Streams: 2, MaxMessagesPerStream: 10, RedactedHeaders: []string{"X-Tenant-Token"}}
+}
+
+func TestCapturer(t *testing.T) {
+	capturer := NewCapturer(config())
+	mock := &mockProcessServer{requests: []*extProcPb.ProcessingRequest{headersRequest(), bodyRequest()}}
+
+	srv := capturer.Wrap(mock)
+	_, err := srv.Recv()
+	require.NoError(t, err)
+	_, err = srv.Recv()
+	require.NoError(t, err)
+	response := &extProcPb.ProcessingResponse{
+		Response: &extProcPb.ProcessingResponse_RequestBody{
+			RequestBody: &extProcPb.BodyResponse{Response: &extProcPb.CommonResponse{
+				BodyMutation: &extProcPb.BodyMutation{Mutation: &extProcPb.BodyMutation_Body{Body: []byte("rewritten")}},
+			}},
+		},
+	}
+	require.NoError(t, srv.Send(response))
+	capturer.Finish(srv)
+
+	assert.Len(t, mock.sentResponses, 1)
+	assert.Equal(t, []byte("rewritten"), mock.sentResponses[0].GetRequestBody().GetResponse().GetBodyMutation().GetBody(),
+		"the sent message should not be sanitized")
+
+	streams := capturer.Streams()
+	require.Len(t, streams, 1)
+	stream := streams[0]
+	assert.Equal(t, "req-1", stream.RequestID)
+	require.Len(t, stream.Messages, 3)
+	assert.Equal(t, DirectionRequest, stream.Messages[0].Direction)
+	assert.Equal(t, DirectionResponse, stream.Messages[2].Direction)
+
+	headers := &extProcPb.ProcessingRequest{}
+	require.NoError(t, protojson.Unmarshal(stream.Messages[0].Message, headers))
+	values := map[string]string{}
+	for _, header := range headers.GetRequestHeaders().GetHeaders().GetHeaders() {
+		values[header.GetKey()] = header.GetValue() + string(header.GetRawValue())
+	}
+	assert.Equal(t, "req-1", values["x-request-id"])
+	assert.Equal(t, redactedValue, values["Authorization"])
+	assert.Equal(t, redactedValue, values["x-tenant-token"])
+	assert.Equal(t, "application/json", values["content-type"])
+
+	body := &extProcPb.ProcessingRequest{}
+	require.NoError(t, protojson.Unmarshal(stream.Messages[1].Message, body))
+	assert.Empty(t, body.GetRequestBody().GetBody(), "bodies should be dropped by default")
+	assert.True(t, body.GetRequestBody().GetEndOfStream())
+
+	resp := &extProcPb.ProcessingResponse{}
+	require.NoError(t, protojson.Unmarshal(stream.Messages[2].Message, resp))
+	assert.Empty(t, resp.GetRequestBody().GetResponse().GetBodyMutation().GetBody())
+}
+
+func TestCapturerLimits(t *testing.T) {
+	cfg := config()
+	cfg.MaxMessagesPerStream = 1
+	cfg.MaxBodyBytes = 4
+	capturer := NewCapturer(cfg)
+
+	for range 3 {
+		srv := capturer.Wrap(&mockProcessServer{requests: []*extProcPb.ProcessingRequest{bodyRequest(), bodyRequest()}})
+		_, _ = srv.Recv()
+		_, _ = srv.Recv()
+		capturer.Finish(srv)
+	}
+	streams := capturer.Streams()
+	require.Len(t, streams, 2, "only the latest streams should be kept")
+	require.Len(t, streams[1].Messages, 1)
+	assert.Equal(t, 1, streams[1].DroppedMessages)
+	body := &extProcPb.ProcessingRequest{}
+	require.NoError(t, protojson.Unmarshal(streams[1].Messages[0].Message, body))
+	assert.Equal(t, []byte(`{"pr`), body.GetRequestBody().GetBody(), "bodies should be truncated")
+
+	capturer.Clear()
+	assert.Empty(t, capturer.Streams())
+}
+
+func TestCapturerSampling(t *testing.T) {
+	cfg := config()
+	cfg.SampleFraction = 0
+	capturer := NewCapturer(cfg)
+	mock := &mockProcessServer{}
+	srv := capturer.Wrap(mock)
+	assert.Same(t, mock, srv, "streams should not be captured when not sampled")
+	capturer.Finish(srv)
+	assert.Empty(t, capturer.Streams())
+}
+
+func TestConfigValidate(t *testing.T) {
+	assert.NoError(t, config().Validate())
+	for name, mutate := range map[string]func(*Config){
+		"sample fraction above 1": func(c *Config) { c.SampleFraction = 1.5 },
+		"no streams":              func(c *Config) { c.Streams = 0 },
+		"no messages":             func(c *Config) { c.MaxMessagesPerStream = 0 },
+		"negative body bytes":     func(c *Config) { c.MaxBodyBytes = -1 },
+	} {
+		cfg := config()
+		mutate(&cfg)
+		assert.Error(t, cfg.Validate(), name)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	capturer := NewCapturer(config())
+	srv := capturer.Wrap(&mockProcessServer{requests: []*extProcPb.ProcessingRequest{headersRequest()}})
+	_, _ = srv.Recv()
+	capturer.Finish(srv)
+	handler := NewHandler(capturer)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, HandlerPath, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	streams := []Stream{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &streams))
+	require.Len(t, streams, 1)
+	assert.NotContains(t, rec.Body.String(), "secret")
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, HandlerPath, nil))
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Empty(t, capturer.Streams())
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, HandlerPath, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
@@ -193,9 +193,26 @@ func ExpectGRPCRouteToWithStream(endpoint, prompt, method string) []*extProcPb.P
 	return buildGRPCRouteResponse(endpoint, prompt, method, true)
 }
 
-// ExpectReject asserts that the EPP immediately rejected the request with the given code and message.
-func ExpectReject(code envoyTypePb.StatusCode, msg string) []*extProcPb.ProcessingResponse {
-	return integration.NewImmediateErrorResponse(code, msg)
+// ExpectReject asserts that the EPP immediately rejected the request with the given HTTP status and JSON body. A
+// non-empty retryAfter is the expected value of the retry-after header.
+func ExpectReject(code envoyTypePb.StatusCode, body string, retryAfter string) []*extProcPb.ProcessingResponse {
+	headers := []*envoyCorev3.HeaderValueOption{
+		{Header: &envoyCorev3.HeaderValue{Key: "content-type", RawValue: []byte("application/json")}},
+	}
+	if retryAfter != "" {
+		headers = append(headers, &envoyCorev3.HeaderValueOption{
+			Header: &envoyCorev3.HeaderValue{Key: "retry-after", RawValue: []byte(retryAfter)},
+		})
+	}
+	return []*extProcPb.ProcessingResponse{{
+		Response: &extProcPb.ProcessingResponse_ImmediateResponse{
+			ImmediateResponse: &extProcPb.ImmediateResponse{
+				Status:  &envoyTypePb.HttpStatus{Code: code},
+				Headers: &extProcPb.HeaderMutation{SetHeaders: headers},
+				Body:    []byte(body),
+			},
+		},
+	}}
 }
 
 // ExpectBufferResp asserts that the EPP buffers the response and rewrites the body.
@@ -315,7 +332,8 @@ func commonTestCases(prio func(int) int) []testCase {
 			requests: integration.ReqHeaderOnly(map[string]string{"content-type": "application/json"}),
 			pods:     nil,
 			wantResponses: ExpectReject(envoyTypePb.StatusCode_InternalServerError,
-				"inference error: Internal - no pods available in datastore"),
+				`{"error":{"message":"no pods available in datastore","type":"server_error","param":null,"code":"internal_error"}}`,
+				""),
 		},
 		{
 			name: "request missing model field",
@@ -324,7 +342,8 @@ func commonTestCases(prio func(int) int) []testCase {
 				`{"prompt":"hello world"}`,
 			),
 			wantResponses: ExpectReject(envoyTypePb.StatusCode_BadRequest,
-				"inference error: BadRequest - model not found in request body"),
+				`{"error":{"message":"model not found in request body","type":"invalid_request_error","param":null,"code":"bad_request"}}`,
+				""),
 		},
 	}
 }
@@ -51,6 +51,7 @@ func TestFullDuplexStreamed_DataLayer(t *testing.T) {
 
 			if diff := cmp.Diff(tc.wantResponses, responses,
 				protocmp.Transform(),
+				integration.IgnoreSchedulingMetadata(),
 				protocmp.SortRepeated(func(a, b *configPb.HeaderValueOption) bool {
 					return a.GetHeader().GetKey() < b.GetHeader().GetKey()
 				}),
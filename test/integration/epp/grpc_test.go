@@ -136,7 +136,8 @@ func TestFullDuplexStreamed_GRPC_KubeInferenceObjectiveRequest(t *testing.T) {
 			},
 			wantResponses: ExpectReject(
 				envoyTypePb.StatusCode_BadRequest,
-				"inference error: BadRequest - parsing gRPC payload for Generate: not able to parse payload",
+				`{"error":{"message":"parsing gRPC payload for Generate: not able to parse payload","type":"invalid_request_error","param":null,"code":"bad_request"}}`,
+				"",
 			),
 		},
 		{
@@ -147,7 +148,8 @@ func TestFullDuplexStreamed_GRPC_KubeInferenceObjectiveRequest(t *testing.T) {
 			},
 			wantResponses: ExpectReject(
 				envoyTypePb.StatusCode_BadRequest,
-				"inference error: BadRequest - unsupported gRPC path: unsupportedMethod",
+				`{"error":{"message":"unsupported gRPC path: unsupportedMethod","type":"invalid_request_error","param":null,"code":"bad_request"}}`,
+				"",
 			),
 		},
 		{
@@ -183,7 +185,8 @@ func TestFullDuplexStreamed_GRPC_KubeInferenceObjectiveRequest(t *testing.T) {
 			requests: integration.ReqHeaderOnly(map[string]string{"content-type": "application/json"}),
 			pods:     nil,
 			wantResponses: ExpectReject(envoyTypePb.StatusCode_InternalServerError,
-				"inference error: Internal - no pods available in datastore"),
+				`{"error":{"message":"no pods available in datastore","type":"server_error","param":null,"code":"internal_error"}}`,
+				""),
 		},
 
 		// // --- Subsetting & Metadata ---
@@ -217,7 +220,8 @@ func TestFullDuplexStreamed_GRPC_KubeInferenceObjectiveRequest(t *testing.T) {
 				P(1, 0, 0.1, "foo", modelSQLLoraTarget),
 			},
 			wantResponses: ExpectReject(envoyTypePb.StatusCode_ServiceUnavailable,
-				"inference error: ServiceUnavailable - failed to find endpoint candidates for serving the request"),
+				`{"error":{"message":"failed to find endpoint candidates for serving the request","type":"server_error","param":null,"code":"service_unavailable"}}`,
+				"1"),
 		},
 
 		// --- Response Processing (Non-streaming) ---
@@ -482,6 +486,7 @@ func TestFullDuplexStreamed_GRPC_KubeInferenceObjectiveRequest(t *testing.T) {
 
 			if diff := cmp.Diff(tc.wantResponses, responses,
 				protocmp.Transform(),
+				integration.IgnoreSchedulingMetadata(),
 				protocmp.SortRepeated(func(a, b *configPb.HeaderValueOption) bool {
 					return a.GetHeader().GetKey() < b.GetHeader().GetKey()
 				}),
@@ -195,7 +195,8 @@ featureGates:
 					},
 					wantResponses: ExpectReject(
 						envoyTypePb.StatusCode_BadRequest,
-						"inference error: BadRequest - error unmarshaling request bodyMap: invalid character 'o' in literal null (expecting 'u')",
+						`{"error":{"message":"error unmarshaling request bodyMap: invalid character 'o' in literal null (expecting 'u')","type":"invalid_request_error","param":null,"code":"bad_request"}}`,
+						"",
 					),
 				},
 				{
@@ -224,7 +225,8 @@ featureGates:
 					requests: integration.ReqHeaderOnly(map[string]string{"content-type": "application/json"}),
 					pods:     nil,
 					wantResponses: ExpectReject(envoyTypePb.StatusCode_InternalServerError,
-						"inference error: Internal - no pods available in datastore"),
+						`{"error":{"message":"no pods available in datastore","type":"server_error","param":null,"code":"internal_error"}}`,
+						""),
 				},
 				{
 					name: "request missing model field",
@@ -233,7 +235,8 @@ featureGates:
 						`{"prompt":"hello world"}`,
 					),
 					wantResponses: ExpectReject(envoyTypePb.StatusCode_BadRequest,
-						"inference error: BadRequest - model not found in request body"),
+						`{"error":{"message":"model not found in request body","type":"invalid_request_error","param":null,"code":"bad_request"}}`,
+						""),
 				},
 
 				// --- Subsetting & Metadata ---
@@ -267,7 +270,8 @@ featureGates:
 						P(1, 0, 0.1, "foo", modelSQLLoraTarget),
 					},
 					wantResponses: ExpectReject(envoyTypePb.StatusCode_ServiceUnavailable,
-						"inference error: ServiceUnavailable - failed to find endpoint candidates for serving the request"),
+						`{"error":{"message":"failed to find endpoint candidates for serving the request","type":"server_error","param":null,"code":"service_unavailable"}}`,
+						"1"),
 				},
 
 				// --- Request Modification (Passthrough & Rewrite) ---
@@ -445,6 +449,7 @@ featureGates:
 
 					if diff := cmp.Diff(tc.wantResponses, responses,
 						protocmp.Transform(),
+						integration.IgnoreSchedulingMetadata(),
 						protocmp.SortRepeated(func(a, b *configPb.HeaderValueOption) bool {
 							return a.GetHeader().GetKey() < b.GetHeader().GetKey()
 						}),
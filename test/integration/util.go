@@ -38,6 +38,8 @@ import (
 	extProcPb "github.com/envoyproxy/go-control-plane/envoy/service/ext_proc/v3"
 	envoyTypePb "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -641,6 +643,14 @@ func GetFreePort() (int, error) {
 	return addr.Port, nil
 }
 
+// IgnoreSchedulingMetadata ignores the scheduling decision emitted as dynamic metadata when comparing responses,
+// its queue wait varying between runs.
+func IgnoreSchedulingMetadata() cmp.Option {
+	return cmpopts.IgnoreMapEntries(func(key string, _ any) bool {
+		return key == metadata.SchedulingMetadataNamespace
+	})
+}
+
 // --- Internal Helpers ---
 
 // makeDestinationMetadata helper to construct the Envoy dynamic metadata for routing.
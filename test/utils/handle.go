@@ -28,6 +28,7 @@ import (
 type testHandle struct {
 	ctx context.Context
 	plugin.HandlePlugins
+	sharedStore *plugin.SharedStore
 }
 
 // Context returns a context the plugins can use, if they need one
@@ -39,6 +40,10 @@ func (h *testHandle) PodList() []types.NamespacedName {
 	return []types.NamespacedName{}
 }
 
+func (h *testHandle) SharedStore() *plugin.SharedStore {
+	return h.sharedStore
+}
+
 type testHandlePlugins struct {
 	plugins map[string]plugin.Plugin
 }
@@ -69,5 +74,6 @@ func NewTestHandle(ctx context.Context) plugin.Handle {
 		HandlePlugins: &testHandlePlugins{
 			plugins: map[string]plugin.Plugin{},
 		},
+		sharedStore: plugin.NewSharedStore(ctx, plugin.DefaultSharedStoreTTL, plugin.DefaultSharedStoreCapacity),
 	}
 }